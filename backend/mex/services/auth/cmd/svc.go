@@ -10,6 +10,7 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 
 	"github.com/d4l-data4life/mex/mex/shared/cfg"
+	"github.com/d4l-data4life/mex/mex/shared/constants"
 	"github.com/d4l-data4life/mex/mex/shared/interceptors"
 	L "github.com/d4l-data4life/mex/mex/shared/log"
 	"github.com/d4l-data4life/mex/mex/shared/log/emit"
@@ -109,12 +110,32 @@ func setup(ctx context.Context, opts svcutils.SetupOpts) error {
 		}
 	}
 
+	var upstreamOptions *auth.UpstreamOptions
+	if upstream := opts.Config.Oauth.Server.Upstream; !cfg.StringIsEmpty(upstream.Issuer) {
+		upstreamOptions = &auth.UpstreamOptions{
+			Issuer:       upstream.Issuer,
+			ClientID:     upstream.ClientId,
+			ClientSecret: upstream.ClientSecret,
+			RedirectURI:  upstream.RedirectUri,
+			Scopes:       upstream.Scopes,
+
+			RolesClaim:          upstream.RolesClaim,
+			ProducerClaimValues: upstream.ProducerClaimValues,
+			ConsumerClaimValues: upstream.ConsumerClaimValues,
+			ForwardClaimValues:  upstream.ForwardClaimValues,
+
+			LoginValidity: upstream.LoginValidity.AsDuration(),
+		}
+	}
+
 	authService, err := auth.NewService(auth.ServiceOptions{
 		ServiceTag: serviceTag,
 		Log:        opts.Log,
 
 		Redis: opts.Redis,
 
+		RevocationTopicName: fmt.Sprintf("%s/%s", opts.Config.Redis.PubSubPrefix, constants.TokenRevocationChannelNameSuffix),
+
 		ClientID:        opts.Config.Oauth.ClientId,
 		ProducerGroupID: opts.Config.Oauth.ProducerGroupId,
 		ConsumerGroupID: opts.Config.Oauth.ConsumerGroupId,
@@ -129,6 +150,8 @@ func setup(ctx context.Context, opts svcutils.SetupOpts) error {
 		AccessTokenValidity:  opts.Config.Oauth.Server.AccessTokenValidity.AsDuration(),
 		RefreshTokenValidity: opts.Config.Oauth.Server.RefreshTokenValidity.AsDuration(),
 
+		Upstream: upstreamOptions,
+
 		TelemetryService: opts.TelemetryService,
 	})
 	if err != nil {
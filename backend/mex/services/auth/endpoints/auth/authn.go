@@ -11,6 +11,7 @@ import (
 	"github.com/lestrrat-go/jwx/v2/jwk"
 	"google.golang.org/grpc/codes"
 
+	mexauth "github.com/d4l-data4life/mex/mex/shared/auth"
 	E "github.com/d4l-data4life/mex/mex/shared/errstat"
 	"github.com/d4l-data4life/mex/mex/shared/hints"
 	"github.com/d4l-data4life/mex/mex/shared/known/statuspb"
@@ -27,6 +28,9 @@ type ServiceOptions struct {
 
 	Redis *redis.Client
 
+	// Topic on which revocations are announced to the services caching revocation checks
+	RevocationTopicName string
+
 	ClientID        string
 	ProducerGroupID string
 	ConsumerGroupID string
@@ -41,12 +45,18 @@ type ServiceOptions struct {
 	AccessTokenValidity  time.Duration
 	RefreshTokenValidity time.Duration
 
+	// If set, users of the authorization code flow log in at this upstream OpenID Connect provider
+	Upstream *UpstreamOptions
+
 	TelemetryService *telemetry.Service
 }
 
 type Service struct {
 	options    ServiceOptions
 	signingKey jwk.Key
+	upstream   *oidcRelyingParty
+
+	revocations *mexauth.RevocationList
 
 	grantFlowClientCredentialsEnabled bool
 	grantFlowAuthorizationCodeEnabled bool
@@ -64,7 +74,10 @@ func NewService(options ServiceOptions) (*Service, error) {
 		return nil, fmt.Errorf("logger is nil")
 	}
 
-	svc := Service{options: options}
+	svc := Service{
+		options:     options,
+		revocations: mexauth.NewRevocationList(options.Redis, options.RevocationTopicName, 0),
+	}
 
 	// This key pair will be used for signing and validating JWTs that this service issues.
 	pem, err := io.ReadAll(options.SigningKeyPEMReader)
@@ -96,6 +109,14 @@ func NewService(options ServiceOptions) (*Service, error) {
 		}
 	}
 
+	if options.Upstream != nil {
+		svc.upstream, err = newOIDCRelyingParty(*options.Upstream)
+		if err != nil {
+			return nil, err
+		}
+		options.Log.Info(context.Background(), L.Messagef("authorization code flow delegates login to upstream provider: %s", options.Upstream.Issuer))
+	}
+
 	if len(options.RedirectURIs) == 0 {
 		options.Log.Warn(context.Background(), L.Message("no redirect URIs specified; authorization code grant flow will now work"))
 	}
@@ -136,31 +157,58 @@ func (svc *Service) Authorize(ctx context.Context, request *pbAuth.AuthorizeRequ
 		return nil, E.MakeGRPCStatus(codes.Unauthenticated, "redirect URI not whitelisted").Err()
 	}
 
+	if svc.upstream != nil {
+		return svc.redirectToUpstream(ctx, request)
+	}
+
+	err := svc.issueAuthCode(ctx, request, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	svc.options.Log.BIEvent(ctx, L.BIActivity("auth-authorize"), L.BIData(BILog{GrantType: "authorization_code"}))
+	return &pbAuth.AuthorizeResponse{}, nil
+}
+
+/*
+issueAuthCode stores the authorization data under the code challenge and redirects the user agent back to the client
+with the authorization code. Without an identity (i.e. without upstream provider), the token is issued to an anonymous
+consumer.
+*/
+func (svc *Service) issueAuthCode(ctx context.Context, request *pbAuth.AuthorizeRequest, identity *TokenData) error {
 	authCode := randomString()
 
-	hashName := redisAuthHashName(request.CodeChallenge)
-	cmdHSet := svc.options.Redis.HSet(ctx, hashName,
+	values := []any{
 		"state", request.State,
 		"response_mode", request.ResponseMode,
 		"redirect_uri", request.RedirectUri,
 		"scope", request.Scope,
 		"authCode", authCode,
 		"client_id", request.ClientId,
-	)
+	}
+	if identity != nil {
+		values = append(values,
+			"oid", identity.OID,
+			"sub", identity.Subject,
+			"email", identity.Email,
+			"groups", encodeGroups(identity.Groups),
+		)
+	}
+
+	hashName := redisAuthHashName(request.CodeChallenge)
+	cmdHSet := svc.options.Redis.HSet(ctx, hashName, values...)
 	if cmdHSet.Err() != nil {
-		return nil, E.MakeGRPCStatus(codes.Internal, "could not store authentication data in Redis", E.Cause(cmdHSet.Err())).Err()
+		return E.MakeGRPCStatus(codes.Internal, "could not store authentication data in Redis", E.Cause(cmdHSet.Err())).Err()
 	}
 
 	cmdExpire := svc.options.Redis.Expire(ctx, hashName, svc.options.AuthCodeValidity)
 	if cmdExpire.Err() != nil {
-		return nil, E.MakeGRPCStatus(codes.Internal, "could set expiration on authentication data in Redis", E.Cause(cmdExpire.Err())).Err()
+		return E.MakeGRPCStatus(codes.Internal, "could set expiration on authentication data in Redis", E.Cause(cmdExpire.Err())).Err()
 	}
 
 	hints.HintHTTPLocation(ctx, fmt.Sprintf("%s%scode=%s&state=%s", request.RedirectUri, fragmentOrQuery(request.ResponseMode), authCode, request.State))
 	hints.HintHTTPStatusCode(ctx, http.StatusFound) // 302
-
-	svc.options.Log.BIEvent(ctx, L.BIActivity("auth-authorize"), L.BIData(BILog{GrantType: "authorization_code"}))
-	return &pbAuth.AuthorizeResponse{}, nil
+	return nil
 }
 
 func (svc *Service) Token(ctx context.Context, request *pbAuth.TokenRequest) (*pbAuth.TokenResponse, error) {
@@ -240,7 +288,14 @@ func (svc *Service) tokenViaAuthorizationCodeFlow(ctx context.Context, request *
 		AppID:     svc.options.ClientID,
 		Groups:    []string{svc.options.ConsumerGroupID},
 		Email:     "anonymous",
-		Subject:   "anonymous",
+		Subject:   anonymousSubject,
+	}
+	// Users who logged in at the upstream provider get a token for their identity.
+	if oid, ok := redisHashValues["oid"]; ok {
+		accessTokenData.OID = oid
+		accessTokenData.Subject = redisHashValues["sub"]
+		accessTokenData.Email = redisHashValues["email"]
+		accessTokenData.Groups = decodeGroups(redisHashValues["groups"])
 	}
 
 	signedAccessTokenString, err := accessTokenData.toJWT(svc.signingKey, svc.options.AccessTokenValidity)
@@ -285,7 +340,7 @@ func (svc *Service) tokenViaClientCredentialsFlow(ctx context.Context, request *
 		GrantFlow: grantTypeClientCredentials,
 		AppID:     svc.options.ClientID,
 		Groups:    []string{svc.options.ConsumerGroupID, svc.options.ProducerGroupID},
-		Subject:   "anonymous",
+		Subject:   anonymousSubject,
 	}
 
 	signedAccessTokenString, err := accessTokenData.toJWT(svc.signingKey, svc.options.AccessTokenValidity)
@@ -334,6 +389,16 @@ func (svc *Service) tokenViaRefreshTokenFlow(ctx context.Context, request *pbAut
 		return nil, err
 	}
 
+	// Sessions of users who logged out everywhere are ended lazily here if they were not indexed.
+	revoked, err := svc.revocations.IsRevoked(ctx, "", accessTokenData.Subject, accessTokenData.Created)
+	if err != nil {
+		return nil, E.MakeGRPCStatus(codes.Internal, "could not check token revocation", E.Cause(err)).Err()
+	}
+	if revoked {
+		svc.options.Redis.Del(ctx, redisAuthRefreshTokenHashName(request.RefreshToken))
+		return nil, E.MakeGRPCStatus(codes.Unauthenticated, "refresh token has been revoked").Err()
+	}
+
 	signedAccessTokenString, err := accessTokenData.toJWT(svc.signingKey, svc.options.AccessTokenValidity)
 	if err != nil {
 		return nil, err
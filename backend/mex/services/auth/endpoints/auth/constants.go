@@ -14,5 +14,11 @@ const (
 	grantTypeAuthorizationCode = "0"
 	grantTypeClientCredentials = "1"
 
+	tokenTypeHintAccessToken  = "access_token"
+	tokenTypeHintRefreshToken = "refresh_token"
+
 	refreshTokenLength = 16
+
+	// Subject of all users without upstream identity
+	anonymousSubject = "anonymous"
 )
@@ -0,0 +1,132 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	E "github.com/d4l-data4life/mex/mex/shared/errstat"
+	"github.com/d4l-data4life/mex/mex/shared/hints"
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+
+	pbAuth "github.com/d4l-data4life/mex/mex/services/auth/endpoints/auth/pb"
+)
+
+/*
+With an upstream provider, the authorization code flow takes a detour: Authorize keeps the client's request under a new
+random state and sends the user agent to the upstream provider (using its own PKCE challenge and a nonce). The provider
+redirects back to Callback, which redeems the upstream code, validates the ID token, and only then issues the MEx
+authorization code to the client as in the flow without upstream provider.
+*/
+
+// redirectToUpstream stores the client's authorization request and redirects the user agent to the upstream provider
+func (svc *Service) redirectToUpstream(ctx context.Context, request *pbAuth.AuthorizeRequest) (*pbAuth.AuthorizeResponse, error) {
+	state := randomString()
+	nonce := randomString()
+	codeVerifier := randomCodeVerifier()
+
+	authCodeURL, err := svc.upstream.authCodeURL(ctx, state, nonce, sha256B64Safe(codeVerifier))
+	if err != nil {
+		return nil, E.MakeGRPCStatus(codes.Unavailable, "upstream identity provider not available", E.Cause(err)).Err()
+	}
+
+	clientRequest, err := protojson.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	hashName := redisLoginHashName(state)
+	cmdHSet := svc.options.Redis.HSet(ctx, hashName,
+		"request", string(clientRequest),
+		"code_verifier", codeVerifier,
+		"nonce", nonce,
+	)
+	if cmdHSet.Err() != nil {
+		return nil, E.MakeGRPCStatus(codes.Internal, "could not store login data in Redis", E.Cause(cmdHSet.Err())).Err()
+	}
+
+	cmdExpire := svc.options.Redis.Expire(ctx, hashName, svc.upstream.options.LoginValidity)
+	if cmdExpire.Err() != nil {
+		return nil, E.MakeGRPCStatus(codes.Internal, "could set expiration on login data in Redis", E.Cause(cmdExpire.Err())).Err()
+	}
+
+	hints.HintHTTPLocation(ctx, authCodeURL)
+	hints.HintHTTPStatusCode(ctx, http.StatusFound) // 302
+
+	svc.options.Log.BIEvent(ctx, L.BIActivity("auth-authorize"), L.BIData(BILog{GrantType: "authorization_code"}))
+	return &pbAuth.AuthorizeResponse{}, nil
+}
+
+// Callback completes the login at the upstream provider and issues the authorization code to the client.
+func (svc *Service) Callback(ctx context.Context, request *pbAuth.CallbackRequest) (*pbAuth.CallbackResponse, error) {
+	if svc.upstream == nil {
+		return nil, E.MakeGRPCStatus(codes.FailedPrecondition, "no upstream identity provider configured").Err()
+	}
+
+	if request.State == "" {
+		return nil, E.MakeGRPCStatus(codes.Unauthenticated, "state missing").Err()
+	}
+
+	// The login data can only be used once: it is read and deleted atomically, so that concurrent callbacks with the
+	// same state cannot both succeed.
+	hashName := redisLoginHashName(request.State)
+	pipe := svc.options.Redis.TxPipeline()
+	cmdHGetAll := pipe.HGetAll(ctx, hashName)
+	pipe.Del(ctx, hashName)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, E.MakeGRPCStatus(codes.Internal, "could not read login data from Redis", E.Cause(err)).Err()
+	}
+
+	loginData := cmdHGetAll.Val()
+	rawClientRequest, ok := loginData["request"]
+	if !ok {
+		return nil, E.MakeGRPCStatus(codes.Unauthenticated, "unknown or expired login").Err()
+	}
+
+	var clientRequest pbAuth.AuthorizeRequest
+	if err := protojson.Unmarshal([]byte(rawClientRequest), &clientRequest); err != nil {
+		return nil, E.MakeGRPCStatus(codes.Internal, "malformed login data", E.Cause(err)).Err()
+	}
+
+	// Pass errors of the upstream provider on to the client, cf. RFC 6749 sec. 4.1.2.1
+	if request.Error != "" {
+		svc.options.Log.Warn(ctx, L.Messagef("upstream login failed: %s: %s", request.Error, request.ErrorDescription))
+		redirectWithError(ctx, &clientRequest, request.Error)
+		return &pbAuth.CallbackResponse{}, nil
+	}
+
+	if request.Code == "" {
+		return nil, E.MakeGRPCStatus(codes.Unauthenticated, "authorization code missing").Err()
+	}
+
+	identity, err := svc.upstream.exchange(ctx, request.Code, loginData["code_verifier"], loginData["nonce"])
+	if err != nil {
+		svc.options.Log.Warn(ctx, L.Messagef("upstream login failed: %s", err.Error()))
+		redirectWithError(ctx, &clientRequest, "access_denied")
+		return &pbAuth.CallbackResponse{}, nil
+	}
+
+	err = svc.issueAuthCode(ctx, &clientRequest, &TokenData{
+		OID:     identity.OID,
+		Subject: identity.Subject,
+		Email:   identity.Email,
+		Groups:  svc.upstream.groups(identity, svc.options.ProducerGroupID, svc.options.ConsumerGroupID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	svc.options.Log.Info(ctx, L.Messagef("upstream login succeeded: %s", identity.OID))
+	return &pbAuth.CallbackResponse{}, nil
+}
+
+// redirectWithError sends the user agent back to the client with an OAuth error code
+func redirectWithError(ctx context.Context, clientRequest *pbAuth.AuthorizeRequest, errorCode string) {
+	hints.HintHTTPLocation(ctx, fmt.Sprintf("%s%serror=%s&state=%s",
+		clientRequest.RedirectUri, fragmentOrQuery(clientRequest.ResponseMode), url.QueryEscape(errorCode), url.QueryEscape(clientRequest.State)))
+	hints.HintHTTPStatusCode(ctx, http.StatusFound) // 302
+}
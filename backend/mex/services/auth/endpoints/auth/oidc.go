@@ -0,0 +1,362 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	b64 "encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jws"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+
+	"github.com/d4l-data4life/mex/mex/shared/utils"
+)
+
+const (
+	oidcDiscoveryPath = "/.well-known/openid-configuration"
+
+	// Length of the random PKCE code verifier (in bytes before encoding), cf. RFC 7636 sec. 4.1
+	codeVerifierLength = 32
+)
+
+/*
+UpstreamOptions configure the auth service as a relying party of an upstream OpenID Connect provider. Users of the
+authorization code flow then log in at the upstream provider, and the auth service mints MEx tokens from the identity
+asserted by the upstream ID token.
+*/
+type UpstreamOptions struct {
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURI  string
+	Scopes       []string
+
+	// Claim values of RolesClaim granting the MEx producer and consumer groups. Without consumer claim values, all
+	// upstream users are consumers.
+	RolesClaim          string
+	ProducerClaimValues []string
+	ConsumerClaimValues []string
+	ForwardClaimValues  bool
+
+	LoginValidity time.Duration
+}
+
+// oidcProviderMetadata is the part of the provider's discovery document used by the relying party
+type oidcProviderMetadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type oidcTokenResponse struct {
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// upstreamIdentity is the user identity asserted by the upstream ID token
+type upstreamIdentity struct {
+	Subject     string
+	OID         string
+	Email       string
+	ClaimValues []string
+}
+
+type oidcRelyingParty struct {
+	options    UpstreamOptions
+	httpClient *http.Client
+
+	// The discovery document is fetched lazily so that the auth service can start while the provider is unavailable.
+	mu       sync.Mutex
+	metadata *oidcProviderMetadata
+
+	// The provider's signing keys are cached until an ID token is signed with an unknown key (key rotation).
+	keysMu sync.Mutex
+	keys   jwk.Set
+}
+
+func newOIDCRelyingParty(options UpstreamOptions) (*oidcRelyingParty, error) {
+	if options.ClientID == "" {
+		return nil, fmt.Errorf("upstream client ID missing")
+	}
+	if options.RedirectURI == "" {
+		return nil, fmt.Errorf("upstream redirect URI missing")
+	}
+	if !utils.Contains(options.Scopes, "openid") {
+		options.Scopes = append([]string{"openid"}, options.Scopes...)
+	}
+
+	return &oidcRelyingParty{
+		options:    options,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// discover returns the provider metadata, fetching the discovery document on first use
+func (rp *oidcRelyingParty) discover(ctx context.Context) (*oidcProviderMetadata, error) {
+	rp.mu.Lock()
+	defer rp.mu.Unlock()
+
+	if rp.metadata != nil {
+		return rp.metadata, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(rp.options.Issuer, "/")+oidcDiscoveryPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := rp.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("OIDC discovery failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("OIDC discovery failed with status code %d", resp.StatusCode)
+	}
+
+	var metadata oidcProviderMetadata
+	if err := json.NewDecoder(resp.Body).Decode(&metadata); err != nil {
+		return nil, fmt.Errorf("malformed OIDC discovery document: %w", err)
+	}
+	if strings.TrimSuffix(metadata.Issuer, "/") != strings.TrimSuffix(rp.options.Issuer, "/") {
+		return nil, fmt.Errorf("OIDC discovery document is for a different issuer: %s", metadata.Issuer)
+	}
+	if metadata.AuthorizationEndpoint == "" || metadata.TokenEndpoint == "" || metadata.JWKSURI == "" {
+		return nil, fmt.Errorf("OIDC discovery document lacks endpoints")
+	}
+
+	rp.metadata = &metadata
+	return rp.metadata, nil
+}
+
+// authCodeURL returns the URL of the upstream authorization endpoint to which the user is sent to log in
+func (rp *oidcRelyingParty) authCodeURL(ctx context.Context, state string, nonce string, codeChallenge string) (string, error) {
+	metadata, err := rp.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	u, err := url.Parse(metadata.AuthorizationEndpoint)
+	if err != nil {
+		return "", err
+	}
+	q := u.Query()
+	q.Set("response_type", responseTypeAuthCode)
+	q.Set("client_id", rp.options.ClientID)
+	q.Set("redirect_uri", rp.options.RedirectURI)
+	q.Set("scope", strings.Join(rp.options.Scopes, " "))
+	q.Set("state", state)
+	q.Set("nonce", nonce)
+	q.Set("code_challenge", codeChallenge)
+	q.Set("code_challenge_method", codeChallengeMethod)
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+// exchange redeems the upstream authorization code and returns the identity asserted by the validated ID token
+func (rp *oidcRelyingParty) exchange(ctx context.Context, code string, codeVerifier string, nonce string) (*upstreamIdentity, error) {
+	metadata, err := rp.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", grantAuthorizationCode)
+	form.Set("code", code)
+	form.Set("redirect_uri", rp.options.RedirectURI)
+	form.Set("client_id", rp.options.ClientID)
+	form.Set("code_verifier", codeVerifier)
+	if rp.options.ClientSecret != "" {
+		form.Set("client_secret", rp.options.ClientSecret)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, metadata.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := rp.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("upstream token request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var tokenResponse oidcTokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResponse); err != nil {
+		return nil, fmt.Errorf("malformed upstream token response (status code %d): %w", resp.StatusCode, err)
+	}
+	if resp.StatusCode != http.StatusOK || tokenResponse.Error != "" {
+		return nil, fmt.Errorf("upstream token request failed with status code %d: %s %s", resp.StatusCode, tokenResponse.Error, tokenResponse.ErrorDescription)
+	}
+	if tokenResponse.IDToken == "" {
+		return nil, fmt.Errorf("upstream token response contains no ID token")
+	}
+
+	return rp.validateIDToken(ctx, metadata, tokenResponse.IDToken, nonce)
+}
+
+// validateIDToken checks signature, issuer, audience, expiry and nonce of the ID token and extracts the identity
+func (rp *oidcRelyingParty) validateIDToken(ctx context.Context, metadata *oidcProviderMetadata, idToken string, nonce string) (*upstreamIdentity, error) {
+	message, err := jws.Parse([]byte(idToken))
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID token: %w", err)
+	}
+	keyID := ""
+	if signatures := message.Signatures(); len(signatures) > 0 {
+		keyID = signatures[0].ProtectedHeaders().KeyID()
+	}
+
+	keySet, err := rp.keySet(ctx, metadata.JWKSURI, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := jwt.Parse([]byte(idToken),
+		jwt.WithKeySet(keySet, jws.WithInferAlgorithmFromKey(true)),
+		jwt.WithValidate(true),
+		jwt.WithIssuer(metadata.Issuer),
+		jwt.WithAudience(rp.options.ClientID),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID token: %w", err)
+	}
+
+	if tokenNonce, _ := token.Get("nonce"); tokenNonce != nonce {
+		return nil, fmt.Errorf("invalid ID token: nonce mismatch")
+	}
+	if token.Subject() == "" {
+		return nil, fmt.Errorf("invalid ID token: no subject")
+	}
+
+	identity := upstreamIdentity{
+		Subject:     token.Subject(),
+		OID:         stringClaim(token, "oid"),
+		Email:       stringClaim(token, "email"),
+		ClaimValues: stringsClaim(token, rp.options.RolesClaim),
+	}
+	if identity.OID == "" {
+		identity.OID = identity.Subject
+	}
+	return &identity, nil
+}
+
+// keySet returns the cached signing keys of the provider, (re)fetching them if there is no key with the given key ID
+func (rp *oidcRelyingParty) keySet(ctx context.Context, jwksURI string, keyID string) (jwk.Set, error) {
+	rp.keysMu.Lock()
+	defer rp.keysMu.Unlock()
+
+	if rp.keys != nil {
+		// Without key ID, the key is inferred from the cached set.
+		if _, ok := rp.keys.LookupKeyID(keyID); ok || keyID == "" {
+			return rp.keys, nil
+		}
+	}
+
+	keys, err := rp.fetchKeys(ctx, jwksURI)
+	if err != nil {
+		return nil, err
+	}
+	rp.keys = keys
+	return keys, nil
+}
+
+func (rp *oidcRelyingParty) fetchKeys(ctx context.Context, jwksURI string) (jwk.Set, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, jwksURI, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := rp.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetching upstream keys failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching upstream keys failed with status code %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return jwk.Parse(data)
+}
+
+// groups maps the claim values of the upstream identity to the groups of the MEx token
+func (rp *oidcRelyingParty) groups(identity *upstreamIdentity, producerGroupID string, consumerGroupID string) []string {
+	groups := []string{}
+
+	isConsumer := len(rp.options.ConsumerClaimValues) == 0
+	isProducer := false
+	for _, value := range identity.ClaimValues {
+		isConsumer = isConsumer || utils.Contains(rp.options.ConsumerClaimValues, value)
+		isProducer = isProducer || utils.Contains(rp.options.ProducerClaimValues, value)
+	}
+	if isConsumer {
+		groups = append(groups, consumerGroupID)
+	}
+	if isProducer {
+		groups = append(groups, producerGroupID)
+	}
+
+	if rp.options.ForwardClaimValues {
+		for _, value := range identity.ClaimValues {
+			if !utils.Contains(groups, value) {
+				groups = append(groups, value)
+			}
+		}
+	}
+	return groups
+}
+
+func stringClaim(token jwt.Token, name string) string {
+	value, ok := token.Get(name)
+	if !ok {
+		return ""
+	}
+	s, _ := value.(string)
+	return s
+}
+
+// stringsClaim returns the values of a claim which is either a string or an array of strings
+func stringsClaim(token jwt.Token, name string) []string {
+	value, ok := token.Get(name)
+	if !ok {
+		return []string{}
+	}
+
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		values := []string{}
+		for _, element := range v {
+			if s, ok := element.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	default:
+		return []string{}
+	}
+}
+
+// randomCodeVerifier returns a PKCE code verifier (43 characters from the unreserved URL characters)
+func randomCodeVerifier() string {
+	t := make([]byte, codeVerifierLength)
+	_, _ = rand.Read(t)
+	return b64.RawURLEncoding.EncodeToString(t)
+}
@@ -0,0 +1,282 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+)
+
+// mockIdP is a minimal OpenID Connect provider issuing ID tokens for a fixed user
+type mockIdP struct {
+	server     *httptest.Server
+	signingKey jwk.Key
+
+	audience     string
+	nonce        string
+	codeVerifier string
+	claims       map[string]any
+
+	keyRequests int
+}
+
+func newSigningKey(t *testing.T, keyID string) jwk.Key {
+	rawKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signingKey, err := jwk.FromRaw(rawKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = signingKey.Set(jwk.KeyIDKey, keyID)
+	_ = signingKey.Set(jwk.AlgorithmKey, jwa.RS256)
+	return signingKey
+}
+
+func newMockIdP(t *testing.T) *mockIdP {
+	idp := &mockIdP{signingKey: newSigningKey(t, "mock"), claims: map[string]any{}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(oidcDiscoveryPath, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(oidcProviderMetadata{
+			Issuer:                idp.server.URL,
+			AuthorizationEndpoint: idp.server.URL + "/authorize",
+			TokenEndpoint:         idp.server.URL + "/token",
+			JWKSURI:               idp.server.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		idp.keyRequests++
+		publicKey, _ := idp.signingKey.PublicKey()
+		set := jwk.NewSet()
+		_ = set.AddKey(publicKey)
+		_ = json.NewEncoder(w).Encode(set)
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		if r.PostForm.Get("code") != "upstream-code" || r.PostForm.Get("code_verifier") != idp.codeVerifier {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(oidcTokenResponse{Error: "invalid_grant"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(oidcTokenResponse{IDToken: idp.idToken(t)})
+	})
+	idp.server = httptest.NewServer(mux)
+	t.Cleanup(idp.server.Close)
+
+	return idp
+}
+
+func (idp *mockIdP) idToken(t *testing.T) string {
+	builder := jwt.NewBuilder().
+		Issuer(idp.server.URL).
+		Audience([]string{idp.audience}).
+		Subject("user-1").
+		IssuedAt(time.Now()).
+		Expiration(time.Now().Add(time.Minute)).
+		Claim("nonce", idp.nonce)
+	for name, value := range idp.claims {
+		builder = builder.Claim(name, value)
+	}
+	token, err := builder.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed, err := jwt.Sign(token, jwt.WithKey(jwa.RS256, idp.signingKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(signed)
+}
+
+func TestOIDCRelyingParty_exchange(t *testing.T) {
+	tests := []struct {
+		name     string
+		audience string
+		nonce    string
+		code     string
+		want     *upstreamIdentity
+		wantErr  bool
+	}{
+		{
+			name:     "Valid ID token",
+			audience: "mex",
+			nonce:    "nonce-1",
+			code:     "upstream-code",
+			want:     &upstreamIdentity{Subject: "user-1", OID: "user-1", Email: "jane@example.org", ClaimValues: []string{"staff", "editors"}},
+		},
+		{
+			name:     "ID token for another client",
+			audience: "other",
+			nonce:    "nonce-1",
+			code:     "upstream-code",
+			wantErr:  true,
+		},
+		{
+			name:     "Nonce mismatch",
+			audience: "mex",
+			nonce:    "nonce-2",
+			code:     "upstream-code",
+			wantErr:  true,
+		},
+		{
+			name:     "Rejected code",
+			audience: "mex",
+			nonce:    "nonce-1",
+			code:     "wrong-code",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idp := newMockIdP(t)
+			idp.audience = tt.audience
+			idp.nonce = tt.nonce
+			idp.codeVerifier = randomCodeVerifier()
+			idp.claims["email"] = "jane@example.org"
+			idp.claims["groups"] = []string{"staff", "editors"}
+
+			rp, err := newOIDCRelyingParty(UpstreamOptions{
+				Issuer:      idp.server.URL,
+				ClientID:    "mex",
+				RedirectURI: "https://mex.example.org/api/v0/oauth/callback",
+				RolesClaim:  "groups",
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := rp.exchange(context.Background(), tt.code, idp.codeVerifier, "nonce-1")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("exchange() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("exchange() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOIDCRelyingParty_keySet(t *testing.T) {
+	idp := newMockIdP(t)
+	idp.audience = "mex"
+	idp.nonce = "nonce-1"
+	idp.codeVerifier = randomCodeVerifier()
+
+	rp, err := newOIDCRelyingParty(UpstreamOptions{
+		Issuer:      idp.server.URL,
+		ClientID:    "mex",
+		RedirectURI: "https://mex.example.org/api/v0/oauth/callback",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	steps := []struct {
+		name            string
+		rotate          bool
+		wantKeyRequests int
+	}{
+		{name: "Keys are fetched on first use", wantKeyRequests: 1},
+		{name: "Keys are cached", wantKeyRequests: 1},
+		{name: "Keys are refetched after a key rotation", rotate: true, wantKeyRequests: 2},
+		{name: "Rotated keys are cached", wantKeyRequests: 2},
+	}
+	for _, step := range steps {
+		if step.rotate {
+			idp.signingKey = newSigningKey(t, "mock-2")
+		}
+		if _, err := rp.exchange(context.Background(), "upstream-code", idp.codeVerifier, "nonce-1"); err != nil {
+			t.Fatalf("%s: exchange() error = %v", step.name, err)
+		}
+		if idp.keyRequests != step.wantKeyRequests {
+			t.Errorf("%s: key requests = %d, want %d", step.name, idp.keyRequests, step.wantKeyRequests)
+		}
+	}
+}
+
+func TestOIDCRelyingParty_authCodeURL(t *testing.T) {
+	idp := newMockIdP(t)
+	rp, err := newOIDCRelyingParty(UpstreamOptions{
+		Issuer:      idp.server.URL,
+		ClientID:    "mex",
+		RedirectURI: "https://mex.example.org/api/v0/oauth/callback",
+		Scopes:      []string{"email"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := rp.authCodeURL(context.Background(), "s1", "n1", "c1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, _ := url.Parse(got)
+	want := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {"mex"},
+		"redirect_uri":          {"https://mex.example.org/api/v0/oauth/callback"},
+		"scope":                 {"openid email"},
+		"state":                 {"s1"},
+		"nonce":                 {"n1"},
+		"code_challenge":        {"c1"},
+		"code_challenge_method": {"S256"},
+	}
+	if u.Path != "/authorize" || !reflect.DeepEqual(u.Query(), want) {
+		t.Errorf("authCodeURL() = %v", got)
+	}
+}
+
+func TestOIDCRelyingParty_groups(t *testing.T) {
+	tests := []struct {
+		name    string
+		options UpstreamOptions
+		values  []string
+		want    []string
+	}{
+		{
+			name:    "Without consumer claim values, everyone is a consumer",
+			options: UpstreamOptions{ProducerClaimValues: []string{"editors"}},
+			values:  []string{"staff"},
+			want:    []string{"consumer-group"},
+		},
+		{
+			name:    "Producer claim value",
+			options: UpstreamOptions{ProducerClaimValues: []string{"editors"}},
+			values:  []string{"staff", "editors"},
+			want:    []string{"consumer-group", "producer-group"},
+		},
+		{
+			name:    "Consumer claim value required",
+			options: UpstreamOptions{ConsumerClaimValues: []string{"staff"}},
+			values:  []string{"guests"},
+			want:    []string{},
+		},
+		{
+			name:    "Forwarded claim values",
+			options: UpstreamOptions{ConsumerClaimValues: []string{"staff"}, ForwardClaimValues: true},
+			values:  []string{"staff", "curators"},
+			want:    []string{"consumer-group", "staff", "curators"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rp := &oidcRelyingParty{options: tt.options}
+			got := rp.groups(&upstreamIdentity{ClaimValues: tt.values}, "producer-group", "consumer-group")
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("groups() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
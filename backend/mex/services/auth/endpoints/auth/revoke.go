@@ -0,0 +1,193 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"google.golang.org/grpc/codes"
+
+	E "github.com/d4l-data4life/mex/mex/shared/errstat"
+	"github.com/d4l-data4life/mex/mex/shared/hints"
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+	"github.com/d4l-data4life/mex/mex/shared/utils"
+
+	pbAuth "github.com/d4l-data4life/mex/mex/services/auth/endpoints/auth/pb"
+)
+
+/*
+Revoke ends the session of a refresh token or puts an access token on the revocation list, which is consulted by all
+services validating tokens. The token type hint is ignored as both token types are easily told apart. Unknown or
+invalid tokens are no error (cf. RFC 7009 sec. 2.2).
+*/
+func (svc *Service) Revoke(ctx context.Context, request *pbAuth.RevokeRequest) (*pbAuth.RevokeResponse, error) {
+	err := svc.authenticateClient(request.ClientId, request.ClientSecret, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Token == "" {
+		return nil, E.MakeGRPCStatus(codes.InvalidArgument, "token missing").Err()
+	}
+
+	ended, err := svc.endSession(ctx, redisAuthRefreshTokenHashName(request.Token))
+	if err != nil {
+		return nil, err
+	}
+
+	if !ended {
+		if token, err := svc.parseAccessToken(request.Token); err == nil {
+			err = svc.revocations.RevokeToken(ctx, token.JwtID(), token.Expiration())
+			if err != nil {
+				return nil, E.MakeGRPCStatus(codes.Internal, "could not revoke access token", E.Cause(err)).Err()
+			}
+		}
+	}
+
+	svc.options.Log.BIEvent(ctx, L.BIActivity("token-revoke"))
+
+	hints.HintHTTPCacheControl(ctx, "no-store")
+	hints.HintHTTPPragma(ctx, "no-cache")
+	return &pbAuth.RevokeResponse{}, nil
+}
+
+/*
+Introspect tells resource servers whether a token is active, i.e. issued by this service, not expired, and not revoked,
+and returns its meta data (cf. RFC 7662). Only confidential clients may introspect tokens.
+*/
+func (svc *Service) Introspect(ctx context.Context, request *pbAuth.IntrospectRequest) (*pbAuth.IntrospectResponse, error) {
+	err := svc.authenticateClient(request.ClientId, request.ClientSecret, true)
+	if err != nil {
+		return nil, err
+	}
+
+	hints.HintHTTPCacheControl(ctx, "no-store")
+	hints.HintHTTPPragma(ctx, "no-cache")
+
+	if request.Token == "" {
+		return &pbAuth.IntrospectResponse{Active: false}, nil
+	}
+
+	session, err := svc.readSession(ctx, redisAuthRefreshTokenHashName(request.Token))
+	if err != nil {
+		return nil, E.MakeGRPCStatus(codes.Internal, "could not read session", E.Cause(err)).Err()
+	}
+	if session != nil {
+		revoked, err := svc.revocations.IsRevoked(ctx, "", session.Subject, session.CreatedAt.AsTime())
+		if err != nil {
+			return nil, E.MakeGRPCStatus(codes.Internal, "could not check token revocation", E.Cause(err)).Err()
+		}
+		if revoked {
+			return &pbAuth.IntrospectResponse{Active: false}, nil
+		}
+
+		return &pbAuth.IntrospectResponse{
+			Active:    true,
+			ClientId:  session.AppId,
+			Username:  usernameOf(session.Email, session.Subject),
+			Iat:       session.CreatedAt.GetSeconds(),
+			Exp:       session.ExpiresAt.GetSeconds(),
+			Sub:       session.Subject,
+			TokenType: "refresh_token",
+		}, nil
+	}
+
+	token, err := svc.parseAccessToken(request.Token)
+	if err != nil {
+		return &pbAuth.IntrospectResponse{Active: false}, nil
+	}
+	revoked, err := svc.revocations.IsRevoked(ctx, token.JwtID(), token.Subject(), token.IssuedAt())
+	if err != nil {
+		return nil, E.MakeGRPCStatus(codes.Internal, "could not check token revocation", E.Cause(err)).Err()
+	}
+	if revoked {
+		return &pbAuth.IntrospectResponse{Active: false}, nil
+	}
+
+	return &pbAuth.IntrospectResponse{
+		Active:    true,
+		Scope:     strings.Join(stringsClaim(token, "scope"), " "),
+		ClientId:  stringClaim(token, "appid"),
+		Username:  usernameOf(stringClaim(token, "email"), token.Subject()),
+		TokenType: "Bearer",
+		Exp:       token.Expiration().Unix(),
+		Iat:       token.IssuedAt().Unix(),
+		Sub:       token.Subject(),
+		Jti:       token.JwtID(),
+	}, nil
+}
+
+/*
+Logout ends all sessions of the user owning the refresh token and revokes the user's access tokens. As all users
+without upstream identity share the anonymous subject, only the given session is ended for them.
+*/
+func (svc *Service) Logout(ctx context.Context, request *pbAuth.LogoutRequest) (*pbAuth.LogoutResponse, error) {
+	if request.ClientId != svc.options.ClientID {
+		return nil, E.MakeGRPCStatus(codes.Unauthenticated, "incorrect client ID").Err()
+	}
+
+	if request.RefreshToken == "" {
+		return nil, E.MakeGRPCStatus(codes.Unauthenticated, "no refresh token specified").Err()
+	}
+
+	hashName := redisAuthRefreshTokenHashName(request.RefreshToken)
+	session, err := svc.readSession(ctx, hashName)
+	if err != nil {
+		return nil, E.MakeGRPCStatus(codes.Internal, "could not read session", E.Cause(err)).Err()
+	}
+	if session == nil {
+		return nil, E.MakeGRPCStatus(codes.Unauthenticated, "invalid refresh token").Err()
+	}
+
+	if session.Subject == anonymousSubject {
+		_, err = svc.endSession(ctx, hashName)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		count, err := svc.endSessions(ctx, session.Subject)
+		if err != nil {
+			return nil, err
+		}
+		svc.options.Log.Info(ctx, L.Messagef("logout: ended %d session(s) of subject: %s", count, session.Subject))
+	}
+
+	svc.options.Log.BIEvent(ctx, L.BIActivity("logout"))
+	return &pbAuth.LogoutResponse{}, nil
+}
+
+// authenticateClient checks the client credentials; a client secret, if given, must always be correct
+func (svc *Service) authenticateClient(clientID string, clientSecret string, secretRequired bool) error {
+	if clientID != svc.options.ClientID {
+		return E.MakeGRPCStatus(codes.Unauthenticated, "incorrect client ID").Err()
+	}
+
+	if clientSecret == "" {
+		if secretRequired {
+			return E.MakeGRPCStatus(codes.Unauthenticated, "client secret missing").Err()
+		}
+		return nil
+	}
+
+	if !utils.Contains(svc.options.ClientSecrets, clientSecret) {
+		return E.MakeGRPCStatus(codes.Unauthenticated, "incorrect client secret").Err()
+	}
+	return nil
+}
+
+// parseAccessToken validates signature and expiry of an access token issued by this service
+func (svc *Service) parseAccessToken(accessToken string) (jwt.Token, error) {
+	publicKey, err := svc.signingKey.PublicKey()
+	if err != nil {
+		return nil, err
+	}
+	return jwt.Parse([]byte(accessToken), jwt.WithKey(jwa.RS256, publicKey), jwt.WithValidate(true))
+}
+
+func usernameOf(email string, subject string) string {
+	if email != "" && email != "anonymous" {
+		return email
+	}
+	return subject
+}
@@ -0,0 +1,84 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwk"
+)
+
+func TestService_authenticateClient(t *testing.T) {
+	svc := &Service{options: ServiceOptions{ClientID: "mex", ClientSecrets: []string{"s1", "s2"}}}
+
+	tests := []struct {
+		name           string
+		clientID       string
+		clientSecret   string
+		secretRequired bool
+		wantErr        bool
+	}{
+		{name: "Public client", clientID: "mex"},
+		{name: "Confidential client", clientID: "mex", clientSecret: "s2", secretRequired: true},
+		{name: "Wrong client ID", clientID: "other", clientSecret: "s1", wantErr: true},
+		{name: "Wrong secret", clientID: "mex", clientSecret: "s3", wantErr: true},
+		{name: "Missing secret", clientID: "mex", secretRequired: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := svc.authenticateClient(tt.clientID, tt.clientSecret, tt.secretRequired)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("authenticateClient() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_parseAccessToken(t *testing.T) {
+	newKey := func() jwk.Key {
+		rawKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			t.Fatal(err)
+		}
+		key, err := jwk.FromRaw(rawKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return key
+	}
+	signingKey := newKey()
+	svc := &Service{signingKey: signingKey}
+
+	tokenData := TokenData{OID: "o1", AppID: "mex", Subject: "user-1", Email: "jane@example.org", Scopes: []string{"a", "b"}}
+
+	tests := []struct {
+		name     string
+		key      jwk.Key
+		lifetime time.Duration
+		wantErr  bool
+	}{
+		{name: "Valid token", key: signingKey, lifetime: time.Minute},
+		{name: "Expired token", key: signingKey, lifetime: -time.Minute, wantErr: true},
+		{name: "Foreign token", key: newKey(), lifetime: time.Minute, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accessToken, err := tokenData.toJWT(tt.key, tt.lifetime)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			token, err := svc.parseAccessToken(accessToken)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseAccessToken() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if token.Subject() != "user-1" || token.JwtID() == "" || token.IssuedAt().IsZero() {
+				t.Errorf("parseAccessToken() = %v", token)
+			}
+		})
+	}
+}
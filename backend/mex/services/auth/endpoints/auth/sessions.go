@@ -0,0 +1,173 @@
+package auth
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	E "github.com/d4l-data4life/mex/mex/shared/errstat"
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+
+	pbAuth "github.com/d4l-data4life/mex/mex/services/auth/endpoints/auth/pb"
+)
+
+/*
+A session is the authentication data stored under a refresh token. Sessions are indexed by subject so that all
+sessions of a user can be ended at once. Ending all sessions of a subject also revokes the subject's access tokens.
+*/
+
+func (svc *Service) ListSessions(ctx context.Context, request *pbAuth.ListSessionsRequest) (*pbAuth.ListSessionsResponse, error) {
+	if err := checkSessionSubject(request.Subject); err != nil {
+		return nil, err
+	}
+
+	hashNames, err := svc.sessionHashNames(ctx, request.Subject)
+	if err != nil {
+		return nil, E.MakeGRPCStatus(codes.Internal, "could not list sessions", E.Cause(err)).Err()
+	}
+
+	sessions := []*pbAuth.Session{}
+	for _, hashName := range hashNames {
+		session, err := svc.readSession(ctx, hashName)
+		if err != nil {
+			return nil, E.MakeGRPCStatus(codes.Internal, "could not read session", E.Cause(err)).Err()
+		}
+		if session == nil {
+			// The session has expired or was ended in the meantime.
+			svc.options.Redis.SRem(ctx, redisSessionsSetName(request.Subject), hashName)
+			continue
+		}
+		sessions = append(sessions, session)
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].CreatedAt.AsTime().Before(sessions[j].CreatedAt.AsTime())
+	})
+
+	return &pbAuth.ListSessionsResponse{Sessions: sessions}, nil
+}
+
+func (svc *Service) RevokeSessions(ctx context.Context, request *pbAuth.RevokeSessionsRequest) (*pbAuth.RevokeSessionsResponse, error) {
+	if err := checkSessionSubject(request.Subject); err != nil {
+		return nil, err
+	}
+
+	count, err := svc.endSessions(ctx, request.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	svc.options.Log.Info(ctx, L.Messagef("revoked %d session(s) of subject: %s", count, request.Subject))
+	return &pbAuth.RevokeSessionsResponse{RevokedSessions: count}, nil
+}
+
+/*
+checkSessionSubject rejects subjects whose sessions cannot be managed as a whole: all users without upstream identity
+share the anonymous subject (see Logout), so its sessions are not those of a single user.
+*/
+func checkSessionSubject(subject string) error {
+	switch subject {
+	case "":
+		return E.MakeGRPCStatus(codes.InvalidArgument, "subject missing").Err()
+	case anonymousSubject:
+		return E.MakeGRPCStatus(codes.InvalidArgument, "the sessions of anonymous users cannot be managed by subject").Err()
+	}
+	return nil
+}
+
+// sessionHashNames returns the Redis hash names of the sessions of the subject
+func (svc *Service) sessionHashNames(ctx context.Context, subject string) ([]string, error) {
+	return svc.options.Redis.SMembers(ctx, redisSessionsSetName(subject)).Result()
+}
+
+// readSession returns the session stored in the given hash, or nil if there is none
+func (svc *Service) readSession(ctx context.Context, hashName string) (*pbAuth.Session, error) {
+	pipe := svc.options.Redis.Pipeline()
+	cmdHGetAll := pipe.HGetAll(ctx, hashName)
+	cmdTTL := pipe.TTL(ctx, hashName)
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	values := cmdHGetAll.Val()
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	session := pbAuth.Session{
+		SessionId: strings.TrimPrefix(hashName, redisAuthRefreshTokenHashPrefix),
+		Subject:   values["sub"],
+		Oid:       values["oid"],
+		Email:     values["email"],
+		AppId:     values["appid"],
+		GrantFlow: values["appidacr"],
+		Groups:    decodeGroups(values["groups"]),
+	}
+	if created, err := strconv.ParseInt(values["created"], 10, 64); err == nil {
+		session.CreatedAt = timestamppb.New(time.Unix(created, 0))
+	}
+	if ttl := cmdTTL.Val(); ttl > 0 {
+		session.ExpiresAt = timestamppb.New(time.Now().Add(ttl).Truncate(time.Second))
+	}
+	return &session, nil
+}
+
+// endSession deletes a single session; it reports whether the session existed
+func (svc *Service) endSession(ctx context.Context, hashName string) (bool, error) {
+	subject, err := svc.options.Redis.HGet(ctx, hashName, "sub").Result()
+	if err == redis.Nil {
+		return false, nil
+	}
+	if err != nil {
+		return false, E.MakeGRPCStatus(codes.Internal, "could not read session", E.Cause(err)).Err()
+	}
+
+	pipe := svc.options.Redis.TxPipeline()
+	pipe.Del(ctx, hashName)
+	pipe.SRem(ctx, redisSessionsSetName(subject), hashName)
+	_, err = pipe.Exec(ctx)
+	if err != nil {
+		return false, E.MakeGRPCStatus(codes.Internal, "could not delete session", E.Cause(err)).Err()
+	}
+	return true, nil
+}
+
+// endSessions deletes all sessions of the subject and revokes all access tokens issued to it so far
+func (svc *Service) endSessions(ctx context.Context, subject string) (uint32, error) {
+	hashNames, err := svc.sessionHashNames(ctx, subject)
+	if err != nil {
+		return 0, E.MakeGRPCStatus(codes.Internal, "could not list sessions", E.Cause(err)).Err()
+	}
+
+	var count uint32
+	for _, hashName := range hashNames {
+		deleted, err := svc.options.Redis.Del(ctx, hashName).Result()
+		if err != nil {
+			return count, E.MakeGRPCStatus(codes.Internal, "could not delete session", E.Cause(err)).Err()
+		}
+		count += uint32(deleted)
+	}
+
+	err = svc.options.Redis.Del(ctx, redisSessionsSetName(subject)).Err()
+	if err != nil {
+		return count, E.MakeGRPCStatus(codes.Internal, "could not delete session index", E.Cause(err)).Err()
+	}
+
+	// Sessions which are not indexed (as they predate the index) are rejected when their refresh token is used.
+	lifetime := svc.options.AccessTokenValidity
+	if svc.options.RefreshTokenValidity > lifetime {
+		lifetime = svc.options.RefreshTokenValidity
+	}
+	err = svc.revocations.RevokeSubject(ctx, subject, lifetime)
+	if err != nil {
+		return count, E.MakeGRPCStatus(codes.Internal, "could not revoke access tokens", E.Cause(err)).Err()
+	}
+	return count, nil
+}
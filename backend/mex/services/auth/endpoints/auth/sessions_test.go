@@ -0,0 +1,37 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pbAuth "github.com/d4l-data4life/mex/mex/services/auth/endpoints/auth/pb"
+)
+
+func TestService_sessionSubjects(t *testing.T) {
+	tests := []struct {
+		name    string
+		subject string
+	}{
+		{name: "Empty subject", subject: ""},
+		{name: "Anonymous subject", subject: anonymousSubject},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The subject is rejected before Redis is accessed.
+			svc := &Service{}
+
+			_, err := svc.ListSessions(context.Background(), &pbAuth.ListSessionsRequest{Subject: tt.subject})
+			if status.Code(err) != codes.InvalidArgument {
+				t.Errorf("ListSessions() error = %v, want InvalidArgument", err)
+			}
+
+			_, err = svc.RevokeSessions(context.Background(), &pbAuth.RevokeSessionsRequest{Subject: tt.subject})
+			if status.Code(err) != codes.InvalidArgument {
+				t.Errorf("RevokeSessions() error = %v, want InvalidArgument", err)
+			}
+		})
+	}
+}
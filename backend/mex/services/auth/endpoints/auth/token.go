@@ -2,6 +2,8 @@ package auth
 
 import (
 	"context"
+	"encoding/json"
+	"strconv"
 	"strings"
 	"time"
 
@@ -23,6 +25,9 @@ type TokenData struct {
 	Scopes    []string
 	Subject   string
 	Email     string
+
+	// Only set for token data read from a session
+	Created time.Time
 }
 
 func (t *TokenData) toJWT(signingKey jwk.Key, lifetime time.Duration) (string, error) {
@@ -33,6 +38,7 @@ func (t *TokenData) toJWT(signingKey jwk.Key, lifetime time.Duration) (string, e
 		Claim("appid", t.AppID).
 		Claim("groups", t.Groups).
 		Subject(t.Subject).
+		IssuedAt(time.Now()).
 		Expiration(time.Now().Add(lifetime))
 
 	if t.Email != "" {
@@ -61,10 +67,11 @@ func (t *TokenData) toRedis(ctx context.Context, rdb *redis.Client, key string,
 		"oid", t.OID,
 		"appidacr", t.GrantFlow,
 		"appid", t.AppID,
-		"groups", strings.Join(t.Groups, " "),
+		"groups", encodeGroups(t.Groups),
 		"scopes", strings.Join(t.Scopes, " "),
 		"sub", t.Subject,
 		"email", t.Email,
+		"created", time.Now().Unix(),
 	)
 	if cmdHSet.Err() != nil {
 		return E.MakeGRPCStatus(codes.Internal, "could not store authentication data in Redis", E.Cause(cmdHSet.Err())).Err()
@@ -75,6 +82,24 @@ func (t *TokenData) toRedis(ctx context.Context, rdb *redis.Client, key string,
 		return E.MakeGRPCStatus(codes.Internal, "could set expiration on authentication data in Redis", E.Cause(cmdExpire.Err())).Err()
 	}
 
+	// The anonymous sessions cannot be listed or ended by subject, so indexing them would only let the index grow.
+	if t.Subject == anonymousSubject {
+		return nil
+	}
+
+	// Index the session by subject so that all sessions of a user can be ended at once.
+	// The index lives as long as the most recent session; expired sessions are pruned when the index is read.
+	sessionsSetName := redisSessionsSetName(t.Subject)
+	cmdSAdd := rdb.SAdd(ctx, sessionsSetName, hashName)
+	if cmdSAdd.Err() != nil {
+		return E.MakeGRPCStatus(codes.Internal, "could not index session in Redis", E.Cause(cmdSAdd.Err())).Err()
+	}
+
+	cmdExpire = rdb.Expire(ctx, sessionsSetName, lifetime)
+	if cmdExpire.Err() != nil {
+		return E.MakeGRPCStatus(codes.Internal, "could set expiration on session index in Redis", E.Cause(cmdExpire.Err())).Err()
+	}
+
 	return nil
 }
 
@@ -109,7 +134,7 @@ func TokenDataFromRedis(ctx context.Context, rdb *redis.Client, key string) (*To
 	}
 
 	if groups, ok := redisHashValues["groups"]; ok {
-		token.Groups = strings.Split(groups, " ")
+		token.Groups = decodeGroups(groups)
 	} else {
 		return nil, E.MakeGRPCStatus(codes.Unauthenticated, "no refresh token data").Err()
 	}
@@ -132,5 +157,30 @@ func TokenDataFromRedis(ctx context.Context, rdb *redis.Client, key string) (*To
 		return nil, E.MakeGRPCStatus(codes.Unauthenticated, "no refresh token data").Err()
 	}
 
+	// Sessions created before the creation time was recorded count as created at the epoch.
+	if created, err := strconv.ParseInt(redisHashValues["created"], 10, 64); err == nil {
+		token.Created = time.Unix(created, 0)
+	} else {
+		token.Created = time.Unix(0, 0)
+	}
+
 	return &token, nil
 }
+
+// encodeGroups serializes group IDs for a Redis hash field; as group IDs may contain any character, they are stored as JSON array.
+func encodeGroups(groups []string) string {
+	if groups == nil {
+		groups = []string{}
+	}
+	data, _ := json.Marshal(groups)
+	return string(data)
+}
+
+// decodeGroups reads group IDs serialized by encodeGroups; data stored before contains space-separated group IDs.
+func decodeGroups(value string) []string {
+	groups := []string{}
+	if err := json.Unmarshal([]byte(value), &groups); err != nil {
+		return strings.Fields(value)
+	}
+	return groups
+}
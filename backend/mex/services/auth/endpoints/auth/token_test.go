@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_decodeGroups(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  []string
+	}{
+		{
+			name:  "Encoded groups",
+			value: encodeGroups([]string{"g1", "group with spaces"}),
+			want:  []string{"g1", "group with spaces"},
+		},
+		{
+			name:  "Encoded empty groups",
+			value: encodeGroups(nil),
+			want:  []string{},
+		},
+		{
+			name:  "Space-separated groups of older sessions",
+			value: "g1 g2",
+			want:  []string{"g1", "g2"},
+		},
+		{
+			name:  "Empty value of older sessions",
+			value: "",
+			want:  []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := decodeGroups(tt.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("decodeGroups() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -17,11 +17,21 @@ func redisAuthHashName(challenge string) string {
 	return fmt.Sprintf("oauth:challenge:%s", challenge)
 }
 
+func redisLoginHashName(state string) string {
+	return fmt.Sprintf("oauth:login:%s", state)
+}
+
+const redisAuthRefreshTokenHashPrefix = "oauth:refresh:"
+
 func redisAuthRefreshTokenHashName(refreshToken string) string {
 	h := sha256.New()
 	h.Write([]byte(refreshToken))
 
-	return fmt.Sprintf("oauth:refresh:%s", b64.StdEncoding.EncodeToString(h.Sum(nil)))
+	return redisAuthRefreshTokenHashPrefix + b64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
+func redisSessionsSetName(subject string) string {
+	return fmt.Sprintf("oauth:sessions:%s", subject)
 }
 
 func fragmentOrQuery(responseMode string) string {
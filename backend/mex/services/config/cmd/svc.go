@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"strings"
 
@@ -15,8 +16,11 @@ import (
 	"github.com/d4l-data4life/mex/mex/shared/log/emit"
 	"github.com/d4l-data4life/mex/mex/shared/svcutils"
 
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/fields/hooks"
+
 	"github.com/d4l-data4life/mex/mex/services/config/endpoints/config"
 	pbConfig "github.com/d4l-data4life/mex/mex/services/config/endpoints/config/pb"
+	"github.com/d4l-data4life/mex/mex/services/config/sources"
 )
 
 var (
@@ -79,6 +83,16 @@ func setup(ctx context.Context, opts svcutils.SetupOpts) error {
 
 	opts.Log.Info(ctx, L.Messagef("number of API keys: %d", len(opts.Config.Services.Config.ApiKeys)))
 
+	// Field hooks used for validating configs; the hierarchy codings DB is not needed for that.
+	fieldDefinitionHooks, err := hooks.NewFieldDefinitionHooks(hooks.FieldDefinitionHooksConfig{})
+	if err != nil {
+		return fmt.Errorf("failed to init field hooks: %w", err)
+	}
+	solrFieldCreationHooks, err := hooks.NewSolrFieldCreationHooks(hooks.SolrFieldCreationHooksConfig{})
+	if err != nil {
+		return fmt.Errorf("failed to init field hooks: %w", err)
+	}
+
 	broadcastTopicName := fmt.Sprintf("%s/%s", opts.Config.Redis.PubSubPrefix, constants.ConfigUpdateChannelNameSuffix)
 	opts.Log.Info(ctx, L.Messagef("topic: %s", broadcastTopicName))
 
@@ -89,42 +103,106 @@ func setup(ctx context.Context, opts svcutils.SetupOpts) error {
 		Redis:              opts.Redis,
 		BroadcastTopicName: broadcastTopicName,
 
-		RepoName:          opts.Config.Services.Config.Github.RepoName,
-		DefaultBranchName: opts.Config.Services.Config.Github.DefaultBranchName,
-		EnvPath:           opts.Config.Services.Config.EnvPath,
-		UpdateTimeout:     opts.Config.Services.Config.UpdateTimeout.AsDuration(),
+		EnvPath:       opts.Config.Services.Config.EnvPath,
+		UpdateTimeout: opts.Config.Services.Config.UpdateTimeout.AsDuration(),
+		AutoRollback:  opts.Config.Services.Config.AutoRollback,
 
 		TelemetryService: opts.TelemetryService,
 		Jobber: sharedJobs.RedisJobber{
 			Redis:      opts.Redis,
 			Expiration: opts.Config.Jobs.Expiration.AsDuration(),
 		},
+
+		FieldDefinitionHooks:   fieldDefinitionHooks,
+		SolrFieldCreationHooks: solrFieldCreationHooks,
+		StrictConfigParsing:    opts.Config.Strictness.StrictJsonParsing.Config,
 	}
 
-	if !cfg.StringIsEmpty(opts.Config.Services.Config.Github.RepoName) {
-		err := configService.InitDeployKey(ctx, opts.Config.Services.Config.Github.DeployKeyPem)
-		if err != nil {
-			return err
-		}
+	source, initialRef, err := newConfigSource(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	if source != nil {
+		configService.Source = source
 		_, err = configService.UpdateConfig(ctx, &pbConfig.UpdateConfigRequest{
 			UpdateType: &pbConfig.UpdateConfigRequest_RefName{
-				RefName: opts.Config.Services.Config.Github.DefaultBranchName,
+				RefName: initialRef,
 			},
 		},
 		)
 		if err != nil {
 			return err
 		}
+
+		if watchingSource, ok := source.(sources.WatchingSource); ok {
+			go watchingSource.Watch(ctx, func(ctx context.Context) error {
+				_, err := configService.UpdateConfig(ctx, &pbConfig.UpdateConfigRequest{
+					UpdateType: &pbConfig.UpdateConfigRequest_RefName{},
+				})
+				return err
+			})
+		}
 	} else {
 		opts.Log.Warn(ctx, L.Message("repo name is empty; no Github actions possible (test mode only)"))
 	}
 
 	pbConfig.RegisterConfigServer(opts.GRPCServer, &configService)
 
-	err := pbConfig.RegisterConfigHandlerFromEndpoint(ctx, opts.HTTPMux, opts.Config.Web.GrpcHost, opts.GRPCOpts)
+	err = pbConfig.RegisterConfigHandlerFromEndpoint(ctx, opts.HTTPMux, opts.Config.Web.GrpcHost, opts.GRPCOpts)
 	if err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// newConfigSource creates the configured config source and returns the ref to load initially. The source is nil if no
+// GitHub repo is configured (test mode).
+func newConfigSource(ctx context.Context, opts svcutils.SetupOpts) (sources.Source, string, error) {
+	configOpts := opts.Config.Services.Config
+
+	switch configOpts.Source {
+	case cfg.ConfigSourceType_GITHUB:
+		if cfg.StringIsEmpty(configOpts.Github.RepoName) {
+			return nil, "", nil
+		}
+		source, err := sources.NewGitSource(ctx, opts.Log, configOpts.Github.RepoName, configOpts.Github.DeployKeyPem)
+		if err != nil {
+			return nil, "", err
+		}
+		return source, configOpts.Github.DefaultBranchName, nil
+	case cfg.ConfigSourceType_LOCAL:
+		if cfg.StringIsEmpty(configOpts.Local.Path) {
+			return nil, "", fmt.Errorf("no local config path configured")
+		}
+		return &sources.LocalSource{
+			Log:          opts.Log,
+			Path:         configOpts.Local.Path,
+			PollInterval: configOpts.Local.PollInterval.AsDuration(),
+		}, "", nil
+	case cfg.ConfigSourceType_HTTP:
+		if cfg.StringIsEmpty(configOpts.Http.Url) {
+			return nil, "", fmt.Errorf("no config URL configured")
+		}
+		return &sources.HTTPSource{
+			Log:    opts.Log,
+			URL:    configOpts.Http.Url,
+			Client: &http.Client{Timeout: configOpts.UpdateTimeout.AsDuration()},
+		}, "", nil
+	case cfg.ConfigSourceType_OCI:
+		if cfg.StringIsEmpty(configOpts.Oci.Reference) {
+			return nil, "", fmt.Errorf("no OCI config artifact reference configured")
+		}
+		return &sources.OCISource{
+			Log:       opts.Log,
+			Reference: configOpts.Oci.Reference,
+			Username:  configOpts.Oci.Username,
+			Password:  configOpts.Oci.Password,
+			PlainHTTP: configOpts.Oci.PlainHttp,
+			Client:    &http.Client{Timeout: configOpts.UpdateTimeout.AsDuration()},
+		}, "", nil
+	default:
+		return nil, "", fmt.Errorf("unknown config source: %s", configOpts.Source)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/go-git/go-billy/v5"
 	"google.golang.org/grpc/codes"
 
 	E "github.com/d4l-data4life/mex/mex/shared/errstat"
@@ -27,52 +28,61 @@ func (svc *Service) GetFile(ctx context.Context, request *pbConfig.GetFileReques
 	svc.mu.RLock()
 	defer svc.mu.RUnlock()
 
-	effectiveFileName := "./" + svc.EnvPath + "/" + request.Name
-	info, err := svc.fs.Stat(effectiveFileName)
+	effectiveFileName, content, err := readConfigFile(svc.fs, svc.EnvPath, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	svc.Log.Info(ctx, L.Messagef("effective name: %s", effectiveFileName))
+	svc.Log.Info(ctx, L.Messagef("bytes read: %d", len(content)))
+
+	return &pbConfig.GetFileResponse{
+		MimeType: mime.TypeByExtension(filepath.Ext(effectiveFileName)),
+		Content:  content,
+	}, nil
+}
+
+// readConfigFile reads a file of the given environment; names of directories resolve to their index.json file.
+func readConfigFile(fs billy.Filesystem, envPath string, name string) (string, []byte, error) {
+	effectiveFileName := "./" + envPath + "/" + name
+	info, err := fs.Stat(effectiveFileName)
 	if err != nil {
 		if err != os.ErrNotExist {
-			return nil, err
+			return "", nil, err
 		}
 
 		// Try again lowercase
 		effectiveFileName = strings.ToLower(effectiveFileName)
-		info, err = svc.fs.Stat(effectiveFileName)
+		info, err = fs.Stat(effectiveFileName)
 		if err != nil {
 			if err == os.ErrNotExist {
-				return nil, E.MakeGRPCStatus(codes.NotFound, "file not found", E.Cause(err), E.DevMessagef("file not found: %s", effectiveFileName)).Err()
+				return "", nil, E.MakeGRPCStatus(codes.NotFound, "file not found", E.Cause(err), E.DevMessagef("file not found: %s", effectiveFileName)).Err()
 			}
-			return nil, err
+			return "", nil, err
 		}
 	}
 
 	if info.IsDir() {
 		effectiveFileName = fmt.Sprintf("%s/index.json", effectiveFileName)
-		_, err = svc.fs.Stat(effectiveFileName)
+		_, err = fs.Stat(effectiveFileName)
 		if err != nil {
 			if err == os.ErrNotExist {
-				return nil, E.MakeGRPCStatus(codes.NotFound, "file not found", E.Cause(err), E.DevMessagef("file not found: %s", effectiveFileName)).Err()
+				return "", nil, E.MakeGRPCStatus(codes.NotFound, "file not found", E.Cause(err), E.DevMessagef("file not found: %s", effectiveFileName)).Err()
 			}
-			return nil, err
+			return "", nil, err
 		}
 	}
 
-	svc.Log.Info(ctx, L.Messagef("effective name: %s", effectiveFileName))
-
-	f, err := svc.fs.Open(effectiveFileName)
+	f, err := fs.Open(effectiveFileName)
 	if err != nil {
-		return nil, err
+		return "", nil, err
 	}
 	defer f.Close()
 
 	buf := bytes.Buffer{}
-	n, err := buf.ReadFrom(f)
+	_, err = buf.ReadFrom(f)
 	if err != nil {
-		return nil, err
+		return "", nil, err
 	}
-	svc.Log.Info(ctx, L.Messagef("bytes read: %d", n))
 
-	return &pbConfig.GetFileResponse{
-		MimeType: mime.TypeByExtension(filepath.Ext(effectiveFileName)),
-		Content:  buf.Bytes(),
-	}, nil
+	return effectiveFileName, buf.Bytes(), nil
 }
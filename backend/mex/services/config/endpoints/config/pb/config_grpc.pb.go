@@ -19,10 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Config_UpdateConfig_FullMethodName = "/d4l.mex.config.Config/UpdateConfig"
-	Config_GetFile_FullMethodName      = "/d4l.mex.config.Config/GetFile"
-	Config_ListConfig_FullMethodName   = "/d4l.mex.config.Config/ListConfig"
-	Config_GetStatus_FullMethodName    = "/d4l.mex.config.Config/GetStatus"
+	Config_UpdateConfig_FullMethodName   = "/d4l.mex.config.Config/UpdateConfig"
+	Config_RollbackConfig_FullMethodName = "/d4l.mex.config.Config/RollbackConfig"
+	Config_ValidateConfig_FullMethodName = "/d4l.mex.config.Config/ValidateConfig"
+	Config_DiffConfig_FullMethodName     = "/d4l.mex.config.Config/DiffConfig"
+	Config_GetFile_FullMethodName        = "/d4l.mex.config.Config/GetFile"
+	Config_ListConfig_FullMethodName     = "/d4l.mex.config.Config/ListConfig"
+	Config_GetStatus_FullMethodName      = "/d4l.mex.config.Config/GetStatus"
 )
 
 // ConfigClient is the client API for Config service.
@@ -31,6 +34,13 @@ const (
 type ConfigClient interface {
 	// Instruct the service to pull/checkout a new config and inform other services about it.
 	UpdateConfig(ctx context.Context, in *UpdateConfigRequest, opts ...grpc.CallOption) (*UpdateConfigResponse, error)
+	// Restore and announce the last config all services converged on.
+	RollbackConfig(ctx context.Context, in *RollbackConfigRequest, opts ...grpc.CallOption) (*RollbackConfigResponse, error)
+	// Load a config (git ref or canned config) without switching to it and check the field definitions,
+	// entity types and search configs for consistency.
+	ValidateConfig(ctx context.Context, in *ValidateConfigRequest, opts ...grpc.CallOption) (*ValidateConfigResponse, error)
+	// Like ValidateConfig, but additionally report the changed files and Solr schema compared to the current config.
+	DiffConfig(ctx context.Context, in *ValidateConfigRequest, opts ...grpc.CallOption) (*DiffConfigResponse, error)
 	// Get a file from the current checked-out config working tree.
 	GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (*GetFileResponse, error)
 	// Get a list of all current config files names.
@@ -55,6 +65,33 @@ func (c *configClient) UpdateConfig(ctx context.Context, in *UpdateConfigRequest
 	return out, nil
 }
 
+func (c *configClient) RollbackConfig(ctx context.Context, in *RollbackConfigRequest, opts ...grpc.CallOption) (*RollbackConfigResponse, error) {
+	out := new(RollbackConfigResponse)
+	err := c.cc.Invoke(ctx, Config_RollbackConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ValidateConfig(ctx context.Context, in *ValidateConfigRequest, opts ...grpc.CallOption) (*ValidateConfigResponse, error) {
+	out := new(ValidateConfigResponse)
+	err := c.cc.Invoke(ctx, Config_ValidateConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) DiffConfig(ctx context.Context, in *ValidateConfigRequest, opts ...grpc.CallOption) (*DiffConfigResponse, error) {
+	out := new(DiffConfigResponse)
+	err := c.cc.Invoke(ctx, Config_DiffConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *configClient) GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (*GetFileResponse, error) {
 	out := new(GetFileResponse)
 	err := c.cc.Invoke(ctx, Config_GetFile_FullMethodName, in, out, opts...)
@@ -88,6 +125,13 @@ func (c *configClient) GetStatus(ctx context.Context, in *GetStatusRequest, opts
 type ConfigServer interface {
 	// Instruct the service to pull/checkout a new config and inform other services about it.
 	UpdateConfig(context.Context, *UpdateConfigRequest) (*UpdateConfigResponse, error)
+	// Restore and announce the last config all services converged on.
+	RollbackConfig(context.Context, *RollbackConfigRequest) (*RollbackConfigResponse, error)
+	// Load a config (git ref or canned config) without switching to it and check the field definitions,
+	// entity types and search configs for consistency.
+	ValidateConfig(context.Context, *ValidateConfigRequest) (*ValidateConfigResponse, error)
+	// Like ValidateConfig, but additionally report the changed files and Solr schema compared to the current config.
+	DiffConfig(context.Context, *ValidateConfigRequest) (*DiffConfigResponse, error)
 	// Get a file from the current checked-out config working tree.
 	GetFile(context.Context, *GetFileRequest) (*GetFileResponse, error)
 	// Get a list of all current config files names.
@@ -103,6 +147,15 @@ type UnimplementedConfigServer struct {
 func (UnimplementedConfigServer) UpdateConfig(context.Context, *UpdateConfigRequest) (*UpdateConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateConfig not implemented")
 }
+func (UnimplementedConfigServer) RollbackConfig(context.Context, *RollbackConfigRequest) (*RollbackConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackConfig not implemented")
+}
+func (UnimplementedConfigServer) ValidateConfig(context.Context, *ValidateConfigRequest) (*ValidateConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateConfig not implemented")
+}
+func (UnimplementedConfigServer) DiffConfig(context.Context, *ValidateConfigRequest) (*DiffConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffConfig not implemented")
+}
 func (UnimplementedConfigServer) GetFile(context.Context, *GetFileRequest) (*GetFileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFile not implemented")
 }
@@ -143,6 +196,60 @@ func _Config_UpdateConfig_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Config_RollbackConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).RollbackConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_RollbackConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).RollbackConfig(ctx, req.(*RollbackConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_ValidateConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ValidateConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ValidateConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ValidateConfig(ctx, req.(*ValidateConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_DiffConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).DiffConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_DiffConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).DiffConfig(ctx, req.(*ValidateConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Config_GetFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetFileRequest)
 	if err := dec(in); err != nil {
@@ -208,6 +315,18 @@ var Config_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateConfig",
 			Handler:    _Config_UpdateConfig_Handler,
 		},
+		{
+			MethodName: "RollbackConfig",
+			Handler:    _Config_RollbackConfig_Handler,
+		},
+		{
+			MethodName: "ValidateConfig",
+			Handler:    _Config_ValidateConfig_Handler,
+		},
+		{
+			MethodName: "DiffConfig",
+			Handler:    _Config_DiffConfig_Handler,
+		},
 		{
 			MethodName: "GetFile",
 			Handler:    _Config_GetFile_Handler,
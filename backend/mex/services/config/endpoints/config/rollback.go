@@ -0,0 +1,259 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-billy/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/d4l-data4life/mex/mex/shared/constants"
+	E "github.com/d4l-data4life/mex/mex/shared/errstat"
+	"github.com/d4l-data4life/mex/mex/shared/known/jobspb"
+	"github.com/d4l-data4life/mex/mex/shared/known/statuspb"
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+
+	pbConfig "github.com/d4l-data4life/mex/mex/services/config/endpoints/config/pb"
+)
+
+// configSnapshot is a config all services converged on.
+type configSnapshot struct {
+	hash string
+	ref  string
+	fs   billy.Filesystem
+}
+
+func (svc *Service) RollbackConfig(ctx context.Context, request *pbConfig.RollbackConfigRequest) (*pbConfig.RollbackConfigResponse, error) {
+	lastGood := svc.getLastGoodConfig(ctx)
+	if lastGood == nil {
+		return nil, E.MakeGRPCStatus(codes.FailedPrecondition, "no config known to be good yet; nothing to roll back to").Err()
+	}
+
+	lock, err := svc.Jobber.AcquireLock(ctx, ConfigResourceName)
+	if err != nil {
+		return nil, E.MakeGRPCStatus(codes.AlreadyExists, "failed to acquire config lock; other job might be running", request).Err()
+	}
+
+	job, err := svc.Jobber.CreateJob(ctx, &jobspb.CreateJobRequest{Title: "Roll back config"})
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failure creating job:  %s", err.Error()))
+	}
+
+	svc.TelemetryService.SetStatus(statuspb.Color_AMBER, EmptyConfigHash)
+	_ = svc.TelemetryService.PublishStatus(ctx)
+
+	ctxJob := constants.NewContextWithValues(ctx, job.JobId)
+
+	// There is nothing to fall back to if the known-good config itself fails now.
+	go svc.rollout(ctxJob, job.JobId, lock, func(ctx context.Context) (string, error) {
+		svc.restoreConfig(ctx, lastGood)
+		return lastGood.hash, nil
+	}, false)
+
+	return &pbConfig.RollbackConfigResponse{Commit: lastGood.hash, JobId: job.JobId}, nil
+}
+
+/*
+rollback restores and announces the last known-good config after a failed rollout and sets the status according to
+whether all services converged on it. It returns false if there is no known-good config to roll back to.
+*/
+func (svc *Service) rollback(ctx context.Context, jobID string) bool {
+	lastGood := svc.getLastGoodConfig(ctx)
+	if lastGood == nil {
+		svc.Log.Warn(ctx, L.Message("no config known to be good yet; cannot roll back"))
+		return false
+	}
+
+	svc.Log.Info(ctx, L.Messagef("rolling back to config %q", lastGood.hash))
+	svc.addJobLogs(ctx, jobID, fmt.Sprintf("rolling back to config %q", lastGood.hash))
+
+	svc.restoreConfig(ctx, lastGood)
+	svc.announceConfigChange(ctx, lastGood.hash)
+
+	if !svc.awaitServices(ctx, lastGood.hash) {
+		svc.logJobError(ctx, jobID, fmt.Sprintf("rollback failed: no successful service states for config hash %q after waiting %v", lastGood.hash, svc.UpdateTimeout))
+		svc.TelemetryService.SetStatus(statuspb.Color_RED, lastGood.hash)
+		return true
+	}
+
+	svc.addJobLogs(ctx, jobID, fmt.Sprintf("rolled back to config %q", lastGood.hash))
+	svc.TelemetryService.SetStatus(statuspb.Color_GREEN, lastGood.hash)
+	return true
+}
+
+/*
+getLastGoodConfig returns the last config all services converged on. After a restart, it is loaded again from the
+config source as persisted by rememberGoodConfig.
+*/
+func (svc *Service) getLastGoodConfig(ctx context.Context) *configSnapshot {
+	svc.mu.RLock()
+	lastGood := svc.lastGood
+	svc.mu.RUnlock()
+
+	if lastGood != nil {
+		return lastGood
+	}
+
+	lastGood = svc.loadLastGoodConfig(ctx)
+	if lastGood == nil {
+		return nil
+	}
+
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	// Some other caller might have been faster.
+	if svc.lastGood == nil {
+		svc.lastGood = lastGood
+	}
+	return svc.lastGood
+}
+
+func (svc *Service) loadLastGoodConfig(ctx context.Context) *configSnapshot {
+	if svc.Source == nil {
+		return nil
+	}
+
+	persisted, err := svc.Redis.HGetAll(ctx, lastGoodConfigKey).Result()
+	if err != nil {
+		svc.Log.Warn(ctx, L.Messagef("could not read last good config: %s", err.Error()))
+		return nil
+	}
+	hash := persisted["hash"]
+	if hash == "" {
+		return nil
+	}
+
+	// The config is loaded by its hash, as the ref might point to a newer one by now.
+	fs, loadedHash, err := svc.Source.Load(ctx, hash)
+	if err != nil {
+		svc.Log.Warn(ctx, L.Messagef("could not load last good config %q (ref %q): %s", hash, persisted["ref"], err.Error()))
+		return nil
+	}
+	if loadedHash != hash {
+		svc.Log.Warn(ctx, L.Messagef("last good config %q (ref %q) is no longer available; source has %q", hash, persisted["ref"], loadedHash))
+		return nil
+	}
+
+	svc.Log.Info(ctx, L.Messagef("loaded last good config %q (ref %q)", hash, persisted["ref"]))
+	return &configSnapshot{hash: hash, ref: persisted["ref"], fs: fs}
+}
+
+/*
+rememberGoodConfig remembers the current config, which all services have converged on, and persists its hash and ref.
+It returns false if the current config is not the one with the given hash (anymore).
+*/
+func (svc *Service) rememberGoodConfig(ctx context.Context, hash string) bool {
+	svc.mu.Lock()
+	if svc.hash != hash {
+		svc.mu.Unlock()
+		return false
+	}
+
+	// Loaded configs are never modified, so no copy is needed.
+	svc.Log.Info(ctx, L.Messagef("remembering config %q as good", hash))
+	snapshot := &configSnapshot{hash: hash, ref: svc.ref, fs: svc.fs}
+	svc.lastGood = snapshot
+	svc.failedReplicas = nil
+	svc.mu.Unlock()
+
+	svc.persistGoodConfig(ctx, snapshot)
+	return true
+}
+
+// persistGoodConfig stores the hash and ref of the given config in Redis. Canned configs cannot be loaded again, so
+// they are not persisted (and the config persisted before is forgotten).
+func (svc *Service) persistGoodConfig(ctx context.Context, snapshot *configSnapshot) {
+	var err error
+	if snapshot.ref == "" {
+		err = svc.Redis.Del(ctx, lastGoodConfigKey).Err()
+	} else {
+		err = svc.Redis.HSet(ctx, lastGoodConfigKey, "hash", snapshot.hash, "ref", snapshot.ref).Err()
+	}
+	if err != nil {
+		svc.Log.Warn(ctx, L.Messagef("could not persist last good config %q: %s", snapshot.hash, err.Error()))
+	}
+}
+
+func (svc *Service) restoreConfig(ctx context.Context, snapshot *configSnapshot) {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	svc.Log.Info(ctx, L.Messagef("restoring config %q", snapshot.hash))
+
+	svc.fs, svc.hash, svc.ref = snapshot.fs, snapshot.hash, snapshot.ref
+}
+
+func (svc *Service) currentHash() string {
+	svc.mu.RLock()
+	defer svc.mu.RUnlock()
+
+	return svc.hash
+}
+
+// recordFailedReplicas remembers which replicas did not converge on the config with the given hash and why.
+func (svc *Service) recordFailedReplicas(ctx context.Context, jobID string, hash string) {
+	statuses, err := svc.getAllServiceStatuses(ctx, defaultMaxAge)
+	if err != nil {
+		svc.Log.Warn(ctx, L.Messagef("could not get service statuses: %s", err.Error()))
+	}
+	failures := replicaFailures(statuses, svc.ServiceTag, hash)
+
+	svc.mu.Lock()
+	svc.failedReplicas = failures
+	svc.mu.Unlock()
+
+	logs := make([]string, len(failures))
+	for i, failure := range failures {
+		logs[i] = fmt.Sprintf("%s/%s: %s", failure.ServiceTag, failure.Replica, failure.Reason)
+	}
+	if len(logs) > 0 {
+		svc.addJobLogs(ctx, jobID, logs...)
+	}
+
+	svc.logJobError(ctx, jobID, fmt.Sprintf("no successful service states for config hash %q after waiting %v; failed replicas: %s",
+		hash, svc.UpdateTimeout, strings.Join(logs, "; ")))
+}
+
+// replicaFailures returns the replicas (other than the config service's own) which are not GREEN on the given config.
+func replicaFailures(statuses map[string][]*statuspb.Status, ownServiceTag string, hash string) []*pbConfig.ReplicaFailure {
+	failures := []*pbConfig.ReplicaFailure{}
+	for serviceTag, bucket := range statuses {
+		if serviceTag == ownServiceTag {
+			continue
+		}
+		for _, st := range bucket {
+			var reason string
+			switch {
+			case st.ConfigHash != hash:
+				reason = fmt.Sprintf("still on config %q", st.ConfigHash)
+			case st.Color != statuspb.Color_GREEN:
+				reason = fmt.Sprintf("status %s", st.Color)
+			default:
+				continue
+			}
+			if st.Progress != nil {
+				reason = fmt.Sprintf("%s (%s: %s)", reason, st.Progress.Step, st.Progress.Details)
+			}
+
+			failures = append(failures, &pbConfig.ReplicaFailure{
+				ServiceTag: st.ServiceTag,
+				Replica:    st.Replica,
+				Color:      st.Color,
+				ConfigHash: st.ConfigHash,
+				Reason:     reason,
+			})
+		}
+	}
+
+	sort.Slice(failures, func(i, j int) bool {
+		if failures[i].ServiceTag != failures[j].ServiceTag {
+			return failures[i].ServiceTag < failures[j].ServiceTag
+		}
+		return failures[i].Replica < failures[j].Replica
+	})
+	return failures
+}
@@ -0,0 +1,52 @@
+package config
+
+import (
+	"context"
+	"testing"
+
+	"github.com/d4l-data4life/mex/mex/shared/known/statuspb"
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+)
+
+func Test_replicaFailures(t *testing.T) {
+	statuses := map[string][]*statuspb.Status{
+		"config": {
+			{ServiceTag: "config", Replica: "config-0", Color: statuspb.Color_AMBER, ConfigHash: "∅"},
+		},
+		"query": {
+			{ServiceTag: "query", Replica: "query-1", Color: statuspb.Color_GREEN, ConfigHash: "new"},
+			{ServiceTag: "query", Replica: "query-0", Color: statuspb.Color_GREEN, ConfigHash: "old"},
+		},
+		"index": {
+			{
+				ServiceTag: "index", Replica: "index-0", Color: statuspb.Color_RED, ConfigHash: "new",
+				Progress: &statuspb.Progress{Step: "schema", Details: "unknown field kind"},
+			},
+		},
+	}
+
+	got := replicaFailures(statuses, "config", "new")
+	want := []string{
+		`index/index-0: status RED (schema: unknown field kind)`,
+		`query/query-0: still on config "old"`,
+	}
+	if len(got) != len(want) {
+		t.Fatalf("replicaFailures() = %v, want %v", got, want)
+	}
+	for i, failure := range got {
+		if s := failure.ServiceTag + "/" + failure.Replica + ": " + failure.Reason; s != want[i] {
+			t.Errorf("replicaFailures()[%d] = %q, want %q", i, s, want[i])
+		}
+	}
+}
+
+func TestService_rememberGoodConfig_otherConfig(t *testing.T) {
+	svc := &Service{Log: &L.NullLogger{}, hash: "new"}
+
+	if svc.rememberGoodConfig(context.Background(), "old") {
+		t.Errorf("rememberGoodConfig() = true for a config which is not current")
+	}
+	if svc.lastGood != nil {
+		t.Errorf("lastGood = %q, want none", svc.lastGood.hash)
+	}
+}
@@ -42,6 +42,13 @@ func (svc *Service) GetStatus(ctx context.Context, request *pbConfig.GetStatusRe
 		ret.Color = statuspb.Color_RED
 	}
 
+	svc.mu.RLock()
+	if svc.lastGood != nil {
+		ret.LastGoodConfigHash = svc.lastGood.hash
+	}
+	ret.FailedReplicas = svc.failedReplicas
+	svc.mu.RUnlock()
+
 	return &ret, nil
 }
 
@@ -1,15 +1,11 @@
 package config
 
 import (
-	"archive/tar"
 	"bytes"
 	"context"
 	"fmt"
-	"io"
-	"os"
 	"time"
 
-	"github.com/go-git/go-billy/v5/memfs"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -20,6 +16,7 @@ import (
 	L "github.com/d4l-data4life/mex/mex/shared/log"
 
 	pbConfig "github.com/d4l-data4life/mex/mex/services/config/endpoints/config/pb"
+	"github.com/d4l-data4life/mex/mex/services/config/sources"
 )
 
 type SubscriberFunc func(ctx context.Context, topic string, message string)
@@ -31,13 +28,12 @@ func (f SubscriberFunc) Message(ctx context.Context, topic string, message strin
 const roundDuration = 2 * time.Second
 
 func (svc *Service) UpdateConfig(ctx context.Context, request *pbConfig.UpdateConfigRequest) (*pbConfig.UpdateConfigResponse, error) {
-	if svc.RepoName == "" {
-		return nil, E.MakeGRPCStatus(codes.Internal, "no repo name configured; cannot clone; test mode only").Err()
-	}
-
 	// Check request type, that is, return error if not one of the two supported ones.
 	switch ty := request.UpdateType.(type) {
 	case *pbConfig.UpdateConfigRequest_RefName:
+		if svc.Source == nil {
+			return nil, E.MakeGRPCStatus(codes.Internal, "no config source configured; cannot load config; test mode only").Err()
+		}
 	case *pbConfig.UpdateConfigRequest_CannedConfig:
 		// happy case: drop out below switch
 	default:
@@ -59,79 +55,122 @@ func (svc *Service) UpdateConfig(ctx context.Context, request *pbConfig.UpdateCo
 
 	ctxJob := constants.NewContextWithValues(ctx, job.JobId)
 
-	go func(ctx context.Context) {
-		logJobError := func(message string) {
-			svc.Log.Error(ctx, L.Message(message))
-			_, err := svc.Jobber.SetError(ctx, &jobspb.SetJobErrorRequest{Error: message, JobId: job.JobId})
-			if err != nil {
-				svc.Log.Warn(ctx, L.Messagef("could not set job error: %s", err.Error()))
-			}
-		}
-
-		svc.Jobber.SetStatusRunning(ctx, job.JobId) //nolint:errcheck
-		hash := ""
-
+	go svc.rollout(ctxJob, job.JobId, lock, func(ctx context.Context) (string, error) {
 		switch ty := request.UpdateType.(type) {
 		case *pbConfig.UpdateConfigRequest_RefName:
-			hash, err = svc.updateConfigFromRefName(ctx, ty.RefName)
+			return svc.updateConfigFromRefName(ctx, ty.RefName)
 		case *pbConfig.UpdateConfigRequest_CannedConfig:
-			hash, err = svc.updateConfigFromCannedConfig(ctx, ty.CannedConfig)
+			return svc.updateConfigFromCannedConfig(ctx, ty.CannedConfig)
+		default:
+			return "", fmt.Errorf("unknown type: %t", ty)
 		}
-		if err != nil {
-			logJobError(fmt.Sprintf("config update failed: %s", err.Error()))
-			svc.Jobber.SetStatusDone(ctx, job.JobId)              //nolint:errcheck
-			svc.Jobber.ReleaseLock(ctx, ConfigResourceName, lock) //nolint:errcheck
+	}, svc.AutoRollback)
 
+	return &pbConfig.UpdateConfigResponse{JobId: job.JobId}, nil
+}
+
+// rollout applies a config, announces it and waits for all services to converge on it.
+// If this fails and rollbackOnFailure is set, the last known-good config is restored.
+func (svc *Service) rollout(ctx context.Context, jobID string, lock string, apply func(ctx context.Context) (string, error), rollbackOnFailure bool) {
+	svc.Jobber.SetStatusRunning(ctx, jobID)                     //nolint:errcheck
+	defer svc.Jobber.ReleaseLock(ctx, ConfigResourceName, lock) //nolint:errcheck
+	defer svc.Jobber.SetStatusDone(ctx, jobID)                  //nolint:errcheck
+
+	hash, err := apply(ctx)
+	if err != nil {
+		svc.logJobError(ctx, jobID, fmt.Sprintf("config update failed: %s", err.Error()))
+		if !rollbackOnFailure || !svc.rollback(ctx, jobID) {
 			svc.TelemetryService.SetStatus(statuspb.Color_RED, EmptyConfigHash)
+		}
+		return
+	}
+
+	svc.announceConfigChange(ctx, hash)
+
+	if svc.awaitServices(ctx, hash) {
+		svc.Log.Info(ctx, L.Message("waited:  for all services (success)"))
+		svc.rememberGoodConfig(ctx, hash)
+		svc.TelemetryService.SetStatus(statuspb.Color_GREEN, hash)
+		return
+	}
+
+	svc.Log.Warn(ctx, L.Message("waited:  for all services (timeout)"))
+	svc.recordFailedReplicas(ctx, jobID, hash)
+	if !rollbackOnFailure || !svc.rollback(ctx, jobID) {
+		svc.TelemetryService.SetStatus(statuspb.Color_RED, hash)
+		// The services might just be slow, e.g. when they all start up together.
+		go svc.awaitConvergence(ctx, hash)
+	}
+}
+
+/*
+awaitConvergence keeps checking whether the services converge on the config with the given hash after a rollout
+timed out without rolling back. Once they do, the config is remembered as good. It gives up as soon as another config
+is applied or the context is done.
+*/
+func (svc *Service) awaitConvergence(ctx context.Context, hash string) {
+	ticker := time.NewTicker(roundDuration)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
 			return
+		case <-ticker.C:
 		}
 
-		svc.announceConfigChange(ctx, hash)
-
-		// Wait some time for all other services to internalize the new config.
-		rounds := int(svc.UpdateTimeout / roundDuration)
-		svc.Log.Info(ctx, L.Messagef("waiting: for all services (max. %d rounds)", rounds))
-		success := false
-	L:
-		for k := 0; k < rounds; k++ {
-			time.Sleep(roundDuration)
-			switch svc.checkServices(ctx, hash, defaultMaxAge) {
-			case statuspb.Color_RED:
-				success = false
-				break L
-			case statuspb.Color_GREEN:
-				success = true
-				break L
-			// would be the default behavior, but let's make it explicit
-			case statuspb.Color_AMBER:
-				continue
-			}
+		if svc.currentHash() != hash {
+			return
 		}
-		if success {
-			svc.Log.Info(ctx, L.Message("waited:  for all services (success)"))
-		} else {
-			svc.Log.Warn(ctx, L.Message("waited:  for all services (timeout)"))
-
-			//nolint:errcheck
-			svc.Jobber.SetError(ctx, &jobspb.SetJobErrorRequest{
-				JobId: job.JobId,
-				Error: fmt.Sprintf("no successful service states for config hash %q after waiting %v", hash, svc.UpdateTimeout),
-			})
+		if svc.checkServices(ctx, hash, defaultMaxAge) != statuspb.Color_GREEN {
+			continue
 		}
 
-		svc.Jobber.SetStatusDone(ctx, job.JobId)              //nolint:errcheck
+		// Holding the lock keeps rollouts from interfering with the status.
+		lock, err := svc.Jobber.AcquireLock(ctx, ConfigResourceName)
+		if err != nil {
+			continue
+		}
+		if svc.rememberGoodConfig(ctx, hash) {
+			svc.Log.Info(ctx, L.Messagef("services converged on config %q after all", hash))
+			svc.TelemetryService.SetStatus(statuspb.Color_GREEN, hash)
+		}
 		svc.Jobber.ReleaseLock(ctx, ConfigResourceName, lock) //nolint:errcheck
+		return
+	}
+}
 
-		if success {
-			svc.TelemetryService.SetStatus(statuspb.Color_GREEN, hash)
-		} else {
-			svc.TelemetryService.SetStatus(statuspb.Color_RED, hash)
+// awaitServices waits some time for all other services to internalize the config with the given hash.
+func (svc *Service) awaitServices(ctx context.Context, hash string) bool {
+	rounds := int(svc.UpdateTimeout / roundDuration)
+	svc.Log.Info(ctx, L.Messagef("waiting: for all services (max. %d rounds)", rounds))
+	for k := 0; k < rounds; k++ {
+		time.Sleep(roundDuration)
+		switch svc.checkServices(ctx, hash, defaultMaxAge) {
+		case statuspb.Color_RED:
+			return false
+		case statuspb.Color_GREEN:
+			return true
+		// would be the default behavior, but let's make it explicit
+		case statuspb.Color_AMBER:
+			continue
 		}
+	}
+	return false
+}
 
-		// Job lock released via defer.
-	}(ctxJob)
+func (svc *Service) logJobError(ctx context.Context, jobID string, message string) {
+	svc.Log.Error(ctx, L.Message(message))
+	_, err := svc.Jobber.SetError(ctx, &jobspb.SetJobErrorRequest{Error: message, JobId: jobID})
+	if err != nil {
+		svc.Log.Warn(ctx, L.Messagef("could not set job error: %s", err.Error()))
+	}
+}
 
-	return &pbConfig.UpdateConfigResponse{JobId: job.JobId}, nil
+func (svc *Service) addJobLogs(ctx context.Context, jobID string, logs ...string) {
+	if _, err := svc.Jobber.AddLogs(ctx, &jobspb.AddJobLogsRequest{JobId: jobID, Logs: logs}); err != nil {
+		svc.Log.Warn(ctx, L.Messagef("could not add job logs: %s", err.Error()))
+	}
 }
 
 // This function executes the following logic:
@@ -172,73 +211,35 @@ func (svc *Service) announceConfigChange(ctx context.Context, headHash string) {
 	}
 }
 
+// updateConfigFromRefName loads the config for the given ref from the config source. For sources which only provide a
+// single config, the ref may be empty.
 func (svc *Service) updateConfigFromRefName(ctx context.Context, refName string) (string, error) {
-	if refName == "" {
-		return "", E.MakeGRPCStatus(codes.InvalidArgument, "ref name must be specified").Err()
-	}
-
 	svc.Log.Info(ctx, L.Messagef("UpdateConfig: %s", refName))
 
-	svc.mu.Lock()
-	defer svc.mu.Unlock()
-
-	if svc.currentRepo == nil {
-		svc.Log.Info(ctx, L.Messagef("nothing cloned yet; cloning %s", svc.RepoName))
-		err := svc.cloneRepo(svc.RepoName)
-		if err != nil {
-			return "", err
-		}
-	}
-
-	svc.Log.Info(ctx, L.Messagef("root: %s", svc.fs.Root()))
-	svc.Log.Info(ctx, L.Messagef("checking out: %s", refName))
-
-	err := svc.checkout("origin", refName)
+	fs, hash, err := svc.Source.Load(ctx, refName)
 	if err != nil {
 		return "", err
 	}
 
-	svc.Log.Info(ctx, L.Messagef("switched: config ref: %s", refName))
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
 
-	head, err := svc.currentRepo.Head()
-	if err != nil {
-		return "", err
-	}
+	svc.fs, svc.hash, svc.ref = fs, hash, refName
+	svc.Log.Info(ctx, L.Messagef("switched: config ref: %s (hash %s)", refName, hash))
 
-	return head.Hash().String(), nil
+	return hash, nil
 }
 
 func (svc *Service) updateConfigFromCannedConfig(ctx context.Context, canned *pbConfig.CannedConfig) (string, error) {
-	svc.currentRepo = nil
-	svc.fs = memfs.New()
-
-	tr := tar.NewReader(bytes.NewBuffer(canned.TarData))
-	for {
-		hdr, err := tr.Next()
-		if err == io.EOF {
-			break // end of archive
-		}
-		if err != nil {
-			return "", err
-		}
-		svc.Log.Info(ctx, L.Messagef("- %s (%v)", hdr.Name, hdr.FileInfo().IsDir()))
+	fs, err := sources.Untar(ctx, svc.Log, bytes.NewReader(canned.TarData))
+	if err != nil {
+		return "", err
+	}
 
-		if hdr.FileInfo().IsDir() {
-			continue
-		}
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
 
-		//nolint:gomnd
-		f, err := svc.fs.OpenFile(hdr.Name, os.O_CREATE|os.O_WRONLY, 0o0666)
-		if err != nil {
-			return "", err
-		}
-		//nolint:gosec
-		_, err = io.Copy(f, tr)
-		if err != nil {
-			return "", err
-		}
-		_ = f.Close()
-	}
+	svc.fs, svc.hash, svc.ref = fs, canned.ConfigHash, ""
 
 	return canned.ConfigHash, nil
 }
@@ -0,0 +1,448 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-billy/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/d4l-data4life/mex/mex/shared/entities"
+	E "github.com/d4l-data4life/mex/mex/shared/errstat"
+	sharedFields "github.com/d4l-data4life/mex/mex/shared/fields"
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+	"github.com/d4l-data4life/mex/mex/shared/searchconfig"
+	"github.com/d4l-data4life/mex/mex/shared/searchconfig/sctypes"
+	"github.com/d4l-data4life/mex/mex/shared/solr"
+
+	"github.com/d4l-data4life/mex/mex/services/index/endpoints/index"
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/fields"
+	kindLink "github.com/d4l-data4life/mex/mex/services/metadata/business/fields/kinds/link"
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/fields/linked"
+
+	pbConfig "github.com/d4l-data4life/mex/mex/services/config/endpoints/config/pb"
+	"github.com/d4l-data4life/mex/mex/services/config/sources"
+)
+
+const (
+	fieldDefsFileName     = "field_defs"
+	entityTypesFileName   = "entity_types"
+	searchConfigsFileName = "search_configs"
+)
+
+// checkedConfig holds the parts of a config which could be parsed, together with all problems found.
+type checkedConfig struct {
+	fieldDefs     []fields.BaseFieldDef
+	entityTypes   []*entities.EntityType
+	searchConfigs *searchconfig.SearchConfigList
+	// Only set if the config is consistent
+	schema *solr.SchemaUpdates
+
+	errors []string
+}
+
+func (checked *checkedConfig) addError(format string, args ...interface{}) {
+	checked.errors = append(checked.errors, fmt.Sprintf(format, args...))
+}
+
+func (svc *Service) ValidateConfig(ctx context.Context, request *pbConfig.ValidateConfigRequest) (*pbConfig.ValidateConfigResponse, error) {
+	fs, hash, err := svc.loadCandidate(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	checked := svc.checkConfig(ctx, fs)
+	svc.Log.Info(ctx, L.Messagef("validated config %q: %d error(s)", hash, len(checked.errors)))
+
+	return &pbConfig.ValidateConfigResponse{
+		Commit: hash,
+		Valid:  len(checked.errors) == 0,
+		Errors: checked.errors,
+	}, nil
+}
+
+func (svc *Service) DiffConfig(ctx context.Context, request *pbConfig.ValidateConfigRequest) (*pbConfig.DiffConfigResponse, error) {
+	fs, hash, err := svc.loadCandidate(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	candidate := svc.checkConfig(ctx, fs)
+
+	svc.mu.RLock()
+	current := &checkedConfig{}
+	if svc.fs != nil {
+		current = svc.checkConfig(ctx, svc.fs)
+	}
+	fileChanges, err := diffFiles(svc.fs, fs)
+	svc.mu.RUnlock()
+	if err != nil {
+		return nil, E.MakeGRPCStatus(codes.Internal, "could not compare config files: "+err.Error()).Err()
+	}
+
+	var schemaChanges []*pbConfig.SchemaChange
+	if candidate.schema != nil {
+		schemaChanges = diffSchemas(current.schema, candidate.schema)
+	}
+	svc.Log.Info(ctx, L.Messagef("diffed config %q: %d error(s), %d file change(s), %d schema change(s)",
+		hash, len(candidate.errors), len(fileChanges), len(schemaChanges)))
+
+	return &pbConfig.DiffConfigResponse{
+		Commit:          hash,
+		Valid:           len(candidate.errors) == 0,
+		Errors:          candidate.errors,
+		FileChanges:     fileChanges,
+		SchemaChanges:   schemaChanges,
+		ReindexRequired: len(schemaChanges) > 0,
+	}, nil
+}
+
+// loadCandidate loads the requested config into memory without switching to it.
+func (svc *Service) loadCandidate(ctx context.Context, request *pbConfig.ValidateConfigRequest) (billy.Filesystem, string, error) {
+	switch ty := request.Candidate.(type) {
+	case *pbConfig.ValidateConfigRequest_RefName:
+		if svc.Source == nil {
+			return nil, "", E.MakeGRPCStatus(codes.Internal, "no config source configured; cannot load config; test mode only").Err()
+		}
+		// Every load yields a new file system, so the current config stays untouched.
+		return svc.Source.Load(ctx, ty.RefName)
+	case *pbConfig.ValidateConfigRequest_CannedConfig:
+		fs, err := sources.Untar(ctx, svc.Log, bytes.NewReader(ty.CannedConfig.TarData))
+		if err != nil {
+			return nil, "", E.MakeGRPCStatus(codes.InvalidArgument, "could not extract canned config: "+err.Error()).Err()
+		}
+		return fs, ty.CannedConfig.ConfigHash, nil
+	default:
+		return nil, "", E.MakeGRPCStatus(codes.InvalidArgument, fmt.Sprintf("unknown type: %t", ty)).Err()
+	}
+}
+
+// checkConfig parses the field definitions, entity types and search configs of a config and checks them for
+// consistency. If no problems are found, the Solr schema is generated as the index service would do it.
+func (svc *Service) checkConfig(ctx context.Context, fs billy.Filesystem) *checkedConfig {
+	checked := &checkedConfig{
+		searchConfigs: &searchconfig.SearchConfigList{},
+	}
+
+	// Field definitions: every definition has to pass the validation of its kind
+	fieldDefList := sharedFields.FieldDefList{}
+	if err := svc.readConfigObject(fs, fieldDefsFileName, &fieldDefList); err != nil {
+		checked.addError("%s", err.Error())
+	}
+	fieldNames := make(map[string]bool)
+	for _, protoFieldDef := range fieldDefList.FieldDefs {
+		if fieldNames[protoFieldDef.Name] {
+			checked.addError("field '%s': defined more than once", protoFieldDef.Name)
+			continue
+		}
+		fieldNames[protoFieldDef.Name] = true
+
+		hook := svc.FieldDefinitionHooks.GetHook(protoFieldDef.Kind)
+		if hook == nil {
+			checked.addError("field '%s': unknown kind '%s'", protoFieldDef.Name, protoFieldDef.Kind)
+			continue
+		}
+		fieldDef, err := hook.ValidateDefinition(ctx, protoFieldDef)
+		if err != nil {
+			checked.addError("field '%s': %s", protoFieldDef.Name, err.Error())
+			continue
+		}
+		checked.fieldDefs = append(checked.fieldDefs, fieldDef)
+	}
+
+	// Linked fields are silently dropped by the services if their target does not exist.
+	for _, fieldDef := range checked.fieldDefs {
+		if linkFieldDef, ok := fieldDef.(kindLink.LinkFieldDef); ok {
+			for _, targetFieldName := range linkFieldDef.LinkedTargetFields() {
+				if !fieldNames[targetFieldName] {
+					checked.addError("field '%s': linked target field '%s' is not defined", fieldDef.Name(), targetFieldName)
+				}
+			}
+		}
+	}
+	linkedFieldDefs, err := linked.GetLinkedFieldDefs(checked.fieldDefs)
+	if err != nil {
+		checked.addError("could not generate linked fields: %s", err.Error())
+	}
+	for _, linkedFieldDef := range linkedFieldDefs {
+		fieldNames[linkedFieldDef.Name()] = true
+	}
+	checked.fieldDefs = append(checked.fieldDefs, linkedFieldDefs...)
+
+	// Entity types: referenced fields and entity types must exist
+	entityTypeList := entities.EntityTypeList{}
+	if err := svc.readConfigObject(fs, entityTypesFileName, &entityTypeList); err != nil {
+		checked.addError("%s", err.Error())
+	}
+	entityTypeNames := make(map[string]bool)
+	for _, entityType := range entityTypeList.EntityTypes {
+		if entityTypeNames[entityType.Name] {
+			checked.addError("entity type '%s': defined more than once", entityType.Name)
+		}
+		entityTypeNames[entityType.Name] = true
+	}
+	for _, entityType := range entityTypeList.EntityTypes {
+		if entityType.Config == nil {
+			continue
+		}
+		businessIDFieldName := entityType.Config.BusinessIdFieldName
+		if businessIDFieldName != "" && !fieldNames[businessIDFieldName] {
+			checked.addError("entity type '%s': business ID field '%s' is not defined", entityType.Name, businessIDFieldName)
+		}
+		partitionFieldName := entityType.Config.PartitionFieldName
+		if partitionFieldName != "" && !fieldNames[partitionFieldName] {
+			checked.addError("entity type '%s': partition field '%s' is not defined", entityType.Name, partitionFieldName)
+		}
+		aggregationEntityType := entityType.Config.AggregationEntityType
+		if aggregationEntityType != "" && !entityTypeNames[aggregationEntityType] {
+			checked.addError("entity type '%s': aggregation entity type '%s' is not defined", entityType.Name, aggregationEntityType)
+		}
+		if entityType.Config.Schema != nil {
+			checked.checkEntityTypeSchema(entityType, fieldNames)
+		}
+	}
+	checked.entityTypes = entityTypeList.EntityTypes
+
+	// Validation rules of fields may require values for entity types, which must exist, too
+	for _, fieldDef := range checked.fieldDefs {
+		for _, entityTypeName := range fieldDef.ValueRules().Ext().GetRequiredForEntityTypes() {
+			if !entityTypeNames[entityTypeName] {
+				checked.addError("field '%s': entity type '%s' requiring a value is not defined", fieldDef.Name(), entityTypeName)
+			}
+		}
+	}
+
+	// Search configs: known types, referring to defined fields only
+	searchConfigList := searchconfig.SearchConfigList{}
+	if err := svc.readConfigObject(fs, searchConfigsFileName, &searchConfigList); err != nil {
+		checked.addError("%s", err.Error())
+	}
+	searchConfigNames := make(map[string]bool)
+	for _, searchConfig := range searchConfigList.SearchConfigs {
+		if searchConfigNames[searchConfig.Name] {
+			checked.addError("search config '%s': defined more than once", searchConfig.Name)
+			continue
+		}
+		searchConfigNames[searchConfig.Name] = true
+
+		if _, ok := sctypes.SearchConfigHooks[searchConfig.Type]; !ok {
+			checked.addError("search config '%s': unknown type '%s'", searchConfig.Name, searchConfig.Type)
+			continue
+		}
+		fieldsDefined := true
+		for _, fieldName := range searchConfig.Fields {
+			if !fieldNames[fieldName] {
+				checked.addError("search config '%s': field '%s' is not defined", searchConfig.Name, fieldName)
+				fieldsDefined = false
+			}
+		}
+		if fieldsDefined {
+			checked.searchConfigs.SearchConfigs = append(checked.searchConfigs.SearchConfigs, searchConfig)
+		}
+	}
+
+	// The Solr schema can only be generated for a consistent config.
+	if len(checked.errors) > 0 {
+		return checked
+	}
+	schema, err := index.GenerateSolrSchema(ctx, svc.SolrFieldCreationHooks, checked.fieldDefs, checked.searchConfigs)
+	if err != nil {
+		checked.addError("could not generate Solr schema: %s", err.Error())
+		return checked
+	}
+	checked.schema = schema
+
+	return checked
+}
+
+// checkEntityTypeSchema checks that the schema of an entity type only refers to defined fields and can be satisfied.
+func (checked *checkedConfig) checkEntityTypeSchema(entityType *entities.EntityType, fieldNames map[string]bool) {
+	schema := entityType.Config.Schema
+
+	referenced := append([]string{}, schema.AllowedFields...)
+	referenced = append(referenced, schema.RequiredFields...)
+	for _, cardinality := range schema.Cardinalities {
+		referenced = append(referenced, cardinality.FieldName)
+		if cardinality.MaxValues > 0 && cardinality.MinValues > cardinality.MaxValues {
+			checked.addError("entity type '%s': field '%s' requires at least %d but allows at most %d values",
+				entityType.Name, cardinality.FieldName, cardinality.MinValues, cardinality.MaxValues)
+		}
+	}
+	for _, languages := range schema.Languages {
+		referenced = append(referenced, languages.FieldName)
+	}
+	for _, fieldName := range referenced {
+		if !fieldNames[fieldName] {
+			checked.addError("entity type '%s': schema field '%s' is not defined", entityType.Name, fieldName)
+		}
+	}
+
+	checked.checkSchemaRequiredFieldsAllowed(entityType)
+}
+
+// checkSchemaRequiredFieldsAllowed checks that fields required by a schema are not ruled out by it at the same time.
+func (checked *checkedConfig) checkSchemaRequiredFieldsAllowed(entityType *entities.EntityType) {
+	schema := entityType.Config.Schema
+	if len(schema.AllowedFields) == 0 {
+		return
+	}
+
+	allowed := map[string]bool{entityType.Config.BusinessIdFieldName: true}
+	for _, fieldName := range schema.AllowedFields {
+		allowed[fieldName] = true
+	}
+	for _, fieldName := range schema.RequiredFields {
+		if !allowed[fieldName] {
+			checked.addError("entity type '%s': required field '%s' is not allowed", entityType.Name, fieldName)
+		}
+	}
+	for _, cardinality := range schema.Cardinalities {
+		if cardinality.MinValues > 0 && !allowed[cardinality.FieldName] {
+			checked.addError("entity type '%s': required field '%s' is not allowed", entityType.Name, cardinality.FieldName)
+		}
+	}
+}
+
+func (svc *Service) readConfigObject(fs billy.Filesystem, name string, msg proto.Message) error {
+	_, content, err := readConfigFile(fs, svc.EnvPath, name)
+	if err != nil {
+		return fmt.Errorf("could not read %s: %s", name, status.Convert(err).Message())
+	}
+	err = protojson.UnmarshalOptions{DiscardUnknown: !svc.StrictConfigParsing}.Unmarshal(content, msg)
+	if err != nil {
+		return fmt.Errorf("could not parse %s: %s", name, err.Error())
+	}
+	return nil
+}
+
+// diffFiles returns the files added, removed or modified by the candidate config; the current one may be nil.
+func diffFiles(current billy.Filesystem, candidate billy.Filesystem) ([]*pbConfig.FileChange, error) {
+	currentFiles := make(map[string][]byte)
+	if current != nil {
+		var err error
+		currentFiles, err = readAllFiles(current)
+		if err != nil {
+			return nil, err
+		}
+	}
+	candidateFiles, err := readAllFiles(candidate)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := []*pbConfig.FileChange{}
+	for name, content := range candidateFiles {
+		currentContent, ok := currentFiles[name]
+		switch {
+		case !ok:
+			changes = append(changes, &pbConfig.FileChange{Name: name, Change: pbConfig.ChangeType_ADDED})
+		case !bytes.Equal(content, currentContent):
+			changes = append(changes, &pbConfig.FileChange{Name: name, Change: pbConfig.ChangeType_MODIFIED})
+		}
+	}
+	for name := range currentFiles {
+		if _, ok := candidateFiles[name]; !ok {
+			changes = append(changes, &pbConfig.FileChange{Name: name, Change: pbConfig.ChangeType_REMOVED})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Name < changes[j].Name })
+	return changes, nil
+}
+
+func readAllFiles(fs billy.Filesystem) (map[string][]byte, error) {
+	fileNames := []string{}
+	if err := listFiles(fs, &fileNames, "."); err != nil {
+		return nil, err
+	}
+
+	files := make(map[string][]byte)
+	for _, fileName := range fileNames {
+		f, err := fs.Open(fileName)
+		if err != nil {
+			return nil, err
+		}
+		content, err := io.ReadAll(f)
+		_ = f.Close()
+		if err != nil {
+			return nil, err
+		}
+		files[strings.TrimPrefix(fileName, "./")] = content
+	}
+	return files, nil
+}
+
+// diffSchemas returns the Solr fields and copy fields added, removed or modified by the candidate schema.
+func diffSchemas(current *solr.SchemaUpdates, candidate *solr.SchemaUpdates) []*pbConfig.SchemaChange {
+	if current == nil {
+		current = &solr.SchemaUpdates{}
+	}
+
+	currentFields := make(map[string]solr.FieldDef)
+	for _, fieldDef := range current.FieldDefs {
+		currentFields[fieldDef.Name] = fieldDef
+	}
+	candidateFields := make(map[string]solr.FieldDef)
+	for _, fieldDef := range candidate.FieldDefs {
+		candidateFields[fieldDef.Name] = fieldDef
+	}
+
+	changes := []*pbConfig.SchemaChange{}
+	for name, fieldDef := range candidateFields {
+		currentFieldDef, ok := currentFields[name]
+		switch {
+		case !ok:
+			changes = append(changes, &pbConfig.SchemaChange{Name: name, Change: pbConfig.ChangeType_ADDED})
+		case fieldDef != currentFieldDef:
+			changes = append(changes, &pbConfig.SchemaChange{Name: name, Change: pbConfig.ChangeType_MODIFIED})
+		}
+	}
+	for name := range currentFields {
+		if _, ok := candidateFields[name]; !ok {
+			changes = append(changes, &pbConfig.SchemaChange{Name: name, Change: pbConfig.ChangeType_REMOVED})
+		}
+	}
+
+	currentCopyFields := copyFieldsByName(current.CopyFieldDefs)
+	candidateCopyFields := copyFieldsByName(candidate.CopyFieldDefs)
+	for name, maxChars := range candidateCopyFields {
+		currentMaxChars, ok := currentCopyFields[name]
+		switch {
+		case !ok:
+			changes = append(changes, &pbConfig.SchemaChange{Name: name, CopyField: true, Change: pbConfig.ChangeType_ADDED})
+		case maxChars != currentMaxChars:
+			changes = append(changes, &pbConfig.SchemaChange{Name: name, CopyField: true, Change: pbConfig.ChangeType_MODIFIED})
+		}
+	}
+	for name := range currentCopyFields {
+		if _, ok := candidateCopyFields[name]; !ok {
+			changes = append(changes, &pbConfig.SchemaChange{Name: name, CopyField: true, Change: pbConfig.ChangeType_REMOVED})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].CopyField != changes[j].CopyField {
+			return !changes[i].CopyField
+		}
+		return changes[i].Name < changes[j].Name
+	})
+	return changes
+}
+
+// copyFieldsByName maps each source/destination pair (as "<source> -> <destination>") to its max. number of chars.
+func copyFieldsByName(copyFieldDefs []solr.CopyFieldDef) map[string]uint {
+	copyFields := make(map[string]uint)
+	for _, copyFieldDef := range copyFieldDefs {
+		for _, destination := range copyFieldDef.Destination {
+			copyFields[fmt.Sprintf("%s -> %s", copyFieldDef.Source, destination)] = copyFieldDef.MaxChars
+		}
+	}
+	return copyFields
+}
@@ -0,0 +1,217 @@
+package config
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-billy/v5/memfs"
+
+	"github.com/d4l-data4life/mex/mex/shared/solr"
+
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/fields/hooks"
+
+	pbConfig "github.com/d4l-data4life/mex/mex/services/config/endpoints/config/pb"
+)
+
+const testFieldDefs = `{"fieldDefs": [
+	{"name": "title", "kind": "text", "indexDef": {"multiValued": true}},
+	{"name": "identifier", "kind": "string", "indexDef": {}},
+	{"name": "contact", "kind": "link", "indexDef": {"ext": [
+		{"@type": "type.googleapis.com/mex.v0.IndexDefExtLink", "relationType": "contact", "linkedTargetFields": ["title"]}
+	]}}
+]}`
+
+const testEntityTypes = `{"entityTypes": [
+	{"name": "Resource", "config": {"isFocal": true, "businessIdFieldName": "identifier"}}
+]}`
+
+const testSearchConfigs = `{"searchConfigs": [
+	{"name": "default", "type": "searchFocus", "fields": ["title", "contact__title"]}
+]}`
+
+func newTestConfigFS(t *testing.T, files map[string]string) billy.Filesystem {
+	fs := memfs.New()
+	for name, content := range files {
+		f, err := fs.Create("test/" + name + "/index.json")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := f.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+		_ = f.Close()
+	}
+	return fs
+}
+
+func newTestService(t *testing.T) *Service {
+	fieldDefinitionHooks, err := hooks.NewFieldDefinitionHooks(hooks.FieldDefinitionHooksConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	solrFieldCreationHooks, err := hooks.NewSolrFieldCreationHooks(hooks.SolrFieldCreationHooksConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Service{
+		EnvPath:                "test",
+		FieldDefinitionHooks:   fieldDefinitionHooks,
+		SolrFieldCreationHooks: solrFieldCreationHooks,
+		StrictConfigParsing:    true,
+	}
+}
+
+func TestService_checkConfig(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+		// Expected prefixes of the errors (the exact protojson messages vary on purpose)
+		wantErrors []string
+	}{
+		{
+			name: "Consistent config",
+			files: map[string]string{
+				fieldDefsFileName:     testFieldDefs,
+				entityTypesFileName:   testEntityTypes,
+				searchConfigsFileName: testSearchConfigs,
+			},
+		},
+		{
+			name: "Unknown field kind and dangling references",
+			files: map[string]string{
+				fieldDefsFileName: `{"fieldDefs": [
+					{"name": "title", "kind": "fancy", "indexDef": {}},
+					{"name": "contact", "kind": "link", "indexDef": {"ext": [
+						{"@type": "type.googleapis.com/mex.v0.IndexDefExtLink", "relationType": "contact", "linkedTargetFields": ["label"]}
+					]}}
+				]}`,
+				entityTypesFileName: `{"entityTypes": [
+					{"name": "ExtractedResource", "config": {"businessIdFieldName": "identifier", "aggregationEntityType": "Resource"}}
+				]}`,
+				searchConfigsFileName: `{"searchConfigs": [
+					{"name": "default", "type": "searchFocus", "fields": ["title"]},
+					{"name": "created", "type": "dateAxis", "fields": []}
+				]}`,
+			},
+			wantErrors: []string{
+				"field 'title': unknown kind 'fancy'",
+				"field 'contact': linked target field 'label' is not defined",
+				"entity type 'ExtractedResource': business ID field 'identifier' is not defined",
+				"entity type 'ExtractedResource': aggregation entity type 'Resource' is not defined",
+				"search config 'created': unknown type 'dateAxis'",
+			},
+		},
+		{
+			name: "Invalid validation rules",
+			files: map[string]string{
+				fieldDefsFileName: `{"fieldDefs": [
+					{"name": "title", "kind": "text", "indexDef": {"ext": [
+						{"@type": "type.googleapis.com/mex.v0.IndexDefExtValidation", "requiredForEntityTypes": ["Dataset"]}
+					]}},
+					{"name": "identifier", "kind": "string", "indexDef": {"ext": [
+						{"@type": "type.googleapis.com/mex.v0.IndexDefExtValidation", "patterns": ["("]}
+					]}}
+				]}`,
+				entityTypesFileName:   testEntityTypes,
+				searchConfigsFileName: `{"searchConfigs": []}`,
+			},
+			wantErrors: []string{
+				"field 'identifier': invalid validation rules: invalid pattern '('",
+				"field 'title': entity type 'Dataset' requiring a value is not defined",
+			},
+		},
+		{
+			name: "Invalid entity type schema",
+			files: map[string]string{
+				fieldDefsFileName: testFieldDefs,
+				entityTypesFileName: `{"entityTypes": [
+					{"name": "Resource", "config": {"businessIdFieldName": "identifier", "schema": {
+						"allowedFields": ["title"],
+						"requiredFields": ["title", "contact"],
+						"cardinalities": [{"fieldName": "created", "minValues": 2, "maxValues": 1}],
+						"languages": [{"fieldName": "title", "requiredLanguages": ["en"]}]
+					}}}
+				]}`,
+				searchConfigsFileName: testSearchConfigs,
+			},
+			wantErrors: []string{
+				"entity type 'Resource': field 'created' requires at least 2 but allows at most 1 values",
+				"entity type 'Resource': schema field 'created' is not defined",
+				"entity type 'Resource': required field 'contact' is not allowed",
+				"entity type 'Resource': required field 'created' is not allowed",
+			},
+		},
+		{
+			name: "Missing and unparsable files",
+			files: map[string]string{
+				fieldDefsFileName:   testFieldDefs,
+				entityTypesFileName: `{"entityTypes": [{"name": "Resource", "color": "red"}]}`,
+			},
+			wantErrors: []string{
+				"could not parse entity_types: ",
+				"could not read search_configs: file not found",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newTestService(t)
+			checked := svc.checkConfig(context.Background(), newTestConfigFS(t, tt.files))
+			if len(checked.errors) != len(tt.wantErrors) {
+				t.Fatalf("checkConfig() errors = %#v, want %#v", checked.errors, tt.wantErrors)
+			}
+			for i, wantError := range tt.wantErrors {
+				if !strings.HasPrefix(checked.errors[i], wantError) {
+					t.Errorf("checkConfig() error = %q, want %q", checked.errors[i], wantError)
+				}
+			}
+			if (checked.schema != nil) != (len(tt.wantErrors) == 0) {
+				t.Errorf("checkConfig() schema generated = %v, want %v", checked.schema != nil, len(tt.wantErrors) == 0)
+			}
+		})
+	}
+}
+
+func Test_diffSchemas(t *testing.T) {
+	current := &solr.SchemaUpdates{
+		FieldDefs: []solr.FieldDef{
+			{Name: "title", Type: "text_general", MultiValued: true},
+			{Name: "keyword", Type: "string"},
+		},
+		CopyFieldDefs: []solr.CopyFieldDef{
+			{Source: "title", Destination: []string{"focus_default"}},
+		},
+	}
+	candidate := &solr.SchemaUpdates{
+		FieldDefs: []solr.FieldDef{
+			{Name: "title", Type: "text_general", MultiValued: false},
+			{Name: "created", Type: "pdate"},
+		},
+		CopyFieldDefs: []solr.CopyFieldDef{
+			{Source: "title", Destination: []string{"focus_default"}},
+			{Source: "created", Destination: []string{"focus_default"}},
+		},
+	}
+
+	want := []*pbConfig.SchemaChange{
+		{Name: "created", Change: pbConfig.ChangeType_ADDED},
+		{Name: "keyword", Change: pbConfig.ChangeType_REMOVED},
+		{Name: "title", Change: pbConfig.ChangeType_MODIFIED},
+		{Name: "created -> focus_default", CopyField: true, Change: pbConfig.ChangeType_ADDED},
+	}
+	got := diffSchemas(current, candidate)
+	if len(got) != len(want) {
+		t.Fatalf("diffSchemas() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i].Name != want[i].Name || got[i].CopyField != want[i].CopyField || got[i].Change != want[i].Change {
+			t.Errorf("diffSchemas()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	if changes := diffSchemas(candidate, candidate); len(changes) != 0 {
+		t.Errorf("diffSchemas() of identical schemas = %v, want no changes", changes)
+	}
+}
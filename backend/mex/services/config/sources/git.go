@@ -0,0 +1,141 @@
+package sources
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"google.golang.org/grpc/codes"
+
+	E "github.com/d4l-data4life/mex/mex/shared/errstat"
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+)
+
+// GitSource loads configs from a GitHub repo via SSH. The ref is a branch or tag name.
+type GitSource struct {
+	Log      L.Logger
+	RepoName string
+
+	mu         sync.Mutex
+	repo       *git.Repository
+	publicKeys *gitssh.PublicKeys
+}
+
+func NewGitSource(ctx context.Context, log L.Logger, repoName string, deployKeyPEM []byte) (*GitSource, error) {
+	if len(deployKeyPEM) == 0 {
+		return nil, fmt.Errorf("Github deploy key not configured")
+	}
+
+	publicKeys, err := gitssh.NewPublicKeys("git", deployKeyPEM, "")
+	if err != nil {
+		return nil, fmt.Errorf("gitssh: error extracting public key(s): %s", err.Error())
+	}
+	log.Info(ctx, L.Messagef("keys: %v", *publicKeys))
+
+	return &GitSource{Log: log, RepoName: repoName, publicKeys: publicKeys}, nil
+}
+
+func (src *GitSource) Load(ctx context.Context, refName string) (billy.Filesystem, string, error) {
+	if refName == "" {
+		return nil, "", E.MakeGRPCStatus(codes.InvalidArgument, "ref name must be specified").Err()
+	}
+
+	src.mu.Lock()
+	defer src.mu.Unlock()
+
+	if src.repo == nil {
+		src.Log.Info(ctx, L.Messagef("nothing cloned yet; cloning %s", src.RepoName))
+		repo, err := src.clone(ctx)
+		if err != nil {
+			return nil, "", err
+		}
+		src.repo = repo
+	}
+
+	err := src.repo.Fetch(&git.FetchOptions{
+		Auth: src.publicKeys,
+		// Tags are needed for resolving tag refs; branches are fetched as remote references anyway.
+		Tags: git.AllTags,
+	})
+	if err != nil && err != git.NoErrAlreadyUpToDate {
+		return nil, "", E.MakeGRPCStatus(codes.Internal, "git fetch failed: "+err.Error(), E.Cause(err)).Err()
+	}
+
+	hash, err := src.resolve(refName)
+	if err != nil {
+		return nil, "", E.MakeGRPCStatus(codes.NotFound, fmt.Sprintf("ref not found: %s", refName), E.Cause(err)).Err()
+	}
+	commit, err := src.repo.CommitObject(hash)
+	if err != nil {
+		return nil, "", err
+	}
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, "", err
+	}
+
+	// The tree is read into a new file system so that configs handed out before stay untouched.
+	fs := memfs.New()
+	err = tree.Files().ForEach(func(file *object.File) error {
+		r, err := file.Reader()
+		if err != nil {
+			return err
+		}
+		defer r.Close()
+
+		w, err := fs.Create(file.Name)
+		if err != nil {
+			return err
+		}
+		defer w.Close()
+
+		_, err = io.Copy(w, r)
+		return err
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	src.Log.Info(ctx, L.Messagef("loaded config ref %s (commit %s)", refName, commit.Hash.String()))
+	return fs, commit.Hash.String(), nil
+}
+
+func (src *GitSource) clone(ctx context.Context) (*git.Repository, error) {
+	repoURL := fmt.Sprintf("git@github.com:%s.git", src.RepoName)
+	src.Log.Info(ctx, L.Messagef("repo URL: '%s'", repoURL))
+
+	return git.CloneContext(ctx, memory.NewStorage(), memfs.New(), &git.CloneOptions{
+		URL:  repoURL,
+		Auth: src.publicKeys,
+	})
+}
+
+// resolve returns the commit a remote branch or, failing that, a tag points to, or the commit with the given hash.
+func (src *GitSource) resolve(refName string) (plumbing.Hash, error) {
+	ref, err := src.repo.Reference(plumbing.NewRemoteReferenceName("origin", refName), true)
+	if err == nil {
+		return ref.Hash(), nil
+	}
+
+	tagRef, tagErr := src.repo.Reference(plumbing.NewTagReferenceName(refName), true)
+	if tagErr != nil {
+		// Commit hashes are accepted as well, so that a config remembered by its hash can be loaded again.
+		if plumbing.IsHash(refName) {
+			return plumbing.NewHash(refName), nil
+		}
+		return plumbing.ZeroHash, err
+	}
+	// Annotated tags point to a tag object rather than to the commit itself.
+	if tag, err := src.repo.TagObject(tagRef.Hash()); err == nil {
+		return tag.Target, nil
+	}
+	return tagRef.Hash(), nil
+}
@@ -0,0 +1,79 @@
+package sources
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/go-git/go-billy/v5"
+	"google.golang.org/grpc/codes"
+
+	E "github.com/d4l-data4life/mex/mex/shared/errstat"
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+)
+
+// maxResponseSize limits how much of a response body is read, so that a misbehaving server cannot exhaust the memory.
+var maxResponseSize int64 = 64 << 20
+
+// HTTPSource loads configs from a (possibly gzip-compressed) tarball served over HTTP(S).
+type HTTPSource struct {
+	Log    L.Logger
+	URL    string
+	Client *http.Client
+}
+
+func (src *HTTPSource) Load(ctx context.Context, _ string) (billy.Filesystem, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, src.URL, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	data, err := fetch(src.client(), req)
+	if err != nil {
+		return nil, "", E.MakeGRPCStatus(codes.Unavailable, fmt.Sprintf("could not download config from %s: %s", src.URL, err.Error()), E.Cause(err)).Err()
+	}
+
+	fs, err := Untar(ctx, src.Log, bytes.NewReader(data))
+	if err != nil {
+		return nil, "", E.MakeGRPCStatus(codes.InvalidArgument, "could not extract config: "+err.Error(), E.Cause(err)).Err()
+	}
+
+	hash := hashBytes(data)
+	src.Log.Info(ctx, L.Messagef("loaded config from %s (hash %s)", src.URL, hash))
+	return fs, hash, nil
+}
+
+func (src *HTTPSource) client() *http.Client {
+	if src.Client == nil {
+		return http.DefaultClient
+	}
+	return src.Client
+}
+
+// fetch executes the request and returns the response body if the status is 200.
+func fetch(client *http.Client, req *http.Request) ([]byte, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+	return readBody(resp.Body)
+}
+
+// readBody reads a response body, failing if it is larger than maxResponseSize.
+func readBody(body io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(body, maxResponseSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxResponseSize {
+		return nil, fmt.Errorf("response larger than %d bytes", maxResponseSize)
+	}
+	return data, nil
+}
@@ -0,0 +1,45 @@
+package sources
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+)
+
+func TestHTTPSource_Load(t *testing.T) {
+	tarball := makeTar(t, map[string]string{"test/field_defs/index.json": `{"fieldDefs": []}`}, true)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/config.tar.gz" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write(tarball)
+	}))
+	defer server.Close()
+
+	src := &HTTPSource{Log: &L.NullLogger{}, URL: server.URL + "/config.tar.gz"}
+	fs, hash, err := src.Load(context.Background(), "")
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	checkFile(t, fs, "test/field_defs/index.json", `{"fieldDefs": []}`)
+	if hash != hashBytes(tarball) {
+		t.Errorf("Load() hash = %q, want %q", hash, hashBytes(tarball))
+	}
+
+	src.URL = server.URL + "/missing.tar.gz"
+	if _, _, err := src.Load(context.Background(), ""); err == nil {
+		t.Errorf("Load() of missing tarball did not fail")
+	}
+
+	defer func(size int64) { maxResponseSize = size }(maxResponseSize)
+	maxResponseSize = int64(len(tarball) - 1)
+	src.URL = server.URL + "/config.tar.gz"
+	if _, _, err := src.Load(context.Background(), ""); err == nil {
+		t.Errorf("Load() of oversized tarball did not fail")
+	}
+}
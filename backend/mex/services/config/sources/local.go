@@ -0,0 +1,130 @@
+package sources
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-billy/v5/memfs"
+
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+)
+
+const defaultPollInterval = 5 * time.Second
+
+// LocalSource loads configs from a directory on the local file system. The directory is polled for changes, since
+// file change notifications are not reliable for volumes mounted into containers.
+type LocalSource struct {
+	Log          L.Logger
+	Path         string
+	PollInterval time.Duration // defaults to defaultPollInterval if not positive
+}
+
+func (src *LocalSource) Load(ctx context.Context, _ string) (billy.Filesystem, string, error) {
+	files, err := src.readFiles()
+	if err != nil {
+		return nil, "", err
+	}
+
+	memFS := memfs.New()
+	for name, content := range files {
+		f, err := memFS.Create(name)
+		if err != nil {
+			return nil, "", err
+		}
+		_, err = f.Write(content)
+		_ = f.Close()
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	hash := hashFiles(files)
+	src.Log.Info(ctx, L.Messagef("loaded config from %s (hash %s)", src.Path, hash))
+	return memFS, hash, nil
+}
+
+func (src *LocalSource) Watch(ctx context.Context, changed func(ctx context.Context) error) {
+	lastHash := ""
+	if files, err := src.readFiles(); err == nil {
+		lastHash = hashFiles(files)
+	}
+
+	ticker := time.NewTicker(src.pollInterval(ctx))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		files, err := src.readFiles()
+		if err != nil {
+			src.Log.Warn(ctx, L.Messagef("could not read config directory %s: %s", src.Path, err.Error()))
+			continue
+		}
+		hash := hashFiles(files)
+		if hash == lastHash {
+			continue
+		}
+		src.Log.Info(ctx, L.Messagef("config directory %s changed (hash %s)", src.Path, hash))
+		if err := changed(ctx); err != nil {
+			src.Log.Warn(ctx, L.Messagef("could not reload config; retrying: %s", err.Error()))
+			continue
+		}
+		lastHash = hash
+	}
+}
+
+// readFiles returns the contents of all regular files below the directory by their slash-separated relative paths.
+func (src *LocalSource) readFiles() (map[string][]byte, error) {
+	files := map[string][]byte{}
+	err := filepath.WalkDir(src.Path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		// Hidden entries include the timestamped directories behind the symlinks of Kubernetes config map volumes.
+		if path != src.Path && strings.HasPrefix(d.Name(), ".") {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.Type()&fs.ModeSymlink != 0 {
+			info, err := os.Stat(path)
+			if err != nil || !info.Mode().IsRegular() {
+				return err
+			}
+		} else if !d.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(src.Path, path)
+		if err != nil {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = content
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+func (src *LocalSource) pollInterval(ctx context.Context) time.Duration {
+	if src.PollInterval <= 0 {
+		src.Log.Warn(ctx, L.Messagef("invalid poll interval %v; polling every %v", src.PollInterval, defaultPollInterval))
+		return defaultPollInterval
+	}
+	return src.PollInterval
+}
@@ -0,0 +1,106 @@
+package sources
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+)
+
+func writeFile(t *testing.T, dir string, name string, content string) {
+	path := filepath.Join(dir, name)
+	//nolint:gomnd
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	//nolint:gomnd
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLocalSource_Load(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "test/field_defs/index.json", `{"fieldDefs": []}`)
+	writeFile(t, dir, ".git/HEAD", "ref: refs/heads/main")
+
+	src := &LocalSource{Log: &L.NullLogger{}, Path: dir}
+	fs, hash, err := src.Load(context.Background(), "")
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	checkFile(t, fs, "test/field_defs/index.json", `{"fieldDefs": []}`)
+	if _, err := fs.Stat(".git/HEAD"); err == nil {
+		t.Errorf("Load() read hidden file .git/HEAD")
+	}
+
+	_, sameHash, err := src.Load(context.Background(), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sameHash != hash {
+		t.Errorf("Load() hash = %q, want unchanged %q", sameHash, hash)
+	}
+
+	writeFile(t, dir, "test/field_defs/index.json", `{"fieldDefs": [{"name": "title"}]}`)
+	_, newHash, err := src.Load(context.Background(), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if newHash == hash {
+		t.Errorf("Load() hash did not change after file change")
+	}
+}
+
+func TestLocalSource_Watch(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "test/field_defs/index.json", `{"fieldDefs": []}`)
+
+	src := &LocalSource{Log: &L.NullLogger{}, Path: dir, PollInterval: 10 * time.Millisecond}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	changes := make(chan struct{}, 1)
+	go src.Watch(ctx, func(ctx context.Context) error {
+		changes <- struct{}{}
+		return nil
+	})
+
+	select {
+	case <-changes:
+		t.Fatalf("Watch() reported a change although nothing changed")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	writeFile(t, dir, "test/entity_types/index.json", `{"entityTypes": []}`)
+
+	select {
+	case <-changes:
+	case <-time.After(2 * time.Second):
+		t.Fatalf("Watch() did not report the new file")
+	}
+}
+
+func TestLocalSource_pollInterval(t *testing.T) {
+	tests := []struct {
+		name         string
+		pollInterval time.Duration
+		want         time.Duration
+	}{
+		{name: "Configured", pollInterval: time.Second, want: time.Second},
+		{name: "Unset", want: defaultPollInterval},
+		{name: "Negative", pollInterval: -time.Second, want: defaultPollInterval},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := &LocalSource{Log: &L.NullLogger{}, PollInterval: tt.pollInterval}
+			if got := src.pollInterval(context.Background()); got != tt.want {
+				t.Errorf("pollInterval() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
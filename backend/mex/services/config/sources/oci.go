@@ -0,0 +1,315 @@
+package sources
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/go-git/go-billy/v5"
+	"google.golang.org/grpc/codes"
+
+	E "github.com/d4l-data4life/mex/mex/shared/errstat"
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+)
+
+const (
+	ociManifestMediaType    = "application/vnd.oci.image.manifest.v1+json"
+	dockerManifestMediaType = "application/vnd.docker.distribution.manifest.v2+json"
+
+	defaultOCITag = "latest"
+)
+
+// OCISource loads configs from an artifact in an OCI registry. The artifact has a single tar layer (possibly
+// gzip-compressed) containing the config; the ref, if given, replaces the tag or digest of the configured reference.
+// The hash of a config is the digest of the artifact's manifest.
+type OCISource struct {
+	Log L.Logger
+
+	// Reference of the artifact, e.g. registry.example.com/mex/config:latest
+	Reference string
+	Username  string
+	Password  string
+	PlainHTTP bool
+
+	Client *http.Client
+
+	mu    sync.Mutex
+	token string
+}
+
+type ociReference struct {
+	registry   string
+	repository string
+	// tag or digest
+	ref string
+}
+
+type ociManifest struct {
+	MediaType string          `json:"mediaType"`
+	Layers    []ociDescriptor `json:"layers"`
+}
+
+type ociDescriptor struct {
+	MediaType string `json:"mediaType"`
+	Digest    string `json:"digest"`
+}
+
+func (src *OCISource) Load(ctx context.Context, ref string) (billy.Filesystem, string, error) {
+	reference, err := parseOCIReference(src.Reference)
+	if err != nil {
+		return nil, "", E.MakeGRPCStatus(codes.InvalidArgument, err.Error()).Err()
+	}
+	if ref != "" {
+		reference.ref = ref
+	}
+
+	manifestData, manifestDigest, err := src.fetchManifest(ctx, reference)
+	if err != nil {
+		return nil, "", E.MakeGRPCStatus(codes.Unavailable, fmt.Sprintf("could not get manifest of %s: %s", reference, err.Error()), E.Cause(err)).Err()
+	}
+
+	manifest := ociManifest{}
+	if err := json.Unmarshal(manifestData, &manifest); err != nil {
+		return nil, "", E.MakeGRPCStatus(codes.InvalidArgument, "could not parse manifest: "+err.Error(), E.Cause(err)).Err()
+	}
+	layer, err := configLayer(manifest)
+	if err != nil {
+		return nil, "", E.MakeGRPCStatus(codes.InvalidArgument, fmt.Sprintf("artifact %s: %s", reference, err.Error())).Err()
+	}
+
+	blob, err := src.fetchBlob(ctx, reference, layer.Digest)
+	if err != nil {
+		return nil, "", E.MakeGRPCStatus(codes.Unavailable, fmt.Sprintf("could not get layer %s of %s: %s", layer.Digest, reference, err.Error()), E.Cause(err)).Err()
+	}
+
+	fs, err := Untar(ctx, src.Log, bytes.NewReader(blob))
+	if err != nil {
+		return nil, "", E.MakeGRPCStatus(codes.InvalidArgument, "could not extract config: "+err.Error(), E.Cause(err)).Err()
+	}
+
+	src.Log.Info(ctx, L.Messagef("loaded config from %s (digest %s)", reference, manifestDigest))
+	return fs, manifestDigest, nil
+}
+
+func (src *OCISource) fetchManifest(ctx context.Context, reference *ociReference) ([]byte, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, src.url(reference, "manifests", reference.ref), nil)
+	if err != nil {
+		return nil, "", err
+	}
+	req.Header.Set("Accept", strings.Join([]string{ociManifestMediaType, dockerManifestMediaType}, ", "))
+
+	data, err := src.do(ctx, req)
+	if err != nil {
+		return nil, "", err
+	}
+
+	digest := sha256Digest(data)
+	if strings.HasPrefix(reference.ref, "sha256:") && reference.ref != digest {
+		return nil, "", fmt.Errorf("manifest digest mismatch: got %s", digest)
+	}
+	return data, digest, nil
+}
+
+func (src *OCISource) fetchBlob(ctx context.Context, reference *ociReference, digest string) ([]byte, error) {
+	if !strings.HasPrefix(digest, "sha256:") {
+		return nil, fmt.Errorf("unsupported digest algorithm: %s", digest)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, src.url(reference, "blobs", digest), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := src.do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if got := sha256Digest(data); got != digest {
+		return nil, fmt.Errorf("blob digest mismatch: got %s", got)
+	}
+	return data, nil
+}
+
+// do executes a registry request, authenticating as demanded by the registry's challenge.
+func (src *OCISource) do(ctx context.Context, req *http.Request) ([]byte, error) {
+	src.mu.Lock()
+	token := src.token
+	src.mu.Unlock()
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := src.client().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		scheme, params := parseChallenge(resp.Header.Get("WWW-Authenticate"))
+		retry := req.Clone(ctx)
+		switch scheme {
+		case "bearer":
+			token, err := src.fetchToken(ctx, params)
+			if err != nil {
+				return nil, fmt.Errorf("could not get registry token: %w", err)
+			}
+			src.mu.Lock()
+			src.token = token
+			src.mu.Unlock()
+			retry.Header.Set("Authorization", "Bearer "+token)
+		case "basic":
+			retry.SetBasicAuth(src.Username, src.Password)
+		default:
+			return nil, fmt.Errorf("unexpected status: %s", resp.Status)
+		}
+		return fetch(src.client(), retry)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+	return readBody(resp.Body)
+}
+
+// fetchToken gets a bearer token from the registry's token service (see the Docker registry token authentication).
+func (src *OCISource) fetchToken(ctx context.Context, params map[string]string) (string, error) {
+	realm, ok := params["realm"]
+	if !ok {
+		return "", fmt.Errorf("no realm in challenge")
+	}
+	tokenURL, err := url.Parse(realm)
+	if err != nil {
+		return "", err
+	}
+	query := tokenURL.Query()
+	for _, key := range []string{"service", "scope"} {
+		if value, ok := params[key]; ok {
+			query.Set(key, value)
+		}
+	}
+	tokenURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tokenURL.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	if src.Username != "" {
+		req.SetBasicAuth(src.Username, src.Password)
+	}
+
+	data, err := fetch(src.client(), req)
+	if err != nil {
+		return "", err
+	}
+
+	tokenResponse := struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}{}
+	if err := json.Unmarshal(data, &tokenResponse); err != nil {
+		return "", err
+	}
+	if tokenResponse.Token != "" {
+		return tokenResponse.Token, nil
+	}
+	if tokenResponse.AccessToken != "" {
+		return tokenResponse.AccessToken, nil
+	}
+	return "", fmt.Errorf("empty token")
+}
+
+func (src *OCISource) url(reference *ociReference, kind string, ref string) string {
+	scheme := "https"
+	if src.PlainHTTP {
+		scheme = "http"
+	}
+	return fmt.Sprintf("%s://%s/v2/%s/%s/%s", scheme, reference.registry, reference.repository, kind, ref)
+}
+
+func (src *OCISource) client() *http.Client {
+	if src.Client == nil {
+		return http.DefaultClient
+	}
+	return src.Client
+}
+
+func (reference *ociReference) String() string {
+	separator := ":"
+	if strings.HasPrefix(reference.ref, "sha256:") {
+		separator = "@"
+	}
+	return reference.registry + "/" + reference.repository + separator + reference.ref
+}
+
+// parseOCIReference splits a reference like registry.example.com/mex/config:latest or
+// registry.example.com/mex/config@sha256:... into its parts.
+func parseOCIReference(s string) (*ociReference, error) {
+	registry, rest, ok := strings.Cut(s, "/")
+	if !ok || registry == "" || rest == "" {
+		return nil, fmt.Errorf("invalid OCI reference %q: registry and repository required", s)
+	}
+
+	reference := &ociReference{registry: registry, repository: rest, ref: defaultOCITag}
+	if repository, digest, ok := strings.Cut(rest, "@"); ok {
+		reference.repository, reference.ref = repository, digest
+	} else if i := strings.LastIndex(rest, ":"); i >= 0 {
+		reference.repository, reference.ref = rest[:i], rest[i+1:]
+	}
+
+	if reference.repository == "" || reference.ref == "" {
+		return nil, fmt.Errorf("invalid OCI reference %q", s)
+	}
+	return reference, nil
+}
+
+// configLayer returns the (only) tar layer of the artifact.
+func configLayer(manifest ociManifest) (*ociDescriptor, error) {
+	var found *ociDescriptor
+	for i, layer := range manifest.Layers {
+		if !strings.HasSuffix(layer.MediaType, ".tar") && !strings.HasSuffix(layer.MediaType, ".tar+gzip") &&
+			!strings.HasSuffix(layer.MediaType, ".tar.gzip") {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("more than one tar layer")
+		}
+		found = &manifest.Layers[i]
+	}
+	if found == nil {
+		return nil, fmt.Errorf("no tar layer")
+	}
+	return found, nil
+}
+
+// parseChallenge parses a WWW-Authenticate header like: Bearer realm="https://auth.example.com/token",service="registry"
+func parseChallenge(header string) (string, map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(header), " ")
+	params := map[string]string{}
+	for rest != "" {
+		var key, value string
+		key, rest, _ = strings.Cut(strings.TrimLeft(rest, " ,"), "=")
+		if strings.HasPrefix(rest, `"`) {
+			value, rest, _ = strings.Cut(rest[1:], `"`)
+		} else {
+			value, rest, _ = strings.Cut(rest, ",")
+		}
+		if key != "" {
+			params[strings.ToLower(strings.TrimSpace(key))] = value
+		}
+	}
+	return strings.ToLower(scheme), params
+}
+
+func sha256Digest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
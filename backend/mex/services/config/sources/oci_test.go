@@ -0,0 +1,169 @@
+package sources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+)
+
+func Test_parseOCIReference(t *testing.T) {
+	tests := []struct {
+		reference string
+		want      *ociReference
+		wantErr   bool
+	}{
+		{
+			reference: "registry.example.com/mex/config:v1",
+			want:      &ociReference{registry: "registry.example.com", repository: "mex/config", ref: "v1"},
+		},
+		{
+			reference: "localhost:5000/config",
+			want:      &ociReference{registry: "localhost:5000", repository: "config", ref: "latest"},
+		},
+		{
+			reference: "registry.example.com/config@sha256:abc",
+			want:      &ociReference{registry: "registry.example.com", repository: "config", ref: "sha256:abc"},
+		},
+		{reference: "config", wantErr: true},
+		{reference: "registry.example.com/config:", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.reference, func(t *testing.T) {
+			got, err := parseOCIReference(tt.reference)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseOCIReference() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && *got != *tt.want {
+				t.Errorf("parseOCIReference() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// newTestRegistry serves a single artifact with the given layer under mex/config:v1, requiring a bearer token.
+func newTestRegistry(t *testing.T, layer []byte) (*httptest.Server, string) {
+	layerDigest := sha256Digest(layer)
+	manifest, err := json.Marshal(ociManifest{
+		MediaType: ociManifestMediaType,
+		Layers:    []ociDescriptor{{MediaType: "application/vnd.oci.image.layer.v1.tar+gzip", Digest: layerDigest}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			if user, password, ok := r.BasicAuth(); !ok || user != "mex" || password != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`{"token": "t0k3n"}`))
+			return
+		}
+
+		if r.Header.Get("Authorization") != "Bearer t0k3n" {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="test",scope="repository:mex/config:pull"`, server.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/v2/mex/config/manifests/v1":
+			if !strings.Contains(r.Header.Get("Accept"), ociManifestMediaType) {
+				w.WriteHeader(http.StatusNotAcceptable)
+				return
+			}
+			_, _ = w.Write(manifest)
+		case "/v2/mex/config/blobs/" + layerDigest:
+			_, _ = w.Write(layer)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	return server, sha256Digest(manifest)
+}
+
+func TestOCISource_Load(t *testing.T) {
+	layer := makeTar(t, map[string]string{"test/field_defs/index.json": `{"fieldDefs": []}`}, true)
+	server, manifestDigest := newTestRegistry(t, layer)
+	defer server.Close()
+
+	registry := strings.TrimPrefix(server.URL, "http://")
+
+	src := &OCISource{
+		Log:       &L.NullLogger{},
+		Reference: registry + "/mex/config:latest",
+		Username:  "mex",
+		Password:  "secret",
+		PlainHTTP: true,
+	}
+
+	// The ref overrides the tag of the configured reference.
+	fs, hash, err := src.Load(context.Background(), "v1")
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	checkFile(t, fs, "test/field_defs/index.json", `{"fieldDefs": []}`)
+	if hash != manifestDigest {
+		t.Errorf("Load() hash = %q, want %q", hash, manifestDigest)
+	}
+
+	if _, _, err := src.Load(context.Background(), ""); err == nil {
+		t.Errorf("Load() of missing tag did not fail")
+	}
+
+	src.Password = "wrong"
+	src.token = ""
+	if _, _, err := src.Load(context.Background(), "v1"); err == nil {
+		t.Errorf("Load() with wrong credentials did not fail")
+	}
+}
+
+func Test_configLayer(t *testing.T) {
+	tests := []struct {
+		name    string
+		layers  []ociDescriptor
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Single tar layer next to other layers",
+			layers: []ociDescriptor{
+				{MediaType: "application/vnd.oci.empty.v1+json", Digest: "sha256:1"},
+				{MediaType: "application/vnd.oci.image.layer.v1.tar", Digest: "sha256:2"},
+			},
+			want: "sha256:2",
+		},
+		{
+			name:    "No tar layer",
+			layers:  []ociDescriptor{{MediaType: "application/json", Digest: "sha256:1"}},
+			wantErr: true,
+		},
+		{
+			name: "Two tar layers",
+			layers: []ociDescriptor{
+				{MediaType: "application/vnd.oci.image.layer.v1.tar", Digest: "sha256:1"},
+				{MediaType: "application/vnd.oci.image.layer.v1.tar+gzip", Digest: "sha256:2"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := configLayer(ociManifest{Layers: tt.layers})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("configLayer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got.Digest != tt.want {
+				t.Errorf("configLayer() = %s, want %s", got.Digest, tt.want)
+			}
+		})
+	}
+}
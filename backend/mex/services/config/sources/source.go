@@ -0,0 +1,114 @@
+/*
+Package sources provides the places the config service can load configs from: a GitHub repo, a local directory,
+a tarball served over HTTP(S) and an OCI registry artifact. Every load yields a new in-memory file tree together with
+a hash identifying the config, which the config service then announces to all other services.
+*/
+package sources
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-billy/v5/memfs"
+
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+)
+
+// Source loads configs. The returned file system is never modified afterwards.
+type Source interface {
+	// Load returns the config for the given ref (branch, tag, the hash of a config, ...) and its hash. Sources which
+	// only provide a single config ignore the ref.
+	Load(ctx context.Context, ref string) (billy.Filesystem, string, error)
+}
+
+// WatchingSource is a source which notices changes of its config itself.
+type WatchingSource interface {
+	Source
+
+	// Watch calls changed whenever the config has changed, until the context is done. If changed returns an error,
+	// it is called again for the same change.
+	Watch(ctx context.Context, changed func(ctx context.Context) error)
+}
+
+// Untar extracts a (possibly gzip-compressed) tar archive into a new in-memory file system.
+func Untar(ctx context.Context, log L.Logger, data io.Reader) (billy.Filesystem, error) {
+	r := bufio.NewReader(data)
+	magic, err := r.Peek(2)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	var archive io.Reader = r
+	if bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		defer gz.Close()
+		archive = gz
+	}
+
+	fs := memfs.New()
+
+	tr := tar.NewReader(archive)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break // end of archive
+		}
+		if err != nil {
+			return nil, err
+		}
+		log.Info(ctx, L.Messagef("- %s (%v)", hdr.Name, hdr.FileInfo().IsDir()))
+
+		if hdr.FileInfo().IsDir() {
+			continue
+		}
+
+		//nolint:gomnd
+		f, err := fs.OpenFile(hdr.Name, os.O_CREATE|os.O_WRONLY, 0o0666)
+		if err != nil {
+			return nil, err
+		}
+		//nolint:gosec
+		_, err = io.Copy(f, tr)
+		if err != nil {
+			return nil, err
+		}
+		_ = f.Close()
+	}
+
+	return fs, nil
+}
+
+// hashFiles returns a hash over the names and contents of the given files.
+func hashFiles(files map[string][]byte) string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	h := sha256.New()
+	for _, name := range names {
+		h.Write([]byte(name))
+		h.Write([]byte{0})
+		h.Write(files[name])
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func hashBytes(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
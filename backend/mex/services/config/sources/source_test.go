@@ -0,0 +1,86 @@
+package sources
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/go-git/go-billy/v5"
+
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+)
+
+func makeTar(t *testing.T, files map[string]string, compress bool) []byte {
+	var buf bytes.Buffer
+	var w io.Writer = &buf
+	var gz *gzip.Writer
+	if compress {
+		gz = gzip.NewWriter(&buf)
+		w = gz
+	}
+
+	tw := tar.NewWriter(w)
+	for name, content := range files {
+		//nolint:gomnd
+		err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content)), Typeflag: tar.TypeReg})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if gz != nil {
+		if err := gz.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return buf.Bytes()
+}
+
+func checkFile(t *testing.T, fs billy.Filesystem, name string, want string) {
+	t.Helper()
+	f, err := fs.Open(name)
+	if err != nil {
+		t.Fatalf("could not open %s: %s", name, err.Error())
+	}
+	defer f.Close()
+	got, err := io.ReadAll(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != want {
+		t.Errorf("%s = %q, want %q", name, string(got), want)
+	}
+}
+
+func TestUntar(t *testing.T) {
+	files := map[string]string{"test/field_defs/index.json": `{"fieldDefs": []}`}
+
+	tests := []struct {
+		name     string
+		compress bool
+	}{
+		{name: "Plain tar", compress: false},
+		{name: "Gzip-compressed tar", compress: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, err := Untar(context.Background(), &L.NullLogger{}, bytes.NewReader(makeTar(t, files, tt.compress)))
+			if err != nil {
+				t.Fatalf("Untar() error = %v", err)
+			}
+			checkFile(t, fs, "test/field_defs/index.json", `{"fieldDefs": []}`)
+		})
+	}
+
+	if _, err := Untar(context.Background(), &L.NullLogger{}, bytes.NewReader([]byte("no tar"))); err == nil {
+		t.Errorf("Untar() of invalid data did not fail")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/d4l-data4life/mex/mex/shared/codings"
 	"github.com/d4l-data4life/mex/mex/shared/codings/csrepo"
 	"github.com/d4l-data4life/mex/mex/shared/codings/mesh"
+	"github.com/d4l-data4life/mex/mex/shared/constants"
 	"github.com/d4l-data4life/mex/mex/shared/entities"
 	"github.com/d4l-data4life/mex/mex/shared/entities/erepo"
 	"github.com/d4l-data4life/mex/mex/shared/interceptors"
@@ -105,7 +107,12 @@ func setup(ctx context.Context, opts svcutils.SetupOpts, strictConfigParsing boo
 		return err
 	}
 	opts.Log.Info(ctx, L.Messagef("existing Solr collections: %v", collections))
-	if !utils.Contains(collections, opts.Config.Solr.Collection) {
+	if opts.Config.Solr.BlueGreen {
+		err = ensureSolrAlias(ctx, opts, collections)
+		if err != nil {
+			return err
+		}
+	} else if !utils.Contains(collections, opts.Config.Solr.Collection) {
 		configSet := solr.DefaultSolrConfigSet
 		opts.Log.Info(ctx, L.Messagef("creating Solr collection '%s' from configset '%s'", opts.Config.Solr.Collection, configSet))
 		err = opts.Solr.CreateCollection(ctx, opts.Config.Solr.Collection, configSet, opts.Config.Solr.ReplicationFactor)
@@ -222,18 +229,29 @@ func setup(ctx context.Context, opts svcutils.SetupOpts, strictConfigParsing boo
 
 		CollectionName:    opts.Config.Solr.Collection,
 		ReplicationFactor: opts.Config.Solr.ReplicationFactor,
+
+		BlueGreen:           opts.Config.Solr.BlueGreen,
+		RetainedCollections: int(opts.Config.Solr.RetainedCollections),
+
+		IndexUpdateTopicName: fmt.Sprintf("%s/%s", opts.Config.Redis.PubSubPrefix, constants.IndexUpdateChannelNameSuffix),
 	}
 	opts.TopicConfigChange.Subscribe(&indexService)
 
 	autoIndexer := indexer.NewAutoIndexer(ctx, indexer.AutoIndexerConfig{
-		Log:           opts.Log,
-		Redis:         opts.Redis,
-		IndexService:  &indexService,
-		SetExpiration: opts.Config.AutoIndexer.SetExpiration.AsDuration(),
+		Log:          opts.Log,
+		Redis:        opts.Redis,
+		DB:           opts.DBPool,
+		IndexService: &indexService,
 
 		TechnicalIDsTopicName: opts.Config.Redis.PubSubPrefix + "/" + items.MetadataItemUpdateByItemIDChannelName,
 		BusinessIDsTopicName:  opts.Config.Redis.PubSubPrefix + "/" + items.MetadataItemUpdateByBusinessIDChannelName,
-		BusinessIDSetName:     opts.Config.Redis.PubSubPrefix + "/" + items.MetadataItemUpdateByBusinessIDSetName,
+
+		PollInterval:    opts.Config.AutoIndexer.OutboxPollInterval.AsDuration(),
+		BatchSize:       int(opts.Config.AutoIndexer.OutboxBatchSize),
+		MaxAttempts:     int(opts.Config.AutoIndexer.OutboxMaxAttempts),
+		RetryBackoff:    opts.Config.AutoIndexer.OutboxRetryBackoff.AsDuration(),
+		MaxRetryBackoff: opts.Config.AutoIndexer.OutboxMaxRetryBackoff.AsDuration(),
+		Lease:           opts.Config.AutoIndexer.OutboxLease.AsDuration(),
 	})
 	autoIndexer.StartPeriodicIndexer()
 
@@ -246,3 +264,30 @@ func setup(ctx context.Context, opts svcutils.SetupOpts, strictConfigParsing boo
 
 	return nil
 }
+
+// ensureSolrAlias creates the alias used with blue/green index rebuilds, together with an initial collection.
+// A plain collection with the name of the alias is kept until the first rebuild replaces it.
+func ensureSolrAlias(ctx context.Context, opts svcutils.SetupOpts, collections []string) error {
+	alias := opts.Config.Solr.Collection
+	if utils.Contains(collections, alias) {
+		opts.Log.Info(ctx, L.Messagef("Solr collection '%s' will be replaced by an alias at the next index rebuild", alias))
+		return nil
+	}
+
+	aliases, err := opts.Solr.GetAliases(ctx)
+	if err != nil {
+		return err
+	}
+	if _, ok := aliases[alias]; ok {
+		return nil
+	}
+
+	collection := index.StagedCollectionName(alias, time.Now())
+	configSet := solr.DefaultSolrConfigSet
+	opts.Log.Info(ctx, L.Messagef("creating Solr collection '%s' from configset '%s' for alias '%s'", collection, configSet, alias))
+	err = opts.Solr.CreateCollection(ctx, collection, configSet, opts.Config.Solr.ReplicationFactor)
+	if err != nil {
+		return err
+	}
+	return opts.Solr.CreateAlias(ctx, alias, collection)
+}
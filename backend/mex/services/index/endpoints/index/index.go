@@ -3,9 +3,9 @@ package index
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/go-redis/redis/v8"
-	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/d4l-data4life/mex/mex/shared/codings/csrepo"
 	"github.com/d4l-data4life/mex/mex/shared/constants"
@@ -16,6 +16,7 @@ import (
 	"github.com/d4l-data4life/mex/mex/shared/solr"
 	"github.com/d4l-data4life/mex/mex/shared/telemetry"
 
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/datamodel"
 	"github.com/d4l-data4life/mex/mex/services/metadata/business/fields"
 	"github.com/d4l-data4life/mex/mex/services/metadata/business/fields/hooks"
 	"github.com/d4l-data4life/mex/mex/services/metadata/endpoints/jobs"
@@ -27,7 +28,7 @@ type Service struct {
 	ServiceTag string
 	Log        L.Logger
 
-	DB             *pgxpool.Pool
+	DB             datamodel.DBTX
 	Redis          *redis.Client
 	Solr           solr.ClientAPI
 	SolrCollection string
@@ -48,6 +49,13 @@ type Service struct {
 	CollectionName    string
 	ReplicationFactor uint32
 
+	// If set, CollectionName is an alias switched over to new collections by rebuilds (see doBlueGreenRebuild)
+	BlueGreen           bool
+	RetainedCollections int
+
+	// Redis channel on which completed index updates are announced (nothing is published if empty)
+	IndexUpdateTopicName string
+
 	pb.UnimplementedIndexServer
 }
 
@@ -73,3 +81,14 @@ func (svc *Service) Message(ctx context.Context, topic string, configHash string
 		svc.TelemetryService.SetStatus(statuspb.Color_RED, configHash)
 	}
 }
+
+// announceIndexUpdate tells the subscribers of the index update topic (e.g. the saved searches) that the index changed.
+func (svc *Service) announceIndexUpdate(ctx context.Context) {
+	if svc.IndexUpdateTopicName == "" || svc.Redis == nil {
+		return
+	}
+
+	if err := svc.Redis.Publish(ctx, svc.IndexUpdateTopicName, time.Now().UTC().Format(time.RFC3339)).Err(); err != nil {
+		svc.Log.Warn(ctx, L.Messagef("could not announce index update: %s", err.Error()))
+	}
+}
@@ -0,0 +1,292 @@
+package index
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	E "github.com/d4l-data4life/mex/mex/shared/errstat"
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+	"github.com/d4l-data4life/mex/mex/shared/solr"
+	"github.com/d4l-data4life/mex/mex/shared/utils"
+
+	"github.com/d4l-data4life/mex/mex/services/index/endpoints/index/pb"
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/datamodel"
+)
+
+/*
+With blue/green rebuilds, the configured collection name is a Solr alias, which is used by the query service.
+A rebuild creates a new collection next to the live one, fills it, and then switches the alias to it in one step.
+Searches are hence never served from a half-built collection. The collections behind the alias are named after the
+alias and the time of their creation (e.g. mex_20060102150405); the most recent previous ones are kept for rollbacks.
+*/
+
+const stagedCollectionTimeFormat = "20060102150405"
+
+// doBlueGreenRebuild builds and fills a new collection and switches the alias to it once its document count is verified
+func (svc *Service) doBlueGreenRebuild(ctx context.Context, progressor utils.Progressor) error {
+	if progressor == nil {
+		progressor = &utils.NopProgressor{}
+	}
+
+	alias := svc.CollectionName
+	newCollection := StagedCollectionName(alias, time.Now())
+	svc.Log.Info(ctx, L.Messagef("blue/green rebuild: building collection '%s' for alias '%s'", newCollection, alias))
+	progressor.Progress("blue/green rebuild", "started")
+
+	err := svc.Solr.CreateCollection(ctx, newCollection, solr.DefaultSolrConfigSet, svc.ReplicationFactor)
+	if err != nil {
+		return fmt.Errorf("failed to create Solr collection '%s': %s", newCollection, err.Error())
+	}
+	progressor.Progress("creating collection", "done")
+
+	// The staged service keeps its own index watermark, which is only taken over by the alias after the switch.
+	staged := *svc
+	staged.Solr = svc.Solr.ForCollection(newCollection)
+	staged.CollectionName = newCollection
+
+	err = staged.fillStagedCollection(ctx, progressor)
+	if err != nil {
+		svc.discardStagedCollection(ctx, newCollection)
+		return err
+	}
+
+	err = svc.switchAlias(ctx, alias, newCollection)
+	if err != nil {
+		svc.discardStagedCollection(ctx, newCollection)
+		return err
+	}
+	progressor.Progress("switching alias", "done")
+	svc.Log.Info(ctx, L.Messagef("blue/green rebuild: alias '%s' now points to collection '%s'", alias, newCollection))
+
+	// Take over the watermark of the new collection and index the changes made while it was built.
+	queries := datamodel.New(svc.DB)
+	watermark, err := queries.DbGetIndexWatermark(ctx, newCollection)
+	if err != nil {
+		return fmt.Errorf("failed to read index watermark of new collection: %s", err.Error())
+	}
+	if err := svc.setIndexWatermark(ctx, watermark); err != nil {
+		return err
+	}
+	if err := queries.DbDeleteIndexWatermark(ctx, newCollection); err != nil {
+		svc.Log.Warn(ctx, L.Messagef("could not remove index watermark of collection '%s': %s", newCollection, err.Error()))
+	}
+	err = svc.DoIncrementalIndexUpdate(ctx, progressor)
+	if err != nil {
+		return fmt.Errorf("failed to index the changes made during the rebuild: %s", err.Error())
+	}
+
+	svc.cleanupCollections(ctx, alias, newCollection)
+	progressor.Progress("blue/green rebuild", "done")
+	return nil
+}
+
+// fillStagedCollection builds the schema and loads all data into the (staged) collection, then verifies the document count
+func (svc *Service) fillStagedCollection(ctx context.Context, progressor utils.Progressor) error {
+	err := svc.doSchemaRebuild(ctx, progressor)
+	if err != nil {
+		return fmt.Errorf("failed to build schema of collection '%s': %s", svc.CollectionName, err.Error())
+	}
+	progressor.Progress("rebuilding schema", "done")
+
+	err = svc.DoIndexUpdate(ctx, progressor)
+	if err != nil {
+		return fmt.Errorf("failed to load data into collection '%s': %s", svc.CollectionName, err.Error())
+	}
+	progressor.Progress("reindexing", "done")
+
+	return svc.verifyDocumentCount(ctx)
+}
+
+/*
+verifyDocumentCount checks that Solr holds one document for each latest item of a focal entity type. Since items may
+be ingested while the collection is loaded, the count is taken as of the watermark of the load: items whose business
+IDs were changed later may or may not have been indexed.
+*/
+func (svc *Service) verifyDocumentCount(ctx context.Context) error {
+	focalEntityNames, err := svc.EntityRepo.GetEntityTypeNames(ctx, true)
+	if err != nil {
+		return err
+	}
+	queries := datamodel.New(svc.DB)
+	watermark, err := queries.DbGetIndexWatermark(ctx, svc.CollectionName)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("collection '%s' was not loaded completely", svc.CollectionName)
+		}
+		return fmt.Errorf("failed to read index watermark: %s", err.Error())
+	}
+	counts, err := queries.DbCountIndexableItems(ctx, datamodel.DbCountIndexableItemsParams{EntityNames: focalEntityNames, Since: watermark})
+	if err != nil {
+		return fmt.Errorf("failed to count indexable items: %s", err.Error())
+	}
+
+	err = svc.Solr.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to commit collection '%s': %s", svc.CollectionName, err.Error())
+	}
+	actual, err := svc.Solr.CountDocuments(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to count documents in collection '%s': %s", svc.CollectionName, err.Error())
+	}
+
+	if actual < counts.Unchanged || actual > counts.Unchanged+counts.Changed {
+		return fmt.Errorf("collection '%s' contains %d document(s), but %d to %d item(s) should have been indexed",
+			svc.CollectionName, actual, counts.Unchanged, counts.Unchanged+counts.Changed)
+	}
+	svc.Log.Info(ctx, L.Messagef("collection '%s' contains %d document(s) as expected", svc.CollectionName, actual))
+	return nil
+}
+
+/*
+switchAlias points the alias to the collection. Before the first blue/green rebuild, a plain collection might carry the
+name of the alias. Since Solr resolves aliases before collections, the alias can be created next to it, which switches
+over the searches in one step; the plain collection is deleted only afterwards.
+*/
+func (svc *Service) switchAlias(ctx context.Context, alias string, collection string) error {
+	collections, err := svc.Solr.GetCollections(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get Solr collections: %s", err.Error())
+	}
+
+	err = svc.Solr.CreateAlias(ctx, alias, collection)
+	if err != nil {
+		return fmt.Errorf("failed to point alias '%s' to collection '%s': %s", alias, collection, err.Error())
+	}
+
+	if utils.Contains(collections, alias) {
+		svc.Log.Warn(ctx, L.Messagef("replaced the collection '%s' by an alias of the same name: deleting the collection", alias))
+		if err := svc.Solr.DeleteCollection(ctx, alias); err != nil {
+			svc.Log.Warn(ctx, L.Messagef("could not delete Solr collection '%s': %s", alias, err.Error()))
+		}
+	}
+	return nil
+}
+
+// discardStagedCollection removes a collection which did not go live; the live collection is left untouched
+func (svc *Service) discardStagedCollection(ctx context.Context, collection string) {
+	svc.Log.Warn(ctx, L.Messagef("blue/green rebuild failed: discarding collection '%s'", collection))
+	if err := svc.Solr.DeleteCollection(ctx, collection); err != nil {
+		svc.Log.Warn(ctx, L.Messagef("could not delete Solr collection '%s': %s", collection, err.Error()))
+	}
+	if err := datamodel.New(svc.DB).DbDeleteIndexWatermark(ctx, collection); err != nil {
+		svc.Log.Warn(ctx, L.Messagef("could not remove index watermark of collection '%s': %s", collection, err.Error()))
+	}
+}
+
+// cleanupCollections deletes the collections of the alias except for the live one and the retained previous ones
+func (svc *Service) cleanupCollections(ctx context.Context, alias string, live string) {
+	collections, err := svc.Solr.GetCollections(ctx)
+	if err != nil {
+		svc.Log.Warn(ctx, L.Messagef("could not list Solr collections for cleanup: %s", err.Error()))
+		return
+	}
+
+	for _, collection := range obsoleteCollections(aliasCollections(alias, collections), live, svc.RetainedCollections) {
+		svc.Log.Info(ctx, L.Messagef("deleting obsolete Solr collection '%s'", collection))
+		if err := svc.Solr.DeleteCollection(ctx, collection); err != nil {
+			svc.Log.Warn(ctx, L.Messagef("could not delete Solr collection '%s': %s", collection, err.Error()))
+		}
+	}
+}
+
+// RollbackIndex points the alias back to the most recent collection older than the live one.
+func (svc *Service) RollbackIndex(ctx context.Context, request *pb.RollbackIndexRequest) (*pb.RollbackIndexResponse, error) {
+	if !svc.BlueGreen {
+		return nil, E.MakeGRPCStatus(codes.FailedPrecondition, "rollback is only possible with blue/green index rebuilds", request).Err()
+	}
+
+	lock, err := svc.JobService.AcquireLock(ctx, SvcResourceName)
+	if err != nil {
+		return nil, E.MakeGRPCStatus(codes.AlreadyExists, "rollback: failed to acquire index lock; other job might be running", request).Err()
+	}
+	defer svc.JobService.ReleaseLock(ctx, SvcResourceName, lock) //nolint:errcheck
+
+	alias := svc.CollectionName
+	aliases, err := svc.Solr.GetAliases(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get Solr aliases: %s", err.Error()))
+	}
+	live, ok := aliases[alias]
+	if !ok {
+		return nil, E.MakeGRPCStatus(codes.FailedPrecondition, fmt.Sprintf("alias '%s' does not exist", alias), request).Err()
+	}
+
+	collections, err := svc.Solr.GetCollections(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get Solr collections: %s", err.Error()))
+	}
+	previous := previousCollection(aliasCollections(alias, collections), live)
+	if previous == "" {
+		return nil, E.MakeGRPCStatus(codes.FailedPrecondition, fmt.Sprintf("no collection older than '%s' to roll back to", live), request).Err()
+	}
+
+	err = svc.Solr.CreateAlias(ctx, alias, previous)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to point alias '%s' to collection '%s': %s", alias, previous, err.Error()))
+	}
+	svc.Log.Info(ctx, L.Messagef("rolled back alias '%s' from collection '%s' to '%s'", alias, live, previous))
+
+	// The previous collection lacks the changes made since it went out of service, so the next incremental
+	// update has to be a full one.
+	if err := svc.clearIndexWatermark(ctx); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.RollbackIndexResponse{Collection: previous, ReplacedCollection: live}, nil
+}
+
+// StagedCollectionName returns the name of a collection created for the alias at the given time
+func StagedCollectionName(alias string, t time.Time) string {
+	return fmt.Sprintf("%s_%s", alias, t.UTC().Format(stagedCollectionTimeFormat))
+}
+
+// aliasCollections returns the collections created for the alias by blue/green rebuilds, oldest first
+func aliasCollections(alias string, collections []string) []string {
+	pattern := regexp.MustCompile(fmt.Sprintf(`^%s_\d{%d}$`, regexp.QuoteMeta(alias), len(stagedCollectionTimeFormat)))
+
+	result := []string{}
+	for _, collection := range collections {
+		if pattern.MatchString(collection) {
+			result = append(result, collection)
+		}
+	}
+	// The timestamp format sorts chronologically.
+	sort.Strings(result)
+	return result
+}
+
+// previousCollection returns the newest of the (sorted) collections older than the live one
+func previousCollection(collections []string, live string) string {
+	previous := ""
+	for _, collection := range collections {
+		if collection < live {
+			previous = collection
+		}
+	}
+	return previous
+}
+
+// obsoleteCollections returns the (sorted) collections except for the live one and the given number of newest other ones
+func obsoleteCollections(collections []string, live string, retained int) []string {
+	others := []string{}
+	for _, collection := range collections {
+		if collection != live {
+			others = append(others, collection)
+		}
+	}
+	if retained >= len(others) {
+		return []string{}
+	}
+	if retained < 0 {
+		retained = 0
+	}
+	return others[:len(others)-retained]
+}
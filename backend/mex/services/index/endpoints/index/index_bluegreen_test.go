@@ -0,0 +1,344 @@
+package index
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/d4l-data4life/mex/mex/shared/db"
+	"github.com/d4l-data4life/mex/mex/shared/entities"
+	"github.com/d4l-data4life/mex/mex/shared/entities/erepo"
+	sharedFields "github.com/d4l-data4life/mex/mex/shared/fields"
+	sharedJobs "github.com/d4l-data4life/mex/mex/shared/jobs"
+	"github.com/d4l-data4life/mex/mex/shared/log"
+	"github.com/d4l-data4life/mex/mex/shared/searchconfig/screpo"
+	"github.com/d4l-data4life/mex/mex/shared/solr"
+
+	"github.com/d4l-data4life/mex/mex/services/index/endpoints/index/pb"
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/fields"
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/fields/frepo"
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/fields/hooks"
+	kindstring "github.com/d4l-data4life/mex/mex/services/metadata/business/fields/kinds/string"
+	"github.com/d4l-data4life/mex/mex/services/metadata/endpoints/jobs"
+)
+
+func Test_StagedCollectionName(t *testing.T) {
+	berlin := time.FixedZone("CEST", 2*60*60)
+	got := StagedCollectionName("mex", time.Date(2023, 6, 1, 14, 30, 5, 0, berlin))
+	if want := "mex_20230601123005"; got != want {
+		t.Errorf("StagedCollectionName() = %v, want %v", got, want)
+	}
+}
+
+func Test_aliasCollections(t *testing.T) {
+	tests := []struct {
+		name        string
+		alias       string
+		collections []string
+		want        []string
+	}{
+		{
+			name:        "No collections",
+			alias:       "mex",
+			collections: []string{},
+			want:        []string{},
+		},
+		{
+			name:        "Collections of the alias are sorted by age",
+			alias:       "mex",
+			collections: []string{"mex_20230601120000", "mex_20220101000000", "mex_20230101000000"},
+			want:        []string{"mex_20220101000000", "mex_20230101000000", "mex_20230601120000"},
+		},
+		{
+			name:        "Other collections are ignored",
+			alias:       "mex",
+			collections: []string{"mex", "mex_test", "mex_2023", "other_20230601120000", "mex_20230601120000x", "mex_20230601120000"},
+			want:        []string{"mex_20230601120000"},
+		},
+		{
+			name:        "Alias names are matched literally",
+			alias:       "m.x",
+			collections: []string{"mex_20230601120000", "m.x_20230601120000"},
+			want:        []string{"m.x_20230601120000"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := aliasCollections(tt.alias, tt.collections); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("aliasCollections() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_previousCollection(t *testing.T) {
+	collections := []string{"mex_20220101000000", "mex_20230101000000", "mex_20230601120000"}
+	tests := []struct {
+		name string
+		live string
+		want string
+	}{
+		{
+			name: "Newest collection older than the live one",
+			live: "mex_20230601120000",
+			want: "mex_20230101000000",
+		},
+		{
+			name: "Newer collections are ignored",
+			live: "mex_20230101000000",
+			want: "mex_20220101000000",
+		},
+		{
+			name: "No older collection",
+			live: "mex_20220101000000",
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := previousCollection(collections, tt.live); got != tt.want {
+				t.Errorf("previousCollection() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_obsoleteCollections(t *testing.T) {
+	collections := []string{"mex_20220101000000", "mex_20230101000000", "mex_20230601120000"}
+	tests := []struct {
+		name     string
+		live     string
+		retained int
+		want     []string
+	}{
+		{
+			name:     "Only the newest previous collection is retained",
+			live:     "mex_20230601120000",
+			retained: 1,
+			want:     []string{"mex_20220101000000"},
+		},
+		{
+			name:     "Nothing is retained",
+			live:     "mex_20230601120000",
+			retained: 0,
+			want:     []string{"mex_20220101000000", "mex_20230101000000"},
+		},
+		{
+			name:     "The live collection is never obsolete",
+			live:     "mex_20220101000000",
+			retained: 0,
+			want:     []string{"mex_20230101000000", "mex_20230601120000"},
+		},
+		{
+			name:     "Fewer collections than retained",
+			live:     "mex_20230601120000",
+			retained: 5,
+			want:     []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := obsoleteCollections(collections, tt.live, tt.retained); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("obsoleteCollections() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// newBlueGreenTestService returns a blue/green service for the alias 'mex' of the cluster; the DB holds one indexable
+// item, unchangedItems are counted as indexable by the document count verification.
+func newBlueGreenTestService(cluster *solr.MockCluster, unchangedItems int64) (*Service, *db.MockTx) {
+	tx := db.NewMockTx(func(stmt db.MockStatement) db.MockResult {
+		switch stmt.Name {
+		case "DbGetIndexWatermark", "DbNextIndexWatermark":
+			return db.MockResult{Rows: [][]any{{int64(120)}}}
+		case "DbCountIndexableItems":
+			return db.MockResult{Rows: [][]any{{unchangedItems, int64(0)}}}
+		case "DbListChangedBusinessIDs":
+			return db.MockResult{Rows: [][]any{}}
+		case "DbSetIndexWatermark", "DbDeleteIndexWatermark":
+			return db.MockResult{}
+		}
+		return db.MockResult{Rows: [][]any{{"a", solr.DefaultUniqueKey, "a"}, {"a", "category", "c1"}}}
+	})
+	solrFieldCreationHooks, _ := hooks.NewSolrFieldCreationHooks(hooks.SolrFieldCreationHooksConfig{})
+	solrDataLoadHooks, _ := hooks.NewSolrDataLoadHooks(hooks.SolrDataLoadHooksConfig{})
+
+	return &Service{
+		Log:        &log.NullLogger{},
+		DB:         tx,
+		Solr:       cluster.Client("mex"),
+		JobService: &jobs.Service{Jobber: sharedJobs.NewMockJobber()},
+		FieldRepo: frepo.NewMockedFieldRepo([]fields.BaseFieldDef{
+			(&kindstring.KindString{}).MustValidateDefinition(context.TODO(), &sharedFields.FieldDef{Name: "category", Kind: "string", IndexDef: &sharedFields.IndexDef{}}),
+		}),
+		EntityRepo: erepo.NewMockedEntityTypesRepo([]*entities.EntityType{
+			{Name: "Resource", Config: &entities.EntityTypeConfig{IsFocal: true}},
+		}),
+		SearchConfigRepo:       screpo.NewMockSearchConfigRepo(nil),
+		SolrFieldCreationHooks: solrFieldCreationHooks,
+		SolrDataLoadHooks:      solrDataLoadHooks,
+		CollectionName:         "mex",
+		BlueGreen:              true,
+		RetainedCollections:    1,
+	}, tx
+}
+
+func TestService_doBlueGreenRebuild(t *testing.T) {
+	tests := []struct {
+		name            string
+		collections     []string
+		aliases         map[string]string
+		unchangedItems  int64
+		wantErr         bool
+		wantOperations  []string
+		wantCollections []string
+	}{
+		{
+			name:            "A plain collection is deleted only once the alias points to the new collection",
+			collections:     []string{"mex"},
+			unchangedItems:  1,
+			wantOperations:  []string{"CREATE <new>", "CREATEALIAS mex <new>", "DELETE mex"},
+			wantCollections: []string{"<new>"},
+		},
+		{
+			name:            "The alias is switched and obsolete collections are deleted",
+			collections:     []string{"mex_20230101000000", "mex_20230201000000"},
+			aliases:         map[string]string{"mex": "mex_20230201000000"},
+			unchangedItems:  1,
+			wantOperations:  []string{"CREATE <new>", "CREATEALIAS mex <new>", "DELETE mex_20230101000000"},
+			wantCollections: []string{"mex_20230201000000", "<new>"},
+		},
+		{
+			name:            "A collection with missing documents is discarded",
+			collections:     []string{"mex_20230201000000"},
+			aliases:         map[string]string{"mex": "mex_20230201000000"},
+			unchangedItems:  2,
+			wantErr:         true,
+			wantOperations:  []string{"CREATE <new>", "DELETE <new>"},
+			wantCollections: []string{"mex_20230201000000"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := solr.NewMockCluster(tt.collections, tt.aliases)
+			svc, tx := newBlueGreenTestService(cluster, tt.unchangedItems)
+			liveAlias := cluster.Aliases["mex"]
+
+			err := svc.doBlueGreenRebuild(context.TODO(), nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("doBlueGreenRebuild() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			newCollection := strings.TrimPrefix(cluster.Operations[0], "CREATE ")
+			replacer := strings.NewReplacer(newCollection, "<new>")
+			operations := strings.Split(replacer.Replace(strings.Join(cluster.Operations, "|")), "|")
+			if !reflect.DeepEqual(operations, tt.wantOperations) {
+				t.Errorf("doBlueGreenRebuild() operations = %v, want %v", operations, tt.wantOperations)
+			}
+			collections := strings.Split(replacer.Replace(strings.Join(cluster.Collections, "|")), "|")
+			if !reflect.DeepEqual(collections, tt.wantCollections) {
+				t.Errorf("doBlueGreenRebuild() collections = %v, want %v", collections, tt.wantCollections)
+			}
+
+			// The data is loaded into the new collection, never into the live one.
+			if got := cluster.Client(newCollection).DocsUploaded; got != 1 {
+				t.Errorf("%d document(s) loaded into the new collection, want 1", got)
+			}
+			if got := cluster.Client("mex").DocsUploaded; got != 0 {
+				t.Errorf("%d document(s) loaded into the live collection", got)
+			}
+
+			if tt.wantErr {
+				if cluster.Aliases["mex"] != liveAlias {
+					t.Errorf("alias switched to %s after a failed rebuild", cluster.Aliases["mex"])
+				}
+				return
+			}
+			set := tx.Executed("DbSetIndexWatermark")
+			if len(set) == 0 || !reflect.DeepEqual(set[len(set)-1].Args, []any{"mex", int64(120)}) {
+				t.Errorf("watermark of the new collection not taken over by the alias: %v", set)
+			}
+		})
+	}
+}
+
+func TestService_RollbackIndex(t *testing.T) {
+	collections := []string{"mex_20230101000000", "mex_20230201000000"}
+	tests := []struct {
+		name       string
+		blueGreen  bool
+		aliases    map[string]string
+		locked     bool
+		want       *pb.RollbackIndexResponse
+		wantCode   codes.Code
+		wantTarget string
+	}{
+		{
+			name:       "The alias is pointed to the previous collection",
+			blueGreen:  true,
+			aliases:    map[string]string{"mex": "mex_20230201000000"},
+			want:       &pb.RollbackIndexResponse{Collection: "mex_20230101000000", ReplacedCollection: "mex_20230201000000"},
+			wantTarget: "mex_20230101000000",
+		},
+		{
+			name:       "Without blue/green rebuilds, there is nothing to roll back to",
+			aliases:    map[string]string{"mex": "mex_20230201000000"},
+			wantCode:   codes.FailedPrecondition,
+			wantTarget: "mex_20230201000000",
+		},
+		{
+			name:      "The alias must exist",
+			blueGreen: true,
+			aliases:   map[string]string{},
+			wantCode:  codes.FailedPrecondition,
+		},
+		{
+			name:       "There must be an older collection",
+			blueGreen:  true,
+			aliases:    map[string]string{"mex": "mex_20230101000000"},
+			wantCode:   codes.FailedPrecondition,
+			wantTarget: "mex_20230101000000",
+		},
+		{
+			name:       "No rollback while another index job is running",
+			blueGreen:  true,
+			aliases:    map[string]string{"mex": "mex_20230201000000"},
+			locked:     true,
+			wantCode:   codes.AlreadyExists,
+			wantTarget: "mex_20230201000000",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := solr.NewMockCluster(collections, tt.aliases)
+			svc, tx := newBlueGreenTestService(cluster, 1)
+			svc.BlueGreen = tt.blueGreen
+			if tt.locked {
+				if _, err := svc.JobService.AcquireLock(context.TODO(), SvcResourceName); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			got, err := svc.RollbackIndex(context.TODO(), &pb.RollbackIndexRequest{})
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("RollbackIndex() error = %v, want code %v", err, tt.wantCode)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RollbackIndex() = %v, want %v", got, tt.want)
+			}
+			if cluster.Aliases["mex"] != tt.wantTarget {
+				t.Errorf("alias points to '%s', want '%s'", cluster.Aliases["mex"], tt.wantTarget)
+			}
+			// The previous collection lacks recent changes, so the next incremental update must be a full one.
+			if cleared := len(tx.Executed("DbDeleteIndexWatermark")) == 1; cleared != (tt.want != nil) {
+				t.Errorf("index watermark cleared: %v", cleared)
+			}
+		})
+	}
+}
@@ -28,7 +28,8 @@ import (
 
 // CreateIndex updates the Solr schema and the query engine configuration,
 // based on the metadata configuration in the DB.
-// The data indexed in Solr is not touched.
+// The data indexed in Solr is not touched, unless blue/green rebuilds are enabled: then, a new collection with the
+// updated schema and all data replaces the live one.
 func (svc *Service) CreateIndex(ctx context.Context, request *pb.CreateIndexRequest) (*pb.CreateIndexResponse, error) {
 	lock, err := svc.JobService.AcquireLock(ctx, SvcResourceName)
 	if err != nil {
@@ -53,7 +54,12 @@ func (svc *Service) CreateIndex(ctx context.Context, request *pb.CreateIndexRequ
 		defer svc.JobService.SetStatusDone(ctx, job.JobId)           //nolint:errcheck
 		defer svc.JobService.ReleaseLock(ctx, SvcResourceName, lock) //nolint:errcheck
 
-		err = svc.doSchemaRebuild(ctx, svc.TelemetryService)
+		if svc.BlueGreen {
+			// Changing the schema of the live collection would degrade search until the data is reloaded.
+			err = svc.doBlueGreenRebuild(ctx, svc.TelemetryService)
+		} else {
+			err = svc.doSchemaRebuild(ctx, svc.TelemetryService)
+		}
 		if err != nil {
 			svc.Log.Error(ctx, L.Message(err.Error()))
 			_, err := svc.JobService.SetError(ctx, &jobspb.SetJobErrorRequest{Error: err.Error(), JobId: job.JobId})
@@ -84,7 +90,7 @@ func (svc *Service) doSchemaRebuild(ctx context.Context, progressor utils.Progre
 	}
 
 	svc.Log.Info(ctx, L.Message("processing search focus field configuration"))
-	solrSchemaUpdates, err := generateSolrSchema(ctx, svc.SolrFieldCreationHooks, fieldDefs, searchConfigElements)
+	solrSchemaUpdates, err := GenerateSolrSchema(ctx, svc.SolrFieldCreationHooks, fieldDefs, searchConfigElements)
 	if err != nil {
 		return fmt.Errorf("failed to generate Solr schema: %s", err.Error())
 	}
@@ -112,8 +118,9 @@ type hierarchyFieldConfig struct {
 	displayFieldName               string
 }
 
-// generateSolrSchema generates all Solr field definitions for the full Solr schema
-func generateSolrSchema(ctx context.Context, solrFieldCreationHooks hooks.SolrFieldCreationHooks,
+// GenerateSolrSchema generates all Solr field definitions for the full Solr schema; it is also used by the config
+// service to validate a config before it is rolled out.
+func GenerateSolrSchema(ctx context.Context, solrFieldCreationHooks hooks.SolrFieldCreationHooks,
 	fieldDefs []fields.BaseFieldDef, searchConfigElements *searchconfig.SearchConfigList,
 ) (*solr.SchemaUpdates, error) {
 	solrSchemaUpdates := &solr.SchemaUpdates{
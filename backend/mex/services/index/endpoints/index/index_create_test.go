@@ -83,7 +83,7 @@ func checkSolrCopyFields(t *testing.T, got []solr.CopyFieldDef, expected []solr.
 	}
 }
 
-func Test_generateSolrSchema(t *testing.T) {
+func Test_GenerateSolrSchema(t *testing.T) {
 
 	// Field sets used for testing
 
@@ -1023,10 +1023,10 @@ func Test_generateSolrSchema(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			fieldDefs, _ := tt.fieldRepo.ListFieldDefs(context.TODO())
-			got, err := generateSolrSchema(context.TODO(), solrFieldCreationHooks, fieldDefs,
+			got, err := GenerateSolrSchema(context.TODO(), solrFieldCreationHooks, fieldDefs,
 				tt.searchConfigElements)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("generateSolrSchema() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("GenerateSolrSchema() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			} else if err == nil {
 				checkSolrFields(t, got.FieldDefs, tt.want.FieldDefs)
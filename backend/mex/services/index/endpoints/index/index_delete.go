@@ -28,6 +28,11 @@ func (svc *Service) DeleteIndex(ctx context.Context, _ *pb.DeleteIndexRequest) (
 		return nil, status.Error(codes.Internal, fmt.Sprintf("failure when trying to delete existing Solr data: %s", err.Error()))
 	}
 
+	err = svc.clearIndexWatermark(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	hints.HintHTTPStatusCode(ctx, http.StatusNoContent)
 	return &pb.DeleteIndexResponse{}, nil
 }
@@ -0,0 +1,53 @@
+package index
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/datamodel"
+
+	"github.com/d4l-data4life/mex/mex/services/index/endpoints/index/pb"
+)
+
+// ListOutboxDeadLetters returns the index outbox entries which could not be indexed within the maximum number of attempts.
+func (svc *Service) ListOutboxDeadLetters(ctx context.Context, _ *pb.ListOutboxDeadLettersRequest) (*pb.ListOutboxDeadLettersResponse, error) {
+	entries, err := datamodel.New(svc.DB).DbListDeadLetteredIndexOutboxEntries(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to list dead-lettered outbox entries: %s", err.Error()))
+	}
+
+	response := &pb.ListOutboxDeadLettersResponse{Entries: make([]*pb.OutboxEntry, len(entries))}
+	for i, entry := range entries {
+		response.Entries[i] = &pb.OutboxEntry{
+			Id:             entry.ID,
+			BusinessId:     entry.BusinessID,
+			CreatedAt:      timestamppb.New(entry.CreatedAt.Time),
+			Attempts:       entry.Attempts,
+			LastError:      entry.LastError.String,
+			DeadLetteredAt: timestamppb.New(entry.DeadLetteredAt.Time),
+		}
+	}
+	return response, nil
+}
+
+// RequeueOutboxDeadLetters resets dead-lettered outbox entries so that they are retried with the next outbox poll.
+func (svc *Service) RequeueOutboxDeadLetters(ctx context.Context, request *pb.RequeueOutboxDeadLettersRequest) (*pb.RequeueOutboxDeadLettersResponse, error) {
+	ids := request.Ids
+	if ids == nil {
+		ids = []int64{}
+	}
+
+	requeued, err := datamodel.New(svc.DB).DbRequeueDeadLetteredIndexOutboxEntries(ctx, ids)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to requeue dead-lettered outbox entries: %s", err.Error()))
+	}
+
+	svc.Log.Info(ctx, L.Messagef("requeued %d dead-lettered index outbox entries", requeued))
+	return &pb.RequeueOutboxDeadLettersResponse{Requeued: requeued}, nil
+}
@@ -50,6 +50,7 @@ func (svc *Service) runRecreateCollectionJob(configHash string) error {
 			}
 		} else {
 			statusColor = statuspb.Color_GREEN
+			svc.announceIndexUpdate(ctx)
 		}
 		svc.Log.Info(ctx, L.Messagef("collection recreation: job done (%s)", job.JobId), L.Phase("job"))
 		svc.TelemetryService.Done()
@@ -70,6 +71,10 @@ func (svc *Service) doCollectionRecreate(ctx context.Context, progressor utils.P
 		progressor = &utils.NopProgressor{}
 	}
 
+	if svc.BlueGreen {
+		return svc.doBlueGreenRebuild(ctx, progressor)
+	}
+
 	progressor.Progress("re-create collection", "started")
 
 	svc.Log.Info(ctx, L.Messagef("recreating and reindexing Solr collection '%s'", svc.CollectionName))
@@ -85,6 +90,10 @@ func (svc *Service) doCollectionRecreate(ctx context.Context, progressor utils.P
 			return err
 		}
 	}
+	err = svc.clearIndexWatermark(ctx)
+	if err != nil {
+		return err
+	}
 
 	err = svc.Solr.CreateCollection(ctx, svc.CollectionName, solr.DefaultSolrConfigSet, svc.ReplicationFactor)
 	if err != nil {
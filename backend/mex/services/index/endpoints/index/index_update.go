@@ -33,8 +33,12 @@ func (svc *Service) UpdateIndex(ctx context.Context, request *pb.UpdateIndexRequ
 		return nil, E.MakeGRPCStatus(codes.AlreadyExists, "update: failed to acquire index lock; other job might be running", request).Err()
 	}
 
+	title := "Repopulate Solr index"
+	if request.Incremental {
+		title = "Update Solr index incrementally"
+	}
 	job, err := svc.JobService.CreateJob(ctx, &jobspb.CreateJobRequest{
-		Title: "Repopulate Solr index",
+		Title: title,
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, fmt.Sprintf("failure creating job:  %s", err.Error()))
@@ -59,7 +63,12 @@ func (svc *Service) UpdateIndex(ctx context.Context, request *pb.UpdateIndexRequ
 		defer svc.JobService.SetStatusDone(ctx, job.JobId)           //nolint:errcheck
 		defer svc.JobService.ReleaseLock(ctx, SvcResourceName, lock) //nolint:errcheck
 
-		indexErr := svc.DoIndexUpdate(ctx, svc.TelemetryService)
+		var indexErr error
+		if request.Incremental {
+			indexErr = svc.DoIncrementalIndexUpdate(ctx, svc.TelemetryService)
+		} else {
+			indexErr = svc.DoIndexUpdate(ctx, svc.TelemetryService)
+		}
 		if indexErr != nil {
 			logJobError(fmt.Sprintf("error during index population: %s", indexErr.Error()))
 			return
@@ -67,6 +76,7 @@ func (svc *Service) UpdateIndex(ctx context.Context, request *pb.UpdateIndexRequ
 
 		svc.Log.Info(ctx, L.Messagef("Solr data load: job done (%s)", job.JobId), L.Phase("job"))
 		svc.TelemetryService.Done()
+		svc.announceIndexUpdate(ctx)
 	}(ctxJob)
 
 	hints.HintHTTPStatusCode(ctx, http.StatusCreated)
@@ -80,13 +90,23 @@ func (svc *Service) DoIndexUpdate(ctx context.Context, progressor utils.Progress
 		progressor = &utils.NopProgressor{}
 	}
 
+	// Determine the watermark before loading the data so that no concurrent change is missed by the next incremental update
+	watermark, err := svc.nextIndexWatermark(ctx)
+	if err != nil {
+		return err
+	}
+
 	// Reset cache in Coding implementations
 	for _, hook := range svc.SolrDataLoadHooks {
 		hook.ResetCaches()
 	}
 
-	err := svc.iterateItems(ctx, progressor)
-	return err
+	state, err := svc.iterateItems(ctx, progressor, itemSelection{})
+	if err != nil {
+		return err
+	}
+
+	return svc.advanceIndexWatermark(ctx, watermark, state)
 }
 
 type iteratorState struct {
@@ -122,23 +142,23 @@ The design with the complex state and the many small functions being called seem
 it was deliberately introduced to make the subtle logic "screechingly obvious" and testable. Future devs are
 invited to search for a potentially better middle ground.
 */
-func (svc *Service) iterateItems(ctx context.Context, progressor utils.Progressor) error {
+func (svc *Service) iterateItems(ctx context.Context, progressor utils.Progressor, selection itemSelection, args ...any) (iteratorState, error) {
 	if progressor == nil {
 		progressor = &utils.NopProgressor{}
 	}
 
 	// Construct the final query
-	finalSQLQuery, err := svc.getSQLStatementForFieldValues(ctx, "")
+	finalSQLQuery, err := svc.getSQLStatementForFieldValues(ctx, selection)
 	if err != nil {
-		return err
+		return iteratorState{}, err
 	}
 
 	startTime := time.Now()
 	svc.Log.Info(ctx, L.Message("begin: indexable items query"))
-	rows, err := svc.DB.Query(ctx, finalSQLQuery)
+	rows, err := svc.DB.Query(ctx, finalSQLQuery, args...)
 	if err != nil {
 		svc.Log.Error(ctx, L.Messagef("failed to retrieve items to index from DB: %s", err.Error()))
-		return err
+		return iteratorState{}, err
 	}
 	svc.Log.Info(ctx, L.Messagef("end: indexable items query (%d ms)", time.Since(startTime).Milliseconds()))
 
@@ -176,7 +196,7 @@ func (svc *Service) iterateItems(ctx context.Context, progressor utils.Progresso
 
 	svc.logReport(ctx, state)
 
-	return nil
+	return state, nil
 }
 
 func isNewItemID(itemID string, state iteratorState) bool {
@@ -323,14 +343,34 @@ func (svc *Service) buildDocumentXML(ctx context.Context, item []datamodel.Curre
 	return sb.String(), nil
 }
 
+// itemSelection restricts the items for which field values are retrieved; the zero value selects all items.
+type itemSelection struct {
+	// Only the item with this ID
+	itemID string
+	// Only the latest items with the business IDs passed as the first parameter of the query (a text array)
+	businessIDsParam bool
+}
+
+// constraint returns the SQL condition (starting with AND) restricting the item ID in the given column to the selection
+func (sel itemSelection) constraint(itemIDColumn string) string {
+	switch {
+	case sel.itemID != "":
+		return fmt.Sprintf(` AND %s = '%s'`, itemIDColumn, sel.itemID)
+	case sel.businessIDsParam:
+		return fmt.Sprintf(` AND %s IN (SELECT item_id FROM latest_items_with_business_id WHERE business_id = ANY($1::text[]))`, itemIDColumn)
+	default:
+		return ""
+	}
+}
+
 /*
 getSQLStatementForFieldValues return a SQL query that will retrieve all field values to be indexed.
-If the version ID is empty, the SQL returned will retrieve field values for all matching items.
+If the selection is empty, the SQL returned will retrieve field values for all matching items.
 Note that since the constructed queries always look up items in a view containing only the items representing
-the most recent versions of the corresponding data, passing an item ID that corresponds to an older version of
+the most recent versions of the corresponding data, selecting an item ID that corresponds to an older version of
 the data will cause the result to be empty.
 */
-func (svc *Service) getSQLStatementForFieldValues(ctx context.Context, newestVersionItemID string) (string, error) {
+func (svc *Service) getSQLStatementForFieldValues(ctx context.Context, selection itemSelection) (string, error) {
 	focalEntityNames, err := svc.EntityRepo.GetEntityTypeNames(ctx, true)
 	if err != nil {
 		return "", err
@@ -363,11 +403,11 @@ func (svc *Service) getSQLStatementForFieldValues(ctx context.Context, newestVer
 	var businessIDValues string
 	var configuredFieldValues string
 
-	// Item ID given --> collect fields from the specified item
-	idValues = sqlExpForItemFields(solr.DefaultUniqueKey, "item_id", focalEntityNames, newestVersionItemID)
-	creationTimeValue = sqlExpForItemFields(solr.ItemCreatedAtField, "TO_CHAR(created_at, 'YYYY-MM-DD\"T\"HH24:MI:SSZ')", focalEntityNames, newestVersionItemID)
-	entityNameValues = sqlExpForItemFields(solr.ItemEntityNameField, "entity_name", focalEntityNames, newestVersionItemID)
-	businessIDValues = sqlExpForItemFields(solr.ItemBusinessIDField, "business_id", focalEntityNames, newestVersionItemID)
+	// Selection given --> collect fields from the selected items
+	idValues = sqlExpForItemFields(solr.DefaultUniqueKey, "item_id", focalEntityNames, selection)
+	creationTimeValue = sqlExpForItemFields(solr.ItemCreatedAtField, "TO_CHAR(created_at, 'YYYY-MM-DD\"T\"HH24:MI:SSZ')", focalEntityNames, selection)
+	entityNameValues = sqlExpForItemFields(solr.ItemEntityNameField, "entity_name", focalEntityNames, selection)
+	businessIDValues = sqlExpForItemFields(solr.ItemBusinessIDField, "business_id", focalEntityNames, selection)
 
 	fieldValueSelects := []string{
 		idValues,
@@ -386,7 +426,7 @@ func (svc *Service) getSQLStatementForFieldValues(ctx context.Context, newestVer
 		return "", E.MakeGRPCStatus(codes.InvalidArgument, "configured field name malformed", E.Cause(err)).Err()
 	}
 	if len(configuredFieldNames) > 0 {
-		configuredFieldValues = sqlExprForNormalFields(configuredFieldNames, focalEntityNames, newestVersionItemID)
+		configuredFieldValues = sqlExprForNormalFields(configuredFieldNames, focalEntityNames, selection)
 		fieldValueSelects = append(fieldValueSelects, configuredFieldValues)
 	}
 
@@ -409,7 +449,7 @@ func (svc *Service) getSQLStatementForFieldValues(ctx context.Context, newestVer
 		if err != nil {
 			return "", E.MakeGRPCStatus(codes.InvalidArgument, "configured linked field name malformed", E.Cause(err)).Err()
 		}
-		linkedFieldValues := sqlExpForLinkedFields(linkFieldNames, focalEntityNames, configuredFieldNames, newestVersionItemID)
+		linkedFieldValues := sqlExpForLinkedFields(linkFieldNames, focalEntityNames, configuredFieldNames, selection)
 		fieldValueSelects = append(fieldValueSelects, linkedFieldValues)
 	}
 
@@ -427,12 +467,9 @@ func createUnionSQL(fieldValueSelects []string) string {
 }
 
 // sqlExpForItemFields builds the SELECT clause to retrieve an item property stored directly in the items table
-func sqlExpForItemFields(fieldName string, fieldValueColumnExp string, focalEntityNames []string, newestVersionItemID string) string {
+func sqlExpForItemFields(fieldName string, fieldValueColumnExp string, focalEntityNames []string, selection itemSelection) string {
 	focalEntityNamesClause := strings.Join(focalEntityNames, ",")
-	itemIDConstraint := ""
-	if newestVersionItemID != "" {
-		itemIDConstraint = fmt.Sprintf(` AND item_id = '%s'`, newestVersionItemID)
-	}
+	itemIDConstraint := selection.constraint("item_id")
 
 	return fmt.Sprintf(`(
 	SELECT item_id AS item_id, '%s' AS field_name, %s AS field_value, 1 AS place, 1 AS revision, NULL AS language
@@ -442,13 +479,10 @@ func sqlExpForItemFields(fieldName string, fieldValueColumnExp string, focalEnti
 }
 
 // sqlExprForNormalFields builds the SELECT clause for normal (user-configure) fields
-func sqlExprForNormalFields(configuredFieldNames []string, focalEntityNames []string, newestVersionItemID string) string {
+func sqlExprForNormalFields(configuredFieldNames []string, focalEntityNames []string, selection itemSelection) string {
 	configuredFieldNamesClause := strings.Join(configuredFieldNames, ",")
 	focalEntityNamesClause := strings.Join(focalEntityNames, ",")
-	itemIDConstraint := ""
-	if newestVersionItemID != "" {
-		itemIDConstraint = fmt.Sprintf(` AND civ.item_id = '%s'`, newestVersionItemID)
-	}
+	itemIDConstraint := selection.constraint("civ.item_id")
 
 	return fmt.Sprintf(`(
 	SELECT civ.item_id, civ.field_name, civ.field_value, civ.place, civ.revision, civ.language
@@ -459,18 +493,15 @@ func sqlExprForNormalFields(configuredFieldNames []string, focalEntityNames []st
 }
 
 // sqlExpForLinkedFields builds the SELECT clause for linked fields
-func sqlExpForLinkedFields(linkFieldNames []string, focalEntityNames []string, configuredFieldNames []string, newestVersionItemID string) string {
+func sqlExpForLinkedFields(linkFieldNames []string, focalEntityNames []string, configuredFieldNames []string, selection itemSelection) string {
 	linkFieldNamesClause := strings.Join(linkFieldNames, ",")
-	itemIDConstraint := ""
-	if newestVersionItemID != "" {
-		itemIDConstraint = fmt.Sprintf(` AND civ_source.item_id = '%s'`, newestVersionItemID)
-	}
+	itemIDConstraint := selection.constraint("civ_source.item_id")
 	focalEntityNamesClause := strings.Join(focalEntityNames, ",")
 	configuredFieldNamesClause := strings.Join(configuredFieldNames, ",")
 
 	/*
 		The constructed SQL does the following:
-		1. Get the link fields in the newest versions of each focal item with a business ID (possibly restricted to a fixed item ID).
+		1. Get the link fields in the newest versions of each focal item with a business ID (possibly restricted to the selected items).
 		2. For each such link field, find the most recent version of the item it links to (need not be focal).
 		3. Get the values for all fields on this linked item.
 		4. Build all possible linked fields with the attendant values.
@@ -45,7 +45,7 @@ func (svc *Service) IndexLatestItem(ctx context.Context, request *pb.IndexLatest
 		return nil, err
 	}
 
-	finalSQLQuery, err := svc.getSQLStatementForFieldValues(ctx, newestItemVersionID)
+	finalSQLQuery, err := svc.getSQLStatementForFieldValues(ctx, itemSelection{itemID: newestItemVersionID})
 	if err != nil {
 		return nil, err
 	}
@@ -86,6 +86,7 @@ func (svc *Service) IndexLatestItem(ctx context.Context, request *pb.IndexLatest
 	if err != nil {
 		return nil, err
 	}
+	svc.announceIndexUpdate(ctx)
 
 	return &pb.IndexLatestItemResponse{}, nil
 }
@@ -0,0 +1,170 @@
+package index
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+	"github.com/d4l-data4life/mex/mex/shared/solr"
+	"github.com/d4l-data4life/mex/mex/shared/utils"
+
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/datamodel"
+	kindHierarchy "github.com/d4l-data4life/mex/mex/services/metadata/business/fields/kinds/hierarchy"
+	kindLink "github.com/d4l-data4life/mex/mex/services/metadata/business/fields/kinds/link"
+)
+
+// Number of business IDs re-indexed in one go by the incremental index update (stays below Solr's default limit of
+// 1024 clauses in the removal query)
+const incrementalBatchSize = 1000
+
+/*
+DoIncrementalIndexUpdate only re-indexes the business IDs which changed since the watermark of the collection, i.e.
+by transactions which had not ended when the last index update started. A business ID has changed if it got a new
+version, a value revision, a new relation, or if one of its versions was deleted. Items whose link fields point at a
+changed business ID are re-indexed as well since their documents contain values of the linked items; this is followed
+through any number of links. Without a watermark, a full index update is done.
+
+All Solr documents of a changed business ID are removed before its latest item is indexed again, so that documents of
+older or deleted versions do not linger in the index.
+*/
+func (svc *Service) DoIncrementalIndexUpdate(ctx context.Context, progressor utils.Progressor) error {
+	if progressor == nil {
+		progressor = &utils.NopProgressor{}
+	}
+
+	queries := datamodel.New(svc.DB)
+	since, err := queries.DbGetIndexWatermark(ctx, svc.CollectionName)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			svc.Log.Info(ctx, L.Messagef("no index watermark for collection '%s': doing a full index update", svc.CollectionName))
+			return svc.DoIndexUpdate(ctx, progressor)
+		}
+		return fmt.Errorf("failed to read index watermark: %s", err.Error())
+	}
+
+	watermark, err := svc.nextIndexWatermark(ctx)
+	if err != nil {
+		return err
+	}
+
+	linkFieldNames, err := svc.linkFieldNames(ctx)
+	if err != nil {
+		return err
+	}
+	businessIDs, err := queries.DbListChangedBusinessIDs(ctx, datamodel.DbListChangedBusinessIDsParams{
+		Since:          since,
+		LinkFieldNames: linkFieldNames,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list changed business IDs: %s", err.Error())
+	}
+	svc.Log.Info(ctx, L.Messagef("incremental index update: %d business ID(s) changed since transaction %d", len(businessIDs), since))
+
+	// Reset cache in Coding implementations
+	for _, hook := range svc.SolrDataLoadHooks {
+		hook.ResetCaches()
+	}
+
+	complete := true
+	for start := 0; start < len(businessIDs); start += incrementalBatchSize {
+		end := start + incrementalBatchSize
+		if end > len(businessIDs) {
+			end = len(businessIDs)
+		}
+		batch := businessIDs[start:end]
+
+		err := svc.Solr.RemoveDocumentsByQuery(ctx, businessIDsQuery(batch))
+		if err != nil {
+			return fmt.Errorf("failed to remove changed documents from Solr: %s", err.Error())
+		}
+
+		state, err := svc.iterateItems(ctx, progressor, itemSelection{businessIDsParam: true}, batch)
+		if err != nil {
+			return err
+		}
+		complete = complete && !isErrorState(state)
+
+		progressor.Progress("indexing", fmt.Sprintf("processed business IDs: %d of %d", start+len(batch), len(businessIDs)))
+	}
+
+	if !complete {
+		svc.Log.Warn(ctx, L.Message("incremental index update incomplete: keeping the index watermark"))
+		return nil
+	}
+	return svc.setIndexWatermark(ctx, watermark)
+}
+
+// nextIndexWatermark returns the watermark to be stored once the index update which is about to start is done
+func (svc *Service) nextIndexWatermark(ctx context.Context) (int64, error) {
+	watermark, err := datamodel.New(svc.DB).DbNextIndexWatermark(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to determine index watermark: %s", err.Error())
+	}
+	return watermark, nil
+}
+
+// advanceIndexWatermark stores the watermark unless not all items could be indexed, so that the next incremental update
+// picks them up again
+func (svc *Service) advanceIndexWatermark(ctx context.Context, watermark int64, state iteratorState) error {
+	if isErrorState(state) {
+		svc.Log.Warn(ctx, L.Message("index update incomplete: keeping the index watermark"))
+		return nil
+	}
+	return svc.setIndexWatermark(ctx, watermark)
+}
+
+func (svc *Service) setIndexWatermark(ctx context.Context, watermark int64) error {
+	err := datamodel.New(svc.DB).DbSetIndexWatermark(ctx, datamodel.DbSetIndexWatermarkParams{
+		Collection:      svc.CollectionName,
+		IndexedUntilXid: watermark,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store index watermark: %s", err.Error())
+	}
+	return nil
+}
+
+// clearIndexWatermark forces the next incremental update to be a full one, e.g. after the index was dropped
+func (svc *Service) clearIndexWatermark(ctx context.Context) error {
+	err := datamodel.New(svc.DB).DbDeleteIndexWatermark(ctx, svc.CollectionName)
+	if err != nil {
+		return fmt.Errorf("failed to clear index watermark: %s", err.Error())
+	}
+	return nil
+}
+
+// linkFieldNames returns the names of the fields whose values are business IDs of other items
+func (svc *Service) linkFieldNames(ctx context.Context) ([]string, error) {
+	linkingFieldDefs, err := svc.FieldRepo.GetFieldDefsByKind(ctx, kindLink.KindName)
+	if err != nil {
+		return nil, err
+	}
+	hierarchyFieldDefs, err := svc.FieldRepo.GetFieldDefsByKind(ctx, kindHierarchy.KindName)
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, fd := range append(linkingFieldDefs, hierarchyFieldDefs...) {
+		names = append(names, fd.Name())
+	}
+	return names, nil
+}
+
+// businessIDsQuery builds a Solr query matching all documents with one of the given business IDs
+func businessIDsQuery(businessIDs []string) string {
+	if len(businessIDs) == 0 {
+		return ""
+	}
+
+	escaper := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	terms := make([]string, len(businessIDs))
+	for i, businessID := range businessIDs {
+		terms[i] = fmt.Sprintf(`"%s"`, escaper.Replace(businessID))
+	}
+	return fmt.Sprintf("%s:(%s)", solr.ItemBusinessIDField, strings.Join(terms, " OR "))
+}
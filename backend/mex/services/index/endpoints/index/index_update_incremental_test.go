@@ -0,0 +1,201 @@
+package index
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/d4l-data4life/mex/mex/shared/db"
+	"github.com/d4l-data4life/mex/mex/shared/entities"
+	"github.com/d4l-data4life/mex/mex/shared/entities/erepo"
+	sharedFields "github.com/d4l-data4life/mex/mex/shared/fields"
+	"github.com/d4l-data4life/mex/mex/shared/log"
+	"github.com/d4l-data4life/mex/mex/shared/solr"
+
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/fields"
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/fields/frepo"
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/fields/hooks"
+	kindlink "github.com/d4l-data4life/mex/mex/services/metadata/business/fields/kinds/link"
+	kindstring "github.com/d4l-data4life/mex/mex/services/metadata/business/fields/kinds/string"
+)
+
+func TestService_DoIncrementalIndexUpdate(t *testing.T) {
+	fieldsRepo := frepo.NewMockedFieldRepo([]fields.BaseFieldDef{
+		(&kindstring.KindString{}).MustValidateDefinition(context.TODO(), &sharedFields.FieldDef{Name: "category", Kind: "string", IndexDef: &sharedFields.IndexDef{}}),
+		fields.NewBaseFieldDef("partOf", kindlink.KindName, "", false, fields.BaseIndexDef{}),
+	})
+	entityRepo := erepo.NewMockedEntityTypesRepo([]*entities.EntityType{
+		{Name: "Resource", Config: &entities.EntityTypeConfig{IsFocal: true}},
+	})
+	solrDataLoadHooks, _ := hooks.NewSolrDataLoadHooks(hooks.SolrDataLoadHooksConfig{})
+
+	validItem := [][]any{{"a", solr.DefaultUniqueKey, "a"}, {"a", "category", "c1"}}
+	tests := []struct {
+		name              string
+		watermark         [][]any
+		itemValues        [][]any
+		wantStatements    []string
+		wantSince         any
+		wantRemoved       []string
+		wantItemsSelected any
+		wantWatermarkSet  bool
+	}{
+		{
+			name:             "Without a watermark, all items are indexed",
+			watermark:        [][]any{},
+			itemValues:       validItem,
+			wantStatements:   []string{"DbGetIndexWatermark", "DbNextIndexWatermark", "<items>", "DbSetIndexWatermark"},
+			wantRemoved:      []string{},
+			wantWatermarkSet: true,
+		},
+		{
+			name:              "Changed business IDs are removed and indexed again",
+			watermark:         [][]any{{int64(100)}},
+			itemValues:        validItem,
+			wantStatements:    []string{"DbGetIndexWatermark", "DbNextIndexWatermark", "DbListChangedBusinessIDs", "<items>", "DbSetIndexWatermark"},
+			wantSince:         int64(100),
+			wantRemoved:       []string{`businessId:("B1" OR "B2")`},
+			wantItemsSelected: []string{"B1", "B2"},
+			wantWatermarkSet:  true,
+		},
+		{
+			name:              "The watermark is kept if items could not be indexed",
+			watermark:         [][]any{{int64(100)}},
+			itemValues:        [][]any{{"a", solr.DefaultUniqueKey, "a"}, {"a", "unknown", "x"}},
+			wantStatements:    []string{"DbGetIndexWatermark", "DbNextIndexWatermark", "DbListChangedBusinessIDs", "<items>"},
+			wantSince:         int64(100),
+			wantRemoved:       []string{`businessId:("B1" OR "B2")`},
+			wantItemsSelected: []string{"B1", "B2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := db.NewMockTx(func(stmt db.MockStatement) db.MockResult {
+				switch stmt.Name {
+				case "DbGetIndexWatermark":
+					return db.MockResult{Rows: tt.watermark}
+				case "DbNextIndexWatermark":
+					return db.MockResult{Rows: [][]any{{int64(120)}}}
+				case "DbListChangedBusinessIDs":
+					return db.MockResult{Rows: [][]any{{"B1"}, {"B2"}}}
+				case "DbSetIndexWatermark":
+					return db.MockResult{}
+				}
+				return db.MockResult{Rows: tt.itemValues}
+			})
+			solrClient := solr.NewMockClient(false, "works", solr.ReturnVals{})
+			svc := &Service{
+				Log:               &log.NullLogger{},
+				DB:                tx,
+				Solr:              &solrClient,
+				FieldRepo:         fieldsRepo,
+				EntityRepo:        entityRepo,
+				SolrDataLoadHooks: solrDataLoadHooks,
+				CollectionName:    "mex",
+			}
+
+			if err := svc.DoIncrementalIndexUpdate(context.TODO(), nil); err != nil {
+				t.Fatalf("DoIncrementalIndexUpdate() error = %v", err)
+			}
+
+			statements := tx.Statements()
+			for i, name := range statements {
+				if !strings.HasPrefix(name, "Db") {
+					statements[i] = "<items>"
+				}
+			}
+			if !reflect.DeepEqual(statements, tt.wantStatements) {
+				t.Errorf("DoIncrementalIndexUpdate() statements = %v, want %v", statements, tt.wantStatements)
+			}
+			if listed := tx.Executed("DbListChangedBusinessIDs"); tt.wantSince != nil &&
+				(len(listed) != 1 || listed[0].Args[0] != tt.wantSince || !reflect.DeepEqual(listed[0].Args[1], []string{"partOf"})) {
+				t.Errorf("changed business IDs not listed since %v via the link fields: %v", tt.wantSince, listed)
+			}
+			if !reflect.DeepEqual(solrClient.QueriesRemoved, tt.wantRemoved) {
+				t.Errorf("removed documents = %v, want %v", solrClient.QueriesRemoved, tt.wantRemoved)
+			}
+			if tt.wantItemsSelected != nil {
+				items := tx.Executed(tx.Statements()[3])
+				if len(items) != 1 || !reflect.DeepEqual(items[0].Args, []any{tt.wantItemsSelected}) {
+					t.Errorf("items selected = %v, want %v", items, tt.wantItemsSelected)
+				}
+			}
+			set := tx.Executed("DbSetIndexWatermark")
+			if tt.wantWatermarkSet && (len(set) != 1 || !reflect.DeepEqual(set[0].Args, []any{"mex", int64(120)})) {
+				t.Errorf("watermark stored: %v, want the next watermark", set)
+			}
+		})
+	}
+}
+
+func Test_businessIDsQuery(t *testing.T) {
+	tests := []struct {
+		name        string
+		businessIDs []string
+		want        string
+	}{
+		{
+			name:        "No business IDs yield no query",
+			businessIDs: nil,
+			want:        "",
+		},
+		{
+			name:        "Single business ID",
+			businessIDs: []string{"abc"},
+			want:        `businessId:("abc")`,
+		},
+		{
+			name:        "Several business IDs are combined with OR",
+			businessIDs: []string{"abc", "def"},
+			want:        `businessId:("abc" OR "def")`,
+		},
+		{
+			name:        "Quotes and backslashes are escaped",
+			businessIDs: []string{`a"b`, `c\d`},
+			want:        `businessId:("a\"b" OR "c\\d")`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := businessIDsQuery(tt.businessIDs); got != tt.want {
+				t.Errorf("businessIDsQuery() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_itemSelection_constraint(t *testing.T) {
+	tests := []struct {
+		name      string
+		selection itemSelection
+		column    string
+		want      string
+	}{
+		{
+			name:      "Empty selection does not constrain the items",
+			selection: itemSelection{},
+			column:    "item_id",
+			want:      "",
+		},
+		{
+			name:      "Item ID selects a single item",
+			selection: itemSelection{itemID: "123"},
+			column:    "civ.item_id",
+			want:      ` AND civ.item_id = '123'`,
+		},
+		{
+			name:      "Business IDs select the latest items via the query parameter",
+			selection: itemSelection{businessIDsParam: true},
+			column:    "civ_source.item_id",
+			want:      ` AND civ_source.item_id IN (SELECT item_id FROM latest_items_with_business_id WHERE business_id = ANY($1::text[]))`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.selection.constraint(tt.column); got != tt.want {
+				t.Errorf("constraint() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -111,6 +112,10 @@ type UpdateIndexRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Only re-index the items changed since the last index update of the collection (and the items linking to them).
+	// Falls back to a full update if the collection has not been updated before.
+	Incremental bool `protobuf:"varint,1,opt,name=incremental,proto3" json:"incremental,omitempty"`
 }
 
 func (x *UpdateIndexRequest) Reset() {
@@ -145,6 +150,13 @@ func (*UpdateIndexRequest) Descriptor() ([]byte, []int) {
 	return file_services_index_endpoints_index_index_proto_rawDescGZIP(), []int{2}
 }
 
+func (x *UpdateIndexRequest) GetIncremental() bool {
+	if x != nil {
+		return x.Incremental
+	}
+	return false
+}
+
 type UpdateIndexResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -353,6 +365,368 @@ func (*IndexLatestItemResponse) Descriptor() ([]byte, []int) {
 	return file_services_index_endpoints_index_index_proto_rawDescGZIP(), []int{7}
 }
 
+type OutboxEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BusinessId     string                 `protobuf:"bytes,2,opt,name=business_id,json=businessId,proto3" json:"business_id,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Attempts       int32                  `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError      string                 `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	DeadLetteredAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=dead_lettered_at,json=deadLetteredAt,proto3" json:"dead_lettered_at,omitempty"`
+}
+
+func (x *OutboxEntry) Reset() {
+	*x = OutboxEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_index_endpoints_index_index_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutboxEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutboxEntry) ProtoMessage() {}
+
+func (x *OutboxEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_services_index_endpoints_index_index_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutboxEntry.ProtoReflect.Descriptor instead.
+func (*OutboxEntry) Descriptor() ([]byte, []int) {
+	return file_services_index_endpoints_index_index_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *OutboxEntry) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OutboxEntry) GetBusinessId() string {
+	if x != nil {
+		return x.BusinessId
+	}
+	return ""
+}
+
+func (x *OutboxEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *OutboxEntry) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *OutboxEntry) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *OutboxEntry) GetDeadLetteredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeadLetteredAt
+	}
+	return nil
+}
+
+type ListOutboxDeadLettersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListOutboxDeadLettersRequest) Reset() {
+	*x = ListOutboxDeadLettersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_index_endpoints_index_index_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOutboxDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOutboxDeadLettersRequest) ProtoMessage() {}
+
+func (x *ListOutboxDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_index_endpoints_index_index_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOutboxDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ListOutboxDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_services_index_endpoints_index_index_proto_rawDescGZIP(), []int{9}
+}
+
+type ListOutboxDeadLettersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*OutboxEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *ListOutboxDeadLettersResponse) Reset() {
+	*x = ListOutboxDeadLettersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_index_endpoints_index_index_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOutboxDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOutboxDeadLettersResponse) ProtoMessage() {}
+
+func (x *ListOutboxDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_index_endpoints_index_index_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOutboxDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ListOutboxDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_services_index_endpoints_index_index_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListOutboxDeadLettersResponse) GetEntries() []*OutboxEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type RequeueOutboxDeadLettersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// IDs of the dead-lettered entries to be retried; all dead-lettered entries if empty
+	Ids []int64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *RequeueOutboxDeadLettersRequest) Reset() {
+	*x = RequeueOutboxDeadLettersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_index_endpoints_index_index_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequeueOutboxDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequeueOutboxDeadLettersRequest) ProtoMessage() {}
+
+func (x *RequeueOutboxDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_index_endpoints_index_index_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequeueOutboxDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*RequeueOutboxDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_services_index_endpoints_index_index_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RequeueOutboxDeadLettersRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type RequeueOutboxDeadLettersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requeued int64 `protobuf:"varint,1,opt,name=requeued,proto3" json:"requeued,omitempty"`
+}
+
+func (x *RequeueOutboxDeadLettersResponse) Reset() {
+	*x = RequeueOutboxDeadLettersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_index_endpoints_index_index_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequeueOutboxDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequeueOutboxDeadLettersResponse) ProtoMessage() {}
+
+func (x *RequeueOutboxDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_index_endpoints_index_index_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequeueOutboxDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*RequeueOutboxDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_services_index_endpoints_index_index_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RequeueOutboxDeadLettersResponse) GetRequeued() int64 {
+	if x != nil {
+		return x.Requeued
+	}
+	return 0
+}
+
+type RollbackIndexRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RollbackIndexRequest) Reset() {
+	*x = RollbackIndexRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_index_endpoints_index_index_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollbackIndexRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackIndexRequest) ProtoMessage() {}
+
+func (x *RollbackIndexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_index_endpoints_index_index_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackIndexRequest.ProtoReflect.Descriptor instead.
+func (*RollbackIndexRequest) Descriptor() ([]byte, []int) {
+	return file_services_index_endpoints_index_index_proto_rawDescGZIP(), []int{13}
+}
+
+type RollbackIndexResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Collection the alias points to after the rollback
+	Collection string `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	// Collection the alias pointed to before the rollback
+	ReplacedCollection string `protobuf:"bytes,2,opt,name=replaced_collection,json=replacedCollection,proto3" json:"replaced_collection,omitempty"`
+}
+
+func (x *RollbackIndexResponse) Reset() {
+	*x = RollbackIndexResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_index_endpoints_index_index_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollbackIndexResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackIndexResponse) ProtoMessage() {}
+
+func (x *RollbackIndexResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_index_endpoints_index_index_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackIndexResponse.ProtoReflect.Descriptor instead.
+func (*RollbackIndexResponse) Descriptor() ([]byte, []int) {
+	return file_services_index_endpoints_index_index_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RollbackIndexResponse) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *RollbackIndexResponse) GetReplacedCollection() string {
+	if x != nil {
+		return x.ReplacedCollection
+	}
+	return ""
+}
+
 type DummyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -362,7 +736,7 @@ type DummyRequest struct {
 func (x *DummyRequest) Reset() {
 	*x = DummyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_index_endpoints_index_index_proto_msgTypes[8]
+		mi := &file_services_index_endpoints_index_index_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -375,7 +749,7 @@ func (x *DummyRequest) String() string {
 func (*DummyRequest) ProtoMessage() {}
 
 func (x *DummyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_index_endpoints_index_index_proto_msgTypes[8]
+	mi := &file_services_index_endpoints_index_index_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -388,7 +762,7 @@ func (x *DummyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DummyRequest.ProtoReflect.Descriptor instead.
 func (*DummyRequest) Descriptor() ([]byte, []int) {
-	return file_services_index_endpoints_index_index_proto_rawDescGZIP(), []int{8}
+	return file_services_index_endpoints_index_index_proto_rawDescGZIP(), []int{15}
 }
 
 type DummyResponse struct {
@@ -400,7 +774,7 @@ type DummyResponse struct {
 func (x *DummyResponse) Reset() {
 	*x = DummyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_index_endpoints_index_index_proto_msgTypes[9]
+		mi := &file_services_index_endpoints_index_index_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -413,7 +787,7 @@ func (x *DummyResponse) String() string {
 func (*DummyResponse) ProtoMessage() {}
 
 func (x *DummyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_index_endpoints_index_index_proto_msgTypes[9]
+	mi := &file_services_index_endpoints_index_index_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -426,7 +800,7 @@ func (x *DummyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DummyResponse.ProtoReflect.Descriptor instead.
 func (*DummyResponse) Descriptor() ([]byte, []int) {
-	return file_services_index_endpoints_index_index_proto_rawDescGZIP(), []int{9}
+	return file_services_index_endpoints_index_index_proto_rawDescGZIP(), []int{16}
 }
 
 type ReplicaStatus struct {
@@ -442,7 +816,7 @@ type ReplicaStatus struct {
 func (x *ReplicaStatus) Reset() {
 	*x = ReplicaStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_index_endpoints_index_index_proto_msgTypes[10]
+		mi := &file_services_index_endpoints_index_index_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -455,7 +829,7 @@ func (x *ReplicaStatus) String() string {
 func (*ReplicaStatus) ProtoMessage() {}
 
 func (x *ReplicaStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_services_index_endpoints_index_index_proto_msgTypes[10]
+	mi := &file_services_index_endpoints_index_index_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -468,7 +842,7 @@ func (x *ReplicaStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReplicaStatus.ProtoReflect.Descriptor instead.
 func (*ReplicaStatus) Descriptor() ([]byte, []int) {
-	return file_services_index_endpoints_index_index_proto_rawDescGZIP(), []int{10}
+	return file_services_index_endpoints_index_index_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ReplicaStatus) GetName() string {
@@ -506,7 +880,7 @@ type ShardStatus struct {
 func (x *ShardStatus) Reset() {
 	*x = ShardStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_index_endpoints_index_index_proto_msgTypes[11]
+		mi := &file_services_index_endpoints_index_index_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -519,7 +893,7 @@ func (x *ShardStatus) String() string {
 func (*ShardStatus) ProtoMessage() {}
 
 func (x *ShardStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_services_index_endpoints_index_index_proto_msgTypes[11]
+	mi := &file_services_index_endpoints_index_index_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -532,7 +906,7 @@ func (x *ShardStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShardStatus.ProtoReflect.Descriptor instead.
 func (*ShardStatus) Descriptor() ([]byte, []int) {
-	return file_services_index_endpoints_index_index_proto_rawDescGZIP(), []int{11}
+	return file_services_index_endpoints_index_index_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ShardStatus) GetName() string {
@@ -576,7 +950,7 @@ type SolrClusterStatus struct {
 func (x *SolrClusterStatus) Reset() {
 	*x = SolrClusterStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_index_endpoints_index_index_proto_msgTypes[12]
+		mi := &file_services_index_endpoints_index_index_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -589,7 +963,7 @@ func (x *SolrClusterStatus) String() string {
 func (*SolrClusterStatus) ProtoMessage() {}
 
 func (x *SolrClusterStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_services_index_endpoints_index_index_proto_msgTypes[12]
+	mi := &file_services_index_endpoints_index_index_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -602,7 +976,7 @@ func (x *SolrClusterStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SolrClusterStatus.ProtoReflect.Descriptor instead.
 func (*SolrClusterStatus) Descriptor() ([]byte, []int) {
-	return file_services_index_endpoints_index_index_proto_rawDescGZIP(), []int{12}
+	return file_services_index_endpoints_index_index_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *SolrClusterStatus) GetCollection() string {
@@ -635,7 +1009,7 @@ type IndexStatusRequest struct {
 func (x *IndexStatusRequest) Reset() {
 	*x = IndexStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_index_endpoints_index_index_proto_msgTypes[13]
+		mi := &file_services_index_endpoints_index_index_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -648,7 +1022,7 @@ func (x *IndexStatusRequest) String() string {
 func (*IndexStatusRequest) ProtoMessage() {}
 
 func (x *IndexStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_index_endpoints_index_index_proto_msgTypes[13]
+	mi := &file_services_index_endpoints_index_index_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -661,7 +1035,7 @@ func (x *IndexStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IndexStatusRequest.ProtoReflect.Descriptor instead.
 func (*IndexStatusRequest) Descriptor() ([]byte, []int) {
-	return file_services_index_endpoints_index_index_proto_rawDescGZIP(), []int{13}
+	return file_services_index_endpoints_index_index_proto_rawDescGZIP(), []int{20}
 }
 
 type IndexStatusResponse struct {
@@ -677,7 +1051,7 @@ type IndexStatusResponse struct {
 func (x *IndexStatusResponse) Reset() {
 	*x = IndexStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_index_endpoints_index_index_proto_msgTypes[14]
+		mi := &file_services_index_endpoints_index_index_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -690,7 +1064,7 @@ func (x *IndexStatusResponse) String() string {
 func (*IndexStatusResponse) ProtoMessage() {}
 
 func (x *IndexStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_index_endpoints_index_index_proto_msgTypes[14]
+	mi := &file_services_index_endpoints_index_index_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -703,7 +1077,7 @@ func (x *IndexStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IndexStatusResponse.ProtoReflect.Descriptor instead.
 func (*IndexStatusResponse) Descriptor() ([]byte, []int) {
-	return file_services_index_endpoints_index_index_proto_rawDescGZIP(), []int{14}
+	return file_services_index_endpoints_index_index_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *IndexStatusResponse) GetClusterStatus() *SolrClusterStatus {
@@ -736,112 +1110,189 @@ var file_services_index_endpoints_index_index_proto_rawDesc = []byte{
 	0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x1a, 0x12, 0x64, 0x34, 0x6c,
 	0x2f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a,
-	0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x2c, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f,
-	0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49,
-	0x64, 0x22, 0x14, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2c, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15,
-	0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x39, 0x0a, 0x16, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x4c, 0x61, 0x74, 0x65, 0x73,
-	0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
-	0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x22, 0x19, 0x0a,
-	0x17, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0e, 0x0a, 0x0c, 0x44, 0x75, 0x6d, 0x6d,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0f, 0x0a, 0x0d, 0x44, 0x75, 0x6d, 0x6d,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x0a, 0x0d, 0x52, 0x65, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x89, 0x01, 0x0a,
-	0x0b, 0x53, 0x68, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x38,
-	0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08,
-	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x22, 0x7f, 0x0a, 0x11, 0x53, 0x6f, 0x6c, 0x72,
-	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a,
-	0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
-	0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x97, 0x01, 0x0a, 0x13, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x20, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2e,
-	0x53, 0x6f, 0x6c, 0x72, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x0d, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0xe9, 0x05, 0x0a, 0x05, 0x49, 0x6e,
-	0x64, 0x65, 0x78, 0x12, 0x8a, 0x01, 0x0a, 0x0b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x21, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78,
-	0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x98, 0xf1, 0x04, 0x02,
-	0xaa, 0xf1, 0x04, 0x0e, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x05, 0x71, 0x75, 0x65,
-	0x72, 0x79, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
-	0x30, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x12, 0x8e, 0x01, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78,
-	0x12, 0x21, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1, 0x04,
-	0x0f, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x30, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x12, 0x8b, 0x01, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65,
-	0x78, 0x12, 0x21, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14,
+	0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x2c, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6a,
+	0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62,
+	0x49, 0x64, 0x22, 0x36, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69,
+	0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x22, 0x2c, 0x0a, 0x13, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x15,
+	0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x0a, 0x16, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x4c, 0x61,
+	0x74, 0x65, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64,
+	0x22, 0x19, 0x0a, 0x17, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x49,
+	0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xfa, 0x01, 0x0a, 0x0b,
+	0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x62,
+	0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x44, 0x0a, 0x10, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x65, 0x64, 0x41, 0x74, 0x22, 0x1e, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74,
+	0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x55, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74,
+	0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x34, 0x6c,
+	0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2e, 0x4f, 0x75, 0x74, 0x62, 0x6f,
+	0x78, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22,
+	0x33, 0x0a, 0x1f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78,
+	0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52,
+	0x03, 0x69, 0x64, 0x73, 0x22, 0x3e, 0x0a, 0x20, 0x52, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4f,
+	0x75, 0x74, 0x62, 0x6f, 0x78, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x68, 0x0a, 0x15,
+	0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x13, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x64, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x0e, 0x0a, 0x0c, 0x44, 0x75, 0x6d, 0x6d, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0f, 0x0a, 0x0d, 0x44, 0x75, 0x6d, 0x6d, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x89, 0x01, 0x0a, 0x0b, 0x53,
+	0x68, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x08,
+	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2e, 0x52,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x72, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x22, 0x7f, 0x0a, 0x11, 0x53, 0x6f, 0x6c, 0x72, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x97, 0x01,
+	0x0a, 0x13, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2e, 0x53, 0x6f,
+	0x6c, 0x72, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x0d, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x99, 0x0a, 0x0a, 0x05, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x8a, 0x01, 0x0a, 0x0b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x21, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1,
-	0x04, 0x0f, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x1a, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x30,
+	0x6e, 0x64, 0x65, 0x78, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1,
+	0x04, 0x0e, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x30, 0x2f,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x8e,
+	0x01, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21,
+	0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1, 0x04, 0x0f, 0x0a,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x30,
 	0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12,
-	0xa5, 0x01, 0x0a, 0x0f, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x49,
-	0x74, 0x65, 0x6d, 0x12, 0x25, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x49,
-	0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x64, 0x34, 0x6c,
-	0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78,
-	0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x43, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1, 0x04, 0x0f, 0x0a, 0x05, 0x69, 0x6e,
+	0x8b, 0x01, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x21, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1, 0x04, 0x0f,
+	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x18, 0x1a, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x30, 0x2f, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0xa5, 0x01,
+	0x0a, 0x0f, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x12, 0x25, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d,
+	0x65, 0x78, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x4c, 0x61,
+	0x74, 0x65, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x43, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1, 0x04, 0x0f, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x1a,
+	0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x30, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x7b, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73,
+	0x73, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xbc, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75,
+	0x74, 0x62, 0x6f, 0x78, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12,
+	0x2b, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x64,
+	0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x98, 0xf1, 0x04, 0x02,
+	0xaa, 0xf1, 0x04, 0x0e, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x05, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x30, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x2f, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x2f, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x73, 0x12, 0xd1, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x73, 0x12, 0x2e, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x44,
+	0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2f, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x44,
+	0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x54, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1, 0x04, 0x0f, 0x0a, 0x05, 0x69, 0x6e,
 	0x64, 0x65, 0x78, 0x12, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x26, 0x1a, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x30, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x7b, 0x62, 0x75, 0x73, 0x69, 0x6e,
-	0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x8b, 0x01, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65,
-	0x78, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e,
-	0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x64, 0x34, 0x6c,
-	0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35,
-	0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1, 0x04, 0x0f, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12,
-	0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x2a, 0x16, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x76, 0x30, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x42, 0x57, 0x5a, 0x55, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x65, 0x73, 0x75, 0x6e, 0x64, 0x68, 0x65, 0x69, 0x74, 0x73, 0x63,
-	0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x72, 0x6b, 0x69, 0x2d, 0x6d, 0x65, 0x78, 0x2d, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x6d, 0x65, 0x78, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x73, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x70, 0x62, 0x3b, 0x70, 0x62, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x37, 0x3a, 0x01, 0x2a, 0x22, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x30, 0x2f, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x6f, 0x75, 0x74,
+	0x62, 0x6f, 0x78, 0x2f, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73,
+	0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x9a, 0x01, 0x0a, 0x0d, 0x52, 0x6f, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x23, 0x2e, 0x64, 0x34, 0x6c,
+	0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2e,
+	0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1, 0x04, 0x0f, 0x0a,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x21, 0x22, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x30, 0x2f, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x72, 0x6f, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x8b, 0x01, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d,
+	0x65, 0x78, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x98, 0xf1,
+	0x04, 0x02, 0xaa, 0xf1, 0x04, 0x0f, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x06, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x2a, 0x16, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x30, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x42, 0x47, 0x5a, 0x45, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x64, 0x34, 0x6c, 0x2d, 0x64, 0x61, 0x74, 0x61, 0x34, 0x6c, 0x69, 0x66, 0x65, 0x2f,
+	0x6d, 0x65, 0x78, 0x2f, 0x6d, 0x65, 0x78, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x70, 0x62, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -856,43 +1307,60 @@ func file_services_index_endpoints_index_index_proto_rawDescGZIP() []byte {
 	return file_services_index_endpoints_index_index_proto_rawDescData
 }
 
-var file_services_index_endpoints_index_index_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_services_index_endpoints_index_index_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_services_index_endpoints_index_index_proto_goTypes = []interface{}{
-	(*CreateIndexRequest)(nil),      // 0: d4l.mex.index.CreateIndexRequest
-	(*CreateIndexResponse)(nil),     // 1: d4l.mex.index.CreateIndexResponse
-	(*UpdateIndexRequest)(nil),      // 2: d4l.mex.index.UpdateIndexRequest
-	(*UpdateIndexResponse)(nil),     // 3: d4l.mex.index.UpdateIndexResponse
-	(*DeleteIndexRequest)(nil),      // 4: d4l.mex.index.DeleteIndexRequest
-	(*DeleteIndexResponse)(nil),     // 5: d4l.mex.index.DeleteIndexResponse
-	(*IndexLatestItemRequest)(nil),  // 6: d4l.mex.index.IndexLatestItemRequest
-	(*IndexLatestItemResponse)(nil), // 7: d4l.mex.index.IndexLatestItemResponse
-	(*DummyRequest)(nil),            // 8: d4l.mex.index.DummyRequest
-	(*DummyResponse)(nil),           // 9: d4l.mex.index.DummyResponse
-	(*ReplicaStatus)(nil),           // 10: d4l.mex.index.ReplicaStatus
-	(*ShardStatus)(nil),             // 11: d4l.mex.index.ShardStatus
-	(*SolrClusterStatus)(nil),       // 12: d4l.mex.index.SolrClusterStatus
-	(*IndexStatusRequest)(nil),      // 13: d4l.mex.index.IndexStatusRequest
-	(*IndexStatusResponse)(nil),     // 14: d4l.mex.index.IndexStatusResponse
+	(*CreateIndexRequest)(nil),               // 0: d4l.mex.index.CreateIndexRequest
+	(*CreateIndexResponse)(nil),              // 1: d4l.mex.index.CreateIndexResponse
+	(*UpdateIndexRequest)(nil),               // 2: d4l.mex.index.UpdateIndexRequest
+	(*UpdateIndexResponse)(nil),              // 3: d4l.mex.index.UpdateIndexResponse
+	(*DeleteIndexRequest)(nil),               // 4: d4l.mex.index.DeleteIndexRequest
+	(*DeleteIndexResponse)(nil),              // 5: d4l.mex.index.DeleteIndexResponse
+	(*IndexLatestItemRequest)(nil),           // 6: d4l.mex.index.IndexLatestItemRequest
+	(*IndexLatestItemResponse)(nil),          // 7: d4l.mex.index.IndexLatestItemResponse
+	(*OutboxEntry)(nil),                      // 8: d4l.mex.index.OutboxEntry
+	(*ListOutboxDeadLettersRequest)(nil),     // 9: d4l.mex.index.ListOutboxDeadLettersRequest
+	(*ListOutboxDeadLettersResponse)(nil),    // 10: d4l.mex.index.ListOutboxDeadLettersResponse
+	(*RequeueOutboxDeadLettersRequest)(nil),  // 11: d4l.mex.index.RequeueOutboxDeadLettersRequest
+	(*RequeueOutboxDeadLettersResponse)(nil), // 12: d4l.mex.index.RequeueOutboxDeadLettersResponse
+	(*RollbackIndexRequest)(nil),             // 13: d4l.mex.index.RollbackIndexRequest
+	(*RollbackIndexResponse)(nil),            // 14: d4l.mex.index.RollbackIndexResponse
+	(*DummyRequest)(nil),                     // 15: d4l.mex.index.DummyRequest
+	(*DummyResponse)(nil),                    // 16: d4l.mex.index.DummyResponse
+	(*ReplicaStatus)(nil),                    // 17: d4l.mex.index.ReplicaStatus
+	(*ShardStatus)(nil),                      // 18: d4l.mex.index.ShardStatus
+	(*SolrClusterStatus)(nil),                // 19: d4l.mex.index.SolrClusterStatus
+	(*IndexStatusRequest)(nil),               // 20: d4l.mex.index.IndexStatusRequest
+	(*IndexStatusResponse)(nil),              // 21: d4l.mex.index.IndexStatusResponse
+	(*timestamppb.Timestamp)(nil),            // 22: google.protobuf.Timestamp
 }
 var file_services_index_endpoints_index_index_proto_depIdxs = []int32{
-	10, // 0: d4l.mex.index.ShardStatus.replicas:type_name -> d4l.mex.index.ReplicaStatus
-	11, // 1: d4l.mex.index.SolrClusterStatus.shards:type_name -> d4l.mex.index.ShardStatus
-	12, // 2: d4l.mex.index.IndexStatusResponse.cluster_status:type_name -> d4l.mex.index.SolrClusterStatus
-	13, // 3: d4l.mex.index.Index.IndexStatus:input_type -> d4l.mex.index.IndexStatusRequest
-	0,  // 4: d4l.mex.index.Index.CreateIndex:input_type -> d4l.mex.index.CreateIndexRequest
-	2,  // 5: d4l.mex.index.Index.UpdateIndex:input_type -> d4l.mex.index.UpdateIndexRequest
-	6,  // 6: d4l.mex.index.Index.IndexLatestItem:input_type -> d4l.mex.index.IndexLatestItemRequest
-	4,  // 7: d4l.mex.index.Index.DeleteIndex:input_type -> d4l.mex.index.DeleteIndexRequest
-	14, // 8: d4l.mex.index.Index.IndexStatus:output_type -> d4l.mex.index.IndexStatusResponse
-	1,  // 9: d4l.mex.index.Index.CreateIndex:output_type -> d4l.mex.index.CreateIndexResponse
-	3,  // 10: d4l.mex.index.Index.UpdateIndex:output_type -> d4l.mex.index.UpdateIndexResponse
-	7,  // 11: d4l.mex.index.Index.IndexLatestItem:output_type -> d4l.mex.index.IndexLatestItemResponse
-	5,  // 12: d4l.mex.index.Index.DeleteIndex:output_type -> d4l.mex.index.DeleteIndexResponse
-	8,  // [8:13] is the sub-list for method output_type
-	3,  // [3:8] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	22, // 0: d4l.mex.index.OutboxEntry.created_at:type_name -> google.protobuf.Timestamp
+	22, // 1: d4l.mex.index.OutboxEntry.dead_lettered_at:type_name -> google.protobuf.Timestamp
+	8,  // 2: d4l.mex.index.ListOutboxDeadLettersResponse.entries:type_name -> d4l.mex.index.OutboxEntry
+	17, // 3: d4l.mex.index.ShardStatus.replicas:type_name -> d4l.mex.index.ReplicaStatus
+	18, // 4: d4l.mex.index.SolrClusterStatus.shards:type_name -> d4l.mex.index.ShardStatus
+	19, // 5: d4l.mex.index.IndexStatusResponse.cluster_status:type_name -> d4l.mex.index.SolrClusterStatus
+	20, // 6: d4l.mex.index.Index.IndexStatus:input_type -> d4l.mex.index.IndexStatusRequest
+	0,  // 7: d4l.mex.index.Index.CreateIndex:input_type -> d4l.mex.index.CreateIndexRequest
+	2,  // 8: d4l.mex.index.Index.UpdateIndex:input_type -> d4l.mex.index.UpdateIndexRequest
+	6,  // 9: d4l.mex.index.Index.IndexLatestItem:input_type -> d4l.mex.index.IndexLatestItemRequest
+	9,  // 10: d4l.mex.index.Index.ListOutboxDeadLetters:input_type -> d4l.mex.index.ListOutboxDeadLettersRequest
+	11, // 11: d4l.mex.index.Index.RequeueOutboxDeadLetters:input_type -> d4l.mex.index.RequeueOutboxDeadLettersRequest
+	13, // 12: d4l.mex.index.Index.RollbackIndex:input_type -> d4l.mex.index.RollbackIndexRequest
+	4,  // 13: d4l.mex.index.Index.DeleteIndex:input_type -> d4l.mex.index.DeleteIndexRequest
+	21, // 14: d4l.mex.index.Index.IndexStatus:output_type -> d4l.mex.index.IndexStatusResponse
+	1,  // 15: d4l.mex.index.Index.CreateIndex:output_type -> d4l.mex.index.CreateIndexResponse
+	3,  // 16: d4l.mex.index.Index.UpdateIndex:output_type -> d4l.mex.index.UpdateIndexResponse
+	7,  // 17: d4l.mex.index.Index.IndexLatestItem:output_type -> d4l.mex.index.IndexLatestItemResponse
+	10, // 18: d4l.mex.index.Index.ListOutboxDeadLetters:output_type -> d4l.mex.index.ListOutboxDeadLettersResponse
+	12, // 19: d4l.mex.index.Index.RequeueOutboxDeadLetters:output_type -> d4l.mex.index.RequeueOutboxDeadLettersResponse
+	14, // 20: d4l.mex.index.Index.RollbackIndex:output_type -> d4l.mex.index.RollbackIndexResponse
+	5,  // 21: d4l.mex.index.Index.DeleteIndex:output_type -> d4l.mex.index.DeleteIndexResponse
+	14, // [14:22] is the sub-list for method output_type
+	6,  // [6:14] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_services_index_endpoints_index_index_proto_init() }
@@ -998,7 +1466,7 @@ func file_services_index_endpoints_index_index_proto_init() {
 			}
 		}
 		file_services_index_endpoints_index_index_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DummyRequest); i {
+			switch v := v.(*OutboxEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1010,7 +1478,7 @@ func file_services_index_endpoints_index_index_proto_init() {
 			}
 		}
 		file_services_index_endpoints_index_index_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DummyResponse); i {
+			switch v := v.(*ListOutboxDeadLettersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1022,7 +1490,7 @@ func file_services_index_endpoints_index_index_proto_init() {
 			}
 		}
 		file_services_index_endpoints_index_index_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReplicaStatus); i {
+			switch v := v.(*ListOutboxDeadLettersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1034,7 +1502,7 @@ func file_services_index_endpoints_index_index_proto_init() {
 			}
 		}
 		file_services_index_endpoints_index_index_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShardStatus); i {
+			switch v := v.(*RequeueOutboxDeadLettersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1046,7 +1514,7 @@ func file_services_index_endpoints_index_index_proto_init() {
 			}
 		}
 		file_services_index_endpoints_index_index_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SolrClusterStatus); i {
+			switch v := v.(*RequeueOutboxDeadLettersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1058,7 +1526,7 @@ func file_services_index_endpoints_index_index_proto_init() {
 			}
 		}
 		file_services_index_endpoints_index_index_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IndexStatusRequest); i {
+			switch v := v.(*RollbackIndexRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1070,6 +1538,90 @@ func file_services_index_endpoints_index_index_proto_init() {
 			}
 		}
 		file_services_index_endpoints_index_index_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackIndexResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_index_endpoints_index_index_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DummyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_index_endpoints_index_index_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DummyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_index_endpoints_index_index_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplicaStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_index_endpoints_index_index_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShardStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_index_endpoints_index_index_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SolrClusterStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_index_endpoints_index_index_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IndexStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_index_endpoints_index_index_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IndexStatusResponse); i {
 			case 0:
 				return &v.state
@@ -1088,7 +1640,7 @@ func file_services_index_endpoints_index_index_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_services_index_endpoints_index_index_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
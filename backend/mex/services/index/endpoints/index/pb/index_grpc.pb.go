@@ -19,11 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Index_IndexStatus_FullMethodName     = "/d4l.mex.index.Index/IndexStatus"
-	Index_CreateIndex_FullMethodName     = "/d4l.mex.index.Index/CreateIndex"
-	Index_UpdateIndex_FullMethodName     = "/d4l.mex.index.Index/UpdateIndex"
-	Index_IndexLatestItem_FullMethodName = "/d4l.mex.index.Index/IndexLatestItem"
-	Index_DeleteIndex_FullMethodName     = "/d4l.mex.index.Index/DeleteIndex"
+	Index_IndexStatus_FullMethodName              = "/d4l.mex.index.Index/IndexStatus"
+	Index_CreateIndex_FullMethodName              = "/d4l.mex.index.Index/CreateIndex"
+	Index_UpdateIndex_FullMethodName              = "/d4l.mex.index.Index/UpdateIndex"
+	Index_IndexLatestItem_FullMethodName          = "/d4l.mex.index.Index/IndexLatestItem"
+	Index_ListOutboxDeadLetters_FullMethodName    = "/d4l.mex.index.Index/ListOutboxDeadLetters"
+	Index_RequeueOutboxDeadLetters_FullMethodName = "/d4l.mex.index.Index/RequeueOutboxDeadLetters"
+	Index_RollbackIndex_FullMethodName            = "/d4l.mex.index.Index/RollbackIndex"
+	Index_DeleteIndex_FullMethodName              = "/d4l.mex.index.Index/DeleteIndex"
 )
 
 // IndexClient is the client API for Index service.
@@ -34,6 +37,9 @@ type IndexClient interface {
 	CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*CreateIndexResponse, error)
 	UpdateIndex(ctx context.Context, in *UpdateIndexRequest, opts ...grpc.CallOption) (*UpdateIndexResponse, error)
 	IndexLatestItem(ctx context.Context, in *IndexLatestItemRequest, opts ...grpc.CallOption) (*IndexLatestItemResponse, error)
+	ListOutboxDeadLetters(ctx context.Context, in *ListOutboxDeadLettersRequest, opts ...grpc.CallOption) (*ListOutboxDeadLettersResponse, error)
+	RequeueOutboxDeadLetters(ctx context.Context, in *RequeueOutboxDeadLettersRequest, opts ...grpc.CallOption) (*RequeueOutboxDeadLettersResponse, error)
+	RollbackIndex(ctx context.Context, in *RollbackIndexRequest, opts ...grpc.CallOption) (*RollbackIndexResponse, error)
 	DeleteIndex(ctx context.Context, in *DeleteIndexRequest, opts ...grpc.CallOption) (*DeleteIndexResponse, error)
 }
 
@@ -81,6 +87,33 @@ func (c *indexClient) IndexLatestItem(ctx context.Context, in *IndexLatestItemRe
 	return out, nil
 }
 
+func (c *indexClient) ListOutboxDeadLetters(ctx context.Context, in *ListOutboxDeadLettersRequest, opts ...grpc.CallOption) (*ListOutboxDeadLettersResponse, error) {
+	out := new(ListOutboxDeadLettersResponse)
+	err := c.cc.Invoke(ctx, Index_ListOutboxDeadLetters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexClient) RequeueOutboxDeadLetters(ctx context.Context, in *RequeueOutboxDeadLettersRequest, opts ...grpc.CallOption) (*RequeueOutboxDeadLettersResponse, error) {
+	out := new(RequeueOutboxDeadLettersResponse)
+	err := c.cc.Invoke(ctx, Index_RequeueOutboxDeadLetters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexClient) RollbackIndex(ctx context.Context, in *RollbackIndexRequest, opts ...grpc.CallOption) (*RollbackIndexResponse, error) {
+	out := new(RollbackIndexResponse)
+	err := c.cc.Invoke(ctx, Index_RollbackIndex_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *indexClient) DeleteIndex(ctx context.Context, in *DeleteIndexRequest, opts ...grpc.CallOption) (*DeleteIndexResponse, error) {
 	out := new(DeleteIndexResponse)
 	err := c.cc.Invoke(ctx, Index_DeleteIndex_FullMethodName, in, out, opts...)
@@ -98,6 +131,9 @@ type IndexServer interface {
 	CreateIndex(context.Context, *CreateIndexRequest) (*CreateIndexResponse, error)
 	UpdateIndex(context.Context, *UpdateIndexRequest) (*UpdateIndexResponse, error)
 	IndexLatestItem(context.Context, *IndexLatestItemRequest) (*IndexLatestItemResponse, error)
+	ListOutboxDeadLetters(context.Context, *ListOutboxDeadLettersRequest) (*ListOutboxDeadLettersResponse, error)
+	RequeueOutboxDeadLetters(context.Context, *RequeueOutboxDeadLettersRequest) (*RequeueOutboxDeadLettersResponse, error)
+	RollbackIndex(context.Context, *RollbackIndexRequest) (*RollbackIndexResponse, error)
 	DeleteIndex(context.Context, *DeleteIndexRequest) (*DeleteIndexResponse, error)
 	mustEmbedUnimplementedIndexServer()
 }
@@ -118,6 +154,15 @@ func (UnimplementedIndexServer) UpdateIndex(context.Context, *UpdateIndexRequest
 func (UnimplementedIndexServer) IndexLatestItem(context.Context, *IndexLatestItemRequest) (*IndexLatestItemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IndexLatestItem not implemented")
 }
+func (UnimplementedIndexServer) ListOutboxDeadLetters(context.Context, *ListOutboxDeadLettersRequest) (*ListOutboxDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOutboxDeadLetters not implemented")
+}
+func (UnimplementedIndexServer) RequeueOutboxDeadLetters(context.Context, *RequeueOutboxDeadLettersRequest) (*RequeueOutboxDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequeueOutboxDeadLetters not implemented")
+}
+func (UnimplementedIndexServer) RollbackIndex(context.Context, *RollbackIndexRequest) (*RollbackIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackIndex not implemented")
+}
 func (UnimplementedIndexServer) DeleteIndex(context.Context, *DeleteIndexRequest) (*DeleteIndexResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteIndex not implemented")
 }
@@ -206,6 +251,60 @@ func _Index_IndexLatestItem_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Index_ListOutboxDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOutboxDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexServer).ListOutboxDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Index_ListOutboxDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexServer).ListOutboxDeadLetters(ctx, req.(*ListOutboxDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Index_RequeueOutboxDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequeueOutboxDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexServer).RequeueOutboxDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Index_RequeueOutboxDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexServer).RequeueOutboxDeadLetters(ctx, req.(*RequeueOutboxDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Index_RollbackIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexServer).RollbackIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Index_RollbackIndex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexServer).RollbackIndex(ctx, req.(*RollbackIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Index_DeleteIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteIndexRequest)
 	if err := dec(in); err != nil {
@@ -247,6 +346,18 @@ var Index_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IndexLatestItem",
 			Handler:    _Index_IndexLatestItem_Handler,
 		},
+		{
+			MethodName: "ListOutboxDeadLetters",
+			Handler:    _Index_ListOutboxDeadLetters_Handler,
+		},
+		{
+			MethodName: "RequeueOutboxDeadLetters",
+			Handler:    _Index_RequeueOutboxDeadLetters_Handler,
+		},
+		{
+			MethodName: "RollbackIndex",
+			Handler:    _Index_RollbackIndex_Handler,
+		},
 		{
 			MethodName: "DeleteIndex",
 			Handler:    _Index_DeleteIndex_Handler,
@@ -6,9 +6,13 @@ import (
 	"time"
 
 	"github.com/go-redis/redis/v8"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
 
 	L "github.com/d4l-data4life/mex/mex/shared/log"
 
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/datamodel"
+
 	"github.com/d4l-data4life/mex/mex/services/index/endpoints/index"
 	"github.com/d4l-data4life/mex/mex/services/index/endpoints/index/pb"
 )
@@ -21,47 +25,78 @@ type AutoIndexer interface {
 	StopPeriodicIndexer()
 }
 
+/*
+The indexer consumes the index outbox, which the metadata service fills within the transactions creating the items.
+Announcements of business IDs only trigger the processing of the outbox; in addition, the outbox is polled periodically.
+Replicas claim disjoint batches of outbox entries, and an entry is only deleted once its business ID has been indexed
+(at-least-once). Failed entries are retried with an exponential backoff and dead-lettered after too many attempts.
+*/
 type indexer struct {
 	mu sync.Mutex
 
 	log   L.Logger
 	redis *redis.Client
+	db    datamodel.DBTX
 
 	technicalIDsTopicName string
 	businessIDsTopicName  string
-	businessIDSetName     string
 
-	indexService *index.Service
+	indexService latestItemIndexer
+
+	pollInterval    time.Duration
+	batchSize       int
+	maxAttempts     int
+	retryBackoff    time.Duration
+	maxRetryBackoff time.Duration
+	lease           time.Duration
 
-	setExpiration time.Duration
+	trigger chan struct{}
+	ticker  *time.Ticker
+	quit    chan struct{}
+}
 
-	ticker *time.Ticker
-	quit   chan struct{}
+// latestItemIndexer is the part of the index service used by the indexer.
+type latestItemIndexer interface {
+	IndexLatestItem(ctx context.Context, request *pb.IndexLatestItemRequest) (*pb.IndexLatestItemResponse, error)
 }
 
 type AutoIndexerConfig struct {
 	Log          L.Logger
 	Redis        *redis.Client
+	DB           *pgxpool.Pool
 	IndexService *index.Service
 
 	TechnicalIDsTopicName string
 	BusinessIDsTopicName  string
-	BusinessIDSetName     string
 
-	SetExpiration time.Duration
+	PollInterval    time.Duration
+	BatchSize       int
+	MaxAttempts     int
+	RetryBackoff    time.Duration
+	MaxRetryBackoff time.Duration
+	Lease           time.Duration
 }
 
 func NewAutoIndexer(ctx context.Context, config AutoIndexerConfig) AutoIndexer {
 	idx := indexer{
 		log:   config.Log,
 		redis: config.Redis,
+		db:    config.DB,
 
 		technicalIDsTopicName: config.TechnicalIDsTopicName,
 		businessIDsTopicName:  config.BusinessIDsTopicName,
-		businessIDSetName:     config.BusinessIDSetName,
 
-		indexService:  config.IndexService,
-		setExpiration: config.SetExpiration,
+		indexService: config.IndexService,
+
+		pollInterval:    config.PollInterval,
+		batchSize:       config.BatchSize,
+		maxAttempts:     config.MaxAttempts,
+		retryBackoff:    config.RetryBackoff,
+		maxRetryBackoff: config.MaxRetryBackoff,
+		lease:           config.Lease,
+
+		// A single pending trigger suffices since each processing run empties the outbox.
+		trigger: make(chan struct{}, 1),
 	}
 
 	topicByTechnicalID := idx.redis.Subscribe(ctx, config.TechnicalIDsTopicName)
@@ -78,35 +113,7 @@ func NewAutoIndexer(ctx context.Context, config AutoIndexerConfig) AutoIndexer {
 
 			case msg := <-channelByBusinessID:
 				idx.log.Info(ctx, L.Messagef("AutoIndexer registered new item with business ID: %s", msg.Payload), L.Phase("bid-update"))
-
-				go func() {
-					// The event of a new business item will be received by every service replica.
-					// We want to ensure that only one replica will do an indexing, and not each replica.
-					// This is achieved by attempting to remove the business ID from the list first.
-					// Since Redis is single-threaded, this will only succeed for one replica, which is the one responsible for indexing.
-					// The SREM command conveniently returns the actual number of removed items; if that value is 1, we are the indexing replica.
-					sremCmd := idx.redis.SRem(ctx, idx.businessIDSetName, msg.Payload)
-					switch {
-					case sremCmd.Err() != nil:
-						idx.log.Warn(ctx, L.Messagef("error deleting item '%s' from set '%s': %s", msg.Payload, idx.businessIDSetName, sremCmd.Err().Error()), L.Phase("bid-update"))
-
-					case sremCmd.Val() == 1:
-						_, err := config.IndexService.IndexLatestItem(ctx, &pb.IndexLatestItemRequest{BusinessId: msg.Payload})
-						if err != nil {
-							idx.log.Warn(ctx, L.Messagef("error indexing item '%s': %s", msg.Payload, err.Error()), L.Phase("bid-update"))
-
-							// We are the indexing replica, but something went wrong.
-							// We stick the business ID back into the set so the periodic indexer can take care of it.
-							err = idx.redis.SAdd(ctx, idx.businessIDSetName, msg.Payload).Err()
-							if err != nil {
-								idx.log.Warn(ctx, L.Messagef("error re-adding business ID '%s' to Redis set '%s': %s", msg.Payload, idx.businessIDSetName, sremCmd.Err().Error()), L.Phase("bid-update"))
-							}
-						}
-
-					default:
-						idx.log.Info(ctx, L.Messagef("wanted to index '%s', but it seems another instance took care of it", msg.Payload), L.Phase("bid-update"))
-					}
-				}()
+				idx.triggerProcessing()
 
 			case <-ctx.Done():
 				idx.log.Info(ctx, L.Message("imminent shutdown; unsubscribing from topics"), L.Phase("shutdown"))
@@ -121,6 +128,18 @@ func NewAutoIndexer(ctx context.Context, config AutoIndexerConfig) AutoIndexer {
 		}
 	}()
 
+	go func() {
+		for {
+			select {
+			case <-idx.trigger:
+				idx.processOutbox(ctx)
+
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
 	return &idx
 }
 
@@ -133,17 +152,6 @@ func (idx *indexer) AnnounceTechnicalItemID(technicalID string) {
 
 func (idx *indexer) AnnounceBusinessItemID(businessID string) {
 	ctx := context.Background()
-
-	// Add the item ID to the set so it is recorded for later indexing in case the below (*) broadcast is somehow lost.
-	if cmdSAdd := idx.redis.SAdd(ctx, idx.businessIDSetName, businessID); cmdSAdd.Err() != nil {
-		idx.log.Warn(ctx, L.Messagef("could not add business ID to indexer set: %s (%s)", businessID, cmdSAdd.Err().Error()))
-	}
-
-	if idx.redis.Expire(ctx, idx.businessIDSetName, idx.setExpiration).Err() != nil {
-		idx.log.Warn(ctx, L.Message("could not reset indexer expiration time"))
-	}
-
-	// (*) Broadcast the item ID.
 	if cmdPublish := idx.redis.Publish(ctx, idx.businessIDsTopicName, businessID); cmdPublish.Err() != nil {
 		idx.log.Warn(ctx, L.Messagef("could not publish business ID: %s: %s", businessID, cmdPublish.Err().Error()))
 	}
@@ -157,22 +165,22 @@ func (idx *indexer) StartPeriodicIndexer() {
 		return
 	}
 
-	idx.ticker = time.NewTicker(idx.setExpiration / 2)
+	idx.ticker = time.NewTicker(idx.pollInterval)
 	idx.quit = make(chan struct{})
 
-	go func() {
+	go func(ticker *time.Ticker, quit chan struct{}) {
 		for {
 			select {
-			case <-idx.ticker.C:
-				idx.indexOverlookedItems()
+			case <-ticker.C:
+				idx.triggerProcessing()
 
-			case <-idx.quit:
-				idx.ticker.Stop()
+			case <-quit:
+				ticker.Stop()
 				idx.log.Info(context.Background(), L.Message("stopping ticker"))
 				return
 			}
 		}
-	}()
+	}(idx.ticker, idx.quit)
 }
 
 func (idx *indexer) StopPeriodicIndexer() {
@@ -189,39 +197,98 @@ func (idx *indexer) StopPeriodicIndexer() {
 	idx.ticker = nil
 }
 
-func (idx *indexer) indexOverlookedItems() {
-	idx.mu.Lock()
-	defer idx.mu.Unlock()
+func (idx *indexer) triggerProcessing() {
+	select {
+	case idx.trigger <- struct{}{}:
+	default:
+		// Processing is already pending.
+	}
+}
 
-	ctx := context.Background()
+// processOutbox claims and processes batches of due outbox entries until there are none left.
+func (idx *indexer) processOutbox(ctx context.Context) {
+	queries := datamodel.New(idx.db)
+	for {
+		entries, err := queries.DbClaimIndexOutboxEntries(ctx, datamodel.DbClaimIndexOutboxEntriesParams{
+			LeaseUntil: pgtype.Timestamptz{Time: time.Now().Add(idx.lease), Valid: true},
+			MaxEntries: int32(idx.batchSize),
+		})
+		if err != nil {
+			idx.log.Warn(ctx, L.Messagef("could not claim index outbox entries: %s", err.Error()), L.Phase("bid-update"))
+			return
+		}
+		if len(entries) == 0 {
+			return
+		}
+		idx.log.Info(ctx, L.Messagef("processing %d index outbox entries", len(entries)), L.Phase("bid-update"))
+
+		// Several entries may refer to the same business ID, which then only needs to be indexed once.
+		var businessIDs []string
+		entriesByBusinessID := make(map[string][]datamodel.IndexOutbox)
+		for _, entry := range entries {
+			if _, ok := entriesByBusinessID[entry.BusinessID]; !ok {
+				businessIDs = append(businessIDs, entry.BusinessID)
+			}
+			entriesByBusinessID[entry.BusinessID] = append(entriesByBusinessID[entry.BusinessID], entry)
+		}
 
-	scardCmd := idx.redis.SCard(ctx, idx.businessIDSetName)
-	if scardCmd.Err() != nil {
-		idx.log.Warn(ctx, L.Messagef("error getting set size of set '%s': %s", idx.businessIDSetName, scardCmd.Err().Error()), L.Phase("bid-update"))
-		return
-	}
+		for _, businessID := range businessIDs {
+			_, err := idx.indexService.IndexLatestItem(ctx, &pb.IndexLatestItemRequest{BusinessId: businessID})
+			if err != nil {
+				idx.log.Warn(ctx, L.Messagef("error indexing item '%s': %s", businessID, err.Error()), L.Phase("bid-update"))
+				for _, entry := range entriesByBusinessID[businessID] {
+					idx.failEntry(ctx, queries, entry, err.Error())
+				}
+				continue
+			}
 
-	if scardCmd.Val() == 0 {
-		return
-	}
+			ids := make([]int64, len(entriesByBusinessID[businessID]))
+			for i, entry := range entriesByBusinessID[businessID] {
+				ids[i] = entry.ID
+			}
+			if _, err := queries.DbDeleteIndexOutboxEntries(ctx, ids); err != nil {
+				// The entries are processed again once their lease expires, which is harmless.
+				idx.log.Warn(ctx, L.Messagef("could not delete index outbox entries of '%s': %s", businessID, err.Error()), L.Phase("bid-update"))
+			}
+		}
 
-	idx.log.Info(ctx, L.Messagef("indexing %d overlooked items", scardCmd.Val()), L.Phase("bid-update"))
+		if len(entries) < idx.batchSize {
+			return
+		}
+	}
+}
 
-	smembersCmd := idx.redis.SMembers(ctx, idx.businessIDSetName)
-	if smembersCmd.Err() != nil {
-		idx.log.Warn(ctx, L.Messagef("error getting set '%s': %s", idx.businessIDSetName, smembersCmd.Err().Error()), L.Phase("bid-update"))
-		return
+func (idx *indexer) failEntry(ctx context.Context, queries *datamodel.Queries, entry datamodel.IndexOutbox, reason string) {
+	var err error
+	if int(entry.Attempts) >= idx.maxAttempts {
+		idx.log.Warn(ctx, L.Messagef("dead-lettering index outbox entry %d (business ID '%s') after %d attempts", entry.ID, entry.BusinessID, entry.Attempts), L.Phase("bid-update"))
+		err = queries.DbDeadLetterIndexOutboxEntry(ctx, datamodel.DbDeadLetterIndexOutboxEntryParams{
+			ID:        entry.ID,
+			LastError: pgtype.Text{String: reason, Valid: true},
+		})
+	} else {
+		err = queries.DbRetryIndexOutboxEntry(ctx, datamodel.DbRetryIndexOutboxEntryParams{
+			ID:            entry.ID,
+			NextAttemptAt: pgtype.Timestamptz{Time: time.Now().Add(retryBackoff(int(entry.Attempts), idx.retryBackoff, idx.maxRetryBackoff)), Valid: true},
+			LastError:     pgtype.Text{String: reason, Valid: true},
+		})
+	}
+	if err != nil {
+		idx.log.Warn(ctx, L.Messagef("could not update index outbox entry %d: %s", entry.ID, err.Error()), L.Phase("bid-update"))
 	}
+}
 
-	for _, businessID := range smembersCmd.Val() {
-		_, err := idx.indexService.IndexLatestItem(ctx, &pb.IndexLatestItemRequest{BusinessId: businessID})
-		if err == nil {
-			sremCmd := idx.redis.SRem(ctx, idx.businessIDSetName, businessID)
-			if sremCmd.Err() != nil {
-				idx.log.Warn(ctx, L.Messagef("error deleting item %s from set '%s': %s", businessID, idx.businessIDSetName, sremCmd.Err().Error()), L.Phase("bid-update"))
-			}
-		} else {
-			idx.log.Warn(ctx, L.Messagef("could not index item %s: %s", businessID, err.Error()), L.Phase("bid-update"))
+// retryBackoff returns the delay before the next attempt, doubling the initial delay with every attempt made so far.
+func retryBackoff(attempts int, initial time.Duration, maxBackoff time.Duration) time.Duration {
+	backoff := initial
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= maxBackoff {
+			return maxBackoff
 		}
 	}
+	if backoff > maxBackoff {
+		return maxBackoff
+	}
+	return backoff
 }
@@ -0,0 +1,158 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/d4l-data4life/mex/mex/shared/db"
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+
+	"github.com/d4l-data4life/mex/mex/services/index/endpoints/index/pb"
+)
+
+func Test_retryBackoff(t *testing.T) {
+	tests := []struct {
+		name     string
+		attempts int
+		want     time.Duration
+	}{
+		{name: "First retry", attempts: 1, want: 10 * time.Second},
+		{name: "Doubled per attempt", attempts: 3, want: 40 * time.Second},
+		{name: "Capped", attempts: 10, want: time.Minute},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := retryBackoff(tt.attempts, 10*time.Second, time.Minute); got != tt.want {
+				t.Errorf("retryBackoff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+type testOutboxEntry struct {
+	businessID   string
+	attempts     int32
+	due          bool
+	deadLettered bool
+}
+
+// testOutbox simulates the index outbox table: entries become due again when the test says so.
+type testOutbox struct {
+	mu      sync.Mutex
+	entries map[int64]*testOutboxEntry
+}
+
+func (outbox *testOutbox) handle(stmt db.MockStatement) db.MockResult {
+	outbox.mu.Lock()
+	defer outbox.mu.Unlock()
+
+	switch stmt.Name {
+	case "DbClaimIndexOutboxEntries":
+		var ids []int64
+		for id, entry := range outbox.entries {
+			if entry.due && !entry.deadLettered {
+				ids = append(ids, id)
+			}
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		if maxEntries := int(stmt.Args[1].(int32)); len(ids) > maxEntries {
+			ids = ids[:maxEntries]
+		}
+		rows := [][]any{}
+		for _, id := range ids {
+			entry := outbox.entries[id]
+			entry.attempts++
+			entry.due = false // leased
+			rows = append(rows, []any{id, entry.businessID, nil, entry.attempts})
+		}
+		return db.MockResult{Rows: rows}
+	case "DbDeleteIndexOutboxEntries":
+		for _, id := range stmt.Args[0].([]int64) {
+			delete(outbox.entries, id)
+		}
+	case "DbDeadLetterIndexOutboxEntry":
+		outbox.entries[stmt.Args[1].(int64)].deadLettered = true
+	}
+	return db.MockResult{}
+}
+
+func (outbox *testOutbox) makeDue() {
+	outbox.mu.Lock()
+	defer outbox.mu.Unlock()
+
+	for _, entry := range outbox.entries {
+		entry.due = true
+	}
+}
+
+// testIndexService fails for the business IDs given, for the given number of times (-1: always).
+type testIndexService struct {
+	failures map[string]int
+	indexed  []string
+}
+
+func (svc *testIndexService) IndexLatestItem(_ context.Context, request *pb.IndexLatestItemRequest) (*pb.IndexLatestItemResponse, error) {
+	if svc.failures[request.BusinessId] != 0 {
+		svc.failures[request.BusinessId]--
+		return nil, fmt.Errorf("solr unavailable")
+	}
+	svc.indexed = append(svc.indexed, request.BusinessId)
+	return &pb.IndexLatestItemResponse{}, nil
+}
+
+func Test_indexer_processOutbox(t *testing.T) {
+	outbox := &testOutbox{entries: map[int64]*testOutboxEntry{
+		1: {businessID: "a", due: true},
+		2: {businessID: "a", due: true},
+		3: {businessID: "b", due: true},
+		4: {businessID: "c", due: true},
+		5: {businessID: "d", due: true},
+	}}
+	indexService := &testIndexService{failures: map[string]int{"b": -1, "c": 1}}
+	tx := db.NewMockTx(outbox.handle)
+
+	idx := &indexer{
+		log:             &L.NullLogger{},
+		db:              tx,
+		indexService:    indexService,
+		batchSize:       2,
+		maxAttempts:     2,
+		retryBackoff:    time.Second,
+		maxRetryBackoff: time.Minute,
+		lease:           time.Minute,
+	}
+
+	// First run: all batches are picked up; the entries of "a" are indexed once, "b" and "c" fail and are retried.
+	idx.processOutbox(context.Background())
+	if want := []string{"a", "d"}; !reflect.DeepEqual(indexService.indexed, want) {
+		t.Errorf("first run indexed %v, want %v", indexService.indexed, want)
+	}
+	if got := len(tx.Executed("DbRetryIndexOutboxEntry")); got != 2 {
+		t.Errorf("first run retried %d entries, want 2", got)
+	}
+	if got := len(outbox.entries); got != 2 {
+		t.Errorf("%d entries left after the first run, want 2", got)
+	}
+
+	// Second run, once the backoff expired: "c" succeeds and is marked done, "b" exceeds the attempts and is dead-lettered.
+	outbox.makeDue()
+	idx.processOutbox(context.Background())
+	if want := []string{"a", "d", "c"}; !reflect.DeepEqual(indexService.indexed, want) {
+		t.Errorf("second run indexed %v, want %v", indexService.indexed, want)
+	}
+	if len(outbox.entries) != 1 || !outbox.entries[3].deadLettered {
+		t.Errorf("only the entry of 'b' should be left, dead-lettered: %v", outbox.entries)
+	}
+
+	// Dead-lettered entries are not picked up again.
+	outbox.makeDue()
+	idx.processOutbox(context.Background())
+	if len(indexService.indexed) != 3 {
+		t.Errorf("dead-lettered entry processed again: %v", indexService.indexed)
+	}
+}
@@ -8,12 +8,48 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type ApiKey struct {
+	ID         string
+	Name       string
+	KeyPrefix  string
+	KeyHash    string
+	Scopes     []string
+	CreatedBy  string
+	CreatedAt  pgtype.Timestamptz
+	ExpiresAt  pgtype.Timestamptz
+	LastUsedAt pgtype.Timestamptz
+	RevokedAt  pgtype.Timestamptz
+	RotatedTo  pgtype.Text
+}
+
+type AuditLog struct {
+	ID        int64
+	CreatedAt pgtype.Timestamptz
+	Service   string
+	Method    string
+	TenantID  string
+	Subject   string
+	AppID     string
+	Roles     []string
+	Resource  string
+	Verb      string
+	TargetIds []string
+	Outcome   string
+	Message   pgtype.Text
+}
+
 type BlobStore struct {
 	BlobName string
 	BlobType string
 	BlobOid  pgtype.Uint32
 }
 
+type BusinessIDDeletion struct {
+	BusinessID string
+	EntityName string
+	DeletedAt  pgtype.Timestamptz
+}
+
 type CurrentItemValue struct {
 	ID         string
 	ItemID     string
@@ -24,6 +60,26 @@ type CurrentItemValue struct {
 	Language   pgtype.Text
 }
 
+type IndexChange struct {
+	BusinessID string
+	ChangedXid interface{}
+}
+
+type IndexOutbox struct {
+	ID             int64
+	BusinessID     string
+	CreatedAt      pgtype.Timestamptz
+	Attempts       int32
+	NextAttemptAt  pgtype.Timestamptz
+	LastError      pgtype.Text
+	DeadLetteredAt pgtype.Timestamptz
+}
+
+type IndexWatermark struct {
+	Collection      string
+	IndexedUntilXid int64
+}
+
 type Item struct {
 	CreatedAt           pgtype.Timestamptz
 	ID                  string
@@ -34,6 +90,11 @@ type Item struct {
 	Hash                pgtype.Text
 }
 
+type ItemOwnerGroup struct {
+	ItemID  string
+	GroupID string
+}
+
 type ItemValue struct {
 	CreatedAt       pgtype.Timestamptz
 	ID              string
@@ -45,6 +106,7 @@ type ItemValue struct {
 	Place           int32
 	ItemID          string
 	RevisionComment pgtype.Text
+	Author          pgtype.Text
 }
 
 type ItemViewCount struct {
@@ -81,6 +143,14 @@ type LatestItemsWithBusinessID struct {
 	BusinessIDFieldName pgtype.Text
 }
 
+type OaiRecord struct {
+	BusinessID string
+	EntityName string
+	ItemID     pgtype.Text
+	Datestamp  pgtype.Timestamptz
+	Deleted    bool
+}
+
 type Relation struct {
 	CreatedAt    pgtype.Timestamptz
 	ID           string
@@ -91,3 +161,20 @@ type Relation struct {
 	TargetItemID string
 	InfoItemID   pgtype.Text
 }
+
+type SavedSearch struct {
+	ID                   string
+	Owner                string
+	Name                 string
+	Request              []byte
+	Identity             []byte
+	NotificationEmail    string
+	NotificationsEnabled bool
+	EvaluatedUntilXid    int64
+	NumMatched           int32
+	Version              int32
+	CreatedAt            pgtype.Timestamptz
+	UpdatedAt            pgtype.Timestamptz
+	LastEvaluatedAt      pgtype.Timestamptz
+	LastNotifiedAt       pgtype.Timestamptz
+}
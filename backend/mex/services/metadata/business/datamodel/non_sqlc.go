@@ -217,3 +217,40 @@ func (q *Queries) DbSimpleAggregationByBusinessID(ctx context.Context, linkField
 	}
 	return items, nil
 }
+
+const dbListSubtreeNodes = `
+with recursive
+edges as (
+	select td.node_id, td.parent_node_id from f_tree_descendants($1, $2) td
+),
+subtree(node_id) as (
+	select unnest($3::TEXT[])
+		union
+	select e.node_id
+	from edges e
+	inner join subtree s
+		on e.parent_node_id = s.node_id
+)
+select node_id from subtree
+`
+
+// DbListSubtreeNodes returns the given nodes of a tree together with all their descendants.
+func (q *Queries) DbListSubtreeNodes(ctx context.Context, nodeEntityType string, linkFieldName string, rootNodeIDs []string) ([]string, error) {
+	rows, err := q.db.Query(ctx, dbListSubtreeNodes, nodeEntityType, linkFieldName, rootNodeIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var nodeID string
+		if err := rows.Scan(&nodeID); err != nil {
+			return nil, err
+		}
+		items = append(items, nodeID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
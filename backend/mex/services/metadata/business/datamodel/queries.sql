@@ -10,23 +10,61 @@ SELECT * FROM current_item_values
 WHERE item_id = $1
 ORDER BY field_name ASC, place ASC;
 
+-- name: DbGetItemValueHistory :many
+SELECT * FROM item_values
+WHERE item_id = $1
+ORDER BY revision ASC;
+
 -- name: DbListItemValues :many
 SELECT * FROM current_item_values
 ORDER BY item_id ASC, field_name ASC, place ASC;
 
+-- name: DbListItemValuesOfItems :many
+SELECT * FROM current_item_values
+WHERE item_id = ANY(@item_ids::text[])
+ORDER BY item_id ASC, field_name ASC, place ASC;
+
 -- name: DbListItems :many
 SELECT * FROM items_nullable_business_id
-WHERE item_id >= $1 ORDER BY item_id ASC LIMIT $2;
+WHERE item_id >= @item_id AND f_item_visible(item_id, entity_name, @visibility::jsonb)
+ORDER BY item_id ASC LIMIT sqlc.arg('limit');
 
 -- name: DbListItemsOfType :many
 SELECT * FROM items_nullable_business_id
-WHERE item_id >= $1 AND entity_name = $2 ORDER BY item_id ASC LIMIT $3;
+WHERE item_id >= @item_id AND entity_name = @entity_name AND f_item_visible(item_id, entity_name, @visibility::jsonb)
+ORDER BY item_id ASC LIMIT sqlc.arg('limit');
+
+-- name: DbListLatestItems :many
+SELECT * FROM items_nullable_business_id i
+WHERE i.item_id >= @item_id AND NOT EXISTS (
+    SELECT 1 FROM items n
+    WHERE n.business_id = i.business_id AND n.entity_name = i.entity_name AND n.created_at > i.created_at
+) AND f_item_visible(i.item_id, i.entity_name, @visibility::jsonb)
+ORDER BY i.item_id ASC LIMIT sqlc.arg('limit');
+
+-- name: DbListLatestItemsOfType :many
+SELECT * FROM items_nullable_business_id i
+WHERE i.item_id >= @item_id AND i.entity_name = @entity_name AND NOT EXISTS (
+    SELECT 1 FROM items n
+    WHERE n.business_id = i.business_id AND n.entity_name = i.entity_name AND n.created_at > i.created_at
+) AND f_item_visible(i.item_id, i.entity_name, @visibility::jsonb)
+ORDER BY i.item_id ASC LIMIT sqlc.arg('limit');
+
+-- Items among the given ones which are visible under the given visibility rules (see f_item_visible).
+-- name: DbListVisibleItemIDs :many
+SELECT id FROM items
+WHERE id = ANY(@item_ids::text[]) AND f_item_visible(id, entity_name, @visibility::jsonb);
 
 -- name: DbCreateItem :one
 INSERT INTO items (created_at, id, owner, entity_name, business_id_field_name, business_id, hash)
 VALUES ($1, $2, $3, $4, $5, $6, $7)
 RETURNING *;
 
+-- name: DbGetItemForUpdate :one
+SELECT * FROM items
+WHERE id = $1
+FOR UPDATE;
+
 -- name: DbDeleteItem :exec
 DELETE FROM items where id = $1;
 
@@ -36,11 +74,38 @@ DELETE FROM items where id = ANY(@item_ids::text[]);
 -- name: DbDeleteAllItems :exec
 DELETE FROM items;
 
+-- name: DbAddItemOwnerGroups :exec
+INSERT INTO item_owner_groups (item_id, group_id)
+SELECT @item_id::text, unnest(@group_ids::text[])
+ON CONFLICT DO NOTHING;
+
+-- Items among the given ones which are neither owned by the user nor shared with one of the given groups.
+-- name: DbListForeignItemIDs :many
+SELECT i.id FROM items i
+WHERE i.id = ANY(@item_ids::text[]) AND i.owner <> @owner::text AND NOT EXISTS (
+    SELECT 1 FROM item_owner_groups iog
+    WHERE iog.item_id = i.id AND iog.group_id = ANY(@group_ids::text[])
+)
+ORDER BY i.id ASC;
+
 -- name: DbCreateItemValue :one
-INSERT INTO item_values (created_at, id, revision, deleted, field_name, field_value, language, place, item_id)
-VALUES ($1, $2, DEFAULT, $3, $4, $5, $6, $7, $8)
+INSERT INTO item_values (created_at, id, revision, deleted, field_name, field_value, language, place, item_id, author)
+VALUES ($1, $2, DEFAULT, $3, $4, $5, $6, $7, $8, $9)
 RETURNING *;
 
+-- name: DbCreateItemValueRevision :one
+INSERT INTO item_values (created_at, id, revision, deleted, field_name, field_value, language, place, item_id, revision_comment, author)
+VALUES ($1, $2, DEFAULT, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING *;
+
+-- name: DbRetireItemValue :execrows
+UPDATE item_values SET deleted = true
+WHERE id = $1 AND deleted = false;
+
+-- name: DbUpdateItemHash :exec
+UPDATE items SET hash = $1
+WHERE id = $2;
+
 -- Perform an UPSERT:
 -- - Either INSERT a new row with initial count 1, or, if already present
 -- - UPDATE the row by incrementing the count
@@ -65,7 +130,14 @@ SELECT target_item_id FROM relations
 WHERE source_item_id = $1 and type = $2;
 
 -- name: DbListRelations :many
-SELECT * FROM relations;
+SELECT * FROM relations r
+WHERE EXISTS (SELECT 1 FROM items s WHERE s.id = r.source_item_id AND f_item_visible(s.id, s.entity_name, @visibility::jsonb))
+  AND EXISTS (SELECT 1 FROM items t WHERE t.id = r.target_item_id AND f_item_visible(t.id, t.entity_name, @visibility::jsonb));
+
+-- name: DbListRelationsForSource :many
+SELECT * FROM relations
+WHERE source_item_id = $1 AND deleted = false
+ORDER BY type ASC, target_item_id ASC;
 
 -- name: DbCreateRelationsFromBusinessIDs :execrows
 INSERT INTO "relations" ("created_at", "id", "deleted", "owner", "type", "source_item_id", "target_item_id", "info_item_id")
@@ -108,7 +180,7 @@ from (select distinct row_number()
                       x.partition_business_id                                                          as partition_business_id
       from (select i.id as candidate_id, i.created_at as candidate_created_at, iv.field_value as partition_business_id
             from items i
-                     join item_values iv
+                     join current_item_values iv
                           on i.id = iv.item_id and iv.field_name = sqlc.arg(partition_field)
             where i.business_id = sqlc.arg(business_id)) x) y
          join current_item_values civ
@@ -117,7 +189,7 @@ where y.date_rank = 1
 order by civ.field_name asc, civ.item_id asc, y.partition_business_id asc, civ.place asc, civ.field_value asc;
 
 -- name: DbListItemsWithBusinessId :many
-select * from items_with_business_id order by business_id, created_at asc;
+select * from items_with_business_id where f_item_visible(item_id, entity_name, @visibility::jsonb) order by business_id, created_at asc;
 
 -- name: DbListItemsForBusinessId :many
 select * from items_with_business_id where business_id = $1 order by created_at asc;
@@ -143,6 +215,12 @@ inner join latest_items_with_business_id liwbi
 	on i.id =liwbi.item_id
 where liwbi.business_id = $1;
 
+-- name: DbResolveBusinessIDs :many
+select liwbi.business_id, liwbi.item_id
+from latest_items_with_business_id liwbi
+where liwbi.business_id = ANY(@business_ids::text[])
+order by liwbi.business_id asc, liwbi.item_id asc;
+
 -- name: DbImputeBusinessId :execrows
 update items
 set business_id = val.business_id
@@ -170,3 +248,207 @@ SELECT c.hash FROM (
     )
  ) c
 WHERE c.date_rank = 1 and c.hash = ANY (@hashes::text[]);
+
+-- name: DbOaiListRecords :many
+SELECT * FROM oai_records
+WHERE entity_name = ANY(@entity_names::text[])
+  AND (entity_name, business_id) > (@after_entity_name::text, @after_business_id::text)
+  AND (sqlc.narg(from_time)::timestamptz IS NULL OR datestamp >= sqlc.narg(from_time))
+  AND (sqlc.narg(until_time)::timestamptz IS NULL OR datestamp < sqlc.narg(until_time))
+  AND f_item_visible(item_id, entity_name, @visibility::jsonb)
+ORDER BY entity_name ASC, business_id ASC
+LIMIT @max_records;
+
+-- name: DbOaiGetRecord :one
+SELECT * FROM oai_records
+WHERE entity_name = @entity_name AND business_id = @business_id AND f_item_visible(item_id, entity_name, @visibility::jsonb);
+
+-- name: DbOaiEarliestDatestamp :one
+SELECT min(datestamp)::timestamptz AS earliest FROM oai_records
+WHERE entity_name = ANY(@entity_names::text[]) AND f_item_visible(item_id, entity_name, @visibility::jsonb);
+
+-- name: DbEnqueueIndexOutboxEntry :exec
+INSERT INTO index_outbox (business_id) VALUES ($1);
+
+-- name: DbClaimIndexOutboxEntries :many
+UPDATE index_outbox SET attempts = attempts + 1, next_attempt_at = @lease_until
+WHERE id IN (
+    SELECT o.id FROM index_outbox o
+    WHERE o.dead_lettered_at IS NULL AND o.next_attempt_at <= NOW()
+    ORDER BY o.id ASC
+    LIMIT @max_entries
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: DbDeleteIndexOutboxEntries :execrows
+DELETE FROM index_outbox WHERE id = ANY(@ids::bigint[]);
+
+-- name: DbRetryIndexOutboxEntry :exec
+UPDATE index_outbox SET next_attempt_at = @next_attempt_at, last_error = @last_error
+WHERE id = @id;
+
+-- name: DbDeadLetterIndexOutboxEntry :exec
+UPDATE index_outbox SET dead_lettered_at = NOW(), last_error = @last_error
+WHERE id = @id;
+
+-- name: DbListDeadLetteredIndexOutboxEntries :many
+SELECT * FROM index_outbox
+WHERE dead_lettered_at IS NOT NULL
+ORDER BY id ASC;
+
+-- name: DbRequeueDeadLetteredIndexOutboxEntries :execrows
+UPDATE index_outbox SET attempts = 0, next_attempt_at = NOW(), dead_lettered_at = NULL
+WHERE dead_lettered_at IS NOT NULL AND (cardinality(@ids::bigint[]) = 0 OR id = ANY(@ids::bigint[]));
+
+-- name: DbGetIndexWatermark :one
+SELECT indexed_until_xid FROM index_watermarks
+WHERE collection = $1;
+
+-- name: DbSetIndexWatermark :exec
+INSERT INTO index_watermarks (collection, indexed_until_xid) VALUES ($1, $2)
+ON CONFLICT (collection) DO UPDATE SET indexed_until_xid = EXCLUDED.indexed_until_xid;
+
+-- name: DbDeleteIndexWatermark :exec
+DELETE FROM index_watermarks
+WHERE collection = $1;
+
+-- The next watermark is the lowest transaction still running: the changes of all transactions from there on may not be
+-- visible yet to the index update which is about to start.
+-- name: DbNextIndexWatermark :one
+SELECT pg_snapshot_xmin(pg_current_snapshot())::text::bigint AS next_watermark;
+
+-- Business IDs changed by transactions from the given watermark onwards, plus the business IDs of the latest items
+-- linking to any of them (via the given link fields), recursively, since the documents of linking items contain values
+-- of the linked items. UNION stops the recursion at business IDs already found, so link cycles are fine.
+-- name: DbListChangedBusinessIDs :many
+WITH RECURSIVE changed(business_id) AS (
+    SELECT ic.business_id FROM index_changes ic
+    WHERE ic.changed_xid >= @since::bigint::text::xid8
+    UNION
+    SELECT liwbi.business_id FROM changed c
+    JOIN current_item_values civ ON civ.field_value = c.business_id
+    JOIN latest_items_with_business_id liwbi ON liwbi.item_id = civ.item_id
+    WHERE civ.field_name = ANY(@link_field_names::text[])
+)
+SELECT c.business_id::text AS business_id FROM changed c
+ORDER BY business_id ASC;
+
+-- IDs of the latest items of the business IDs changed by transactions from the first watermark up to (excluding) the
+-- second one, i.e. of the items added or changed between two index updates.
+-- name: DbListChangedItemIDs :many
+SELECT liwbi.item_id FROM index_changes ic
+JOIN latest_items_with_business_id liwbi ON liwbi.business_id = ic.business_id
+WHERE ic.changed_xid >= @since::bigint::text::xid8 AND ic.changed_xid < @until::bigint::text::xid8
+ORDER BY liwbi.item_id ASC;
+
+-- Counts of the latest items of the given entity types whose business IDs have not been changed by transactions from
+-- the given watermark onwards, and of the business IDs which have been changed (of any type, including deleted ones).
+-- An index loaded as of the watermark holds at least the former and at most both together.
+-- name: DbCountIndexableItems :one
+SELECT
+    (SELECT count(*) FROM latest_items_with_business_id liwbi
+     WHERE liwbi.entity_name = ANY(@entity_names::text[])
+       AND NOT EXISTS (
+           SELECT 1 FROM index_changes ic
+           WHERE ic.business_id = liwbi.business_id AND ic.changed_xid >= @since::bigint::text::xid8
+       )) AS unchanged,
+    (SELECT count(*) FROM index_changes ic
+     WHERE ic.changed_xid >= @since::bigint::text::xid8) AS changed;
+
+-- name: DbCreateApiKey :one
+INSERT INTO api_keys (id, name, key_prefix, key_hash, scopes, created_by, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: DbGetApiKey :one
+SELECT * FROM api_keys
+WHERE id = $1;
+
+-- name: DbGetApiKeyForUpdate :one
+SELECT * FROM api_keys
+WHERE id = $1
+FOR UPDATE;
+
+-- name: DbGetApiKeyByHash :one
+SELECT * FROM api_keys
+WHERE key_hash = $1;
+
+-- All API keys, or those created by the given user.
+-- name: DbListApiKeys :many
+SELECT * FROM api_keys
+WHERE sqlc.narg(created_by)::text IS NULL OR created_by = sqlc.narg(created_by)
+ORDER BY created_at ASC, id ASC;
+
+-- name: DbRevokeApiKey :exec
+UPDATE api_keys SET revoked_at = NOW()
+WHERE id = $1 AND revoked_at IS NULL;
+
+-- name: DbRotateApiKey :exec
+UPDATE api_keys SET rotated_to = $2, expires_at = $3
+WHERE id = $1;
+
+-- name: DbTouchApiKey :exec
+UPDATE api_keys SET last_used_at = NOW()
+WHERE id = $1;
+
+-- name: DbCreateAuditEntry :exec
+INSERT INTO audit_log (created_at, service, method, tenant_id, subject, app_id, roles, resource, verb, target_ids, outcome, message)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12);
+
+-- Audit entries recorded after the given one, optionally restricted to a subject, a resource, and a time range.
+-- name: DbListAuditEntries :many
+SELECT * FROM audit_log
+WHERE id > @after_id
+  AND (sqlc.narg(subject)::text IS NULL OR subject = sqlc.narg(subject))
+  AND (sqlc.narg(resource)::text IS NULL OR resource = sqlc.narg(resource))
+  AND (sqlc.narg(created_from)::timestamptz IS NULL OR created_at >= sqlc.narg(created_from))
+  AND (sqlc.narg(created_to)::timestamptz IS NULL OR created_at < sqlc.narg(created_to))
+ORDER BY id ASC
+LIMIT @max_entries;
+
+-- name: DbCountSavedSearches :one
+SELECT COUNT(*) FROM saved_searches
+WHERE owner = $1;
+
+-- name: DbCreateSavedSearch :one
+INSERT INTO saved_searches (id, owner, name, request, identity, notification_email, notifications_enabled, evaluated_until_xid, num_matched, last_evaluated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW())
+RETURNING *;
+
+-- name: DbDeleteSavedSearch :execrows
+DELETE FROM saved_searches
+WHERE id = $1 AND owner = $2;
+
+-- name: DbGetSavedSearch :one
+SELECT * FROM saved_searches
+WHERE id = $1 AND owner = $2;
+
+-- name: DbListSavedSearches :many
+SELECT * FROM saved_searches
+WHERE owner = $1
+ORDER BY created_at ASC, id ASC;
+
+-- The saved searches of all owners whose notifications are enabled.
+-- name: DbListSubscribedSavedSearches :many
+SELECT * FROM saved_searches
+WHERE notifications_enabled
+ORDER BY created_at ASC, id ASC;
+
+-- Records an evaluation unless the saved search has been changed since it was read.
+-- name: DbRecordSavedSearchEvaluation :execrows
+UPDATE saved_searches SET evaluated_until_xid = @evaluated_until_xid, num_matched = @num_matched, last_evaluated_at = NOW(),
+    last_notified_at = CASE WHEN @notified::boolean THEN NOW() ELSE last_notified_at END, version = version + 1
+WHERE id = @id AND version = @version;
+
+-- name: DbUnsubscribeSavedSearch :one
+UPDATE saved_searches SET notifications_enabled = FALSE, version = version + 1, updated_at = NOW()
+WHERE id = $1 AND owner = $2
+RETURNING *;
+
+-- Updates the saved search unless it has been changed since it was read.
+-- name: DbUpdateSavedSearch :one
+UPDATE saved_searches SET name = $3, request = $4, identity = $5, notification_email = $6, notifications_enabled = $7,
+    evaluated_until_xid = $8, num_matched = $9, last_evaluated_at = $10, version = version + 1, updated_at = NOW()
+WHERE id = $1 AND version = $2
+RETURNING *;
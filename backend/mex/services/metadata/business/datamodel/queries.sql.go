@@ -11,6 +11,22 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const dbAddItemOwnerGroups = `-- name: DbAddItemOwnerGroups :exec
+INSERT INTO item_owner_groups (item_id, group_id)
+SELECT $1::text, unnest($2::text[])
+ON CONFLICT DO NOTHING
+`
+
+type DbAddItemOwnerGroupsParams struct {
+	ItemID   string
+	GroupIds []string
+}
+
+func (q *Queries) DbAddItemOwnerGroups(ctx context.Context, arg DbAddItemOwnerGroupsParams) error {
+	_, err := q.db.Exec(ctx, dbAddItemOwnerGroups, arg.ItemID, arg.GroupIds)
+	return err
+}
+
 const dbAggregationCandidateValues = `-- name: DbAggregationCandidateValues :many
 select civ.field_name, y.partition_business_id, civ.place, civ.field_value, civ.language, civ.item_id
 from (select distinct row_number()
@@ -19,7 +35,7 @@ from (select distinct row_number()
                       x.partition_business_id                                                          as partition_business_id
       from (select i.id as candidate_id, i.created_at as candidate_created_at, iv.field_value as partition_business_id
             from items i
-                     join item_values iv
+                     join current_item_values iv
                           on i.id = iv.item_id and iv.field_name = $1
             where i.business_id = $2) x) y
          join current_item_values civ
@@ -70,6 +86,51 @@ func (q *Queries) DbAggregationCandidateValues(ctx context.Context, arg DbAggreg
 	return items, nil
 }
 
+const dbClaimIndexOutboxEntries = `-- name: DbClaimIndexOutboxEntries :many
+UPDATE index_outbox SET attempts = attempts + 1, next_attempt_at = $1
+WHERE id IN (
+    SELECT o.id FROM index_outbox o
+    WHERE o.dead_lettered_at IS NULL AND o.next_attempt_at <= NOW()
+    ORDER BY o.id ASC
+    LIMIT $2
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, business_id, created_at, attempts, next_attempt_at, last_error, dead_lettered_at
+`
+
+type DbClaimIndexOutboxEntriesParams struct {
+	LeaseUntil pgtype.Timestamptz
+	MaxEntries int32
+}
+
+func (q *Queries) DbClaimIndexOutboxEntries(ctx context.Context, arg DbClaimIndexOutboxEntriesParams) ([]IndexOutbox, error) {
+	rows, err := q.db.Query(ctx, dbClaimIndexOutboxEntries, arg.LeaseUntil, arg.MaxEntries)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []IndexOutbox
+	for rows.Next() {
+		var i IndexOutbox
+		if err := rows.Scan(
+			&i.ID,
+			&i.BusinessID,
+			&i.CreatedAt,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.LastError,
+			&i.DeadLetteredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const dbComputeVersions = `-- name: DbComputeVersions :many
 select y.item_id, y.created_at, y.version from (
 	select x.item_id, x.created_at, x.owner, x.entity_name, x.business_id, x.business_id_field_name, row_number() over () as version
@@ -111,6 +172,131 @@ func (q *Queries) DbComputeVersions(ctx context.Context, itemID string) ([]DbCom
 	return items, nil
 }
 
+const dbCountIndexableItems = `-- name: DbCountIndexableItems :one
+SELECT
+    (SELECT count(*) FROM latest_items_with_business_id liwbi
+     WHERE liwbi.entity_name = ANY($1::text[])
+       AND NOT EXISTS (
+           SELECT 1 FROM index_changes ic
+           WHERE ic.business_id = liwbi.business_id AND ic.changed_xid >= $2::bigint::text::xid8
+       )) AS unchanged,
+    (SELECT count(*) FROM index_changes ic
+     WHERE ic.changed_xid >= $2::bigint::text::xid8) AS changed
+`
+
+type DbCountIndexableItemsParams struct {
+	EntityNames []string
+	Since       int64
+}
+
+type DbCountIndexableItemsRow struct {
+	Unchanged int64
+	Changed   int64
+}
+
+// Counts of the latest items of the given entity types whose business IDs have not been changed by transactions from
+// the given watermark onwards, and of the business IDs which have been changed (of any type, including deleted ones).
+// An index loaded as of the watermark holds at least the former and at most both together.
+func (q *Queries) DbCountIndexableItems(ctx context.Context, arg DbCountIndexableItemsParams) (DbCountIndexableItemsRow, error) {
+	row := q.db.QueryRow(ctx, dbCountIndexableItems, arg.EntityNames, arg.Since)
+	var i DbCountIndexableItemsRow
+	err := row.Scan(&i.Unchanged, &i.Changed)
+	return i, err
+}
+
+const dbCountSavedSearches = `-- name: DbCountSavedSearches :one
+SELECT COUNT(*) FROM saved_searches
+WHERE owner = $1
+`
+
+func (q *Queries) DbCountSavedSearches(ctx context.Context, owner string) (int64, error) {
+	row := q.db.QueryRow(ctx, dbCountSavedSearches, owner)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const dbCreateApiKey = `-- name: DbCreateApiKey :one
+INSERT INTO api_keys (id, name, key_prefix, key_hash, scopes, created_by, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, name, key_prefix, key_hash, scopes, created_by, created_at, expires_at, last_used_at, revoked_at, rotated_to
+`
+
+type DbCreateApiKeyParams struct {
+	ID        string
+	Name      string
+	KeyPrefix string
+	KeyHash   string
+	Scopes    []string
+	CreatedBy string
+	ExpiresAt pgtype.Timestamptz
+}
+
+func (q *Queries) DbCreateApiKey(ctx context.Context, arg DbCreateApiKeyParams) (ApiKey, error) {
+	row := q.db.QueryRow(ctx, dbCreateApiKey,
+		arg.ID,
+		arg.Name,
+		arg.KeyPrefix,
+		arg.KeyHash,
+		arg.Scopes,
+		arg.CreatedBy,
+		arg.ExpiresAt,
+	)
+	var i ApiKey
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.KeyPrefix,
+		&i.KeyHash,
+		&i.Scopes,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.RevokedAt,
+		&i.RotatedTo,
+	)
+	return i, err
+}
+
+const dbCreateAuditEntry = `-- name: DbCreateAuditEntry :exec
+INSERT INTO audit_log (created_at, service, method, tenant_id, subject, app_id, roles, resource, verb, target_ids, outcome, message)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+`
+
+type DbCreateAuditEntryParams struct {
+	CreatedAt pgtype.Timestamptz
+	Service   string
+	Method    string
+	TenantID  string
+	Subject   string
+	AppID     string
+	Roles     []string
+	Resource  string
+	Verb      string
+	TargetIds []string
+	Outcome   string
+	Message   pgtype.Text
+}
+
+func (q *Queries) DbCreateAuditEntry(ctx context.Context, arg DbCreateAuditEntryParams) error {
+	_, err := q.db.Exec(ctx, dbCreateAuditEntry,
+		arg.CreatedAt,
+		arg.Service,
+		arg.Method,
+		arg.TenantID,
+		arg.Subject,
+		arg.AppID,
+		arg.Roles,
+		arg.Resource,
+		arg.Verb,
+		arg.TargetIds,
+		arg.Outcome,
+		arg.Message,
+	)
+	return err
+}
+
 const dbCreateItem = `-- name: DbCreateItem :one
 INSERT INTO items (created_at, id, owner, entity_name, business_id_field_name, business_id, hash)
 VALUES ($1, $2, $3, $4, $5, $6, $7)
@@ -151,9 +337,9 @@ func (q *Queries) DbCreateItem(ctx context.Context, arg DbCreateItemParams) (Ite
 }
 
 const dbCreateItemValue = `-- name: DbCreateItemValue :one
-INSERT INTO item_values (created_at, id, revision, deleted, field_name, field_value, language, place, item_id)
-VALUES ($1, $2, DEFAULT, $3, $4, $5, $6, $7, $8)
-RETURNING created_at, id, revision, deleted, language, field_name, field_value, place, item_id, revision_comment
+INSERT INTO item_values (created_at, id, revision, deleted, field_name, field_value, language, place, item_id, author)
+VALUES ($1, $2, DEFAULT, $3, $4, $5, $6, $7, $8, $9)
+RETURNING created_at, id, revision, deleted, language, field_name, field_value, place, item_id, revision_comment, author
 `
 
 type DbCreateItemValueParams struct {
@@ -165,6 +351,7 @@ type DbCreateItemValueParams struct {
 	Language   pgtype.Text
 	Place      int32
 	ItemID     string
+	Author     pgtype.Text
 }
 
 func (q *Queries) DbCreateItemValue(ctx context.Context, arg DbCreateItemValueParams) (ItemValue, error) {
@@ -177,6 +364,56 @@ func (q *Queries) DbCreateItemValue(ctx context.Context, arg DbCreateItemValuePa
 		arg.Language,
 		arg.Place,
 		arg.ItemID,
+		arg.Author,
+	)
+	var i ItemValue
+	err := row.Scan(
+		&i.CreatedAt,
+		&i.ID,
+		&i.Revision,
+		&i.Deleted,
+		&i.Language,
+		&i.FieldName,
+		&i.FieldValue,
+		&i.Place,
+		&i.ItemID,
+		&i.RevisionComment,
+		&i.Author,
+	)
+	return i, err
+}
+
+const dbCreateItemValueRevision = `-- name: DbCreateItemValueRevision :one
+INSERT INTO item_values (created_at, id, revision, deleted, field_name, field_value, language, place, item_id, revision_comment, author)
+VALUES ($1, $2, DEFAULT, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING created_at, id, revision, deleted, language, field_name, field_value, place, item_id, revision_comment, author
+`
+
+type DbCreateItemValueRevisionParams struct {
+	CreatedAt       pgtype.Timestamptz
+	ID              string
+	Deleted         bool
+	FieldName       string
+	FieldValue      string
+	Language        pgtype.Text
+	Place           int32
+	ItemID          string
+	RevisionComment pgtype.Text
+	Author          pgtype.Text
+}
+
+func (q *Queries) DbCreateItemValueRevision(ctx context.Context, arg DbCreateItemValueRevisionParams) (ItemValue, error) {
+	row := q.db.QueryRow(ctx, dbCreateItemValueRevision,
+		arg.CreatedAt,
+		arg.ID,
+		arg.Deleted,
+		arg.FieldName,
+		arg.FieldValue,
+		arg.Language,
+		arg.Place,
+		arg.ItemID,
+		arg.RevisionComment,
+		arg.Author,
 	)
 	var i ItemValue
 	err := row.Scan(
@@ -190,6 +427,7 @@ func (q *Queries) DbCreateItemValue(ctx context.Context, arg DbCreateItemValuePa
 		&i.Place,
 		&i.ItemID,
 		&i.RevisionComment,
+		&i.Author,
 	)
 	return i, err
 }
@@ -276,6 +514,71 @@ func (q *Queries) DbCreateRelationsFromBusinessIDs(ctx context.Context, arg DbCr
 	return result.RowsAffected(), nil
 }
 
+const dbCreateSavedSearch = `-- name: DbCreateSavedSearch :one
+INSERT INTO saved_searches (id, owner, name, request, identity, notification_email, notifications_enabled, evaluated_until_xid, num_matched, last_evaluated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW())
+RETURNING id, owner, name, request, identity, notification_email, notifications_enabled, evaluated_until_xid, num_matched, version, created_at, updated_at, last_evaluated_at, last_notified_at
+`
+
+type DbCreateSavedSearchParams struct {
+	ID                   string
+	Owner                string
+	Name                 string
+	Request              []byte
+	Identity             []byte
+	NotificationEmail    string
+	NotificationsEnabled bool
+	EvaluatedUntilXid    int64
+	NumMatched           int32
+}
+
+func (q *Queries) DbCreateSavedSearch(ctx context.Context, arg DbCreateSavedSearchParams) (SavedSearch, error) {
+	row := q.db.QueryRow(ctx, dbCreateSavedSearch,
+		arg.ID,
+		arg.Owner,
+		arg.Name,
+		arg.Request,
+		arg.Identity,
+		arg.NotificationEmail,
+		arg.NotificationsEnabled,
+		arg.EvaluatedUntilXid,
+		arg.NumMatched,
+	)
+	var i SavedSearch
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Name,
+		&i.Request,
+		&i.Identity,
+		&i.NotificationEmail,
+		&i.NotificationsEnabled,
+		&i.EvaluatedUntilXid,
+		&i.NumMatched,
+		&i.Version,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LastEvaluatedAt,
+		&i.LastNotifiedAt,
+	)
+	return i, err
+}
+
+const dbDeadLetterIndexOutboxEntry = `-- name: DbDeadLetterIndexOutboxEntry :exec
+UPDATE index_outbox SET dead_lettered_at = NOW(), last_error = $1
+WHERE id = $2
+`
+
+type DbDeadLetterIndexOutboxEntryParams struct {
+	LastError pgtype.Text
+	ID        int64
+}
+
+func (q *Queries) DbDeadLetterIndexOutboxEntry(ctx context.Context, arg DbDeadLetterIndexOutboxEntryParams) error {
+	_, err := q.db.Exec(ctx, dbDeadLetterIndexOutboxEntry, arg.LastError, arg.ID)
+	return err
+}
+
 const dbDeleteAllItems = `-- name: DbDeleteAllItems :exec
 DELETE FROM items
 `
@@ -285,6 +588,28 @@ func (q *Queries) DbDeleteAllItems(ctx context.Context) error {
 	return err
 }
 
+const dbDeleteIndexOutboxEntries = `-- name: DbDeleteIndexOutboxEntries :execrows
+DELETE FROM index_outbox WHERE id = ANY($1::bigint[])
+`
+
+func (q *Queries) DbDeleteIndexOutboxEntries(ctx context.Context, ids []int64) (int64, error) {
+	result, err := q.db.Exec(ctx, dbDeleteIndexOutboxEntries, ids)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const dbDeleteIndexWatermark = `-- name: DbDeleteIndexWatermark :exec
+DELETE FROM index_watermarks
+WHERE collection = $1
+`
+
+func (q *Queries) DbDeleteIndexWatermark(ctx context.Context, collection string) error {
+	_, err := q.db.Exec(ctx, dbDeleteIndexWatermark, collection)
+	return err
+}
+
 const dbDeleteItem = `-- name: DbDeleteItem :exec
 DELETE FROM items where id = $1
 `
@@ -306,6 +631,33 @@ func (q *Queries) DbDeleteItems(ctx context.Context, itemIds []string) (int64, e
 	return result.RowsAffected(), nil
 }
 
+const dbDeleteSavedSearch = `-- name: DbDeleteSavedSearch :execrows
+DELETE FROM saved_searches
+WHERE id = $1 AND owner = $2
+`
+
+type DbDeleteSavedSearchParams struct {
+	ID    string
+	Owner string
+}
+
+func (q *Queries) DbDeleteSavedSearch(ctx context.Context, arg DbDeleteSavedSearchParams) (int64, error) {
+	result, err := q.db.Exec(ctx, dbDeleteSavedSearch, arg.ID, arg.Owner)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const dbEnqueueIndexOutboxEntry = `-- name: DbEnqueueIndexOutboxEntry :exec
+INSERT INTO index_outbox (business_id) VALUES ($1)
+`
+
+func (q *Queries) DbEnqueueIndexOutboxEntry(ctx context.Context, businessID string) error {
+	_, err := q.db.Exec(ctx, dbEnqueueIndexOutboxEntry, businessID)
+	return err
+}
+
 const dbFollowRelationsTwoSteps = `-- name: DbFollowRelationsTwoSteps :many
 select distinct r1."type" as relation_type_1 , r2.type as relation_type_2, r2.target_item_id
 from relations r2
@@ -340,6 +692,91 @@ func (q *Queries) DbFollowRelationsTwoSteps(ctx context.Context, sourceItemID st
 	return items, nil
 }
 
+const dbGetApiKey = `-- name: DbGetApiKey :one
+SELECT id, name, key_prefix, key_hash, scopes, created_by, created_at, expires_at, last_used_at, revoked_at, rotated_to FROM api_keys
+WHERE id = $1
+`
+
+func (q *Queries) DbGetApiKey(ctx context.Context, id string) (ApiKey, error) {
+	row := q.db.QueryRow(ctx, dbGetApiKey, id)
+	var i ApiKey
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.KeyPrefix,
+		&i.KeyHash,
+		&i.Scopes,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.RevokedAt,
+		&i.RotatedTo,
+	)
+	return i, err
+}
+
+const dbGetApiKeyByHash = `-- name: DbGetApiKeyByHash :one
+SELECT id, name, key_prefix, key_hash, scopes, created_by, created_at, expires_at, last_used_at, revoked_at, rotated_to FROM api_keys
+WHERE key_hash = $1
+`
+
+func (q *Queries) DbGetApiKeyByHash(ctx context.Context, keyHash string) (ApiKey, error) {
+	row := q.db.QueryRow(ctx, dbGetApiKeyByHash, keyHash)
+	var i ApiKey
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.KeyPrefix,
+		&i.KeyHash,
+		&i.Scopes,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.RevokedAt,
+		&i.RotatedTo,
+	)
+	return i, err
+}
+
+const dbGetApiKeyForUpdate = `-- name: DbGetApiKeyForUpdate :one
+SELECT id, name, key_prefix, key_hash, scopes, created_by, created_at, expires_at, last_used_at, revoked_at, rotated_to FROM api_keys
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) DbGetApiKeyForUpdate(ctx context.Context, id string) (ApiKey, error) {
+	row := q.db.QueryRow(ctx, dbGetApiKeyForUpdate, id)
+	var i ApiKey
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.KeyPrefix,
+		&i.KeyHash,
+		&i.Scopes,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.RevokedAt,
+		&i.RotatedTo,
+	)
+	return i, err
+}
+
+const dbGetIndexWatermark = `-- name: DbGetIndexWatermark :one
+SELECT indexed_until_xid FROM index_watermarks
+WHERE collection = $1
+`
+
+func (q *Queries) DbGetIndexWatermark(ctx context.Context, collection string) (int64, error) {
+	row := q.db.QueryRow(ctx, dbGetIndexWatermark, collection)
+	var indexed_until_xid int64
+	err := row.Scan(&indexed_until_xid)
+	return indexed_until_xid, err
+}
+
 const dbGetItem = `-- name: DbGetItem :many
 SELECT created_at, id, owner, entity_name, business_id, business_id_field_name, hash FROM items
 WHERE id = $1
@@ -373,6 +810,65 @@ func (q *Queries) DbGetItem(ctx context.Context, id string) ([]Item, error) {
 	return items, nil
 }
 
+const dbGetItemForUpdate = `-- name: DbGetItemForUpdate :one
+SELECT created_at, id, owner, entity_name, business_id, business_id_field_name, hash FROM items
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) DbGetItemForUpdate(ctx context.Context, id string) (Item, error) {
+	row := q.db.QueryRow(ctx, dbGetItemForUpdate, id)
+	var i Item
+	err := row.Scan(
+		&i.CreatedAt,
+		&i.ID,
+		&i.Owner,
+		&i.EntityName,
+		&i.BusinessID,
+		&i.BusinessIDFieldName,
+		&i.Hash,
+	)
+	return i, err
+}
+
+const dbGetItemValueHistory = `-- name: DbGetItemValueHistory :many
+SELECT created_at, id, revision, deleted, language, field_name, field_value, place, item_id, revision_comment, author FROM item_values
+WHERE item_id = $1
+ORDER BY revision ASC
+`
+
+func (q *Queries) DbGetItemValueHistory(ctx context.Context, itemID string) ([]ItemValue, error) {
+	rows, err := q.db.Query(ctx, dbGetItemValueHistory, itemID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ItemValue
+	for rows.Next() {
+		var i ItemValue
+		if err := rows.Scan(
+			&i.CreatedAt,
+			&i.ID,
+			&i.Revision,
+			&i.Deleted,
+			&i.Language,
+			&i.FieldName,
+			&i.FieldValue,
+			&i.Place,
+			&i.ItemID,
+			&i.RevisionComment,
+			&i.Author,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const dbGetItemValues = `-- name: DbGetItemValues :many
 SELECT id, item_id, field_name, field_value, place, revision, language FROM current_item_values
 WHERE item_id = $1
@@ -470,6 +966,38 @@ func (q *Queries) DbGetRelationTargetsForSourceAndType(ctx context.Context, arg
 	return items, nil
 }
 
+const dbGetSavedSearch = `-- name: DbGetSavedSearch :one
+SELECT id, owner, name, request, identity, notification_email, notifications_enabled, evaluated_until_xid, num_matched, version, created_at, updated_at, last_evaluated_at, last_notified_at FROM saved_searches
+WHERE id = $1 AND owner = $2
+`
+
+type DbGetSavedSearchParams struct {
+	ID    string
+	Owner string
+}
+
+func (q *Queries) DbGetSavedSearch(ctx context.Context, arg DbGetSavedSearchParams) (SavedSearch, error) {
+	row := q.db.QueryRow(ctx, dbGetSavedSearch, arg.ID, arg.Owner)
+	var i SavedSearch
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Name,
+		&i.Request,
+		&i.Identity,
+		&i.NotificationEmail,
+		&i.NotificationsEnabled,
+		&i.EvaluatedUntilXid,
+		&i.NumMatched,
+		&i.Version,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LastEvaluatedAt,
+		&i.LastNotifiedAt,
+	)
+	return i, err
+}
+
 const dbImputeBusinessId = `-- name: DbImputeBusinessId :execrows
 update items
 set business_id = val.business_id
@@ -584,21 +1112,268 @@ func (q *Queries) DbLatestItemValuesByBusinessId(ctx context.Context, businessID
 	return items, nil
 }
 
-const dbListHashesPresentLatestOnly = `-- name: DbListHashesPresentLatestOnly :many
-SELECT c.hash FROM (
-    SELECT i2.hash, row_number() over (partition by i2.business_id order by i2.created_at desc) as date_rank
-    FROM items i2
-    WHERE i2.business_id in (
-        SELECT i1.business_id
-        FROM items i1
-        WHERE i1.hash = ANY ($1::text[]) AND i1.business_id IS NOT NULL
-    )
- ) c
-WHERE c.date_rank = 1 and c.hash = ANY ($1::text[])
+const dbListApiKeys = `-- name: DbListApiKeys :many
+SELECT id, name, key_prefix, key_hash, scopes, created_by, created_at, expires_at, last_used_at, revoked_at, rotated_to FROM api_keys
+WHERE $1::text IS NULL OR created_by = $1
+ORDER BY created_at ASC, id ASC
 `
 
-func (q *Queries) DbListHashesPresentLatestOnly(ctx context.Context, hashes []string) ([]pgtype.Text, error) {
-	rows, err := q.db.Query(ctx, dbListHashesPresentLatestOnly, hashes)
+// All API keys, or those created by the given user.
+func (q *Queries) DbListApiKeys(ctx context.Context, createdBy pgtype.Text) ([]ApiKey, error) {
+	rows, err := q.db.Query(ctx, dbListApiKeys, createdBy)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ApiKey
+	for rows.Next() {
+		var i ApiKey
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.KeyPrefix,
+			&i.KeyHash,
+			&i.Scopes,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+			&i.LastUsedAt,
+			&i.RevokedAt,
+			&i.RotatedTo,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const dbListAuditEntries = `-- name: DbListAuditEntries :many
+SELECT id, created_at, service, method, tenant_id, subject, app_id, roles, resource, verb, target_ids, outcome, message FROM audit_log
+WHERE id > $1
+  AND ($2::text IS NULL OR subject = $2)
+  AND ($3::text IS NULL OR resource = $3)
+  AND ($4::timestamptz IS NULL OR created_at >= $4)
+  AND ($5::timestamptz IS NULL OR created_at < $5)
+ORDER BY id ASC
+LIMIT $6
+`
+
+type DbListAuditEntriesParams struct {
+	AfterID     int64
+	Subject     pgtype.Text
+	Resource    pgtype.Text
+	CreatedFrom pgtype.Timestamptz
+	CreatedTo   pgtype.Timestamptz
+	MaxEntries  int32
+}
+
+// Audit entries recorded after the given one, optionally restricted to a subject, a resource, and a time range.
+func (q *Queries) DbListAuditEntries(ctx context.Context, arg DbListAuditEntriesParams) ([]AuditLog, error) {
+	rows, err := q.db.Query(ctx, dbListAuditEntries,
+		arg.AfterID,
+		arg.Subject,
+		arg.Resource,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.MaxEntries,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditLog
+	for rows.Next() {
+		var i AuditLog
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.Service,
+			&i.Method,
+			&i.TenantID,
+			&i.Subject,
+			&i.AppID,
+			&i.Roles,
+			&i.Resource,
+			&i.Verb,
+			&i.TargetIds,
+			&i.Outcome,
+			&i.Message,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const dbListChangedBusinessIDs = `-- name: DbListChangedBusinessIDs :many
+WITH RECURSIVE changed(business_id) AS (
+    SELECT ic.business_id FROM index_changes ic
+    WHERE ic.changed_xid >= $1::bigint::text::xid8
+    UNION
+    SELECT liwbi.business_id FROM changed c
+    JOIN current_item_values civ ON civ.field_value = c.business_id
+    JOIN latest_items_with_business_id liwbi ON liwbi.item_id = civ.item_id
+    WHERE civ.field_name = ANY($2::text[])
+)
+SELECT c.business_id::text AS business_id FROM changed c
+ORDER BY business_id ASC
+`
+
+type DbListChangedBusinessIDsParams struct {
+	Since          int64
+	LinkFieldNames []string
+}
+
+// Business IDs changed by transactions from the given watermark onwards, plus the business IDs of the latest items
+// linking to any of them (via the given link fields), recursively, since the documents of linking items contain values
+// of the linked items. UNION stops the recursion at business IDs already found, so link cycles are fine.
+func (q *Queries) DbListChangedBusinessIDs(ctx context.Context, arg DbListChangedBusinessIDsParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, dbListChangedBusinessIDs, arg.Since, arg.LinkFieldNames)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var business_id string
+		if err := rows.Scan(&business_id); err != nil {
+			return nil, err
+		}
+		items = append(items, business_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const dbListChangedItemIDs = `-- name: DbListChangedItemIDs :many
+SELECT liwbi.item_id FROM index_changes ic
+JOIN latest_items_with_business_id liwbi ON liwbi.business_id = ic.business_id
+WHERE ic.changed_xid >= $1::bigint::text::xid8 AND ic.changed_xid < $2::bigint::text::xid8
+ORDER BY liwbi.item_id ASC
+`
+
+type DbListChangedItemIDsParams struct {
+	Since int64
+	Until int64
+}
+
+// IDs of the latest items of the business IDs changed by transactions from the first watermark up to (excluding) the
+// second one, i.e. of the items added or changed between two index updates.
+func (q *Queries) DbListChangedItemIDs(ctx context.Context, arg DbListChangedItemIDsParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, dbListChangedItemIDs, arg.Since, arg.Until)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var item_id string
+		if err := rows.Scan(&item_id); err != nil {
+			return nil, err
+		}
+		items = append(items, item_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const dbListDeadLetteredIndexOutboxEntries = `-- name: DbListDeadLetteredIndexOutboxEntries :many
+SELECT id, business_id, created_at, attempts, next_attempt_at, last_error, dead_lettered_at FROM index_outbox
+WHERE dead_lettered_at IS NOT NULL
+ORDER BY id ASC
+`
+
+func (q *Queries) DbListDeadLetteredIndexOutboxEntries(ctx context.Context) ([]IndexOutbox, error) {
+	rows, err := q.db.Query(ctx, dbListDeadLetteredIndexOutboxEntries)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []IndexOutbox
+	for rows.Next() {
+		var i IndexOutbox
+		if err := rows.Scan(
+			&i.ID,
+			&i.BusinessID,
+			&i.CreatedAt,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.LastError,
+			&i.DeadLetteredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const dbListForeignItemIDs = `-- name: DbListForeignItemIDs :many
+SELECT i.id FROM items i
+WHERE i.id = ANY($1::text[]) AND i.owner <> $2::text AND NOT EXISTS (
+    SELECT 1 FROM item_owner_groups iog
+    WHERE iog.item_id = i.id AND iog.group_id = ANY($3::text[])
+)
+ORDER BY i.id ASC
+`
+
+type DbListForeignItemIDsParams struct {
+	ItemIds  []string
+	Owner    string
+	GroupIds []string
+}
+
+// Items among the given ones which are neither owned by the user nor shared with one of the given groups.
+func (q *Queries) DbListForeignItemIDs(ctx context.Context, arg DbListForeignItemIDsParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, dbListForeignItemIDs, arg.ItemIds, arg.Owner, arg.GroupIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const dbListHashesPresentLatestOnly = `-- name: DbListHashesPresentLatestOnly :many
+SELECT c.hash FROM (
+    SELECT i2.hash, row_number() over (partition by i2.business_id order by i2.created_at desc) as date_rank
+    FROM items i2
+    WHERE i2.business_id in (
+        SELECT i1.business_id
+        FROM items i1
+        WHERE i1.hash = ANY ($1::text[]) AND i1.business_id IS NOT NULL
+    )
+ ) c
+WHERE c.date_rank = 1 and c.hash = ANY ($1::text[])
+`
+
+func (q *Queries) DbListHashesPresentLatestOnly(ctx context.Context, hashes []string) ([]pgtype.Text, error) {
+	rows, err := q.db.Query(ctx, dbListHashesPresentLatestOnly, hashes)
 	if err != nil {
 		return nil, err
 	}
@@ -676,18 +1451,54 @@ func (q *Queries) DbListItemValues(ctx context.Context) ([]CurrentItemValue, err
 	return items, nil
 }
 
+const dbListItemValuesOfItems = `-- name: DbListItemValuesOfItems :many
+SELECT id, item_id, field_name, field_value, place, revision, language FROM current_item_values
+WHERE item_id = ANY($1::text[])
+ORDER BY item_id ASC, field_name ASC, place ASC
+`
+
+func (q *Queries) DbListItemValuesOfItems(ctx context.Context, itemIds []string) ([]CurrentItemValue, error) {
+	rows, err := q.db.Query(ctx, dbListItemValuesOfItems, itemIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CurrentItemValue
+	for rows.Next() {
+		var i CurrentItemValue
+		if err := rows.Scan(
+			&i.ID,
+			&i.ItemID,
+			&i.FieldName,
+			&i.FieldValue,
+			&i.Place,
+			&i.Revision,
+			&i.Language,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const dbListItems = `-- name: DbListItems :many
 SELECT item_id, created_at, owner, entity_name, business_id, business_id_field_name FROM items_nullable_business_id
-WHERE item_id >= $1 ORDER BY item_id ASC LIMIT $2
+WHERE item_id >= $1 AND f_item_visible(item_id, entity_name, $2::jsonb)
+ORDER BY item_id ASC LIMIT $3
 `
 
 type DbListItemsParams struct {
-	ItemID string
-	Limit  int32
+	ItemID     string
+	Visibility []byte
+	Limit      int32
 }
 
 func (q *Queries) DbListItems(ctx context.Context, arg DbListItemsParams) ([]ItemsNullableBusinessID, error) {
-	rows, err := q.db.Query(ctx, dbListItems, arg.ItemID, arg.Limit)
+	rows, err := q.db.Query(ctx, dbListItems, arg.ItemID, arg.Visibility, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
@@ -746,17 +1557,24 @@ func (q *Queries) DbListItemsForBusinessId(ctx context.Context, businessID strin
 
 const dbListItemsOfType = `-- name: DbListItemsOfType :many
 SELECT item_id, created_at, owner, entity_name, business_id, business_id_field_name FROM items_nullable_business_id
-WHERE item_id >= $1 AND entity_name = $2 ORDER BY item_id ASC LIMIT $3
+WHERE item_id >= $1 AND entity_name = $2 AND f_item_visible(item_id, entity_name, $3::jsonb)
+ORDER BY item_id ASC LIMIT $4
 `
 
 type DbListItemsOfTypeParams struct {
 	ItemID     string
 	EntityName string
+	Visibility []byte
 	Limit      int32
 }
 
 func (q *Queries) DbListItemsOfType(ctx context.Context, arg DbListItemsOfTypeParams) ([]ItemsNullableBusinessID, error) {
-	rows, err := q.db.Query(ctx, dbListItemsOfType, arg.ItemID, arg.EntityName, arg.Limit)
+	rows, err := q.db.Query(ctx, dbListItemsOfType,
+		arg.ItemID,
+		arg.EntityName,
+		arg.Visibility,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -783,11 +1601,11 @@ func (q *Queries) DbListItemsOfType(ctx context.Context, arg DbListItemsOfTypePa
 }
 
 const dbListItemsWithBusinessId = `-- name: DbListItemsWithBusinessId :many
-select item_id, created_at, owner, entity_name, business_id, business_id_field_name from items_with_business_id order by business_id, created_at asc
+select item_id, created_at, owner, entity_name, business_id, business_id_field_name from items_with_business_id where f_item_visible(item_id, entity_name, $1::jsonb) order by business_id, created_at asc
 `
 
-func (q *Queries) DbListItemsWithBusinessId(ctx context.Context) ([]ItemsWithBusinessID, error) {
-	rows, err := q.db.Query(ctx, dbListItemsWithBusinessId)
+func (q *Queries) DbListItemsWithBusinessId(ctx context.Context, visibility []byte) ([]ItemsWithBusinessID, error) {
+	rows, err := q.db.Query(ctx, dbListItemsWithBusinessId, visibility)
 	if err != nil {
 		return nil, err
 	}
@@ -813,12 +1631,139 @@ func (q *Queries) DbListItemsWithBusinessId(ctx context.Context) ([]ItemsWithBus
 	return items, nil
 }
 
+const dbListLatestItems = `-- name: DbListLatestItems :many
+SELECT i.item_id, i.created_at, i.owner, i.entity_name, i.business_id, i.business_id_field_name FROM items_nullable_business_id i
+WHERE i.item_id >= $1 AND NOT EXISTS (
+    SELECT 1 FROM items n
+    WHERE n.business_id = i.business_id AND n.entity_name = i.entity_name AND n.created_at > i.created_at
+) AND f_item_visible(i.item_id, i.entity_name, $2::jsonb)
+ORDER BY i.item_id ASC LIMIT $3
+`
+
+type DbListLatestItemsParams struct {
+	ItemID     string
+	Visibility []byte
+	Limit      int32
+}
+
+func (q *Queries) DbListLatestItems(ctx context.Context, arg DbListLatestItemsParams) ([]ItemsNullableBusinessID, error) {
+	rows, err := q.db.Query(ctx, dbListLatestItems, arg.ItemID, arg.Visibility, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ItemsNullableBusinessID
+	for rows.Next() {
+		var i ItemsNullableBusinessID
+		if err := rows.Scan(
+			&i.ItemID,
+			&i.CreatedAt,
+			&i.Owner,
+			&i.EntityName,
+			&i.BusinessID,
+			&i.BusinessIDFieldName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const dbListLatestItemsOfType = `-- name: DbListLatestItemsOfType :many
+SELECT i.item_id, i.created_at, i.owner, i.entity_name, i.business_id, i.business_id_field_name FROM items_nullable_business_id i
+WHERE i.item_id >= $1 AND i.entity_name = $2 AND NOT EXISTS (
+    SELECT 1 FROM items n
+    WHERE n.business_id = i.business_id AND n.entity_name = i.entity_name AND n.created_at > i.created_at
+) AND f_item_visible(i.item_id, i.entity_name, $3::jsonb)
+ORDER BY i.item_id ASC LIMIT $4
+`
+
+type DbListLatestItemsOfTypeParams struct {
+	ItemID     string
+	EntityName string
+	Visibility []byte
+	Limit      int32
+}
+
+func (q *Queries) DbListLatestItemsOfType(ctx context.Context, arg DbListLatestItemsOfTypeParams) ([]ItemsNullableBusinessID, error) {
+	rows, err := q.db.Query(ctx, dbListLatestItemsOfType,
+		arg.ItemID,
+		arg.EntityName,
+		arg.Visibility,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ItemsNullableBusinessID
+	for rows.Next() {
+		var i ItemsNullableBusinessID
+		if err := rows.Scan(
+			&i.ItemID,
+			&i.CreatedAt,
+			&i.Owner,
+			&i.EntityName,
+			&i.BusinessID,
+			&i.BusinessIDFieldName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const dbListRelations = `-- name: DbListRelations :many
+SELECT created_at, id, deleted, owner, source_item_id, type, target_item_id, info_item_id FROM relations r
+WHERE EXISTS (SELECT 1 FROM items s WHERE s.id = r.source_item_id AND f_item_visible(s.id, s.entity_name, $1::jsonb))
+  AND EXISTS (SELECT 1 FROM items t WHERE t.id = r.target_item_id AND f_item_visible(t.id, t.entity_name, $1::jsonb))
+`
+
+func (q *Queries) DbListRelations(ctx context.Context, visibility []byte) ([]Relation, error) {
+	rows, err := q.db.Query(ctx, dbListRelations, visibility)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Relation
+	for rows.Next() {
+		var i Relation
+		if err := rows.Scan(
+			&i.CreatedAt,
+			&i.ID,
+			&i.Deleted,
+			&i.Owner,
+			&i.SourceItemID,
+			&i.Type,
+			&i.TargetItemID,
+			&i.InfoItemID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const dbListRelationsForSource = `-- name: DbListRelationsForSource :many
 SELECT created_at, id, deleted, owner, source_item_id, type, target_item_id, info_item_id FROM relations
+WHERE source_item_id = $1 AND deleted = false
+ORDER BY type ASC, target_item_id ASC
 `
 
-func (q *Queries) DbListRelations(ctx context.Context) ([]Relation, error) {
-	rows, err := q.db.Query(ctx, dbListRelations)
+func (q *Queries) DbListRelationsForSource(ctx context.Context, sourceItemID string) ([]Relation, error) {
+	rows, err := q.db.Query(ctx, dbListRelationsForSource, sourceItemID)
 	if err != nil {
 		return nil, err
 	}
@@ -845,3 +1790,482 @@ func (q *Queries) DbListRelations(ctx context.Context) ([]Relation, error) {
 	}
 	return items, nil
 }
+
+const dbListSavedSearches = `-- name: DbListSavedSearches :many
+SELECT id, owner, name, request, identity, notification_email, notifications_enabled, evaluated_until_xid, num_matched, version, created_at, updated_at, last_evaluated_at, last_notified_at FROM saved_searches
+WHERE owner = $1
+ORDER BY created_at ASC, id ASC
+`
+
+func (q *Queries) DbListSavedSearches(ctx context.Context, owner string) ([]SavedSearch, error) {
+	rows, err := q.db.Query(ctx, dbListSavedSearches, owner)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SavedSearch
+	for rows.Next() {
+		var i SavedSearch
+		if err := rows.Scan(
+			&i.ID,
+			&i.Owner,
+			&i.Name,
+			&i.Request,
+			&i.Identity,
+			&i.NotificationEmail,
+			&i.NotificationsEnabled,
+			&i.EvaluatedUntilXid,
+			&i.NumMatched,
+			&i.Version,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.LastEvaluatedAt,
+			&i.LastNotifiedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const dbListSubscribedSavedSearches = `-- name: DbListSubscribedSavedSearches :many
+SELECT id, owner, name, request, identity, notification_email, notifications_enabled, evaluated_until_xid, num_matched, version, created_at, updated_at, last_evaluated_at, last_notified_at FROM saved_searches
+WHERE notifications_enabled
+ORDER BY created_at ASC, id ASC
+`
+
+// The saved searches of all owners whose notifications are enabled.
+func (q *Queries) DbListSubscribedSavedSearches(ctx context.Context) ([]SavedSearch, error) {
+	rows, err := q.db.Query(ctx, dbListSubscribedSavedSearches)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SavedSearch
+	for rows.Next() {
+		var i SavedSearch
+		if err := rows.Scan(
+			&i.ID,
+			&i.Owner,
+			&i.Name,
+			&i.Request,
+			&i.Identity,
+			&i.NotificationEmail,
+			&i.NotificationsEnabled,
+			&i.EvaluatedUntilXid,
+			&i.NumMatched,
+			&i.Version,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.LastEvaluatedAt,
+			&i.LastNotifiedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const dbListVisibleItemIDs = `-- name: DbListVisibleItemIDs :many
+SELECT id FROM items
+WHERE id = ANY($1::text[]) AND f_item_visible(id, entity_name, $2::jsonb)
+`
+
+type DbListVisibleItemIDsParams struct {
+	ItemIds    []string
+	Visibility []byte
+}
+
+// Items among the given ones which are visible under the given visibility rules (see f_item_visible).
+func (q *Queries) DbListVisibleItemIDs(ctx context.Context, arg DbListVisibleItemIDsParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, dbListVisibleItemIDs, arg.ItemIds, arg.Visibility)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const dbNextIndexWatermark = `-- name: DbNextIndexWatermark :one
+SELECT pg_snapshot_xmin(pg_current_snapshot())::text::bigint AS next_watermark
+`
+
+// The next watermark is the lowest transaction still running: the changes of all transactions from there on may not be
+// visible yet to the index update which is about to start.
+func (q *Queries) DbNextIndexWatermark(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, dbNextIndexWatermark)
+	var next_watermark int64
+	err := row.Scan(&next_watermark)
+	return next_watermark, err
+}
+
+const dbOaiEarliestDatestamp = `-- name: DbOaiEarliestDatestamp :one
+SELECT min(datestamp)::timestamptz AS earliest FROM oai_records
+WHERE entity_name = ANY($1::text[]) AND f_item_visible(item_id, entity_name, $2::jsonb)
+`
+
+type DbOaiEarliestDatestampParams struct {
+	EntityNames []string
+	Visibility  []byte
+}
+
+func (q *Queries) DbOaiEarliestDatestamp(ctx context.Context, arg DbOaiEarliestDatestampParams) (pgtype.Timestamptz, error) {
+	row := q.db.QueryRow(ctx, dbOaiEarliestDatestamp, arg.EntityNames, arg.Visibility)
+	var earliest pgtype.Timestamptz
+	err := row.Scan(&earliest)
+	return earliest, err
+}
+
+const dbOaiGetRecord = `-- name: DbOaiGetRecord :one
+SELECT business_id, entity_name, item_id, datestamp, deleted FROM oai_records
+WHERE entity_name = $1 AND business_id = $2 AND f_item_visible(item_id, entity_name, $3::jsonb)
+`
+
+type DbOaiGetRecordParams struct {
+	EntityName string
+	BusinessID string
+	Visibility []byte
+}
+
+func (q *Queries) DbOaiGetRecord(ctx context.Context, arg DbOaiGetRecordParams) (OaiRecord, error) {
+	row := q.db.QueryRow(ctx, dbOaiGetRecord, arg.EntityName, arg.BusinessID, arg.Visibility)
+	var i OaiRecord
+	err := row.Scan(
+		&i.BusinessID,
+		&i.EntityName,
+		&i.ItemID,
+		&i.Datestamp,
+		&i.Deleted,
+	)
+	return i, err
+}
+
+const dbOaiListRecords = `-- name: DbOaiListRecords :many
+SELECT business_id, entity_name, item_id, datestamp, deleted FROM oai_records
+WHERE entity_name = ANY($1::text[])
+  AND (entity_name, business_id) > ($2::text, $3::text)
+  AND ($4::timestamptz IS NULL OR datestamp >= $4)
+  AND ($5::timestamptz IS NULL OR datestamp < $5)
+  AND f_item_visible(item_id, entity_name, $6::jsonb)
+ORDER BY entity_name ASC, business_id ASC
+LIMIT $7
+`
+
+type DbOaiListRecordsParams struct {
+	EntityNames     []string
+	AfterEntityName string
+	AfterBusinessID string
+	FromTime        pgtype.Timestamptz
+	UntilTime       pgtype.Timestamptz
+	Visibility      []byte
+	MaxRecords      int32
+}
+
+func (q *Queries) DbOaiListRecords(ctx context.Context, arg DbOaiListRecordsParams) ([]OaiRecord, error) {
+	rows, err := q.db.Query(ctx, dbOaiListRecords,
+		arg.EntityNames,
+		arg.AfterEntityName,
+		arg.AfterBusinessID,
+		arg.FromTime,
+		arg.UntilTime,
+		arg.Visibility,
+		arg.MaxRecords,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []OaiRecord
+	for rows.Next() {
+		var i OaiRecord
+		if err := rows.Scan(
+			&i.BusinessID,
+			&i.EntityName,
+			&i.ItemID,
+			&i.Datestamp,
+			&i.Deleted,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const dbRecordSavedSearchEvaluation = `-- name: DbRecordSavedSearchEvaluation :execrows
+UPDATE saved_searches SET evaluated_until_xid = $1, num_matched = $2, last_evaluated_at = NOW(),
+    last_notified_at = CASE WHEN $3::boolean THEN NOW() ELSE last_notified_at END, version = version + 1
+WHERE id = $4 AND version = $5
+`
+
+type DbRecordSavedSearchEvaluationParams struct {
+	EvaluatedUntilXid int64
+	NumMatched        int32
+	Notified          bool
+	ID                string
+	Version           int32
+}
+
+// Records an evaluation unless the saved search has been changed since it was read.
+func (q *Queries) DbRecordSavedSearchEvaluation(ctx context.Context, arg DbRecordSavedSearchEvaluationParams) (int64, error) {
+	result, err := q.db.Exec(ctx, dbRecordSavedSearchEvaluation,
+		arg.EvaluatedUntilXid,
+		arg.NumMatched,
+		arg.Notified,
+		arg.ID,
+		arg.Version,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const dbRequeueDeadLetteredIndexOutboxEntries = `-- name: DbRequeueDeadLetteredIndexOutboxEntries :execrows
+UPDATE index_outbox SET attempts = 0, next_attempt_at = NOW(), dead_lettered_at = NULL
+WHERE dead_lettered_at IS NOT NULL AND (cardinality($1::bigint[]) = 0 OR id = ANY($1::bigint[]))
+`
+
+func (q *Queries) DbRequeueDeadLetteredIndexOutboxEntries(ctx context.Context, ids []int64) (int64, error) {
+	result, err := q.db.Exec(ctx, dbRequeueDeadLetteredIndexOutboxEntries, ids)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const dbResolveBusinessIDs = `-- name: DbResolveBusinessIDs :many
+select liwbi.business_id, liwbi.item_id
+from latest_items_with_business_id liwbi
+where liwbi.business_id = ANY($1::text[])
+order by liwbi.business_id asc, liwbi.item_id asc
+`
+
+type DbResolveBusinessIDsRow struct {
+	BusinessID string
+	ItemID     string
+}
+
+func (q *Queries) DbResolveBusinessIDs(ctx context.Context, businessIds []string) ([]DbResolveBusinessIDsRow, error) {
+	rows, err := q.db.Query(ctx, dbResolveBusinessIDs, businessIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DbResolveBusinessIDsRow
+	for rows.Next() {
+		var i DbResolveBusinessIDsRow
+		if err := rows.Scan(&i.BusinessID, &i.ItemID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const dbRetireItemValue = `-- name: DbRetireItemValue :execrows
+UPDATE item_values SET deleted = true
+WHERE id = $1 AND deleted = false
+`
+
+func (q *Queries) DbRetireItemValue(ctx context.Context, id string) (int64, error) {
+	result, err := q.db.Exec(ctx, dbRetireItemValue, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const dbRetryIndexOutboxEntry = `-- name: DbRetryIndexOutboxEntry :exec
+UPDATE index_outbox SET next_attempt_at = $1, last_error = $2
+WHERE id = $3
+`
+
+type DbRetryIndexOutboxEntryParams struct {
+	NextAttemptAt pgtype.Timestamptz
+	LastError     pgtype.Text
+	ID            int64
+}
+
+func (q *Queries) DbRetryIndexOutboxEntry(ctx context.Context, arg DbRetryIndexOutboxEntryParams) error {
+	_, err := q.db.Exec(ctx, dbRetryIndexOutboxEntry, arg.NextAttemptAt, arg.LastError, arg.ID)
+	return err
+}
+
+const dbRevokeApiKey = `-- name: DbRevokeApiKey :exec
+UPDATE api_keys SET revoked_at = NOW()
+WHERE id = $1 AND revoked_at IS NULL
+`
+
+func (q *Queries) DbRevokeApiKey(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, dbRevokeApiKey, id)
+	return err
+}
+
+const dbRotateApiKey = `-- name: DbRotateApiKey :exec
+UPDATE api_keys SET rotated_to = $2, expires_at = $3
+WHERE id = $1
+`
+
+type DbRotateApiKeyParams struct {
+	ID        string
+	RotatedTo pgtype.Text
+	ExpiresAt pgtype.Timestamptz
+}
+
+func (q *Queries) DbRotateApiKey(ctx context.Context, arg DbRotateApiKeyParams) error {
+	_, err := q.db.Exec(ctx, dbRotateApiKey, arg.ID, arg.RotatedTo, arg.ExpiresAt)
+	return err
+}
+
+const dbSetIndexWatermark = `-- name: DbSetIndexWatermark :exec
+INSERT INTO index_watermarks (collection, indexed_until_xid) VALUES ($1, $2)
+ON CONFLICT (collection) DO UPDATE SET indexed_until_xid = EXCLUDED.indexed_until_xid
+`
+
+type DbSetIndexWatermarkParams struct {
+	Collection      string
+	IndexedUntilXid int64
+}
+
+func (q *Queries) DbSetIndexWatermark(ctx context.Context, arg DbSetIndexWatermarkParams) error {
+	_, err := q.db.Exec(ctx, dbSetIndexWatermark, arg.Collection, arg.IndexedUntilXid)
+	return err
+}
+
+const dbTouchApiKey = `-- name: DbTouchApiKey :exec
+UPDATE api_keys SET last_used_at = NOW()
+WHERE id = $1
+`
+
+func (q *Queries) DbTouchApiKey(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, dbTouchApiKey, id)
+	return err
+}
+
+const dbUnsubscribeSavedSearch = `-- name: DbUnsubscribeSavedSearch :one
+UPDATE saved_searches SET notifications_enabled = FALSE, version = version + 1, updated_at = NOW()
+WHERE id = $1 AND owner = $2
+RETURNING id, owner, name, request, identity, notification_email, notifications_enabled, evaluated_until_xid, num_matched, version, created_at, updated_at, last_evaluated_at, last_notified_at
+`
+
+type DbUnsubscribeSavedSearchParams struct {
+	ID    string
+	Owner string
+}
+
+func (q *Queries) DbUnsubscribeSavedSearch(ctx context.Context, arg DbUnsubscribeSavedSearchParams) (SavedSearch, error) {
+	row := q.db.QueryRow(ctx, dbUnsubscribeSavedSearch, arg.ID, arg.Owner)
+	var i SavedSearch
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Name,
+		&i.Request,
+		&i.Identity,
+		&i.NotificationEmail,
+		&i.NotificationsEnabled,
+		&i.EvaluatedUntilXid,
+		&i.NumMatched,
+		&i.Version,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LastEvaluatedAt,
+		&i.LastNotifiedAt,
+	)
+	return i, err
+}
+
+const dbUpdateItemHash = `-- name: DbUpdateItemHash :exec
+UPDATE items SET hash = $1
+WHERE id = $2
+`
+
+type DbUpdateItemHashParams struct {
+	Hash pgtype.Text
+	ID   string
+}
+
+func (q *Queries) DbUpdateItemHash(ctx context.Context, arg DbUpdateItemHashParams) error {
+	_, err := q.db.Exec(ctx, dbUpdateItemHash, arg.Hash, arg.ID)
+	return err
+}
+
+const dbUpdateSavedSearch = `-- name: DbUpdateSavedSearch :one
+UPDATE saved_searches SET name = $3, request = $4, identity = $5, notification_email = $6, notifications_enabled = $7,
+    evaluated_until_xid = $8, num_matched = $9, last_evaluated_at = $10, version = version + 1, updated_at = NOW()
+WHERE id = $1 AND version = $2
+RETURNING id, owner, name, request, identity, notification_email, notifications_enabled, evaluated_until_xid, num_matched, version, created_at, updated_at, last_evaluated_at, last_notified_at
+`
+
+type DbUpdateSavedSearchParams struct {
+	ID                   string
+	Version              int32
+	Name                 string
+	Request              []byte
+	Identity             []byte
+	NotificationEmail    string
+	NotificationsEnabled bool
+	EvaluatedUntilXid    int64
+	NumMatched           int32
+	LastEvaluatedAt      pgtype.Timestamptz
+}
+
+// Updates the saved search unless it has been changed since it was read.
+func (q *Queries) DbUpdateSavedSearch(ctx context.Context, arg DbUpdateSavedSearchParams) (SavedSearch, error) {
+	row := q.db.QueryRow(ctx, dbUpdateSavedSearch,
+		arg.ID,
+		arg.Version,
+		arg.Name,
+		arg.Request,
+		arg.Identity,
+		arg.NotificationEmail,
+		arg.NotificationsEnabled,
+		arg.EvaluatedUntilXid,
+		arg.NumMatched,
+		arg.LastEvaluatedAt,
+	)
+	var i SavedSearch
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Name,
+		&i.Request,
+		&i.Identity,
+		&i.NotificationEmail,
+		&i.NotificationsEnabled,
+		&i.EvaluatedUntilXid,
+		&i.NumMatched,
+		&i.Version,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LastEvaluatedAt,
+		&i.LastNotifiedAt,
+	)
+	return i, err
+}
@@ -14,6 +14,7 @@ type baseFieldDef struct {
 	isLinkedField bool
 
 	multiValued bool
+	valueRules  *ValueRules
 }
 
 func (def *baseFieldDef) Name() string        { return def.name }
@@ -22,8 +23,12 @@ func (def *baseFieldDef) DisplayID() string   { return def.displayID }
 func (def *baseFieldDef) IsLinkedField() bool { return def.isLinkedField }
 func (def *baseFieldDef) MultiValued() bool   { return def.multiValued }
 
+func (def *baseFieldDef) ValueRules() *ValueRules { return def.valueRules }
+
 type BaseIndexDef struct {
 	MultiValued bool
+	// Optional rules for the values of the field
+	ValueRules *ValueRules
 }
 
 func NewBaseFieldDef(fieldName string, kind string, displayID string, isLinkedField bool, baseIndexDef BaseIndexDef) BaseFieldDef {
@@ -33,6 +38,7 @@ func NewBaseFieldDef(fieldName string, kind string, displayID string, isLinkedFi
 		displayID:     displayID,
 		isLinkedField: isLinkedField,
 		multiValued:   baseIndexDef.MultiValued,
+		valueRules:    baseIndexDef.ValueRules,
 	}
 }
 
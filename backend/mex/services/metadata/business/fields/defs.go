@@ -18,12 +18,20 @@ type FieldRepo interface {
 	Purge(ctx context.Context) error
 }
 
+type VocabularyRepo interface {
+	// GetVocabularyMapping returns the mapping of entity types, fields and relation types onto vocabulary IRIs
+	GetVocabularyMapping(ctx context.Context) (*fields.VocabularyMapping, error)
+	Purge(ctx context.Context) error
+}
+
 type BaseFieldDef interface {
 	Name() string
 	Kind() string
 	DisplayID() string
 
 	MultiValued() bool
+	// ValueRules returns the rules values of the field have to follow (nil if there are none)
+	ValueRules() *ValueRules
 }
 
 // +--------------+  MarshalToProtobufFormat   +-------------------+
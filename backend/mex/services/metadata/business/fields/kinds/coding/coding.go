@@ -52,16 +52,28 @@ func (kind *KindCoding) ValidateDefinition(_ context.Context, request *fieldUtil
 		return nil, fmt.Errorf("index definition extension is empty")
 	}
 
+	// Other extensions (validation rules) may come along, so the coding extension is looked up by its type.
+	codingExtAny := request.IndexDef.Ext[0]
+	for _, ext := range request.IndexDef.Ext {
+		if ext.MessageName() == solr.CodingExtID {
+			codingExtAny = ext
+			break
+		}
+	}
+
 	var codingExt fieldUtils.IndexDefExtCoding
-	err = request.IndexDef.Ext[0].UnmarshalTo(&codingExt)
+	err = codingExtAny.UnmarshalTo(&codingExt)
 	if err != nil {
 		return nil, fmt.Errorf("malformed index definition extension: %s", err.Error())
 	}
 
+	indexDef, err := fields.ParseBaseIndexDef(request.IndexDef)
+	if err != nil {
+		return nil, err
+	}
+
 	return &codingFieldDef{
-		BaseFieldDef: fields.NewBaseFieldDef(request.Name, request.Kind, request.DisplayId, false, fields.BaseIndexDef{
-			MultiValued: request.IndexDef.MultiValued,
-		}),
+		BaseFieldDef:   fields.NewBaseFieldDef(request.Name, request.Kind, request.DisplayId, false, indexDef),
 		codingsetNames: codingExt.CodingsetNames,
 	}, nil
 }
@@ -82,6 +94,10 @@ func (kind *KindCoding) MarshalToProtobufFormat(_ context.Context, fieldDef fiel
 		if err != nil {
 			return nil, err
 		}
+		exts, err := fields.AppendValidationExt([]*anypb.Any{ext}, fieldDef)
+		if err != nil {
+			return nil, err
+		}
 
 		return &fieldUtils.FieldDef{
 			Name:      fieldDef.Name(),
@@ -89,7 +105,7 @@ func (kind *KindCoding) MarshalToProtobufFormat(_ context.Context, fieldDef fiel
 			DisplayId: fieldDef.DisplayID(),
 			IndexDef: &fieldUtils.IndexDef{
 				MultiValued: fieldDef.MultiValued(),
-				Ext:         []*anypb.Any{ext},
+				Ext:         exts,
 			},
 		}, nil
 	}
@@ -97,8 +113,8 @@ func (kind *KindCoding) MarshalToProtobufFormat(_ context.Context, fieldDef fiel
 	return nil, fmt.Errorf("field definition object is not a CodingFieldDef")
 }
 
-func (kind *KindCoding) ValidateFieldValue(context.Context, fields.BaseFieldDef, string) error {
-	return nil
+func (kind *KindCoding) ValidateFieldValue(_ context.Context, fieldDef fields.BaseFieldDef, fieldValue string) error {
+	return fieldDef.ValueRules().Check(fieldValue)
 }
 
 func (kind *KindCoding) GenerateSolrFields(_ context.Context, fieldDef fields.BaseFieldDef) (solr.FieldCategoryToSolrFieldDefsMap, error) {
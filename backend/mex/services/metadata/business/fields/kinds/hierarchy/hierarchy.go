@@ -80,10 +80,13 @@ func (kind *kindHierarchy) ValidateDefinition(_ context.Context, request *fieldU
 		return nil, fmt.Errorf("no link config extension found for hierarchy field")
 	}
 
+	indexDef, err := fields.ParseBaseIndexDef(request.IndexDef)
+	if err != nil {
+		return nil, err
+	}
+
 	completeFieldDef := hierarchyFieldDef{
-		BaseFieldDef: fields.NewBaseFieldDef(request.Name, request.Kind, request.DisplayId, false, fields.BaseIndexDef{
-			MultiValued: request.IndexDef.MultiValued,
-		}),
+		BaseFieldDef: fields.NewBaseFieldDef(request.Name, request.Kind, request.DisplayId, false, indexDef),
 
 		// Hierarchy properties
 		codeSystemNameOrEntityType: hierarchyExt.CodeSystemNameOrNodeEntityType,
@@ -131,22 +134,24 @@ func (kind *kindHierarchy) MarshalToProtobufFormat(_ context.Context, fieldDef f
 		return nil, err
 	}
 
+	ext, err := fields.AppendValidationExt([]*anypb.Any{hierarchyExt, linkExt}, fieldDef)
+	if err != nil {
+		return nil, err
+	}
+
 	return &fieldUtils.FieldDef{
 		Name:      fieldDef.Name(),
 		Kind:      fieldDef.Kind(),
 		DisplayId: fieldDef.DisplayID(),
 		IndexDef: &fieldUtils.IndexDef{
 			MultiValued: fieldDef.MultiValued(),
-			Ext: []*anypb.Any{
-				hierarchyExt,
-				linkExt,
-			},
+			Ext:         ext,
 		},
 	}, nil
 }
 
-func (kind *kindHierarchy) ValidateFieldValue(_ context.Context, _ fields.BaseFieldDef, _ string) error {
-	return nil
+func (kind *kindHierarchy) ValidateFieldValue(_ context.Context, fieldDef fields.BaseFieldDef, fieldValue string) error {
+	return fieldDef.ValueRules().Check(fieldValue)
 }
 
 // revive:disable:unexported-return
@@ -53,10 +53,13 @@ func (kind *KindLink) ValidateDefinition(_ context.Context, fieldDef *fieldUtils
 		return nil, err
 	}
 
+	indexDef, err := fields.ParseBaseIndexDef(fieldDef.IndexDef)
+	if err != nil {
+		return nil, err
+	}
+
 	return &linkFieldDef{
-		BaseFieldDef: fields.NewBaseFieldDef(fieldDef.Name, fieldDef.Kind, fieldDef.DisplayId, false, fields.BaseIndexDef{
-			MultiValued: fieldDef.IndexDef.MultiValued,
-		}),
+		BaseFieldDef:       fields.NewBaseFieldDef(fieldDef.Name, fieldDef.Kind, fieldDef.DisplayId, false, indexDef),
 		relationType:       extLink.RelationType,
 		linkedTargetFields: extLink.LinkedTargetFields,
 	}, nil
@@ -79,6 +82,10 @@ func (kind *KindLink) MarshalToProtobufFormat(_ context.Context, fieldDef fields
 		if err != nil {
 			return nil, err
 		}
+		exts, err := fields.AppendValidationExt([]*anypb.Any{ext}, fieldDef)
+		if err != nil {
+			return nil, err
+		}
 
 		return &fieldUtils.FieldDef{
 			Name:      fieldDef.Name(),
@@ -86,7 +93,7 @@ func (kind *KindLink) MarshalToProtobufFormat(_ context.Context, fieldDef fields
 			DisplayId: fieldDef.DisplayID(),
 			IndexDef: &fieldUtils.IndexDef{
 				MultiValued: fieldDef.MultiValued(),
-				Ext:         []*anypb.Any{ext},
+				Ext:         exts,
 			},
 		}, nil
 	}
@@ -94,8 +101,8 @@ func (kind *KindLink) MarshalToProtobufFormat(_ context.Context, fieldDef fields
 	return nil, fmt.Errorf("field definition object is not a LinkFieldDef")
 }
 
-func (kind *KindLink) ValidateFieldValue(_ context.Context, _ fields.BaseFieldDef, _ string) error {
-	return nil
+func (kind *KindLink) ValidateFieldValue(_ context.Context, fieldDef fields.BaseFieldDef, fieldValue string) error {
+	return fieldDef.ValueRules().Check(fieldValue)
 }
 
 func (kind *KindLink) GenerateSolrFields(_ context.Context, fieldDef fields.BaseFieldDef) (solr.FieldCategoryToSolrFieldDefsMap, error) {
@@ -26,9 +26,12 @@ func (kind *KindNumber) ValidateDefinition(_ context.Context, request *fieldUtil
 		return nil, fmt.Errorf("kind is not %s: %s", KindName, request.Kind)
 	}
 
-	return fields.NewBaseFieldDef(request.Name, request.Kind, request.DisplayId, false, fields.BaseIndexDef{
-		MultiValued: request.IndexDef.MultiValued,
-	}), nil
+	indexDef, err := fields.ParseBaseIndexDef(request.IndexDef, fields.NumberRangeRule)
+	if err != nil {
+		return nil, err
+	}
+
+	return fields.NewBaseFieldDef(request.Name, request.Kind, request.DisplayId, false, indexDef), nil
 }
 
 func (kind *KindNumber) MustValidateDefinition(ctx context.Context, request *fieldUtils.FieldDef) fields.BaseFieldDef {
@@ -40,19 +43,32 @@ func (kind *KindNumber) MustValidateDefinition(ctx context.Context, request *fie
 }
 
 func (kind *KindNumber) MarshalToProtobufFormat(_ context.Context, fieldDef fields.BaseFieldDef) (*fieldUtils.FieldDef, error) {
+	ext, err := fields.AppendValidationExt(nil, fieldDef)
+	if err != nil {
+		return nil, err
+	}
+
 	return &fieldUtils.FieldDef{
 		Name:      fieldDef.Name(),
 		Kind:      fieldDef.Kind(),
 		DisplayId: fieldDef.DisplayID(),
 		IndexDef: &fieldUtils.IndexDef{
 			MultiValued: fieldDef.MultiValued(),
+			Ext:         ext,
 		},
 	}, nil
 }
 
-func (kind *KindNumber) ValidateFieldValue(_ context.Context, _ fields.BaseFieldDef, fieldValue string) error {
-	_, err := strconv.ParseFloat(fieldValue, 64)
-	return err
+func (kind *KindNumber) ValidateFieldValue(_ context.Context, fieldDef fields.BaseFieldDef, fieldValue string) error {
+	value, err := strconv.ParseFloat(fieldValue, 64)
+	if err != nil {
+		return fmt.Errorf("value is not a number")
+	}
+
+	if err := fieldDef.ValueRules().Check(fieldValue); err != nil {
+		return err
+	}
+	return fieldDef.ValueRules().CheckNumber(value)
 }
 
 func (kind *KindNumber) GenerateSolrFields(_ context.Context, fieldDef fields.BaseFieldDef) (solr.FieldCategoryToSolrFieldDefsMap, error) {
@@ -5,6 +5,9 @@ import (
 	"reflect"
 	"testing"
 
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
 	"github.com/d4l-data4life/mex/mex/shared/solr"
 
 	fieldUtils "github.com/d4l-data4life/mex/mex/shared/fields"
@@ -113,3 +116,37 @@ func Test_GenerateSolrFields(t *testing.T) {
 		})
 	}
 }
+
+func Test_ValidateFieldValue(t *testing.T) {
+	ext, err := anypb.New(&fieldUtils.IndexDefExtValidation{MinValue: proto.Float64(0), MaxValue: proto.Float64(100)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	kind := &KindNumber{}
+	fieldDef, err := kind.ValidateDefinition(context.TODO(), &fieldUtils.FieldDef{
+		Name:     "percentage",
+		Kind:     KindName,
+		IndexDef: &fieldUtils.IndexDef{Ext: []*anypb.Any{ext}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		value   string
+		wantErr bool
+	}{
+		{value: "0"},
+		{value: "99.5"},
+		{value: "-0.1", wantErr: true},
+		{value: "100.1", wantErr: true},
+		{value: "many", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if err := kind.ValidateFieldValue(context.TODO(), fieldDef, tt.value); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateFieldValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -26,9 +26,12 @@ func (kind *KindString) ValidateDefinition(_ context.Context, request *fieldUtil
 		return nil, fmt.Errorf("kind is not %s: %s", KindName, request.Kind)
 	}
 
-	return fields.NewBaseFieldDef(request.Name, request.Kind, request.DisplayId, false, fields.BaseIndexDef{
-		MultiValued: request.IndexDef.MultiValued,
-	}), nil
+	indexDef, err := fields.ParseBaseIndexDef(request.IndexDef)
+	if err != nil {
+		return nil, err
+	}
+
+	return fields.NewBaseFieldDef(request.Name, request.Kind, request.DisplayId, false, indexDef), nil
 }
 
 func (kind *KindString) MustValidateDefinition(ctx context.Context, request *fieldUtils.FieldDef) fields.BaseFieldDef {
@@ -40,18 +43,24 @@ func (kind *KindString) MustValidateDefinition(ctx context.Context, request *fie
 }
 
 func (kind *KindString) MarshalToProtobufFormat(_ context.Context, fieldDef fields.BaseFieldDef) (*fieldUtils.FieldDef, error) {
+	ext, err := fields.AppendValidationExt(nil, fieldDef)
+	if err != nil {
+		return nil, err
+	}
+
 	return &fieldUtils.FieldDef{
 		Name:      fieldDef.Name(),
 		Kind:      fieldDef.Kind(),
 		DisplayId: fieldDef.DisplayID(),
 		IndexDef: &fieldUtils.IndexDef{
 			MultiValued: fieldDef.MultiValued(),
+			Ext:         ext,
 		},
 	}, nil
 }
 
-func (kind *KindString) ValidateFieldValue(context.Context, fields.BaseFieldDef, string) error {
-	return nil
+func (kind *KindString) ValidateFieldValue(_ context.Context, fieldDef fields.BaseFieldDef, fieldValue string) error {
+	return fieldDef.ValueRules().Check(fieldValue)
 }
 
 func (kind *KindString) GenerateSolrFields(_ context.Context, fieldDef fields.BaseFieldDef) (solr.FieldCategoryToSolrFieldDefsMap, error) {
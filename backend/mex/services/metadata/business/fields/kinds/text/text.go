@@ -26,9 +26,12 @@ func (kind *KindText) ValidateDefinition(_ context.Context, request *fieldUtils.
 		return nil, fmt.Errorf("kind is not %s: %s", KindName, request.Kind)
 	}
 
-	return fields.NewBaseFieldDef(request.Name, request.Kind, request.DisplayId, false, fields.BaseIndexDef{
-		MultiValued: request.IndexDef.MultiValued,
-	}), nil
+	indexDef, err := fields.ParseBaseIndexDef(request.IndexDef)
+	if err != nil {
+		return nil, err
+	}
+
+	return fields.NewBaseFieldDef(request.Name, request.Kind, request.DisplayId, false, indexDef), nil
 }
 
 func (kind *KindText) MustValidateDefinition(ctx context.Context, request *fieldUtils.FieldDef) fields.BaseFieldDef {
@@ -40,18 +43,24 @@ func (kind *KindText) MustValidateDefinition(ctx context.Context, request *field
 }
 
 func (kind *KindText) MarshalToProtobufFormat(_ context.Context, fieldDef fields.BaseFieldDef) (*fieldUtils.FieldDef, error) {
+	ext, err := fields.AppendValidationExt(nil, fieldDef)
+	if err != nil {
+		return nil, err
+	}
+
 	return &fieldUtils.FieldDef{
 		Name:      fieldDef.Name(),
 		Kind:      fieldDef.Kind(),
 		DisplayId: fieldDef.DisplayID(),
 		IndexDef: &fieldUtils.IndexDef{
 			MultiValued: fieldDef.MultiValued(),
+			Ext:         ext,
 		},
 	}, nil
 }
 
-func (kind *KindText) ValidateFieldValue(_ context.Context, _ fields.BaseFieldDef, _ string) error {
-	return nil
+func (kind *KindText) ValidateFieldValue(_ context.Context, fieldDef fields.BaseFieldDef, fieldValue string) error {
+	return fieldDef.ValueRules().Check(fieldValue)
 }
 
 func (kind *KindText) GenerateSolrFields(_ context.Context, fieldDef fields.BaseFieldDef) (solr.FieldCategoryToSolrFieldDefsMap, error) {
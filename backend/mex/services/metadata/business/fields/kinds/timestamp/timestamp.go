@@ -14,6 +14,17 @@ import (
 
 const KindName = "timestamp"
 
+// Accepted timestamp layouts, from the most to the least precise
+var timestampLayouts = []struct {
+	layout    string
+	precision string
+}{
+	{layout: "2006-01-02T15:04:05Z", precision: fields.DatePrecisionSecond},
+	{layout: "2006-01-02", precision: fields.DatePrecisionDay},
+	{layout: "2006-01", precision: fields.DatePrecisionMonth},
+	{layout: "2006", precision: fields.DatePrecisionYear},
+}
+
 type KindTimestamp struct{}
 
 func (kind *KindTimestamp) ValidateDefinition(_ context.Context, request *fieldUtils.FieldDef) (fields.BaseFieldDef, error) {
@@ -26,9 +37,12 @@ func (kind *KindTimestamp) ValidateDefinition(_ context.Context, request *fieldU
 		return nil, fmt.Errorf("kind is not %s: %s", KindName, request.Kind)
 	}
 
-	return fields.NewBaseFieldDef(request.Name, request.Kind, request.DisplayId, false, fields.BaseIndexDef{
-		MultiValued: request.IndexDef.MultiValued,
-	}), nil
+	indexDef, err := fields.ParseBaseIndexDef(request.IndexDef, fields.DatePrecisionRule)
+	if err != nil {
+		return nil, err
+	}
+
+	return fields.NewBaseFieldDef(request.Name, request.Kind, request.DisplayId, false, indexDef), nil
 }
 
 func (kind *KindTimestamp) MustValidateDefinition(ctx context.Context, request *fieldUtils.FieldDef) fields.BaseFieldDef {
@@ -40,18 +54,32 @@ func (kind *KindTimestamp) MustValidateDefinition(ctx context.Context, request *
 }
 
 func (kind *KindTimestamp) MarshalToProtobufFormat(_ context.Context, fieldDef fields.BaseFieldDef) (*fieldUtils.FieldDef, error) {
+	ext, err := fields.AppendValidationExt(nil, fieldDef)
+	if err != nil {
+		return nil, err
+	}
+
 	return &fieldUtils.FieldDef{
 		Name:      fieldDef.Name(),
 		Kind:      fieldDef.Kind(),
 		DisplayId: fieldDef.DisplayID(),
 		IndexDef: &fieldUtils.IndexDef{
 			MultiValued: fieldDef.MultiValued(),
+			Ext:         ext,
 		},
 	}, nil
 }
 
-func (kind *KindTimestamp) ValidateFieldValue(_ context.Context, _ fields.BaseFieldDef, _ string) error {
-	return nil
+func (kind *KindTimestamp) ValidateFieldValue(_ context.Context, fieldDef fields.BaseFieldDef, fieldValue string) error {
+	_, precision, err := parseTimestamp(fieldValue)
+	if err != nil {
+		return err
+	}
+
+	if err := fieldDef.ValueRules().Check(fieldValue); err != nil {
+		return err
+	}
+	return fieldDef.ValueRules().CheckDatePrecision(precision)
 }
 
 func (kind *KindTimestamp) GenerateSolrFields(_ context.Context, fieldDef fields.BaseFieldDef) (solr.FieldCategoryToSolrFieldDefsMap, error) {
@@ -66,19 +94,9 @@ func (kind *KindTimestamp) GenerateSolrFields(_ context.Context, fieldDef fields
 }
 
 func (*KindTimestamp) GenerateXMLFieldTags(_ context.Context, _ fields.BaseFieldDef, itemValue datamodel.CurrentItemValue) ([]string, error) {
-	// Parse timestamp string, allowing for different levels of precision
-	t, err := time.Parse("2006-01-02T15:04:05Z", itemValue.FieldValue)
+	t, _, err := parseTimestamp(itemValue.FieldValue)
 	if err != nil {
-		t, err = time.Parse("2006-01-02", itemValue.FieldValue)
-		if err != nil {
-			t, err = time.Parse("2006-01", itemValue.FieldValue)
-			if err != nil {
-				t, err = time.Parse("2006", itemValue.FieldValue)
-				if err != nil {
-					return nil, err
-				}
-			}
-		}
+		return nil, err
 	}
 
 	return []string{
@@ -93,3 +111,13 @@ func (kind *KindTimestamp) EnrichFacetBucket(_ context.Context, bucket *solr.Fac
 }
 
 func (kind *KindTimestamp) ResetCaches() {}
+
+// parseTimestamp parses a timestamp string, allowing for different levels of precision.
+func parseTimestamp(value string) (time.Time, string, error) {
+	for _, layout := range timestampLayouts {
+		if t, err := time.Parse(layout.layout, value); err == nil {
+			return t, layout.precision, nil
+		}
+	}
+	return time.Time{}, "", fmt.Errorf("value is not a timestamp (expected YYYY, YYYY-MM, YYYY-MM-DD or YYYY-MM-DDThh:mm:ssZ)")
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/types/known/anypb"
 
 	fieldUtils "github.com/d4l-data4life/mex/mex/shared/fields"
 	"github.com/d4l-data4life/mex/mex/shared/solr"
@@ -229,3 +230,46 @@ func Test_GenerateXMLFieldTags(t *testing.T) {
 		})
 	}
 }
+
+func Test_ValidateFieldValue(t *testing.T) {
+	ext, err := anypb.New(&fieldUtils.IndexDefExtValidation{DatePrecisions: []string{fields.DatePrecisionDay, fields.DatePrecisionSecond}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	kind := &KindTimestamp{}
+	fieldDef, err := kind.ValidateDefinition(context.TODO(), &fieldUtils.FieldDef{
+		Name:     "created",
+		Kind:     KindName,
+		IndexDef: &fieldUtils.IndexDef{Ext: []*anypb.Any{ext}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		value   string
+		wantErr bool
+	}{
+		{value: "2021-03-04"},
+		{value: "2021-03-04T05:06:07Z"},
+		{value: "2021", wantErr: true},
+		{value: "2021-13-01", wantErr: true},
+		{value: "yesterday", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if err := kind.ValidateFieldValue(context.TODO(), fieldDef, tt.value); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateFieldValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	// Without rules, all precisions are accepted, but invalid timestamps are still rejected.
+	fieldDef = kind.MustValidateDefinition(context.TODO(), &fieldUtils.FieldDef{Name: "created", Kind: KindName, IndexDef: &fieldUtils.IndexDef{}})
+	if err := kind.ValidateFieldValue(context.TODO(), fieldDef, "2021"); err != nil {
+		t.Errorf("ValidateFieldValue() error = %v", err)
+	}
+	if err := kind.ValidateFieldValue(context.TODO(), fieldDef, "2021-02-30"); err == nil {
+		t.Errorf("ValidateFieldValue() of invalid date did not fail")
+	}
+}
@@ -0,0 +1,212 @@
+package fields
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/d4l-data4life/mex/mex/shared/fields"
+	"github.com/d4l-data4life/mex/mex/shared/solr"
+	"github.com/d4l-data4life/mex/mex/shared/utils"
+)
+
+// Precisions of timestamp values
+const (
+	DatePrecisionYear   = "year"
+	DatePrecisionMonth  = "month"
+	DatePrecisionDay    = "day"
+	DatePrecisionSecond = "second"
+)
+
+var DatePrecisions = []string{DatePrecisionYear, DatePrecisionMonth, DatePrecisionDay, DatePrecisionSecond}
+
+// KindRule is a validation rule which only makes sense for some kinds of fields.
+type KindRule int
+
+const (
+	NumberRangeRule KindRule = iota
+	DatePrecisionRule
+)
+
+// ValueRules are the validation rules of a field (see IndexDefExtValidation), ready to be applied to values.
+// All methods can be called on nil, which stands for no rules.
+type ValueRules struct {
+	ext      *fields.IndexDefExtValidation
+	patterns []*regexp.Regexp
+}
+
+// NewValueRules checks the given validation extension and compiles its patterns. Rules for number ranges and date
+// precisions are only accepted if they are listed in kindRules.
+func NewValueRules(ext *fields.IndexDefExtValidation, kindRules ...KindRule) (*ValueRules, error) {
+	rules := &ValueRules{ext: ext}
+
+	for _, pattern := range ext.Patterns {
+		// The pattern has to match the whole value, not just a part of it.
+		re, err := regexp.Compile("^(?:" + pattern + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern '%s': %s", pattern, err.Error())
+		}
+		rules.patterns = append(rules.patterns, re)
+	}
+
+	if ext.MaxLength > 0 && ext.MinLength > ext.MaxLength {
+		return nil, fmt.Errorf("minimum length %d is greater than maximum length %d", ext.MinLength, ext.MaxLength)
+	}
+
+	if ext.MinValue != nil || ext.MaxValue != nil {
+		if !utils.Contains(kindRules, NumberRangeRule) {
+			return nil, fmt.Errorf("value ranges are only supported for number fields")
+		}
+		if ext.MinValue != nil && ext.MaxValue != nil && *ext.MinValue > *ext.MaxValue {
+			return nil, fmt.Errorf("minimum value %v is greater than maximum value %v", *ext.MinValue, *ext.MaxValue)
+		}
+	}
+
+	if len(ext.DatePrecisions) > 0 {
+		if !utils.Contains(kindRules, DatePrecisionRule) {
+			return nil, fmt.Errorf("date precisions are only supported for timestamp fields")
+		}
+		for _, precision := range ext.DatePrecisions {
+			if !utils.Contains(DatePrecisions, precision) {
+				return nil, fmt.Errorf("unknown date precision '%s' (known: %s)", precision, strings.Join(DatePrecisions, ", "))
+			}
+		}
+	}
+
+	return rules, nil
+}
+
+// Ext returns the validation extension the rules were created from (nil if there are no rules).
+func (rules *ValueRules) Ext() *fields.IndexDefExtValidation {
+	if rules == nil {
+		return nil
+	}
+	return rules.ext
+}
+
+// Check applies the rules for patterns, allowed values and lengths, which apply to values of all kinds.
+func (rules *ValueRules) Check(value string) error {
+	if rules == nil {
+		return nil
+	}
+
+	var problems []string
+	for i, re := range rules.patterns {
+		if !re.MatchString(value) {
+			problems = append(problems, fmt.Sprintf("value does not match pattern '%s'", rules.ext.Patterns[i]))
+		}
+	}
+	if len(rules.ext.AllowedValues) > 0 && !utils.Contains(rules.ext.AllowedValues, value) {
+		problems = append(problems, fmt.Sprintf("value is not one of the allowed values: %s", strings.Join(rules.ext.AllowedValues, ", ")))
+	}
+
+	length := uint32(utf8.RuneCountInString(value))
+	if length < rules.ext.MinLength {
+		problems = append(problems, fmt.Sprintf("value has %d characters, at least %d required", length, rules.ext.MinLength))
+	}
+	if rules.ext.MaxLength > 0 && length > rules.ext.MaxLength {
+		problems = append(problems, fmt.Sprintf("value has %d characters, at most %d allowed", length, rules.ext.MaxLength))
+	}
+
+	return joinProblems(problems)
+}
+
+// CheckNumber applies the rules for number ranges.
+func (rules *ValueRules) CheckNumber(value float64) error {
+	if rules == nil {
+		return nil
+	}
+
+	var problems []string
+	if rules.ext.MinValue != nil && value < *rules.ext.MinValue {
+		problems = append(problems, fmt.Sprintf("value %s is less than the minimum %s", formatNumber(value), formatNumber(*rules.ext.MinValue)))
+	}
+	if rules.ext.MaxValue != nil && value > *rules.ext.MaxValue {
+		problems = append(problems, fmt.Sprintf("value %s is greater than the maximum %s", formatNumber(value), formatNumber(*rules.ext.MaxValue)))
+	}
+	return joinProblems(problems)
+}
+
+// CheckDatePrecision applies the rules for the precision of timestamps.
+func (rules *ValueRules) CheckDatePrecision(precision string) error {
+	if rules == nil || len(rules.ext.DatePrecisions) == 0 {
+		return nil
+	}
+	if !utils.Contains(rules.ext.DatePrecisions, precision) {
+		return fmt.Errorf("precision '%s' is not accepted (accepted: %s)", precision, strings.Join(rules.ext.DatePrecisions, ", "))
+	}
+	return nil
+}
+
+// RequiredFor returns true if items of the given entity type must have a value for the field.
+func (rules *ValueRules) RequiredFor(entityType string) bool {
+	if rules == nil {
+		return false
+	}
+	return utils.Contains(rules.ext.RequiredForEntityTypes, entityType)
+}
+
+func joinProblems(problems []string) error {
+	if len(problems) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s", strings.Join(problems, "; "))
+}
+
+func formatNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// GetValidationExt returns the validation extension in a given field definition (nil if there is none).
+func GetValidationExt(indexDef *fields.IndexDef) (*fields.IndexDefExtValidation, error) {
+	for _, ext := range indexDef.GetExt() {
+		if ext.MessageName() == solr.ValidationExtID {
+			var validationExt fields.IndexDefExtValidation
+			err := ext.UnmarshalTo(&validationExt)
+			if err != nil {
+				return nil, err
+			}
+			return &validationExt, nil
+		}
+	}
+	return nil, nil
+}
+
+// ParseBaseIndexDef reads the parts of an index definition which are common to all kinds, including the validation
+// rules. Rules for number ranges and date precisions are only accepted if they are listed in kindRules.
+func ParseBaseIndexDef(indexDef *fields.IndexDef, kindRules ...KindRule) (BaseIndexDef, error) {
+	baseIndexDef := BaseIndexDef{
+		MultiValued: indexDef.GetMultiValued(),
+	}
+
+	validationExt, err := GetValidationExt(indexDef)
+	if err != nil {
+		return BaseIndexDef{}, fmt.Errorf("malformed validation extension: %s", err.Error())
+	}
+	if validationExt != nil {
+		baseIndexDef.ValueRules, err = NewValueRules(validationExt, kindRules...)
+		if err != nil {
+			return BaseIndexDef{}, fmt.Errorf("invalid validation rules: %s", err.Error())
+		}
+	}
+
+	return baseIndexDef, nil
+}
+
+// AppendValidationExt appends the validation extension of the field definition (if any) to the given extensions.
+func AppendValidationExt(exts []*anypb.Any, fieldDef BaseFieldDef) ([]*anypb.Any, error) {
+	validationExt := fieldDef.ValueRules().Ext()
+	if validationExt == nil {
+		return exts, nil
+	}
+
+	ext, err := anypb.New(validationExt)
+	if err != nil {
+		return nil, err
+	}
+	return append(exts, ext), nil
+}
@@ -0,0 +1,186 @@
+package fields
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/d4l-data4life/mex/mex/shared/fields"
+)
+
+func TestNewValueRules(t *testing.T) {
+	tests := []struct {
+		name      string
+		ext       *fields.IndexDefExtValidation
+		kindRules []KindRule
+		wantErr   bool
+	}{
+		{
+			name: "Valid generic rules",
+			ext: &fields.IndexDefExtValidation{
+				Patterns:               []string{"[A-Z]+-[0-9]+"},
+				AllowedValues:          []string{"ABC-1", "ABC-2"},
+				MinLength:              3,
+				MaxLength:              10,
+				RequiredForEntityTypes: []string{"Resource"},
+			},
+		},
+		{
+			name:    "Invalid pattern",
+			ext:     &fields.IndexDefExtValidation{Patterns: []string{"("}},
+			wantErr: true,
+		},
+		{
+			name:    "Minimum length greater than maximum length",
+			ext:     &fields.IndexDefExtValidation{MinLength: 5, MaxLength: 4},
+			wantErr: true,
+		},
+		{
+			name:      "Value range for number field",
+			ext:       &fields.IndexDefExtValidation{MinValue: proto.Float64(0), MaxValue: proto.Float64(1)},
+			kindRules: []KindRule{NumberRangeRule},
+		},
+		{
+			name:    "Value range for other field",
+			ext:     &fields.IndexDefExtValidation{MinValue: proto.Float64(0)},
+			wantErr: true,
+		},
+		{
+			name:      "Minimum value greater than maximum value",
+			ext:       &fields.IndexDefExtValidation{MinValue: proto.Float64(1), MaxValue: proto.Float64(0)},
+			kindRules: []KindRule{NumberRangeRule},
+			wantErr:   true,
+		},
+		{
+			name:      "Date precisions for timestamp field",
+			ext:       &fields.IndexDefExtValidation{DatePrecisions: []string{DatePrecisionDay, DatePrecisionSecond}},
+			kindRules: []KindRule{DatePrecisionRule},
+		},
+		{
+			name:      "Unknown date precision",
+			ext:       &fields.IndexDefExtValidation{DatePrecisions: []string{"week"}},
+			kindRules: []KindRule{DatePrecisionRule},
+			wantErr:   true,
+		},
+		{
+			name:      "Date precisions for other field",
+			ext:       &fields.IndexDefExtValidation{DatePrecisions: []string{DatePrecisionDay}},
+			kindRules: []KindRule{NumberRangeRule},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewValueRules(tt.ext, tt.kindRules...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewValueRules() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValueRules_Check(t *testing.T) {
+	rules, err := NewValueRules(&fields.IndexDefExtValidation{
+		Patterns:  []string{`\p{Ll}+`},
+		MinLength: 2,
+		MaxLength: 4,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		value   string
+		wantErr string
+	}{
+		{value: "abc"},
+		{value: "äöü"},
+		{value: "abc1", wantErr: "value does not match pattern '\\p{Ll}+'"},
+		{value: "a", wantErr: "value has 1 characters, at least 2 required"},
+		{value: "ABCDE", wantErr: "value does not match pattern '\\p{Ll}+'; value has 5 characters, at most 4 allowed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			err := rules.Check(tt.value)
+			if tt.wantErr == "" && err != nil {
+				t.Errorf("Check() error = %v, want none", err)
+			}
+			if tt.wantErr != "" && (err == nil || err.Error() != tt.wantErr) {
+				t.Errorf("Check() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	allowed, err := NewValueRules(&fields.IndexDefExtValidation{AllowedValues: []string{"open", "closed"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := allowed.Check("closed"); err != nil {
+		t.Errorf("Check() of allowed value error = %v", err)
+	}
+	if err := allowed.Check("ajar"); err == nil || err.Error() != "value is not one of the allowed values: open, closed" {
+		t.Errorf("Check() of other value error = %v", err)
+	}
+
+	var noRules *ValueRules
+	if err := noRules.Check("anything"); err != nil {
+		t.Errorf("Check() without rules error = %v", err)
+	}
+}
+
+func TestValueRules_CheckNumber(t *testing.T) {
+	rules, err := NewValueRules(&fields.IndexDefExtValidation{MinValue: proto.Float64(0), MaxValue: proto.Float64(1.5)}, NumberRangeRule)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := rules.CheckNumber(1.5); err != nil {
+		t.Errorf("CheckNumber() error = %v", err)
+	}
+	if err := rules.CheckNumber(-1); err == nil || err.Error() != "value -1 is less than the minimum 0" {
+		t.Errorf("CheckNumber() error = %v", err)
+	}
+	if err := rules.CheckNumber(2); err == nil || err.Error() != "value 2 is greater than the maximum 1.5" {
+		t.Errorf("CheckNumber() error = %v", err)
+	}
+}
+
+func TestParseBaseIndexDef(t *testing.T) {
+	ext, err := anypb.New(&fields.IndexDefExtValidation{RequiredForEntityTypes: []string{"Resource"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	linkExt, err := anypb.New(&fields.IndexDefExtLink{RelationType: "contact"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	indexDef, err := ParseBaseIndexDef(&fields.IndexDef{MultiValued: true, Ext: []*anypb.Any{linkExt, ext}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !indexDef.MultiValued {
+		t.Errorf("ParseBaseIndexDef() multi-valued = false, want true")
+	}
+	if !indexDef.ValueRules.RequiredFor("Resource") || indexDef.ValueRules.RequiredFor("Person") {
+		t.Errorf("ParseBaseIndexDef() rules = %v, want required for Resource only", indexDef.ValueRules.Ext())
+	}
+
+	fieldDef := NewBaseFieldDef("title", "string", "", false, indexDef)
+	exts, err := AppendValidationExt(nil, fieldDef)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(exts) != 1 || !proto.Equal(exts[0], ext) {
+		t.Errorf("AppendValidationExt() = %v, want %v", exts, ext)
+	}
+
+	indexDef, err = ParseBaseIndexDef(&fields.IndexDef{Ext: []*anypb.Any{linkExt}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if indexDef.ValueRules != nil {
+		t.Errorf("ParseBaseIndexDef() rules = %v, want none", indexDef.ValueRules.Ext())
+	}
+}
@@ -0,0 +1,65 @@
+package vrepo
+
+import (
+	"context"
+	"sync"
+
+	sharedFields "github.com/d4l-data4life/mex/mex/shared/fields"
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/fields"
+)
+
+type vocabularyRepoCached struct {
+	mu sync.RWMutex
+
+	log      L.Logger
+	delegate fields.VocabularyRepo
+
+	cachedMapping *sharedFields.VocabularyMapping
+}
+
+type NewCachedVocabularyRepoParams struct {
+	Log      L.Logger
+	Delegate fields.VocabularyRepo
+}
+
+func NewCachedVocabularyRepo(_ context.Context, params NewCachedVocabularyRepoParams) fields.VocabularyRepo {
+	return &vocabularyRepoCached{
+		log:      params.Log,
+		delegate: params.Delegate,
+	}
+}
+
+func (repo *vocabularyRepoCached) GetVocabularyMapping(ctx context.Context) (*sharedFields.VocabularyMapping, error) {
+	repo.mu.RLock()
+	mapping := repo.cachedMapping
+	repo.mu.RUnlock()
+	if mapping != nil {
+		return mapping, nil
+	}
+
+	return repo.loadFromDelegate(ctx)
+}
+
+// loadFromDelegate loads the mapping from another vocabulary repo. A missing mapping is not cached so that it is
+// picked up as soon as it is added to the config.
+func (repo *vocabularyRepoCached) loadFromDelegate(ctx context.Context) (*sharedFields.VocabularyMapping, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	mapping, err := repo.delegate.GetVocabularyMapping(ctx)
+	if err != nil {
+		repo.cachedMapping = nil
+		return nil, err
+	}
+
+	repo.cachedMapping = mapping
+	repo.log.Info(ctx, L.Messagef("refreshed vocabulary mapping cache, now contains %d field mappings", len(mapping.Fields)))
+	return mapping, nil
+}
+
+func (repo *vocabularyRepoCached) Purge(ctx context.Context) error {
+	_, err := repo.loadFromDelegate(ctx)
+	return err
+}
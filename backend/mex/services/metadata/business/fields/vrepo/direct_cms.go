@@ -0,0 +1,65 @@
+package vrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	sharedFields "github.com/d4l-data4life/mex/mex/shared/fields"
+
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/fields"
+)
+
+const apiPath = "/api/v0/config/files"
+
+// ErrNoVocabularyMapping is returned if the config does not contain a vocabulary mapping.
+var ErrNoVocabularyMapping = errors.New("no vocabulary mapping configured")
+
+type vocabularyRepoDirectCMS struct {
+	originCMS           string
+	strictConfigParsing bool
+}
+
+func NewDirectCMSVocabularyRepo(originCMS string, strictConfigParsing bool) fields.VocabularyRepo {
+	return &vocabularyRepoDirectCMS{
+		originCMS:           originCMS,
+		strictConfigParsing: strictConfigParsing,
+	}
+}
+
+// GetVocabularyMapping fetches the vocabulary mapping stored next to the field definitions in the config
+func (repo *vocabularyRepoDirectCMS) GetVocabularyMapping(_ context.Context) (*sharedFields.VocabularyMapping, error) {
+	client := &http.Client{}
+	resp, err := client.Get(fmt.Sprintf("%s%s/field_defs/vocabulary.json", repo.originCMS, apiPath))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNoVocabularyMapping
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not fetch vocabulary mapping from CMS - got response status code %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	mapping := sharedFields.VocabularyMapping{}
+	discardUnknown := !repo.strictConfigParsing
+	err = protojson.UnmarshalOptions{DiscardUnknown: discardUnknown}.Unmarshal(data, &mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mapping, nil
+}
+
+func (repo *vocabularyRepoDirectCMS) Purge(_ context.Context) error { return nil }
@@ -0,0 +1,86 @@
+package importer
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/d4l-data4life/mex/mex/shared/items"
+)
+
+const FormatCSV = "csv"
+
+/*
+CSVImporter creates one item per row. The first row holds the column names, which are the sources of the mappings.
+Empty cells are skipped; with a value separator, a cell can hold several values of a field.
+*/
+type CSVImporter struct{}
+
+func (importer *CSVImporter) Import(_ context.Context, data []byte, options Options) ([]*items.Item, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	if options.Delimiter != "" {
+		delimiter, size := utf8.DecodeRuneInString(options.Delimiter)
+		if size != len(options.Delimiter) {
+			return nil, fmt.Errorf("CSV delimiter must be a single character: %s", options.Delimiter)
+		}
+		reader.Comma = delimiter
+	}
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("CSV data is empty")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %s", err.Error())
+	}
+	columns := make(map[string]bool)
+	for _, name := range header {
+		columns[strings.TrimSpace(name)] = true
+	}
+	for _, m := range options.Mappings {
+		if !columns[m.Source] {
+			return nil, fmt.Errorf("column not found in CSV header: %s", m.Source)
+		}
+	}
+
+	mapper := newValueMapper(options.Mappings)
+	var result []*items.Item
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV data: %s", err.Error())
+		}
+
+		item := &items.Item{EntityType: options.EntityType}
+		for i, cell := range row {
+			for _, value := range splitCell(cell, options.ValueSeparator) {
+				mapper.appendValues(item, strings.TrimSpace(header[i]), value, "")
+			}
+		}
+		result = append(result, item)
+	}
+
+	return result, nil
+}
+
+func splitCell(cell string, separator string) []string {
+	parts := []string{cell}
+	if separator != "" {
+		parts = strings.Split(cell, separator)
+	}
+
+	values := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if v := strings.TrimSpace(p); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
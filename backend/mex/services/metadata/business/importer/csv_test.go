@@ -0,0 +1,81 @@
+package importer
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/d4l-data4life/mex/mex/shared/items"
+)
+
+func TestCSVImporter_Import(t *testing.T) {
+	mappings := []Mapping{
+		{Source: "Title", FieldName: "title", Language: "en"},
+		{Source: "Keywords", FieldName: "keyword"},
+		{Source: "Keywords", FieldName: "theme"},
+	}
+
+	tests := []struct {
+		name    string
+		data    string
+		options Options
+		want    []*items.Item
+		wantErr bool
+	}{
+		{
+			name:    "Mapped columns, empty cells and unmapped columns are skipped",
+			data:    "Title,Internal,Keywords\nNumbers,x,math\n,y,\n",
+			options: Options{EntityType: "Resource", Mappings: mappings},
+			want: []*items.Item{
+				{EntityType: "Resource", Values: []*items.ItemValue{
+					{FieldName: "title", FieldValue: "Numbers", Language: "en"},
+					{FieldName: "keyword", FieldValue: "math"},
+					{FieldName: "theme", FieldValue: "math"},
+				}},
+				{EntityType: "Resource"},
+			},
+		},
+		{
+			name:    "Delimiter and value separator",
+			data:    "Title;Keywords\nNumbers;math| logic |\n",
+			options: Options{EntityType: "Resource", Mappings: mappings[:2], Delimiter: ";", ValueSeparator: "|"},
+			want: []*items.Item{
+				{EntityType: "Resource", Values: []*items.ItemValue{
+					{FieldName: "title", FieldValue: "Numbers", Language: "en"},
+					{FieldName: "keyword", FieldValue: "math"},
+					{FieldName: "keyword", FieldValue: "logic"},
+				}},
+			},
+		},
+		{
+			name:    "Mapped column missing",
+			data:    "Title\nNumbers\n",
+			options: Options{EntityType: "Resource", Mappings: mappings},
+			wantErr: true,
+		},
+		{
+			name:    "Invalid delimiter",
+			data:    "Title\nNumbers\n",
+			options: Options{EntityType: "Resource", Mappings: mappings[:1], Delimiter: "::"},
+			wantErr: true,
+		},
+		{
+			name:    "Rows of different length",
+			data:    "Title,Keywords\nNumbers\n",
+			options: Options{EntityType: "Resource", Mappings: mappings},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := (&CSVImporter{}).Import(context.Background(), []byte(tt.data), tt.options)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Import() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Import() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
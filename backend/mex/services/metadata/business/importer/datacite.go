@@ -0,0 +1,36 @@
+package importer
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/d4l-data4life/mex/mex/shared/items"
+)
+
+const (
+	FormatDataCite = "datacite"
+
+	// The namespaces of the DataCite metadata schema versions start with this prefix, followed by the major version.
+	dataCiteNamespacePrefix = "http://datacite.org/schema/kernel-"
+)
+
+/*
+DataCiteImporter creates one item per DataCite resource element. Sources are element paths below the resource element,
+e.g. titles/title or creators/creator/creatorName.
+*/
+type DataCiteImporter struct{}
+
+func (importer *DataCiteImporter) Import(_ context.Context, data []byte, options Options) ([]*items.Item, error) {
+	result, err := importXMLRecords(data, func(name xml.Name) bool {
+		return name.Local == "resource" && strings.HasPrefix(name.Space, dataCiteNamespacePrefix)
+	}, options)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("no DataCite resources found")
+	}
+	return result, nil
+}
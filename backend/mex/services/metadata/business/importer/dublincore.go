@@ -0,0 +1,34 @@
+package importer
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+
+	"github.com/d4l-data4life/mex/mex/shared/items"
+)
+
+const (
+	FormatDublinCore = "dublin_core"
+
+	namespaceOAIDC = "http://www.openarchives.org/OAI/2.0/oai_dc/"
+)
+
+/*
+DublinCoreImporter creates one item per oai_dc:dc element, as found in OAI-PMH responses. Sources are the names of the
+Dublin Core elements, e.g. title or creator.
+*/
+type DublinCoreImporter struct{}
+
+func (importer *DublinCoreImporter) Import(_ context.Context, data []byte, options Options) ([]*items.Item, error) {
+	result, err := importXMLRecords(data, func(name xml.Name) bool {
+		return name.Local == "dc" && name.Space == namespaceOAIDC
+	}, options)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("no Dublin Core records found")
+	}
+	return result, nil
+}
@@ -0,0 +1,97 @@
+package importer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/d4l-data4life/mex/mex/shared/entities"
+	"github.com/d4l-data4life/mex/mex/shared/items"
+
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/fields"
+)
+
+// Mapping maps the values of a source element (a CSV column or an XML element path) onto a field.
+type Mapping struct {
+	Source    string
+	FieldName string
+	// Language of the values which do not carry a language themselves
+	Language string
+}
+
+type Options struct {
+	EntityType string
+	Mappings   []Mapping
+
+	// CSV only: the column delimiter (default: comma) and the separator of multiple values within a cell (default: none)
+	Delimiter      string
+	ValueSeparator string
+}
+
+// Importer converts source data in a specific format into items of the entity type given in the options.
+type Importer interface {
+	Import(ctx context.Context, data []byte, options Options) ([]*items.Item, error)
+}
+
+type Importers map[string]Importer
+
+func NewImporters() Importers {
+	importers := make(Importers)
+
+	importers[FormatCSV] = &CSVImporter{}
+	importers[FormatDataCite] = &DataCiteImporter{}
+	importers[FormatDublinCore] = &DublinCoreImporter{}
+
+	return importers
+}
+
+func (importers Importers) GetImporter(format string) Importer {
+	return importers[format]
+}
+
+// CheckOptions verifies that the entity type and all fields mapped onto exist in the current configuration.
+func CheckOptions(ctx context.Context, fieldRepo fields.FieldRepo, entityRepo entities.EntityRepo, options Options) error {
+	if options.EntityType == "" {
+		return fmt.Errorf("no entity type given")
+	}
+	if _, err := entityRepo.GetEntityType(ctx, options.EntityType); err != nil {
+		return fmt.Errorf("unknown entity type: %s", options.EntityType)
+	}
+
+	if len(options.Mappings) == 0 {
+		return fmt.Errorf("no field mappings given")
+	}
+	for _, m := range options.Mappings {
+		if m.Source == "" {
+			return fmt.Errorf("no source given for field: %s", m.FieldName)
+		}
+		if _, err := fieldRepo.GetFieldDefByName(ctx, m.FieldName); err != nil {
+			return fmt.Errorf("unknown field: %s", m.FieldName)
+		}
+	}
+	return nil
+}
+
+// A valueMapper turns source values into item values, according to the mappings of the sources.
+type valueMapper map[string][]Mapping
+
+func newValueMapper(mappings []Mapping) valueMapper {
+	mapper := make(valueMapper)
+	for _, m := range mappings {
+		mapper[m.Source] = append(mapper[m.Source], m)
+	}
+	return mapper
+}
+
+func (mapper valueMapper) appendValues(item *items.Item, source string, value string, language string) {
+	for _, m := range mapper[source] {
+		lang := language
+		if lang == "" {
+			lang = m.Language
+		}
+		item.Values = append(item.Values, &items.ItemValue{
+			FieldName:  m.FieldName,
+			FieldValue: value,
+			Language:   lang,
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package importer
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/d4l-data4life/mex/mex/shared/items"
+)
+
+const xmlNamespace = "http://www.w3.org/XML/1998/namespace"
+
+type xmlElement struct {
+	name        string
+	language    string
+	text        strings.Builder
+	hasChildren bool
+}
+
+/*
+importXMLRecords creates one item per record element, i.e. per element for which isRecord holds. Records may be
+embedded into other elements, for example into an OAI-PMH response. The sources of the values are the paths of the
+leaf elements below the record element, made of the local element names separated by slashes (e.g. titles/title).
+The xml:lang attribute of an element is used as the language of its value.
+*/
+func importXMLRecords(data []byte, isRecord func(name xml.Name) bool, options Options) ([]*items.Item, error) {
+	mapper := newValueMapper(options.Mappings)
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+
+	var result []*items.Item
+	var item *items.Item
+	var stack []*xmlElement
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse XML: %s", err.Error())
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if item == nil {
+				if isRecord(t.Name) {
+					item = &items.Item{EntityType: options.EntityType}
+					stack = stack[:0]
+				}
+				continue
+			}
+			if len(stack) > 0 {
+				stack[len(stack)-1].hasChildren = true
+			}
+			element := &xmlElement{name: t.Name.Local}
+			for _, attr := range t.Attr {
+				if attr.Name.Space == xmlNamespace && attr.Name.Local == "lang" {
+					element.language = attr.Value
+				}
+			}
+			stack = append(stack, element)
+
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text.Write(t)
+			}
+
+		case xml.EndElement:
+			if item == nil {
+				continue
+			}
+			if len(stack) == 0 {
+				// End of the record element
+				result = append(result, item)
+				item = nil
+				continue
+			}
+
+			element := stack[len(stack)-1]
+			if value := strings.TrimSpace(element.text.String()); value != "" && !element.hasChildren {
+				mapper.appendValues(item, elementPath(stack), value, element.language)
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	return result, nil
+}
+
+func elementPath(stack []*xmlElement) string {
+	names := make([]string, len(stack))
+	for i, element := range stack {
+		names[i] = element.name
+	}
+	return strings.Join(names, "/")
+}
@@ -0,0 +1,124 @@
+package importer
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/d4l-data4life/mex/mex/shared/items"
+)
+
+const testDataCite = `<?xml version="1.0" encoding="UTF-8"?>
+<resource xmlns="http://datacite.org/schema/kernel-4">
+  <identifier identifierType="DOI">10.1234/abc</identifier>
+  <creators>
+    <creator><creatorName>Turing, Alan</creatorName></creator>
+    <creator><creatorName>Church, Alonzo</creatorName></creator>
+  </creators>
+  <titles>
+    <title xml:lang="en">On Computable Numbers</title>
+    <title xml:lang="de">Über berechenbare Zahlen</title>
+  </titles>
+  <publicationYear>1936</publicationYear>
+</resource>`
+
+const testOaiDC = `<?xml version="1.0" encoding="UTF-8"?>
+<OAI-PMH xmlns="http://www.openarchives.org/OAI/2.0/">
+  <ListRecords>
+    <record>
+      <header><identifier>oai:x:1</identifier></header>
+      <metadata>
+        <oai_dc:dc xmlns:oai_dc="http://www.openarchives.org/OAI/2.0/oai_dc/" xmlns:dc="http://purl.org/dc/elements/1.1/">
+          <dc:title>Numbers</dc:title>
+          <dc:subject>math</dc:subject>
+        </oai_dc:dc>
+      </metadata>
+    </record>
+    <record>
+      <header status="deleted"><identifier>oai:x:2</identifier></header>
+    </record>
+    <record>
+      <header><identifier>oai:x:3</identifier></header>
+      <metadata>
+        <oai_dc:dc xmlns:oai_dc="http://www.openarchives.org/OAI/2.0/oai_dc/" xmlns:dc="http://purl.org/dc/elements/1.1/">
+          <dc:title xml:lang="de">Zahlen</dc:title>
+        </oai_dc:dc>
+      </metadata>
+    </record>
+  </ListRecords>
+</OAI-PMH>`
+
+func TestXMLImporters(t *testing.T) {
+	tests := []struct {
+		name     string
+		importer Importer
+		data     string
+		options  Options
+		want     []*items.Item
+		wantErr  bool
+	}{
+		{
+			name:     "DataCite resource",
+			importer: &DataCiteImporter{},
+			data:     testDataCite,
+			options: Options{EntityType: "Resource", Mappings: []Mapping{
+				{Source: "identifier", FieldName: "doi"},
+				{Source: "creators/creator/creatorName", FieldName: "author"},
+				{Source: "titles/title", FieldName: "title"},
+				{Source: "publicationYear", FieldName: "issued"},
+			}},
+			want: []*items.Item{
+				{EntityType: "Resource", Values: []*items.ItemValue{
+					{FieldName: "doi", FieldValue: "10.1234/abc"},
+					{FieldName: "author", FieldValue: "Turing, Alan"},
+					{FieldName: "author", FieldValue: "Church, Alonzo"},
+					{FieldName: "title", FieldValue: "On Computable Numbers", Language: "en"},
+					{FieldName: "title", FieldValue: "Über berechenbare Zahlen", Language: "de"},
+					{FieldName: "issued", FieldValue: "1936"},
+				}},
+			},
+		},
+		{
+			name:     "Dublin Core records in an OAI-PMH response",
+			importer: &DublinCoreImporter{},
+			data:     testOaiDC,
+			options: Options{EntityType: "Resource", Mappings: []Mapping{
+				{Source: "title", FieldName: "title", Language: "en"},
+			}},
+			want: []*items.Item{
+				{EntityType: "Resource", Values: []*items.ItemValue{
+					{FieldName: "title", FieldValue: "Numbers", Language: "en"},
+				}},
+				{EntityType: "Resource", Values: []*items.ItemValue{
+					{FieldName: "title", FieldValue: "Zahlen", Language: "de"},
+				}},
+			},
+		},
+		{
+			name:     "No records of the format",
+			importer: &DataCiteImporter{},
+			data:     testOaiDC,
+			options:  Options{EntityType: "Resource"},
+			wantErr:  true,
+		},
+		{
+			name:     "Malformed XML",
+			importer: &DublinCoreImporter{},
+			data:     "<oai_dc:dc",
+			options:  Options{EntityType: "Resource"},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.importer.Import(context.Background(), []byte(tt.data), tt.options)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Import() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Import() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package jsonld
+
+import (
+	"time"
+
+	sharedFields "github.com/d4l-data4life/mex/mex/shared/fields"
+
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/fields"
+	kind_link "github.com/d4l-data4life/mex/mex/services/metadata/business/fields/kinds/link"
+	kind_number "github.com/d4l-data4life/mex/mex/services/metadata/business/fields/kinds/number"
+	kind_timestamp "github.com/d4l-data4life/mex/mex/services/metadata/business/fields/kinds/timestamp"
+)
+
+const (
+	xsdNamespace = "http://www.w3.org/2001/XMLSchema#"
+
+	defaultBaseIRI = "urn:uuid:"
+)
+
+// Precisions of timestamp values, see the timestamp field kind, and the XML schema datatypes they correspond to
+var timestampDatatypes = []struct {
+	layout   string
+	datatype string
+}{
+	{"2006-01-02T15:04:05Z", xsdNamespace + "dateTime"},
+	{"2006-01-02", xsdNamespace + "date"},
+	{"2006-01", xsdNamespace + "gYearMonth"},
+	{"2006", xsdNamespace + "gYear"},
+}
+
+type Value struct {
+	FieldName  string
+	FieldValue string
+	Language   string
+}
+
+type Relation struct {
+	Type         string
+	TargetItemID string
+}
+
+type Item struct {
+	ItemID     string
+	EntityType string
+	Values     []Value
+	// LinkTargets maps the values of link fields (business IDs) onto the IDs of the latest items with that business ID
+	LinkTargets map[string][]string
+	Relations   []Relation
+}
+
+/*
+Serializer turns items into JSON-LD nodes according to a vocabulary mapping. Only mapped fields and relation types are
+serialized, all other values are dropped. Values of link fields are written as references to the items they resolve to.
+The nodes are plain maps, ready to be passed to json.Marshal.
+*/
+type Serializer struct {
+	baseIRI    string
+	context    map[string]any
+	classes    map[string]string
+	properties map[string]string
+	relations  map[string]string
+	fieldKinds map[string]string
+}
+
+func NewSerializer(mapping *sharedFields.VocabularyMapping, fieldDefs []fields.BaseFieldDef) *Serializer {
+	s := &Serializer{
+		baseIRI:    mapping.BaseIri,
+		context:    make(map[string]any, len(mapping.Prefixes)),
+		classes:    make(map[string]string, len(mapping.EntityTypes)),
+		properties: make(map[string]string, len(mapping.Fields)),
+		relations:  make(map[string]string, len(mapping.Relations)),
+		fieldKinds: make(map[string]string, len(fieldDefs)),
+	}
+	if s.baseIRI == "" {
+		s.baseIRI = defaultBaseIRI
+	}
+
+	for prefix, iri := range mapping.Prefixes {
+		s.context[prefix] = iri
+	}
+	for _, m := range mapping.EntityTypes {
+		s.classes[m.EntityType] = m.ClassIri
+	}
+	for _, m := range mapping.Fields {
+		s.properties[m.FieldName] = m.PropertyIri
+	}
+	for _, m := range mapping.Relations {
+		s.relations[m.RelationType] = m.PropertyIri
+	}
+	for _, fd := range fieldDefs {
+		s.fieldKinds[fd.Name()] = fd.Kind()
+	}
+
+	return s
+}
+
+func (s *Serializer) ItemIRI(itemID string) string {
+	return s.baseIRI + itemID
+}
+
+func (s *Serializer) IsLinkField(fieldName string) bool {
+	return s.fieldKinds[fieldName] == kind_link.KindName
+}
+
+// Document wraps the given nodes into a JSON-LD document carrying the prefixes of the mapping as context.
+func (s *Serializer) Document(nodes ...map[string]any) map[string]any {
+	graph := make([]any, len(nodes))
+	for i, node := range nodes {
+		graph[i] = node
+	}
+
+	return map[string]any{
+		"@context": s.context,
+		"@graph":   graph,
+	}
+}
+
+// Node returns the JSON-LD node of a single item. Properties are always arrays, in the order of the item values.
+func (s *Serializer) Node(item Item) map[string]any {
+	node := map[string]any{
+		"@id": s.ItemIRI(item.ItemID),
+	}
+	if class, ok := s.classes[item.EntityType]; ok && class != "" {
+		node["@type"] = class
+	}
+
+	add := func(property string, object any) {
+		objects, _ := node[property].([]any)
+		node[property] = append(objects, object)
+	}
+
+	for _, v := range item.Values {
+		property, ok := s.properties[v.FieldName]
+		if !ok || property == "" {
+			continue
+		}
+
+		switch s.fieldKinds[v.FieldName] {
+		case kind_link.KindName:
+			targets := item.LinkTargets[v.FieldValue]
+			if len(targets) == 0 {
+				// Unresolvable links are kept as plain values rather than dropped.
+				add(property, v.FieldValue)
+			}
+			for _, target := range targets {
+				add(property, map[string]any{"@id": s.ItemIRI(target)})
+			}
+		case kind_timestamp.KindName:
+			add(property, typedLiteral(v.FieldValue, timestampDatatype(v.FieldValue)))
+		case kind_number.KindName:
+			add(property, typedLiteral(v.FieldValue, xsdNamespace+"decimal"))
+		default:
+			if v.Language != "" {
+				add(property, map[string]any{"@value": v.FieldValue, "@language": v.Language})
+			} else {
+				add(property, v.FieldValue)
+			}
+		}
+	}
+
+	for _, r := range item.Relations {
+		property, ok := s.relations[r.Type]
+		if !ok || property == "" {
+			continue
+		}
+		add(property, map[string]any{"@id": s.ItemIRI(r.TargetItemID)})
+	}
+
+	return node
+}
+
+func typedLiteral(value string, datatype string) any {
+	if datatype == "" {
+		return value
+	}
+	return map[string]any{"@value": value, "@type": datatype}
+}
+
+// timestampDatatype returns the XML schema datatype matching the precision of a timestamp value (empty if none matches)
+func timestampDatatype(value string) string {
+	for _, td := range timestampDatatypes {
+		if _, err := time.Parse(td.layout, value); err == nil {
+			return td.datatype
+		}
+	}
+	return ""
+}
@@ -0,0 +1,132 @@
+package jsonld
+
+import (
+	"reflect"
+	"testing"
+
+	sharedFields "github.com/d4l-data4life/mex/mex/shared/fields"
+
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/fields"
+)
+
+func testSerializer(baseIRI string) *Serializer {
+	mapping := &sharedFields.VocabularyMapping{
+		BaseIri:  baseIRI,
+		Prefixes: map[string]string{"dcat": "http://www.w3.org/ns/dcat#", "dct": "http://purl.org/dc/terms/"},
+		EntityTypes: []*sharedFields.VocabularyMapping_EntityTypeMapping{
+			{EntityType: "Resource", ClassIri: "dcat:Dataset"},
+		},
+		Fields: []*sharedFields.VocabularyMapping_FieldMapping{
+			{FieldName: "title", PropertyIri: "dct:title"},
+			{FieldName: "alternativeTitle", PropertyIri: "dct:title"},
+			{FieldName: "issued", PropertyIri: "dct:issued"},
+			{FieldName: "size", PropertyIri: "dcat:byteSize"},
+			{FieldName: "publisher", PropertyIri: "dct:publisher"},
+		},
+		Relations: []*sharedFields.VocabularyMapping_RelationMapping{
+			{RelationType: "isPartOf", PropertyIri: "dct:isPartOf"},
+		},
+	}
+	fieldDefs := []fields.BaseFieldDef{
+		fields.NewBaseFieldDef("title", "text", "", false, fields.BaseIndexDef{}),
+		fields.NewBaseFieldDef("alternativeTitle", "text", "", false, fields.BaseIndexDef{}),
+		fields.NewBaseFieldDef("issued", "timestamp", "", false, fields.BaseIndexDef{}),
+		fields.NewBaseFieldDef("size", "number", "", false, fields.BaseIndexDef{}),
+		fields.NewBaseFieldDef("publisher", "link", "", false, fields.BaseIndexDef{}),
+		fields.NewBaseFieldDef("internalNote", "string", "", false, fields.BaseIndexDef{}),
+	}
+	return NewSerializer(mapping, fieldDefs)
+}
+
+func TestSerializer_Node(t *testing.T) {
+	tests := []struct {
+		name    string
+		baseIRI string
+		item    Item
+		want    map[string]any
+	}{
+		{
+			name: "Unmapped entity types, fields and relations are left out",
+			item: Item{
+				ItemID:     "a1",
+				EntityType: "Person",
+				Values:     []Value{{FieldName: "internalNote", FieldValue: "secret"}},
+				Relations:  []Relation{{Type: "hasAuthor", TargetItemID: "p1"}},
+			},
+			want: map[string]any{"@id": "urn:uuid:a1"},
+		},
+		{
+			name:    "Values are written as literals matching the field kind",
+			baseIRI: "https://mex.example.org/items/",
+			item: Item{
+				ItemID:     "a1",
+				EntityType: "Resource",
+				Values: []Value{
+					{FieldName: "title", FieldValue: "Zahlen", Language: "de"},
+					{FieldName: "alternativeTitle", FieldValue: "Numbers"},
+					{FieldName: "issued", FieldValue: "2021-03"},
+					{FieldName: "size", FieldValue: "42"},
+				},
+			},
+			want: map[string]any{
+				"@id":   "https://mex.example.org/items/a1",
+				"@type": "dcat:Dataset",
+				"dct:title": []any{
+					map[string]any{"@value": "Zahlen", "@language": "de"},
+					"Numbers",
+				},
+				"dct:issued":    []any{map[string]any{"@value": "2021-03", "@type": "http://www.w3.org/2001/XMLSchema#gYearMonth"}},
+				"dcat:byteSize": []any{map[string]any{"@value": "42", "@type": "http://www.w3.org/2001/XMLSchema#decimal"}},
+			},
+		},
+		{
+			name: "Links and relations are references to the target items",
+			item: Item{
+				ItemID:     "a1",
+				EntityType: "Resource",
+				Values: []Value{
+					{FieldName: "publisher", FieldValue: "org-1"},
+					{FieldName: "publisher", FieldValue: "org-unknown"},
+				},
+				LinkTargets: map[string][]string{"org-1": {"o1"}},
+				Relations:   []Relation{{Type: "isPartOf", TargetItemID: "c1"}},
+			},
+			want: map[string]any{
+				"@id":   "urn:uuid:a1",
+				"@type": "dcat:Dataset",
+				"dct:publisher": []any{
+					map[string]any{"@id": "urn:uuid:o1"},
+					"org-unknown",
+				},
+				"dct:isPartOf": []any{map[string]any{"@id": "urn:uuid:c1"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := testSerializer(tt.baseIRI).Node(tt.item)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Node() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_timestampDatatype(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: "2021-03-04T05:06:07Z", want: "http://www.w3.org/2001/XMLSchema#dateTime"},
+		{value: "2021-03-04", want: "http://www.w3.org/2001/XMLSchema#date"},
+		{value: "2021", want: "http://www.w3.org/2001/XMLSchema#gYear"},
+		{value: "yesterday", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := timestampDatatype(tt.value); got != tt.want {
+				t.Errorf("timestampDatatype() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package visibility
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/d4l-data4life/mex/mex/shared/auth"
+	"github.com/d4l-data4life/mex/mex/shared/searchconfig"
+
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/datamodel"
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/fields"
+	kindHierarchy "github.com/d4l-data4life/mex/mex/services/metadata/business/fields/kinds/hierarchy"
+)
+
+// Resolver translates the visibility rules of a user into the form evaluated by the database function f_item_visible.
+type Resolver struct {
+	FieldRepo        fields.FieldRepo
+	SearchConfigRepo searchconfig.SearchConfigRepo
+}
+
+// record is a visibility rule restricted to (at most) one field, see f_item_visible
+type record struct {
+	EntityTypes []string `json:"entityTypes,omitempty"`
+	FieldName   string   `json:"fieldName,omitempty"`
+	Values      []string `json:"values,omitempty"`
+}
+
+/*
+Resolve returns the visibility rules of the user as expected by the queries of the metadata read paths, or nil if the
+user is not restricted. Like in the search (where the hierarchy axis contains the ancestors of the assigned nodes), an
+item lies in a sub-tree of a hierarchy axis if one of the axis fields has the sub-tree's root or one of its
+descendants as value; hence, a rule is flattened into one record per axis field with the nodes of the sub-trees.
+*/
+func (resolver *Resolver) Resolve(ctx context.Context, queries *datamodel.Queries, claims *auth.Claims) ([]byte, error) {
+	if claims == nil || !claims.VisibilityRestricted {
+		return nil, nil
+	}
+
+	records := []record{}
+	for _, rule := range claims.Visibility {
+		ruleRecords, err := resolver.resolveRule(ctx, queries, rule)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, ruleRecords...)
+	}
+	return json.Marshal(records)
+}
+
+func (resolver *Resolver) resolveRule(ctx context.Context, queries *datamodel.Queries, rule *auth.VisibilityRule) ([]record, error) {
+	if rule.HierarchyAxis == "" || len(rule.Subtrees) == 0 {
+		return []record{{EntityTypes: rule.EntityTypes}}, nil
+	}
+
+	fieldNames, err := resolver.SearchConfigRepo.GetFieldsForAxis(ctx, rule.HierarchyAxis)
+	if err != nil {
+		return nil, fmt.Errorf("cannot resolve the fields of the visibility axis '%s': %s", rule.HierarchyAxis, err.Error())
+	}
+
+	// Without any field, no item lies on the axis and the rule matches nothing.
+	records := make([]record, 0, len(fieldNames))
+	for _, fieldName := range fieldNames {
+		values, err := resolver.subtreeNodes(ctx, queries, fieldName, rule.Subtrees)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record{EntityTypes: rule.EntityTypes, FieldName: fieldName, Values: values})
+	}
+	return records, nil
+}
+
+// subtreeNodes returns the nodes of the given sub-trees in the hierarchy of a field; other fields match the roots only
+func (resolver *Resolver) subtreeNodes(ctx context.Context, queries *datamodel.Queries, fieldName string, roots []string) ([]string, error) {
+	fieldDef, err := resolver.FieldRepo.GetFieldDefByName(ctx, fieldName)
+	if err != nil {
+		return nil, fmt.Errorf("cannot resolve the visibility axis field '%s': %s", fieldName, err.Error())
+	}
+
+	hierarchyFieldDef, ok := fieldDef.(kindHierarchy.HierarchyFieldDef)
+	if !ok {
+		return roots, nil
+	}
+
+	nodes, err := queries.DbListSubtreeNodes(ctx, hierarchyFieldDef.CodeSystemNameOrEntityType(), hierarchyFieldDef.LinkFieldName(), roots)
+	if err != nil {
+		return nil, fmt.Errorf("cannot resolve the sub-trees of the visibility axis field '%s': %s", fieldName, err.Error())
+	}
+	return nodes, nil
+}
+
+// VisibleItemIDs returns the given items which are visible under the resolved visibility rules (nil: all of them).
+func VisibleItemIDs(ctx context.Context, queries *datamodel.Queries, visibility []byte, itemIDs []string) ([]string, error) {
+	if visibility == nil {
+		return itemIDs, nil
+	}
+	return queries.DbListVisibleItemIDs(ctx, datamodel.DbListVisibleItemIDsParams{ItemIds: itemIDs, Visibility: visibility})
+}
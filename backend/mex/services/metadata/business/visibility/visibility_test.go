@@ -0,0 +1,118 @@
+package visibility
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/d4l-data4life/mex/mex/shared/auth"
+	"github.com/d4l-data4life/mex/mex/shared/db"
+	fieldUtils "github.com/d4l-data4life/mex/mex/shared/fields"
+	"github.com/d4l-data4life/mex/mex/shared/searchconfig"
+	"github.com/d4l-data4life/mex/mex/shared/searchconfig/screpo"
+	"github.com/d4l-data4life/mex/mex/shared/solr"
+
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/datamodel"
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/fields"
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/fields/frepo"
+	kindHierarchy "github.com/d4l-data4life/mex/mex/services/metadata/business/fields/kinds/hierarchy"
+	kindString "github.com/d4l-data4life/mex/mex/services/metadata/business/fields/kinds/string"
+)
+
+func newTestResolver(t *testing.T) *Resolver {
+	kind, _ := kindHierarchy.NewKindHierarchy(nil)
+	exts := make([]*anypb.Any, 0, 2)
+	for _, ext := range []proto.Message{
+		&fieldUtils.IndexDefExtHierarchy{CodeSystemNameOrNodeEntityType: "OrganizationalUnit", LinkFieldName: "parentUnit"},
+		&fieldUtils.IndexDefExtLink{RelationType: "unit"},
+	} {
+		anyExt, err := anypb.New(ext)
+		if err != nil {
+			t.Fatal(err)
+		}
+		exts = append(exts, anyExt)
+	}
+
+	return &Resolver{
+		FieldRepo: frepo.NewMockedFieldRepo([]fields.BaseFieldDef{
+			kind.MustValidateDefinition(context.Background(), &fieldUtils.FieldDef{
+				Name: "unit", Kind: kindHierarchy.KindName, IndexDef: &fieldUtils.IndexDef{Ext: exts},
+			}),
+			fields.NewBaseFieldDef("unitCode", kindString.KindName, "", false, fields.BaseIndexDef{}),
+		}),
+		SearchConfigRepo: screpo.NewMockSearchConfigRepo([]*searchconfig.SearchConfigObject{
+			{Type: solr.MexHierarchyAxisType, Name: "unit", Fields: []string{"unit", "unitCode"}},
+			{Type: solr.MexHierarchyAxisType, Name: "empty"},
+		}),
+	}
+}
+
+func TestResolver_Resolve(t *testing.T) {
+	tests := []struct {
+		name   string
+		claims *auth.Claims
+		want   string
+	}{
+		{
+			name:   "No claims",
+			claims: nil,
+			want:   "",
+		},
+		{
+			name:   "Unrestricted user",
+			claims: &auth.Claims{Visibility: []*auth.VisibilityRule{{EntityTypes: []string{"Resource"}}}},
+			want:   "",
+		},
+		{
+			name: "Rule without axis",
+			claims: &auth.Claims{
+				VisibilityRestricted: true,
+				Visibility:           []*auth.VisibilityRule{{EntityTypes: []string{"Resource"}}},
+			},
+			want: `[{"entityTypes":["Resource"]}]`,
+		},
+		{
+			name: "Hierarchy fields match the sub-trees, other fields the roots",
+			claims: &auth.Claims{
+				VisibilityRestricted: true,
+				Visibility: []*auth.VisibilityRule{
+					{EntityTypes: []string{"Resource"}, HierarchyAxis: "unit", Subtrees: []string{"u1"}},
+				},
+			},
+			want: `[{"entityTypes":["Resource"],"fieldName":"unit","values":["u1","u11","u12"]},` +
+				`{"entityTypes":["Resource"],"fieldName":"unitCode","values":["u1"]}]`,
+		},
+		{
+			name: "Axis without fields matches nothing",
+			claims: &auth.Claims{
+				VisibilityRestricted: true,
+				Visibility:           []*auth.VisibilityRule{{HierarchyAxis: "empty", Subtrees: []string{"u1"}}},
+			},
+			want: `[]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := db.NewMockTx(func(stmt db.MockStatement) db.MockResult {
+				if strings.Contains(stmt.Name, "f_tree_descendants") {
+					return db.MockResult{Rows: [][]any{{"u1"}, {"u11"}, {"u12"}}}
+				}
+				return db.MockResult{Rows: [][]any{}}
+			})
+
+			got, err := newTestResolver(t).Resolve(context.Background(), datamodel.New(tx), tt.claims)
+			if err != nil {
+				t.Fatalf("Resolve() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Resolve() = %s, want %s", got, tt.want)
+			}
+			if tt.want == "" && got != nil {
+				t.Errorf("Resolve() = %v, want nil", got)
+			}
+		})
+	}
+}
@@ -9,6 +9,9 @@ import (
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 
+	"github.com/d4l-data4life/mex/mex/shared/audit"
+	"github.com/d4l-data4life/mex/mex/shared/auth"
+	apikeysStore "github.com/d4l-data4life/mex/mex/shared/auth/apikeys"
 	"github.com/d4l-data4life/mex/mex/shared/cfg"
 	"github.com/d4l-data4life/mex/mex/shared/db"
 	"github.com/d4l-data4life/mex/mex/shared/entities"
@@ -18,15 +21,23 @@ import (
 	sharedJobs "github.com/d4l-data4life/mex/mex/shared/jobs"
 	L "github.com/d4l-data4life/mex/mex/shared/log"
 	"github.com/d4l-data4life/mex/mex/shared/log/emit"
-	"github.com/d4l-data4life/mex/mex/shared/mail"
 	"github.com/d4l-data4life/mex/mex/shared/mail/flowmailer"
+	"github.com/d4l-data4life/mex/mex/shared/searchconfig"
+	"github.com/d4l-data4life/mex/mex/shared/searchconfig/screpo"
 	"github.com/d4l-data4life/mex/mex/shared/svcutils"
 	"github.com/d4l-data4life/mex/mex/shared/web"
 
 	"github.com/d4l-data4life/mex/mex/services/metadata/business/fields"
 	"github.com/d4l-data4life/mex/mex/services/metadata/business/fields/frepo"
 	"github.com/d4l-data4life/mex/mex/services/metadata/business/fields/hooks"
-
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/fields/vrepo"
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/importer"
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/visibility"
+
+	"github.com/d4l-data4life/mex/mex/services/metadata/endpoints/apikeys"
+	pbApiKeys "github.com/d4l-data4life/mex/mex/services/metadata/endpoints/apikeys/pb"
+	auditEndpoint "github.com/d4l-data4life/mex/mex/services/metadata/endpoints/audit"
+	pbAudit "github.com/d4l-data4life/mex/mex/services/metadata/endpoints/audit/pb"
 	"github.com/d4l-data4life/mex/mex/services/metadata/endpoints/blobs"
 	pbBlobs "github.com/d4l-data4life/mex/mex/services/metadata/endpoints/blobs/pb"
 	"github.com/d4l-data4life/mex/mex/services/metadata/endpoints/items"
@@ -35,6 +46,8 @@ import (
 	pbJobs "github.com/d4l-data4life/mex/mex/services/metadata/endpoints/jobs/pb"
 	"github.com/d4l-data4life/mex/mex/services/metadata/endpoints/notify"
 	pbNotify "github.com/d4l-data4life/mex/mex/services/metadata/endpoints/notify/pb"
+	"github.com/d4l-data4life/mex/mex/services/metadata/endpoints/oai"
+	pbOai "github.com/d4l-data4life/mex/mex/services/metadata/endpoints/oai/pb"
 
 	"github.com/d4l-data4life/mex/mex/services/metadata/migrations/migrate_database"
 )
@@ -165,6 +178,18 @@ func setup(ctx context.Context, opts svcutils.SetupOpts, strictConfigParsing boo
 		return fmt.Errorf("unknown fields repo type: %s", opts.Config.FieldDefs.RepoType)
 	}
 
+	// The vocabulary mapping is stored next to the field definitions and hence shares their repo type.
+	var vocabularyRepo fields.VocabularyRepo
+	switch opts.Config.FieldDefs.RepoType {
+	case cfg.RepoType_DIRECT:
+		vocabularyRepo = vrepo.NewDirectCMSVocabularyRepo(opts.Config.Services.Config.Origin, strictConfigParsing)
+	case cfg.RepoType_CACHED:
+		vocabularyRepo = vrepo.NewCachedVocabularyRepo(ctx, vrepo.NewCachedVocabularyRepoParams{
+			Log:      opts.Log,
+			Delegate: vrepo.NewDirectCMSVocabularyRepo(opts.Config.Services.Config.Origin, strictConfigParsing),
+		})
+	}
+
 	var entityRepo entities.EntityRepo
 	switch opts.Config.EntityTypes.RepoType {
 	case cfg.RepoType_DIRECT:
@@ -176,15 +201,37 @@ func setup(ctx context.Context, opts svcutils.SetupOpts, strictConfigParsing boo
 		})
 	}
 
+	var searchConfigRepo searchconfig.SearchConfigRepo
+	switch opts.Config.SearchConfig.RepoType {
+	case cfg.RepoType_DIRECT:
+		searchConfigRepo = screpo.NewDirectCMSSearchConfigRepo(opts.Config.Services.Config.Origin, strictConfigParsing)
+	case cfg.RepoType_CACHED:
+		searchConfigRepo = screpo.NewCachedSearchConfigRepo(ctx,
+			screpo.NewCachedSearchConfigRepoParams{
+				Log:      opts.Log,
+				Delegate: screpo.NewDirectCMSSearchConfigRepo(opts.Config.Services.Config.Origin, strictConfigParsing),
+			})
+	default:
+		return fmt.Errorf("unknown search config repo type: %s", opts.Config.SearchConfig.RepoType)
+	}
+
+	// The visibility rules refer to hierarchy axes, whose fields and sub-trees are resolved for the read paths.
+	visibilityResolver := visibility.Resolver{
+		FieldRepo:        fieldRepo,
+		SearchConfigRepo: searchConfigRepo,
+	}
+
+	visibilityRules, err := auth.ParseVisibilityRules(opts.Config.AccessControl.VisibilityRules)
+	if err != nil {
+		return fmt.Errorf("failed to parse visibility rules: %s", err.Error())
+	}
+
 	announcer := index.RedisAnnouncer{
 		Log:   opts.Log,
 		Redis: opts.Redis,
 
-		SetExpiration: opts.Config.AutoIndexer.SetExpiration.AsDuration(),
-
 		TechnicalIDsTopicName: opts.Config.Redis.PubSubPrefix + "/" + items.MetadataItemUpdateByItemIDChannelName,
 		BusinessIDsTopicName:  opts.Config.Redis.PubSubPrefix + "/" + items.MetadataItemUpdateByBusinessIDChannelName,
-		BusinessIDSetName:     opts.Config.Redis.PubSubPrefix + "/" + items.MetadataItemUpdateByBusinessIDSetName,
 	}
 
 	metadataService := items.Service{
@@ -195,17 +242,28 @@ func setup(ctx context.Context, opts svcutils.SetupOpts, strictConfigParsing boo
 		Redis:  opts.Redis,
 		Jobber: jobber,
 
-		FieldRepo:  fieldRepo,
-		EntityRepo: entityRepo,
+		FieldRepo:        fieldRepo,
+		EntityRepo:       entityRepo,
+		VocabularyRepo:   vocabularyRepo,
+		SearchConfigRepo: searchConfigRepo,
+
+		Visibility: &visibilityResolver,
 
 		ItemCreationHooks:      itemCreationHooks,
 		SolrFieldCreationHooks: solrFieldCreationHooks,
 		SolrDataLoadHooks:      solrDataLoadHooks,
 
+		Importers: importer.NewImporters(),
+
 		Announcer:        &announcer,
 		TelemetryService: opts.TelemetryService,
 
 		DuplicateDetectionAlgorithm: opts.Config.Indexing.DuplicationDetectionAlgorithm,
+		StreamChunkSize:             int(opts.Config.Ingestion.StreamChunkSize),
+		StreamLockTimeout:           opts.Config.Ingestion.StreamLockTimeout.AsDuration(),
+
+		OwnerScopedWrites: opts.Config.AccessControl.OwnerScopedWrites,
+		OwnerGroups:       opts.Config.AccessControl.OwnerGroups,
 	}
 	opts.TopicConfigChange.Subscribe(&metadataService)
 
@@ -215,27 +273,9 @@ func setup(ctx context.Context, opts svcutils.SetupOpts, strictConfigParsing boo
 		MasterTableName: opts.Config.Services.Blobs.MasterTableName,
 	}
 
-	var mailer mail.Mailer
-	switch opts.Config.Notify.EmailerType {
-	case cfg.EmailerType_MOCKMAILER:
-		opts.Log.Warn(ctx, L.Message("using mockmailer"))
-		mailer = flowmailer.NewMockMailer(opts.Redis)
-
-	case cfg.EmailerType_FLOWMAILER:
-		mailer, err = flowmailer.NewSimpleFlowmailer(flowmailer.Params{
-			OriginOAuth:         opts.Config.Notify.Flowmailer.OriginOauth,
-			OriginAPI:           opts.Config.Notify.Flowmailer.OriginApi,
-			ClientID:            opts.Config.Notify.Flowmailer.ClientId,
-			ClientSecret:        opts.Config.Notify.Flowmailer.ClientSecret,
-			AccountID:           opts.Config.Notify.Flowmailer.AccountId,
-			NoReplyEmailAddress: opts.Config.Notify.Flowmailer.NoreplyEmailAddress,
-			Timeout:             opts.Config.Web.ReadTimeout.AsDuration(), // reuse
-		})
-		if err != nil {
-			return fmt.Errorf("failed to init Flowmailer client: %w", err)
-		}
-	default:
-		return fmt.Errorf("unsupported emailer type: %d", opts.Config.Notify.EmailerType)
+	mailer, err := flowmailer.NewMailer(ctx, opts.Log, opts.Config, opts.Redis)
+	if err != nil {
+		return err
 	}
 
 	notifyService := notify.Service{
@@ -248,10 +288,51 @@ func setup(ctx context.Context, opts svcutils.SetupOpts, strictConfigParsing boo
 		ConfigServiceOrigin: opts.Config.Services.Config.Origin,
 	}
 
+	oaiService := oai.Service{
+		Log: opts.Log,
+		DB:  opts.DBPool,
+
+		EntityRepo:     entityRepo,
+		VocabularyRepo: vocabularyRepo,
+
+		Visibility:      &visibilityResolver,
+		VisibilityRules: visibilityRules,
+		HarvesterGroups: opts.Config.Oai.HarvesterGroups,
+
+		Enabled:              opts.Config.Oai.Enabled,
+		RepositoryName:       opts.Config.Oai.RepositoryName,
+		BaseURL:              opts.Config.Oai.BaseUrl,
+		RepositoryIdentifier: opts.Config.Oai.RepositoryIdentifier,
+		AdminEmails:          opts.Config.Oai.AdminEmails,
+		PageSize:             int(opts.Config.Oai.PageSize),
+	}
+
+	apiKeysService := apikeys.Service{
+		Log:   opts.Log,
+		Store: &apikeysStore.Store{DB: opts.DBPool, Log: opts.Log},
+		// Only used to validate scopes, which are the privileges known to every privilege manager.
+		PrivMgr: auth.NewPrivMgr(),
+	}
+
+	auditStore := &audit.PostgresStore{DB: opts.DBPool}
+	auditService := auditEndpoint.Service{Store: auditStore}
+
+	// Move the audit entries of the services without database access into the audit log.
+	auditForwarder := &audit.Forwarder{
+		Redis:  opts.Redis,
+		Stream: svcutils.AuditStreamName(opts.Config),
+		Target: auditStore,
+		Log:    opts.Log,
+	}
+	go auditForwarder.Run(ctx)
+
 	pbItems.RegisterItemsServer(opts.GRPCServer, &metadataService)
 	pbJobs.RegisterJobsServer(opts.GRPCServer, &jobService)
 	pbBlobs.RegisterBlobsServer(opts.GRPCServer, &blobsService)
 	pbNotify.RegisterNotifyServer(opts.GRPCServer, &notifyService)
+	pbOai.RegisterOaiServer(opts.GRPCServer, &oaiService)
+	pbApiKeys.RegisterApiKeysServer(opts.GRPCServer, &apiKeysService)
+	pbAudit.RegisterAuditServer(opts.GRPCServer, &auditService)
 
 	err = pbItems.RegisterItemsHandlerFromEndpoint(ctx, opts.HTTPMux, opts.Config.Web.GrpcHost, opts.GRPCOpts)
 	if err != nil {
@@ -273,5 +354,20 @@ func setup(ctx context.Context, opts svcutils.SetupOpts, strictConfigParsing boo
 		return err
 	}
 
+	err = pbOai.RegisterOaiHandlerFromEndpoint(ctx, opts.HTTPMux, opts.Config.Web.GrpcHost, opts.GRPCOpts)
+	if err != nil {
+		return err
+	}
+
+	err = pbApiKeys.RegisterApiKeysHandlerFromEndpoint(ctx, opts.HTTPMux, opts.Config.Web.GrpcHost, opts.GRPCOpts)
+	if err != nil {
+		return err
+	}
+
+	err = pbAudit.RegisterAuditHandlerFromEndpoint(ctx, opts.HTTPMux, opts.Config.Web.GrpcHost, opts.GRPCOpts)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
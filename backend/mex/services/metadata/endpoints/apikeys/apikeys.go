@@ -0,0 +1,197 @@
+package apikeys
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/d4l-data4life/mex/mex/shared/auth"
+	"github.com/d4l-data4life/mex/mex/shared/auth/apikeys"
+	E "github.com/d4l-data4life/mex/mex/shared/errstat"
+	"github.com/d4l-data4life/mex/mex/shared/hints"
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+
+	pbApiKeys "github.com/d4l-data4life/mex/mex/services/metadata/endpoints/apikeys/pb"
+)
+
+type Service struct {
+	Log L.Logger
+
+	Store   *apikeys.Store
+	PrivMgr *auth.PrivMgr
+
+	pbApiKeys.UnimplementedApiKeysServer
+}
+
+func (svc *Service) CreateApiKey(ctx context.Context, request *pbApiKeys.CreateApiKeyRequest) (*pbApiKeys.CreateApiKeyResponse, error) {
+	user, err := auth.GetMexUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Name == "" {
+		return nil, E.MakeGRPCStatus(codes.InvalidArgument, "API key name missing").Err()
+	}
+
+	err = svc.checkScopes(user, request.Scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	var expiresAt *time.Time
+	if request.ExpiresAt != nil {
+		t := request.ExpiresAt.AsTime()
+		if !t.After(time.Now()) {
+			return nil, E.MakeGRPCStatus(codes.InvalidArgument, "API key expiry is in the past").Err()
+		}
+		expiresAt = &t
+	}
+
+	apiKey, key, err := svc.Store.Create(ctx, request.Name, request.Scopes, user.UserId, expiresAt)
+	if err != nil {
+		return nil, E.MakeGRPCStatus(codes.Internal, "could not create API key", E.Cause(err)).Err()
+	}
+	svc.Log.Info(ctx, L.Messagef("API key created: %s (%s)", apiKey.ID, apiKey.Name))
+
+	hints.HintHTTPStatusCode(ctx, http.StatusCreated)
+	hints.HintHTTPCacheControl(ctx, "no-store")
+	return &pbApiKeys.CreateApiKeyResponse{ApiKey: toProto(apiKey), Key: key}, nil
+}
+
+// ListApiKeys lists the keys created by the user, or all keys for users with the apikeys/admin privilege.
+func (svc *Service) ListApiKeys(ctx context.Context, request *pbApiKeys.ListApiKeysRequest) (*pbApiKeys.ListApiKeysResponse, error) {
+	user, err := auth.GetMexUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	createdBy := user.UserId
+	if svc.isAdmin(user) {
+		createdBy = ""
+	}
+
+	apiKeys, err := svc.Store.List(ctx, request.IncludeInactive, createdBy)
+	if err != nil {
+		return nil, E.MakeGRPCStatus(codes.Internal, "could not list API keys", E.Cause(err)).Err()
+	}
+
+	response := pbApiKeys.ListApiKeysResponse{ApiKeys: make([]*pbApiKeys.ApiKey, len(apiKeys))}
+	for i, apiKey := range apiKeys {
+		response.ApiKeys[i] = toProto(apiKey)
+	}
+	return &response, nil
+}
+
+func (svc *Service) RevokeApiKey(ctx context.Context, request *pbApiKeys.RevokeApiKeyRequest) (*pbApiKeys.RevokeApiKeyResponse, error) {
+	user, err := auth.GetMexUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = svc.getOwnKey(ctx, user, request.KeyId)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey, err := svc.Store.Revoke(ctx, request.KeyId)
+	if err != nil {
+		return nil, E.MakeGRPCStatus(E.CodeFrom(err), "could not revoke API key", E.DevMessage(err.Error())).Err()
+	}
+	svc.Log.Info(ctx, L.Messagef("API key revoked: %s (%s)", apiKey.ID, apiKey.Name))
+
+	return &pbApiKeys.RevokeApiKeyResponse{ApiKey: toProto(apiKey)}, nil
+}
+
+func (svc *Service) RotateApiKey(ctx context.Context, request *pbApiKeys.RotateApiKeyRequest) (*pbApiKeys.RotateApiKeyResponse, error) {
+	user, err := auth.GetMexUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gracePeriod := request.GracePeriod.AsDuration()
+	if gracePeriod < 0 {
+		return nil, E.MakeGRPCStatus(codes.InvalidArgument, "negative grace period").Err()
+	}
+
+	oldKey, err := svc.getOwnKey(ctx, user, request.KeyId)
+	if err != nil {
+		return nil, err
+	}
+
+	// The user must hold the key's scopes, which may exceed the user's privileges (e.g. after a change of roles).
+	err = svc.checkScopes(user, oldKey.Scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey, key, err := svc.Store.Rotate(ctx, request.KeyId, gracePeriod)
+	if err != nil {
+		return nil, E.MakeGRPCStatus(E.CodeFrom(err), "could not rotate API key", E.DevMessage(err.Error())).Err()
+	}
+	svc.Log.Info(ctx, L.Messagef("API key rotated by %s: %s -> %s (%s)", user.UserId, request.KeyId, apiKey.ID, apiKey.Name))
+
+	hints.HintHTTPCacheControl(ctx, "no-store")
+	return &pbApiKeys.RotateApiKeyResponse{ApiKey: toProto(apiKey), Key: key}, nil
+}
+
+// getOwnKey returns the key if the user created it or has the apikeys/admin privilege
+func (svc *Service) getOwnKey(ctx context.Context, user *auth.Claims, keyID string) (*apikeys.APIKey, error) {
+	apiKey, err := svc.Store.Get(ctx, keyID)
+	if err != nil {
+		return nil, E.MakeGRPCStatus(E.CodeFrom(err), "could not read API key", E.DevMessage(err.Error())).Err()
+	}
+
+	if apiKey.CreatedBy != user.UserId && !svc.isAdmin(user) {
+		return nil, E.MakeGRPCStatus(codes.PermissionDenied, "API key of another user").Err()
+	}
+	return apiKey, nil
+}
+
+func (svc *Service) isAdmin(user *auth.Claims) bool {
+	admin := svc.PrivMgr.MustPrivMask(auth.ResourceAPIKeys, auth.VerbAdmin)
+	return user.Privileges&admin == admin
+}
+
+// checkScopes makes sure that the scopes are known privileges and that users only grant privileges they hold themselves
+func (svc *Service) checkScopes(user *auth.Claims, scopes []string) error {
+	if len(scopes) == 0 {
+		return E.MakeGRPCStatus(codes.InvalidArgument, "API key without scopes").Err()
+	}
+
+	mask, err := svc.PrivMgr.ResolvePrivileges(scopes)
+	if err != nil {
+		return E.MakeGRPCStatus(codes.InvalidArgument, fmt.Sprintf("invalid scope: %s", err.Error())).Err()
+	}
+
+	if user.Privileges&mask != mask {
+		return E.MakeGRPCStatus(codes.PermissionDenied, "API key scopes exceed own privileges").Err()
+	}
+	return nil
+}
+
+func toProto(apiKey *apikeys.APIKey) *pbApiKeys.ApiKey {
+	return &pbApiKeys.ApiKey{
+		KeyId:      apiKey.ID,
+		Name:       apiKey.Name,
+		KeyPrefix:  apiKey.KeyPrefix,
+		Scopes:     apiKey.Scopes,
+		CreatedBy:  apiKey.CreatedBy,
+		CreatedAt:  timestamppb.New(apiKey.CreatedAt),
+		ExpiresAt:  optionalTimestamp(apiKey.ExpiresAt),
+		LastUsedAt: optionalTimestamp(apiKey.LastUsedAt),
+		RevokedAt:  optionalTimestamp(apiKey.RevokedAt),
+		RotatedTo:  apiKey.RotatedTo,
+		Active:     apiKey.Active(time.Now()),
+	}
+}
+
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
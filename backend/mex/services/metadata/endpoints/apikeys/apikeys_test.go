@@ -0,0 +1,171 @@
+package apikeys
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/d4l-data4life/mex/mex/shared/auth"
+	"github.com/d4l-data4life/mex/mex/shared/auth/apikeys"
+	"github.com/d4l-data4life/mex/mex/shared/constants"
+	"github.com/d4l-data4life/mex/mex/shared/db"
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+
+	pbApiKeys "github.com/d4l-data4life/mex/mex/services/metadata/endpoints/apikeys/pb"
+)
+
+// newTestService returns a service whose store holds a single active key created by owner-1 with the given scopes
+func newTestService(scopes []string) (*Service, *db.MockTx) {
+	tx := db.NewMockTx(func(stmt db.MockStatement) db.MockResult {
+		switch stmt.Name {
+		case "DbGetApiKey", "DbGetApiKeyForUpdate":
+			return db.MockResult{Rows: [][]any{{"key-1", "ci", "mex_0123456", "hash", scopes, "owner-1", time.Now()}}}
+		case "DbCreateApiKey":
+			return db.MockResult{Rows: [][]any{{stmt.Args[0], stmt.Args[1], stmt.Args[2], stmt.Args[3], stmt.Args[4], stmt.Args[5], time.Now()}}}
+		case "DbListApiKeys":
+			return db.MockResult{Rows: [][]any{}}
+		}
+		return db.MockResult{}
+	})
+	return &Service{Log: &L.NullLogger{}, Store: &apikeys.Store{DB: tx}, PrivMgr: auth.NewPrivMgr()}, tx
+}
+
+func userContext(userID string, privileges ...string) context.Context {
+	mgr := auth.NewPrivMgr()
+	mask, err := mgr.ResolvePrivileges(privileges)
+	if err != nil {
+		panic(err)
+	}
+	return context.WithValue(context.Background(), constants.ContextKeyUserClaims, &auth.Claims{UserId: userID, Privileges: mask})
+}
+
+func TestService_RevokeApiKey(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		wantCode codes.Code
+	}{
+		{
+			name:     "Own key",
+			ctx:      userContext("owner-1", "apikeys/delete"),
+			wantCode: codes.OK,
+		},
+		{
+			name:     "Key of another user",
+			ctx:      userContext("user-2", "apikeys/delete"),
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "Key of another user, with admin privilege",
+			ctx:      userContext("admin-1", "apikeys/delete", "apikeys/admin"),
+			wantCode: codes.OK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, tx := newTestService([]string{"items/read"})
+
+			_, err := svc.RevokeApiKey(tt.ctx, &pbApiKeys.RevokeApiKeyRequest{KeyId: "key-1"})
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("RevokeApiKey() error = %v, want code %v", err, tt.wantCode)
+			}
+			if revoked := len(tx.Executed("DbRevokeApiKey")) > 0; revoked != (tt.wantCode == codes.OK) {
+				t.Errorf("RevokeApiKey() revoked = %v", revoked)
+			}
+		})
+	}
+}
+
+func TestService_RotateApiKey(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		scopes   []string
+		wantCode codes.Code
+	}{
+		{
+			name:     "Own key",
+			ctx:      userContext("owner-1", "apikeys/update", "items/read"),
+			scopes:   []string{"items/read"},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "Key scopes exceed own privileges",
+			ctx:      userContext("owner-1", "apikeys/update", "items/read"),
+			scopes:   []string{"items/read", "items/delete"},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "Key of another user",
+			ctx:      userContext("user-2", "apikeys/update", "items/read"),
+			scopes:   []string{"items/read"},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "Key of another user, with admin privilege",
+			ctx:      userContext("admin-1", "apikeys/update", "apikeys/admin", "items/read"),
+			scopes:   []string{"items/read"},
+			wantCode: codes.OK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, tx := newTestService(tt.scopes)
+
+			response, err := svc.RotateApiKey(tt.ctx, &pbApiKeys.RotateApiKeyRequest{KeyId: "key-1"})
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("RotateApiKey() error = %v, want code %v", err, tt.wantCode)
+			}
+			if tt.wantCode != codes.OK {
+				if len(tx.Executed("DbCreateApiKey")) > 0 {
+					t.Errorf("RotateApiKey() created a key")
+				}
+				return
+			}
+			if response.ApiKey.CreatedBy != "owner-1" {
+				t.Errorf("RotateApiKey() created by = %s, want owner-1", response.ApiKey.CreatedBy)
+			}
+		})
+	}
+}
+
+func TestService_ListApiKeys(t *testing.T) {
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		wantCreatedBy pgtype.Text
+	}{
+		{
+			name:          "Own keys",
+			ctx:           userContext("owner-1", "apikeys/read"),
+			wantCreatedBy: pgtype.Text{String: "owner-1", Valid: true},
+		},
+		{
+			name:          "All keys, with admin privilege",
+			ctx:           userContext("admin-1", "apikeys/read", "apikeys/admin"),
+			wantCreatedBy: pgtype.Text{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, tx := newTestService([]string{"items/read"})
+
+			_, err := svc.ListApiKeys(tt.ctx, &pbApiKeys.ListApiKeysRequest{})
+			if err != nil {
+				t.Fatalf("ListApiKeys() error = %v", err)
+			}
+
+			statements := tx.Executed("DbListApiKeys")
+			if len(statements) != 1 {
+				t.Fatalf("ListApiKeys() executed DbListApiKeys %d times, want 1", len(statements))
+			}
+			if createdBy := statements[0].Args[0]; createdBy != tt.wantCreatedBy {
+				t.Errorf("ListApiKeys() created by = %v, want %v", createdBy, tt.wantCreatedBy)
+			}
+		})
+	}
+}
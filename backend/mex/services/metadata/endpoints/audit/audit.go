@@ -0,0 +1,128 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/d4l-data4life/mex/mex/shared/audit"
+	E "github.com/d4l-data4life/mex/mex/shared/errstat"
+
+	pbAudit "github.com/d4l-data4life/mex/mex/services/metadata/endpoints/audit/pb"
+)
+
+const (
+	contentTypeNDJSON = "application/x-ndjson"
+
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+type Service struct {
+	Store *audit.PostgresStore
+
+	pbAudit.UnimplementedAuditServer
+}
+
+func (svc *Service) QueryAuditLog(ctx context.Context, request *pbAudit.QueryAuditLogRequest) (*pbAudit.QueryAuditLogResponse, error) {
+	var afterID int64
+	if request.PageToken != "" {
+		var err error
+		afterID, err = strconv.ParseInt(request.PageToken, 10, 64)
+		if err != nil {
+			return nil, E.MakeGRPCStatus(codes.InvalidArgument, "invalid page token").Err()
+		}
+	}
+
+	if request.PageSize < 0 {
+		return nil, E.MakeGRPCStatus(codes.InvalidArgument, "negative page size").Err()
+	}
+	pageSize := int(request.PageSize)
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	entries, err := svc.Store.Query(ctx, filterOf(request.Subject, request.Resource, request.From, request.To), afterID, pageSize+1)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to query audit log: %s", err.Error()))
+	}
+
+	response := pbAudit.QueryAuditLogResponse{Entries: []*pbAudit.AuditEntry{}}
+	for i, entry := range entries {
+		if i == pageSize {
+			response.NextPageToken = strconv.FormatInt(entries[i-1].ID, 10)
+			break
+		}
+		response.Entries = append(response.Entries, toProto(entry))
+	}
+	return &response, nil
+}
+
+// ExportAuditLog streams all matching entries, one JSON document per entry.
+func (svc *Service) ExportAuditLog(request *pbAudit.ExportAuditLogRequest, stream pbAudit.Audit_ExportAuditLogServer) error {
+	ctx := stream.Context()
+	filter := filterOf(request.Subject, request.Resource, request.From, request.To)
+
+	var afterID int64
+	for {
+		entries, err := svc.Store.Query(ctx, filter, afterID, maxPageSize)
+		if err != nil {
+			return status.Error(codes.Internal, fmt.Sprintf("failed to query audit log: %s", err.Error()))
+		}
+
+		for _, entry := range entries {
+			data, err := json.Marshal(entry)
+			if err != nil {
+				return status.Error(codes.Internal, fmt.Sprintf("failed to serialize audit entry %d: %s", entry.ID, err.Error()))
+			}
+			if err := stream.Send(&httpbody.HttpBody{ContentType: contentTypeNDJSON, Data: data}); err != nil {
+				return err
+			}
+			afterID = entry.ID
+		}
+
+		if len(entries) < maxPageSize {
+			return nil
+		}
+	}
+}
+
+func filterOf(subject string, resource string, from *timestamppb.Timestamp, to *timestamppb.Timestamp) audit.Filter {
+	filter := audit.Filter{Subject: subject, Resource: resource}
+	if from != nil {
+		t := from.AsTime()
+		filter.From = &t
+	}
+	if to != nil {
+		t := to.AsTime()
+		filter.To = &t
+	}
+	return filter
+}
+
+func toProto(entry *audit.Entry) *pbAudit.AuditEntry {
+	return &pbAudit.AuditEntry{
+		Id:        entry.ID,
+		CreatedAt: timestamppb.New(entry.CreatedAt),
+		Service:   entry.Service,
+		Method:    entry.Method,
+		TenantId:  entry.TenantID,
+		Subject:   entry.Subject,
+		AppId:     entry.AppID,
+		Roles:     entry.Roles,
+		Resource:  entry.Resource,
+		Verb:      entry.Verb,
+		TargetIds: entry.TargetIDs,
+		Outcome:   entry.Outcome,
+		Message:   entry.Message,
+	}
+}
@@ -2,54 +2,75 @@ package items
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/go-redis/redis/v8"
-	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5"
 
 	"github.com/d4l-data4life/mex/mex/shared/auth"
 	"github.com/d4l-data4life/mex/mex/shared/cfg"
 	"github.com/d4l-data4life/mex/mex/shared/constants"
+	"github.com/d4l-data4life/mex/mex/shared/db"
 	"github.com/d4l-data4life/mex/mex/shared/entities"
 	"github.com/d4l-data4life/mex/mex/shared/index"
 	"github.com/d4l-data4life/mex/mex/shared/jobs"
 	"github.com/d4l-data4life/mex/mex/shared/known/jobspb"
 	"github.com/d4l-data4life/mex/mex/shared/known/statuspb"
 	L "github.com/d4l-data4life/mex/mex/shared/log"
+	"github.com/d4l-data4life/mex/mex/shared/searchconfig"
 	"github.com/d4l-data4life/mex/mex/shared/telemetry"
 
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/datamodel"
 	"github.com/d4l-data4life/mex/mex/services/metadata/business/fields"
 	"github.com/d4l-data4life/mex/mex/services/metadata/business/fields/hooks"
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/importer"
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/visibility"
 	itemspb "github.com/d4l-data4life/mex/mex/services/metadata/endpoints/items/pb"
 )
 
 const (
 	MetadataItemUpdateByItemIDChannelName     = "mex-metadata-item-update-by-item-id"
 	MetadataItemUpdateByBusinessIDChannelName = "mex-metadata-item-update-by-business-id"
-	MetadataItemUpdateByBusinessIDSetName     = "mex-metadata-item-update-by-business-id-set"
 )
 
 type Service struct {
 	ServiceTag string
 	Log        L.Logger
 
-	DB    *pgxpool.Pool
+	DB    db.Pool
 	Redis *redis.Client
 
 	Jobber jobs.Jobber
 
-	FieldRepo  fields.FieldRepo
-	EntityRepo entities.EntityRepo
+	FieldRepo      fields.FieldRepo
+	EntityRepo     entities.EntityRepo
+	VocabularyRepo fields.VocabularyRepo
+
+	// Only used to resolve the visibility rules, see Visibility
+	SearchConfigRepo searchconfig.SearchConfigRepo
+
+	// Restricts the items users with visibility rules can read
+	Visibility *visibility.Resolver
 
 	// Field lifecycle hooks
 	ItemCreationHooks      hooks.ItemCreationHooks
 	SolrFieldCreationHooks hooks.SolrFieldCreationHooks
 	SolrDataLoadHooks      hooks.SolrDataLoadHooks
 
+	Importers importer.Importers
+
 	Announcer        index.Announcer
 	TelemetryService *telemetry.Service
 
 	DuplicateDetectionAlgorithm cfg.DuplicateDetectionAlgorithm
+	StreamChunkSize             int
+	StreamLockTimeout           time.Duration
+
+	// If set, producers may only change or delete items owned by themselves or one of their owner groups
+	OwnerScopedWrites bool
+	OwnerGroups       []string
 
 	itemspb.UnimplementedItemsServer
 }
@@ -66,6 +87,24 @@ func AddJobItemIDs(ctx context.Context, j jobs.Jobber, itemIDs []string) {
 	}
 }
 
+/*
+EnqueueForIndexing records business IDs in the index outbox, from which the index service picks them up. It must be
+called with the transaction which creates or changes the items, so that the outbox entries are committed if and only if
+the items are. The announcement after the commit only serves to trigger the indexing without delay.
+*/
+func EnqueueForIndexing(ctx context.Context, tx pgx.Tx, businessIDs ...string) error {
+	queries := datamodel.New(tx)
+	for _, businessID := range businessIDs {
+		if businessID == "" {
+			continue
+		}
+		if err := queries.DbEnqueueIndexOutboxEntry(ctx, businessID); err != nil {
+			return fmt.Errorf("failed to add business ID to index outbox: %s", err.Error())
+		}
+	}
+	return nil
+}
+
 // This method makes the Service an rdb.TopicSubscriber
 func (svc *Service) Message(ctx context.Context, topic string, configHash string) {
 	if !strings.HasSuffix(topic, constants.ConfigUpdateChannelNameSuffix) {
@@ -74,6 +113,10 @@ func (svc *Service) Message(ctx context.Context, topic string, configHash string
 
 	_ = svc.EntityRepo.Purge(context.Background())
 	_ = svc.FieldRepo.Purge(context.Background())
+	_ = svc.VocabularyRepo.Purge(context.Background())
+	if svc.SearchConfigRepo != nil {
+		_ = svc.SearchConfigRepo.Purge(context.Background())
+	}
 
 	svc.TelemetryService.SetStatus(statuspb.Color_GREEN, configHash)
 }
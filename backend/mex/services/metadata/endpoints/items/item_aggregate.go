@@ -34,15 +34,14 @@ func (svc *Service) AggregateItems(ctx context.Context, request *itemspb.Aggrega
 	}
 
 	txCommit := false
+	var result *itemspb.AggregateItemsResponse
 	defer func() {
 		if txCommit {
 			if commitErr := tx.Commit(ctx); commitErr != nil {
 				svc.Log.Error(ctx, L.Messagef("commit error: %s", commitErr.Error()))
+			} else if result.NewBusinessId != "" {
+				svc.Announcer.AnnounceBusinessItemID(result.NewBusinessId)
 			}
-
-			// Do not announce items as we may be inside a bulk creation.
-			// Instead, we report back the new business ID and let the calling function take
-			// responsibility of the announcement.
 		} else {
 			svc.Log.Warn(ctx, L.Message("rolling back result of aggregation"))
 			if err := tx.Rollback(ctx); err != nil {
@@ -51,16 +50,22 @@ func (svc *Service) AggregateItems(ctx context.Context, request *itemspb.Aggrega
 		}
 	}()
 
-	result, aggErr := svc.doItemsAggregation(ctx, aggregationInfo{
+	result, err = svc.doItemsAggregation(ctx, aggregationInfo{
 		EntityType:          request.EntityType,
 		BusinessID:          request.BusinessId,
 		PreventAnnouncement: false, // Explicit aggregation triggers announcement of new items
 		DuplicateAlgorithm:  svc.DuplicateDetectionAlgorithm,
 		DbTx:                tx,
 	})
-	if aggErr != nil {
+	if err != nil {
 		svc.Log.Error(ctx, L.Messagef("aggregation: aggregation failed"))
-		return nil, E.MakeGRPCStatus(codes.Internal, "aggregation: aggregation failed", E.Cause(aggErr), request).Err()
+		return nil, E.MakeGRPCStatus(codes.Internal, "aggregation: aggregation failed", E.Cause(err), request).Err()
+	}
+
+	// Contrary to the aggregation triggered by an item creation (which leaves the announcement to the creation), the
+	// explicitly requested aggregation records the merged item for indexing itself.
+	if err := EnqueueForIndexing(ctx, tx, result.NewBusinessId); err != nil {
+		return nil, E.MakeGRPCStatus(codes.Internal, "aggregation: indexing could not be requested", E.Cause(err), request).Err()
 	}
 
 	txCommit = true
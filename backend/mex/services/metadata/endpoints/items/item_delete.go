@@ -5,7 +5,10 @@ import (
 	"math"
 	"net/http"
 
+	"google.golang.org/grpc/codes"
+
 	"github.com/d4l-data4life/mex/mex/shared/constants"
+	E "github.com/d4l-data4life/mex/mex/shared/errstat"
 	"github.com/d4l-data4life/mex/mex/shared/hints"
 	"github.com/d4l-data4life/mex/mex/shared/log"
 
@@ -16,7 +19,12 @@ import (
 func (svc *Service) DeleteItem(ctx context.Context, request *itemspb.DeleteItemRequest) (*itemspb.DeleteItemResponse, error) {
 	queries := datamodel.New(svc.DB)
 
-	err := queries.DbDeleteItem(ctx, request.ItemId)
+	err := svc.checkItemOwnership(ctx, queries, []string{request.ItemId}, request)
+	if err != nil {
+		return nil, err
+	}
+
+	err = queries.DbDeleteItem(ctx, request.ItemId)
 	if err != nil {
 		return nil, err
 	}
@@ -70,6 +78,9 @@ func (svc *Service) DeleteItems(ctx context.Context, request *itemspb.DeleteItem
 		itemsToDelete[i] = iID
 		i++
 	}
+	if err := svc.checkItemOwnership(ctx, queries, itemsToDelete, request); err != nil {
+		return nil, err
+	}
 	rawAffectedRowNo, err := queries.DbDeleteItems(ctx, itemsToDelete)
 	if err != nil {
 		return nil, err
@@ -97,6 +108,10 @@ func (svc *Service) DeleteItems(ctx context.Context, request *itemspb.DeleteItem
 }
 
 func (svc *Service) DeleteAllItems(ctx context.Context, request *itemspb.DeleteAllItemsRequest) (*itemspb.DeleteAllItemsResponse, error) {
+	if svc.OwnerScopedWrites {
+		return nil, E.MakeGRPCStatus(codes.PermissionDenied, "deleting all items is not allowed with owner-scoped writes", request).Err()
+	}
+
 	queries := datamodel.New(svc.DB)
 
 	err := queries.DbDeleteAllItems(ctx)
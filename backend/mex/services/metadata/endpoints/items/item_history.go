@@ -0,0 +1,81 @@
+package items
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/datamodel"
+	itemspb "github.com/d4l-data4life/mex/mex/services/metadata/endpoints/items/pb"
+)
+
+func (svc *Service) GetItemHistory(ctx context.Context, request *itemspb.GetItemHistoryRequest) (*itemspb.GetItemHistoryResponse, error) {
+	queries := datamodel.New(svc.DB)
+
+	visible, err := svc.visibleItemIDs(ctx, queries, []string{request.ItemId})
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []datamodel.ItemValue
+	if visible[request.ItemId] {
+		rows, err = queries.DbGetItemValueHistory(ctx, request.ItemId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(rows) == 0 {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no value history for item '%s' (it does either not exist or has no values)", request.ItemId))
+	}
+
+	return &itemspb.GetItemHistoryResponse{
+		ItemId:    request.ItemId,
+		Revisions: classifyRevisions(rows),
+	}, nil
+}
+
+/*
+classifyRevisions determines the kind of change each row of the item value table represents. The rows must be ordered
+by revision. The first revision of a value ID is its addition. Each later revision replaces the previous one, unless it
+is the last revision of the value and marked as deleted, in which case the value was removed.
+*/
+func classifyRevisions(rows []datamodel.ItemValue) []*itemspb.GetItemHistoryResponse_ValueRevision {
+	remaining := make(map[string]int)
+	for _, row := range rows {
+		remaining[row.ID]++
+	}
+
+	seen := make(map[string]bool)
+	revisions := make([]*itemspb.GetItemHistoryResponse_ValueRevision, len(rows))
+	for i, row := range rows {
+		remaining[row.ID]--
+
+		change := itemspb.GetItemHistoryResponse_REPLACED
+		switch {
+		case !seen[row.ID]:
+			change = itemspb.GetItemHistoryResponse_ADDED
+		case row.Deleted && remaining[row.ID] == 0:
+			change = itemspb.GetItemHistoryResponse_REMOVED
+		}
+		seen[row.ID] = true
+
+		revisions[i] = &itemspb.GetItemHistoryResponse_ValueRevision{
+			ItemValueId:     row.ID,
+			Revision:        row.Revision,
+			Change:          change,
+			FieldName:       row.FieldName,
+			FieldValue:      row.FieldValue,
+			Language:        row.Language.String,
+			Place:           row.Place,
+			Author:          row.Author.String,
+			CreatedAt:       timestamppb.New(row.CreatedAt.Time),
+			RevisionComment: row.RevisionComment.String,
+		}
+	}
+
+	return revisions
+}
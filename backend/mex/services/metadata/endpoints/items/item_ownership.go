@@ -0,0 +1,76 @@
+package items
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/runtime/protoiface"
+
+	"github.com/d4l-data4life/mex/mex/shared/auth"
+	E "github.com/d4l-data4life/mex/mex/shared/errstat"
+	"github.com/d4l-data4life/mex/mex/shared/utils"
+
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/datamodel"
+)
+
+/*
+With owner-scoped writes, an item may only be updated or deleted (or superseded by a new version) by its owner, i.e.
+the user who created it, or by a member of one of the owner groups the owner belonged to when creating the item.
+Owner groups are configured explicitly so that unrelated token groups do not grant write access.
+*/
+
+// itemOwnerGroups returns the configured owner groups the user is a member of
+func itemOwnerGroups(userGroups []string, ownerGroups []string) []string {
+	groups := []string{}
+	for _, group := range userGroups {
+		if utils.Contains(ownerGroups, group) && !utils.Contains(groups, group) {
+			groups = append(groups, group)
+		}
+	}
+	return groups
+}
+
+// recordItemOwnerGroups shares the ownership of a newly created item with the owner groups of the user
+func (svc *Service) recordItemOwnerGroups(ctx context.Context, queries *datamodel.Queries, itemID string, user *auth.Claims) error {
+	groups := itemOwnerGroups(user.Groups, svc.OwnerGroups)
+	if len(groups) == 0 {
+		return nil
+	}
+
+	err := queries.DbAddItemOwnerGroups(ctx, datamodel.DbAddItemOwnerGroupsParams{
+		ItemID:   itemID,
+		GroupIds: groups,
+	})
+	if err != nil {
+		return status.Error(codes.Internal, fmt.Sprintf("failed to record item owner groups: %s", err.Error()))
+	}
+	return nil
+}
+
+// checkItemOwnership fails with PermissionDenied if owner-scoped writes are on and the user does not own all the items
+func (svc *Service) checkItemOwnership(ctx context.Context, queries *datamodel.Queries, itemIDs []string, request protoiface.MessageV1) error {
+	if !svc.OwnerScopedWrites || len(itemIDs) == 0 {
+		return nil
+	}
+
+	user, err := auth.GetMexUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	foreignItemIDs, err := queries.DbListForeignItemIDs(ctx, datamodel.DbListForeignItemIDsParams{
+		ItemIds:  itemIDs,
+		Owner:    user.UserId,
+		GroupIds: itemOwnerGroups(user.Groups, svc.OwnerGroups),
+	})
+	if err != nil {
+		return status.Error(codes.Internal, fmt.Sprintf("failed to check item ownership: %s", err.Error()))
+	}
+	if len(foreignItemIDs) > 0 {
+		return E.MakeGRPCStatus(codes.PermissionDenied, fmt.Sprintf("items not owned by the user: %s", strings.Join(foreignItemIDs, ", ")), request).Err()
+	}
+	return nil
+}
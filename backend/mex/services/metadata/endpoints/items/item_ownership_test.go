@@ -0,0 +1,47 @@
+package items
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_itemOwnerGroups(t *testing.T) {
+	tests := []struct {
+		name        string
+		userGroups  []string
+		ownerGroups []string
+		want        []string
+	}{
+		{
+			name:        "No owner groups configured",
+			userGroups:  []string{"g1", "g2"},
+			ownerGroups: nil,
+			want:        []string{},
+		},
+		{
+			name:        "User without groups",
+			userGroups:  nil,
+			ownerGroups: []string{"g1"},
+			want:        []string{},
+		},
+		{
+			name:        "Only the configured owner groups are used",
+			userGroups:  []string{"g1", "g2", "g3"},
+			ownerGroups: []string{"g3", "g1"},
+			want:        []string{"g1", "g3"},
+		},
+		{
+			name:        "Duplicate groups are ignored",
+			userGroups:  []string{"g1", "g1"},
+			ownerGroups: []string{"g1"},
+			want:        []string{"g1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := itemOwnerGroups(tt.userGroups, tt.ownerGroups); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("itemOwnerGroups() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
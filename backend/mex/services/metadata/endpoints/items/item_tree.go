@@ -14,6 +14,11 @@ import (
 	pbItems "github.com/d4l-data4life/mex/mex/services/metadata/endpoints/items/pb"
 )
 
+/*
+ComputeItemsTree returns the nodes of a hierarchy (e.g. an organizational structure) with their display values. The
+nodes are not subject to the visibility rules: they are the values of the hierarchy axes, which restricted users need to
+navigate and which the search shows in its facets as well.
+*/
 func (svc *Service) ComputeItemsTree(ctx context.Context, request *pbItems.ComputeItemsTreeRequest) (*pbItems.ComputeItemsTreeResponse, error) {
 	if request.NodeEntityType == "" || request.LinkFieldName == "" {
 		return nil, errstat.MakeGRPCStatus(codes.InvalidArgument, "specify node entity type and link field name", request).Err()
@@ -0,0 +1,347 @@
+package items
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/d4l-data4life/mex/mex/shared/auth"
+	"github.com/d4l-data4life/mex/mex/shared/db"
+	"github.com/d4l-data4life/mex/mex/shared/entities"
+	E "github.com/d4l-data4life/mex/mex/shared/errstat"
+	"github.com/d4l-data4life/mex/mex/shared/items"
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+	"github.com/d4l-data4life/mex/mex/shared/uuid"
+
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/canonical"
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/datamodel"
+	itemspb "github.com/d4l-data4life/mex/mex/services/metadata/endpoints/items/pb"
+)
+
+/*
+UpdateItem adds, replaces or removes individual values of an existing item.
+
+Item values are never changed in place: a replaced or removed value is retired (its current row is marked as deleted)
+and a new row with the same value ID and a new revision is written. For removals, the new row is itself marked as
+deleted so that the history of a value always ends with the reason it disappeared from the item.
+*/
+func (svc *Service) UpdateItem(ctx context.Context, request *itemspb.UpdateItemRequest) (*itemspb.UpdateItemResponse, error) {
+	if request.ItemId == "" {
+		return nil, E.MakeGRPCStatus(codes.InvalidArgument, "no item ID given", request).Err()
+	}
+	if len(request.Updates) == 0 {
+		return nil, E.MakeGRPCStatus(codes.InvalidArgument, "no value updates given", request).Err()
+	}
+
+	user, err := auth.GetMexUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := db.AcquireTx(ctx, svc.DB)
+	if err != nil {
+		return nil, fmt.Errorf("transaction creation failed: %s", err.Error())
+	}
+
+	// As for item creation, the announcement must only happen AFTER the transaction is committed.
+	txCommit := false
+	var item datamodel.Item
+	var aggregatedBusinessID string
+	defer func() {
+		if txCommit {
+			if err := tx.Commit(ctx); err != nil {
+				svc.Log.Error(ctx, L.Messagef("commit error: %s", err.Error()))
+			} else {
+				svc.Announcer.AnnounceTechnicalItemID(item.ID)
+				if aggregatedBusinessID != "" {
+					svc.Announcer.AnnounceBusinessItemID(aggregatedBusinessID)
+				}
+			}
+		} else {
+			if err := tx.Rollback(ctx); err != nil {
+				svc.Log.Error(ctx, L.Messagef("rollback error: %s", err.Error()))
+			}
+		}
+	}()
+
+	queries := datamodel.New(tx)
+
+	// Lock the item row so that concurrent updates of the same item are serialized.
+	item, err = queries.DbGetItemForUpdate(ctx, request.ItemId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, E.MakeGRPCStatus(codes.NotFound, "item not found", request).Err()
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to fetch item from DB: %s", err.Error()))
+	}
+	if err := svc.checkItemOwnership(ctx, queries, []string{item.ID}, request); err != nil {
+		return nil, err
+	}
+
+	currentValues, err := queries.DbGetItemValues(ctx, item.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to fetch item values from DB: %s", err.Error()))
+	}
+
+	revisions, err := planValueRevisions(currentValues, request.Updates)
+	if err != nil {
+		return nil, E.MakeGRPCStatus(codes.InvalidArgument, err.Error(), request).Err()
+	}
+
+	for _, rev := range revisions {
+		// The business ID is fixed when the item is created and determines its versioning, hence it cannot be patched.
+		if item.BusinessIDFieldName.Valid && rev.value.FieldName == item.BusinessIDFieldName.String {
+			return nil, E.MakeGRPCStatus(codes.InvalidArgument, fmt.Sprintf("business ID field cannot be updated: %s", rev.value.FieldName), request).Err()
+		}
+		if !rev.deleted {
+			if valErr := svc.validateFieldValue(ctx, rev.value.FieldName, rev.value.FieldValue); valErr != nil {
+				return nil, E.MakeGRPCStatus(codes.InvalidArgument, valErr.Error(), request).Err()
+			}
+		}
+	}
+
+	entityType, err := svc.EntityRepo.GetEntityType(ctx, item.EntityName)
+	if err != nil {
+		return nil, fmt.Errorf("unknown entity type: %s: %s", item.EntityName, err.Error())
+	}
+	values := resultingValues(currentValues, revisions)
+	if err := svc.checkItemRules(ctx, entityType, values); err != nil {
+		return nil, E.MakeGRPCStatus(codes.InvalidArgument, err.Error(), request).Err()
+	}
+
+	now := time.Now()
+	valueIDs := make([]string, len(revisions))
+	var latestRevision int32
+	for i, rev := range revisions {
+		valueID := rev.supersedes
+		if valueID == "" {
+			valueID = uuid.MustNewV4()
+		} else {
+			retired, retireErr := queries.DbRetireItemValue(ctx, valueID)
+			if retireErr != nil {
+				return nil, status.Error(codes.Internal, fmt.Sprintf("failed to retire item value in DB: %s", retireErr.Error()))
+			}
+			if retired != 1 {
+				return nil, status.Error(codes.Internal, fmt.Sprintf("expected to retire one row of item value %s, but retired %d", valueID, retired))
+			}
+		}
+
+		created, createErr := queries.DbCreateItemValueRevision(ctx, datamodel.DbCreateItemValueRevisionParams{
+			CreatedAt:       pgtype.Timestamptz{Time: now, Valid: true},
+			ID:              valueID,
+			Deleted:         rev.deleted,
+			FieldName:       rev.value.FieldName,
+			FieldValue:      rev.value.FieldValue,
+			Language:        db.TextFromString(rev.value.Language),
+			Place:           rev.value.Place,
+			ItemID:          item.ID,
+			RevisionComment: db.TextFromString(request.RevisionComment),
+			Author:          db.TextFromString(user.UserId),
+		})
+		if createErr != nil {
+			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to create item value revision in DB: %s", createErr.Error()))
+		}
+
+		valueIDs[i] = created.ID
+		if created.Revision > latestRevision {
+			latestRevision = created.Revision
+		}
+	}
+
+	// The fingerprint must reflect the updated values, otherwise duplicate detection compares uploads against the old ones.
+	err = queries.DbUpdateItemHash(ctx, datamodel.DbUpdateItemHashParams{
+		Hash: db.TextFromString(fingerprint(item, values)),
+		ID:   item.ID,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to update item hash in DB: %s", err.Error()))
+	}
+
+	// Changed fragments need to be re-aggregated, exactly as if a new fragment version had been created.
+	if entityType.Config.AggregationAlgorithm != "" && item.BusinessID.Valid {
+		aggregatedBusinessID, err = svc.runAggregationLogic(ctx, aggregationLogicArgs{
+			sourceEntityType:   item.EntityName,
+			sourceBusinessID:   item.BusinessID.String,
+			duplicateAlgorithm: svc.DuplicateDetectionAlgorithm,
+			dbTx:               tx,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := EnqueueForIndexing(ctx, tx, aggregatedBusinessID); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	svc.Log.Info(ctx, L.Messagef("item updated: %s (%d value revisions)", item.ID, len(revisions)))
+	txCommit = true
+
+	return &itemspb.UpdateItemResponse{
+		ItemId:       item.ID,
+		ItemValueIds: valueIDs,
+		Revision:     latestRevision,
+	}, nil
+}
+
+/*
+checkItemRules checks the values the item has after the update against the required fields and the schema of its entity
+type, exactly as the values of a newly created item are checked.
+*/
+func (svc *Service) checkItemRules(ctx context.Context, entityType *entities.EntityType, values []*items.ItemValue) error {
+	if err := svc.checkRequiredFields(ctx, entityType.Name, values); err != nil {
+		return err
+	}
+	if violations := entities.CheckSchema(entityType, values); len(violations) > 0 {
+		return fmt.Errorf("item does not conform to the schema of entity type %s: %s", entityType.Name, strings.Join(violations, "; "))
+	}
+	return nil
+}
+
+/*
+resultingValues returns the current values of the item after applying the value revisions, ordered by field name and
+place like the current values read from the DB.
+*/
+func resultingValues(current []datamodel.CurrentItemValue, revisions []valueRevision) []*items.ItemValue {
+	superseded := make(map[string]bool, len(revisions))
+	for _, rev := range revisions {
+		if rev.supersedes != "" {
+			superseded[rev.supersedes] = true
+		}
+	}
+
+	var values []placedItemValue
+	for _, v := range current {
+		if !superseded[v.ID] {
+			values = append(values, placedItemValue{FieldName: v.FieldName, FieldValue: v.FieldValue, Language: v.Language.String, Place: v.Place})
+		}
+	}
+	for _, rev := range revisions {
+		if !rev.deleted {
+			values = append(values, rev.value)
+		}
+	}
+	sort.SliceStable(values, func(i, j int) bool {
+		if values[i].FieldName != values[j].FieldName {
+			return values[i].FieldName < values[j].FieldName
+		}
+		return values[i].Place < values[j].Place
+	})
+
+	result := make([]*items.ItemValue, len(values))
+	for i, v := range values {
+		result[i] = &items.ItemValue{FieldName: v.FieldName, FieldValue: v.FieldValue, Language: v.Language}
+	}
+	return result
+}
+
+/*
+fingerprint computes the hash of an item with the given values as it is computed for uploads (see canonical.Fingerprint).
+Uploads usually carry the business ID as value of the business ID field only, so it is only part of the fingerprint if
+it is not among the values.
+*/
+func fingerprint(item datamodel.Item, values []*items.ItemValue) string {
+	businessID := item.BusinessID.String
+	for _, v := range values {
+		if item.BusinessIDFieldName.Valid && v.FieldName == item.BusinessIDFieldName.String {
+			businessID = ""
+			break
+		}
+	}
+	return canonical.Fingerprint(&items.Item{EntityType: item.EntityName, BusinessId: businessID, Values: values})
+}
+
+// valueRevision describes a single new row to be written into the item value table.
+type valueRevision struct {
+	// ID of the current item value replaced or removed by this revision; empty for new values.
+	supersedes string
+	value      placedItemValue
+	deleted    bool
+}
+
+type fieldPlace struct {
+	fieldName string
+	place     int32
+}
+
+/*
+planValueRevisions turns the requested value updates into the list of new value revisions, given the current values
+of the item. New values are appended after the highest place currently used by their field. Each existing value can
+only be touched once per update.
+*/
+func planValueRevisions(current []datamodel.CurrentItemValue, updates []*itemspb.UpdateItemRequest_ValueUpdate) ([]valueRevision, error) {
+	byPlace := make(map[fieldPlace]datamodel.CurrentItemValue, len(current))
+	nextPlace := make(map[string]int32)
+	for _, v := range current {
+		byPlace[fieldPlace{v.FieldName, v.Place}] = v
+		if v.Place >= nextPlace[v.FieldName] {
+			nextPlace[v.FieldName] = v.Place + 1
+		}
+	}
+
+	touched := make(map[string]bool)
+	revisions := make([]valueRevision, len(updates))
+	for i, u := range updates {
+		if u.FieldName == "" {
+			return nil, fmt.Errorf("update %d: no field name given", i+1)
+		}
+
+		if u.Operation == itemspb.UpdateItemRequest_ADD {
+			revisions[i] = valueRevision{
+				value: placedItemValue{
+					FieldName:  u.FieldName,
+					FieldValue: u.FieldValue,
+					Language:   u.Language,
+					Place:      nextPlace[u.FieldName],
+				},
+			}
+			nextPlace[u.FieldName]++
+			continue
+		}
+
+		existing, ok := byPlace[fieldPlace{u.FieldName, u.Place}]
+		if !ok {
+			return nil, fmt.Errorf("update %d: item has no value for field %s at place %d", i+1, u.FieldName, u.Place)
+		}
+		if touched[existing.ID] {
+			return nil, fmt.Errorf("update %d: value for field %s at place %d is updated more than once", i+1, u.FieldName, u.Place)
+		}
+		touched[existing.ID] = true
+
+		switch u.Operation {
+		case itemspb.UpdateItemRequest_REPLACE:
+			revisions[i] = valueRevision{
+				supersedes: existing.ID,
+				value: placedItemValue{
+					FieldName:  u.FieldName,
+					FieldValue: u.FieldValue,
+					Language:   u.Language,
+					Place:      existing.Place,
+				},
+			}
+		case itemspb.UpdateItemRequest_REMOVE:
+			revisions[i] = valueRevision{
+				supersedes: existing.ID,
+				value: placedItemValue{
+					FieldName:  existing.FieldName,
+					FieldValue: existing.FieldValue,
+					Language:   existing.Language.String,
+					Place:      existing.Place,
+				},
+				deleted: true,
+			}
+		default:
+			return nil, fmt.Errorf("update %d: unknown operation: %s", i+1, u.Operation)
+		}
+	}
+
+	return revisions, nil
+}
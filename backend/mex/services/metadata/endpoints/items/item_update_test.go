@@ -0,0 +1,214 @@
+package items
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/d4l-data4life/mex/mex/shared/entities"
+	sharedFields "github.com/d4l-data4life/mex/mex/shared/fields"
+	"github.com/d4l-data4life/mex/mex/shared/items"
+
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/canonical"
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/datamodel"
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/fields"
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/fields/frepo"
+	kind_string "github.com/d4l-data4life/mex/mex/services/metadata/business/fields/kinds/string"
+	itemspb "github.com/d4l-data4life/mex/mex/services/metadata/endpoints/items/pb"
+)
+
+func Test_planValueRevisions(t *testing.T) {
+	current := []datamodel.CurrentItemValue{
+		{ID: "v1", FieldName: "author", FieldValue: "Turing", Place: 0},
+		{ID: "v2", FieldName: "author", FieldValue: "Brown", Place: 1},
+		{ID: "v3", FieldName: "title", FieldValue: "On Computable Numbers", Place: 0, Language: pgtype.Text{String: "en", Valid: true}},
+	}
+
+	tests := []struct {
+		name    string
+		updates []*itemspb.UpdateItemRequest_ValueUpdate
+		want    []valueRevision
+		wantErr bool
+	}{
+		{
+			name: "Added values are placed after the existing values of the field",
+			updates: []*itemspb.UpdateItemRequest_ValueUpdate{
+				{Operation: itemspb.UpdateItemRequest_ADD, FieldName: "author", FieldValue: "Church"},
+				{Operation: itemspb.UpdateItemRequest_ADD, FieldName: "author", FieldValue: "Gödel"},
+				{Operation: itemspb.UpdateItemRequest_ADD, FieldName: "keyword", FieldValue: "computability"},
+			},
+			want: []valueRevision{
+				{value: placedItemValue{FieldName: "author", FieldValue: "Church", Place: 2}},
+				{value: placedItemValue{FieldName: "author", FieldValue: "Gödel", Place: 3}},
+				{value: placedItemValue{FieldName: "keyword", FieldValue: "computability", Place: 0}},
+			},
+		},
+		{
+			name: "Replaced values keep their ID and place",
+			updates: []*itemspb.UpdateItemRequest_ValueUpdate{
+				{Operation: itemspb.UpdateItemRequest_REPLACE, FieldName: "author", Place: 1, FieldValue: "E.L. Brown"},
+			},
+			want: []valueRevision{
+				{supersedes: "v2", value: placedItemValue{FieldName: "author", FieldValue: "E.L. Brown", Place: 1}},
+			},
+		},
+		{
+			name: "Removed values are written as deleted copies of the current value",
+			updates: []*itemspb.UpdateItemRequest_ValueUpdate{
+				{Operation: itemspb.UpdateItemRequest_REMOVE, FieldName: "title", Place: 0},
+			},
+			want: []valueRevision{
+				{supersedes: "v3", value: placedItemValue{FieldName: "title", FieldValue: "On Computable Numbers", Language: "en", Place: 0}, deleted: true},
+			},
+		},
+		{
+			name: "Replacing a non-existing value is an error",
+			updates: []*itemspb.UpdateItemRequest_ValueUpdate{
+				{Operation: itemspb.UpdateItemRequest_REPLACE, FieldName: "author", Place: 5, FieldValue: "Post"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Touching the same value twice is an error",
+			updates: []*itemspb.UpdateItemRequest_ValueUpdate{
+				{Operation: itemspb.UpdateItemRequest_REPLACE, FieldName: "author", Place: 0, FieldValue: "A.M. Turing"},
+				{Operation: itemspb.UpdateItemRequest_REMOVE, FieldName: "author", Place: 0},
+			},
+			wantErr: true,
+		},
+		{
+			name: "An empty field name is an error",
+			updates: []*itemspb.UpdateItemRequest_ValueUpdate{
+				{Operation: itemspb.UpdateItemRequest_ADD, FieldValue: "x"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := planValueRevisions(current, tt.updates)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("planValueRevisions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("planValueRevisions() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_fingerprint(t *testing.T) {
+	item := datamodel.Item{
+		EntityName:          "Resource",
+		BusinessIDFieldName: pgtype.Text{String: "identifier", Valid: true},
+		BusinessID:          pgtype.Text{String: "R1", Valid: true},
+	}
+	current := []datamodel.CurrentItemValue{
+		{ID: "v1", FieldName: "author", FieldValue: "Turing", Place: 0},
+		{ID: "v2", FieldName: "author", FieldValue: "Brown", Place: 1},
+		{ID: "v3", FieldName: "identifier", FieldValue: "R1", Place: 0},
+		{ID: "v4", FieldName: "title", FieldValue: "On Computable Numbers", Place: 0, Language: pgtype.Text{String: "en", Valid: true}},
+	}
+	upload := func(values ...*items.ItemValue) string {
+		return canonical.Fingerprint(&items.Item{EntityType: "Resource", Values: values})
+	}
+
+	tests := []struct {
+		name      string
+		revisions []valueRevision
+		want      string
+	}{
+		{
+			name: "Unchanged",
+			want: upload(
+				&items.ItemValue{FieldName: "author", FieldValue: "Turing"},
+				&items.ItemValue{FieldName: "author", FieldValue: "Brown"},
+				&items.ItemValue{FieldName: "identifier", FieldValue: "R1"},
+				&items.ItemValue{FieldName: "title", FieldValue: "On Computable Numbers", Language: "en"},
+			),
+		},
+		{
+			name: "Added, replaced and removed values",
+			revisions: []valueRevision{
+				{value: placedItemValue{FieldName: "author", FieldValue: "Church", Place: 2}},
+				{supersedes: "v1", value: placedItemValue{FieldName: "author", FieldValue: "A.M. Turing", Place: 0}},
+				{supersedes: "v4", value: placedItemValue{FieldName: "title", FieldValue: "On Computable Numbers", Language: "en"}, deleted: true},
+			},
+			want: upload(
+				&items.ItemValue{FieldName: "author", FieldValue: "A.M. Turing"},
+				&items.ItemValue{FieldName: "author", FieldValue: "Brown"},
+				&items.ItemValue{FieldName: "author", FieldValue: "Church"},
+				&items.ItemValue{FieldName: "identifier", FieldValue: "R1"},
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fingerprint(item, resultingValues(current, tt.revisions)); got != tt.want {
+				t.Errorf("fingerprint() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_checkItemRules(t *testing.T) {
+	titleRules, err := fields.NewValueRules(&sharedFields.IndexDefExtValidation{RequiredForEntityTypes: []string{"Resource"}})
+	if err != nil {
+		t.Fatalf("NewValueRules() error = %v", err)
+	}
+	svc := &Service{
+		FieldRepo: frepo.NewMockedFieldRepo([]fields.BaseFieldDef{
+			fields.NewBaseFieldDef("author", kind_string.KindName, "", false, fields.BaseIndexDef{}),
+			fields.NewBaseFieldDef("title", kind_string.KindName, "", false, fields.BaseIndexDef{ValueRules: titleRules}),
+		}),
+	}
+	entityType := &entities.EntityType{
+		Name: "Resource",
+		Config: &entities.EntityTypeConfig{
+			BusinessIdFieldName: "identifier",
+			Schema:              &entities.EntityTypeSchema{AllowedFields: []string{"title"}},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		values  []*items.ItemValue
+		wantErr string
+	}{
+		{
+			name: "Conforming",
+			values: []*items.ItemValue{
+				{FieldName: "identifier", FieldValue: "R1"},
+				{FieldName: "title", FieldValue: "On Computable Numbers"},
+			},
+		},
+		{
+			name:    "Required field removed",
+			values:  []*items.ItemValue{{FieldName: "identifier", FieldValue: "R1"}},
+			wantErr: "field rules",
+		},
+		{
+			name: "Field not allowed by the schema",
+			values: []*items.ItemValue{
+				{FieldName: "author", FieldValue: "Turing"},
+				{FieldName: "title", FieldValue: "On Computable Numbers"},
+			},
+			wantErr: "schema",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := svc.checkItemRules(context.Background(), entityType, tt.values)
+			if tt.wantErr == "" && err != nil {
+				t.Errorf("checkItemRules() error = %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("checkItemRules() error = %v, want an error about the %s", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package items
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/d4l-data4life/mex/mex/shared/auth"
+
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/datamodel"
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/visibility"
+)
+
+// resolveVisibility returns the visibility rules of the requesting user as passed to the queries (nil: not restricted).
+func (svc *Service) resolveVisibility(ctx context.Context, queries *datamodel.Queries) ([]byte, error) {
+	user, err := auth.GetMexUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return svc.Visibility.Resolve(ctx, queries, user)
+}
+
+// visibleItemIDs returns the set of the given items which the requesting user may see.
+func (svc *Service) visibleItemIDs(ctx context.Context, queries *datamodel.Queries, itemIDs []string) (map[string]bool, error) {
+	rules, err := svc.resolveVisibility(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return visibleSet(ctx, queries, rules, itemIDs)
+}
+
+// visibleSet returns the set of the given items which are visible under the given (resolved) visibility rules.
+func visibleSet(ctx context.Context, queries *datamodel.Queries, rules []byte, itemIDs []string) (map[string]bool, error) {
+	visibleIDs, err := visibility.VisibleItemIDs(ctx, queries, rules, itemIDs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to check item visibility: %s", err.Error()))
+	}
+
+	visible := make(map[string]bool, len(visibleIDs))
+	for _, itemID := range visibleIDs {
+		visible[itemID] = true
+	}
+	return visible, nil
+}
+
+// visibleVersions drops the versions of a business ID which the requesting user must not see.
+func (svc *Service) visibleVersions(ctx context.Context, queries *datamodel.Queries, versions []datamodel.ItemsWithBusinessID) ([]datamodel.ItemsWithBusinessID, error) {
+	itemIDs := make([]string, len(versions))
+	for i, version := range versions {
+		itemIDs[i] = version.ItemID
+	}
+
+	visible, err := svc.visibleItemIDs(ctx, queries, itemIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]datamodel.ItemsWithBusinessID, 0, len(versions))
+	for _, version := range versions {
+		if visible[version.ItemID] {
+			result = append(result, version)
+		}
+	}
+	return result, nil
+}
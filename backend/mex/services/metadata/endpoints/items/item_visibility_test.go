@@ -0,0 +1,156 @@
+package items
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/d4l-data4life/mex/mex/shared/auth"
+	"github.com/d4l-data4life/mex/mex/shared/constants"
+	"github.com/d4l-data4life/mex/mex/shared/db"
+
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/visibility"
+	itemspb "github.com/d4l-data4life/mex/mex/services/metadata/endpoints/items/pb"
+)
+
+// visibilityHandler answers the item queries for item-1; the visibility check reports it as (in)visible.
+func visibilityHandler(visible bool) db.MockHandler {
+	return func(stmt db.MockStatement) db.MockResult {
+		switch stmt.Name {
+		case "DbGetItem":
+			return db.MockResult{Rows: [][]any{{nil, "item-1", "producer-1", "Resource"}}}
+		case "DbListVisibleItemIDs":
+			if visible {
+				return db.MockResult{Rows: [][]any{{"item-1"}}}
+			}
+			return db.MockResult{Rows: [][]any{}}
+		case "DbListItems":
+			return db.MockResult{Rows: [][]any{}}
+		}
+		return db.MockResult{}
+	}
+}
+
+func newVisibilityTestService(visible bool, claims *auth.Claims) (*Service, *db.MockTx, context.Context) {
+	svc, _, tx, ctx := newTestService(visibilityHandler(visible))
+	svc.DB = tx
+	svc.Visibility = &visibility.Resolver{}
+	return svc, tx, context.WithValue(ctx, constants.ContextKeyUserClaims, claims)
+}
+
+var (
+	unrestrictedClaims = &auth.Claims{UserId: "producer-1"}
+	restrictedClaims   = &auth.Claims{
+		UserId:               "consumer-1",
+		VisibilityRestricted: true,
+		Visibility:           []*auth.VisibilityRule{{EntityTypes: []string{"Activity"}}},
+	}
+)
+
+func TestService_GetItem_visibility(t *testing.T) {
+	tests := []struct {
+		name        string
+		claims      *auth.Claims
+		visible     bool
+		wantChecked bool
+		wantItem    bool
+	}{
+		{
+			name:        "Unrestricted user",
+			claims:      unrestrictedClaims,
+			wantChecked: false,
+			wantItem:    true,
+		},
+		{
+			name:        "Restricted user, visible item",
+			claims:      restrictedClaims,
+			visible:     true,
+			wantChecked: true,
+			wantItem:    true,
+		},
+		{
+			name:        "Restricted user, invisible item",
+			claims:      restrictedClaims,
+			visible:     false,
+			wantChecked: true,
+			wantItem:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, tx, ctx := newVisibilityTestService(tt.visible, tt.claims)
+
+			response, err := svc.GetItem(ctx, &itemspb.GetItemRequest{ItemId: "item-1"})
+			if err != nil {
+				t.Fatalf("GetItem() error = %v", err)
+			}
+			if got := len(tx.Executed("DbListVisibleItemIDs")) > 0; got != tt.wantChecked {
+				t.Errorf("GetItem() checked visibility = %v, want %v", got, tt.wantChecked)
+			}
+			if got := response.ItemId == "item-1"; got != tt.wantItem {
+				t.Errorf("GetItem() returned item = %v, want %v", got, tt.wantItem)
+			}
+			if got := len(tx.Executed("DbGetItemValues")) > 0; got != tt.wantItem {
+				t.Errorf("GetItem() read values = %v, want %v", got, tt.wantItem)
+			}
+		})
+	}
+}
+
+func TestService_ListItems_visibility(t *testing.T) {
+	tests := []struct {
+		name           string
+		claims         *auth.Claims
+		wantVisibility string
+	}{
+		{
+			name:           "Unrestricted user",
+			claims:         unrestrictedClaims,
+			wantVisibility: "",
+		},
+		{
+			name:           "Restricted user",
+			claims:         restrictedClaims,
+			wantVisibility: `[{"entityTypes":["Activity"]}]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, tx, ctx := newVisibilityTestService(false, tt.claims)
+
+			response, err := svc.ListItems(ctx, &itemspb.ListItemsRequest{})
+			if err != nil {
+				t.Fatalf("ListItems() error = %v", err)
+			}
+			if len(response.Items) != 0 {
+				t.Errorf("ListItems() = %v, want no items", response.Items)
+			}
+
+			statements := tx.Executed("DbListItems")
+			if len(statements) != 1 {
+				t.Fatalf("ListItems() executed DbListItems %d times, want 1", len(statements))
+			}
+			got, _ := statements[0].Args[1].([]byte)
+			if string(got) != tt.wantVisibility {
+				t.Errorf("ListItems() visibility = %s, want %s", got, tt.wantVisibility)
+			}
+			if tt.wantVisibility == "" && got != nil {
+				t.Errorf("ListItems() visibility = %v, want nil", got)
+			}
+		})
+	}
+}
+
+func TestService_GetItemHistory_visibility(t *testing.T) {
+	svc, tx, ctx := newVisibilityTestService(false, restrictedClaims)
+
+	_, err := svc.GetItemHistory(ctx, &itemspb.GetItemHistoryRequest{ItemId: "item-1"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("GetItemHistory() error = %v, want NotFound", err)
+	}
+	if len(tx.Executed("DbGetItemValueHistory")) > 0 {
+		t.Errorf("GetItemHistory() read the history of an invisible item")
+	}
+}
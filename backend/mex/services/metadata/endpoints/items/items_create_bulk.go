@@ -4,17 +4,20 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/runtime/protoiface"
 
 	"github.com/d4l-data4life/mex/mex/shared/auth"
 	"github.com/d4l-data4life/mex/mex/shared/cfg"
 	"github.com/d4l-data4life/mex/mex/shared/constants"
 	"github.com/d4l-data4life/mex/mex/shared/db"
+	"github.com/d4l-data4life/mex/mex/shared/entities"
 	"github.com/d4l-data4life/mex/mex/shared/errstat"
 	"github.com/d4l-data4life/mex/mex/shared/hints"
 	"github.com/d4l-data4life/mex/mex/shared/items"
@@ -37,16 +40,49 @@ func (svc *Service) CreateItemsBulk(ctx context.Context, request *pbItems.Create
 		return nil, errstat.MakeGRPCStatus(codes.InvalidArgument, "no items to be created passed", request).Err()
 	}
 
+	jobID, err := svc.startItemsCreationJob(ctx, "bulk item creation", request.Items, request, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// Synchronous return
+	hints.HintHTTPStatusCode(ctx, http.StatusCreated)
+	return &pbItems.CreateItemsBulkResponse{JobId: jobID}, nil
+}
+
+// duplicateAlgorithmRequest is a request which may override the configured duplicate detection algorithm.
+type duplicateAlgorithmRequest interface {
+	GetOverrideDuplicateAlgorithm() bool
+	GetDuplicateAlgorithm() cfg.DuplicateDetectionAlgorithm
+}
+
+// duplicateAlgorithm returns the configured duplicate detection algorithm unless the request explicitly overrides it.
+func (svc *Service) duplicateAlgorithm(request duplicateAlgorithmRequest) cfg.DuplicateDetectionAlgorithm {
+	if request.GetOverrideDuplicateAlgorithm() {
+		return request.GetDuplicateAlgorithm()
+	}
+	return svc.DuplicateDetectionAlgorithm
+}
+
+/*
+startItemsCreationJob creates the given items in an asynchronous job and returns the job ID. The items are not
+announced. The duplicate detection algorithm is taken from the request, see duplicateAlgorithm. The details are
+attached to the error returned if the items lock cannot be acquired.
+*/
+func (svc *Service) startItemsCreationJob(ctx context.Context, title string, itemsToCreate []*items.Item, request duplicateAlgorithmRequest, details ...protoiface.MessageV1) (string, error) {
+	duplicateAlgorithm := svc.duplicateAlgorithm(request)
+
 	lock, err := svc.Jobber.AcquireLock(ctx, SvcResourceName)
 	if err != nil {
-		return nil, errstat.MakeGRPCStatus(codes.AlreadyExists, "failed to acquire items lock; other job might be running", request).Err()
+		return "", errstat.MakeGRPCStatus(codes.AlreadyExists, "failed to acquire items lock; other job might be running", details...).Err()
 	}
 
 	job, err := svc.Jobber.CreateJob(ctx, &jobspb.CreateJobRequest{
-		Title: "bulk item creation",
+		Title: title,
 	})
 	if err != nil {
-		return nil, status.Error(codes.Internal, fmt.Sprintf("failure creating job:  %s", err.Error()))
+		svc.Jobber.ReleaseLock(ctx, SvcResourceName, lock) //nolint:errcheck
+		return "", status.Error(codes.Internal, fmt.Sprintf("failure creating job:  %s", err.Error()))
 	}
 
 	// Add the job ID to the context so that CreateItem can log created item IDs.
@@ -54,21 +90,15 @@ func (svc *Service) CreateItemsBulk(ctx context.Context, request *pbItems.Create
 	ctxJob := constants.NewContextWithValues(ctx, job.JobId)
 
 	go func(ctx context.Context) {
-		svc.Log.Info(ctx, L.Messagef("bulk item creation: job started (%s)", job.JobId), L.Phase("job"))
+		svc.Log.Info(ctx, L.Messagef("%s: job started (%s)", title, job.JobId), L.Phase("job"))
 
 		svc.Jobber.SetStatusRunning(ctx, job.JobId)              //nolint:errcheck
 		defer svc.Jobber.SetStatusDone(ctx, job.JobId)           //nolint:errcheck
 		defer svc.Jobber.ReleaseLock(ctx, SvcResourceName, lock) //nolint:errcheck
 
-		// Override configured duplicate detection algorithm only if explicitly requested
-		duplicateAlgorithm := svc.DuplicateDetectionAlgorithm
-		if request.OverrideDuplicateAlgorithm {
-			duplicateAlgorithm = request.DuplicateAlgorithm
-		}
-
 		// Create items
 		itemResults, processErr := svc.doItemsCreate(ctx, createMultipleItemsInput{
-			items:               request.Items,
+			items:               itemsToCreate,
 			precomputedHashes:   []string{},
 			duplicateAlgorithm:  duplicateAlgorithm,
 			preventAnnouncement: true, // Do not announce the items created as part of a bulk load
@@ -85,12 +115,10 @@ func (svc *Service) CreateItemsBulk(ctx context.Context, request *pbItems.Create
 			return
 		}
 
-		svc.Log.Info(ctx, L.Messagef("bulk item creation job done (job ID: %s) - %d items created", job.JobId, len(itemResults)), L.Phase("job"))
+		svc.Log.Info(ctx, L.Messagef("%s job done (job ID: %s) - %d items created", title, job.JobId, len(itemResults)), L.Phase("job"))
 	}(ctxJob)
 
-	// Synchronous return
-	hints.HintHTTPStatusCode(ctx, http.StatusCreated)
-	return &pbItems.CreateItemsBulkResponse{JobId: job.JobId}, nil
+	return job.JobId, nil
 }
 
 type createSingleItemInput struct {
@@ -197,6 +225,16 @@ func (svc *Service) doItemsCreate(ctx context.Context, creationArgs createMultip
 
 		results = append(results, singleCreateResponse)
 	}
+
+	if !creationArgs.preventAnnouncement {
+		businessIDs := make([]string, len(results))
+		for i, singleCreateResult := range results {
+			businessIDs[i] = singleCreateResult.aggregatedBusinessID
+		}
+		if err := EnqueueForIndexing(ctx, tx, businessIDs...); err != nil {
+			return nil, err
+		}
+	}
 	txCommit = true
 
 	return results, nil
@@ -231,20 +269,16 @@ func (svc *Service) doSingleItemCreate(ctx context.Context, input createSingleIt
 
 	// Validate all the field values
 	for _, v := range input.Item.Values {
-		fieldDef, fieldsErr := svc.FieldRepo.GetFieldDefByName(ctx, v.FieldName)
-		if fieldsErr != nil {
-			return createSingleItemResult{}, fmt.Errorf("could not retrieve config for the field " + v.FieldName)
-		}
-
-		hook := svc.ItemCreationHooks.GetHook(fieldDef.Kind())
-		if hook == nil {
-			return createSingleItemResult{}, fmt.Errorf("no field value validation hook for kind: " + fieldDef.Kind())
-		}
-
-		if valErr := hook.ValidateFieldValue(ctx, fieldDef, v.FieldValue); valErr != nil {
-			return createSingleItemResult{}, fmt.Errorf("invalid field value for: %s (%s)", v.FieldName, v.FieldValue)
+		if valErr := svc.validateFieldValue(ctx, v.FieldName, v.FieldValue); valErr != nil {
+			return createSingleItemResult{}, valErr
 		}
 	}
+	if reqErr := svc.checkRequiredFields(ctx, entityType.Name, input.Item.Values); reqErr != nil {
+		return createSingleItemResult{}, reqErr
+	}
+	if violations := entities.CheckSchema(entityType, input.Item.Values); len(violations) > 0 {
+		return createSingleItemResult{}, fmt.Errorf("item does not conform to the schema of entity type %s: %s", entityType.Name, strings.Join(violations, "; "))
+	}
 
 	// Copy the input values and determine the place indexes of multi-values fields, updated business ID if needed
 	businessID := input.Item.BusinessId // Could be "".
@@ -264,6 +298,21 @@ func (svc *Service) doSingleItemCreate(ctx context.Context, input createSingleIt
 		}
 	}
 
+	// A new version supersedes the previous ones, so with owner-scoped writes, the user must own them.
+	if svc.OwnerScopedWrites && businessID != "" {
+		versions, err := datamodel.New(input.dbTx).DbListItemsForBusinessId(ctx, businessID)
+		if err != nil {
+			return createSingleItemResult{}, err
+		}
+		versionIDs := make([]string, len(versions))
+		for i, version := range versions {
+			versionIDs[i] = version.ItemID
+		}
+		if err := svc.checkItemOwnership(ctx, datamodel.New(input.dbTx), versionIDs, input.Item); err != nil {
+			return createSingleItemResult{}, err
+		}
+	}
+
 	// Create new item
 	createItemArgs := singleCreateArgs{
 		owner:               user.UserId,
@@ -278,6 +327,10 @@ func (svc *Service) doSingleItemCreate(ctx context.Context, input createSingleIt
 	if err != nil {
 		return createSingleItemResult{}, err
 	}
+	err = svc.recordItemOwnerGroups(ctx, datamodel.New(input.dbTx), createdItem.ID, user)
+	if err != nil {
+		return createSingleItemResult{}, err
+	}
 
 	// Impute business IDs before aggregation so the views used in aggregation have access to imputed business IDs.
 	_, imputeErr := imputeBusinessIDs(ctx, input.dbTx)
@@ -307,6 +360,47 @@ func (svc *Service) doSingleItemCreate(ctx context.Context, input createSingleIt
 	}, nil
 }
 
+// validateFieldValue checks that the field is known and that the value is acceptable for the kind of the field.
+func (svc *Service) validateFieldValue(ctx context.Context, fieldName string, fieldValue string) error {
+	fieldDef, fieldsErr := svc.FieldRepo.GetFieldDefByName(ctx, fieldName)
+	if fieldsErr != nil {
+		return fmt.Errorf("could not retrieve config for the field " + fieldName)
+	}
+
+	hook := svc.ItemCreationHooks.GetHook(fieldDef.Kind())
+	if hook == nil {
+		return fmt.Errorf("no field value validation hook for kind: " + fieldDef.Kind())
+	}
+
+	if valErr := hook.ValidateFieldValue(ctx, fieldDef, fieldValue); valErr != nil {
+		return fmt.Errorf("invalid field value for: %s (%s): %s", fieldName, fieldValue, valErr.Error())
+	}
+
+	return nil
+}
+
+// checkRequiredFields checks that the item has a value for every field whose validation rules require one for the
+// entity type of the item.
+func (svc *Service) checkRequiredFields(ctx context.Context, entityType string, values []*items.ItemValue) error {
+	fieldDefs, err := svc.FieldRepo.ListFieldDefs(ctx)
+	if err != nil {
+		return fmt.Errorf("could not retrieve field configs: %s", err.Error())
+	}
+
+	var requiredFields []string
+	for _, fieldDef := range fieldDefs {
+		if fieldDef.ValueRules().RequiredFor(entityType) {
+			requiredFields = append(requiredFields, fieldDef.Name())
+		}
+	}
+
+	if violations := entities.CheckRequiredFields(requiredFields, values); len(violations) > 0 {
+		return fmt.Errorf("item does not conform to the field rules for entity type %s: %s", entityType, strings.Join(violations, "; "))
+	}
+
+	return nil
+}
+
 type singleCreateArgs struct {
 	dbTx                pgx.Tx
 	owner               string
@@ -346,6 +440,7 @@ func createNewItem(ctx context.Context, args singleCreateArgs) (datamodel.Item,
 			Language:   db.TextFromString(v.Language),
 			Place:      v.Place,
 			ItemID:     itemID,
+			Author:     db.TextFromString(args.owner),
 		})
 		if valErr != nil {
 			return datamodel.Item{}, status.Error(codes.Internal, fmt.Sprintf("failed to create new item values in DB: %s", valErr.Error()))
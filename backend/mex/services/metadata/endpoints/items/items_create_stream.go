@@ -0,0 +1,251 @@
+package items
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/d4l-data4life/mex/mex/shared/auth"
+	"github.com/d4l-data4life/mex/mex/shared/constants"
+	"github.com/d4l-data4life/mex/mex/shared/db"
+	"github.com/d4l-data4life/mex/mex/shared/hints"
+	"github.com/d4l-data4life/mex/mex/shared/items"
+	"github.com/d4l-data4life/mex/mex/shared/known/jobspb"
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/canonical"
+	pbItems "github.com/d4l-data4life/mex/mex/services/metadata/endpoints/items/pb"
+)
+
+const (
+	defaultStreamChunkSize   = 500
+	defaultStreamLockTimeout = time.Minute
+	streamLockPollInterval   = 200 * time.Millisecond
+)
+
+/*
+CreateItemsStream receives items from a client stream and creates them in chunks, each chunk in its own transaction.
+Contrary to CreateItemsBulk, a failing item does not abort the ingestion: it is rolled back individually (using a
+savepoint), the failure reason is added to the job logs, and the ingestion continues with the next item. The IDs of the
+created items are added to the job items. The job is completed when the client closes the stream.
+
+As the pace of the stream is controlled by the client, the items lock is not held for the whole stream but only while a
+chunk is written. A chunk waits up to StreamLockTimeout for the lock; if it is not obtained, the chunk fails.
+*/
+func (svc *Service) CreateItemsStream(stream pbItems.Items_CreateItemsStreamServer) error {
+	ctx := stream.Context()
+
+	job, err := svc.Jobber.CreateJob(ctx, &jobspb.CreateJobRequest{
+		Title: "streaming item creation",
+	})
+	if err != nil {
+		return status.Error(codes.Internal, fmt.Sprintf("failure creating job:  %s", err.Error()))
+	}
+
+	ctxJob := constants.NewContextWithValues(ctx, job.JobId)
+	svc.Log.Info(ctxJob, L.Messagef("streaming item creation: job started (%s)", job.JobId), L.Phase("job"))
+
+	svc.Jobber.SetStatusRunning(ctxJob, job.JobId)    //nolint:errcheck
+	defer svc.Jobber.SetStatusDone(ctxJob, job.JobId) //nolint:errcheck
+
+	chunkSize := svc.StreamChunkSize
+	if chunkSize <= 0 {
+		chunkSize = defaultStreamChunkSize
+	}
+
+	response := pbItems.CreateItemsStreamResponse{JobId: job.JobId}
+	chunk := make([]*items.Item, 0, chunkSize)
+	for {
+		request, recvErr := stream.Recv()
+		if recvErr == io.EOF {
+			break
+		}
+		if recvErr != nil {
+			svc.setJobError(ctxJob, job.JobId, fmt.Sprintf("receiving items failed after %d items: %s", response.Received, recvErr.Error()))
+			return recvErr
+		}
+
+		response.Received++
+		chunk = append(chunk, request.Item)
+		if len(chunk) == chunkSize {
+			svc.ingestChunk(ctxJob, chunk, int(response.Received)-len(chunk), &response)
+			chunk = chunk[:0]
+		}
+	}
+	if len(chunk) > 0 {
+		svc.ingestChunk(ctxJob, chunk, int(response.Received)-len(chunk), &response)
+	}
+
+	svc.Log.Info(ctxJob, L.Messagef("streaming item creation job done (job ID: %s) - received: %d, created: %d, duplicates: %d, failed: %d",
+		job.JobId, response.Received, response.Created, response.Duplicate, response.Failed), L.Phase("job"))
+
+	hints.HintHTTPStatusCode(ctx, http.StatusCreated)
+	return stream.SendAndClose(&response)
+}
+
+/*
+ingestChunk creates the items of one chunk in a single transaction while holding the items lock. The offset is the
+number of items received before the chunk and is used for numbering items in the logs.
+*/
+func (svc *Service) ingestChunk(ctx context.Context, chunk []*items.Item, offset int, response *pbItems.CreateItemsStreamResponse) {
+	lock, err := svc.acquireItemsLock(ctx)
+	if err != nil {
+		response.Failed += int32(len(chunk))
+		svc.addJobLogs(ctx, []string{fmt.Sprintf("items %d-%d: failed: items lock not available: %s", offset+1, offset+len(chunk), err.Error())})
+		return
+	}
+	defer svc.Jobber.ReleaseLock(ctx, SvcResourceName, lock) //nolint:errcheck
+
+	svc.writeChunk(ctx, chunk, offset, response)
+}
+
+// acquireItemsLock waits for the items lock until it is obtained, the stream lock timeout expires or the context is done.
+func (svc *Service) acquireItemsLock(ctx context.Context) (string, error) {
+	timeout := svc.StreamLockTimeout
+	if timeout <= 0 {
+		timeout = defaultStreamLockTimeout
+	}
+	ctxTimeout, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(streamLockPollInterval)
+	defer ticker.Stop()
+	for {
+		lock, err := svc.Jobber.AcquireLock(ctx, SvcResourceName)
+		if err == nil {
+			return lock, nil
+		}
+		select {
+		case <-ctxTimeout.Done():
+			return "", fmt.Errorf("%s (waited %s)", err.Error(), timeout)
+		case <-ticker.C:
+		}
+	}
+}
+
+/*
+writeChunk creates the items of one chunk in a single transaction, skipping duplicates and rolling back failing items
+individually. Announcements are prevented, exactly as for the bulk creation.
+*/
+func (svc *Service) writeChunk(ctx context.Context, chunk []*items.Item, offset int, response *pbItems.CreateItemsStreamResponse) {
+	// The counts are only added to the response once the chunk is committed.
+	var logs []string
+	var createdItemIDs []string
+	var failed, duplicate int32
+	failChunk := func(reason string) {
+		response.Failed += int32(len(chunk))
+		svc.addJobLogs(ctx, []string{fmt.Sprintf("items %d-%d: failed: %s", offset+1, offset+len(chunk), reason)})
+	}
+
+	tx, err := db.AcquireTx(ctx, svc.DB)
+	if err != nil {
+		failChunk(fmt.Sprintf("transaction creation failed: %s", err.Error()))
+		return
+	}
+
+	// Items which are absent cannot be fingerprinted and are sorted out before the duplicate check.
+	var candidates []*items.Item
+	var candidateNumbers []int
+	for i, item := range chunk {
+		if item == nil {
+			failed++
+			logs = append(logs, fmt.Sprintf("item %d: failed: no item given", offset+i+1))
+			continue
+		}
+		candidates = append(candidates, item)
+		candidateNumbers = append(candidateNumbers, offset+i+1)
+	}
+
+	hashes := make([]string, len(candidates))
+	for i, item := range candidates {
+		hashes[i] = canonical.Fingerprint(item)
+	}
+	_, isDuplicateHash, err := findDuplicates(ctx, duplicateArgs{
+		hashes:             hashes,
+		duplicateAlgorithm: svc.DuplicateDetectionAlgorithm,
+		dbTx:               tx,
+	})
+	if err != nil {
+		_ = tx.Rollback(ctx)
+		failChunk(err.Error())
+		return
+	}
+
+	for i, item := range candidates {
+		if isDuplicateHash[i] {
+			duplicate++
+			logs = append(logs, fmt.Sprintf("item %d: skipped: duplicate", candidateNumbers[i]))
+			continue
+		}
+
+		// A nested transaction is a savepoint, so only this item is rolled back in case of a failure.
+		savepoint, spErr := tx.Begin(ctx)
+		if spErr != nil {
+			_ = tx.Rollback(ctx)
+			failChunk(fmt.Sprintf("savepoint creation failed: %s", spErr.Error()))
+			return
+		}
+
+		result, createErr := svc.doSingleItemCreate(context.WithValue(ctx, db.ContextKeyTx, savepoint), createSingleItemInput{
+			Item:                item,
+			preventAnnouncement: true,
+			duplicateAlgorithm:  svc.DuplicateDetectionAlgorithm,
+			hash:                hashes[i],
+			dbTx:                savepoint,
+		})
+		if createErr != nil {
+			if rbErr := savepoint.Rollback(ctx); rbErr != nil {
+				_ = tx.Rollback(ctx)
+				failChunk(fmt.Sprintf("rollback of item %d failed: %s", candidateNumbers[i], rbErr.Error()))
+				return
+			}
+			failed++
+			logs = append(logs, fmt.Sprintf("item %d: failed: %s", candidateNumbers[i], createErr.Error()))
+			continue
+		}
+		if commitErr := savepoint.Commit(ctx); commitErr != nil {
+			_ = tx.Rollback(ctx)
+			failChunk(fmt.Sprintf("release of item %d failed: %s", candidateNumbers[i], commitErr.Error()))
+			return
+		}
+
+		createdItemIDs = append(createdItemIDs, result.itemID)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		svc.Log.Error(ctx, L.Messagef("commit error: %s", err.Error()))
+		failChunk(fmt.Sprintf("commit failed: %s", err.Error()))
+		return
+	}
+
+	response.Created += int32(len(createdItemIDs))
+	response.Duplicate += duplicate
+	response.Failed += failed
+	if len(createdItemIDs) > 0 {
+		AddJobItemIDs(ctx, svc.Jobber, createdItemIDs)
+	}
+	svc.addJobLogs(ctx, logs)
+}
+
+func (svc *Service) addJobLogs(ctx context.Context, logs []string) {
+	jobID := auth.GetJobID(ctx)
+	if jobID == "" || len(logs) == 0 {
+		return
+	}
+
+	if _, err := svc.Jobber.AddLogs(ctx, &jobspb.AddJobLogsRequest{JobId: jobID, Logs: logs}); err != nil {
+		svc.Log.Warn(ctx, L.Messagef("could not add job logs: %s", err.Error()))
+	}
+}
+
+func (svc *Service) setJobError(ctx context.Context, jobID string, message string) {
+	svc.Log.Error(ctx, L.Message(message))
+	if _, err := svc.Jobber.SetError(ctx, &jobspb.SetJobErrorRequest{JobId: jobID, Error: message}); err != nil {
+		svc.Log.Warn(ctx, L.Messagef("could not set job error: %s", err.Error()))
+	}
+}
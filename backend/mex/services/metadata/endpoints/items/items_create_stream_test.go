@@ -0,0 +1,194 @@
+package items
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/d4l-data4life/mex/mex/shared/auth"
+	"github.com/d4l-data4life/mex/mex/shared/cfg"
+	"github.com/d4l-data4life/mex/mex/shared/constants"
+	"github.com/d4l-data4life/mex/mex/shared/db"
+	"github.com/d4l-data4life/mex/mex/shared/entities"
+	"github.com/d4l-data4life/mex/mex/shared/entities/erepo"
+	"github.com/d4l-data4life/mex/mex/shared/items"
+	"github.com/d4l-data4life/mex/mex/shared/jobs"
+	"github.com/d4l-data4life/mex/mex/shared/known/jobspb"
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/canonical"
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/fields"
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/fields/frepo"
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/fields/hooks"
+	kind_string "github.com/d4l-data4life/mex/mex/services/metadata/business/fields/kinds/string"
+	pbItems "github.com/d4l-data4life/mex/mex/services/metadata/endpoints/items/pb"
+)
+
+// newTestService returns a service whose DB statements are answered by the returned MockTx.
+func newTestService(handler db.MockHandler) (*Service, *jobs.MockJobber, *db.MockTx, context.Context) {
+	jobber := jobs.NewMockJobber()
+	tx := db.NewMockTx(handler)
+	svc := &Service{
+		Log:    &L.NullLogger{},
+		DB:     tx,
+		Jobber: jobber,
+		FieldRepo: frepo.NewMockedFieldRepo([]fields.BaseFieldDef{
+			fields.NewBaseFieldDef("identifier", kind_string.KindName, "", false, fields.BaseIndexDef{}),
+			fields.NewBaseFieldDef("title", kind_string.KindName, "", false, fields.BaseIndexDef{}),
+		}),
+		EntityRepo: erepo.NewMockedEntityTypesRepo([]*entities.EntityType{
+			{Name: "Resource", Config: &entities.EntityTypeConfig{BusinessIdFieldName: "identifier"}},
+		}),
+		ItemCreationHooks:           hooks.ItemCreationHooks{kind_string.KindName: &kind_string.KindString{}},
+		DuplicateDetectionAlgorithm: cfg.DuplicateDetectionAlgorithm_SIMPLE,
+	}
+
+	ctx := context.WithValue(context.Background(), constants.ContextKeyUserClaims, &auth.Claims{UserId: "producer-1"})
+	return svc, jobber, tx, ctx
+}
+
+// createItemHandler answers item creation statements, returning the generated item ID; the hashes are reported as present.
+func createItemHandler(presentHashes ...string) db.MockHandler {
+	return func(stmt db.MockStatement) db.MockResult {
+		switch stmt.Name {
+		case "DbCreateItem":
+			return db.MockResult{Rows: [][]any{{stmt.Args[0], stmt.Args[1]}}}
+		case "DbListHashesPresentSimple":
+			rows := [][]any{}
+			for _, hash := range presentHashes {
+				rows = append(rows, []any{hash})
+			}
+			return db.MockResult{Rows: rows}
+		}
+		return db.MockResult{}
+	}
+}
+
+func testItem(businessID string, extraValues ...*items.ItemValue) *items.Item {
+	return &items.Item{
+		EntityType: "Resource",
+		Values: append([]*items.ItemValue{
+			{FieldName: "identifier", FieldValue: businessID},
+			{FieldName: "title", FieldValue: "Title of " + businessID},
+		}, extraValues...),
+	}
+}
+
+type testItemsStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	items    []*items.Item
+	onRecv   func()
+	response *pbItems.CreateItemsStreamResponse
+}
+
+func (s *testItemsStream) Context() context.Context { return s.ctx }
+
+func (s *testItemsStream) Recv() (*pbItems.CreateItemsStreamRequest, error) {
+	if s.onRecv != nil {
+		s.onRecv()
+	}
+	if len(s.items) == 0 {
+		return nil, io.EOF
+	}
+	item := s.items[0]
+	s.items = s.items[1:]
+	return &pbItems.CreateItemsStreamRequest{Item: item}, nil
+}
+
+func (s *testItemsStream) SendAndClose(response *pbItems.CreateItemsStreamResponse) error {
+	s.response = response
+	return nil
+}
+
+func TestService_CreateItemsStream(t *testing.T) {
+	duplicate := testItem("R2")
+	svc, jobber, tx, ctx := newTestService(createItemHandler(canonical.Fingerprint(duplicate)))
+	svc.StreamChunkSize = 2
+
+	stream := &testItemsStream{
+		ctx: ctx,
+		items: []*items.Item{
+			testItem("R1"),
+			duplicate,
+			testItem("R3", &items.ItemValue{FieldName: "unknown", FieldValue: "x"}),
+			testItem("R4"),
+			nil,
+		},
+	}
+	// The items lock must only be held while a chunk is written, not while the client sends items.
+	stream.onRecv = func() {
+		if jobber.Locked(SvcResourceName) {
+			t.Error("items lock held while receiving")
+		}
+	}
+
+	if err := svc.CreateItemsStream(stream); err != nil {
+		t.Fatalf("CreateItemsStream() error = %v", err)
+	}
+
+	response := stream.response
+	if response.Received != 5 || response.Created != 2 || response.Duplicate != 1 || response.Failed != 2 {
+		t.Errorf("CreateItemsStream() counts: received %d, created %d, duplicate %d, failed %d, want 5, 2, 1, 2",
+			response.Received, response.Created, response.Duplicate, response.Failed)
+	}
+
+	job := jobber.Job(response.JobId)
+	if job.Status != jobs.StatusDone {
+		t.Errorf("job status = %s, want %s", job.Status, jobs.StatusDone)
+	}
+	created := tx.Executed("DbCreateItem")
+	if len(created) != 2 {
+		t.Fatalf("DbCreateItem executed %d times, want 2", len(created))
+	}
+	if len(job.ItemIDs) != 2 || job.ItemIDs[0] != created[0].Args[1] || job.ItemIDs[1] != created[1].Args[1] {
+		t.Errorf("job items = %v, want the IDs of the created items", job.ItemIDs)
+	}
+
+	wantLogs := []string{
+		"item 2: skipped: duplicate",
+		"item 3: failed: could not retrieve config for the field unknown",
+		"item 5: failed: no item given",
+	}
+	logs := strings.Join(job.Logs, "\n")
+	for _, want := range wantLogs {
+		if !strings.Contains(logs, want) {
+			t.Errorf("job logs %q do not contain %q", logs, want)
+		}
+	}
+
+	if got := len(tx.Executed("ROLLBACK TO SAVEPOINT")); got != 1 {
+		t.Errorf("%d items rolled back, want 1", got)
+	}
+	if jobber.Locked(SvcResourceName) {
+		t.Error("items lock still held after the stream was closed")
+	}
+}
+
+func TestService_ingestChunk_lockNotAvailable(t *testing.T) {
+	svc, jobber, tx, ctx := newTestService(createItemHandler())
+	svc.StreamLockTimeout = 10 * time.Millisecond
+
+	if _, err := jobber.AcquireLock(ctx, SvcResourceName); err != nil {
+		t.Fatal(err)
+	}
+	job, _ := jobber.CreateJob(ctx, &jobspb.CreateJobRequest{})
+	ctxJob := constants.NewContextWithValues(ctx, job.JobId)
+
+	response := pbItems.CreateItemsStreamResponse{}
+	svc.ingestChunk(ctxJob, []*items.Item{testItem("R1"), testItem("R2")}, 4, &response)
+
+	if response.Failed != 2 || response.Created != 0 {
+		t.Errorf("ingestChunk() counts: created %d, failed %d, want 0, 2", response.Created, response.Failed)
+	}
+	if statements := tx.Statements(); len(statements) != 0 {
+		t.Errorf("statements executed without the items lock: %v", statements)
+	}
+	if logs := jobber.Job(job.JobId).Logs; len(logs) != 1 || !strings.HasPrefix(logs[0], "items 5-6: failed: items lock not available") {
+		t.Errorf("job logs = %v", logs)
+	}
+}
@@ -0,0 +1,265 @@
+package items
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	E "github.com/d4l-data4life/mex/mex/shared/errstat"
+
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/datamodel"
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/fields/vrepo"
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/jsonld"
+	itemspb "github.com/d4l-data4life/mex/mex/services/metadata/endpoints/items/pb"
+)
+
+const (
+	contentTypeJSONLD = "application/ld+json"
+	contentTypeNDJSON = "application/x-ndjson"
+
+	exportItemsPageSize = 100
+)
+
+// ExportItem returns a single item as JSON-LD document, see ExportItems.
+func (svc *Service) ExportItem(ctx context.Context, request *itemspb.ExportItemRequest) (*httpbody.HttpBody, error) {
+	if request.ItemId == "" {
+		return nil, E.MakeGRPCStatus(codes.InvalidArgument, "no item ID given", request).Err()
+	}
+
+	serializer, err := svc.newJSONLDSerializer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	queries := datamodel.New(svc.DB)
+	rules, err := svc.resolveVisibility(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+
+	rawItems, err := queries.DbGetItem(ctx, request.ItemId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to fetch item from DB: %s", err.Error()))
+	}
+	if len(rawItems) > 0 {
+		visible, visibilityErr := visibleSet(ctx, queries, rules, []string{request.ItemId})
+		if visibilityErr != nil {
+			return nil, visibilityErr
+		}
+		if !visible[request.ItemId] {
+			rawItems = nil
+		}
+	}
+	if len(rawItems) == 0 {
+		return nil, E.MakeGRPCStatus(codes.NotFound, "item not found", request).Err()
+	}
+
+	node, err := exportNode(ctx, queries, serializer, rules, rawItems[0].ID, rawItems[0].EntityName)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(serializer.Document(node))
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to serialize item: %s", err.Error()))
+	}
+
+	return &httpbody.HttpBody{ContentType: contentTypeJSONLD, Data: data}, nil
+}
+
+/*
+ExportItems streams all items (or all items of one entity type) as JSON-LD, one self-contained document per item.
+The items are serialized according to the vocabulary mapping from the config: entity types become classes, item values
+and relations become properties. Values of link fields are resolved to the latest item with the linked business ID.
+Only the latest version per business ID is exported unless all versions are requested.
+*/
+func (svc *Service) ExportItems(request *itemspb.ExportItemsRequest, stream itemspb.Items_ExportItemsServer) error {
+	ctx := stream.Context()
+
+	serializer, err := svc.newJSONLDSerializer(ctx)
+	if err != nil {
+		return err
+	}
+
+	queries := datamodel.New(svc.DB)
+	rules, err := svc.resolveVisibility(ctx, queries)
+	if err != nil {
+		return err
+	}
+
+	next := ""
+	for {
+		page, pageErr := listItemsPage(ctx, queries, itemsPageArgs{
+			entityType:      request.EntityType,
+			includeVersions: request.IncludeVersions,
+			visibility:      rules,
+			from:            next,
+			limit:           exportItemsPageSize + 1,
+		})
+		if pageErr != nil {
+			return pageErr
+		}
+
+		size := len(page)
+		if size > exportItemsPageSize {
+			size = exportItemsPageSize
+		}
+
+		for _, item := range page[:size] {
+			node, nodeErr := exportNode(ctx, queries, serializer, rules, item.ItemID, item.EntityName)
+			if nodeErr != nil {
+				return nodeErr
+			}
+
+			data, marshalErr := json.Marshal(serializer.Document(node))
+			if marshalErr != nil {
+				return status.Error(codes.Internal, fmt.Sprintf("failed to serialize item %s: %s", item.ItemID, marshalErr.Error()))
+			}
+
+			if sendErr := stream.Send(&httpbody.HttpBody{ContentType: contentTypeNDJSON, Data: data}); sendErr != nil {
+				return sendErr
+			}
+		}
+
+		if len(page) <= exportItemsPageSize {
+			return nil
+		}
+		next = page[exportItemsPageSize].ItemID
+	}
+}
+
+type itemsPageArgs struct {
+	// If empty, items of all entity types are listed.
+	entityType string
+	// If not set, only the latest version per business ID is listed.
+	includeVersions bool
+	// Visibility rules of the user, see visibility.Resolver (nil: all items are listed)
+	visibility []byte
+	// Item ID to start the page with (inclusive)
+	from  string
+	limit int32
+}
+
+// listItemsPage lists a page of items ordered by item ID.
+func listItemsPage(ctx context.Context, queries *datamodel.Queries, args itemsPageArgs) ([]datamodel.ItemsNullableBusinessID, error) {
+	var page []datamodel.ItemsNullableBusinessID
+	var err error
+	switch {
+	case args.entityType == "" && args.includeVersions:
+		page, err = queries.DbListItems(ctx, datamodel.DbListItemsParams{
+			ItemID: args.from, Visibility: args.visibility, Limit: args.limit,
+		})
+	case args.entityType == "":
+		page, err = queries.DbListLatestItems(ctx, datamodel.DbListLatestItemsParams{
+			ItemID: args.from, Visibility: args.visibility, Limit: args.limit,
+		})
+	case args.includeVersions:
+		page, err = queries.DbListItemsOfType(ctx, datamodel.DbListItemsOfTypeParams{
+			ItemID: args.from, EntityName: args.entityType, Visibility: args.visibility, Limit: args.limit,
+		})
+	default:
+		page, err = queries.DbListLatestItemsOfType(ctx, datamodel.DbListLatestItemsOfTypeParams{
+			ItemID: args.from, EntityName: args.entityType, Visibility: args.visibility, Limit: args.limit,
+		})
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to list items from DB: %s", err.Error()))
+	}
+	return page, nil
+}
+
+func (svc *Service) newJSONLDSerializer(ctx context.Context) (*jsonld.Serializer, error) {
+	mapping, err := svc.VocabularyRepo.GetVocabularyMapping(ctx)
+	if err != nil {
+		if errors.Is(err, vrepo.ErrNoVocabularyMapping) {
+			return nil, E.MakeGRPCStatus(codes.FailedPrecondition, "no vocabulary mapping configured; items cannot be exported").Err()
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to load vocabulary mapping: %s", err.Error()))
+	}
+
+	fieldDefs, err := svc.FieldRepo.ListFieldDefs(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to load field definitions: %s", err.Error()))
+	}
+
+	return jsonld.NewSerializer(mapping, fieldDefs), nil
+}
+
+/*
+exportNode collects the current values, the link targets and the relations of an item and serializes them. Link targets
+and relation targets the user must not see (according to the given visibility rules) are left out.
+*/
+func exportNode(ctx context.Context, queries *datamodel.Queries, serializer *jsonld.Serializer, rules []byte, itemID string, entityType string) (map[string]any, error) {
+	values, err := queries.DbGetItemValues(ctx, itemID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to fetch item values from DB: %s", err.Error()))
+	}
+
+	item := jsonld.Item{
+		ItemID:      itemID,
+		EntityType:  entityType,
+		Values:      make([]jsonld.Value, len(values)),
+		LinkTargets: make(map[string][]string),
+	}
+	var linkedBusinessIDs []string
+	for i, v := range values {
+		item.Values[i] = jsonld.Value{
+			FieldName:  v.FieldName,
+			FieldValue: v.FieldValue,
+			Language:   v.Language.String,
+		}
+		if serializer.IsLinkField(v.FieldName) {
+			linkedBusinessIDs = append(linkedBusinessIDs, v.FieldValue)
+		}
+	}
+
+	var targets []datamodel.DbResolveBusinessIDsRow
+	if len(linkedBusinessIDs) > 0 {
+		targets, err = queries.DbResolveBusinessIDs(ctx, linkedBusinessIDs)
+		if err != nil {
+			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to resolve linked business IDs: %s", err.Error()))
+		}
+	}
+
+	relations, err := queries.DbListRelationsForSource(ctx, itemID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to fetch relations from DB: %s", err.Error()))
+	}
+
+	visible, err := visibleTargets(ctx, queries, rules, targets, relations)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range targets {
+		if visible[t.ItemID] {
+			item.LinkTargets[t.BusinessID] = append(item.LinkTargets[t.BusinessID], t.ItemID)
+		}
+	}
+	for _, r := range relations {
+		if visible[r.TargetItemID] {
+			item.Relations = append(item.Relations, jsonld.Relation{Type: r.Type, TargetItemID: r.TargetItemID})
+		}
+	}
+
+	return serializer.Node(item), nil
+}
+
+// visibleTargets returns the set of the link and relation targets of an item which are visible under the given rules.
+func visibleTargets(ctx context.Context, queries *datamodel.Queries, rules []byte, targets []datamodel.DbResolveBusinessIDsRow, relations []datamodel.Relation) (map[string]bool, error) {
+	itemIDs := make([]string, 0, len(targets)+len(relations))
+	for _, t := range targets {
+		itemIDs = append(itemIDs, t.ItemID)
+	}
+	for _, r := range relations {
+		itemIDs = append(itemIDs, r.TargetItemID)
+	}
+	if len(itemIDs) == 0 {
+		return nil, nil
+	}
+	return visibleSet(ctx, queries, rules, itemIDs)
+}
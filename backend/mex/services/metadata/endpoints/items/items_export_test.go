@@ -0,0 +1,58 @@
+package items
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/d4l-data4life/mex/mex/shared/db"
+
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/datamodel"
+)
+
+func Test_listItemsPage(t *testing.T) {
+	tests := []struct {
+		name string
+		args itemsPageArgs
+		want string
+	}{
+		{
+			name: "Latest versions of all entity types",
+			args: itemsPageArgs{limit: 10},
+			want: "DbListLatestItems",
+		},
+		{
+			name: "Latest versions of one entity type",
+			args: itemsPageArgs{entityType: "Resource", limit: 10},
+			want: "DbListLatestItemsOfType",
+		},
+		{
+			name: "All versions of all entity types",
+			args: itemsPageArgs{includeVersions: true, limit: 10},
+			want: "DbListItems",
+		},
+		{
+			name: "All versions of one entity type",
+			args: itemsPageArgs{entityType: "Resource", includeVersions: true, limit: 10},
+			want: "DbListItemsOfType",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := db.NewMockTx(func(stmt db.MockStatement) db.MockResult {
+				return db.MockResult{Rows: [][]any{{"item-1", nil, "owner", "Resource"}}}
+			})
+
+			page, err := listItemsPage(context.Background(), datamodel.New(tx), tt.args)
+			if err != nil {
+				t.Fatalf("listItemsPage() error = %v", err)
+			}
+			if got := tx.Statements(); !reflect.DeepEqual(got, []string{tt.want}) {
+				t.Errorf("listItemsPage() statements = %v, want %v", got, []string{tt.want})
+			}
+			if len(page) != 1 || page[0].ItemID != "item-1" {
+				t.Errorf("listItemsPage() = %v", page)
+			}
+		})
+	}
+}
@@ -23,17 +23,22 @@ const listItemsLimit = 1000
 func (svc *Service) ListItems(ctx context.Context, request *itemspb.ListItemsRequest) (*itemspb.ListItemsResponse, error) {
 	queries := datamodel.New(svc.DB)
 
-	var items []datamodel.ItemsNullableBusinessID
-	var err error
+	visibility, err := svc.resolveVisibility(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
 
+	var items []datamodel.ItemsNullableBusinessID
 	if request.EntityType == "" {
 		items, err = queries.DbListItems(ctx, datamodel.DbListItemsParams{
-			ItemID: request.Next,
-			Limit:  listItemsLimit + 1,
+			ItemID:     request.Next,
+			Visibility: visibility,
+			Limit:      listItemsLimit + 1,
 		})
 	} else {
 		items, err = queries.DbListItemsOfType(ctx, datamodel.DbListItemsOfTypeParams{
 			ItemID:     request.Next,
+			Visibility: visibility,
 			Limit:      listItemsLimit + 1,
 			EntityName: request.EntityType,
 		})
@@ -83,6 +88,17 @@ func (svc *Service) GetItem(ctx context.Context, request *itemspb.GetItemRequest
 		svc.Log.Error(ctx, L.Messagef("failed to fetch item from DB: % s", err.Error()))
 		return nil, err
 	}
+	if len(rawItems) == 1 {
+		// Items the user must not see are reported as missing.
+		visible, visibilityErr := svc.visibleItemIDs(ctx, queries, []string{request.ItemId})
+		if visibilityErr != nil {
+			return nil, visibilityErr
+		}
+		if !visible[request.ItemId] {
+			rawItems = nil
+		}
+	}
+
 	var item datamodel.Item
 	switch len(rawItems) {
 	case 0:
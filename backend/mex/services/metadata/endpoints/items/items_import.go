@@ -0,0 +1,87 @@
+package items
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/d4l-data4life/mex/mex/shared/errstat"
+	"github.com/d4l-data4life/mex/mex/shared/hints"
+
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/fields/vrepo"
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/importer"
+	pbItems "github.com/d4l-data4life/mex/mex/services/metadata/endpoints/items/pb"
+)
+
+/*
+ImportItems converts the passed data into items and creates them in an asynchronous job, like CreateItemsBulk. The
+conversion happens synchronously, so that malformed data and mappings onto unknown fields are rejected right away.
+The values themselves are validated during the item creation.
+*/
+func (svc *Service) ImportItems(ctx context.Context, request *pbItems.ImportItemsRequest) (*pbItems.ImportItemsResponse, error) {
+	imp := svc.Importers.GetImporter(request.Format)
+	if imp == nil {
+		return nil, errstat.MakeGRPCStatus(codes.InvalidArgument, fmt.Sprintf("unsupported import format: %s", request.Format)).Err()
+	}
+
+	options := importer.Options{
+		EntityType:     request.EntityType,
+		Delimiter:      request.CsvDelimiter,
+		ValueSeparator: request.CsvValueSeparator,
+	}
+	for _, m := range request.Mappings {
+		options.Mappings = append(options.Mappings, importer.Mapping{
+			Source:    m.Source,
+			FieldName: m.FieldName,
+			Language:  m.Language,
+		})
+	}
+	if len(options.Mappings) == 0 && request.Format == importer.FormatDublinCore {
+		mappings, err := svc.dublinCoreImportMappings(ctx)
+		if err != nil {
+			return nil, err
+		}
+		options.Mappings = mappings
+	}
+
+	if err := importer.CheckOptions(ctx, svc.FieldRepo, svc.EntityRepo, options); err != nil {
+		return nil, errstat.MakeGRPCStatus(codes.InvalidArgument, err.Error()).Err()
+	}
+
+	importedItems, err := imp.Import(ctx, []byte(request.Data), options)
+	if err != nil {
+		return nil, errstat.MakeGRPCStatus(codes.InvalidArgument, fmt.Sprintf("import failed: %s", err.Error())).Err()
+	}
+	if len(importedItems) == 0 {
+		return nil, errstat.MakeGRPCStatus(codes.InvalidArgument, "no items to be created found in data").Err()
+	}
+
+	jobID, err := svc.startItemsCreationJob(ctx, fmt.Sprintf("item import (%s)", request.Format), importedItems, request)
+	if err != nil {
+		return nil, err
+	}
+
+	hints.HintHTTPStatusCode(ctx, http.StatusCreated)
+	return &pbItems.ImportItemsResponse{JobId: jobID, ItemCount: int32(len(importedItems))}, nil
+}
+
+// dublinCoreImportMappings reverses the Dublin Core mapping of the vocabulary, which is used for the OAI-PMH export.
+func (svc *Service) dublinCoreImportMappings(ctx context.Context) ([]importer.Mapping, error) {
+	vocabulary, err := svc.VocabularyRepo.GetVocabularyMapping(ctx)
+	if err != nil {
+		if errors.Is(err, vrepo.ErrNoVocabularyMapping) {
+			return nil, nil
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to load vocabulary mapping: %s", err.Error()))
+	}
+
+	mappings := make([]importer.Mapping, len(vocabulary.DublinCore))
+	for i, m := range vocabulary.DublinCore {
+		mappings[i] = importer.Mapping{Source: m.Element, FieldName: m.FieldName}
+	}
+	return mappings, nil
+}
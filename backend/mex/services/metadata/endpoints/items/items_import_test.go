@@ -0,0 +1,128 @@
+package items
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/d4l-data4life/mex/mex/shared/cfg"
+	"github.com/d4l-data4life/mex/mex/shared/jobs"
+
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/importer"
+	pbItems "github.com/d4l-data4life/mex/mex/services/metadata/endpoints/items/pb"
+)
+
+const testImportCSV = "id,name\nR1,First resource\nR2,Second resource\n"
+
+var testImportMappings = []*pbItems.ImportItemsRequest_FieldMapping{
+	{Source: "id", FieldName: "identifier"},
+	{Source: "name", FieldName: "title", Language: "en"},
+}
+
+func TestService_ImportItems(t *testing.T) {
+	svc, jobber, tx, ctx := newTestService(createItemHandler())
+	svc.DB = tx
+	svc.Importers = importer.NewImporters()
+
+	response, err := svc.ImportItems(ctx, &pbItems.ImportItemsRequest{
+		Format:                     importer.FormatCSV,
+		EntityType:                 "Resource",
+		Mappings:                   testImportMappings,
+		Data:                       testImportCSV,
+		OverrideDuplicateAlgorithm: true,
+		DuplicateAlgorithm:         cfg.DuplicateDetectionAlgorithm_LATEST_ONLY,
+	})
+	if err != nil {
+		t.Fatalf("ImportItems() error = %v", err)
+	}
+	if response.ItemCount != 2 {
+		t.Errorf("ImportItems() item count = %d, want 2", response.ItemCount)
+	}
+
+	job := waitForJob(t, jobber, response.JobId)
+	if job.Error != "" {
+		t.Fatalf("import job failed: %s", job.Error)
+	}
+	if len(job.ItemIDs) != 2 {
+		t.Errorf("import job created %d items, want 2", len(job.ItemIDs))
+	}
+	if len(tx.Executed("DbListHashesPresentLatestOnly")) != 1 || len(tx.Executed("DbListHashesPresentSimple")) != 0 {
+		t.Errorf("requested duplicate detection algorithm not used: %v", tx.Statements())
+	}
+	if jobber.Locked(SvcResourceName) {
+		t.Error("items lock still held after the import job")
+	}
+}
+
+func TestService_ImportItems_rejected(t *testing.T) {
+	tests := []struct {
+		name     string
+		request  *pbItems.ImportItemsRequest
+		locked   bool
+		wantCode codes.Code
+	}{
+		{
+			name:     "Unsupported format",
+			request:  &pbItems.ImportItemsRequest{Format: "xlsx", EntityType: "Resource", Data: testImportCSV},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "Mapping onto unknown field",
+			request: &pbItems.ImportItemsRequest{Format: importer.FormatCSV, EntityType: "Resource", Data: testImportCSV,
+				Mappings: []*pbItems.ImportItemsRequest_FieldMapping{{Source: "id", FieldName: "unknown"}}},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "No items in data",
+			request: &pbItems.ImportItemsRequest{Format: importer.FormatCSV, EntityType: "Resource", Data: "id,name\n",
+				Mappings: testImportMappings},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "Items lock held by another job",
+			request: &pbItems.ImportItemsRequest{Format: importer.FormatCSV, EntityType: "Resource", Data: testImportCSV,
+				Mappings: testImportMappings},
+			locked:   true,
+			wantCode: codes.AlreadyExists,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, jobber, tx, ctx := newTestService(createItemHandler())
+			svc.DB = tx
+			svc.Importers = importer.NewImporters()
+			if tt.locked {
+				if _, err := jobber.AcquireLock(ctx, SvcResourceName); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			_, err := svc.ImportItems(ctx, tt.request)
+			if status.Code(err) != tt.wantCode {
+				t.Errorf("ImportItems() error = %v, want code %v", err, tt.wantCode)
+			}
+			if statements := tx.Statements(); len(statements) != 0 {
+				t.Errorf("statements executed for a rejected import: %v", statements)
+			}
+		})
+	}
+}
+
+// waitForJob waits until the job is done and returns it.
+func waitForJob(t *testing.T, jobber *jobs.MockJobber, jobID string) *jobs.MockJob {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		job := jobber.Job(jobID)
+		if job != nil && job.Status == jobs.StatusDone {
+			return job
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("job %s not done in time", jobID)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,144 @@
+package items
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/d4l-data4life/mex/mex/shared/entities"
+	E "github.com/d4l-data4life/mex/mex/shared/errstat"
+	"github.com/d4l-data4life/mex/mex/shared/items"
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/datamodel"
+	itemspb "github.com/d4l-data4life/mex/mex/services/metadata/endpoints/items/pb"
+)
+
+const schemaConformancePageSize = 100
+
+/*
+CheckSchemaConformance checks the current values of the latest versions of the stored items (or of the items of one
+entity type) against the schema of their entity type, see entities.CheckSchema. Items of entity types without a schema
+are skipped, as are items of entity types which are no longer configured. Items created before a schema was introduced
+or changed may violate it. The items are checked one page per call; the response holds the item ID to continue with.
+*/
+func (svc *Service) CheckSchemaConformance(ctx context.Context, request *itemspb.CheckSchemaConformanceRequest) (*itemspb.CheckSchemaConformanceResponse, error) {
+	entityTypes, err := svc.schemaEntityTypes(ctx, request.EntityType)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &itemspb.CheckSchemaConformanceResponse{Items: []*itemspb.CheckSchemaConformanceResponse_NonconformingItem{}}
+	if len(entityTypes) == 0 {
+		return response, nil
+	}
+
+	queries := datamodel.New(svc.DB)
+	visibility, err := svc.resolveVisibility(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := listItemsPage(ctx, queries, itemsPageArgs{
+		entityType: request.EntityType,
+		visibility: visibility,
+		from:       request.Next,
+		limit:      schemaConformancePageSize + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(page) > schemaConformancePageSize {
+		response.Next = page[schemaConformancePageSize].ItemID
+		page = page[:schemaConformancePageSize]
+	}
+
+	values, err := listValuesOfItems(ctx, queries, schemaItemIDs(page, entityTypes))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range page {
+		entityType, ok := entityTypes[item.EntityName]
+		if !ok {
+			continue
+		}
+
+		response.CheckedCount++
+		if violations := entities.CheckSchema(entityType, values[item.ItemID]); len(violations) > 0 {
+			response.NonconformingCount++
+			response.Items = append(response.Items, &itemspb.CheckSchemaConformanceResponse_NonconformingItem{
+				ItemId:     item.ItemID,
+				EntityType: item.EntityName,
+				BusinessId: item.BusinessID.String,
+				Violations: violations,
+			})
+		}
+	}
+
+	svc.Log.Info(ctx, L.Messagef("schema conformance: %d out of %d items checked violate their schema",
+		response.NonconformingCount, response.CheckedCount))
+
+	return response, nil
+}
+
+// schemaEntityTypes returns the entity types with a schema by name, restricted to the given one if not empty.
+func (svc *Service) schemaEntityTypes(ctx context.Context, entityTypeName string) (map[string]*entities.EntityType, error) {
+	var entityTypes []*entities.EntityType
+	if entityTypeName == "" {
+		var err error
+		entityTypes, err = svc.EntityRepo.ListEntityTypes(ctx, false)
+		if err != nil {
+			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to load entity types: %s", err.Error()))
+		}
+	} else {
+		entityType, err := svc.EntityRepo.GetEntityType(ctx, entityTypeName)
+		if err != nil {
+			return nil, E.MakeGRPCStatus(codes.InvalidArgument, fmt.Sprintf("unknown entity type: %s: %s", entityTypeName, err.Error())).Err()
+		}
+		entityTypes = []*entities.EntityType{entityType}
+	}
+
+	schemaEntityTypes := make(map[string]*entities.EntityType)
+	for _, entityType := range entityTypes {
+		if entityType.GetConfig().GetSchema() != nil {
+			schemaEntityTypes[entityType.Name] = entityType
+		}
+	}
+	return schemaEntityTypes, nil
+}
+
+// schemaItemIDs returns the IDs of the items whose entity type is among the given ones.
+func schemaItemIDs(page []datamodel.ItemsNullableBusinessID, entityTypes map[string]*entities.EntityType) []string {
+	var itemIDs []string
+	for _, item := range page {
+		if _, ok := entityTypes[item.EntityName]; ok {
+			itemIDs = append(itemIDs, item.ItemID)
+		}
+	}
+	return itemIDs
+}
+
+// listValuesOfItems returns the current values of the given items by item ID.
+func listValuesOfItems(ctx context.Context, queries *datamodel.Queries, itemIDs []string) (map[string][]*items.ItemValue, error) {
+	values := make(map[string][]*items.ItemValue, len(itemIDs))
+	if len(itemIDs) == 0 {
+		return values, nil
+	}
+
+	rows, err := queries.DbListItemValuesOfItems(ctx, itemIDs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to fetch item values from DB: %s", err.Error()))
+	}
+
+	for _, row := range rows {
+		values[row.ItemID] = append(values[row.ItemID], &items.ItemValue{
+			FieldName:  row.FieldName,
+			FieldValue: row.FieldValue,
+			Language:   row.Language.String,
+		})
+	}
+	return values, nil
+}
@@ -0,0 +1,64 @@
+package items
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/d4l-data4life/mex/mex/shared/db"
+
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/datamodel"
+)
+
+func Test_listValuesOfItems(t *testing.T) {
+	tests := []struct {
+		name           string
+		itemIDs        []string
+		wantStatements []string
+		want           map[string][]string
+	}{
+		{
+			name:           "No items",
+			wantStatements: []string{},
+			want:           map[string][]string{},
+		},
+		{
+			name:           "Values grouped by item",
+			itemIDs:        []string{"item-1", "item-2", "item-3"},
+			wantStatements: []string{"DbListItemValuesOfItems"},
+			want: map[string][]string{
+				"item-1": {"keyword", "title"},
+				"item-2": {"title"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := db.NewMockTx(func(stmt db.MockStatement) db.MockResult {
+				return db.MockResult{Rows: [][]any{
+					{"v1", "item-1", "keyword", "health"},
+					{"v2", "item-1", "title", "Title"},
+					{"v3", "item-2", "title", "Other title"},
+				}}
+			})
+
+			values, err := listValuesOfItems(context.Background(), datamodel.New(tx), tt.itemIDs)
+			if err != nil {
+				t.Fatalf("listValuesOfItems() error = %v", err)
+			}
+			if got := tx.Statements(); !reflect.DeepEqual(got, tt.wantStatements) {
+				t.Errorf("listValuesOfItems() statements = %v, want %v", got, tt.wantStatements)
+			}
+
+			got := map[string][]string{}
+			for itemID, itemValues := range values {
+				for _, v := range itemValues {
+					got[itemID] = append(got[itemID], v.FieldName)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("listValuesOfItems() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
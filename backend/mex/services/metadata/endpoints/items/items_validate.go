@@ -0,0 +1,145 @@
+package items
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+
+	"github.com/d4l-data4life/mex/mex/shared/db"
+	"github.com/d4l-data4life/mex/mex/shared/errstat"
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/canonical"
+	pbItems "github.com/d4l-data4life/mex/mex/services/metadata/endpoints/items/pb"
+)
+
+/*
+ValidateItems runs the item creation for the passed items inside a transaction which is always rolled back.
+Each value is checked with the item creation hook of its field kind, each item is checked against its entity type
+and the duplicate detection. Items passing these checks are then created (including aggregation) so that errors only
+surfacing at the DB level are reported, too. As in CreateItemsBulk, all items share the transaction, so later items see
+the items created before them (e.g. as link targets or previous versions). Each item is created within a savepoint which
+is only rolled back if the item fails, keeping the transaction usable for the remaining items. Nothing is stored and
+nothing is announced.
+*/
+func (svc *Service) ValidateItems(ctx context.Context, request *pbItems.ValidateItemsRequest) (*pbItems.ValidateItemsResponse, error) {
+	if len(request.Items) == 0 {
+		return nil, errstat.MakeGRPCStatus(codes.InvalidArgument, "no items to be validated passed", request).Err()
+	}
+
+	duplicateAlgorithm := svc.duplicateAlgorithm(request)
+
+	tx, err := db.AcquireTx(ctx, svc.DB)
+	if err != nil {
+		return nil, fmt.Errorf("transaction creation failed: %s", err.Error())
+	}
+	defer func() {
+		if err := tx.Rollback(ctx); err != nil {
+			svc.Log.Error(ctx, L.Messagef("rollback error: %s", err.Error()))
+		}
+	}()
+
+	// Absent items cannot be fingerprinted, hence they get an empty hash which is never a duplicate.
+	hashes := make([]string, len(request.Items))
+	for i, item := range request.Items {
+		if item != nil {
+			hashes[i] = canonical.Fingerprint(item)
+		}
+	}
+	_, isDuplicateHash, err := findDuplicates(ctx, duplicateArgs{
+		hashes:             hashes,
+		duplicateAlgorithm: duplicateAlgorithm,
+		dbTx:               tx,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := pbItems.ValidateItemsResponse{
+		Items: make([]*pbItems.ValidateItemsResponse_ItemReport, len(request.Items)),
+	}
+	for i, item := range request.Items {
+		report := &pbItems.ValidateItemsResponse_ItemReport{
+			Index:     int32(i),
+			Duplicate: isDuplicateHash[i],
+			Values:    []*pbItems.ValidateItemsResponse_ValueReport{},
+		}
+		response.Items[i] = report
+
+		if item == nil {
+			report.Errors = append(report.Errors, "input item is nil")
+			response.InvalidCount++
+			continue
+		}
+
+		if _, etErr := svc.EntityRepo.GetEntityType(ctx, item.EntityType); etErr != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("unknown entity type: %s: %s", item.EntityType, etErr.Error()))
+		}
+
+		valuesValid := true
+		for j, v := range item.Values {
+			valueReport := &pbItems.ValidateItemsResponse_ValueReport{
+				Index:      int32(j),
+				FieldName:  v.FieldName,
+				FieldValue: v.FieldValue,
+				Valid:      true,
+			}
+			if valErr := svc.validateFieldValue(ctx, v.FieldName, v.FieldValue); valErr != nil {
+				valueReport.Valid = false
+				valueReport.Error = valErr.Error()
+				valuesValid = false
+			}
+			report.Values = append(report.Values, valueReport)
+		}
+
+		// Only run the actual creation if the cheap checks passed; duplicates would not be created at all.
+		if len(report.Errors) == 0 && valuesValid && !report.Duplicate {
+			if createErr := svc.tryItemCreate(ctx, tx, createSingleItemInput{
+				Item:                item,
+				preventAnnouncement: true,
+				duplicateAlgorithm:  duplicateAlgorithm,
+				hash:                hashes[i],
+			}); createErr != nil {
+				report.Errors = append(report.Errors, createErr.Error())
+			}
+		}
+
+		report.Valid = len(report.Errors) == 0 && valuesValid
+		switch {
+		case !report.Valid:
+			response.InvalidCount++
+		case report.Duplicate:
+			response.DuplicateCount++
+		default:
+			response.ValidCount++
+		}
+	}
+
+	svc.Log.Info(ctx, L.Messagef("item validation: %d valid, %d invalid, %d duplicates",
+		response.ValidCount, response.InvalidCount, response.DuplicateCount))
+
+	return &response, nil
+}
+
+/*
+tryItemCreate creates a single item within a savepoint of the passed transaction. The savepoint is released if the item
+is created, so that it stays visible to the following items, and rolled back otherwise.
+*/
+func (svc *Service) tryItemCreate(ctx context.Context, tx pgx.Tx, input createSingleItemInput) error {
+	savepoint, err := tx.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("savepoint creation failed: %s", err.Error())
+	}
+
+	input.dbTx = savepoint
+	if _, err = svc.doSingleItemCreate(context.WithValue(ctx, db.ContextKeyTx, savepoint), input); err != nil {
+		_ = savepoint.Rollback(ctx)
+		return err
+	}
+	if err = savepoint.Commit(ctx); err != nil {
+		return fmt.Errorf("savepoint release failed: %s", err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package items
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/d4l-data4life/mex/mex/shared/db"
+	"github.com/d4l-data4life/mex/mex/shared/items"
+
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/canonical"
+	pbItems "github.com/d4l-data4life/mex/mex/services/metadata/endpoints/items/pb"
+)
+
+func TestService_ValidateItems(t *testing.T) {
+	duplicate := testItem("R2")
+	svc, _, tx, ctx := newTestService(createItemHandler(canonical.Fingerprint(duplicate)))
+
+	response, err := svc.ValidateItems(ctx, &pbItems.ValidateItemsRequest{
+		Items: []*items.Item{
+			testItem("R1"),
+			duplicate,
+			testItem("R3", &items.ItemValue{FieldName: "unknown", FieldValue: "x"}),
+			{EntityType: "Unknown"},
+			nil,
+			testItem("R1"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("ValidateItems() error = %v", err)
+	}
+
+	if response.ValidCount != 2 || response.InvalidCount != 3 || response.DuplicateCount != 1 {
+		t.Errorf("ValidateItems() counts: valid %d, invalid %d, duplicate %d, want 2, 3, 1",
+			response.ValidCount, response.InvalidCount, response.DuplicateCount)
+	}
+	wantValid := []bool{true, true, false, false, false, true}
+	for i, report := range response.Items {
+		if report.Valid != wantValid[i] {
+			t.Errorf("item %d: valid = %v, want %v (errors: %v)", i, report.Valid, wantValid[i], report.Errors)
+		}
+	}
+	if !response.Items[1].Duplicate {
+		t.Error("item 1 not reported as duplicate")
+	}
+	if values := response.Items[2].Values; values[2].Valid || !strings.Contains(values[2].Error, "unknown") {
+		t.Errorf("invalid value not reported: %v", values[2])
+	}
+
+	// The valid items are kept for the rest of the batch and only rolled back together with the transaction.
+	want := []string{
+		"SAVEPOINT",
+		"DbListHashesPresentSimple",
+		"SAVEPOINT", "DbCreateItem", "DbCreateItemValue", "DbCreateItemValue", "DbImputeBusinessId", "RELEASE SAVEPOINT",
+		"SAVEPOINT", "DbCreateItem", "DbCreateItemValue", "DbCreateItemValue", "DbImputeBusinessId", "RELEASE SAVEPOINT",
+		"ROLLBACK TO SAVEPOINT",
+	}
+	if got := tx.Statements(); !reflect.DeepEqual(got, want) {
+		t.Errorf("ValidateItems() statements = %v, want %v", got, want)
+	}
+}
+
+func TestService_ValidateItems_failingItemRolledBack(t *testing.T) {
+	created := createItemHandler()
+	svc, _, tx, ctx := newTestService(func(stmt db.MockStatement) db.MockResult {
+		if stmt.Name == "DbCreateItem" && stmt.Args[5] == db.TextFromString("R2") {
+			return db.MockResult{Err: fmt.Errorf("unique constraint violated")}
+		}
+		return created(stmt)
+	})
+
+	response, err := svc.ValidateItems(ctx, &pbItems.ValidateItemsRequest{
+		Items: []*items.Item{testItem("R1"), testItem("R2"), testItem("R3")},
+	})
+	if err != nil {
+		t.Fatalf("ValidateItems() error = %v", err)
+	}
+	if response.ValidCount != 2 || response.InvalidCount != 1 {
+		t.Errorf("ValidateItems() counts: valid %d, invalid %d, want 2, 1", response.ValidCount, response.InvalidCount)
+	}
+	if errs := response.Items[1].Errors; len(errs) != 1 || !strings.Contains(errs[0], "unique constraint violated") {
+		t.Errorf("item 1: errors = %v", errs)
+	}
+
+	// Only the failing item is rolled back to its savepoint, the whole transaction is rolled back at the end.
+	if got := len(tx.Executed("RELEASE SAVEPOINT")); got != 2 {
+		t.Errorf("%d savepoints released, want 2", got)
+	}
+	if got := len(tx.Executed("ROLLBACK TO SAVEPOINT")); got != 2 {
+		t.Errorf("%d rollbacks, want 2 (failing item and transaction)", got)
+	}
+}
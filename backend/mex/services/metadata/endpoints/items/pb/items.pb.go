@@ -12,6 +12,7 @@ import (
 	_ "github.com/d4l-data4life/mex/mex/shared/known/securitypb"
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -26,6 +27,104 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type UpdateItemRequest_Operation int32
+
+const (
+	UpdateItemRequest_ADD     UpdateItemRequest_Operation = 0 // append a new value to the field (at the next free place)
+	UpdateItemRequest_REPLACE UpdateItemRequest_Operation = 1 // replace the value at the given field name and place
+	UpdateItemRequest_REMOVE  UpdateItemRequest_Operation = 2 // remove the value at the given field name and place
+)
+
+// Enum value maps for UpdateItemRequest_Operation.
+var (
+	UpdateItemRequest_Operation_name = map[int32]string{
+		0: "ADD",
+		1: "REPLACE",
+		2: "REMOVE",
+	}
+	UpdateItemRequest_Operation_value = map[string]int32{
+		"ADD":     0,
+		"REPLACE": 1,
+		"REMOVE":  2,
+	}
+)
+
+func (x UpdateItemRequest_Operation) Enum() *UpdateItemRequest_Operation {
+	p := new(UpdateItemRequest_Operation)
+	*p = x
+	return p
+}
+
+func (x UpdateItemRequest_Operation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UpdateItemRequest_Operation) Descriptor() protoreflect.EnumDescriptor {
+	return file_services_metadata_endpoints_items_items_proto_enumTypes[0].Descriptor()
+}
+
+func (UpdateItemRequest_Operation) Type() protoreflect.EnumType {
+	return &file_services_metadata_endpoints_items_items_proto_enumTypes[0]
+}
+
+func (x UpdateItemRequest_Operation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UpdateItemRequest_Operation.Descriptor instead.
+func (UpdateItemRequest_Operation) EnumDescriptor() ([]byte, []int) {
+	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{28, 0}
+}
+
+type GetItemHistoryResponse_ChangeType int32
+
+const (
+	GetItemHistoryResponse_ADDED    GetItemHistoryResponse_ChangeType = 0 // value was created (together with the item or added later)
+	GetItemHistoryResponse_REPLACED GetItemHistoryResponse_ChangeType = 1 // value was replaced by a new revision
+	GetItemHistoryResponse_REMOVED  GetItemHistoryResponse_ChangeType = 2 // value was removed from the item
+)
+
+// Enum value maps for GetItemHistoryResponse_ChangeType.
+var (
+	GetItemHistoryResponse_ChangeType_name = map[int32]string{
+		0: "ADDED",
+		1: "REPLACED",
+		2: "REMOVED",
+	}
+	GetItemHistoryResponse_ChangeType_value = map[string]int32{
+		"ADDED":    0,
+		"REPLACED": 1,
+		"REMOVED":  2,
+	}
+)
+
+func (x GetItemHistoryResponse_ChangeType) Enum() *GetItemHistoryResponse_ChangeType {
+	p := new(GetItemHistoryResponse_ChangeType)
+	*p = x
+	return p
+}
+
+func (x GetItemHistoryResponse_ChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetItemHistoryResponse_ChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_services_metadata_endpoints_items_items_proto_enumTypes[1].Descriptor()
+}
+
+func (GetItemHistoryResponse_ChangeType) Type() protoreflect.EnumType {
+	return &file_services_metadata_endpoints_items_items_proto_enumTypes[1]
+}
+
+func (x GetItemHistoryResponse_ChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetItemHistoryResponse_ChangeType.Descriptor instead.
+func (GetItemHistoryResponse_ChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{41, 0}
+}
+
 type CreateItemRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -256,16 +355,16 @@ func (x *CreateItemsBulkResponse) GetJobId() string {
 	return ""
 }
 
-type ComputeVersionsRequest struct {
+type CreateItemsStreamRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ItemId string `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Item *items.Item `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
 }
 
-func (x *ComputeVersionsRequest) Reset() {
-	*x = ComputeVersionsRequest{}
+func (x *CreateItemsStreamRequest) Reset() {
+	*x = CreateItemsStreamRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -273,13 +372,13 @@ func (x *ComputeVersionsRequest) Reset() {
 	}
 }
 
-func (x *ComputeVersionsRequest) String() string {
+func (x *CreateItemsStreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ComputeVersionsRequest) ProtoMessage() {}
+func (*CreateItemsStreamRequest) ProtoMessage() {}
 
-func (x *ComputeVersionsRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateItemsStreamRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -291,28 +390,32 @@ func (x *ComputeVersionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ComputeVersionsRequest.ProtoReflect.Descriptor instead.
-func (*ComputeVersionsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateItemsStreamRequest.ProtoReflect.Descriptor instead.
+func (*CreateItemsStreamRequest) Descriptor() ([]byte, []int) {
 	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ComputeVersionsRequest) GetItemId() string {
+func (x *CreateItemsStreamRequest) GetItem() *items.Item {
 	if x != nil {
-		return x.ItemId
+		return x.Item
 	}
-	return ""
+	return nil
 }
 
-type ComputeVersionsResponse struct {
+type CreateItemsStreamResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Versions []*ComputeVersionsResponse_Version `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	JobId     string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Received  int32  `protobuf:"varint,2,opt,name=received,proto3" json:"received,omitempty"`
+	Created   int32  `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	Duplicate int32  `protobuf:"varint,4,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
+	Failed    int32  `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
 }
 
-func (x *ComputeVersionsResponse) Reset() {
-	*x = ComputeVersionsResponse{}
+func (x *CreateItemsStreamResponse) Reset() {
+	*x = CreateItemsStreamResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -320,13 +423,13 @@ func (x *ComputeVersionsResponse) Reset() {
 	}
 }
 
-func (x *ComputeVersionsResponse) String() string {
+func (x *CreateItemsStreamResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ComputeVersionsResponse) ProtoMessage() {}
+func (*CreateItemsStreamResponse) ProtoMessage() {}
 
-func (x *ComputeVersionsResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateItemsStreamResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -338,28 +441,67 @@ func (x *ComputeVersionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ComputeVersionsResponse.ProtoReflect.Descriptor instead.
-func (*ComputeVersionsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateItemsStreamResponse.ProtoReflect.Descriptor instead.
+func (*CreateItemsStreamResponse) Descriptor() ([]byte, []int) {
 	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ComputeVersionsResponse) GetVersions() []*ComputeVersionsResponse_Version {
+func (x *CreateItemsStreamResponse) GetJobId() string {
 	if x != nil {
-		return x.Versions
+		return x.JobId
 	}
-	return nil
+	return ""
 }
 
-type ComputeVersionsByBusinessIdRequest struct {
+func (x *CreateItemsStreamResponse) GetReceived() int32 {
+	if x != nil {
+		return x.Received
+	}
+	return 0
+}
+
+func (x *CreateItemsStreamResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *CreateItemsStreamResponse) GetDuplicate() int32 {
+	if x != nil {
+		return x.Duplicate
+	}
+	return 0
+}
+
+func (x *CreateItemsStreamResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type ImportItemsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BusinessId string `protobuf:"bytes,1,opt,name=business_id,json=businessId,proto3" json:"business_id,omitempty"`
-}
-
-func (x *ComputeVersionsByBusinessIdRequest) Reset() {
-	*x = ComputeVersionsByBusinessIdRequest{}
+	// One of: csv, datacite, dublin_core
+	Format     string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	EntityType string `protobuf:"bytes,2,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	// For Dublin Core, the mapping defaults to the reverse of the configured Dublin Core vocabulary mapping.
+	Mappings []*ImportItemsRequest_FieldMapping `protobuf:"bytes,3,rep,name=mappings,proto3" json:"mappings,omitempty"`
+	Data     string                             `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	// CSV only: column delimiter (default: comma) and separator of multiple values within a cell (default: none)
+	CsvDelimiter      string `protobuf:"bytes,5,opt,name=csv_delimiter,json=csvDelimiter,proto3" json:"csv_delimiter,omitempty"`
+	CsvValueSeparator string `protobuf:"bytes,6,opt,name=csv_value_separator,json=csvValueSeparator,proto3" json:"csv_value_separator,omitempty"`
+	// See CreateItemsBulkRequest.
+	OverrideDuplicateAlgorithm bool                            `protobuf:"varint,7,opt,name=override_duplicate_algorithm,json=overrideDuplicateAlgorithm,proto3" json:"override_duplicate_algorithm,omitempty"`
+	DuplicateAlgorithm         cfg.DuplicateDetectionAlgorithm `protobuf:"varint,8,opt,name=duplicate_algorithm,json=duplicateAlgorithm,proto3,enum=d4l.mex.cfg.DuplicateDetectionAlgorithm" json:"duplicate_algorithm,omitempty"`
+}
+
+func (x *ImportItemsRequest) Reset() {
+	*x = ImportItemsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -367,13 +509,13 @@ func (x *ComputeVersionsByBusinessIdRequest) Reset() {
 	}
 }
 
-func (x *ComputeVersionsByBusinessIdRequest) String() string {
+func (x *ImportItemsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ComputeVersionsByBusinessIdRequest) ProtoMessage() {}
+func (*ImportItemsRequest) ProtoMessage() {}
 
-func (x *ComputeVersionsByBusinessIdRequest) ProtoReflect() protoreflect.Message {
+func (x *ImportItemsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -385,28 +527,78 @@ func (x *ComputeVersionsByBusinessIdRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ComputeVersionsByBusinessIdRequest.ProtoReflect.Descriptor instead.
-func (*ComputeVersionsByBusinessIdRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportItemsRequest.ProtoReflect.Descriptor instead.
+func (*ImportItemsRequest) Descriptor() ([]byte, []int) {
 	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ComputeVersionsByBusinessIdRequest) GetBusinessId() string {
+func (x *ImportItemsRequest) GetFormat() string {
 	if x != nil {
-		return x.BusinessId
+		return x.Format
 	}
 	return ""
 }
 
-type ComputeVersionsByBusinessIdResponse struct {
+func (x *ImportItemsRequest) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *ImportItemsRequest) GetMappings() []*ImportItemsRequest_FieldMapping {
+	if x != nil {
+		return x.Mappings
+	}
+	return nil
+}
+
+func (x *ImportItemsRequest) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *ImportItemsRequest) GetCsvDelimiter() string {
+	if x != nil {
+		return x.CsvDelimiter
+	}
+	return ""
+}
+
+func (x *ImportItemsRequest) GetCsvValueSeparator() string {
+	if x != nil {
+		return x.CsvValueSeparator
+	}
+	return ""
+}
+
+func (x *ImportItemsRequest) GetOverrideDuplicateAlgorithm() bool {
+	if x != nil {
+		return x.OverrideDuplicateAlgorithm
+	}
+	return false
+}
+
+func (x *ImportItemsRequest) GetDuplicateAlgorithm() cfg.DuplicateDetectionAlgorithm {
+	if x != nil {
+		return x.DuplicateAlgorithm
+	}
+	return cfg.DuplicateDetectionAlgorithm(0)
+}
+
+type ImportItemsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Versions []*ComputeVersionsByBusinessIdResponse_Version `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	JobId     string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	ItemCount int32  `protobuf:"varint,2,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`
 }
 
-func (x *ComputeVersionsByBusinessIdResponse) Reset() {
-	*x = ComputeVersionsByBusinessIdResponse{}
+func (x *ImportItemsResponse) Reset() {
+	*x = ImportItemsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -414,13 +606,13 @@ func (x *ComputeVersionsByBusinessIdResponse) Reset() {
 	}
 }
 
-func (x *ComputeVersionsByBusinessIdResponse) String() string {
+func (x *ImportItemsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ComputeVersionsByBusinessIdResponse) ProtoMessage() {}
+func (*ImportItemsResponse) ProtoMessage() {}
 
-func (x *ComputeVersionsByBusinessIdResponse) ProtoReflect() protoreflect.Message {
+func (x *ImportItemsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -432,31 +624,38 @@ func (x *ComputeVersionsByBusinessIdResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ComputeVersionsByBusinessIdResponse.ProtoReflect.Descriptor instead.
-func (*ComputeVersionsByBusinessIdResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportItemsResponse.ProtoReflect.Descriptor instead.
+func (*ImportItemsResponse) Descriptor() ([]byte, []int) {
 	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ComputeVersionsByBusinessIdResponse) GetVersions() []*ComputeVersionsByBusinessIdResponse_Version {
+func (x *ImportItemsResponse) GetJobId() string {
 	if x != nil {
-		return x.Versions
+		return x.JobId
 	}
-	return nil
+	return ""
 }
 
-type CreateRelationRequest struct {
+func (x *ImportItemsResponse) GetItemCount() int32 {
+	if x != nil {
+		return x.ItemCount
+	}
+	return 0
+}
+
+type ValidateItemsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type         string             `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	SourceItemId string             `protobuf:"bytes,2,opt,name=source_item_id,json=sourceItemId,proto3" json:"source_item_id,omitempty"`
-	TargetItemId string             `protobuf:"bytes,3,opt,name=target_item_id,json=targetItemId,proto3" json:"target_item_id,omitempty"`
-	Values       []*items.ItemValue `protobuf:"bytes,4,rep,name=values,proto3" json:"values,omitempty"`
+	Items []*items.Item `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// See CreateItemsBulkRequest.
+	OverrideDuplicateAlgorithm bool                            `protobuf:"varint,2,opt,name=override_duplicate_algorithm,json=overrideDuplicateAlgorithm,proto3" json:"override_duplicate_algorithm,omitempty"`
+	DuplicateAlgorithm         cfg.DuplicateDetectionAlgorithm `protobuf:"varint,3,opt,name=duplicate_algorithm,json=duplicateAlgorithm,proto3,enum=d4l.mex.cfg.DuplicateDetectionAlgorithm" json:"duplicate_algorithm,omitempty"`
 }
 
-func (x *CreateRelationRequest) Reset() {
-	*x = CreateRelationRequest{}
+func (x *ValidateItemsRequest) Reset() {
+	*x = ValidateItemsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -464,13 +663,13 @@ func (x *CreateRelationRequest) Reset() {
 	}
 }
 
-func (x *CreateRelationRequest) String() string {
+func (x *ValidateItemsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRelationRequest) ProtoMessage() {}
+func (*ValidateItemsRequest) ProtoMessage() {}
 
-func (x *CreateRelationRequest) ProtoReflect() protoreflect.Message {
+func (x *ValidateItemsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -482,50 +681,45 @@ func (x *CreateRelationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRelationRequest.ProtoReflect.Descriptor instead.
-func (*CreateRelationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ValidateItemsRequest.ProtoReflect.Descriptor instead.
+func (*ValidateItemsRequest) Descriptor() ([]byte, []int) {
 	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *CreateRelationRequest) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-func (x *CreateRelationRequest) GetSourceItemId() string {
+func (x *ValidateItemsRequest) GetItems() []*items.Item {
 	if x != nil {
-		return x.SourceItemId
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateRelationRequest) GetTargetItemId() string {
+func (x *ValidateItemsRequest) GetOverrideDuplicateAlgorithm() bool {
 	if x != nil {
-		return x.TargetItemId
+		return x.OverrideDuplicateAlgorithm
 	}
-	return ""
+	return false
 }
 
-func (x *CreateRelationRequest) GetValues() []*items.ItemValue {
+func (x *ValidateItemsRequest) GetDuplicateAlgorithm() cfg.DuplicateDetectionAlgorithm {
 	if x != nil {
-		return x.Values
+		return x.DuplicateAlgorithm
 	}
-	return nil
+	return cfg.DuplicateDetectionAlgorithm(0)
 }
 
-type CreateRelationResponse struct {
+type ValidateItemsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RelationId string  `protobuf:"bytes,1,opt,name=relation_id,json=relationId,proto3" json:"relation_id,omitempty"`
-	InfoItemId *string `protobuf:"bytes,2,opt,name=info_item_id,json=infoItemId,proto3,oneof" json:"info_item_id,omitempty"`
+	Items          []*ValidateItemsResponse_ItemReport `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	ValidCount     int32                               `protobuf:"varint,2,opt,name=valid_count,json=validCount,proto3" json:"valid_count,omitempty"`
+	InvalidCount   int32                               `protobuf:"varint,3,opt,name=invalid_count,json=invalidCount,proto3" json:"invalid_count,omitempty"`
+	DuplicateCount int32                               `protobuf:"varint,4,opt,name=duplicate_count,json=duplicateCount,proto3" json:"duplicate_count,omitempty"`
 }
 
-func (x *CreateRelationResponse) Reset() {
-	*x = CreateRelationResponse{}
+func (x *ValidateItemsResponse) Reset() {
+	*x = ValidateItemsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -533,13 +727,13 @@ func (x *CreateRelationResponse) Reset() {
 	}
 }
 
-func (x *CreateRelationResponse) String() string {
+func (x *ValidateItemsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRelationResponse) ProtoMessage() {}
+func (*ValidateItemsResponse) ProtoMessage() {}
 
-func (x *CreateRelationResponse) ProtoReflect() protoreflect.Message {
+func (x *ValidateItemsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -551,37 +745,49 @@ func (x *CreateRelationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRelationResponse.ProtoReflect.Descriptor instead.
-func (*CreateRelationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ValidateItemsResponse.ProtoReflect.Descriptor instead.
+func (*ValidateItemsResponse) Descriptor() ([]byte, []int) {
 	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *CreateRelationResponse) GetRelationId() string {
+func (x *ValidateItemsResponse) GetItems() []*ValidateItemsResponse_ItemReport {
 	if x != nil {
-		return x.RelationId
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateRelationResponse) GetInfoItemId() string {
-	if x != nil && x.InfoItemId != nil {
-		return *x.InfoItemId
+func (x *ValidateItemsResponse) GetValidCount() int32 {
+	if x != nil {
+		return x.ValidCount
 	}
-	return ""
+	return 0
 }
 
-type CreateRelationsFromBusinessIdsRequest struct {
+func (x *ValidateItemsResponse) GetInvalidCount() int32 {
+	if x != nil {
+		return x.InvalidCount
+	}
+	return 0
+}
+
+func (x *ValidateItemsResponse) GetDuplicateCount() int32 {
+	if x != nil {
+		return x.DuplicateCount
+	}
+	return 0
+}
+
+type ComputeVersionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RelationType        string `protobuf:"bytes,1,opt,name=relation_type,json=relationType,proto3" json:"relation_type,omitempty"`
-	SourceItemId        string `protobuf:"bytes,4,opt,name=source_item_id,json=sourceItemId,proto3" json:"source_item_id,omitempty"`
-	SourceItemFieldName string `protobuf:"bytes,5,opt,name=source_item_field_name,json=sourceItemFieldName,proto3" json:"source_item_field_name,omitempty"`
+	ItemId string `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
 }
 
-func (x *CreateRelationsFromBusinessIdsRequest) Reset() {
-	*x = CreateRelationsFromBusinessIdsRequest{}
+func (x *ComputeVersionsRequest) Reset() {
+	*x = ComputeVersionsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -589,13 +795,13 @@ func (x *CreateRelationsFromBusinessIdsRequest) Reset() {
 	}
 }
 
-func (x *CreateRelationsFromBusinessIdsRequest) String() string {
+func (x *ComputeVersionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRelationsFromBusinessIdsRequest) ProtoMessage() {}
+func (*ComputeVersionsRequest) ProtoMessage() {}
 
-func (x *CreateRelationsFromBusinessIdsRequest) ProtoReflect() protoreflect.Message {
+func (x *ComputeVersionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -607,42 +813,28 @@ func (x *CreateRelationsFromBusinessIdsRequest) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRelationsFromBusinessIdsRequest.ProtoReflect.Descriptor instead.
-func (*CreateRelationsFromBusinessIdsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ComputeVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ComputeVersionsRequest) Descriptor() ([]byte, []int) {
 	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *CreateRelationsFromBusinessIdsRequest) GetRelationType() string {
+func (x *ComputeVersionsRequest) GetItemId() string {
 	if x != nil {
-		return x.RelationType
+		return x.ItemId
 	}
 	return ""
 }
 
-func (x *CreateRelationsFromBusinessIdsRequest) GetSourceItemId() string {
-	if x != nil {
-		return x.SourceItemId
-	}
-	return ""
-}
-
-func (x *CreateRelationsFromBusinessIdsRequest) GetSourceItemFieldName() string {
-	if x != nil {
-		return x.SourceItemFieldName
-	}
-	return ""
-}
-
-type CreateRelationsFromBusinessIdsResponse struct {
+type ComputeVersionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Inserted int32 `protobuf:"varint,1,opt,name=inserted,proto3" json:"inserted,omitempty"`
+	Versions []*ComputeVersionsResponse_Version `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
 }
 
-func (x *CreateRelationsFromBusinessIdsResponse) Reset() {
-	*x = CreateRelationsFromBusinessIdsResponse{}
+func (x *ComputeVersionsResponse) Reset() {
+	*x = ComputeVersionsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -650,13 +842,13 @@ func (x *CreateRelationsFromBusinessIdsResponse) Reset() {
 	}
 }
 
-func (x *CreateRelationsFromBusinessIdsResponse) String() string {
+func (x *ComputeVersionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRelationsFromBusinessIdsResponse) ProtoMessage() {}
+func (*ComputeVersionsResponse) ProtoMessage() {}
 
-func (x *CreateRelationsFromBusinessIdsResponse) ProtoReflect() protoreflect.Message {
+func (x *ComputeVersionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -668,30 +860,28 @@ func (x *CreateRelationsFromBusinessIdsResponse) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRelationsFromBusinessIdsResponse.ProtoReflect.Descriptor instead.
-func (*CreateRelationsFromBusinessIdsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ComputeVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ComputeVersionsResponse) Descriptor() ([]byte, []int) {
 	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *CreateRelationsFromBusinessIdsResponse) GetInserted() int32 {
+func (x *ComputeVersionsResponse) GetVersions() []*ComputeVersionsResponse_Version {
 	if x != nil {
-		return x.Inserted
+		return x.Versions
 	}
-	return 0
+	return nil
 }
 
-type CreateRelationsFromOriginalItemsRequest struct {
+type ComputeVersionsByBusinessIdRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RelationType string `protobuf:"bytes,1,opt,name=relation_type,json=relationType,proto3" json:"relation_type,omitempty"`
-	SourceItemId string `protobuf:"bytes,2,opt,name=source_item_id,json=sourceItemId,proto3" json:"source_item_id,omitempty"`
-	BusinessId   string `protobuf:"bytes,3,opt,name=business_id,json=businessId,proto3" json:"business_id,omitempty"`
+	BusinessId string `protobuf:"bytes,1,opt,name=business_id,json=businessId,proto3" json:"business_id,omitempty"`
 }
 
-func (x *CreateRelationsFromOriginalItemsRequest) Reset() {
-	*x = CreateRelationsFromOriginalItemsRequest{}
+func (x *ComputeVersionsByBusinessIdRequest) Reset() {
+	*x = ComputeVersionsByBusinessIdRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -699,13 +889,13 @@ func (x *CreateRelationsFromOriginalItemsRequest) Reset() {
 	}
 }
 
-func (x *CreateRelationsFromOriginalItemsRequest) String() string {
+func (x *ComputeVersionsByBusinessIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRelationsFromOriginalItemsRequest) ProtoMessage() {}
+func (*ComputeVersionsByBusinessIdRequest) ProtoMessage() {}
 
-func (x *CreateRelationsFromOriginalItemsRequest) ProtoReflect() protoreflect.Message {
+func (x *ComputeVersionsByBusinessIdRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -717,42 +907,28 @@ func (x *CreateRelationsFromOriginalItemsRequest) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRelationsFromOriginalItemsRequest.ProtoReflect.Descriptor instead.
-func (*CreateRelationsFromOriginalItemsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ComputeVersionsByBusinessIdRequest.ProtoReflect.Descriptor instead.
+func (*ComputeVersionsByBusinessIdRequest) Descriptor() ([]byte, []int) {
 	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *CreateRelationsFromOriginalItemsRequest) GetRelationType() string {
-	if x != nil {
-		return x.RelationType
-	}
-	return ""
-}
-
-func (x *CreateRelationsFromOriginalItemsRequest) GetSourceItemId() string {
-	if x != nil {
-		return x.SourceItemId
-	}
-	return ""
-}
-
-func (x *CreateRelationsFromOriginalItemsRequest) GetBusinessId() string {
+func (x *ComputeVersionsByBusinessIdRequest) GetBusinessId() string {
 	if x != nil {
 		return x.BusinessId
 	}
 	return ""
 }
 
-type CreateRelationsFromOriginalItemsResponse struct {
+type ComputeVersionsByBusinessIdResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Inserted int32 `protobuf:"varint,1,opt,name=inserted,proto3" json:"inserted,omitempty"`
+	Versions []*ComputeVersionsByBusinessIdResponse_Version `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
 }
 
-func (x *CreateRelationsFromOriginalItemsResponse) Reset() {
-	*x = CreateRelationsFromOriginalItemsResponse{}
+func (x *ComputeVersionsByBusinessIdResponse) Reset() {
+	*x = ComputeVersionsByBusinessIdResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -760,13 +936,13 @@ func (x *CreateRelationsFromOriginalItemsResponse) Reset() {
 	}
 }
 
-func (x *CreateRelationsFromOriginalItemsResponse) String() string {
+func (x *ComputeVersionsByBusinessIdResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRelationsFromOriginalItemsResponse) ProtoMessage() {}
+func (*ComputeVersionsByBusinessIdResponse) ProtoMessage() {}
 
-func (x *CreateRelationsFromOriginalItemsResponse) ProtoReflect() protoreflect.Message {
+func (x *ComputeVersionsByBusinessIdResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -778,26 +954,31 @@ func (x *CreateRelationsFromOriginalItemsResponse) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRelationsFromOriginalItemsResponse.ProtoReflect.Descriptor instead.
-func (*CreateRelationsFromOriginalItemsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ComputeVersionsByBusinessIdResponse.ProtoReflect.Descriptor instead.
+func (*ComputeVersionsByBusinessIdResponse) Descriptor() ([]byte, []int) {
 	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CreateRelationsFromOriginalItemsResponse) GetInserted() int32 {
+func (x *ComputeVersionsByBusinessIdResponse) GetVersions() []*ComputeVersionsByBusinessIdResponse_Version {
 	if x != nil {
-		return x.Inserted
+		return x.Versions
 	}
-	return 0
+	return nil
 }
 
-type ListRelationsRequest struct {
+type CreateRelationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Type         string             `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	SourceItemId string             `protobuf:"bytes,2,opt,name=source_item_id,json=sourceItemId,proto3" json:"source_item_id,omitempty"`
+	TargetItemId string             `protobuf:"bytes,3,opt,name=target_item_id,json=targetItemId,proto3" json:"target_item_id,omitempty"`
+	Values       []*items.ItemValue `protobuf:"bytes,4,rep,name=values,proto3" json:"values,omitempty"`
 }
 
-func (x *ListRelationsRequest) Reset() {
-	*x = ListRelationsRequest{}
+func (x *CreateRelationRequest) Reset() {
+	*x = CreateRelationRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -805,13 +986,13 @@ func (x *ListRelationsRequest) Reset() {
 	}
 }
 
-func (x *ListRelationsRequest) String() string {
+func (x *CreateRelationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRelationsRequest) ProtoMessage() {}
+func (*CreateRelationRequest) ProtoMessage() {}
 
-func (x *ListRelationsRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateRelationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -823,25 +1004,50 @@ func (x *ListRelationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRelationsRequest.ProtoReflect.Descriptor instead.
-func (*ListRelationsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateRelationRequest.ProtoReflect.Descriptor instead.
+func (*CreateRelationRequest) Descriptor() ([]byte, []int) {
 	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{14}
 }
 
-type ListRelation struct {
+func (x *CreateRelationRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateRelationRequest) GetSourceItemId() string {
+	if x != nil {
+		return x.SourceItemId
+	}
+	return ""
+}
+
+func (x *CreateRelationRequest) GetTargetItemId() string {
+	if x != nil {
+		return x.TargetItemId
+	}
+	return ""
+}
+
+func (x *CreateRelationRequest) GetValues() []*items.ItemValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type CreateRelationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RelationId   string  `protobuf:"bytes,1,opt,name=relation_id,json=relationId,proto3" json:"relation_id,omitempty"`
-	Type         string  `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	SourceItemId string  `protobuf:"bytes,3,opt,name=source_item_id,json=sourceItemId,proto3" json:"source_item_id,omitempty"`
-	TargetItemId string  `protobuf:"bytes,4,opt,name=target_item_id,json=targetItemId,proto3" json:"target_item_id,omitempty"`
-	InfoItemId   *string `protobuf:"bytes,5,opt,name=info_item_id,json=infoItemId,proto3,oneof" json:"info_item_id,omitempty"`
+	RelationId string  `protobuf:"bytes,1,opt,name=relation_id,json=relationId,proto3" json:"relation_id,omitempty"`
+	InfoItemId *string `protobuf:"bytes,2,opt,name=info_item_id,json=infoItemId,proto3,oneof" json:"info_item_id,omitempty"`
 }
 
-func (x *ListRelation) Reset() {
-	*x = ListRelation{}
+func (x *CreateRelationResponse) Reset() {
+	*x = CreateRelationResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -849,13 +1055,13 @@ func (x *ListRelation) Reset() {
 	}
 }
 
-func (x *ListRelation) String() string {
+func (x *CreateRelationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRelation) ProtoMessage() {}
+func (*CreateRelationResponse) ProtoMessage() {}
 
-func (x *ListRelation) ProtoReflect() protoreflect.Message {
+func (x *CreateRelationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -867,56 +1073,37 @@ func (x *ListRelation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRelation.ProtoReflect.Descriptor instead.
-func (*ListRelation) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateRelationResponse.ProtoReflect.Descriptor instead.
+func (*CreateRelationResponse) Descriptor() ([]byte, []int) {
 	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ListRelation) GetRelationId() string {
+func (x *CreateRelationResponse) GetRelationId() string {
 	if x != nil {
 		return x.RelationId
 	}
 	return ""
 }
 
-func (x *ListRelation) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-func (x *ListRelation) GetSourceItemId() string {
-	if x != nil {
-		return x.SourceItemId
-	}
-	return ""
-}
-
-func (x *ListRelation) GetTargetItemId() string {
-	if x != nil {
-		return x.TargetItemId
-	}
-	return ""
-}
-
-func (x *ListRelation) GetInfoItemId() string {
+func (x *CreateRelationResponse) GetInfoItemId() string {
 	if x != nil && x.InfoItemId != nil {
 		return *x.InfoItemId
 	}
 	return ""
 }
 
-type ListRelationsResponse struct {
+type CreateRelationsFromBusinessIdsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Relations map[string]*ListRelation `protobuf:"bytes,1,rep,name=relations,proto3" json:"relations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RelationType        string `protobuf:"bytes,1,opt,name=relation_type,json=relationType,proto3" json:"relation_type,omitempty"`
+	SourceItemId        string `protobuf:"bytes,4,opt,name=source_item_id,json=sourceItemId,proto3" json:"source_item_id,omitempty"`
+	SourceItemFieldName string `protobuf:"bytes,5,opt,name=source_item_field_name,json=sourceItemFieldName,proto3" json:"source_item_field_name,omitempty"`
 }
 
-func (x *ListRelationsResponse) Reset() {
-	*x = ListRelationsResponse{}
+func (x *CreateRelationsFromBusinessIdsRequest) Reset() {
+	*x = CreateRelationsFromBusinessIdsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -924,13 +1111,13 @@ func (x *ListRelationsResponse) Reset() {
 	}
 }
 
-func (x *ListRelationsResponse) String() string {
+func (x *CreateRelationsFromBusinessIdsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRelationsResponse) ProtoMessage() {}
+func (*CreateRelationsFromBusinessIdsRequest) ProtoMessage() {}
 
-func (x *ListRelationsResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateRelationsFromBusinessIdsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -942,29 +1129,42 @@ func (x *ListRelationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRelationsResponse.ProtoReflect.Descriptor instead.
-func (*ListRelationsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateRelationsFromBusinessIdsRequest.ProtoReflect.Descriptor instead.
+func (*CreateRelationsFromBusinessIdsRequest) Descriptor() ([]byte, []int) {
 	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ListRelationsResponse) GetRelations() map[string]*ListRelation {
+func (x *CreateRelationsFromBusinessIdsRequest) GetRelationType() string {
 	if x != nil {
-		return x.Relations
+		return x.RelationType
 	}
-	return nil
+	return ""
 }
 
-type ListItemsRequest struct {
+func (x *CreateRelationsFromBusinessIdsRequest) GetSourceItemId() string {
+	if x != nil {
+		return x.SourceItemId
+	}
+	return ""
+}
+
+func (x *CreateRelationsFromBusinessIdsRequest) GetSourceItemFieldName() string {
+	if x != nil {
+		return x.SourceItemFieldName
+	}
+	return ""
+}
+
+type CreateRelationsFromBusinessIdsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Next       string `protobuf:"bytes,1,opt,name=next,proto3" json:"next,omitempty"`
-	EntityType string `protobuf:"bytes,2,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	Inserted int32 `protobuf:"varint,1,opt,name=inserted,proto3" json:"inserted,omitempty"`
 }
 
-func (x *ListItemsRequest) Reset() {
-	*x = ListItemsRequest{}
+func (x *CreateRelationsFromBusinessIdsResponse) Reset() {
+	*x = CreateRelationsFromBusinessIdsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -972,13 +1172,13 @@ func (x *ListItemsRequest) Reset() {
 	}
 }
 
-func (x *ListItemsRequest) String() string {
+func (x *CreateRelationsFromBusinessIdsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListItemsRequest) ProtoMessage() {}
+func (*CreateRelationsFromBusinessIdsResponse) ProtoMessage() {}
 
-func (x *ListItemsRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateRelationsFromBusinessIdsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -990,39 +1190,30 @@ func (x *ListItemsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListItemsRequest.ProtoReflect.Descriptor instead.
-func (*ListItemsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateRelationsFromBusinessIdsResponse.ProtoReflect.Descriptor instead.
+func (*CreateRelationsFromBusinessIdsResponse) Descriptor() ([]byte, []int) {
 	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ListItemsRequest) GetNext() string {
+func (x *CreateRelationsFromBusinessIdsResponse) GetInserted() int32 {
 	if x != nil {
-		return x.Next
+		return x.Inserted
 	}
-	return ""
-}
-
-func (x *ListItemsRequest) GetEntityType() string {
-	if x != nil {
-		return x.EntityType
-	}
-	return ""
+	return 0
 }
 
-type ListItem struct {
+type CreateRelationsFromOriginalItemsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ItemId     string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
-	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	EntityType string                 `protobuf:"bytes,3,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
-	Owner      string                 `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
-	BusinessId string                 `protobuf:"bytes,5,opt,name=business_id,json=businessId,proto3" json:"business_id,omitempty"`
+	RelationType string `protobuf:"bytes,1,opt,name=relation_type,json=relationType,proto3" json:"relation_type,omitempty"`
+	SourceItemId string `protobuf:"bytes,2,opt,name=source_item_id,json=sourceItemId,proto3" json:"source_item_id,omitempty"`
+	BusinessId   string `protobuf:"bytes,3,opt,name=business_id,json=businessId,proto3" json:"business_id,omitempty"`
 }
 
-func (x *ListItem) Reset() {
-	*x = ListItem{}
+func (x *CreateRelationsFromOriginalItemsRequest) Reset() {
+	*x = CreateRelationsFromOriginalItemsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1030,13 +1221,13 @@ func (x *ListItem) Reset() {
 	}
 }
 
-func (x *ListItem) String() string {
+func (x *CreateRelationsFromOriginalItemsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListItem) ProtoMessage() {}
+func (*CreateRelationsFromOriginalItemsRequest) ProtoMessage() {}
 
-func (x *ListItem) ProtoReflect() protoreflect.Message {
+func (x *CreateRelationsFromOriginalItemsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1048,57 +1239,42 @@ func (x *ListItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListItem.ProtoReflect.Descriptor instead.
-func (*ListItem) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateRelationsFromOriginalItemsRequest.ProtoReflect.Descriptor instead.
+func (*CreateRelationsFromOriginalItemsRequest) Descriptor() ([]byte, []int) {
 	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ListItem) GetItemId() string {
-	if x != nil {
-		return x.ItemId
-	}
-	return ""
-}
-
-func (x *ListItem) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-func (x *ListItem) GetEntityType() string {
+func (x *CreateRelationsFromOriginalItemsRequest) GetRelationType() string {
 	if x != nil {
-		return x.EntityType
+		return x.RelationType
 	}
 	return ""
 }
 
-func (x *ListItem) GetOwner() string {
+func (x *CreateRelationsFromOriginalItemsRequest) GetSourceItemId() string {
 	if x != nil {
-		return x.Owner
+		return x.SourceItemId
 	}
 	return ""
 }
 
-func (x *ListItem) GetBusinessId() string {
+func (x *CreateRelationsFromOriginalItemsRequest) GetBusinessId() string {
 	if x != nil {
 		return x.BusinessId
 	}
 	return ""
 }
 
-type ListItemsResponse struct {
+type CreateRelationsFromOriginalItemsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Items []*ListItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	Next  string      `protobuf:"bytes,2,opt,name=next,proto3" json:"next,omitempty"`
+	Inserted int32 `protobuf:"varint,1,opt,name=inserted,proto3" json:"inserted,omitempty"`
 }
 
-func (x *ListItemsResponse) Reset() {
-	*x = ListItemsResponse{}
+func (x *CreateRelationsFromOriginalItemsResponse) Reset() {
+	*x = CreateRelationsFromOriginalItemsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1106,13 +1282,13 @@ func (x *ListItemsResponse) Reset() {
 	}
 }
 
-func (x *ListItemsResponse) String() string {
+func (x *CreateRelationsFromOriginalItemsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListItemsResponse) ProtoMessage() {}
+func (*CreateRelationsFromOriginalItemsResponse) ProtoMessage() {}
 
-func (x *ListItemsResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateRelationsFromOriginalItemsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1124,35 +1300,26 @@ func (x *ListItemsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListItemsResponse.ProtoReflect.Descriptor instead.
-func (*ListItemsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateRelationsFromOriginalItemsResponse.ProtoReflect.Descriptor instead.
+func (*CreateRelationsFromOriginalItemsResponse) Descriptor() ([]byte, []int) {
 	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ListItemsResponse) GetItems() []*ListItem {
-	if x != nil {
-		return x.Items
-	}
-	return nil
-}
-
-func (x *ListItemsResponse) GetNext() string {
+func (x *CreateRelationsFromOriginalItemsResponse) GetInserted() int32 {
 	if x != nil {
-		return x.Next
+		return x.Inserted
 	}
-	return ""
+	return 0
 }
 
-type GetItemRequest struct {
+type ListRelationsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	ItemId string `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
 }
 
-func (x *GetItemRequest) Reset() {
-	*x = GetItemRequest{}
+func (x *ListRelationsRequest) Reset() {
+	*x = ListRelationsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1160,13 +1327,13 @@ func (x *GetItemRequest) Reset() {
 	}
 }
 
-func (x *GetItemRequest) String() string {
+func (x *ListRelationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetItemRequest) ProtoMessage() {}
+func (*ListRelationsRequest) ProtoMessage() {}
 
-func (x *GetItemRequest) ProtoReflect() protoreflect.Message {
+func (x *ListRelationsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1178,33 +1345,25 @@ func (x *GetItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetItemRequest.ProtoReflect.Descriptor instead.
-func (*GetItemRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListRelationsRequest.ProtoReflect.Descriptor instead.
+func (*ListRelationsRequest) Descriptor() ([]byte, []int) {
 	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetItemRequest) GetItemId() string {
-	if x != nil {
-		return x.ItemId
-	}
-	return ""
-}
-
-type GetItemResponse struct {
+type ListRelation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ItemId     string                           `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
-	EntityType string                           `protobuf:"bytes,2,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
-	Owner      string                           `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
-	CreatedAt  *timestamppb.Timestamp           `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	BusinessId *string                          `protobuf:"bytes,5,opt,name=business_id,json=businessId,proto3,oneof" json:"business_id,omitempty"`
-	Values     []*GetItemResponse_FullItemValue `protobuf:"bytes,6,rep,name=values,proto3" json:"values,omitempty"`
+	RelationId   string  `protobuf:"bytes,1,opt,name=relation_id,json=relationId,proto3" json:"relation_id,omitempty"`
+	Type         string  `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	SourceItemId string  `protobuf:"bytes,3,opt,name=source_item_id,json=sourceItemId,proto3" json:"source_item_id,omitempty"`
+	TargetItemId string  `protobuf:"bytes,4,opt,name=target_item_id,json=targetItemId,proto3" json:"target_item_id,omitempty"`
+	InfoItemId   *string `protobuf:"bytes,5,opt,name=info_item_id,json=infoItemId,proto3,oneof" json:"info_item_id,omitempty"`
 }
 
-func (x *GetItemResponse) Reset() {
-	*x = GetItemResponse{}
+func (x *ListRelation) Reset() {
+	*x = ListRelation{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1212,13 +1371,13 @@ func (x *GetItemResponse) Reset() {
 	}
 }
 
-func (x *GetItemResponse) String() string {
+func (x *ListRelation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetItemResponse) ProtoMessage() {}
+func (*ListRelation) ProtoMessage() {}
 
-func (x *GetItemResponse) ProtoReflect() protoreflect.Message {
+func (x *ListRelation) ProtoReflect() protoreflect.Message {
 	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1230,63 +1389,56 @@ func (x *GetItemResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetItemResponse.ProtoReflect.Descriptor instead.
-func (*GetItemResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListRelation.ProtoReflect.Descriptor instead.
+func (*ListRelation) Descriptor() ([]byte, []int) {
 	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetItemResponse) GetItemId() string {
+func (x *ListRelation) GetRelationId() string {
 	if x != nil {
-		return x.ItemId
+		return x.RelationId
 	}
 	return ""
 }
 
-func (x *GetItemResponse) GetEntityType() string {
+func (x *ListRelation) GetType() string {
 	if x != nil {
-		return x.EntityType
+		return x.Type
 	}
 	return ""
 }
 
-func (x *GetItemResponse) GetOwner() string {
+func (x *ListRelation) GetSourceItemId() string {
 	if x != nil {
-		return x.Owner
+		return x.SourceItemId
 	}
 	return ""
 }
 
-func (x *GetItemResponse) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ListRelation) GetTargetItemId() string {
 	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-func (x *GetItemResponse) GetBusinessId() string {
-	if x != nil && x.BusinessId != nil {
-		return *x.BusinessId
+		return x.TargetItemId
 	}
 	return ""
 }
 
-func (x *GetItemResponse) GetValues() []*GetItemResponse_FullItemValue {
-	if x != nil {
-		return x.Values
+func (x *ListRelation) GetInfoItemId() string {
+	if x != nil && x.InfoItemId != nil {
+		return *x.InfoItemId
 	}
-	return nil
+	return ""
 }
 
-type DeleteItemRequest struct {
+type ListRelationsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ItemId string `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Relations map[string]*ListRelation `protobuf:"bytes,1,rep,name=relations,proto3" json:"relations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *DeleteItemRequest) Reset() {
-	*x = DeleteItemRequest{}
+func (x *ListRelationsResponse) Reset() {
+	*x = ListRelationsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1294,13 +1446,13 @@ func (x *DeleteItemRequest) Reset() {
 	}
 }
 
-func (x *DeleteItemRequest) String() string {
+func (x *ListRelationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteItemRequest) ProtoMessage() {}
+func (*ListRelationsResponse) ProtoMessage() {}
 
-func (x *DeleteItemRequest) ProtoReflect() protoreflect.Message {
+func (x *ListRelationsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1312,26 +1464,29 @@ func (x *DeleteItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteItemRequest.ProtoReflect.Descriptor instead.
-func (*DeleteItemRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListRelationsResponse.ProtoReflect.Descriptor instead.
+func (*ListRelationsResponse) Descriptor() ([]byte, []int) {
 	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *DeleteItemRequest) GetItemId() string {
+func (x *ListRelationsResponse) GetRelations() map[string]*ListRelation {
 	if x != nil {
-		return x.ItemId
+		return x.Relations
 	}
-	return ""
+	return nil
 }
 
-type DeleteItemResponse struct {
+type ListItemsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Next       string `protobuf:"bytes,1,opt,name=next,proto3" json:"next,omitempty"`
+	EntityType string `protobuf:"bytes,2,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
 }
 
-func (x *DeleteItemResponse) Reset() {
-	*x = DeleteItemResponse{}
+func (x *ListItemsRequest) Reset() {
+	*x = ListItemsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1339,13 +1494,13 @@ func (x *DeleteItemResponse) Reset() {
 	}
 }
 
-func (x *DeleteItemResponse) String() string {
+func (x *ListItemsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteItemResponse) ProtoMessage() {}
+func (*ListItemsRequest) ProtoMessage() {}
 
-func (x *DeleteItemResponse) ProtoReflect() protoreflect.Message {
+func (x *ListItemsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1357,23 +1512,39 @@ func (x *DeleteItemResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteItemResponse.ProtoReflect.Descriptor instead.
-func (*DeleteItemResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListItemsRequest) Descriptor() ([]byte, []int) {
 	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{23}
 }
 
-type DeleteItemsRequest struct {
+func (x *ListItemsRequest) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
+func (x *ListItemsRequest) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+type ListItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ItemIds     []string `protobuf:"bytes,1,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
-	BusinessIds []string `protobuf:"bytes,2,rep,name=business_ids,json=businessIds,proto3" json:"business_ids,omitempty"`
-	Cascade     bool     `protobuf:"varint,3,opt,name=cascade,proto3" json:"cascade,omitempty"`
+	ItemId     string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	EntityType string                 `protobuf:"bytes,3,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	Owner      string                 `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	BusinessId string                 `protobuf:"bytes,5,opt,name=business_id,json=businessId,proto3" json:"business_id,omitempty"`
 }
 
-func (x *DeleteItemsRequest) Reset() {
-	*x = DeleteItemsRequest{}
+func (x *ListItem) Reset() {
+	*x = ListItem{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1381,13 +1552,13 @@ func (x *DeleteItemsRequest) Reset() {
 	}
 }
 
-func (x *DeleteItemsRequest) String() string {
+func (x *ListItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteItemsRequest) ProtoMessage() {}
+func (*ListItem) ProtoMessage() {}
 
-func (x *DeleteItemsRequest) ProtoReflect() protoreflect.Message {
+func (x *ListItem) ProtoReflect() protoreflect.Message {
 	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1399,43 +1570,57 @@ func (x *DeleteItemsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteItemsRequest.ProtoReflect.Descriptor instead.
-func (*DeleteItemsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListItem.ProtoReflect.Descriptor instead.
+func (*ListItem) Descriptor() ([]byte, []int) {
 	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *DeleteItemsRequest) GetItemIds() []string {
+func (x *ListItem) GetItemId() string {
 	if x != nil {
-		return x.ItemIds
+		return x.ItemId
 	}
-	return nil
+	return ""
 }
 
-func (x *DeleteItemsRequest) GetBusinessIds() []string {
+func (x *ListItem) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.BusinessIds
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *DeleteItemsRequest) GetCascade() bool {
+func (x *ListItem) GetEntityType() string {
 	if x != nil {
-		return x.Cascade
+		return x.EntityType
 	}
-	return false
+	return ""
 }
 
-type DeleteItemsResponse struct {
+func (x *ListItem) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *ListItem) GetBusinessId() string {
+	if x != nil {
+		return x.BusinessId
+	}
+	return ""
+}
+
+type ListItemsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DeleteItemIds []string `protobuf:"bytes,1,rep,name=delete_item_ids,json=deleteItemIds,proto3" json:"delete_item_ids,omitempty"`
-	RowsModified  int32    `protobuf:"varint,2,opt,name=rows_modified,json=rowsModified,proto3" json:"rows_modified,omitempty"`
+	Items []*ListItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Next  string      `protobuf:"bytes,2,opt,name=next,proto3" json:"next,omitempty"`
 }
 
-func (x *DeleteItemsResponse) Reset() {
-	*x = DeleteItemsResponse{}
+func (x *ListItemsResponse) Reset() {
+	*x = ListItemsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1443,13 +1628,13 @@ func (x *DeleteItemsResponse) Reset() {
 	}
 }
 
-func (x *DeleteItemsResponse) String() string {
+func (x *ListItemsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteItemsResponse) ProtoMessage() {}
+func (*ListItemsResponse) ProtoMessage() {}
 
-func (x *DeleteItemsResponse) ProtoReflect() protoreflect.Message {
+func (x *ListItemsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1461,33 +1646,35 @@ func (x *DeleteItemsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteItemsResponse.ProtoReflect.Descriptor instead.
-func (*DeleteItemsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListItemsResponse.ProtoReflect.Descriptor instead.
+func (*ListItemsResponse) Descriptor() ([]byte, []int) {
 	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *DeleteItemsResponse) GetDeleteItemIds() []string {
+func (x *ListItemsResponse) GetItems() []*ListItem {
 	if x != nil {
-		return x.DeleteItemIds
+		return x.Items
 	}
 	return nil
 }
 
-func (x *DeleteItemsResponse) GetRowsModified() int32 {
+func (x *ListItemsResponse) GetNext() string {
 	if x != nil {
-		return x.RowsModified
+		return x.Next
 	}
-	return 0
+	return ""
 }
 
-type DeleteAllItemsRequest struct {
+type GetItemRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ItemId string `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
 }
 
-func (x *DeleteAllItemsRequest) Reset() {
-	*x = DeleteAllItemsRequest{}
+func (x *GetItemRequest) Reset() {
+	*x = GetItemRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1495,13 +1682,13 @@ func (x *DeleteAllItemsRequest) Reset() {
 	}
 }
 
-func (x *DeleteAllItemsRequest) String() string {
+func (x *GetItemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAllItemsRequest) ProtoMessage() {}
+func (*GetItemRequest) ProtoMessage() {}
 
-func (x *DeleteAllItemsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetItemRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1513,19 +1700,33 @@ func (x *DeleteAllItemsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAllItemsRequest.ProtoReflect.Descriptor instead.
-func (*DeleteAllItemsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetItemRequest.ProtoReflect.Descriptor instead.
+func (*GetItemRequest) Descriptor() ([]byte, []int) {
 	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{26}
 }
 
-type DeleteAllItemsResponse struct {
+func (x *GetItemRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+type GetItemResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ItemId     string                           `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	EntityType string                           `protobuf:"bytes,2,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	Owner      string                           `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	CreatedAt  *timestamppb.Timestamp           `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	BusinessId *string                          `protobuf:"bytes,5,opt,name=business_id,json=businessId,proto3,oneof" json:"business_id,omitempty"`
+	Values     []*GetItemResponse_FullItemValue `protobuf:"bytes,6,rep,name=values,proto3" json:"values,omitempty"`
 }
 
-func (x *DeleteAllItemsResponse) Reset() {
-	*x = DeleteAllItemsResponse{}
+func (x *GetItemResponse) Reset() {
+	*x = GetItemResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1533,13 +1734,13 @@ func (x *DeleteAllItemsResponse) Reset() {
 	}
 }
 
-func (x *DeleteAllItemsResponse) String() string {
+func (x *GetItemResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAllItemsResponse) ProtoMessage() {}
+func (*GetItemResponse) ProtoMessage() {}
 
-func (x *DeleteAllItemsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetItemResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1551,22 +1752,65 @@ func (x *DeleteAllItemsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAllItemsResponse.ProtoReflect.Descriptor instead.
-func (*DeleteAllItemsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetItemResponse.ProtoReflect.Descriptor instead.
+func (*GetItemResponse) Descriptor() ([]byte, []int) {
 	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{27}
 }
 
-type AggregateItemsRequest struct {
+func (x *GetItemResponse) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *GetItemResponse) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *GetItemResponse) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *GetItemResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *GetItemResponse) GetBusinessId() string {
+	if x != nil && x.BusinessId != nil {
+		return *x.BusinessId
+	}
+	return ""
+}
+
+func (x *GetItemResponse) GetValues() []*GetItemResponse_FullItemValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type UpdateItemRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EntityType string `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
-	BusinessId string `protobuf:"bytes,2,opt,name=business_id,json=businessId,proto3" json:"business_id,omitempty"`
+	ItemId          string                           `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Updates         []*UpdateItemRequest_ValueUpdate `protobuf:"bytes,2,rep,name=updates,proto3" json:"updates,omitempty"`
+	RevisionComment string                           `protobuf:"bytes,3,opt,name=revision_comment,json=revisionComment,proto3" json:"revision_comment,omitempty"`
 }
 
-func (x *AggregateItemsRequest) Reset() {
-	*x = AggregateItemsRequest{}
+func (x *UpdateItemRequest) Reset() {
+	*x = UpdateItemRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1574,13 +1818,13 @@ func (x *AggregateItemsRequest) Reset() {
 	}
 }
 
-func (x *AggregateItemsRequest) String() string {
+func (x *UpdateItemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AggregateItemsRequest) ProtoMessage() {}
+func (*UpdateItemRequest) ProtoMessage() {}
 
-func (x *AggregateItemsRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateItemRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1592,37 +1836,44 @@ func (x *AggregateItemsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AggregateItemsRequest.ProtoReflect.Descriptor instead.
-func (*AggregateItemsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateItemRequest.ProtoReflect.Descriptor instead.
+func (*UpdateItemRequest) Descriptor() ([]byte, []int) {
 	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *AggregateItemsRequest) GetEntityType() string {
+func (x *UpdateItemRequest) GetItemId() string {
 	if x != nil {
-		return x.EntityType
+		return x.ItemId
 	}
 	return ""
 }
 
-func (x *AggregateItemsRequest) GetBusinessId() string {
+func (x *UpdateItemRequest) GetUpdates() []*UpdateItemRequest_ValueUpdate {
 	if x != nil {
-		return x.BusinessId
+		return x.Updates
+	}
+	return nil
+}
+
+func (x *UpdateItemRequest) GetRevisionComment() string {
+	if x != nil {
+		return x.RevisionComment
 	}
 	return ""
 }
 
-type AggregateItemsResponse struct {
+type UpdateItemResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AggregateItemId   string   `protobuf:"bytes,1,opt,name=aggregate_item_id,json=aggregateItemId,proto3" json:"aggregate_item_id,omitempty"`
-	AggregatedItemIds []string `protobuf:"bytes,2,rep,name=aggregated_item_ids,json=aggregatedItemIds,proto3" json:"aggregated_item_ids,omitempty"`
-	NewBusinessId     string   `protobuf:"bytes,3,opt,name=new_business_id,json=newBusinessId,proto3" json:"new_business_id,omitempty"`
+	ItemId       string   `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	ItemValueIds []string `protobuf:"bytes,2,rep,name=item_value_ids,json=itemValueIds,proto3" json:"item_value_ids,omitempty"`
+	Revision     int32    `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
 }
 
-func (x *AggregateItemsResponse) Reset() {
-	*x = AggregateItemsResponse{}
+func (x *UpdateItemResponse) Reset() {
+	*x = UpdateItemResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1630,13 +1881,13 @@ func (x *AggregateItemsResponse) Reset() {
 	}
 }
 
-func (x *AggregateItemsResponse) String() string {
+func (x *UpdateItemResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AggregateItemsResponse) ProtoMessage() {}
+func (*UpdateItemResponse) ProtoMessage() {}
 
-func (x *AggregateItemsResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateItemResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1648,40 +1899,42 @@ func (x *AggregateItemsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AggregateItemsResponse.ProtoReflect.Descriptor instead.
-func (*AggregateItemsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateItemResponse.ProtoReflect.Descriptor instead.
+func (*UpdateItemResponse) Descriptor() ([]byte, []int) {
 	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *AggregateItemsResponse) GetAggregateItemId() string {
+func (x *UpdateItemResponse) GetItemId() string {
 	if x != nil {
-		return x.AggregateItemId
+		return x.ItemId
 	}
 	return ""
 }
 
-func (x *AggregateItemsResponse) GetAggregatedItemIds() []string {
+func (x *UpdateItemResponse) GetItemValueIds() []string {
 	if x != nil {
-		return x.AggregatedItemIds
+		return x.ItemValueIds
 	}
 	return nil
 }
 
-func (x *AggregateItemsResponse) GetNewBusinessId() string {
+func (x *UpdateItemResponse) GetRevision() int32 {
 	if x != nil {
-		return x.NewBusinessId
+		return x.Revision
 	}
-	return ""
+	return 0
 }
 
-type ListAllVersionsRequest struct {
+type DeleteItemRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ItemId string `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
 }
 
-func (x *ListAllVersionsRequest) Reset() {
-	*x = ListAllVersionsRequest{}
+func (x *DeleteItemRequest) Reset() {
+	*x = DeleteItemRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1689,13 +1942,13 @@ func (x *ListAllVersionsRequest) Reset() {
 	}
 }
 
-func (x *ListAllVersionsRequest) String() string {
+func (x *DeleteItemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAllVersionsRequest) ProtoMessage() {}
+func (*DeleteItemRequest) ProtoMessage() {}
 
-func (x *ListAllVersionsRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteItemRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1707,21 +1960,26 @@ func (x *ListAllVersionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAllVersionsRequest.ProtoReflect.Descriptor instead.
-func (*ListAllVersionsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteItemRequest.ProtoReflect.Descriptor instead.
+func (*DeleteItemRequest) Descriptor() ([]byte, []int) {
 	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{30}
 }
 
-type ListAllVersionsResponse struct {
+func (x *DeleteItemRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+type DeleteItemResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Versions []*ListAllVersionsResponse_Versions `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
 }
 
-func (x *ListAllVersionsResponse) Reset() {
-	*x = ListAllVersionsResponse{}
+func (x *DeleteItemResponse) Reset() {
+	*x = DeleteItemResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1729,13 +1987,13 @@ func (x *ListAllVersionsResponse) Reset() {
 	}
 }
 
-func (x *ListAllVersionsResponse) String() string {
+func (x *DeleteItemResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAllVersionsResponse) ProtoMessage() {}
+func (*DeleteItemResponse) ProtoMessage() {}
 
-func (x *ListAllVersionsResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteItemResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1747,30 +2005,23 @@ func (x *ListAllVersionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAllVersionsResponse.ProtoReflect.Descriptor instead.
-func (*ListAllVersionsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteItemResponse.ProtoReflect.Descriptor instead.
+func (*DeleteItemResponse) Descriptor() ([]byte, []int) {
 	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *ListAllVersionsResponse) GetVersions() []*ListAllVersionsResponse_Versions {
-	if x != nil {
-		return x.Versions
-	}
-	return nil
-}
-
-type ComputeItemsTreeRequest struct {
+type DeleteItemsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NodeEntityType   string `protobuf:"bytes,1,opt,name=node_entity_type,json=nodeEntityType,proto3" json:"node_entity_type,omitempty"`
-	LinkFieldName    string `protobuf:"bytes,2,opt,name=link_field_name,json=linkFieldName,proto3" json:"link_field_name,omitempty"`
-	DisplayFieldName string `protobuf:"bytes,3,opt,name=display_field_name,json=displayFieldName,proto3" json:"display_field_name,omitempty"`
+	ItemIds     []string `protobuf:"bytes,1,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
+	BusinessIds []string `protobuf:"bytes,2,rep,name=business_ids,json=businessIds,proto3" json:"business_ids,omitempty"`
+	Cascade     bool     `protobuf:"varint,3,opt,name=cascade,proto3" json:"cascade,omitempty"`
 }
 
-func (x *ComputeItemsTreeRequest) Reset() {
-	*x = ComputeItemsTreeRequest{}
+func (x *DeleteItemsRequest) Reset() {
+	*x = DeleteItemsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1778,13 +2029,13 @@ func (x *ComputeItemsTreeRequest) Reset() {
 	}
 }
 
-func (x *ComputeItemsTreeRequest) String() string {
+func (x *DeleteItemsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ComputeItemsTreeRequest) ProtoMessage() {}
+func (*DeleteItemsRequest) ProtoMessage() {}
 
-func (x *ComputeItemsTreeRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteItemsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1796,42 +2047,43 @@ func (x *ComputeItemsTreeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ComputeItemsTreeRequest.ProtoReflect.Descriptor instead.
-func (*ComputeItemsTreeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteItemsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteItemsRequest) Descriptor() ([]byte, []int) {
 	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ComputeItemsTreeRequest) GetNodeEntityType() string {
+func (x *DeleteItemsRequest) GetItemIds() []string {
 	if x != nil {
-		return x.NodeEntityType
+		return x.ItemIds
 	}
-	return ""
+	return nil
 }
 
-func (x *ComputeItemsTreeRequest) GetLinkFieldName() string {
+func (x *DeleteItemsRequest) GetBusinessIds() []string {
 	if x != nil {
-		return x.LinkFieldName
+		return x.BusinessIds
 	}
-	return ""
+	return nil
 }
 
-func (x *ComputeItemsTreeRequest) GetDisplayFieldName() string {
+func (x *DeleteItemsRequest) GetCascade() bool {
 	if x != nil {
-		return x.DisplayFieldName
+		return x.Cascade
 	}
-	return ""
+	return false
 }
 
-type ComputeItemsTreeResponse struct {
+type DeleteItemsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Nodes []*ComputeItemsTreeResponse_TreeNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	DeleteItemIds []string `protobuf:"bytes,1,rep,name=delete_item_ids,json=deleteItemIds,proto3" json:"delete_item_ids,omitempty"`
+	RowsModified  int32    `protobuf:"varint,2,opt,name=rows_modified,json=rowsModified,proto3" json:"rows_modified,omitempty"`
 }
 
-func (x *ComputeItemsTreeResponse) Reset() {
-	*x = ComputeItemsTreeResponse{}
+func (x *DeleteItemsResponse) Reset() {
+	*x = DeleteItemsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1839,13 +2091,13 @@ func (x *ComputeItemsTreeResponse) Reset() {
 	}
 }
 
-func (x *ComputeItemsTreeResponse) String() string {
+func (x *DeleteItemsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ComputeItemsTreeResponse) ProtoMessage() {}
+func (*DeleteItemsResponse) ProtoMessage() {}
 
-func (x *ComputeItemsTreeResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteItemsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1857,28 +2109,33 @@ func (x *ComputeItemsTreeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ComputeItemsTreeResponse.ProtoReflect.Descriptor instead.
-func (*ComputeItemsTreeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteItemsResponse.ProtoReflect.Descriptor instead.
+func (*DeleteItemsResponse) Descriptor() ([]byte, []int) {
 	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ComputeItemsTreeResponse) GetNodes() []*ComputeItemsTreeResponse_TreeNode {
+func (x *DeleteItemsResponse) GetDeleteItemIds() []string {
 	if x != nil {
-		return x.Nodes
+		return x.DeleteItemIds
 	}
 	return nil
 }
 
-type CreateItemResponse_PostActionResult struct {
+func (x *DeleteItemsResponse) GetRowsModified() int32 {
+	if x != nil {
+		return x.RowsModified
+	}
+	return 0
+}
+
+type DeleteAllItemsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
 }
 
-func (x *CreateItemResponse_PostActionResult) Reset() {
-	*x = CreateItemResponse_PostActionResult{}
+func (x *DeleteAllItemsRequest) Reset() {
+	*x = DeleteAllItemsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1886,13 +2143,13 @@ func (x *CreateItemResponse_PostActionResult) Reset() {
 	}
 }
 
-func (x *CreateItemResponse_PostActionResult) String() string {
+func (x *DeleteAllItemsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateItemResponse_PostActionResult) ProtoMessage() {}
+func (*DeleteAllItemsRequest) ProtoMessage() {}
 
-func (x *CreateItemResponse_PostActionResult) ProtoReflect() protoreflect.Message {
+func (x *DeleteAllItemsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1904,45 +2161,1423 @@ func (x *CreateItemResponse_PostActionResult) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateItemResponse_PostActionResult.ProtoReflect.Descriptor instead.
-func (*CreateItemResponse_PostActionResult) Descriptor() ([]byte, []int) {
-	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use DeleteAllItemsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAllItemsRequest) Descriptor() ([]byte, []int) {
+	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *CreateItemResponse_PostActionResult) GetAction() string {
+type DeleteAllItemsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteAllItemsResponse) Reset() {
+	*x = DeleteAllItemsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAllItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAllItemsResponse) ProtoMessage() {}
+
+func (x *DeleteAllItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAllItemsResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAllItemsResponse) Descriptor() ([]byte, []int) {
+	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{35}
+}
+
+type AggregateItemsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntityType string `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	BusinessId string `protobuf:"bytes,2,opt,name=business_id,json=businessId,proto3" json:"business_id,omitempty"`
+}
+
+func (x *AggregateItemsRequest) Reset() {
+	*x = AggregateItemsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateItemsRequest) ProtoMessage() {}
+
+func (x *AggregateItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateItemsRequest.ProtoReflect.Descriptor instead.
+func (*AggregateItemsRequest) Descriptor() ([]byte, []int) {
+	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AggregateItemsRequest) GetEntityType() string {
 	if x != nil {
-		return x.Action
+		return x.EntityType
 	}
 	return ""
 }
 
-type ComputeVersionsResponse_Version struct {
+func (x *AggregateItemsRequest) GetBusinessId() string {
+	if x != nil {
+		return x.BusinessId
+	}
+	return ""
+}
+
+type AggregateItemsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ItemId      string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
-	VersionDesc string                 `protobuf:"bytes,2,opt,name=version_desc,json=versionDesc,proto3" json:"version_desc,omitempty"`
-	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	AggregateItemId   string   `protobuf:"bytes,1,opt,name=aggregate_item_id,json=aggregateItemId,proto3" json:"aggregate_item_id,omitempty"`
+	AggregatedItemIds []string `protobuf:"bytes,2,rep,name=aggregated_item_ids,json=aggregatedItemIds,proto3" json:"aggregated_item_ids,omitempty"`
+	NewBusinessId     string   `protobuf:"bytes,3,opt,name=new_business_id,json=newBusinessId,proto3" json:"new_business_id,omitempty"`
 }
 
-func (x *ComputeVersionsResponse_Version) Reset() {
-	*x = ComputeVersionsResponse_Version{}
+func (x *AggregateItemsResponse) Reset() {
+	*x = AggregateItemsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateItemsResponse) ProtoMessage() {}
+
+func (x *AggregateItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateItemsResponse.ProtoReflect.Descriptor instead.
+func (*AggregateItemsResponse) Descriptor() ([]byte, []int) {
+	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AggregateItemsResponse) GetAggregateItemId() string {
+	if x != nil {
+		return x.AggregateItemId
+	}
+	return ""
+}
+
+func (x *AggregateItemsResponse) GetAggregatedItemIds() []string {
+	if x != nil {
+		return x.AggregatedItemIds
+	}
+	return nil
+}
+
+func (x *AggregateItemsResponse) GetNewBusinessId() string {
+	if x != nil {
+		return x.NewBusinessId
+	}
+	return ""
+}
+
+type ListAllVersionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListAllVersionsRequest) Reset() {
+	*x = ListAllVersionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAllVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllVersionsRequest) ProtoMessage() {}
+
+func (x *ListAllVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListAllVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{38}
+}
+
+type ListAllVersionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Versions []*ListAllVersionsResponse_Versions `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+}
+
+func (x *ListAllVersionsResponse) Reset() {
+	*x = ListAllVersionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAllVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllVersionsResponse) ProtoMessage() {}
+
+func (x *ListAllVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListAllVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListAllVersionsResponse) GetVersions() []*ListAllVersionsResponse_Versions {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type GetItemHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemId string `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+}
+
+func (x *GetItemHistoryRequest) Reset() {
+	*x = GetItemHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetItemHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemHistoryRequest) ProtoMessage() {}
+
+func (x *GetItemHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetItemHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetItemHistoryRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+type GetItemHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemId    string                                  `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Revisions []*GetItemHistoryResponse_ValueRevision `protobuf:"bytes,2,rep,name=revisions,proto3" json:"revisions,omitempty"`
+}
+
+func (x *GetItemHistoryResponse) Reset() {
+	*x = GetItemHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetItemHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemHistoryResponse) ProtoMessage() {}
+
+func (x *GetItemHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetItemHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetItemHistoryResponse) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *GetItemHistoryResponse) GetRevisions() []*GetItemHistoryResponse_ValueRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type DiffVersionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BusinessId string `protobuf:"bytes,1,opt,name=business_id,json=businessId,proto3" json:"business_id,omitempty"`
+	// If empty, the second to latest version of the business ID is used.
+	FromItemId string `protobuf:"bytes,2,opt,name=from_item_id,json=fromItemId,proto3" json:"from_item_id,omitempty"`
+	// If empty, the latest version of the business ID is used.
+	ToItemId string `protobuf:"bytes,3,opt,name=to_item_id,json=toItemId,proto3" json:"to_item_id,omitempty"`
+}
+
+func (x *DiffVersionsRequest) Reset() {
+	*x = DiffVersionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffVersionsRequest) ProtoMessage() {}
+
+func (x *DiffVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffVersionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DiffVersionsRequest) GetBusinessId() string {
+	if x != nil {
+		return x.BusinessId
+	}
+	return ""
+}
+
+func (x *DiffVersionsRequest) GetFromItemId() string {
+	if x != nil {
+		return x.FromItemId
+	}
+	return ""
+}
+
+func (x *DiffVersionsRequest) GetToItemId() string {
+	if x != nil {
+		return x.ToItemId
+	}
+	return ""
+}
+
+type DiffVersionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BusinessId string                            `protobuf:"bytes,1,opt,name=business_id,json=businessId,proto3" json:"business_id,omitempty"`
+	FromItemId string                            `protobuf:"bytes,2,opt,name=from_item_id,json=fromItemId,proto3" json:"from_item_id,omitempty"`
+	ToItemId   string                            `protobuf:"bytes,3,opt,name=to_item_id,json=toItemId,proto3" json:"to_item_id,omitempty"`
+	Fields     []*DiffVersionsResponse_FieldDiff `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *DiffVersionsResponse) Reset() {
+	*x = DiffVersionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffVersionsResponse) ProtoMessage() {}
+
+func (x *DiffVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffVersionsResponse.ProtoReflect.Descriptor instead.
+func (*DiffVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DiffVersionsResponse) GetBusinessId() string {
+	if x != nil {
+		return x.BusinessId
+	}
+	return ""
+}
+
+func (x *DiffVersionsResponse) GetFromItemId() string {
+	if x != nil {
+		return x.FromItemId
+	}
+	return ""
+}
+
+func (x *DiffVersionsResponse) GetToItemId() string {
+	if x != nil {
+		return x.ToItemId
+	}
+	return ""
+}
+
+func (x *DiffVersionsResponse) GetFields() []*DiffVersionsResponse_FieldDiff {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type ExportItemRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemId string `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+}
+
+func (x *ExportItemRequest) Reset() {
+	*x = ExportItemRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportItemRequest) ProtoMessage() {}
+
+func (x *ExportItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportItemRequest.ProtoReflect.Descriptor instead.
+func (*ExportItemRequest) Descriptor() ([]byte, []int) {
+	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ExportItemRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+type ExportItemsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If empty, items of all entity types are exported.
+	EntityType string `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	// If set, all versions of the items are exported, not only the latest version per business ID.
+	IncludeVersions bool `protobuf:"varint,2,opt,name=include_versions,json=includeVersions,proto3" json:"include_versions,omitempty"`
+}
+
+func (x *ExportItemsRequest) Reset() {
+	*x = ExportItemsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportItemsRequest) ProtoMessage() {}
+
+func (x *ExportItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportItemsRequest.ProtoReflect.Descriptor instead.
+func (*ExportItemsRequest) Descriptor() ([]byte, []int) {
+	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ExportItemsRequest) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *ExportItemsRequest) GetIncludeVersions() bool {
+	if x != nil {
+		return x.IncludeVersions
+	}
+	return false
+}
+
+type CheckSchemaConformanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If empty, items of all entity types with a schema are checked.
+	EntityType string `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	// Item ID to continue with, as returned by the previous call; empty for the first page.
+	Next string `protobuf:"bytes,2,opt,name=next,proto3" json:"next,omitempty"`
+}
+
+func (x *CheckSchemaConformanceRequest) Reset() {
+	*x = CheckSchemaConformanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckSchemaConformanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckSchemaConformanceRequest) ProtoMessage() {}
+
+func (x *CheckSchemaConformanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckSchemaConformanceRequest.ProtoReflect.Descriptor instead.
+func (*CheckSchemaConformanceRequest) Descriptor() ([]byte, []int) {
+	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CheckSchemaConformanceRequest) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *CheckSchemaConformanceRequest) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
+type CheckSchemaConformanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only the items violating the schema of their entity type are reported. The counts refer to the current page.
+	Items              []*CheckSchemaConformanceResponse_NonconformingItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	CheckedCount       int32                                               `protobuf:"varint,2,opt,name=checked_count,json=checkedCount,proto3" json:"checked_count,omitempty"`
+	NonconformingCount int32                                               `protobuf:"varint,3,opt,name=nonconforming_count,json=nonconformingCount,proto3" json:"nonconforming_count,omitempty"`
+	// Item ID to continue with; empty if all items were checked.
+	Next string `protobuf:"bytes,4,opt,name=next,proto3" json:"next,omitempty"`
+}
+
+func (x *CheckSchemaConformanceResponse) Reset() {
+	*x = CheckSchemaConformanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckSchemaConformanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckSchemaConformanceResponse) ProtoMessage() {}
+
+func (x *CheckSchemaConformanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckSchemaConformanceResponse.ProtoReflect.Descriptor instead.
+func (*CheckSchemaConformanceResponse) Descriptor() ([]byte, []int) {
+	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CheckSchemaConformanceResponse) GetItems() []*CheckSchemaConformanceResponse_NonconformingItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CheckSchemaConformanceResponse) GetCheckedCount() int32 {
+	if x != nil {
+		return x.CheckedCount
+	}
+	return 0
+}
+
+func (x *CheckSchemaConformanceResponse) GetNonconformingCount() int32 {
+	if x != nil {
+		return x.NonconformingCount
+	}
+	return 0
+}
+
+func (x *CheckSchemaConformanceResponse) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
+type ComputeItemsTreeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeEntityType   string `protobuf:"bytes,1,opt,name=node_entity_type,json=nodeEntityType,proto3" json:"node_entity_type,omitempty"`
+	LinkFieldName    string `protobuf:"bytes,2,opt,name=link_field_name,json=linkFieldName,proto3" json:"link_field_name,omitempty"`
+	DisplayFieldName string `protobuf:"bytes,3,opt,name=display_field_name,json=displayFieldName,proto3" json:"display_field_name,omitempty"`
+}
+
+func (x *ComputeItemsTreeRequest) Reset() {
+	*x = ComputeItemsTreeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ComputeItemsTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComputeItemsTreeRequest) ProtoMessage() {}
+
+func (x *ComputeItemsTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComputeItemsTreeRequest.ProtoReflect.Descriptor instead.
+func (*ComputeItemsTreeRequest) Descriptor() ([]byte, []int) {
+	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ComputeItemsTreeRequest) GetNodeEntityType() string {
+	if x != nil {
+		return x.NodeEntityType
+	}
+	return ""
+}
+
+func (x *ComputeItemsTreeRequest) GetLinkFieldName() string {
+	if x != nil {
+		return x.LinkFieldName
+	}
+	return ""
+}
+
+func (x *ComputeItemsTreeRequest) GetDisplayFieldName() string {
+	if x != nil {
+		return x.DisplayFieldName
+	}
+	return ""
+}
+
+type ComputeItemsTreeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nodes []*ComputeItemsTreeResponse_TreeNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+}
+
+func (x *ComputeItemsTreeResponse) Reset() {
+	*x = ComputeItemsTreeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ComputeItemsTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComputeItemsTreeResponse) ProtoMessage() {}
+
+func (x *ComputeItemsTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComputeItemsTreeResponse.ProtoReflect.Descriptor instead.
+func (*ComputeItemsTreeResponse) Descriptor() ([]byte, []int) {
+	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ComputeItemsTreeResponse) GetNodes() []*ComputeItemsTreeResponse_TreeNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+type CreateItemResponse_PostActionResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+}
+
+func (x *CreateItemResponse_PostActionResult) Reset() {
+	*x = CreateItemResponse_PostActionResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateItemResponse_PostActionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateItemResponse_PostActionResult) ProtoMessage() {}
+
+func (x *CreateItemResponse_PostActionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateItemResponse_PostActionResult.ProtoReflect.Descriptor instead.
+func (*CreateItemResponse_PostActionResult) Descriptor() ([]byte, []int) {
+	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *CreateItemResponse_PostActionResult) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+type ImportItemsRequest_FieldMapping struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// CSV column name, or path of an XML element below the record element (e.g. "titles/title" for DataCite)
+	Source    string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	FieldName string `protobuf:"bytes,2,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	// Language of the values which do not carry a language themselves
+	Language string `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+}
+
+func (x *ImportItemsRequest_FieldMapping) Reset() {
+	*x = ImportItemsRequest_FieldMapping{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportItemsRequest_FieldMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportItemsRequest_FieldMapping) ProtoMessage() {}
+
+func (x *ImportItemsRequest_FieldMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportItemsRequest_FieldMapping.ProtoReflect.Descriptor instead.
+func (*ImportItemsRequest_FieldMapping) Descriptor() ([]byte, []int) {
+	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *ImportItemsRequest_FieldMapping) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ImportItemsRequest_FieldMapping) GetFieldName() string {
+	if x != nil {
+		return x.FieldName
+	}
+	return ""
+}
+
+func (x *ImportItemsRequest_FieldMapping) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+type ValidateItemsResponse_ValueReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index      int32  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	FieldName  string `protobuf:"bytes,2,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	FieldValue string `protobuf:"bytes,3,opt,name=field_value,json=fieldValue,proto3" json:"field_value,omitempty"`
+	Valid      bool   `protobuf:"varint,4,opt,name=valid,proto3" json:"valid,omitempty"`
+	Error      string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ValidateItemsResponse_ValueReport) Reset() {
+	*x = ValidateItemsResponse_ValueReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateItemsResponse_ValueReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateItemsResponse_ValueReport) ProtoMessage() {}
+
+func (x *ValidateItemsResponse_ValueReport) ProtoReflect() protoreflect.Message {
+	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateItemsResponse_ValueReport.ProtoReflect.Descriptor instead.
+func (*ValidateItemsResponse_ValueReport) Descriptor() ([]byte, []int) {
+	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *ValidateItemsResponse_ValueReport) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ValidateItemsResponse_ValueReport) GetFieldName() string {
+	if x != nil {
+		return x.FieldName
+	}
+	return ""
+}
+
+func (x *ValidateItemsResponse_ValueReport) GetFieldValue() string {
+	if x != nil {
+		return x.FieldValue
+	}
+	return ""
+}
+
+func (x *ValidateItemsResponse_ValueReport) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateItemsResponse_ValueReport) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ValidateItemsResponse_ItemReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index int32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Valid bool  `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+	// Duplicates are not invalid, but would be skipped on creation.
+	Duplicate bool                                 `protobuf:"varint,3,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
+	Errors    []string                             `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	Values    []*ValidateItemsResponse_ValueReport `protobuf:"bytes,5,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *ValidateItemsResponse_ItemReport) Reset() {
+	*x = ValidateItemsResponse_ItemReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateItemsResponse_ItemReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateItemsResponse_ItemReport) ProtoMessage() {}
+
+func (x *ValidateItemsResponse_ItemReport) ProtoReflect() protoreflect.Message {
+	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateItemsResponse_ItemReport.ProtoReflect.Descriptor instead.
+func (*ValidateItemsResponse_ItemReport) Descriptor() ([]byte, []int) {
+	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{9, 1}
+}
+
+func (x *ValidateItemsResponse_ItemReport) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ValidateItemsResponse_ItemReport) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateItemsResponse_ItemReport) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
+func (x *ValidateItemsResponse_ItemReport) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ValidateItemsResponse_ItemReport) GetValues() []*ValidateItemsResponse_ValueReport {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type ComputeVersionsResponse_Version struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemId      string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	VersionDesc string                 `protobuf:"bytes,2,opt,name=version_desc,json=versionDesc,proto3" json:"version_desc,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *ComputeVersionsResponse_Version) Reset() {
+	*x = ComputeVersionsResponse_Version{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ComputeVersionsResponse_Version) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComputeVersionsResponse_Version) ProtoMessage() {}
+
+func (x *ComputeVersionsResponse_Version) ProtoReflect() protoreflect.Message {
+	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComputeVersionsResponse_Version.ProtoReflect.Descriptor instead.
+func (*ComputeVersionsResponse_Version) Descriptor() ([]byte, []int) {
+	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *ComputeVersionsResponse_Version) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *ComputeVersionsResponse_Version) GetVersionDesc() string {
+	if x != nil {
+		return x.VersionDesc
+	}
+	return ""
+}
+
+func (x *ComputeVersionsResponse_Version) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ComputeVersionsByBusinessIdResponse_Version struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemId      string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	VersionDesc string                 `protobuf:"bytes,2,opt,name=version_desc,json=versionDesc,proto3" json:"version_desc,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *ComputeVersionsByBusinessIdResponse_Version) Reset() {
+	*x = ComputeVersionsByBusinessIdResponse_Version{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ComputeVersionsByBusinessIdResponse_Version) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComputeVersionsByBusinessIdResponse_Version) ProtoMessage() {}
+
+func (x *ComputeVersionsByBusinessIdResponse_Version) ProtoReflect() protoreflect.Message {
+	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComputeVersionsByBusinessIdResponse_Version.ProtoReflect.Descriptor instead.
+func (*ComputeVersionsByBusinessIdResponse_Version) Descriptor() ([]byte, []int) {
+	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{13, 0}
+}
+
+func (x *ComputeVersionsByBusinessIdResponse_Version) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *ComputeVersionsByBusinessIdResponse_Version) GetVersionDesc() string {
+	if x != nil {
+		return x.VersionDesc
+	}
+	return ""
+}
+
+func (x *ComputeVersionsByBusinessIdResponse_Version) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetItemResponse_FullItemValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemValueId string `protobuf:"bytes,1,opt,name=item_value_id,json=itemValueId,proto3" json:"item_value_id,omitempty"`
+	FieldName   string `protobuf:"bytes,2,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	FieldValue  string `protobuf:"bytes,3,opt,name=field_value,json=fieldValue,proto3" json:"field_value,omitempty"`
+	Language    string `protobuf:"bytes,4,opt,name=language,proto3" json:"language,omitempty"`
+	Place       int32  `protobuf:"varint,5,opt,name=place,proto3" json:"place,omitempty"`
+	Revision    int32  `protobuf:"varint,6,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *GetItemResponse_FullItemValue) Reset() {
+	*x = GetItemResponse_FullItemValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetItemResponse_FullItemValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemResponse_FullItemValue) ProtoMessage() {}
+
+func (x *GetItemResponse_FullItemValue) ProtoReflect() protoreflect.Message {
+	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemResponse_FullItemValue.ProtoReflect.Descriptor instead.
+func (*GetItemResponse_FullItemValue) Descriptor() ([]byte, []int) {
+	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{27, 0}
+}
+
+func (x *GetItemResponse_FullItemValue) GetItemValueId() string {
+	if x != nil {
+		return x.ItemValueId
+	}
+	return ""
+}
+
+func (x *GetItemResponse_FullItemValue) GetFieldName() string {
+	if x != nil {
+		return x.FieldName
+	}
+	return ""
+}
+
+func (x *GetItemResponse_FullItemValue) GetFieldValue() string {
+	if x != nil {
+		return x.FieldValue
+	}
+	return ""
+}
+
+func (x *GetItemResponse_FullItemValue) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *GetItemResponse_FullItemValue) GetPlace() int32 {
+	if x != nil {
+		return x.Place
+	}
+	return 0
+}
+
+func (x *GetItemResponse_FullItemValue) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type UpdateItemRequest_ValueUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operation  UpdateItemRequest_Operation `protobuf:"varint,1,opt,name=operation,proto3,enum=d4l.mex.items.UpdateItemRequest_Operation" json:"operation,omitempty"`
+	FieldName  string                      `protobuf:"bytes,2,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	FieldValue string                      `protobuf:"bytes,3,opt,name=field_value,json=fieldValue,proto3" json:"field_value,omitempty"` // ignored for REMOVE
+	Language   string                      `protobuf:"bytes,4,opt,name=language,proto3" json:"language,omitempty"`                       // ignored for REMOVE
+	Place      int32                       `protobuf:"varint,5,opt,name=place,proto3" json:"place,omitempty"`                            // ignored for ADD
+}
+
+func (x *UpdateItemRequest_ValueUpdate) Reset() {
+	*x = UpdateItemRequest_ValueUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateItemRequest_ValueUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateItemRequest_ValueUpdate) ProtoMessage() {}
+
+func (x *UpdateItemRequest_ValueUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateItemRequest_ValueUpdate.ProtoReflect.Descriptor instead.
+func (*UpdateItemRequest_ValueUpdate) Descriptor() ([]byte, []int) {
+	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{28, 0}
+}
+
+func (x *UpdateItemRequest_ValueUpdate) GetOperation() UpdateItemRequest_Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return UpdateItemRequest_ADD
+}
+
+func (x *UpdateItemRequest_ValueUpdate) GetFieldName() string {
+	if x != nil {
+		return x.FieldName
+	}
+	return ""
+}
+
+func (x *UpdateItemRequest_ValueUpdate) GetFieldValue() string {
+	if x != nil {
+		return x.FieldValue
+	}
+	return ""
+}
+
+func (x *UpdateItemRequest_ValueUpdate) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *UpdateItemRequest_ValueUpdate) GetPlace() int32 {
+	if x != nil {
+		return x.Place
+	}
+	return 0
+}
+
+type ListAllVersionsResponse_Versions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BusinessId string   `protobuf:"bytes,1,opt,name=business_id,json=businessId,proto3" json:"business_id,omitempty"`
+	ItemIds    []string `protobuf:"bytes,2,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
+}
+
+func (x *ListAllVersionsResponse_Versions) Reset() {
+	*x = ListAllVersionsResponse_Versions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[35]
+		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ComputeVersionsResponse_Version) String() string {
+func (x *ListAllVersionsResponse_Versions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ComputeVersionsResponse_Version) ProtoMessage() {}
+func (*ListAllVersionsResponse_Versions) ProtoMessage() {}
 
-func (x *ComputeVersionsResponse_Version) ProtoReflect() protoreflect.Message {
-	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[35]
+func (x *ListAllVersionsResponse_Versions) ProtoReflect() protoreflect.Message {
+	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1953,59 +3588,59 @@ func (x *ComputeVersionsResponse_Version) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ComputeVersionsResponse_Version.ProtoReflect.Descriptor instead.
-func (*ComputeVersionsResponse_Version) Descriptor() ([]byte, []int) {
-	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{5, 0}
-}
-
-func (x *ComputeVersionsResponse_Version) GetItemId() string {
-	if x != nil {
-		return x.ItemId
-	}
-	return ""
+// Deprecated: Use ListAllVersionsResponse_Versions.ProtoReflect.Descriptor instead.
+func (*ListAllVersionsResponse_Versions) Descriptor() ([]byte, []int) {
+	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{39, 0}
 }
 
-func (x *ComputeVersionsResponse_Version) GetVersionDesc() string {
+func (x *ListAllVersionsResponse_Versions) GetBusinessId() string {
 	if x != nil {
-		return x.VersionDesc
+		return x.BusinessId
 	}
 	return ""
 }
 
-func (x *ComputeVersionsResponse_Version) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ListAllVersionsResponse_Versions) GetItemIds() []string {
 	if x != nil {
-		return x.CreatedAt
+		return x.ItemIds
 	}
 	return nil
 }
 
-type ComputeVersionsByBusinessIdResponse_Version struct {
+type GetItemHistoryResponse_ValueRevision struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ItemId      string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
-	VersionDesc string                 `protobuf:"bytes,2,opt,name=version_desc,json=versionDesc,proto3" json:"version_desc,omitempty"`
-	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ItemValueId     string                            `protobuf:"bytes,1,opt,name=item_value_id,json=itemValueId,proto3" json:"item_value_id,omitempty"`
+	Revision        int32                             `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	Change          GetItemHistoryResponse_ChangeType `protobuf:"varint,3,opt,name=change,proto3,enum=d4l.mex.items.GetItemHistoryResponse_ChangeType" json:"change,omitempty"`
+	FieldName       string                            `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	FieldValue      string                            `protobuf:"bytes,5,opt,name=field_value,json=fieldValue,proto3" json:"field_value,omitempty"`
+	Language        string                            `protobuf:"bytes,6,opt,name=language,proto3" json:"language,omitempty"`
+	Place           int32                             `protobuf:"varint,7,opt,name=place,proto3" json:"place,omitempty"`
+	Author          string                            `protobuf:"bytes,8,opt,name=author,proto3" json:"author,omitempty"`
+	CreatedAt       *timestamppb.Timestamp            `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RevisionComment string                            `protobuf:"bytes,10,opt,name=revision_comment,json=revisionComment,proto3" json:"revision_comment,omitempty"`
 }
 
-func (x *ComputeVersionsByBusinessIdResponse_Version) Reset() {
-	*x = ComputeVersionsByBusinessIdResponse_Version{}
+func (x *GetItemHistoryResponse_ValueRevision) Reset() {
+	*x = GetItemHistoryResponse_ValueRevision{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[36]
+		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ComputeVersionsByBusinessIdResponse_Version) String() string {
+func (x *GetItemHistoryResponse_ValueRevision) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ComputeVersionsByBusinessIdResponse_Version) ProtoMessage() {}
+func (*GetItemHistoryResponse_ValueRevision) ProtoMessage() {}
 
-func (x *ComputeVersionsByBusinessIdResponse_Version) ProtoReflect() protoreflect.Message {
-	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[36]
+func (x *GetItemHistoryResponse_ValueRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2016,62 +3651,109 @@ func (x *ComputeVersionsByBusinessIdResponse_Version) ProtoReflect() protoreflec
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ComputeVersionsByBusinessIdResponse_Version.ProtoReflect.Descriptor instead.
-func (*ComputeVersionsByBusinessIdResponse_Version) Descriptor() ([]byte, []int) {
-	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{7, 0}
+// Deprecated: Use GetItemHistoryResponse_ValueRevision.ProtoReflect.Descriptor instead.
+func (*GetItemHistoryResponse_ValueRevision) Descriptor() ([]byte, []int) {
+	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{41, 0}
 }
 
-func (x *ComputeVersionsByBusinessIdResponse_Version) GetItemId() string {
+func (x *GetItemHistoryResponse_ValueRevision) GetItemValueId() string {
 	if x != nil {
-		return x.ItemId
+		return x.ItemValueId
 	}
 	return ""
 }
 
-func (x *ComputeVersionsByBusinessIdResponse_Version) GetVersionDesc() string {
+func (x *GetItemHistoryResponse_ValueRevision) GetRevision() int32 {
 	if x != nil {
-		return x.VersionDesc
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *GetItemHistoryResponse_ValueRevision) GetChange() GetItemHistoryResponse_ChangeType {
+	if x != nil {
+		return x.Change
+	}
+	return GetItemHistoryResponse_ADDED
+}
+
+func (x *GetItemHistoryResponse_ValueRevision) GetFieldName() string {
+	if x != nil {
+		return x.FieldName
 	}
 	return ""
 }
 
-func (x *ComputeVersionsByBusinessIdResponse_Version) GetCreatedAt() *timestamppb.Timestamp {
+func (x *GetItemHistoryResponse_ValueRevision) GetFieldValue() string {
+	if x != nil {
+		return x.FieldValue
+	}
+	return ""
+}
+
+func (x *GetItemHistoryResponse_ValueRevision) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *GetItemHistoryResponse_ValueRevision) GetPlace() int32 {
+	if x != nil {
+		return x.Place
+	}
+	return 0
+}
+
+func (x *GetItemHistoryResponse_ValueRevision) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *GetItemHistoryResponse_ValueRevision) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-type GetItemResponse_FullItemValue struct {
+func (x *GetItemHistoryResponse_ValueRevision) GetRevisionComment() string {
+	if x != nil {
+		return x.RevisionComment
+	}
+	return ""
+}
+
+type DiffVersionsResponse_FieldDiff struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ItemValueId string `protobuf:"bytes,1,opt,name=item_value_id,json=itemValueId,proto3" json:"item_value_id,omitempty"`
-	FieldName   string `protobuf:"bytes,2,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
-	FieldValue  string `protobuf:"bytes,3,opt,name=field_value,json=fieldValue,proto3" json:"field_value,omitempty"`
-	Language    string `protobuf:"bytes,4,opt,name=language,proto3" json:"language,omitempty"`
-	Place       int32  `protobuf:"varint,5,opt,name=place,proto3" json:"place,omitempty"`
-	Revision    int32  `protobuf:"varint,6,opt,name=revision,proto3" json:"revision,omitempty"`
+	FieldName string   `protobuf:"bytes,1,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	Language  string   `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	Removed   []string `protobuf:"bytes,3,rep,name=removed,proto3" json:"removed,omitempty"`
+	Added     []string `protobuf:"bytes,4,rep,name=added,proto3" json:"added,omitempty"`
 }
 
-func (x *GetItemResponse_FullItemValue) Reset() {
-	*x = GetItemResponse_FullItemValue{}
+func (x *DiffVersionsResponse_FieldDiff) Reset() {
+	*x = DiffVersionsResponse_FieldDiff{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[38]
+		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetItemResponse_FullItemValue) String() string {
+func (x *DiffVersionsResponse_FieldDiff) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetItemResponse_FullItemValue) ProtoMessage() {}
+func (*DiffVersionsResponse_FieldDiff) ProtoMessage() {}
 
-func (x *GetItemResponse_FullItemValue) ProtoReflect() protoreflect.Message {
-	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[38]
+func (x *DiffVersionsResponse_FieldDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2082,79 +3764,67 @@ func (x *GetItemResponse_FullItemValue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetItemResponse_FullItemValue.ProtoReflect.Descriptor instead.
-func (*GetItemResponse_FullItemValue) Descriptor() ([]byte, []int) {
-	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{21, 0}
-}
-
-func (x *GetItemResponse_FullItemValue) GetItemValueId() string {
-	if x != nil {
-		return x.ItemValueId
-	}
-	return ""
+// Deprecated: Use DiffVersionsResponse_FieldDiff.ProtoReflect.Descriptor instead.
+func (*DiffVersionsResponse_FieldDiff) Descriptor() ([]byte, []int) {
+	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{43, 0}
 }
 
-func (x *GetItemResponse_FullItemValue) GetFieldName() string {
+func (x *DiffVersionsResponse_FieldDiff) GetFieldName() string {
 	if x != nil {
 		return x.FieldName
 	}
 	return ""
 }
 
-func (x *GetItemResponse_FullItemValue) GetFieldValue() string {
-	if x != nil {
-		return x.FieldValue
-	}
-	return ""
-}
-
-func (x *GetItemResponse_FullItemValue) GetLanguage() string {
+func (x *DiffVersionsResponse_FieldDiff) GetLanguage() string {
 	if x != nil {
 		return x.Language
 	}
 	return ""
 }
 
-func (x *GetItemResponse_FullItemValue) GetPlace() int32 {
+func (x *DiffVersionsResponse_FieldDiff) GetRemoved() []string {
 	if x != nil {
-		return x.Place
+		return x.Removed
 	}
-	return 0
+	return nil
 }
 
-func (x *GetItemResponse_FullItemValue) GetRevision() int32 {
+func (x *DiffVersionsResponse_FieldDiff) GetAdded() []string {
 	if x != nil {
-		return x.Revision
+		return x.Added
 	}
-	return 0
+	return nil
 }
 
-type ListAllVersionsResponse_Versions struct {
+type CheckSchemaConformanceResponse_NonconformingItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BusinessId string   `protobuf:"bytes,1,opt,name=business_id,json=businessId,proto3" json:"business_id,omitempty"`
-	ItemIds    []string `protobuf:"bytes,2,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
+	ItemId     string   `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	EntityType string   `protobuf:"bytes,2,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	BusinessId string   `protobuf:"bytes,3,opt,name=business_id,json=businessId,proto3" json:"business_id,omitempty"`
+	Violations []string `protobuf:"bytes,4,rep,name=violations,proto3" json:"violations,omitempty"`
 }
 
-func (x *ListAllVersionsResponse_Versions) Reset() {
-	*x = ListAllVersionsResponse_Versions{}
+func (x *CheckSchemaConformanceResponse_NonconformingItem) Reset() {
+	*x = CheckSchemaConformanceResponse_NonconformingItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[39]
+		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListAllVersionsResponse_Versions) String() string {
+func (x *CheckSchemaConformanceResponse_NonconformingItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAllVersionsResponse_Versions) ProtoMessage() {}
+func (*CheckSchemaConformanceResponse_NonconformingItem) ProtoMessage() {}
 
-func (x *ListAllVersionsResponse_Versions) ProtoReflect() protoreflect.Message {
-	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[39]
+func (x *CheckSchemaConformanceResponse_NonconformingItem) ProtoReflect() protoreflect.Message {
+	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2165,21 +3835,35 @@ func (x *ListAllVersionsResponse_Versions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAllVersionsResponse_Versions.ProtoReflect.Descriptor instead.
-func (*ListAllVersionsResponse_Versions) Descriptor() ([]byte, []int) {
-	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{31, 0}
+// Deprecated: Use CheckSchemaConformanceResponse_NonconformingItem.ProtoReflect.Descriptor instead.
+func (*CheckSchemaConformanceResponse_NonconformingItem) Descriptor() ([]byte, []int) {
+	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{47, 0}
 }
 
-func (x *ListAllVersionsResponse_Versions) GetBusinessId() string {
+func (x *CheckSchemaConformanceResponse_NonconformingItem) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *CheckSchemaConformanceResponse_NonconformingItem) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *CheckSchemaConformanceResponse_NonconformingItem) GetBusinessId() string {
 	if x != nil {
 		return x.BusinessId
 	}
 	return ""
 }
 
-func (x *ListAllVersionsResponse_Versions) GetItemIds() []string {
+func (x *CheckSchemaConformanceResponse_NonconformingItem) GetViolations() []string {
 	if x != nil {
-		return x.ItemIds
+		return x.Violations
 	}
 	return nil
 }
@@ -2197,7 +3881,7 @@ type ComputeItemsTreeResponse_Display struct {
 func (x *ComputeItemsTreeResponse_Display) Reset() {
 	*x = ComputeItemsTreeResponse_Display{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[40]
+		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2210,7 +3894,7 @@ func (x *ComputeItemsTreeResponse_Display) String() string {
 func (*ComputeItemsTreeResponse_Display) ProtoMessage() {}
 
 func (x *ComputeItemsTreeResponse_Display) ProtoReflect() protoreflect.Message {
-	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[40]
+	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2223,7 +3907,7 @@ func (x *ComputeItemsTreeResponse_Display) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ComputeItemsTreeResponse_Display.ProtoReflect.Descriptor instead.
 func (*ComputeItemsTreeResponse_Display) Descriptor() ([]byte, []int) {
-	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{33, 0}
+	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{49, 0}
 }
 
 func (x *ComputeItemsTreeResponse_Display) GetLanguage() string {
@@ -2261,7 +3945,7 @@ type ComputeItemsTreeResponse_TreeNode struct {
 func (x *ComputeItemsTreeResponse_TreeNode) Reset() {
 	*x = ComputeItemsTreeResponse_TreeNode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[41]
+		mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2274,7 +3958,7 @@ func (x *ComputeItemsTreeResponse_TreeNode) String() string {
 func (*ComputeItemsTreeResponse_TreeNode) ProtoMessage() {}
 
 func (x *ComputeItemsTreeResponse_TreeNode) ProtoReflect() protoreflect.Message {
-	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[41]
+	mi := &file_services_metadata_endpoints_items_items_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2287,7 +3971,7 @@ func (x *ComputeItemsTreeResponse_TreeNode) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ComputeItemsTreeResponse_TreeNode.ProtoReflect.Descriptor instead.
 func (*ComputeItemsTreeResponse_TreeNode) Descriptor() ([]byte, []int) {
-	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{33, 1}
+	return file_services_metadata_endpoints_items_items_proto_rawDescGZIP(), []int{49, 1}
 }
 
 func (x *ComputeItemsTreeResponse_TreeNode) GetNodeId() string {
@@ -2331,477 +4015,747 @@ var file_services_metadata_endpoints_items_items_proto_rawDesc = []byte{
 	0x6f, 0x1a, 0x12, 0x64, 0x34, 0x6c, 0x2f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70,
 	0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d,
-	0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd4, 0x03, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49,
-	0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x04, 0x69, 0x74,
-	0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d,
-	0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69,
-	0x74, 0x65, 0x6d, 0x12, 0x31, 0x0a, 0x14, 0x70, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x61,
-	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x13, 0x70, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
-	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x3a, 0xce, 0x02, 0x92, 0x41, 0xca,
-	0x02, 0x32, 0xc7, 0x02, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x3a, 0x7b, 0x22, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x72, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x22, 0x2c, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x3a, 0x5b, 0x7b,
-	0x22, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x22, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x22, 0x2c, 0x22, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22,
-	0x3a, 0x22, 0x4f, 0x6e, 0x20, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x20,
-	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x2c, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x61, 0x6e,
-	0x20, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x6f, 0x20,
-	0x74, 0x68, 0x65, 0x20, 0x45, 0x6e, 0x74, 0x73, 0x63, 0x68, 0x65, 0x69, 0x64, 0x75, 0x6e, 0x67,
-	0x73, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x22, 0x7d, 0x2c, 0x7b, 0x22, 0x66, 0x69, 0x65,
-	0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x22,
-	0x2c, 0x22, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x22, 0x41,
-	0x2e, 0x4d, 0x2e, 0x20, 0x54, 0x75, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x7b, 0x22, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x22, 0x2c, 0x22, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a,
-	0x22, 0x45, 0x2e, 0x4c, 0x2e, 0x20, 0x42, 0x72, 0x6f, 0x77, 0x6e, 0x22, 0x7d, 0x2c, 0x7b, 0x22,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x62, 0x73, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x22, 0x2c, 0x22, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x22, 0x3a, 0x22, 0x54, 0x68, 0x65, 0x20, 0x27, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x27, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x20, 0x6d, 0x61, 0x79,
-	0x20, 0x62, 0x65, 0x2e, 0x2e, 0x2e, 0x22, 0x7d, 0x5d, 0x7d, 0x7d, 0x22, 0xb2, 0x01, 0x0a, 0x12,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x62,
-	0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x1a, 0x2a, 0x0a, 0x10,
-	0x50, 0x6f, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x36, 0x92, 0x41, 0x33, 0x32, 0x31, 0x7b,
-	0x22, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x22, 0x3a, 0x22, 0x38, 0x64, 0x39, 0x38, 0x37, 0x36,
-	0x64, 0x62, 0x2d, 0x65, 0x61, 0x64, 0x39, 0x2d, 0x34, 0x62, 0x30, 0x65, 0x2d, 0x39, 0x63, 0x63,
-	0x31, 0x2d, 0x64, 0x31, 0x37, 0x32, 0x33, 0x65, 0x65, 0x66, 0x31, 0x39, 0x38, 0x38, 0x22, 0x7d,
-	0x22, 0xe0, 0x01, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73,
-	0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x69,
-	0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x34, 0x6c,
+	0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x68, 0x74, 0x74, 0x70, 0x62, 0x6f, 0x64, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61,
+	0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xd4, 0x03, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x31,
+	0x0a, 0x14, 0x70, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x70, 0x72,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x3a, 0xce, 0x02, 0x92, 0x41, 0xca, 0x02, 0x32, 0xc7, 0x02, 0x7b,
+	0x22, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x3a, 0x7b, 0x22, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x2c,
+	0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x3a, 0x5b, 0x7b, 0x22, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x22, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x2c, 0x22,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x22, 0x4f, 0x6e, 0x20,
+	0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x20, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x2c, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x61, 0x6e, 0x20, 0x41, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x45,
+	0x6e, 0x74, 0x73, 0x63, 0x68, 0x65, 0x69, 0x64, 0x75, 0x6e, 0x67, 0x73, 0x70, 0x72, 0x6f, 0x62,
+	0x6c, 0x65, 0x6d, 0x22, 0x7d, 0x2c, 0x7b, 0x22, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x22, 0x2c, 0x22, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x22, 0x41, 0x2e, 0x4d, 0x2e, 0x20, 0x54,
+	0x75, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x7b, 0x22, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x22, 0x2c, 0x22, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x22, 0x45, 0x2e, 0x4c, 0x2e,
+	0x20, 0x42, 0x72, 0x6f, 0x77, 0x6e, 0x22, 0x7d, 0x2c, 0x7b, 0x22, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x62, 0x73, 0x74, 0x72, 0x61, 0x63, 0x74, 0x22,
+	0x2c, 0x22, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x22, 0x54,
+	0x68, 0x65, 0x20, 0x27, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x27, 0x20,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x20, 0x6d, 0x61, 0x79, 0x20, 0x62, 0x65, 0x2e, 0x2e,
+	0x2e, 0x22, 0x7d, 0x5d, 0x7d, 0x7d, 0x22, 0xb2, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65,
+	0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x73,
+	0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x1a, 0x2a, 0x0a, 0x10, 0x50, 0x6f, 0x73, 0x74, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x3a, 0x36, 0x92, 0x41, 0x33, 0x32, 0x31, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d,
+	0x49, 0x64, 0x22, 0x3a, 0x22, 0x38, 0x64, 0x39, 0x38, 0x37, 0x36, 0x64, 0x62, 0x2d, 0x65, 0x61,
+	0x64, 0x39, 0x2d, 0x34, 0x62, 0x30, 0x65, 0x2d, 0x39, 0x63, 0x63, 0x31, 0x2d, 0x64, 0x31, 0x37,
+	0x32, 0x33, 0x65, 0x65, 0x66, 0x31, 0x39, 0x38, 0x38, 0x22, 0x7d, 0x22, 0xe0, 0x01, 0x0a, 0x16,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x42, 0x75, 0x6c, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x12, 0x40, 0x0a, 0x1c, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x64, 0x75,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1a, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+	0x65, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69,
+	0x74, 0x68, 0x6d, 0x12, 0x59, 0x0a, 0x13, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x28, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x44,
+	0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x12, 0x64, 0x75, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x22, 0x30,
+	0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x42, 0x75, 0x6c,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64,
+	0x22, 0x43, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x04,
+	0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x34, 0x6c,
 	0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52,
-	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x40, 0x0a, 0x1c, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
-	0x64, 0x65, 0x5f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x6c, 0x67,
-	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1a, 0x6f, 0x76,
-	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41,
-	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x59, 0x0a, 0x13, 0x64, 0x75, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e,
-	0x63, 0x66, 0x67, 0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x44, 0x65, 0x74,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52,
-	0x12, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69,
-	0x74, 0x68, 0x6d, 0x22, 0x30, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65,
-	0x6d, 0x73, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15,
-	0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x31, 0x0a, 0x16, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x22, 0xe8, 0x01, 0x0a, 0x17, 0x43, 0x6f, 0x6d,
-	0x70, 0x75, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78,
-	0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x1a, 0x80, 0x01, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07,
-	0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69,
-	0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x5f, 0x64, 0x65, 0x73, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x22, 0x45, 0x0a, 0x22, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73,
-	0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x73,
-	0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x22, 0x80, 0x02, 0x0a, 0x23, 0x43,
-	0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79,
-	0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x56, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69,
-	0x74, 0x65, 0x6d, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x80, 0x01, 0x0a, 0x07, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12,
-	0x21, 0x0a, 0x0c, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65,
-	0x73, 0x63, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa9, 0x01,
-	0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x9e, 0x01, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x49, 0x74, 0x65, 0x6d, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x22, 0x82, 0x04, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x4a, 0x0a, 0x08, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d,
+	0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49,
+	0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x73, 0x76, 0x5f, 0x64, 0x65,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63,
+	0x73, 0x76, 0x44, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x13, 0x63,
+	0x73, 0x76, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x73, 0x76, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x53, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x40, 0x0a, 0x1c, 0x6f,
+	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x1a, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x44, 0x75, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x59, 0x0a,
+	0x13, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x64, 0x34, 0x6c,
+	0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x52, 0x12, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41,
+	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x1a, 0x61, 0x0a, 0x0c, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0x4b, 0x0a, 0x13, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x74, 0x65,
+	0x6d, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x69,
+	0x74, 0x65, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xde, 0x01, 0x0a, 0x14, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x29, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x40, 0x0a, 0x1c,
+	0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x1a, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x44, 0x75, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x59,
+	0x0a, 0x13, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x64, 0x34,
+	0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x12, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x22, 0x9a, 0x04, 0x0a, 0x15, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x69,
+	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x27, 0x0a, 0x0f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x64, 0x75, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x8f, 0x01, 0x0a, 0x0b, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0xb8, 0x01, 0x0a, 0x0a,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x75, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x48, 0x0a, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x64,
+	0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x31, 0x0a, 0x16, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74,
+	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x22, 0xe8, 0x01, 0x0a, 0x17, 0x43, 0x6f,
+	0x6d, 0x70, 0x75, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65,
+	0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x1a, 0x80, 0x01, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a,
+	0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0x45, 0x0a, 0x22, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73,
+	0x73, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75,
+	0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x22, 0x80, 0x02, 0x0a, 0x23,
+	0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42,
+	0x79, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x80, 0x01, 0x0a, 0x07,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x73, 0x63,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x44,
+	0x65, 0x73, 0x63, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa9,
+	0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0e,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x74, 0x65, 0x6d,
+	0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x74, 0x65,
+	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d,
+	0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x71, 0x0a, 0x16, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0c, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x69, 0x74,
+	0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x69,
+	0x6e, 0x66, 0x6f, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x22, 0xa7, 0x01,
+	0x0a, 0x25, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0e,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x74, 0x65, 0x6d,
+	0x49, 0x64, 0x12, 0x33, 0x0a, 0x16, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x74, 0x65,
+	0x6d, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x13, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x44, 0x0a, 0x26, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x75,
+	0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x65, 0x64, 0x22, 0x95, 0x01,
+	0x0a, 0x27, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x46, 0x72, 0x6f, 0x6d, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x49, 0x74, 0x65,
+	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24,
+	0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x74,
+	0x65, 0x6d, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x73, 0x69, 0x6e,
+	0x65, 0x73, 0x73, 0x49, 0x64, 0x22, 0x46, 0x0a, 0x28, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x4f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x65, 0x64, 0x22, 0x16, 0x0a,
+	0x14, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc7, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x49,
 	0x64, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d,
-	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65,
-	0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x71, 0x0a, 0x16, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0c, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x69, 0x74, 0x65,
-	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x69, 0x6e,
-	0x66, 0x6f, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
-	0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x22, 0xa7, 0x01, 0x0a,
-	0x25, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x46, 0x72, 0x6f, 0x6d, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72,
-	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x49,
-	0x64, 0x12, 0x33, 0x0a, 0x16, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d,
-	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x13, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x44, 0x0a, 0x26, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x75, 0x73,
-	0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x65, 0x64, 0x22, 0x95, 0x01, 0x0a,
-	0x27, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x46, 0x72, 0x6f, 0x6d, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a,
-	0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x74, 0x65,
-	0x6d, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f,
-	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65,
-	0x73, 0x73, 0x49, 0x64, 0x22, 0x46, 0x0a, 0x28, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x4f, 0x72, 0x69, 0x67, 0x69,
-	0x6e, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x65, 0x64, 0x22, 0x16, 0x0a, 0x14,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0xc7, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x6c, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64,
-	0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f,
-	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0c, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x69,
-	0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a,
-	0x69, 0x6e, 0x66, 0x6f, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a,
-	0x0d, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x22, 0xc5,
-	0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x09, 0x72, 0x65, 0x6c, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x64, 0x34,
-	0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x09, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x59, 0x0a, 0x0e, 0x52,
-	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x47, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74,
-	0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65,
-	0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x12, 0x1f,
-	0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x22,
-	0xb6, 0x01, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x17, 0x0a, 0x07,
-	0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69,
-	0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x73, 0x69, 0x6e,
-	0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75,
-	0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x22, 0x56, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74,
-	0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a,
-	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0c, 0x69, 0x6e, 0x66, 0x6f, 0x5f,
+	0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x0a, 0x69, 0x6e, 0x66, 0x6f, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0f,
+	0x0a, 0x0d, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x22,
+	0xc5, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x09, 0x72, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x64,
 	0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74,
-	0x22, 0x29, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x22, 0xdc, 0x03, 0x0a, 0x0f,
-	0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e,
-	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12,
-	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x24, 0x0a, 0x0b, 0x62, 0x75,
-	0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x0a, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x88, 0x01, 0x01,
-	0x12, 0x44, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x2c, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73,
-	0x2e, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x2e, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0xc1, 0x01, 0x0a, 0x0d, 0x46, 0x75, 0x6c, 0x6c, 0x49,
-	0x74, 0x65, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x74, 0x65, 0x6d,
-	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x69, 0x74, 0x65, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x63,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x62,
-	0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x22, 0x2c, 0x0a, 0x11, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6c,
-	0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x73, 0x12,
-	0x21, 0x0a, 0x0c, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49,
-	0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x73, 0x63, 0x61, 0x64, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x61, 0x73, 0x63, 0x61, 0x64, 0x65, 0x22, 0x62, 0x0a, 0x13,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x69, 0x74,
-	0x65, 0x6d, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x72,
-	0x6f, 0x77, 0x73, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0c, 0x72, 0x6f, 0x77, 0x73, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64,
-	0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x49, 0x74, 0x65,
-	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x59, 0x0a, 0x15, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65,
-	0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x59, 0x0a, 0x0e,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x47, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x49,
+	0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x22, 0xb6, 0x01, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x17, 0x0a,
+	0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x73, 0x69,
+	0x6e, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62,
+	0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x22, 0x56, 0x0a, 0x11, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x65, 0x78,
+	0x74, 0x22, 0x29, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x22, 0xdc, 0x03, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x24, 0x0a, 0x0b, 0x62,
+	0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x0a, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x44, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0xc1, 0x01, 0x0a, 0x0d, 0x46, 0x75, 0x6c, 0x6c,
+	0x49, 0x74, 0x65, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x74, 0x65,
+	0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x69, 0x74, 0x65, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x22, 0xf4, 0x04, 0x0a, 0x11,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x07, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x64, 0x34,
+	0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0xc9, 0x01,
+	0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x48, 0x0a,
+	0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x2a, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x22, 0x2d, 0x0a, 0x09, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x44, 0x44, 0x10, 0x00, 0x12,
+	0x0b, 0x0a, 0x07, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06,
+	0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x10, 0x02, 0x3a, 0xd7, 0x01, 0x92, 0x41, 0xd3, 0x01, 0x32,
+	0xd0, 0x01, 0x7b, 0x22, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x22, 0x3a, 0x22, 0x66, 0x69, 0x78, 0x20, 0x74, 0x79, 0x70, 0x6f, 0x20, 0x69,
+	0x6e, 0x20, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x2c, 0x22, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x73, 0x22, 0x3a, 0x5b, 0x7b, 0x22, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x3a, 0x22, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x22, 0x2c, 0x22, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x22, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x2c, 0x22,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x22, 0x3a, 0x30, 0x2c, 0x22, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x22, 0x4f, 0x6e, 0x20, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x20, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x7d, 0x2c, 0x7b,
+	0x22, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x41, 0x44, 0x44,
+	0x22, 0x2c, 0x22, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x22, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x22, 0x2c, 0x22, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x3a, 0x22, 0x41, 0x2e, 0x20, 0x43, 0x68, 0x75, 0x72, 0x63, 0x68, 0x22, 0x7d,
+	0x5d, 0x7d, 0x22, 0x6f, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49,
+	0x64, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x74, 0x65, 0x6d, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x49, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0x2c, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x74, 0x65,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49,
+	0x64, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6c, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x75, 0x73, 0x69,
+	0x6e, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
+	0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x61, 0x73, 0x63, 0x61, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x61,
+	0x73, 0x63, 0x61, 0x64, 0x65, 0x22, 0x62, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49,
+	0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x74, 0x65,
+	0x6d, 0x49, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x6f, 0x77, 0x73, 0x5f, 0x6d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x6f, 0x77,
+	0x73, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x49,
+	0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x59, 0x0a, 0x15,
+	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65,
+	0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x73,
+	0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x22, 0x9c, 0x01, 0x0a, 0x16, 0x41, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f,
+	0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x2e,
+	0x0a, 0x13, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x74, 0x65,
+	0x6d, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x61, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x6e, 0x65, 0x77, 0x5f, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x77, 0x42, 0x75, 0x73, 0x69,
+	0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c,
+	0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0xae, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x08,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f,
+	0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x46, 0x0a, 0x08, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73,
+	0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x73, 0x69,
+	0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64,
+	0x73, 0x22, 0x30, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74,
+	0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65,
+	0x6d, 0x49, 0x64, 0x22, 0xc4, 0x04, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x51, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x64, 0x34, 0x6c,
+	0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x74,
+	0x65, 0x6d, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x89, 0x03, 0x0a, 0x0d, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0d,
+	0x69, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x74, 0x65, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x06,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x64,
+	0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x47, 0x65, 0x74,
+	0x49, 0x74, 0x65, 0x6d, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x32, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x0c, 0x0a, 0x08, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a,
+	0x07, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x02, 0x22, 0x76, 0x0a, 0x13, 0x44, 0x69,
+	0x66, 0x66, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73,
+	0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x49, 0x74,
+	0x65, 0x6d, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x49, 0x74, 0x65, 0x6d,
+	0x49, 0x64, 0x22, 0xb6, 0x02, 0x0a, 0x14, 0x44, 0x69, 0x66, 0x66, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x62,
+	0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c,
+	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x1c,
+	0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x45, 0x0a, 0x06,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x64,
+	0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x44, 0x69, 0x66,
+	0x66, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x69, 0x66, 0x66, 0x52, 0x06, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x1a, 0x76, 0x0a, 0x09, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x69, 0x66, 0x66,
+	0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x22, 0x2c, 0x0a, 0x11, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x22, 0x60, 0x0a, 0x12, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x54, 0x0a, 0x1d, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x43, 0x6f, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
 	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a,
-	0x0b, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x22, 0x9c,
-	0x01, 0x0a, 0x16, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x67, 0x67,
-	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x49,
-	0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x11, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x49, 0x74,
-	0x65, 0x6d, 0x49, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x77, 0x5f, 0x62, 0x75, 0x73,
-	0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x6e, 0x65, 0x77, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x22, 0x18, 0x0a,
-	0x16, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xae, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74,
-	0x41, 0x6c, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e,
-	0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x1a, 0x46, 0x0a, 0x08, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
-	0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x12, 0x19, 0x0a,
-	0x08, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x07, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x17, 0x43, 0x6f, 0x6d,
-	0x70, 0x75, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x6e, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26,
-	0x0a, 0x0f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x69, 0x6e, 0x6b, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
-	0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x10, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x9f, 0x03, 0x0a, 0x18, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65,
-	0x49, 0x74, 0x65, 0x6d, 0x73, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x46, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x30, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73,
-	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x54, 0x72, 0x65,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f,
-	0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x1a, 0x76, 0x0a, 0x07, 0x44, 0x69, 0x73,
-	0x70, 0x6c, 0x61, 0x79, 0x12, 0x1f, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
-	0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x88, 0x01, 0x01,
-	0x12, 0x18, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c,
-	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x70, 0x6c, 0x61, 0x63,
-	0x65, 0x1a, 0xc2, 0x01, 0x0a, 0x08, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x17,
-	0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e,
-	0x74, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x88,
-	0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0x49, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x70,
-	0x6c, 0x61, 0x79, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x64, 0x34, 0x6c, 0x2e,
-	0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74,
-	0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x07, 0x64, 0x69, 0x73, 0x70,
-	0x6c, 0x61, 0x79, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6e,
-	0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x32, 0xf1, 0x20, 0x0a, 0x05, 0x49, 0x74, 0x65, 0x6d, 0x73,
-	0x12, 0xcf, 0x03, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12,
-	0x20, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x21, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d,
-	0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xfb, 0x02, 0x92, 0x41, 0xbf, 0x02, 0x0a, 0x05, 0x69, 0x74, 0x65,
-	0x6d, 0x73, 0x12, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x6e, 0x65, 0x77, 0x20, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x4a, 0x64, 0x0a, 0x03,
-	0x32, 0x30, 0x30, 0x12, 0x5d, 0x0a, 0x59, 0x54, 0x68, 0x69, 0x73, 0x20, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x65, 0x76, 0x65, 0x72, 0x20, 0x72, 0x65,
-	0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x2e, 0x20, 0x49, 0x74, 0x20, 0x69, 0x73, 0x20, 0x61, 0x6e,
-	0x20, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65,
-	0x20, 0x52, 0x45, 0x53, 0x54, 0x20, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x20, 0x53, 0x77,
-	0x61, 0x67, 0x67, 0x65, 0x72, 0x20, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e,
-	0x12, 0x00, 0x4a, 0x8f, 0x01, 0x0a, 0x03, 0x32, 0x30, 0x31, 0x12, 0x87, 0x01, 0x0a, 0x3e, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x20, 0x77, 0x61, 0x73,
-	0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x20, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x66, 0x75, 0x6c, 0x6c, 0x79, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x69, 0x74, 0x73, 0x20, 0x49, 0x44,
-	0x20, 0x69, 0x73, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x2e, 0x22, 0x45, 0x0a,
-	0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f,
-	0x6e, 0x12, 0x31, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x22, 0x3a, 0x22, 0x38, 0x64,
-	0x39, 0x38, 0x37, 0x36, 0x64, 0x62, 0x2d, 0x65, 0x61, 0x64, 0x39, 0x2d, 0x34, 0x62, 0x30, 0x65,
-	0x2d, 0x39, 0x63, 0x63, 0x31, 0x2d, 0x64, 0x31, 0x37, 0x32, 0x33, 0x65, 0x65, 0x66, 0x31, 0x39,
-	0x38, 0x38, 0x22, 0x7d, 0x62, 0x24, 0x0a, 0x22, 0x0a, 0x12, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x32,
-	0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x64, 0x73, 0x12, 0x0c, 0x0a, 0x0a,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x77, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1,
-	0x04, 0x0f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x76, 0x30, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x69, 0x74, 0x65,
-	0x6d, 0x73, 0x12, 0x9f, 0x01, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65,
-	0x6d, 0x73, 0x42, 0x75, 0x6c, 0x6b, 0x12, 0x25, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78,
-	0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65,
-	0x6d, 0x73, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x65, 0x78,
+	0x74, 0x22, 0xf2, 0x02, 0x0a, 0x1e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x43, 0x6f, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x43,
+	0x6f, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x4e, 0x6f, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x6e, 0x67,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x2f, 0x0a, 0x13, 0x6e, 0x6f, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x6e,
+	0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x6e,
+	0x6f, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x65, 0x78, 0x74, 0x1a, 0x8e, 0x01, 0x0a, 0x11, 0x4e, 0x6f, 0x6e, 0x63, 0x6f, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x69,
+	0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74,
+	0x65, 0x6d, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73,
+	0x73, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x73, 0x69,
+	0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x69, 0x6f, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x17, 0x43, 0x6f, 0x6d, 0x70, 0x75,
+	0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6e, 0x6f,
+	0x64, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x0f,
+	0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x69, 0x6e, 0x6b, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x9f, 0x03, 0x0a, 0x18, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x49, 0x74,
+	0x65, 0x6d, 0x73, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x46, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30,
+	0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x43,
+	0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x54, 0x72, 0x65, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65,
+	0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x1a, 0x76, 0x0a, 0x07, 0x44, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x12, 0x1f, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x01, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x18,
+	0x0a, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x1a,
+	0xc2, 0x01, 0x0a, 0x08, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e,
+	0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x0c, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0x49, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65,
+	0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x49,
+	0x74, 0x65, 0x6d, 0x73, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x6f, 0x64,
+	0x65, 0x5f, 0x69, 0x64, 0x32, 0x8c, 0x3e, 0x0a, 0x05, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0xcf,
+	0x03, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x20, 0x2e,
 	0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1, 0x04, 0x0f, 0x0a,
-	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x30,
-	0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x5f,
-	0x62, 0x75, 0x6c, 0x6b, 0x12, 0x83, 0x01, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65,
-	0x6d, 0x73, 0x12, 0x1f, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65,
-	0x6d, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74,
-	0x65, 0x6d, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1, 0x04, 0x0d, 0x0a,
-	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x04, 0x72, 0x65, 0x61, 0x64, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x30, 0x2f, 0x6d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x2f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x87, 0x01, 0x0a, 0x07, 0x47,
-	0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1d, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78,
-	0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e,
-	0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1, 0x04, 0x0d, 0x0a,
-	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x04, 0x72, 0x65, 0x61, 0x64, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x22, 0x12, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x30, 0x2f, 0x6d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x2f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2f, 0x7b, 0x69, 0x74, 0x65, 0x6d,
-	0x5f, 0x69, 0x64, 0x7d, 0x12, 0xe6, 0x03, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49,
-	0x74, 0x65, 0x6d, 0x12, 0x20, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74,
-	0x65, 0x6d, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e,
-	0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x92, 0x03, 0x92, 0x41, 0xcf, 0x02, 0x12,
-	0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x61, 0x20, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x1a, 0x86, 0x01, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x20, 0x69,
-	0x74, 0x65, 0x6d, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65,
-	0x20, 0x49, 0x44, 0x2e, 0x20, 0x49, 0x6e, 0x20, 0x63, 0x61, 0x73, 0x65, 0x20, 0x74, 0x68, 0x65,
-	0x20, 0x69, 0x74, 0x65, 0x6d, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x65,
-	0x78, 0x69, 0x73, 0x74, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x20, 0x63, 0x6f, 0x64, 0x65, 0x20, 0x77, 0x69, 0x6c, 0x6c, 0x20, 0x73, 0x74, 0x69, 0x6c, 0x6c,
-	0x20, 0x62, 0x65, 0x20, 0x61, 0x20, 0x32, 0x30, 0x34, 0x20, 0x28, 0x61, 0x6e, 0x64, 0x20, 0x6e,
-	0x6f, 0x74, 0x20, 0x61, 0x20, 0x34, 0x30, 0x34, 0x20, 0x6f, 0x72, 0x20, 0x34, 0x30, 0x33, 0x29,
-	0x2e, 0x4a, 0x3c, 0x0a, 0x03, 0x32, 0x30, 0x34, 0x12, 0x35, 0x0a, 0x33, 0x53, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x20,
-	0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x20, 0x6f, 0x72, 0x20, 0x69,
-	0x74, 0x65, 0x6d, 0x20, 0x77, 0x61, 0x73, 0x20, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x2e, 0x4a,
-	0x26, 0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0x1f, 0x0a, 0x1d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
-	0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x2e, 0x62, 0x45, 0x0a, 0x1f, 0x0a, 0x0f, 0x4f, 0x41, 0x75,
-	0x74, 0x68, 0x32, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0c, 0x0a, 0x0a,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x77, 0x0a, 0x22, 0x0a, 0x12, 0x4f, 0x41,
-	0x75, 0x74, 0x68, 0x32, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x64, 0x73,
-	0x12, 0x0c, 0x0a, 0x0a, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x77, 0x98, 0xf1,
-	0x04, 0x02, 0xaa, 0xf1, 0x04, 0x0f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x06, 0x64,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x2a, 0x20, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x76, 0x30, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x69, 0x74,
-	0x65, 0x6d, 0x73, 0x2f, 0x7b, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x8e, 0x07,
-	0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x21, 0x2e,
-	0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x22, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb7, 0x06, 0x92, 0x41, 0xfb, 0x05, 0x12, 0x16, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x20, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x20, 0x69, 0x74, 0x65,
-	0x6d, 0x73, 0x2e, 0x1a, 0xe6, 0x03, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x74, 0x68, 0x65,
-	0x20, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x20,
-	0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x49, 0x44, 0x73,
-	0x20, 0x28, 0x69, 0x74, 0x65, 0x6d, 0x20, 0x6f, 0x72, 0x20, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65,
-	0x73, 0x73, 0x29, 0x2c, 0x20, 0x63, 0x61, 0x73, 0x63, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x74,
-	0x6f, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x66,
-	0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x69, 0x66, 0x20, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x65, 0x64, 0x2e, 0x20, 0x41, 0x20, 0x32, 0x30, 0x34, 0x20, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x28, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x20, 0x62, 0x6f, 0x64,
-	0x79, 0x29, 0x20, 0x6d, 0x65, 0x61, 0x6e, 0x73, 0x20, 0x6e, 0x6f, 0x20, 0x69, 0x74, 0x65, 0x6d,
-	0x20, 0x49, 0x44, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x77,
-	0x65, 0x72, 0x65, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x6f, 0x72, 0x20, 0x64, 0x65, 0x72,
-	0x69, 0x76, 0x65, 0x64, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68, 0x65, 0x20, 0x67, 0x69,
-	0x76, 0x65, 0x6e, 0x20, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x20, 0x49, 0x44, 0x73,
-	0x2e, 0x20, 0x41, 0x20, 0x32, 0x30, 0x30, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x20, 0x6d, 0x65, 0x61, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x64, 0x65, 0x6c, 0x65,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x77, 0x65, 0x72, 0x65, 0x20, 0x61, 0x74, 0x74, 0x65, 0x6d,
-	0x70, 0x74, 0x65, 0x64, 0x3a, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
-	0x65, 0x64, 0x20, 0x62, 0x6f, 0x64, 0x79, 0x20, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65,
-	0x73, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x20, 0x49, 0x44,
-	0x73, 0x20, 0x77, 0x65, 0x72, 0x65, 0x20, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64,
-	0x20, 0x66, 0x6f, 0x72, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x61, 0x6e,
-	0x64, 0x20, 0x68, 0x6f, 0x77, 0x20, 0x6d, 0x61, 0x6e, 0x79, 0x20, 0x44, 0x42, 0x20, 0x72, 0x6f,
-	0x77, 0x73, 0x20, 0x77, 0x65, 0x72, 0x65, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x20,
-	0x61, 0x73, 0x20, 0x61, 0x20, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x20, 0x49, 0x6e, 0x20,
-	0x63, 0x61, 0x73, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x20, 0x64,
-	0x6f, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x65, 0x78, 0x69, 0x73, 0x74, 0x2c, 0x20, 0x74, 0x68, 0x65,
-	0x20, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x20, 0x77, 0x69, 0x6c,
-	0x6c, 0x20, 0x73, 0x74, 0x69, 0x6c, 0x6c, 0x20, 0x62, 0x65, 0x20, 0x61, 0x20, 0x32, 0x30, 0x30,
-	0x2f, 0x32, 0x30, 0x34, 0x20, 0x28, 0x61, 0x6e, 0x64, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x61, 0x20,
-	0x34, 0x30, 0x34, 0x20, 0x6f, 0x72, 0x20, 0x34, 0x30, 0x33, 0x29, 0x2e, 0x4a, 0x3d, 0x0a, 0x03,
-	0x32, 0x30, 0x30, 0x12, 0x36, 0x0a, 0x34, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75,
-	0x6c, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68,
-	0x65, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x20, 0x6f, 0x72, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x20,
-	0x77, 0x61, 0x73, 0x20, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x2e, 0x4a, 0x4a, 0x0a, 0x03, 0x32,
-	0x30, 0x34, 0x12, 0x43, 0x0a, 0x41, 0x4e, 0x6f, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x20, 0x49,
-	0x44, 0x20, 0x74, 0x6f, 0x20, 0x62, 0x65, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x20,
-	0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x64, 0x65, 0x72,
-	0x69, 0x76, 0x65, 0x64, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65,
-	0x73, 0x73, 0x20, 0x49, 0x44, 0x73, 0x2e, 0x4a, 0x26, 0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0x1f,
-	0x0a, 0x1d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74,
-	0x20, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x2e, 0x62,
-	0x45, 0x0a, 0x1f, 0x0a, 0x0f, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x32, 0x2f, 0x61, 0x75, 0x74, 0x68,
-	0x43, 0x6f, 0x64, 0x65, 0x12, 0x0c, 0x0a, 0x0a, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x3a, 0x77, 0x0a, 0x22, 0x0a, 0x12, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x32, 0x2f, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x64, 0x73, 0x12, 0x0c, 0x0a, 0x0a, 0x6d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x3a, 0x77, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1, 0x04, 0x0f, 0x0a, 0x05,
-	0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x2a, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x30, 0x2f,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0xe9,
-	0x02, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d,
-	0x73, 0x12, 0x24, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d,
-	0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65,
-	0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6c,
-	0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x89,
-	0x02, 0x92, 0x41, 0xcc, 0x01, 0x12, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x61, 0x6c,
-	0x6c, 0x20, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x73,
-	0x2e, 0x4a, 0x3f, 0x0a, 0x03, 0x32, 0x30, 0x34, 0x12, 0x38, 0x0a, 0x36, 0x53, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x20,
-	0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x20, 0x6f, 0x72, 0x20,
-	0x69, 0x74, 0x65, 0x6d, 0x73, 0x20, 0x77, 0x65, 0x72, 0x65, 0x20, 0x61, 0x62, 0x73, 0x65, 0x6e,
+	0x65, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xfb, 0x02, 0x92, 0x41, 0xbf, 0x02, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x12, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x6e, 0x65, 0x77, 0x20, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x4a, 0x64, 0x0a, 0x03, 0x32, 0x30,
+	0x30, 0x12, 0x5d, 0x0a, 0x59, 0x54, 0x68, 0x69, 0x73, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x65, 0x76, 0x65, 0x72, 0x20, 0x72, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x65, 0x64, 0x2e, 0x20, 0x49, 0x74, 0x20, 0x69, 0x73, 0x20, 0x61, 0x6e, 0x20, 0x61,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x52,
+	0x45, 0x53, 0x54, 0x20, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x20, 0x53, 0x77, 0x61, 0x67,
+	0x67, 0x65, 0x72, 0x20, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x12, 0x00,
+	0x4a, 0x8f, 0x01, 0x0a, 0x03, 0x32, 0x30, 0x31, 0x12, 0x87, 0x01, 0x0a, 0x3e, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x20, 0x77, 0x61, 0x73, 0x20, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x20, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75,
+	0x6c, 0x6c, 0x79, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x69, 0x74, 0x73, 0x20, 0x49, 0x44, 0x20, 0x69,
+	0x73, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x2e, 0x22, 0x45, 0x0a, 0x10, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12,
+	0x31, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x22, 0x3a, 0x22, 0x38, 0x64, 0x39, 0x38,
+	0x37, 0x36, 0x64, 0x62, 0x2d, 0x65, 0x61, 0x64, 0x39, 0x2d, 0x34, 0x62, 0x30, 0x65, 0x2d, 0x39,
+	0x63, 0x63, 0x31, 0x2d, 0x64, 0x31, 0x37, 0x32, 0x33, 0x65, 0x65, 0x66, 0x31, 0x39, 0x38, 0x38,
+	0x22, 0x7d, 0x62, 0x24, 0x0a, 0x22, 0x0a, 0x12, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x32, 0x2f, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x64, 0x73, 0x12, 0x0c, 0x0a, 0x0a, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x77, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1, 0x04, 0x0f,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x30, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x12, 0x9f, 0x01, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73,
+	0x42, 0x75, 0x6c, 0x6b, 0x12, 0x25, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73,
+	0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x64, 0x34,
+	0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x3d, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1, 0x04, 0x0f, 0x0a, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x12, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x30, 0x2f, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x5f, 0x62, 0x75,
+	0x6c, 0x6b, 0x12, 0xa9, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65,
+	0x6d, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x27, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d,
+	0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49,
+	0x74, 0x65, 0x6d, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x28, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x98, 0xf1, 0x04,
+	0x02, 0xaa, 0xf1, 0x04, 0x0f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x06, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x01, 0x2a, 0x22, 0x1d, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x30, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x28, 0x01, 0x12, 0xc0,
+	0x03, 0x0a, 0x0b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x21,
+	0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe9, 0x02, 0x92, 0x41, 0xa6, 0x02, 0x12, 0x44, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x20, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x20, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x43, 0x53, 0x56, 0x2c, 0x20, 0x44, 0x61,
+	0x74, 0x61, 0x43, 0x69, 0x74, 0x65, 0x20, 0x58, 0x4d, 0x4c, 0x20, 0x6f, 0x72, 0x20, 0x44, 0x75,
+	0x62, 0x6c, 0x69, 0x6e, 0x20, 0x43, 0x6f, 0x72, 0x65, 0x20, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x2e, 0x1a, 0xdd, 0x01, 0x54, 0x68, 0x65, 0x20, 0x64, 0x61, 0x74, 0x61, 0x20, 0x69, 0x73,
+	0x20, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x74, 0x6f, 0x20,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x67, 0x69, 0x76,
+	0x65, 0x6e, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x74, 0x79, 0x70, 0x65, 0x20, 0x61,
+	0x63, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x20,
+	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x20, 0x73,
+	0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x6f, 0x75, 0x73, 0x6c, 0x79, 0x3b, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x74, 0x68, 0x65, 0x6e,
+	0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x61, 0x6e, 0x20, 0x61,
+	0x73, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x6f, 0x75, 0x73, 0x20, 0x6a, 0x6f, 0x62, 0x2c,
+	0x20, 0x65, 0x78, 0x61, 0x63, 0x74, 0x6c, 0x79, 0x20, 0x61, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x62, 0x75, 0x6c, 0x6b, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1, 0x04, 0x0f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x12, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a,
+	0x01, 0x2a, 0x22, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x30, 0x2f, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x2f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0xb3, 0x03, 0x0a, 0x0d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x49, 0x74,
+	0x65, 0x6d, 0x73, 0x12, 0x23, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d,
+	0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd6,
+	0x02, 0x92, 0x41, 0x8f, 0x02, 0x12, 0x2d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x20,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x20, 0x77,
+	0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x20, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x74,
+	0x68, 0x65, 0x6d, 0x2e, 0x1a, 0xdd, 0x01, 0x52, 0x75, 0x6e, 0x73, 0x20, 0x61, 0x6c, 0x6c, 0x20,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x69, 0x74,
+	0x65, 0x6d, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x28, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x20, 0x74, 0x79, 0x70, 0x65, 0x2c, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x2c, 0x20, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x20, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2c, 0x20, 0x61, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x29, 0x20, 0x69, 0x6e, 0x73, 0x69, 0x64, 0x65,
+	0x20, 0x61, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x77,
+	0x68, 0x69, 0x63, 0x68, 0x20, 0x69, 0x73, 0x20, 0x61, 0x6c, 0x77, 0x61, 0x79, 0x73, 0x20, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x20, 0x62, 0x61, 0x63, 0x6b, 0x2c, 0x20, 0x61, 0x6e, 0x64, 0x20,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x20, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x20, 0x70, 0x65, 0x72, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x2e, 0x20, 0x4e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x20, 0x69, 0x73, 0x20,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
+	0x63, 0x65, 0x64, 0x2e, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1, 0x04, 0x0f, 0x0a, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x12, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x26, 0x3a, 0x01, 0x2a, 0x22, 0x21, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x30, 0x2f, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x5f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x83, 0x01, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74,
+	0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1f, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78,
+	0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1,
+	0x04, 0x0d, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x04, 0x72, 0x65, 0x61, 0x64, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x30, 0x2f, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x87, 0x01,
+	0x0a, 0x07, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1d, 0x2e, 0x64, 0x34, 0x6c, 0x2e,
+	0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d,
+	0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1,
+	0x04, 0x0d, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x04, 0x72, 0x65, 0x61, 0x64, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x30, 0x2f, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2f, 0x7b, 0x69,
+	0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xfd, 0x03, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x20, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78,
+	0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d,
+	0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49,
+	0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa9, 0x03, 0x92, 0x41,
+	0xe3, 0x02, 0x12, 0x21, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x20,
+	0x69, 0x74, 0x65, 0x6d, 0x2e, 0x1a, 0xd3, 0x01, 0x41, 0x64, 0x64, 0x2c, 0x20, 0x72, 0x65, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x20, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x20, 0x69,
+	0x6e, 0x64, 0x69, 0x76, 0x69, 0x64, 0x75, 0x61, 0x6c, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x20, 0x6f, 0x66, 0x20, 0x61, 0x6e, 0x20, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x20,
+	0x69, 0x74, 0x65, 0x6d, 0x2e, 0x20, 0x41, 0x6c, 0x6c, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x20, 0x61, 0x73,
+	0x20, 0x6e, 0x65, 0x77, 0x20, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x6f,
+	0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x69,
+	0x74, 0x65, 0x6d, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x3b, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x20, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x6b, 0x65, 0x70, 0x74, 0x2e, 0x20, 0x54, 0x68, 0x65,
+	0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x20, 0x69, 0x73,
+	0x20, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x64, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x72,
+	0x65, 0x2d, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x69, 0x6e, 0x67, 0x2e, 0x4a, 0x21, 0x0a, 0x03, 0x34,
+	0x30, 0x34, 0x12, 0x1a, 0x0a, 0x18, 0x54, 0x68, 0x65, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x20, 0x64,
+	0x6f, 0x65, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x65, 0x78, 0x69, 0x73, 0x74, 0x2e, 0x62, 0x45,
+	0x0a, 0x1f, 0x0a, 0x0f, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x32, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x0c, 0x0a, 0x0a, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x3a,
+	0x77, 0x0a, 0x22, 0x0a, 0x12, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x32, 0x2f, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x43, 0x72, 0x65, 0x64, 0x73, 0x12, 0x0c, 0x0a, 0x0a, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x3a, 0x77, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1, 0x04, 0x0f, 0x0a, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x12, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x25, 0x3a, 0x01, 0x2a, 0x32, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x30, 0x2f, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2f, 0x7b, 0x69,
+	0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xe6, 0x03, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x20, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78,
+	0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x74, 0x65,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d,
+	0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49,
+	0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x92, 0x03, 0x92, 0x41,
+	0xcf, 0x02, 0x12, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x61, 0x20, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x1a, 0x86, 0x01, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x62, 0x79, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x49, 0x44, 0x2e, 0x20, 0x49, 0x6e, 0x20, 0x63, 0x61, 0x73, 0x65, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x20, 0x6e, 0x6f,
+	0x74, 0x20, 0x65, 0x78, 0x69, 0x73, 0x74, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x20, 0x77, 0x69, 0x6c, 0x6c, 0x20, 0x73, 0x74,
+	0x69, 0x6c, 0x6c, 0x20, 0x62, 0x65, 0x20, 0x61, 0x20, 0x32, 0x30, 0x34, 0x20, 0x28, 0x61, 0x6e,
+	0x64, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x61, 0x20, 0x34, 0x30, 0x34, 0x20, 0x6f, 0x72, 0x20, 0x34,
+	0x30, 0x33, 0x29, 0x2e, 0x4a, 0x3c, 0x0a, 0x03, 0x32, 0x30, 0x34, 0x12, 0x35, 0x0a, 0x33, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69,
+	0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x20, 0x6f,
+	0x72, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x20, 0x77, 0x61, 0x73, 0x20, 0x61, 0x62, 0x73, 0x65, 0x6e,
 	0x74, 0x2e, 0x4a, 0x26, 0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0x1f, 0x0a, 0x1d, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x61, 0x75, 0x74, 0x68,
 	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x2e, 0x62, 0x45, 0x0a, 0x1f, 0x0a, 0x0f,
@@ -2810,112 +4764,326 @@ var file_services_metadata_endpoints_items_items_proto_rawDesc = []byte{
 	0x12, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x32, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x72,
 	0x65, 0x64, 0x73, 0x12, 0x0c, 0x0a, 0x0a, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x3a,
 	0x77, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1, 0x04, 0x0f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
-	0x12, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x2a, 0x1a,
+	0x12, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x2a, 0x20,
 	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x30, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x2f, 0x61, 0x6c, 0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0xa8, 0x01, 0x0a, 0x0f, 0x43,
-	0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25,
-	0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x43,
-	0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e,
-	0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x98,
-	0xf1, 0x04, 0x02, 0xaa, 0xf1, 0x04, 0x0d, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x04,
-	0x72, 0x65, 0x61, 0x64, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x22, 0x29, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x76, 0x30, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x69, 0x74, 0x65,
-	0x6d, 0x73, 0x2f, 0x7b, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xcd, 0x01, 0x0a, 0x1b, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74,
-	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x42, 0x75, 0x73, 0x69, 0x6e,
-	0x65, 0x73, 0x73, 0x49, 0x44, 0x12, 0x31, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e,
-	0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d,
+	0x2f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2f, 0x7b, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x7d,
+	0x12, 0x8e, 0x07, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73,
+	0x12, 0x21, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb7, 0x06, 0x92, 0x41, 0xfb, 0x05, 0x12, 0x16,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x20,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x1a, 0xe6, 0x03, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x20, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x49, 0x44, 0x73, 0x20, 0x28, 0x69, 0x74, 0x65, 0x6d, 0x20, 0x6f, 0x72, 0x20, 0x62, 0x75, 0x73,
+	0x69, 0x6e, 0x65, 0x73, 0x73, 0x29, 0x2c, 0x20, 0x63, 0x61, 0x73, 0x63, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x20, 0x74, 0x6f, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6e,
+	0x67, 0x20, 0x66, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x69, 0x66, 0x20, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x2e, 0x20, 0x41, 0x20, 0x32, 0x30, 0x34, 0x20,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x28, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x20,
+	0x62, 0x6f, 0x64, 0x79, 0x29, 0x20, 0x6d, 0x65, 0x61, 0x6e, 0x73, 0x20, 0x6e, 0x6f, 0x20, 0x69,
+	0x74, 0x65, 0x6d, 0x20, 0x49, 0x44, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x20, 0x77, 0x65, 0x72, 0x65, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x6f, 0x72, 0x20,
+	0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x20,
+	0x49, 0x44, 0x73, 0x2e, 0x20, 0x41, 0x20, 0x32, 0x30, 0x30, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x20, 0x6d, 0x65, 0x61, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x77, 0x65, 0x72, 0x65, 0x20, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x65, 0x64, 0x3a, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x62, 0x6f, 0x64, 0x79, 0x20, 0x69, 0x6e, 0x64, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x73, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x20, 0x49, 0x44, 0x73, 0x20, 0x77, 0x65, 0x72, 0x65, 0x20, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x64, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x20, 0x61, 0x6e, 0x64, 0x20, 0x68, 0x6f, 0x77, 0x20, 0x6d, 0x61, 0x6e, 0x79, 0x20, 0x44, 0x42,
+	0x20, 0x72, 0x6f, 0x77, 0x73, 0x20, 0x77, 0x65, 0x72, 0x65, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x20, 0x61, 0x73, 0x20, 0x61, 0x20, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x20,
+	0x49, 0x6e, 0x20, 0x63, 0x61, 0x73, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x20, 0x64, 0x6f, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x65, 0x78, 0x69, 0x73, 0x74, 0x2c, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x20,
+	0x77, 0x69, 0x6c, 0x6c, 0x20, 0x73, 0x74, 0x69, 0x6c, 0x6c, 0x20, 0x62, 0x65, 0x20, 0x61, 0x20,
+	0x32, 0x30, 0x30, 0x2f, 0x32, 0x30, 0x34, 0x20, 0x28, 0x61, 0x6e, 0x64, 0x20, 0x6e, 0x6f, 0x74,
+	0x20, 0x61, 0x20, 0x34, 0x30, 0x34, 0x20, 0x6f, 0x72, 0x20, 0x34, 0x30, 0x33, 0x29, 0x2e, 0x4a,
+	0x3d, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x36, 0x0a, 0x34, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x66, 0x75, 0x6c, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x20, 0x6f, 0x72, 0x20, 0x69, 0x74,
+	0x65, 0x6d, 0x20, 0x77, 0x61, 0x73, 0x20, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x2e, 0x4a, 0x4a,
+	0x0a, 0x03, 0x32, 0x30, 0x34, 0x12, 0x43, 0x0a, 0x41, 0x4e, 0x6f, 0x20, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x20, 0x49, 0x44, 0x20, 0x74, 0x6f, 0x20, 0x62, 0x65, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x20, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x20, 0x6f, 0x72, 0x20,
+	0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x62, 0x75, 0x73,
+	0x69, 0x6e, 0x65, 0x73, 0x73, 0x20, 0x49, 0x44, 0x73, 0x2e, 0x4a, 0x26, 0x0a, 0x03, 0x34, 0x30,
+	0x31, 0x12, 0x1f, 0x0a, 0x1d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x69, 0x73, 0x20,
+	0x6e, 0x6f, 0x74, 0x20, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x64, 0x2e, 0x62, 0x45, 0x0a, 0x1f, 0x0a, 0x0f, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x32, 0x2f, 0x61,
+	0x75, 0x74, 0x68, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0c, 0x0a, 0x0a, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x3a, 0x77, 0x0a, 0x22, 0x0a, 0x12, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x32, 0x2f,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x64, 0x73, 0x12, 0x0c, 0x0a, 0x0a, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x77, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1, 0x04,
+	0x0f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x2a, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x30, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x12, 0xe9, 0x02, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x49,
+	0x74, 0x65, 0x6d, 0x73, 0x12, 0x24, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x49, 0x74,
+	0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64, 0x34, 0x6c,
+	0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x41, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x89, 0x02, 0x92, 0x41, 0xcc, 0x01, 0x12, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x20, 0x61, 0x6c, 0x6c, 0x20, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x20, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x2e, 0x4a, 0x3f, 0x0a, 0x03, 0x32, 0x30, 0x34, 0x12, 0x38, 0x0a, 0x36, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69,
+	0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x20,
+	0x6f, 0x72, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x20, 0x77, 0x65, 0x72, 0x65, 0x20, 0x61, 0x62,
+	0x73, 0x65, 0x6e, 0x74, 0x2e, 0x4a, 0x26, 0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0x1f, 0x0a, 0x1d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x61,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x2e, 0x62, 0x45, 0x0a,
+	0x1f, 0x0a, 0x0f, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x32, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x0c, 0x0a, 0x0a, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x77,
+	0x0a, 0x22, 0x0a, 0x12, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x32, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x43, 0x72, 0x65, 0x64, 0x73, 0x12, 0x0c, 0x0a, 0x0a, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x3a, 0x77, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1, 0x04, 0x0f, 0x0a, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x12, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1c, 0x2a, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x30, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x2f, 0x61, 0x6c, 0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0xa8, 0x01,
+	0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x25, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d,
+	0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x46, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1, 0x04, 0x0d, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x12, 0x04, 0x72, 0x65, 0x61, 0x64, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x22, 0x29, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x30, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x2f, 0x7b, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xcd, 0x01, 0x0a, 0x1b, 0x43, 0x6f, 0x6d,
+	0x70, 0x75, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x42, 0x75,
+	0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x44, 0x12, 0x31, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d,
 	0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65,
 	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65,
-	0x73, 0x73, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x98, 0xf1,
-	0x04, 0x02, 0xaa, 0xf1, 0x04, 0x0d, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x04, 0x72,
-	0x65, 0x61, 0x64, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x30, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x69,
-	0x64, 0x3d, 0x2a, 0x2a, 0x7d, 0x12, 0x9b, 0x01, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d,
-	0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
-	0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1, 0x04, 0x0f, 0x0a,
-	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x30,
-	0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0xd1, 0x01, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x75, 0x73, 0x69, 0x6e,
-	0x65, 0x73, 0x73, 0x49, 0x64, 0x73, 0x12, 0x34, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78,
-	0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65,
-	0x73, 0x73, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x64,
-	0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x72, 0x6f, 0x6d,
-	0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x42, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1, 0x04, 0x0f, 0x0a, 0x05, 0x69,
-	0x74, 0x65, 0x6d, 0x73, 0x12, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x25, 0x3a, 0x01, 0x2a, 0x22, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x30, 0x2f, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0xe1, 0x01, 0x0a, 0x20, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x4f,
-	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x36, 0x2e, 0x64,
-	0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x72, 0x6f, 0x6d,
-	0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69,
-	0x74, 0x65, 0x6d, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c,
-	0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4c, 0x98,
-	0xf1, 0x04, 0x02, 0xaa, 0xf1, 0x04, 0x0f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x06,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x3a, 0x01, 0x2a, 0x22,
-	0x2a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x30, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x2f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x69, 0x74, 0x65, 0x6d,
-	0x73, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x73, 0x12, 0x93, 0x01, 0x0a, 0x0d,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x2e,
-	0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65,
-	0x6d, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1,
+	0x73, 0x73, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x64, 0x34,
+	0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70,
+	0x75, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x42, 0x75, 0x73,
+	0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x47, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1, 0x04, 0x0d, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x12, 0x04, 0x72, 0x65, 0x61, 0x64, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x30, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73,
+	0x73, 0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x2a, 0x7d, 0x12, 0x9b, 0x01, 0x0a, 0x0e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x64, 0x34,
+	0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1,
+	0x04, 0x0f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x30, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x72, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xd1, 0x01, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x75,
+	0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x73, 0x12, 0x34, 0x2e, 0x64, 0x34, 0x6c, 0x2e,
+	0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x75, 0x73,
+	0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x35, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46,
+	0x72, 0x6f, 0x6d, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1, 0x04, 0x0f,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x25, 0x3a, 0x01, 0x2a, 0x22, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x30, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0xe1, 0x01, 0x0a, 0x20, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x72,
+	0x6f, 0x6d, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12,
+	0x36, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46,
+	0x72, 0x6f, 0x6d, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65,
+	0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x4f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x4c, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1, 0x04, 0x0f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x12, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x3a,
+	0x01, 0x2a, 0x22, 0x2a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x30, 0x2f, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x2f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x73, 0x12, 0x93,
+	0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x23, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x98, 0xf1, 0x04,
+	0x02, 0xaa, 0xf1, 0x04, 0x0d, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x04, 0x72, 0x65,
+	0x61, 0x64, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x30, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x5f, 0x0a, 0x0e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x24, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78,
+	0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64,
+	0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x98, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c,
+	0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x2e, 0x64, 0x34, 0x6c, 0x2e,
+	0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c,
+	0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x26, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1,
 	0x04, 0x0d, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x04, 0x72, 0x65, 0x61, 0x64, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x30, 0x2f, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x5f, 0x0a, 0x0e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x49, 0x74,
-	0x65, 0x6d, 0x73, 0x12, 0x24, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74,
-	0x65, 0x6d, 0x73, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65,
-	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64, 0x34, 0x6c, 0x2e,
-	0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
-	0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x98, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78,
-	0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
-	0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x41, 0x6c, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1, 0x04, 0x0d, 0x0a,
-	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x04, 0x72, 0x65, 0x61, 0x64, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x30, 0x2f, 0x6d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x9a, 0x01,
-	0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x54, 0x72,
-	0x65, 0x65, 0x12, 0x26, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65,
-	0x6d, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x54,
-	0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x64, 0x34, 0x6c,
-	0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x75,
-	0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x35, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1, 0x04, 0x0d, 0x0a, 0x05, 0x69,
-	0x74, 0x65, 0x6d, 0x73, 0x12, 0x04, 0x72, 0x65, 0x61, 0x64, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a,
-	0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x30, 0x2f, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x74, 0x72, 0x65, 0x65, 0x42, 0x5f, 0x5a, 0x5d, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x65, 0x73, 0x75, 0x6e, 0x64, 0x68,
-	0x65, 0x69, 0x74, 0x73, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x72, 0x6b, 0x69, 0x2d, 0x6d, 0x65,
-	0x78, 0x2d, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x6d, 0x65, 0x78, 0x2f, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x2f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2f, 0x69, 0x74, 0x65, 0x6d, 0x73,
-	0x2f, 0x70, 0x62, 0x3b, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x30, 0x2f, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0xde, 0x02, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x24, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64, 0x34, 0x6c, 0x2e,
+	0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xfe, 0x01, 0x92, 0x41, 0xb5, 0x01, 0x12, 0x28, 0x47, 0x65, 0x74, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x6c, 0x6f, 0x67, 0x20, 0x6f, 0x66,
+	0x20, 0x61, 0x20, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x20, 0x69, 0x74, 0x65, 0x6d,
+	0x2e, 0x1a, 0x88, 0x01, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x6c, 0x6c, 0x20,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x6c, 0x6c,
+	0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x69,
+	0x74, 0x65, 0x6d, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x20, 0x74, 0x68, 0x65, 0x79, 0x20, 0x77, 0x65, 0x72, 0x65, 0x20, 0x77, 0x72, 0x69, 0x74, 0x74,
+	0x65, 0x6e, 0x2c, 0x20, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x77, 0x65, 0x72, 0x65, 0x20, 0x72,
+	0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x20, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x20, 0x6f, 0x6e, 0x2e, 0x98, 0xf1, 0x04, 0x02,
+	0xaa, 0xf1, 0x04, 0x0d, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x04, 0x72, 0x65, 0x61,
+	0x64, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x30,
+	0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2f,
+	0x7b, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x12, 0xf0, 0x02, 0x0a, 0x0c, 0x44, 0x69, 0x66, 0x66, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x22, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78,
+	0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x96, 0x02, 0x92, 0x41,
+	0xd4, 0x01, 0x12, 0x26, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x20, 0x74, 0x77, 0x6f, 0x20,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x62, 0x75,
+	0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x20, 0x49, 0x44, 0x2e, 0x1a, 0xa9, 0x01, 0x43, 0x6f, 0x6d,
+	0x70, 0x75, 0x74, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x65, 0x72, 0x2d, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x20, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x20,
+	0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x77,
+	0x6f, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x20, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x73, 0x61, 0x6d, 0x65, 0x20, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73,
+	0x73, 0x20, 0x49, 0x44, 0x2e, 0x20, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x20, 0x65, 0x78,
+	0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x20, 0x49, 0x44, 0x73, 0x2c,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x77, 0x6f, 0x20, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x20,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1, 0x04, 0x0d, 0x0a, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x04, 0x72, 0x65, 0x61, 0x64, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x23, 0x3a, 0x01, 0x2a, 0x22, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x30, 0x2f, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5f,
+	0x64, 0x69, 0x66, 0x66, 0x12, 0xa1, 0x03, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49,
+	0x74, 0x65, 0x6d, 0x12, 0x20, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x22, 0xda, 0x02, 0x92, 0x41,
+	0x92, 0x02, 0x12, 0x22, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x61, 0x20, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x20, 0x61, 0x73, 0x20, 0x4a, 0x53,
+	0x4f, 0x4e, 0x2d, 0x4c, 0x44, 0x2e, 0x1a, 0xeb, 0x01, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x2c, 0x20, 0x69, 0x74,
+	0x73, 0x20, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20,
+	0x69, 0x74, 0x73, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x20, 0x6c, 0x69, 0x6e,
+	0x6b, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x20, 0x61, 0x73, 0x20, 0x61, 0x20, 0x4a, 0x53,
+	0x4f, 0x4e, 0x2d, 0x4c, 0x44, 0x20, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x28,
+	0x65, 0x2e, 0x67, 0x2e, 0x20, 0x44, 0x43, 0x41, 0x54, 0x2d, 0x41, 0x50, 0x29, 0x2c, 0x20, 0x75,
+	0x73, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c,
+	0x61, 0x72, 0x79, 0x20, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x20, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x64, 0x20, 0x6e, 0x65, 0x78, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x20, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x20,
+	0x55, 0x6e, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x64, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x20,
+	0x61, 0x6e, 0x64, 0x20, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x65, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x2e, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1, 0x04, 0x0d, 0x0a, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x12, 0x04, 0x72, 0x65, 0x61, 0x64, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12,
+	0x27, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x30, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x2f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2f, 0x7b, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x6c, 0x64, 0x12, 0xe0, 0x02, 0x0a, 0x0b, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x21, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d,
+	0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49,
+	0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x42, 0x6f, 0x64,
+	0x79, 0x22, 0x95, 0x02, 0x92, 0x41, 0xd7, 0x01, 0x12, 0x25, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x20, 0x61, 0x6c, 0x6c, 0x20, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x20, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x20, 0x61, 0x73, 0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x2d, 0x4c, 0x44, 0x2e, 0x1a,
+	0xad, 0x01, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x4a, 0x53,
+	0x4f, 0x4e, 0x2d, 0x4c, 0x44, 0x20, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x20,
+	0x6f, 0x66, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x20, 0x28, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x6c, 0x79, 0x20, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x74, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x20, 0x74, 0x79, 0x70, 0x65, 0x29, 0x20, 0x61, 0x73, 0x20, 0x6e, 0x65, 0x77, 0x6c, 0x69,
+	0x6e, 0x65, 0x2d, 0x64, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x20, 0x4a, 0x53, 0x4f,
+	0x4e, 0x2c, 0x20, 0x75, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x61, 0x6d,
+	0x65, 0x20, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x20, 0x6d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x69, 0x6e, 0x67,
+	0x6c, 0x65, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x20, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x98,
+	0xf1, 0x04, 0x02, 0xaa, 0xf1, 0x04, 0x0d, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x04,
+	0x72, 0x65, 0x61, 0x64, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x30, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x5f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x30, 0x01, 0x12, 0x98, 0x05, 0x0a, 0x16,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x43, 0x6f, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2c, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78,
+	0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x43, 0x6f, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x43, 0x6f, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xa0, 0x04, 0x92, 0x41, 0xdc, 0x03, 0x12, 0x48, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x20, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x20, 0x61, 0x67, 0x61, 0x69, 0x6e, 0x73,
+	0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x20, 0x6f, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x69, 0x72, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x1a, 0x8f, 0x03, 0x53, 0x63, 0x61, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x20, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x20, 0x28, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x6c, 0x79,
+	0x20, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x6f,
+	0x6e, 0x65, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x74, 0x79, 0x70, 0x65, 0x29, 0x20,
+	0x77, 0x68, 0x6f, 0x73, 0x65, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x74, 0x79, 0x70,
+	0x65, 0x20, 0x64, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x73, 0x20, 0x61, 0x20, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x20, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x20, 0x69, 0x74, 0x2c, 0x20, 0x65, 0x2e, 0x67, 0x2e, 0x20, 0x62, 0x65, 0x63, 0x61, 0x75,
+	0x73, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x2c, 0x20, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x2c, 0x20, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x20, 0x6f, 0x66, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x6f, 0x72, 0x20, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x20, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x20, 0x74, 0x79, 0x70, 0x65, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x20, 0x61,
+	0x20, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x20, 0x61, 0x72, 0x65, 0x20, 0x6e, 0x6f, 0x74, 0x20,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x2e, 0x20, 0x54, 0x68, 0x65, 0x20, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x20, 0x70,
+	0x61, 0x67, 0x65, 0x20, 0x62, 0x79, 0x20, 0x70, 0x61, 0x67, 0x65, 0x3b, 0x20, 0x70, 0x61, 0x73,
+	0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x6e,
+	0x65, 0x78, 0x74, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x63, 0x6f, 0x6e,
+	0x74, 0x69, 0x6e, 0x75, 0x65, 0x2e, 0x98, 0xf1, 0x04, 0x02, 0xaa, 0xf1, 0x04, 0x0d, 0x0a, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x04, 0x72, 0x65, 0x61, 0x64, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x25, 0x12, 0x23, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x30, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x9a, 0x01, 0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x70, 0x75,
+	0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x54, 0x72, 0x65, 0x65, 0x12, 0x26, 0x2e, 0x64, 0x34,
+	0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70,
+	0x75, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73,
+	0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x98, 0xf1,
+	0x04, 0x02, 0xaa, 0xf1, 0x04, 0x0d, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x04, 0x72,
+	0x65, 0x61, 0x64, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x30, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x74,
+	0x72, 0x65, 0x65, 0x42, 0x4f, 0x5a, 0x4d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x64, 0x34, 0x6c, 0x2d, 0x64, 0x61, 0x74, 0x61, 0x34, 0x6c, 0x69, 0x66, 0x65, 0x2f,
+	0x6d, 0x65, 0x78, 0x2f, 0x6d, 0x65, 0x78, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x2f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2f, 0x70, 0x62, 0x3b, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2930,110 +5098,169 @@ func file_services_metadata_endpoints_items_items_proto_rawDescGZIP() []byte {
 	return file_services_metadata_endpoints_items_items_proto_rawDescData
 }
 
-var file_services_metadata_endpoints_items_items_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
+var file_services_metadata_endpoints_items_items_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_services_metadata_endpoints_items_items_proto_msgTypes = make([]protoimpl.MessageInfo, 65)
 var file_services_metadata_endpoints_items_items_proto_goTypes = []interface{}{
-	(*CreateItemRequest)(nil),                           // 0: d4l.mex.items.CreateItemRequest
-	(*CreateItemResponse)(nil),                          // 1: d4l.mex.items.CreateItemResponse
-	(*CreateItemsBulkRequest)(nil),                      // 2: d4l.mex.items.CreateItemsBulkRequest
-	(*CreateItemsBulkResponse)(nil),                     // 3: d4l.mex.items.CreateItemsBulkResponse
-	(*ComputeVersionsRequest)(nil),                      // 4: d4l.mex.items.ComputeVersionsRequest
-	(*ComputeVersionsResponse)(nil),                     // 5: d4l.mex.items.ComputeVersionsResponse
-	(*ComputeVersionsByBusinessIdRequest)(nil),          // 6: d4l.mex.items.ComputeVersionsByBusinessIdRequest
-	(*ComputeVersionsByBusinessIdResponse)(nil),         // 7: d4l.mex.items.ComputeVersionsByBusinessIdResponse
-	(*CreateRelationRequest)(nil),                       // 8: d4l.mex.items.CreateRelationRequest
-	(*CreateRelationResponse)(nil),                      // 9: d4l.mex.items.CreateRelationResponse
-	(*CreateRelationsFromBusinessIdsRequest)(nil),       // 10: d4l.mex.items.CreateRelationsFromBusinessIdsRequest
-	(*CreateRelationsFromBusinessIdsResponse)(nil),      // 11: d4l.mex.items.CreateRelationsFromBusinessIdsResponse
-	(*CreateRelationsFromOriginalItemsRequest)(nil),     // 12: d4l.mex.items.CreateRelationsFromOriginalItemsRequest
-	(*CreateRelationsFromOriginalItemsResponse)(nil),    // 13: d4l.mex.items.CreateRelationsFromOriginalItemsResponse
-	(*ListRelationsRequest)(nil),                        // 14: d4l.mex.items.ListRelationsRequest
-	(*ListRelation)(nil),                                // 15: d4l.mex.items.ListRelation
-	(*ListRelationsResponse)(nil),                       // 16: d4l.mex.items.ListRelationsResponse
-	(*ListItemsRequest)(nil),                            // 17: d4l.mex.items.ListItemsRequest
-	(*ListItem)(nil),                                    // 18: d4l.mex.items.ListItem
-	(*ListItemsResponse)(nil),                           // 19: d4l.mex.items.ListItemsResponse
-	(*GetItemRequest)(nil),                              // 20: d4l.mex.items.GetItemRequest
-	(*GetItemResponse)(nil),                             // 21: d4l.mex.items.GetItemResponse
-	(*DeleteItemRequest)(nil),                           // 22: d4l.mex.items.DeleteItemRequest
-	(*DeleteItemResponse)(nil),                          // 23: d4l.mex.items.DeleteItemResponse
-	(*DeleteItemsRequest)(nil),                          // 24: d4l.mex.items.DeleteItemsRequest
-	(*DeleteItemsResponse)(nil),                         // 25: d4l.mex.items.DeleteItemsResponse
-	(*DeleteAllItemsRequest)(nil),                       // 26: d4l.mex.items.DeleteAllItemsRequest
-	(*DeleteAllItemsResponse)(nil),                      // 27: d4l.mex.items.DeleteAllItemsResponse
-	(*AggregateItemsRequest)(nil),                       // 28: d4l.mex.items.AggregateItemsRequest
-	(*AggregateItemsResponse)(nil),                      // 29: d4l.mex.items.AggregateItemsResponse
-	(*ListAllVersionsRequest)(nil),                      // 30: d4l.mex.items.ListAllVersionsRequest
-	(*ListAllVersionsResponse)(nil),                     // 31: d4l.mex.items.ListAllVersionsResponse
-	(*ComputeItemsTreeRequest)(nil),                     // 32: d4l.mex.items.ComputeItemsTreeRequest
-	(*ComputeItemsTreeResponse)(nil),                    // 33: d4l.mex.items.ComputeItemsTreeResponse
-	(*CreateItemResponse_PostActionResult)(nil),         // 34: d4l.mex.items.CreateItemResponse.PostActionResult
-	(*ComputeVersionsResponse_Version)(nil),             // 35: d4l.mex.items.ComputeVersionsResponse.Version
-	(*ComputeVersionsByBusinessIdResponse_Version)(nil), // 36: d4l.mex.items.ComputeVersionsByBusinessIdResponse.Version
-	nil,                                       // 37: d4l.mex.items.ListRelationsResponse.RelationsEntry
-	(*GetItemResponse_FullItemValue)(nil),     // 38: d4l.mex.items.GetItemResponse.FullItemValue
-	(*ListAllVersionsResponse_Versions)(nil),  // 39: d4l.mex.items.ListAllVersionsResponse.Versions
-	(*ComputeItemsTreeResponse_Display)(nil),  // 40: d4l.mex.items.ComputeItemsTreeResponse.Display
-	(*ComputeItemsTreeResponse_TreeNode)(nil), // 41: d4l.mex.items.ComputeItemsTreeResponse.TreeNode
-	(*items.Item)(nil),                        // 42: d4l.mex.items.Item
-	(cfg.DuplicateDetectionAlgorithm)(0),      // 43: d4l.mex.cfg.DuplicateDetectionAlgorithm
-	(*items.ItemValue)(nil),                   // 44: d4l.mex.items.ItemValue
-	(*timestamppb.Timestamp)(nil),             // 45: google.protobuf.Timestamp
+	(UpdateItemRequest_Operation)(0),                    // 0: d4l.mex.items.UpdateItemRequest.Operation
+	(GetItemHistoryResponse_ChangeType)(0),              // 1: d4l.mex.items.GetItemHistoryResponse.ChangeType
+	(*CreateItemRequest)(nil),                           // 2: d4l.mex.items.CreateItemRequest
+	(*CreateItemResponse)(nil),                          // 3: d4l.mex.items.CreateItemResponse
+	(*CreateItemsBulkRequest)(nil),                      // 4: d4l.mex.items.CreateItemsBulkRequest
+	(*CreateItemsBulkResponse)(nil),                     // 5: d4l.mex.items.CreateItemsBulkResponse
+	(*CreateItemsStreamRequest)(nil),                    // 6: d4l.mex.items.CreateItemsStreamRequest
+	(*CreateItemsStreamResponse)(nil),                   // 7: d4l.mex.items.CreateItemsStreamResponse
+	(*ImportItemsRequest)(nil),                          // 8: d4l.mex.items.ImportItemsRequest
+	(*ImportItemsResponse)(nil),                         // 9: d4l.mex.items.ImportItemsResponse
+	(*ValidateItemsRequest)(nil),                        // 10: d4l.mex.items.ValidateItemsRequest
+	(*ValidateItemsResponse)(nil),                       // 11: d4l.mex.items.ValidateItemsResponse
+	(*ComputeVersionsRequest)(nil),                      // 12: d4l.mex.items.ComputeVersionsRequest
+	(*ComputeVersionsResponse)(nil),                     // 13: d4l.mex.items.ComputeVersionsResponse
+	(*ComputeVersionsByBusinessIdRequest)(nil),          // 14: d4l.mex.items.ComputeVersionsByBusinessIdRequest
+	(*ComputeVersionsByBusinessIdResponse)(nil),         // 15: d4l.mex.items.ComputeVersionsByBusinessIdResponse
+	(*CreateRelationRequest)(nil),                       // 16: d4l.mex.items.CreateRelationRequest
+	(*CreateRelationResponse)(nil),                      // 17: d4l.mex.items.CreateRelationResponse
+	(*CreateRelationsFromBusinessIdsRequest)(nil),       // 18: d4l.mex.items.CreateRelationsFromBusinessIdsRequest
+	(*CreateRelationsFromBusinessIdsResponse)(nil),      // 19: d4l.mex.items.CreateRelationsFromBusinessIdsResponse
+	(*CreateRelationsFromOriginalItemsRequest)(nil),     // 20: d4l.mex.items.CreateRelationsFromOriginalItemsRequest
+	(*CreateRelationsFromOriginalItemsResponse)(nil),    // 21: d4l.mex.items.CreateRelationsFromOriginalItemsResponse
+	(*ListRelationsRequest)(nil),                        // 22: d4l.mex.items.ListRelationsRequest
+	(*ListRelation)(nil),                                // 23: d4l.mex.items.ListRelation
+	(*ListRelationsResponse)(nil),                       // 24: d4l.mex.items.ListRelationsResponse
+	(*ListItemsRequest)(nil),                            // 25: d4l.mex.items.ListItemsRequest
+	(*ListItem)(nil),                                    // 26: d4l.mex.items.ListItem
+	(*ListItemsResponse)(nil),                           // 27: d4l.mex.items.ListItemsResponse
+	(*GetItemRequest)(nil),                              // 28: d4l.mex.items.GetItemRequest
+	(*GetItemResponse)(nil),                             // 29: d4l.mex.items.GetItemResponse
+	(*UpdateItemRequest)(nil),                           // 30: d4l.mex.items.UpdateItemRequest
+	(*UpdateItemResponse)(nil),                          // 31: d4l.mex.items.UpdateItemResponse
+	(*DeleteItemRequest)(nil),                           // 32: d4l.mex.items.DeleteItemRequest
+	(*DeleteItemResponse)(nil),                          // 33: d4l.mex.items.DeleteItemResponse
+	(*DeleteItemsRequest)(nil),                          // 34: d4l.mex.items.DeleteItemsRequest
+	(*DeleteItemsResponse)(nil),                         // 35: d4l.mex.items.DeleteItemsResponse
+	(*DeleteAllItemsRequest)(nil),                       // 36: d4l.mex.items.DeleteAllItemsRequest
+	(*DeleteAllItemsResponse)(nil),                      // 37: d4l.mex.items.DeleteAllItemsResponse
+	(*AggregateItemsRequest)(nil),                       // 38: d4l.mex.items.AggregateItemsRequest
+	(*AggregateItemsResponse)(nil),                      // 39: d4l.mex.items.AggregateItemsResponse
+	(*ListAllVersionsRequest)(nil),                      // 40: d4l.mex.items.ListAllVersionsRequest
+	(*ListAllVersionsResponse)(nil),                     // 41: d4l.mex.items.ListAllVersionsResponse
+	(*GetItemHistoryRequest)(nil),                       // 42: d4l.mex.items.GetItemHistoryRequest
+	(*GetItemHistoryResponse)(nil),                      // 43: d4l.mex.items.GetItemHistoryResponse
+	(*DiffVersionsRequest)(nil),                         // 44: d4l.mex.items.DiffVersionsRequest
+	(*DiffVersionsResponse)(nil),                        // 45: d4l.mex.items.DiffVersionsResponse
+	(*ExportItemRequest)(nil),                           // 46: d4l.mex.items.ExportItemRequest
+	(*ExportItemsRequest)(nil),                          // 47: d4l.mex.items.ExportItemsRequest
+	(*CheckSchemaConformanceRequest)(nil),               // 48: d4l.mex.items.CheckSchemaConformanceRequest
+	(*CheckSchemaConformanceResponse)(nil),              // 49: d4l.mex.items.CheckSchemaConformanceResponse
+	(*ComputeItemsTreeRequest)(nil),                     // 50: d4l.mex.items.ComputeItemsTreeRequest
+	(*ComputeItemsTreeResponse)(nil),                    // 51: d4l.mex.items.ComputeItemsTreeResponse
+	(*CreateItemResponse_PostActionResult)(nil),         // 52: d4l.mex.items.CreateItemResponse.PostActionResult
+	(*ImportItemsRequest_FieldMapping)(nil),             // 53: d4l.mex.items.ImportItemsRequest.FieldMapping
+	(*ValidateItemsResponse_ValueReport)(nil),           // 54: d4l.mex.items.ValidateItemsResponse.ValueReport
+	(*ValidateItemsResponse_ItemReport)(nil),            // 55: d4l.mex.items.ValidateItemsResponse.ItemReport
+	(*ComputeVersionsResponse_Version)(nil),             // 56: d4l.mex.items.ComputeVersionsResponse.Version
+	(*ComputeVersionsByBusinessIdResponse_Version)(nil), // 57: d4l.mex.items.ComputeVersionsByBusinessIdResponse.Version
+	nil,                                                      // 58: d4l.mex.items.ListRelationsResponse.RelationsEntry
+	(*GetItemResponse_FullItemValue)(nil),                    // 59: d4l.mex.items.GetItemResponse.FullItemValue
+	(*UpdateItemRequest_ValueUpdate)(nil),                    // 60: d4l.mex.items.UpdateItemRequest.ValueUpdate
+	(*ListAllVersionsResponse_Versions)(nil),                 // 61: d4l.mex.items.ListAllVersionsResponse.Versions
+	(*GetItemHistoryResponse_ValueRevision)(nil),             // 62: d4l.mex.items.GetItemHistoryResponse.ValueRevision
+	(*DiffVersionsResponse_FieldDiff)(nil),                   // 63: d4l.mex.items.DiffVersionsResponse.FieldDiff
+	(*CheckSchemaConformanceResponse_NonconformingItem)(nil), // 64: d4l.mex.items.CheckSchemaConformanceResponse.NonconformingItem
+	(*ComputeItemsTreeResponse_Display)(nil),                 // 65: d4l.mex.items.ComputeItemsTreeResponse.Display
+	(*ComputeItemsTreeResponse_TreeNode)(nil),                // 66: d4l.mex.items.ComputeItemsTreeResponse.TreeNode
+	(*items.Item)(nil),                                       // 67: d4l.mex.items.Item
+	(cfg.DuplicateDetectionAlgorithm)(0),                     // 68: d4l.mex.cfg.DuplicateDetectionAlgorithm
+	(*items.ItemValue)(nil),                                  // 69: d4l.mex.items.ItemValue
+	(*timestamppb.Timestamp)(nil),                            // 70: google.protobuf.Timestamp
+	(*httpbody.HttpBody)(nil),                                // 71: google.api.HttpBody
 }
 var file_services_metadata_endpoints_items_items_proto_depIdxs = []int32{
-	42, // 0: d4l.mex.items.CreateItemRequest.item:type_name -> d4l.mex.items.Item
-	42, // 1: d4l.mex.items.CreateItemsBulkRequest.items:type_name -> d4l.mex.items.Item
-	43, // 2: d4l.mex.items.CreateItemsBulkRequest.duplicate_algorithm:type_name -> d4l.mex.cfg.DuplicateDetectionAlgorithm
-	35, // 3: d4l.mex.items.ComputeVersionsResponse.versions:type_name -> d4l.mex.items.ComputeVersionsResponse.Version
-	36, // 4: d4l.mex.items.ComputeVersionsByBusinessIdResponse.versions:type_name -> d4l.mex.items.ComputeVersionsByBusinessIdResponse.Version
-	44, // 5: d4l.mex.items.CreateRelationRequest.values:type_name -> d4l.mex.items.ItemValue
-	37, // 6: d4l.mex.items.ListRelationsResponse.relations:type_name -> d4l.mex.items.ListRelationsResponse.RelationsEntry
-	45, // 7: d4l.mex.items.ListItem.created_at:type_name -> google.protobuf.Timestamp
-	18, // 8: d4l.mex.items.ListItemsResponse.items:type_name -> d4l.mex.items.ListItem
-	45, // 9: d4l.mex.items.GetItemResponse.created_at:type_name -> google.protobuf.Timestamp
-	38, // 10: d4l.mex.items.GetItemResponse.values:type_name -> d4l.mex.items.GetItemResponse.FullItemValue
-	39, // 11: d4l.mex.items.ListAllVersionsResponse.versions:type_name -> d4l.mex.items.ListAllVersionsResponse.Versions
-	41, // 12: d4l.mex.items.ComputeItemsTreeResponse.nodes:type_name -> d4l.mex.items.ComputeItemsTreeResponse.TreeNode
-	45, // 13: d4l.mex.items.ComputeVersionsResponse.Version.created_at:type_name -> google.protobuf.Timestamp
-	45, // 14: d4l.mex.items.ComputeVersionsByBusinessIdResponse.Version.created_at:type_name -> google.protobuf.Timestamp
-	15, // 15: d4l.mex.items.ListRelationsResponse.RelationsEntry.value:type_name -> d4l.mex.items.ListRelation
-	40, // 16: d4l.mex.items.ComputeItemsTreeResponse.TreeNode.display:type_name -> d4l.mex.items.ComputeItemsTreeResponse.Display
-	0,  // 17: d4l.mex.items.Items.CreateItem:input_type -> d4l.mex.items.CreateItemRequest
-	2,  // 18: d4l.mex.items.Items.CreateItemsBulk:input_type -> d4l.mex.items.CreateItemsBulkRequest
-	17, // 19: d4l.mex.items.Items.ListItems:input_type -> d4l.mex.items.ListItemsRequest
-	20, // 20: d4l.mex.items.Items.GetItem:input_type -> d4l.mex.items.GetItemRequest
-	22, // 21: d4l.mex.items.Items.DeleteItem:input_type -> d4l.mex.items.DeleteItemRequest
-	24, // 22: d4l.mex.items.Items.DeleteItems:input_type -> d4l.mex.items.DeleteItemsRequest
-	26, // 23: d4l.mex.items.Items.DeleteAllItems:input_type -> d4l.mex.items.DeleteAllItemsRequest
-	4,  // 24: d4l.mex.items.Items.ComputeVersions:input_type -> d4l.mex.items.ComputeVersionsRequest
-	6,  // 25: d4l.mex.items.Items.ComputeVersionsByBusinessID:input_type -> d4l.mex.items.ComputeVersionsByBusinessIdRequest
-	8,  // 26: d4l.mex.items.Items.CreateRelation:input_type -> d4l.mex.items.CreateRelationRequest
-	10, // 27: d4l.mex.items.Items.CreateRelationsFromBusinessIds:input_type -> d4l.mex.items.CreateRelationsFromBusinessIdsRequest
-	12, // 28: d4l.mex.items.Items.CreateRelationsFromOriginalItems:input_type -> d4l.mex.items.CreateRelationsFromOriginalItemsRequest
-	14, // 29: d4l.mex.items.Items.ListRelations:input_type -> d4l.mex.items.ListRelationsRequest
-	28, // 30: d4l.mex.items.Items.AggregateItems:input_type -> d4l.mex.items.AggregateItemsRequest
-	30, // 31: d4l.mex.items.Items.ListAllVersions:input_type -> d4l.mex.items.ListAllVersionsRequest
-	32, // 32: d4l.mex.items.Items.ComputeItemsTree:input_type -> d4l.mex.items.ComputeItemsTreeRequest
-	1,  // 33: d4l.mex.items.Items.CreateItem:output_type -> d4l.mex.items.CreateItemResponse
-	3,  // 34: d4l.mex.items.Items.CreateItemsBulk:output_type -> d4l.mex.items.CreateItemsBulkResponse
-	19, // 35: d4l.mex.items.Items.ListItems:output_type -> d4l.mex.items.ListItemsResponse
-	21, // 36: d4l.mex.items.Items.GetItem:output_type -> d4l.mex.items.GetItemResponse
-	23, // 37: d4l.mex.items.Items.DeleteItem:output_type -> d4l.mex.items.DeleteItemResponse
-	25, // 38: d4l.mex.items.Items.DeleteItems:output_type -> d4l.mex.items.DeleteItemsResponse
-	27, // 39: d4l.mex.items.Items.DeleteAllItems:output_type -> d4l.mex.items.DeleteAllItemsResponse
-	5,  // 40: d4l.mex.items.Items.ComputeVersions:output_type -> d4l.mex.items.ComputeVersionsResponse
-	7,  // 41: d4l.mex.items.Items.ComputeVersionsByBusinessID:output_type -> d4l.mex.items.ComputeVersionsByBusinessIdResponse
-	9,  // 42: d4l.mex.items.Items.CreateRelation:output_type -> d4l.mex.items.CreateRelationResponse
-	11, // 43: d4l.mex.items.Items.CreateRelationsFromBusinessIds:output_type -> d4l.mex.items.CreateRelationsFromBusinessIdsResponse
-	13, // 44: d4l.mex.items.Items.CreateRelationsFromOriginalItems:output_type -> d4l.mex.items.CreateRelationsFromOriginalItemsResponse
-	16, // 45: d4l.mex.items.Items.ListRelations:output_type -> d4l.mex.items.ListRelationsResponse
-	29, // 46: d4l.mex.items.Items.AggregateItems:output_type -> d4l.mex.items.AggregateItemsResponse
-	31, // 47: d4l.mex.items.Items.ListAllVersions:output_type -> d4l.mex.items.ListAllVersionsResponse
-	33, // 48: d4l.mex.items.Items.ComputeItemsTree:output_type -> d4l.mex.items.ComputeItemsTreeResponse
-	33, // [33:49] is the sub-list for method output_type
-	17, // [17:33] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	67, // 0: d4l.mex.items.CreateItemRequest.item:type_name -> d4l.mex.items.Item
+	67, // 1: d4l.mex.items.CreateItemsBulkRequest.items:type_name -> d4l.mex.items.Item
+	68, // 2: d4l.mex.items.CreateItemsBulkRequest.duplicate_algorithm:type_name -> d4l.mex.cfg.DuplicateDetectionAlgorithm
+	67, // 3: d4l.mex.items.CreateItemsStreamRequest.item:type_name -> d4l.mex.items.Item
+	53, // 4: d4l.mex.items.ImportItemsRequest.mappings:type_name -> d4l.mex.items.ImportItemsRequest.FieldMapping
+	68, // 5: d4l.mex.items.ImportItemsRequest.duplicate_algorithm:type_name -> d4l.mex.cfg.DuplicateDetectionAlgorithm
+	67, // 6: d4l.mex.items.ValidateItemsRequest.items:type_name -> d4l.mex.items.Item
+	68, // 7: d4l.mex.items.ValidateItemsRequest.duplicate_algorithm:type_name -> d4l.mex.cfg.DuplicateDetectionAlgorithm
+	55, // 8: d4l.mex.items.ValidateItemsResponse.items:type_name -> d4l.mex.items.ValidateItemsResponse.ItemReport
+	56, // 9: d4l.mex.items.ComputeVersionsResponse.versions:type_name -> d4l.mex.items.ComputeVersionsResponse.Version
+	57, // 10: d4l.mex.items.ComputeVersionsByBusinessIdResponse.versions:type_name -> d4l.mex.items.ComputeVersionsByBusinessIdResponse.Version
+	69, // 11: d4l.mex.items.CreateRelationRequest.values:type_name -> d4l.mex.items.ItemValue
+	58, // 12: d4l.mex.items.ListRelationsResponse.relations:type_name -> d4l.mex.items.ListRelationsResponse.RelationsEntry
+	70, // 13: d4l.mex.items.ListItem.created_at:type_name -> google.protobuf.Timestamp
+	26, // 14: d4l.mex.items.ListItemsResponse.items:type_name -> d4l.mex.items.ListItem
+	70, // 15: d4l.mex.items.GetItemResponse.created_at:type_name -> google.protobuf.Timestamp
+	59, // 16: d4l.mex.items.GetItemResponse.values:type_name -> d4l.mex.items.GetItemResponse.FullItemValue
+	60, // 17: d4l.mex.items.UpdateItemRequest.updates:type_name -> d4l.mex.items.UpdateItemRequest.ValueUpdate
+	61, // 18: d4l.mex.items.ListAllVersionsResponse.versions:type_name -> d4l.mex.items.ListAllVersionsResponse.Versions
+	62, // 19: d4l.mex.items.GetItemHistoryResponse.revisions:type_name -> d4l.mex.items.GetItemHistoryResponse.ValueRevision
+	63, // 20: d4l.mex.items.DiffVersionsResponse.fields:type_name -> d4l.mex.items.DiffVersionsResponse.FieldDiff
+	64, // 21: d4l.mex.items.CheckSchemaConformanceResponse.items:type_name -> d4l.mex.items.CheckSchemaConformanceResponse.NonconformingItem
+	66, // 22: d4l.mex.items.ComputeItemsTreeResponse.nodes:type_name -> d4l.mex.items.ComputeItemsTreeResponse.TreeNode
+	54, // 23: d4l.mex.items.ValidateItemsResponse.ItemReport.values:type_name -> d4l.mex.items.ValidateItemsResponse.ValueReport
+	70, // 24: d4l.mex.items.ComputeVersionsResponse.Version.created_at:type_name -> google.protobuf.Timestamp
+	70, // 25: d4l.mex.items.ComputeVersionsByBusinessIdResponse.Version.created_at:type_name -> google.protobuf.Timestamp
+	23, // 26: d4l.mex.items.ListRelationsResponse.RelationsEntry.value:type_name -> d4l.mex.items.ListRelation
+	0,  // 27: d4l.mex.items.UpdateItemRequest.ValueUpdate.operation:type_name -> d4l.mex.items.UpdateItemRequest.Operation
+	1,  // 28: d4l.mex.items.GetItemHistoryResponse.ValueRevision.change:type_name -> d4l.mex.items.GetItemHistoryResponse.ChangeType
+	70, // 29: d4l.mex.items.GetItemHistoryResponse.ValueRevision.created_at:type_name -> google.protobuf.Timestamp
+	65, // 30: d4l.mex.items.ComputeItemsTreeResponse.TreeNode.display:type_name -> d4l.mex.items.ComputeItemsTreeResponse.Display
+	2,  // 31: d4l.mex.items.Items.CreateItem:input_type -> d4l.mex.items.CreateItemRequest
+	4,  // 32: d4l.mex.items.Items.CreateItemsBulk:input_type -> d4l.mex.items.CreateItemsBulkRequest
+	6,  // 33: d4l.mex.items.Items.CreateItemsStream:input_type -> d4l.mex.items.CreateItemsStreamRequest
+	8,  // 34: d4l.mex.items.Items.ImportItems:input_type -> d4l.mex.items.ImportItemsRequest
+	10, // 35: d4l.mex.items.Items.ValidateItems:input_type -> d4l.mex.items.ValidateItemsRequest
+	25, // 36: d4l.mex.items.Items.ListItems:input_type -> d4l.mex.items.ListItemsRequest
+	28, // 37: d4l.mex.items.Items.GetItem:input_type -> d4l.mex.items.GetItemRequest
+	30, // 38: d4l.mex.items.Items.UpdateItem:input_type -> d4l.mex.items.UpdateItemRequest
+	32, // 39: d4l.mex.items.Items.DeleteItem:input_type -> d4l.mex.items.DeleteItemRequest
+	34, // 40: d4l.mex.items.Items.DeleteItems:input_type -> d4l.mex.items.DeleteItemsRequest
+	36, // 41: d4l.mex.items.Items.DeleteAllItems:input_type -> d4l.mex.items.DeleteAllItemsRequest
+	12, // 42: d4l.mex.items.Items.ComputeVersions:input_type -> d4l.mex.items.ComputeVersionsRequest
+	14, // 43: d4l.mex.items.Items.ComputeVersionsByBusinessID:input_type -> d4l.mex.items.ComputeVersionsByBusinessIdRequest
+	16, // 44: d4l.mex.items.Items.CreateRelation:input_type -> d4l.mex.items.CreateRelationRequest
+	18, // 45: d4l.mex.items.Items.CreateRelationsFromBusinessIds:input_type -> d4l.mex.items.CreateRelationsFromBusinessIdsRequest
+	20, // 46: d4l.mex.items.Items.CreateRelationsFromOriginalItems:input_type -> d4l.mex.items.CreateRelationsFromOriginalItemsRequest
+	22, // 47: d4l.mex.items.Items.ListRelations:input_type -> d4l.mex.items.ListRelationsRequest
+	38, // 48: d4l.mex.items.Items.AggregateItems:input_type -> d4l.mex.items.AggregateItemsRequest
+	40, // 49: d4l.mex.items.Items.ListAllVersions:input_type -> d4l.mex.items.ListAllVersionsRequest
+	42, // 50: d4l.mex.items.Items.GetItemHistory:input_type -> d4l.mex.items.GetItemHistoryRequest
+	44, // 51: d4l.mex.items.Items.DiffVersions:input_type -> d4l.mex.items.DiffVersionsRequest
+	46, // 52: d4l.mex.items.Items.ExportItem:input_type -> d4l.mex.items.ExportItemRequest
+	47, // 53: d4l.mex.items.Items.ExportItems:input_type -> d4l.mex.items.ExportItemsRequest
+	48, // 54: d4l.mex.items.Items.CheckSchemaConformance:input_type -> d4l.mex.items.CheckSchemaConformanceRequest
+	50, // 55: d4l.mex.items.Items.ComputeItemsTree:input_type -> d4l.mex.items.ComputeItemsTreeRequest
+	3,  // 56: d4l.mex.items.Items.CreateItem:output_type -> d4l.mex.items.CreateItemResponse
+	5,  // 57: d4l.mex.items.Items.CreateItemsBulk:output_type -> d4l.mex.items.CreateItemsBulkResponse
+	7,  // 58: d4l.mex.items.Items.CreateItemsStream:output_type -> d4l.mex.items.CreateItemsStreamResponse
+	9,  // 59: d4l.mex.items.Items.ImportItems:output_type -> d4l.mex.items.ImportItemsResponse
+	11, // 60: d4l.mex.items.Items.ValidateItems:output_type -> d4l.mex.items.ValidateItemsResponse
+	27, // 61: d4l.mex.items.Items.ListItems:output_type -> d4l.mex.items.ListItemsResponse
+	29, // 62: d4l.mex.items.Items.GetItem:output_type -> d4l.mex.items.GetItemResponse
+	31, // 63: d4l.mex.items.Items.UpdateItem:output_type -> d4l.mex.items.UpdateItemResponse
+	33, // 64: d4l.mex.items.Items.DeleteItem:output_type -> d4l.mex.items.DeleteItemResponse
+	35, // 65: d4l.mex.items.Items.DeleteItems:output_type -> d4l.mex.items.DeleteItemsResponse
+	37, // 66: d4l.mex.items.Items.DeleteAllItems:output_type -> d4l.mex.items.DeleteAllItemsResponse
+	13, // 67: d4l.mex.items.Items.ComputeVersions:output_type -> d4l.mex.items.ComputeVersionsResponse
+	15, // 68: d4l.mex.items.Items.ComputeVersionsByBusinessID:output_type -> d4l.mex.items.ComputeVersionsByBusinessIdResponse
+	17, // 69: d4l.mex.items.Items.CreateRelation:output_type -> d4l.mex.items.CreateRelationResponse
+	19, // 70: d4l.mex.items.Items.CreateRelationsFromBusinessIds:output_type -> d4l.mex.items.CreateRelationsFromBusinessIdsResponse
+	21, // 71: d4l.mex.items.Items.CreateRelationsFromOriginalItems:output_type -> d4l.mex.items.CreateRelationsFromOriginalItemsResponse
+	24, // 72: d4l.mex.items.Items.ListRelations:output_type -> d4l.mex.items.ListRelationsResponse
+	39, // 73: d4l.mex.items.Items.AggregateItems:output_type -> d4l.mex.items.AggregateItemsResponse
+	41, // 74: d4l.mex.items.Items.ListAllVersions:output_type -> d4l.mex.items.ListAllVersionsResponse
+	43, // 75: d4l.mex.items.Items.GetItemHistory:output_type -> d4l.mex.items.GetItemHistoryResponse
+	45, // 76: d4l.mex.items.Items.DiffVersions:output_type -> d4l.mex.items.DiffVersionsResponse
+	71, // 77: d4l.mex.items.Items.ExportItem:output_type -> google.api.HttpBody
+	71, // 78: d4l.mex.items.Items.ExportItems:output_type -> google.api.HttpBody
+	49, // 79: d4l.mex.items.Items.CheckSchemaConformance:output_type -> d4l.mex.items.CheckSchemaConformanceResponse
+	51, // 80: d4l.mex.items.Items.ComputeItemsTree:output_type -> d4l.mex.items.ComputeItemsTreeResponse
+	56, // [56:81] is the sub-list for method output_type
+	31, // [31:56] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
 }
 
 func init() { file_services_metadata_endpoints_items_items_proto_init() }
@@ -3054,8 +5281,80 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateItemResponse); i {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateItemResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_metadata_endpoints_items_items_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateItemsBulkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_metadata_endpoints_items_items_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateItemsBulkResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_metadata_endpoints_items_items_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateItemsStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_metadata_endpoints_items_items_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateItemsStreamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_metadata_endpoints_items_items_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportItemsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_metadata_endpoints_items_items_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportItemsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3066,8 +5365,8 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateItemsBulkRequest); i {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateItemsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3078,8 +5377,8 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateItemsBulkResponse); i {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateItemsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3090,7 +5389,7 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ComputeVersionsRequest); i {
 			case 0:
 				return &v.state
@@ -3102,7 +5401,7 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ComputeVersionsResponse); i {
 			case 0:
 				return &v.state
@@ -3114,7 +5413,7 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ComputeVersionsByBusinessIdRequest); i {
 			case 0:
 				return &v.state
@@ -3126,7 +5425,7 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ComputeVersionsByBusinessIdResponse); i {
 			case 0:
 				return &v.state
@@ -3138,7 +5437,7 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateRelationRequest); i {
 			case 0:
 				return &v.state
@@ -3150,7 +5449,7 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateRelationResponse); i {
 			case 0:
 				return &v.state
@@ -3162,7 +5461,7 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateRelationsFromBusinessIdsRequest); i {
 			case 0:
 				return &v.state
@@ -3174,7 +5473,7 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateRelationsFromBusinessIdsResponse); i {
 			case 0:
 				return &v.state
@@ -3186,7 +5485,7 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateRelationsFromOriginalItemsRequest); i {
 			case 0:
 				return &v.state
@@ -3198,7 +5497,7 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateRelationsFromOriginalItemsResponse); i {
 			case 0:
 				return &v.state
@@ -3210,7 +5509,7 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListRelationsRequest); i {
 			case 0:
 				return &v.state
@@ -3222,7 +5521,7 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListRelation); i {
 			case 0:
 				return &v.state
@@ -3234,7 +5533,7 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListRelationsResponse); i {
 			case 0:
 				return &v.state
@@ -3246,7 +5545,7 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListItemsRequest); i {
 			case 0:
 				return &v.state
@@ -3258,7 +5557,7 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListItem); i {
 			case 0:
 				return &v.state
@@ -3270,7 +5569,7 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListItemsResponse); i {
 			case 0:
 				return &v.state
@@ -3282,7 +5581,7 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetItemRequest); i {
 			case 0:
 				return &v.state
@@ -3294,7 +5593,7 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetItemResponse); i {
 			case 0:
 				return &v.state
@@ -3306,7 +5605,31 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateItemRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_metadata_endpoints_items_items_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateItemResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_metadata_endpoints_items_items_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteItemRequest); i {
 			case 0:
 				return &v.state
@@ -3318,7 +5641,7 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteItemResponse); i {
 			case 0:
 				return &v.state
@@ -3330,7 +5653,7 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteItemsRequest); i {
 			case 0:
 				return &v.state
@@ -3342,7 +5665,7 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteItemsResponse); i {
 			case 0:
 				return &v.state
@@ -3354,7 +5677,7 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteAllItemsRequest); i {
 			case 0:
 				return &v.state
@@ -3366,7 +5689,7 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteAllItemsResponse); i {
 			case 0:
 				return &v.state
@@ -3378,7 +5701,7 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AggregateItemsRequest); i {
 			case 0:
 				return &v.state
@@ -3390,7 +5713,7 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AggregateItemsResponse); i {
 			case 0:
 				return &v.state
@@ -3402,7 +5725,7 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListAllVersionsRequest); i {
 			case 0:
 				return &v.state
@@ -3414,7 +5737,7 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListAllVersionsResponse); i {
 			case 0:
 				return &v.state
@@ -3426,7 +5749,103 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetItemHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_metadata_endpoints_items_items_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetItemHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_metadata_endpoints_items_items_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffVersionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_metadata_endpoints_items_items_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffVersionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_metadata_endpoints_items_items_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportItemRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_metadata_endpoints_items_items_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportItemsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_metadata_endpoints_items_items_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckSchemaConformanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_metadata_endpoints_items_items_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckSchemaConformanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_metadata_endpoints_items_items_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ComputeItemsTreeRequest); i {
 			case 0:
 				return &v.state
@@ -3438,7 +5857,7 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ComputeItemsTreeResponse); i {
 			case 0:
 				return &v.state
@@ -3450,7 +5869,7 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateItemResponse_PostActionResult); i {
 			case 0:
 				return &v.state
@@ -3462,7 +5881,43 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportItemsRequest_FieldMapping); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_metadata_endpoints_items_items_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateItemsResponse_ValueReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_metadata_endpoints_items_items_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateItemsResponse_ItemReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_metadata_endpoints_items_items_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ComputeVersionsResponse_Version); i {
 			case 0:
 				return &v.state
@@ -3474,7 +5929,7 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ComputeVersionsByBusinessIdResponse_Version); i {
 			case 0:
 				return &v.state
@@ -3486,7 +5941,7 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetItemResponse_FullItemValue); i {
 			case 0:
 				return &v.state
@@ -3498,7 +5953,19 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateItemRequest_ValueUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_metadata_endpoints_items_items_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListAllVersionsResponse_Versions); i {
 			case 0:
 				return &v.state
@@ -3510,7 +5977,43 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetItemHistoryResponse_ValueRevision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_metadata_endpoints_items_items_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffVersionsResponse_FieldDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_metadata_endpoints_items_items_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckSchemaConformanceResponse_NonconformingItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_metadata_endpoints_items_items_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ComputeItemsTreeResponse_Display); i {
 			case 0:
 				return &v.state
@@ -3522,7 +6025,7 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 				return nil
 			}
 		}
-		file_services_metadata_endpoints_items_items_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_services_metadata_endpoints_items_items_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ComputeItemsTreeResponse_TreeNode); i {
 			case 0:
 				return &v.state
@@ -3535,23 +6038,24 @@ func file_services_metadata_endpoints_items_items_proto_init() {
 			}
 		}
 	}
-	file_services_metadata_endpoints_items_items_proto_msgTypes[9].OneofWrappers = []interface{}{}
 	file_services_metadata_endpoints_items_items_proto_msgTypes[15].OneofWrappers = []interface{}{}
 	file_services_metadata_endpoints_items_items_proto_msgTypes[21].OneofWrappers = []interface{}{}
-	file_services_metadata_endpoints_items_items_proto_msgTypes[40].OneofWrappers = []interface{}{}
-	file_services_metadata_endpoints_items_items_proto_msgTypes[41].OneofWrappers = []interface{}{}
+	file_services_metadata_endpoints_items_items_proto_msgTypes[27].OneofWrappers = []interface{}{}
+	file_services_metadata_endpoints_items_items_proto_msgTypes[63].OneofWrappers = []interface{}{}
+	file_services_metadata_endpoints_items_items_proto_msgTypes[64].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_services_metadata_endpoints_items_items_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   42,
+			NumEnums:      2,
+			NumMessages:   65,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_services_metadata_endpoints_items_items_proto_goTypes,
 		DependencyIndexes: file_services_metadata_endpoints_items_items_proto_depIdxs,
+		EnumInfos:         file_services_metadata_endpoints_items_items_proto_enumTypes,
 		MessageInfos:      file_services_metadata_endpoints_items_items_proto_msgTypes,
 	}.Build()
 	File_services_metadata_endpoints_items_items_proto = out.File
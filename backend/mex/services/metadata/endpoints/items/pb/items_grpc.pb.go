@@ -8,6 +8,7 @@ package itemspb
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -21,8 +22,12 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	Items_CreateItem_FullMethodName                       = "/d4l.mex.items.Items/CreateItem"
 	Items_CreateItemsBulk_FullMethodName                  = "/d4l.mex.items.Items/CreateItemsBulk"
+	Items_CreateItemsStream_FullMethodName                = "/d4l.mex.items.Items/CreateItemsStream"
+	Items_ImportItems_FullMethodName                      = "/d4l.mex.items.Items/ImportItems"
+	Items_ValidateItems_FullMethodName                    = "/d4l.mex.items.Items/ValidateItems"
 	Items_ListItems_FullMethodName                        = "/d4l.mex.items.Items/ListItems"
 	Items_GetItem_FullMethodName                          = "/d4l.mex.items.Items/GetItem"
+	Items_UpdateItem_FullMethodName                       = "/d4l.mex.items.Items/UpdateItem"
 	Items_DeleteItem_FullMethodName                       = "/d4l.mex.items.Items/DeleteItem"
 	Items_DeleteItems_FullMethodName                      = "/d4l.mex.items.Items/DeleteItems"
 	Items_DeleteAllItems_FullMethodName                   = "/d4l.mex.items.Items/DeleteAllItems"
@@ -34,6 +39,11 @@ const (
 	Items_ListRelations_FullMethodName                    = "/d4l.mex.items.Items/ListRelations"
 	Items_AggregateItems_FullMethodName                   = "/d4l.mex.items.Items/AggregateItems"
 	Items_ListAllVersions_FullMethodName                  = "/d4l.mex.items.Items/ListAllVersions"
+	Items_GetItemHistory_FullMethodName                   = "/d4l.mex.items.Items/GetItemHistory"
+	Items_DiffVersions_FullMethodName                     = "/d4l.mex.items.Items/DiffVersions"
+	Items_ExportItem_FullMethodName                       = "/d4l.mex.items.Items/ExportItem"
+	Items_ExportItems_FullMethodName                      = "/d4l.mex.items.Items/ExportItems"
+	Items_CheckSchemaConformance_FullMethodName           = "/d4l.mex.items.Items/CheckSchemaConformance"
 	Items_ComputeItemsTree_FullMethodName                 = "/d4l.mex.items.Items/ComputeItemsTree"
 )
 
@@ -43,8 +53,15 @@ const (
 type ItemsClient interface {
 	CreateItem(ctx context.Context, in *CreateItemRequest, opts ...grpc.CallOption) (*CreateItemResponse, error)
 	CreateItemsBulk(ctx context.Context, in *CreateItemsBulkRequest, opts ...grpc.CallOption) (*CreateItemsBulkResponse, error)
+	// Client-streaming variant of CreateItemsBulk. Via the REST gateway, the requests are sent as newline-delimited JSON,
+	// i.e. one {"item": {...}} object per line.
+	// Items are committed in chunks and failing items are recorded in the job instead of aborting the ingestion.
+	CreateItemsStream(ctx context.Context, opts ...grpc.CallOption) (Items_CreateItemsStreamClient, error)
+	ImportItems(ctx context.Context, in *ImportItemsRequest, opts ...grpc.CallOption) (*ImportItemsResponse, error)
+	ValidateItems(ctx context.Context, in *ValidateItemsRequest, opts ...grpc.CallOption) (*ValidateItemsResponse, error)
 	ListItems(ctx context.Context, in *ListItemsRequest, opts ...grpc.CallOption) (*ListItemsResponse, error)
 	GetItem(ctx context.Context, in *GetItemRequest, opts ...grpc.CallOption) (*GetItemResponse, error)
+	UpdateItem(ctx context.Context, in *UpdateItemRequest, opts ...grpc.CallOption) (*UpdateItemResponse, error)
 	DeleteItem(ctx context.Context, in *DeleteItemRequest, opts ...grpc.CallOption) (*DeleteItemResponse, error)
 	DeleteItems(ctx context.Context, in *DeleteItemsRequest, opts ...grpc.CallOption) (*DeleteItemsResponse, error)
 	DeleteAllItems(ctx context.Context, in *DeleteAllItemsRequest, opts ...grpc.CallOption) (*DeleteAllItemsResponse, error)
@@ -57,6 +74,12 @@ type ItemsClient interface {
 	ListRelations(ctx context.Context, in *ListRelationsRequest, opts ...grpc.CallOption) (*ListRelationsResponse, error)
 	AggregateItems(ctx context.Context, in *AggregateItemsRequest, opts ...grpc.CallOption) (*AggregateItemsResponse, error)
 	ListAllVersions(ctx context.Context, in *ListAllVersionsRequest, opts ...grpc.CallOption) (*ListAllVersionsResponse, error)
+	GetItemHistory(ctx context.Context, in *GetItemHistoryRequest, opts ...grpc.CallOption) (*GetItemHistoryResponse, error)
+	DiffVersions(ctx context.Context, in *DiffVersionsRequest, opts ...grpc.CallOption) (*DiffVersionsResponse, error)
+	ExportItem(ctx context.Context, in *ExportItemRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// Streams one JSON-LD document per item. Via the REST gateway, the documents are returned as newline-delimited JSON.
+	ExportItems(ctx context.Context, in *ExportItemsRequest, opts ...grpc.CallOption) (Items_ExportItemsClient, error)
+	CheckSchemaConformance(ctx context.Context, in *CheckSchemaConformanceRequest, opts ...grpc.CallOption) (*CheckSchemaConformanceResponse, error)
 	ComputeItemsTree(ctx context.Context, in *ComputeItemsTreeRequest, opts ...grpc.CallOption) (*ComputeItemsTreeResponse, error)
 }
 
@@ -86,6 +109,58 @@ func (c *itemsClient) CreateItemsBulk(ctx context.Context, in *CreateItemsBulkRe
 	return out, nil
 }
 
+func (c *itemsClient) CreateItemsStream(ctx context.Context, opts ...grpc.CallOption) (Items_CreateItemsStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Items_ServiceDesc.Streams[0], Items_CreateItemsStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &itemsCreateItemsStreamClient{stream}
+	return x, nil
+}
+
+type Items_CreateItemsStreamClient interface {
+	Send(*CreateItemsStreamRequest) error
+	CloseAndRecv() (*CreateItemsStreamResponse, error)
+	grpc.ClientStream
+}
+
+type itemsCreateItemsStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *itemsCreateItemsStreamClient) Send(m *CreateItemsStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *itemsCreateItemsStreamClient) CloseAndRecv() (*CreateItemsStreamResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(CreateItemsStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *itemsClient) ImportItems(ctx context.Context, in *ImportItemsRequest, opts ...grpc.CallOption) (*ImportItemsResponse, error) {
+	out := new(ImportItemsResponse)
+	err := c.cc.Invoke(ctx, Items_ImportItems_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemsClient) ValidateItems(ctx context.Context, in *ValidateItemsRequest, opts ...grpc.CallOption) (*ValidateItemsResponse, error) {
+	out := new(ValidateItemsResponse)
+	err := c.cc.Invoke(ctx, Items_ValidateItems_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *itemsClient) ListItems(ctx context.Context, in *ListItemsRequest, opts ...grpc.CallOption) (*ListItemsResponse, error) {
 	out := new(ListItemsResponse)
 	err := c.cc.Invoke(ctx, Items_ListItems_FullMethodName, in, out, opts...)
@@ -104,6 +179,15 @@ func (c *itemsClient) GetItem(ctx context.Context, in *GetItemRequest, opts ...g
 	return out, nil
 }
 
+func (c *itemsClient) UpdateItem(ctx context.Context, in *UpdateItemRequest, opts ...grpc.CallOption) (*UpdateItemResponse, error) {
+	out := new(UpdateItemResponse)
+	err := c.cc.Invoke(ctx, Items_UpdateItem_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *itemsClient) DeleteItem(ctx context.Context, in *DeleteItemRequest, opts ...grpc.CallOption) (*DeleteItemResponse, error) {
 	out := new(DeleteItemResponse)
 	err := c.cc.Invoke(ctx, Items_DeleteItem_FullMethodName, in, out, opts...)
@@ -203,6 +287,74 @@ func (c *itemsClient) ListAllVersions(ctx context.Context, in *ListAllVersionsRe
 	return out, nil
 }
 
+func (c *itemsClient) GetItemHistory(ctx context.Context, in *GetItemHistoryRequest, opts ...grpc.CallOption) (*GetItemHistoryResponse, error) {
+	out := new(GetItemHistoryResponse)
+	err := c.cc.Invoke(ctx, Items_GetItemHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemsClient) DiffVersions(ctx context.Context, in *DiffVersionsRequest, opts ...grpc.CallOption) (*DiffVersionsResponse, error) {
+	out := new(DiffVersionsResponse)
+	err := c.cc.Invoke(ctx, Items_DiffVersions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemsClient) ExportItem(ctx context.Context, in *ExportItemRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, Items_ExportItem_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemsClient) ExportItems(ctx context.Context, in *ExportItemsRequest, opts ...grpc.CallOption) (Items_ExportItemsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Items_ServiceDesc.Streams[1], Items_ExportItems_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &itemsExportItemsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Items_ExportItemsClient interface {
+	Recv() (*httpbody.HttpBody, error)
+	grpc.ClientStream
+}
+
+type itemsExportItemsClient struct {
+	grpc.ClientStream
+}
+
+func (x *itemsExportItemsClient) Recv() (*httpbody.HttpBody, error) {
+	m := new(httpbody.HttpBody)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *itemsClient) CheckSchemaConformance(ctx context.Context, in *CheckSchemaConformanceRequest, opts ...grpc.CallOption) (*CheckSchemaConformanceResponse, error) {
+	out := new(CheckSchemaConformanceResponse)
+	err := c.cc.Invoke(ctx, Items_CheckSchemaConformance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *itemsClient) ComputeItemsTree(ctx context.Context, in *ComputeItemsTreeRequest, opts ...grpc.CallOption) (*ComputeItemsTreeResponse, error) {
 	out := new(ComputeItemsTreeResponse)
 	err := c.cc.Invoke(ctx, Items_ComputeItemsTree_FullMethodName, in, out, opts...)
@@ -218,8 +370,15 @@ func (c *itemsClient) ComputeItemsTree(ctx context.Context, in *ComputeItemsTree
 type ItemsServer interface {
 	CreateItem(context.Context, *CreateItemRequest) (*CreateItemResponse, error)
 	CreateItemsBulk(context.Context, *CreateItemsBulkRequest) (*CreateItemsBulkResponse, error)
+	// Client-streaming variant of CreateItemsBulk. Via the REST gateway, the requests are sent as newline-delimited JSON,
+	// i.e. one {"item": {...}} object per line.
+	// Items are committed in chunks and failing items are recorded in the job instead of aborting the ingestion.
+	CreateItemsStream(Items_CreateItemsStreamServer) error
+	ImportItems(context.Context, *ImportItemsRequest) (*ImportItemsResponse, error)
+	ValidateItems(context.Context, *ValidateItemsRequest) (*ValidateItemsResponse, error)
 	ListItems(context.Context, *ListItemsRequest) (*ListItemsResponse, error)
 	GetItem(context.Context, *GetItemRequest) (*GetItemResponse, error)
+	UpdateItem(context.Context, *UpdateItemRequest) (*UpdateItemResponse, error)
 	DeleteItem(context.Context, *DeleteItemRequest) (*DeleteItemResponse, error)
 	DeleteItems(context.Context, *DeleteItemsRequest) (*DeleteItemsResponse, error)
 	DeleteAllItems(context.Context, *DeleteAllItemsRequest) (*DeleteAllItemsResponse, error)
@@ -232,6 +391,12 @@ type ItemsServer interface {
 	ListRelations(context.Context, *ListRelationsRequest) (*ListRelationsResponse, error)
 	AggregateItems(context.Context, *AggregateItemsRequest) (*AggregateItemsResponse, error)
 	ListAllVersions(context.Context, *ListAllVersionsRequest) (*ListAllVersionsResponse, error)
+	GetItemHistory(context.Context, *GetItemHistoryRequest) (*GetItemHistoryResponse, error)
+	DiffVersions(context.Context, *DiffVersionsRequest) (*DiffVersionsResponse, error)
+	ExportItem(context.Context, *ExportItemRequest) (*httpbody.HttpBody, error)
+	// Streams one JSON-LD document per item. Via the REST gateway, the documents are returned as newline-delimited JSON.
+	ExportItems(*ExportItemsRequest, Items_ExportItemsServer) error
+	CheckSchemaConformance(context.Context, *CheckSchemaConformanceRequest) (*CheckSchemaConformanceResponse, error)
 	ComputeItemsTree(context.Context, *ComputeItemsTreeRequest) (*ComputeItemsTreeResponse, error)
 	mustEmbedUnimplementedItemsServer()
 }
@@ -246,12 +411,24 @@ func (UnimplementedItemsServer) CreateItem(context.Context, *CreateItemRequest)
 func (UnimplementedItemsServer) CreateItemsBulk(context.Context, *CreateItemsBulkRequest) (*CreateItemsBulkResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateItemsBulk not implemented")
 }
+func (UnimplementedItemsServer) CreateItemsStream(Items_CreateItemsStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method CreateItemsStream not implemented")
+}
+func (UnimplementedItemsServer) ImportItems(context.Context, *ImportItemsRequest) (*ImportItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportItems not implemented")
+}
+func (UnimplementedItemsServer) ValidateItems(context.Context, *ValidateItemsRequest) (*ValidateItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateItems not implemented")
+}
 func (UnimplementedItemsServer) ListItems(context.Context, *ListItemsRequest) (*ListItemsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListItems not implemented")
 }
 func (UnimplementedItemsServer) GetItem(context.Context, *GetItemRequest) (*GetItemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetItem not implemented")
 }
+func (UnimplementedItemsServer) UpdateItem(context.Context, *UpdateItemRequest) (*UpdateItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateItem not implemented")
+}
 func (UnimplementedItemsServer) DeleteItem(context.Context, *DeleteItemRequest) (*DeleteItemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteItem not implemented")
 }
@@ -285,6 +462,21 @@ func (UnimplementedItemsServer) AggregateItems(context.Context, *AggregateItemsR
 func (UnimplementedItemsServer) ListAllVersions(context.Context, *ListAllVersionsRequest) (*ListAllVersionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAllVersions not implemented")
 }
+func (UnimplementedItemsServer) GetItemHistory(context.Context, *GetItemHistoryRequest) (*GetItemHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItemHistory not implemented")
+}
+func (UnimplementedItemsServer) DiffVersions(context.Context, *DiffVersionsRequest) (*DiffVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffVersions not implemented")
+}
+func (UnimplementedItemsServer) ExportItem(context.Context, *ExportItemRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportItem not implemented")
+}
+func (UnimplementedItemsServer) ExportItems(*ExportItemsRequest, Items_ExportItemsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportItems not implemented")
+}
+func (UnimplementedItemsServer) CheckSchemaConformance(context.Context, *CheckSchemaConformanceRequest) (*CheckSchemaConformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckSchemaConformance not implemented")
+}
 func (UnimplementedItemsServer) ComputeItemsTree(context.Context, *ComputeItemsTreeRequest) (*ComputeItemsTreeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ComputeItemsTree not implemented")
 }
@@ -337,6 +529,68 @@ func _Items_CreateItemsBulk_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Items_CreateItemsStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ItemsServer).CreateItemsStream(&itemsCreateItemsStreamServer{stream})
+}
+
+type Items_CreateItemsStreamServer interface {
+	SendAndClose(*CreateItemsStreamResponse) error
+	Recv() (*CreateItemsStreamRequest, error)
+	grpc.ServerStream
+}
+
+type itemsCreateItemsStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *itemsCreateItemsStreamServer) SendAndClose(m *CreateItemsStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *itemsCreateItemsStreamServer) Recv() (*CreateItemsStreamRequest, error) {
+	m := new(CreateItemsStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Items_ImportItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemsServer).ImportItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Items_ImportItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemsServer).ImportItems(ctx, req.(*ImportItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Items_ValidateItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemsServer).ValidateItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Items_ValidateItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemsServer).ValidateItems(ctx, req.(*ValidateItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Items_ListItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListItemsRequest)
 	if err := dec(in); err != nil {
@@ -373,6 +627,24 @@ func _Items_GetItem_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Items_UpdateItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemsServer).UpdateItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Items_UpdateItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemsServer).UpdateItem(ctx, req.(*UpdateItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Items_DeleteItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteItemRequest)
 	if err := dec(in); err != nil {
@@ -571,6 +843,99 @@ func _Items_ListAllVersions_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Items_GetItemHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetItemHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemsServer).GetItemHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Items_GetItemHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemsServer).GetItemHistory(ctx, req.(*GetItemHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Items_DiffVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemsServer).DiffVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Items_DiffVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemsServer).DiffVersions(ctx, req.(*DiffVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Items_ExportItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemsServer).ExportItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Items_ExportItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemsServer).ExportItem(ctx, req.(*ExportItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Items_ExportItems_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportItemsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ItemsServer).ExportItems(m, &itemsExportItemsServer{stream})
+}
+
+type Items_ExportItemsServer interface {
+	Send(*httpbody.HttpBody) error
+	grpc.ServerStream
+}
+
+type itemsExportItemsServer struct {
+	grpc.ServerStream
+}
+
+func (x *itemsExportItemsServer) Send(m *httpbody.HttpBody) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Items_CheckSchemaConformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckSchemaConformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemsServer).CheckSchemaConformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Items_CheckSchemaConformance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemsServer).CheckSchemaConformance(ctx, req.(*CheckSchemaConformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Items_ComputeItemsTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ComputeItemsTreeRequest)
 	if err := dec(in); err != nil {
@@ -604,6 +969,14 @@ var Items_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateItemsBulk",
 			Handler:    _Items_CreateItemsBulk_Handler,
 		},
+		{
+			MethodName: "ImportItems",
+			Handler:    _Items_ImportItems_Handler,
+		},
+		{
+			MethodName: "ValidateItems",
+			Handler:    _Items_ValidateItems_Handler,
+		},
 		{
 			MethodName: "ListItems",
 			Handler:    _Items_ListItems_Handler,
@@ -612,6 +985,10 @@ var Items_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetItem",
 			Handler:    _Items_GetItem_Handler,
 		},
+		{
+			MethodName: "UpdateItem",
+			Handler:    _Items_UpdateItem_Handler,
+		},
 		{
 			MethodName: "DeleteItem",
 			Handler:    _Items_DeleteItem_Handler,
@@ -656,11 +1033,38 @@ var Items_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListAllVersions",
 			Handler:    _Items_ListAllVersions_Handler,
 		},
+		{
+			MethodName: "GetItemHistory",
+			Handler:    _Items_GetItemHistory_Handler,
+		},
+		{
+			MethodName: "DiffVersions",
+			Handler:    _Items_DiffVersions_Handler,
+		},
+		{
+			MethodName: "ExportItem",
+			Handler:    _Items_ExportItem_Handler,
+		},
+		{
+			MethodName: "CheckSchemaConformance",
+			Handler:    _Items_CheckSchemaConformance_Handler,
+		},
 		{
 			MethodName: "ComputeItemsTree",
 			Handler:    _Items_ComputeItemsTree_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "CreateItemsStream",
+			Handler:       _Items_CreateItemsStream_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportItems",
+			Handler:       _Items_ExportItems_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "services/metadata/endpoints/items/items.proto",
 }
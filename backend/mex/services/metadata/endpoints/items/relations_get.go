@@ -12,7 +12,13 @@ import (
 func (svc *Service) ListRelations(ctx context.Context, request *itemspb.ListRelationsRequest) (*itemspb.ListRelationsResponse, error) {
 	queries := datamodel.New(svc.DB)
 
-	relations, err := queries.DbListRelations(ctx)
+	visibility, err := svc.resolveVisibility(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the relations between items the user may see are listed.
+	relations, err := queries.DbListRelations(ctx, visibility)
 	if err != nil {
 		return nil, err
 	}
@@ -20,6 +20,20 @@ func (svc *Service) ComputeVersions(ctx context.Context, request *itemspb.Comput
 		return nil, err
 	}
 
+	itemIDs := make([]string, len(versions))
+	for i, version := range versions {
+		itemIDs[i] = version.ItemID
+	}
+	visible, err := svc.visibleItemIDs(ctx, queries, itemIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// The versions keep their numbers, even if the user must not see some of the other versions.
+	if !visible[request.ItemId] {
+		versions = nil
+	}
+
 	if len(versions) == 0 {
 		return nil, status.Error(codes.NotFound, fmt.Sprintf("cannot find versions of item '%s' (it does either not exist or does not have a business ID", request.ItemId))
 	}
@@ -29,6 +43,9 @@ func (svc *Service) ComputeVersions(ctx context.Context, request *itemspb.Comput
 	}
 
 	for _, version := range versions {
+		if !visible[version.ItemID] {
+			continue
+		}
 		response.Versions = append(response.Versions, &itemspb.ComputeVersionsResponse_Version{
 			ItemId:      version.ItemID,
 			VersionDesc: fmt.Sprintf("v%d", version.Version),
@@ -47,6 +64,11 @@ func (svc *Service) ComputeVersionsByBusinessID(ctx context.Context, request *it
 		return nil, err
 	}
 
+	versions, err = svc.visibleVersions(ctx, queries, versions)
+	if err != nil {
+		return nil, err
+	}
+
 	response := itemspb.ComputeVersionsByBusinessIdResponse{
 		Versions: []*itemspb.ComputeVersionsByBusinessIdResponse_Version{},
 	}
@@ -65,7 +87,12 @@ func (svc *Service) ComputeVersionsByBusinessID(ctx context.Context, request *it
 func (svc *Service) ListAllVersions(ctx context.Context, request *itemspb.ListAllVersionsRequest) (*itemspb.ListAllVersionsResponse, error) {
 	queries := datamodel.New(svc.DB)
 
-	items, err := queries.DbListItemsWithBusinessId(ctx)
+	visibility, err := svc.resolveVisibility(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := queries.DbListItemsWithBusinessId(ctx, visibility)
 	if err != nil {
 		return nil, err
 	}
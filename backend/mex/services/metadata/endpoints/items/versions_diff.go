@@ -0,0 +1,152 @@
+package items
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	E "github.com/d4l-data4life/mex/mex/shared/errstat"
+
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/datamodel"
+	itemspb "github.com/d4l-data4life/mex/mex/services/metadata/endpoints/items/pb"
+)
+
+func (svc *Service) DiffVersions(ctx context.Context, request *itemspb.DiffVersionsRequest) (*itemspb.DiffVersionsResponse, error) {
+	if request.BusinessId == "" {
+		return nil, E.MakeGRPCStatus(codes.InvalidArgument, "no business ID given", request).Err()
+	}
+
+	queries := datamodel.New(svc.DB)
+
+	// Versions are ordered from oldest to latest, exactly as in ComputeVersionsByBusinessID.
+	versions, err := queries.DbListItemsForBusinessId(ctx, request.BusinessId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Versions the user must not see are treated as if they did not exist.
+	versions, err = svc.visibleVersions(ctx, queries, versions)
+	if err != nil {
+		return nil, err
+	}
+
+	isVersion := make(map[string]bool, len(versions))
+	for _, version := range versions {
+		isVersion[version.ItemID] = true
+	}
+
+	fromItemID := request.FromItemId
+	if fromItemID == "" {
+		if len(versions) < 2 {
+			return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("business ID '%s' has less than two versions", request.BusinessId))
+		}
+		fromItemID = versions[len(versions)-2].ItemID
+	}
+	toItemID := request.ToItemId
+	if toItemID == "" {
+		if len(versions) == 0 {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("business ID '%s' has no versions", request.BusinessId))
+		}
+		toItemID = versions[len(versions)-1].ItemID
+	}
+
+	for _, itemID := range []string{fromItemID, toItemID} {
+		if !isVersion[itemID] {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("item '%s' is not a version of business ID '%s'", itemID, request.BusinessId))
+		}
+	}
+
+	fromValues, err := queries.DbGetItemValues(ctx, fromItemID)
+	if err != nil {
+		return nil, err
+	}
+	toValues, err := queries.DbGetItemValues(ctx, toItemID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &itemspb.DiffVersionsResponse{
+		BusinessId: request.BusinessId,
+		FromItemId: fromItemID,
+		ToItemId:   toItemID,
+		Fields:     diffItemValues(fromValues, toValues),
+	}, nil
+}
+
+type fieldLanguage struct {
+	fieldName string
+	language  string
+}
+
+/*
+diffItemValues compares the values of two items field by field (and language by language). Values are compared as
+multisets, so that a mere change of the order (place) of the values of a field is not reported as a difference.
+Only fields with differences are returned, sorted by field name and language.
+*/
+func diffItemValues(from []datamodel.CurrentItemValue, to []datamodel.CurrentItemValue) []*itemspb.DiffVersionsResponse_FieldDiff {
+	group := func(values []datamodel.CurrentItemValue) map[fieldLanguage][]string {
+		grouped := make(map[fieldLanguage][]string)
+		for _, v := range values {
+			key := fieldLanguage{v.FieldName, v.Language.String}
+			grouped[key] = append(grouped[key], v.FieldValue)
+		}
+		return grouped
+	}
+	fromGrouped := group(from)
+	toGrouped := group(to)
+
+	keys := []fieldLanguage{}
+	for key := range fromGrouped {
+		keys = append(keys, key)
+	}
+	for key := range toGrouped {
+		if _, ok := fromGrouped[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].fieldName != keys[j].fieldName {
+			return keys[i].fieldName < keys[j].fieldName
+		}
+		return keys[i].language < keys[j].language
+	})
+
+	diffs := []*itemspb.DiffVersionsResponse_FieldDiff{}
+	for _, key := range keys {
+		removed := subtractValues(fromGrouped[key], toGrouped[key])
+		added := subtractValues(toGrouped[key], fromGrouped[key])
+		if len(removed) == 0 && len(added) == 0 {
+			continue
+		}
+		diffs = append(diffs, &itemspb.DiffVersionsResponse_FieldDiff{
+			FieldName: key.fieldName,
+			Language:  key.language,
+			Removed:   removed,
+			Added:     added,
+		})
+	}
+
+	return diffs
+}
+
+// subtractValues returns the values of a not contained in b, respecting multiplicities and keeping the order of a.
+func subtractValues(a []string, b []string) []string {
+	counts := make(map[string]int, len(b))
+	for _, v := range b {
+		counts[v]++
+	}
+
+	rest := []string{}
+	for _, v := range a {
+		if counts[v] > 0 {
+			counts[v]--
+			continue
+		}
+		rest = append(rest, v)
+	}
+
+	return rest
+}
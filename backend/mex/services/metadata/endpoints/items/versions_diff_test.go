@@ -0,0 +1,77 @@
+package items
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/datamodel"
+	itemspb "github.com/d4l-data4life/mex/mex/services/metadata/endpoints/items/pb"
+)
+
+func civ(fieldName string, fieldValue string, language string) datamodel.CurrentItemValue {
+	return datamodel.CurrentItemValue{
+		FieldName:  fieldName,
+		FieldValue: fieldValue,
+		Language:   pgtype.Text{String: language, Valid: language != ""},
+	}
+}
+
+func Test_diffItemValues(t *testing.T) {
+	tests := []struct {
+		name string
+		from []datamodel.CurrentItemValue
+		to   []datamodel.CurrentItemValue
+		want []*itemspb.DiffVersionsResponse_FieldDiff
+	}{
+		{
+			name: "Identical values yield no differences",
+			from: []datamodel.CurrentItemValue{civ("author", "Turing", ""), civ("title", "Numbers", "en")},
+			to:   []datamodel.CurrentItemValue{civ("author", "Turing", ""), civ("title", "Numbers", "en")},
+			want: []*itemspb.DiffVersionsResponse_FieldDiff{},
+		},
+		{
+			name: "A changed order of values is not a difference",
+			from: []datamodel.CurrentItemValue{civ("author", "Turing", ""), civ("author", "Church", "")},
+			to:   []datamodel.CurrentItemValue{civ("author", "Church", ""), civ("author", "Turing", "")},
+			want: []*itemspb.DiffVersionsResponse_FieldDiff{},
+		},
+		{
+			name: "Added, removed and replaced values are reported per field and language",
+			from: []datamodel.CurrentItemValue{
+				civ("author", "Turing", ""),
+				civ("title", "Numbers", "en"),
+				civ("title", "Zahlen", "de"),
+				civ("keyword", "logic", ""),
+			},
+			to: []datamodel.CurrentItemValue{
+				civ("author", "Turing", ""),
+				civ("author", "Church", ""),
+				civ("title", "Computable Numbers", "en"),
+				civ("title", "Zahlen", "de"),
+			},
+			want: []*itemspb.DiffVersionsResponse_FieldDiff{
+				{FieldName: "author", Added: []string{"Church"}, Removed: []string{}},
+				{FieldName: "keyword", Added: []string{}, Removed: []string{"logic"}},
+				{FieldName: "title", Language: "en", Added: []string{"Computable Numbers"}, Removed: []string{"Numbers"}},
+			},
+		},
+		{
+			name: "Multiplicities of values are respected",
+			from: []datamodel.CurrentItemValue{civ("keyword", "logic", ""), civ("keyword", "logic", "")},
+			to:   []datamodel.CurrentItemValue{civ("keyword", "logic", "")},
+			want: []*itemspb.DiffVersionsResponse_FieldDiff{
+				{FieldName: "keyword", Added: []string{}, Removed: []string{"logic"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := diffItemValues(tt.from, tt.to)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("diffItemValues() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
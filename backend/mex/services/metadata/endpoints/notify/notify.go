@@ -4,14 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
-	"time"
 
 	"github.com/go-redis/redis/v8"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/protobuf/encoding/protojson"
 
 	E "github.com/d4l-data4life/mex/mex/shared/errstat"
 	L "github.com/d4l-data4life/mex/mex/shared/log"
@@ -66,7 +62,7 @@ func (svc *Service) SendNotification(ctx context.Context, request *pbNotify.Send
 		return nil, err
 	}
 
-	mailTemplate, err := svc.retrieveTemplate(request.TemplateInfo.TemplateName)
+	mailTemplate, err := mail.RetrieveTemplate(svc.ConfigServiceOrigin, request.TemplateInfo.TemplateName)
 	if err != nil {
 		return nil, err
 	}
@@ -146,29 +142,3 @@ func (svc *Service) getItem(ctx context.Context, itemID string) (mail.Item, erro
 
 	return item, nil
 }
-
-func (svc *Service) retrieveTemplate(templateName string) (*mail.MailTemplate, error) {
-	client := &http.Client{Timeout: time.Second}
-	resp, err := client.Get(fmt.Sprintf("%s/api/v0/config/files/mail_templates/%s", svc.ConfigServiceOrigin, templateName))
-	if err != nil {
-		return nil, err
-	}
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("could not fetch template %q: status code %d", templateName, resp.StatusCode)
-	}
-
-	defer resp.Body.Close()
-
-	buf, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	mailTemplate := mail.MailTemplate{}
-	err = protojson.Unmarshal(buf, &mailTemplate)
-	if err != nil {
-		return nil, err
-	}
-
-	return &mailTemplate, nil
-}
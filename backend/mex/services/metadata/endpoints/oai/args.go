@@ -0,0 +1,230 @@
+package oai
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	pbOai "github.com/d4l-data4life/mex/mex/services/metadata/endpoints/oai/pb"
+)
+
+// OAI-PMH error codes
+const (
+	errBadArgument             = "badArgument"
+	errBadResumptionToken      = "badResumptionToken"
+	errBadVerb                 = "badVerb"
+	errCannotDisseminateFormat = "cannotDisseminateFormat"
+	errIDDoesNotExist          = "idDoesNotExist"
+	errNoRecordsMatch          = "noRecordsMatch"
+	errNoMetadataFormats       = "noMetadataFormats"
+)
+
+// protocolError is an error which is reported to the harvester inside the OAI-PMH response.
+type protocolError struct {
+	code    string
+	message string
+}
+
+func (e *protocolError) Error() string {
+	return fmt.Sprintf("%s: %s", e.code, e.message)
+}
+
+func newProtocolError(code string, format string, a ...any) *protocolError {
+	return &protocolError{code: code, message: fmt.Sprintf(format, a...)}
+}
+
+const (
+	argIdentifier      = "identifier"
+	argMetadataPrefix  = "metadataPrefix"
+	argFrom            = "from"
+	argUntil           = "until"
+	argSet             = "set"
+	argResumptionToken = "resumptionToken"
+)
+
+type verbArguments struct {
+	required  []string
+	optional  []string
+	exclusive string
+}
+
+var verbs = map[string]verbArguments{
+	"Identify":            {},
+	"ListMetadataFormats": {optional: []string{argIdentifier}},
+	"ListSets":            {exclusive: argResumptionToken},
+	"GetRecord":           {required: []string{argIdentifier, argMetadataPrefix}},
+	"ListIdentifiers":     {required: []string{argMetadataPrefix}, optional: []string{argFrom, argUntil, argSet}, exclusive: argResumptionToken},
+	"ListRecords":         {required: []string{argMetadataPrefix}, optional: []string{argFrom, argUntil, argSet}, exclusive: argResumptionToken},
+}
+
+func requestArguments(request *pbOai.OaiRequest) map[string]string {
+	args := map[string]string{
+		argIdentifier:      request.Identifier,
+		argMetadataPrefix:  request.MetadataPrefix,
+		argFrom:            request.From,
+		argUntil:           request.Until,
+		argSet:             request.Set,
+		argResumptionToken: request.ResumptionToken,
+	}
+	for name, value := range args {
+		if value == "" {
+			delete(args, name)
+		}
+	}
+	return args
+}
+
+// checkArguments verifies that the verb exists and that exactly the arguments allowed for it are given.
+func checkArguments(request *pbOai.OaiRequest) error {
+	allowed, ok := verbs[request.Verb]
+	if !ok {
+		if request.Verb == "" {
+			return newProtocolError(errBadVerb, "no verb given")
+		}
+		return newProtocolError(errBadVerb, "illegal verb: %s", request.Verb)
+	}
+
+	given := requestArguments(request)
+
+	if allowed.exclusive != "" {
+		if _, ok := given[allowed.exclusive]; ok {
+			if len(given) > 1 {
+				return newProtocolError(errBadArgument, "%s is an exclusive argument", allowed.exclusive)
+			}
+			return nil
+		}
+	}
+
+	for _, name := range allowed.required {
+		if _, ok := given[name]; !ok {
+			return newProtocolError(errBadArgument, "missing required argument: %s", name)
+		}
+		delete(given, name)
+	}
+	for _, name := range allowed.optional {
+		delete(given, name)
+	}
+	if len(given) > 0 {
+		illegal := make([]string, 0, len(given))
+		for name := range given {
+			illegal = append(illegal, name)
+		}
+		sort.Strings(illegal)
+		return newProtocolError(errBadArgument, "illegal arguments for verb %s: %s", request.Verb, strings.Join(illegal, ", "))
+	}
+
+	return nil
+}
+
+// harvestArgs are the arguments of a list request, either given explicitly or restored from a resumption token.
+type harvestArgs struct {
+	MetadataPrefix string    `json:"p"`
+	Set            string    `json:"s,omitempty"`
+	From           time.Time `json:"f"`
+	Until          time.Time `json:"u"` // exclusive upper bound of the datestamps
+
+	// Position of the next page
+	AfterEntityName string `json:"e,omitempty"`
+	AfterBusinessID string `json:"b,omitempty"`
+	Cursor          int    `json:"c,omitempty"`
+}
+
+func parseHarvestArgs(request *pbOai.OaiRequest) (harvestArgs, error) {
+	if request.ResumptionToken != "" {
+		return decodeResumptionToken(request.ResumptionToken)
+	}
+
+	args := harvestArgs{
+		MetadataPrefix: request.MetadataPrefix,
+		Set:            request.Set,
+	}
+
+	var fromGranularity, untilGranularity string
+	var err error
+	if request.From != "" {
+		args.From, fromGranularity, err = parseDatestamp(request.From)
+		if err != nil {
+			return harvestArgs{}, newProtocolError(errBadArgument, "invalid from argument: %s", request.From)
+		}
+	}
+	if request.Until != "" {
+		args.Until, untilGranularity, err = parseDatestamp(request.Until)
+		if err != nil {
+			return harvestArgs{}, newProtocolError(errBadArgument, "invalid until argument: %s", request.Until)
+		}
+		// The until argument is inclusive, down to the granularity it is given in.
+		if untilGranularity == dayLayout {
+			args.Until = args.Until.AddDate(0, 0, 1)
+		} else {
+			args.Until = args.Until.Add(time.Second)
+		}
+	}
+
+	if fromGranularity != "" && untilGranularity != "" {
+		if fromGranularity != untilGranularity {
+			return harvestArgs{}, newProtocolError(errBadArgument, "from and until arguments have different granularities")
+		}
+		if !args.From.Before(args.Until) {
+			return harvestArgs{}, newProtocolError(errBadArgument, "from argument is later than until argument")
+		}
+	}
+
+	return args, nil
+}
+
+// parseDatestamp parses a datestamp in either of the granularities of OAI-PMH and returns the layout it was given in.
+func parseDatestamp(s string) (time.Time, string, error) {
+	for _, layout := range []string{datestampLayout, dayLayout} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, layout, nil
+		}
+	}
+	return time.Time{}, "", fmt.Errorf("invalid datestamp: %s", s)
+}
+
+func formatDatestamp(t time.Time) string {
+	return t.UTC().Format(datestampLayout)
+}
+
+func encodeResumptionToken(args harvestArgs) string {
+	data, err := json.Marshal(args)
+	if err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeResumptionToken(token string) (harvestArgs, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return harvestArgs{}, newProtocolError(errBadResumptionToken, "malformed resumption token")
+	}
+
+	var args harvestArgs
+	if err := json.Unmarshal(data, &args); err != nil || args.MetadataPrefix == "" {
+		return harvestArgs{}, newProtocolError(errBadResumptionToken, "malformed resumption token")
+	}
+	return args, nil
+}
+
+// recordIdentifier returns the OAI identifier of a record: oai:<repository identifier>:<entity type>:<business ID>
+func recordIdentifier(repositoryIdentifier string, entityName string, businessID string) string {
+	return fmt.Sprintf("oai:%s:%s:%s", repositoryIdentifier, entityName, businessID)
+}
+
+// parseRecordIdentifier is the inverse of recordIdentifier. Business IDs may contain colons, entity type names cannot.
+func parseRecordIdentifier(repositoryIdentifier string, identifier string) (string, string, error) {
+	prefix := fmt.Sprintf("oai:%s:", repositoryIdentifier)
+	if !strings.HasPrefix(identifier, prefix) {
+		return "", "", newProtocolError(errIDDoesNotExist, "unknown identifier: %s", identifier)
+	}
+
+	entityName, businessID, ok := strings.Cut(strings.TrimPrefix(identifier, prefix), ":")
+	if !ok || entityName == "" || businessID == "" {
+		return "", "", newProtocolError(errIDDoesNotExist, "unknown identifier: %s", identifier)
+	}
+	return entityName, businessID, nil
+}
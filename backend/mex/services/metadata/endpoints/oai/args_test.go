@@ -0,0 +1,191 @@
+package oai
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	pbOai "github.com/d4l-data4life/mex/mex/services/metadata/endpoints/oai/pb"
+)
+
+func errorCode(err error) string {
+	var protocolErr *protocolError
+	if errors.As(err, &protocolErr) {
+		return protocolErr.code
+	}
+	if err != nil {
+		return err.Error()
+	}
+	return ""
+}
+
+func Test_checkArguments(t *testing.T) {
+	tests := []struct {
+		name    string
+		request *pbOai.OaiRequest
+		want    string
+	}{
+		{
+			name:    "Missing verb",
+			request: &pbOai.OaiRequest{},
+			want:    errBadVerb,
+		},
+		{
+			name:    "Unknown verb",
+			request: &pbOai.OaiRequest{Verb: "ListEverything"},
+			want:    errBadVerb,
+		},
+		{
+			name:    "Identify without arguments",
+			request: &pbOai.OaiRequest{Verb: "Identify"},
+		},
+		{
+			name:    "Identify with arguments",
+			request: &pbOai.OaiRequest{Verb: "Identify", Set: "Resource"},
+			want:    errBadArgument,
+		},
+		{
+			name:    "GetRecord without metadata prefix",
+			request: &pbOai.OaiRequest{Verb: "GetRecord", Identifier: "oai:mex:Resource:r1"},
+			want:    errBadArgument,
+		},
+		{
+			name:    "ListRecords with optional arguments",
+			request: &pbOai.OaiRequest{Verb: "ListRecords", MetadataPrefix: "oai_dc", From: "2021-01-01", Set: "Resource"},
+		},
+		{
+			name:    "ListRecords with resumption token only",
+			request: &pbOai.OaiRequest{Verb: "ListRecords", ResumptionToken: "abc"},
+		},
+		{
+			name:    "ListRecords with resumption token and other arguments",
+			request: &pbOai.OaiRequest{Verb: "ListRecords", ResumptionToken: "abc", MetadataPrefix: "oai_dc"},
+			want:    errBadArgument,
+		},
+		{
+			name:    "ListSets with unknown argument",
+			request: &pbOai.OaiRequest{Verb: "ListSets", Identifier: "oai:mex:Resource:r1"},
+			want:    errBadArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errorCode(checkArguments(tt.request)); got != tt.want {
+				t.Errorf("checkArguments() error = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_parseHarvestArgs(t *testing.T) {
+	resumed := harvestArgs{
+		MetadataPrefix:  "oai_dc",
+		Set:             "Resource",
+		From:            time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		AfterEntityName: "Resource",
+		AfterBusinessID: "r100",
+		Cursor:          100,
+	}
+
+	tests := []struct {
+		name    string
+		request *pbOai.OaiRequest
+		want    harvestArgs
+		wantErr string
+	}{
+		{
+			name:    "Day granularity includes the whole until day",
+			request: &pbOai.OaiRequest{MetadataPrefix: "oai_dc", From: "2021-01-01", Until: "2021-01-31"},
+			want: harvestArgs{
+				MetadataPrefix: "oai_dc",
+				From:           time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				Until:          time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:    "Seconds granularity includes the until second",
+			request: &pbOai.OaiRequest{MetadataPrefix: "oai_dc", Until: "2021-01-31T12:00:00Z"},
+			want: harvestArgs{
+				MetadataPrefix: "oai_dc",
+				Until:          time.Date(2021, 1, 31, 12, 0, 1, 0, time.UTC),
+			},
+		},
+		{
+			name:    "Different granularities",
+			request: &pbOai.OaiRequest{MetadataPrefix: "oai_dc", From: "2021-01-01", Until: "2021-01-31T12:00:00Z"},
+			wantErr: errBadArgument,
+		},
+		{
+			name:    "From after until",
+			request: &pbOai.OaiRequest{MetadataPrefix: "oai_dc", From: "2021-02-01", Until: "2021-01-31"},
+			wantErr: errBadArgument,
+		},
+		{
+			name:    "Malformed datestamp",
+			request: &pbOai.OaiRequest{MetadataPrefix: "oai_dc", From: "01.01.2021"},
+			wantErr: errBadArgument,
+		},
+		{
+			name:    "Resumption token",
+			request: &pbOai.OaiRequest{ResumptionToken: encodeResumptionToken(resumed)},
+			want:    resumed,
+		},
+		{
+			name:    "Malformed resumption token",
+			request: &pbOai.OaiRequest{ResumptionToken: "not a token"},
+			wantErr: errBadResumptionToken,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseHarvestArgs(tt.request)
+			if errorCode(err) != tt.wantErr {
+				t.Errorf("parseHarvestArgs() error = %v, want %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseHarvestArgs() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_parseRecordIdentifier(t *testing.T) {
+	tests := []struct {
+		name           string
+		identifier     string
+		wantEntityName string
+		wantBusinessID string
+		wantErr        string
+	}{
+		{
+			name:           "Business ID with colons",
+			identifier:     recordIdentifier("mex", "Resource", "urn:x:1"),
+			wantEntityName: "Resource",
+			wantBusinessID: "urn:x:1",
+		},
+		{
+			name:       "Other repository",
+			identifier: "oai:other:Resource:r1",
+			wantErr:    errIDDoesNotExist,
+		},
+		{
+			name:       "Missing business ID",
+			identifier: "oai:mex:Resource",
+			wantErr:    errIDDoesNotExist,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entityName, businessID, err := parseRecordIdentifier("mex", tt.identifier)
+			if errorCode(err) != tt.wantErr {
+				t.Errorf("parseRecordIdentifier() error = %v, want %v", err, tt.wantErr)
+				return
+			}
+			if entityName != tt.wantEntityName || businessID != tt.wantBusinessID {
+				t.Errorf("parseRecordIdentifier() got = %v, %v, want %v, %v", entityName, businessID, tt.wantEntityName, tt.wantBusinessID)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package oai
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/d4l-data4life/mex/mex/shared/auth"
+	"github.com/d4l-data4life/mex/mex/shared/entities"
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/fields"
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/visibility"
+	pbOai "github.com/d4l-data4life/mex/mex/services/metadata/endpoints/oai/pb"
+)
+
+const (
+	protocolVersion  = "2.0"
+	granularity      = "YYYY-MM-DDThh:mm:ssZ"
+	datestampLayout  = "2006-01-02T15:04:05Z"
+	dayLayout        = "2006-01-02"
+	deletedRecord    = "persistent"
+	contentTypeXML   = "text/xml; charset=utf-8"
+	metadataPrefixDC = "oai_dc"
+
+	defaultPageSize = 100
+)
+
+type Service struct {
+	Log L.Logger
+
+	DB *pgxpool.Pool
+
+	EntityRepo     entities.EntityRepo
+	VocabularyRepo fields.VocabularyRepo
+
+	// If any visibility rules are configured, harvesters only see the items matching the rules of the harvester groups.
+	Visibility      *visibility.Resolver
+	VisibilityRules *auth.VisibilityRules
+	HarvesterGroups []string
+
+	Enabled              bool
+	RepositoryName       string
+	BaseURL              string
+	RepositoryIdentifier string
+	AdminEmails          []string
+	PageSize             int
+
+	pbOai.UnimplementedOaiServer
+}
+
+/*
+harvesterClaims returns the claims OAI-PMH requests are answered with. Harvesters are anonymous: without visibility
+rules, they see all records; otherwise, they are restricted to the rules of the harvester groups (and see nothing if
+none of these groups has a rule).
+*/
+func (svc *Service) harvesterClaims() *auth.Claims {
+	groupRules := svc.VisibilityRules.GetGroupRules()
+	if len(groupRules) == 0 {
+		return nil
+	}
+
+	claims := &auth.Claims{VisibilityRestricted: true}
+	for _, group := range svc.HarvesterGroups {
+		if rule, ok := groupRules[group]; ok {
+			claims.Visibility = append(claims.Visibility, rule)
+		}
+	}
+	return claims
+}
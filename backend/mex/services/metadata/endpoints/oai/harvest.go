@@ -0,0 +1,379 @@
+package oai
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	E "github.com/d4l-data4life/mex/mex/shared/errstat"
+	sharedFields "github.com/d4l-data4life/mex/mex/shared/fields"
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+	"github.com/d4l-data4life/mex/mex/shared/utils"
+
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/datamodel"
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/fields/vrepo"
+	pbOai "github.com/d4l-data4life/mex/mex/services/metadata/endpoints/oai/pb"
+)
+
+/*
+Harvest answers an OAI-PMH request. The records are the latest versions of the items of the focal entity types which
+have a business ID; the sets are the focal entity types. Deleted records are reported until the business ID is used
+again, see the oai_records table. Harvesters only see the records visible to the harvester groups, see harvesterClaims.
+
+Protocol errors (bad arguments, unknown identifiers, empty lists, ...) are part of a regular OAI-PMH response, only
+technical failures are returned as gRPC errors.
+*/
+func (svc *Service) Harvest(ctx context.Context, request *pbOai.OaiRequest) (*httpbody.HttpBody, error) {
+	if !svc.Enabled {
+		return nil, E.MakeGRPCStatus(codes.Unimplemented, "OAI-PMH is not enabled").Err()
+	}
+
+	response := newOaiPMH()
+	response.ResponseDate = formatDatestamp(time.Now())
+	response.Request = oaiRequest{
+		Verb:            request.Verb,
+		Identifier:      request.Identifier,
+		MetadataPrefix:  request.MetadataPrefix,
+		From:            request.From,
+		Until:           request.Until,
+		Set:             request.Set,
+		ResumptionToken: request.ResumptionToken,
+		BaseURL:         svc.BaseURL,
+	}
+
+	err := checkArguments(request)
+	if err == nil {
+		err = svc.handleVerb(ctx, request, response)
+	}
+
+	var protocolErr *protocolError
+	if errors.As(err, &protocolErr) {
+		// The request attributes must only be echoed if the request was syntactically correct.
+		if protocolErr.code == errBadVerb || protocolErr.code == errBadArgument {
+			response.Request = oaiRequest{BaseURL: svc.BaseURL}
+		}
+		response.Errors = []oaiError{{Code: protocolErr.code, Message: protocolErr.message}}
+	} else if err != nil {
+		svc.Log.Error(ctx, L.Messagef("OAI-PMH request failed: %s", err.Error()))
+		return nil, err
+	}
+
+	data, err := xml.MarshalIndent(response, "", "  ")
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to serialize OAI-PMH response: %s", err.Error()))
+	}
+
+	return &httpbody.HttpBody{
+		ContentType: contentTypeXML,
+		Data:        append([]byte(xml.Header), data...),
+	}, nil
+}
+
+func (svc *Service) handleVerb(ctx context.Context, request *pbOai.OaiRequest, response *oaiPMH) error {
+	var err error
+	switch request.Verb {
+	case "Identify":
+		response.Identify, err = svc.identify(ctx)
+	case "ListMetadataFormats":
+		response.Formats, err = svc.listMetadataFormats(ctx, request.Identifier)
+	case "ListSets":
+		response.Sets, err = svc.listSets(ctx, request.ResumptionToken)
+	case "GetRecord":
+		response.Record, err = svc.getRecord(ctx, request.Identifier, request.MetadataPrefix)
+	case "ListIdentifiers", "ListRecords":
+		args, argsErr := parseHarvestArgs(request)
+		if argsErr != nil {
+			return argsErr
+		}
+		records, token, listErr := svc.listRecords(ctx, args, request.Verb == "ListRecords")
+		if listErr != nil {
+			return listErr
+		}
+		if request.Verb == "ListRecords" {
+			response.Records = &listRecords{Records: records, ResumptionToken: token}
+		} else {
+			headers := make([]header, len(records))
+			for i, r := range records {
+				headers[i] = r.Header
+			}
+			response.Identifiers = &listHeaders{Headers: headers, ResumptionToken: token}
+		}
+	}
+	return err
+}
+
+func (svc *Service) identify(ctx context.Context) (*identify, error) {
+	entityNames, err := svc.EntityRepo.GetEntityTypeNames(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	queries := datamodel.New(svc.DB)
+	visibility, err := svc.Visibility.Resolve(ctx, queries, svc.harvesterClaims())
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to resolve visibility rules: %s", err.Error()))
+	}
+
+	earliest, err := queries.DbOaiEarliestDatestamp(ctx, datamodel.DbOaiEarliestDatestampParams{
+		EntityNames: entityNames,
+		Visibility:  visibility,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to determine earliest datestamp: %s", err.Error()))
+	}
+	if !earliest.Valid {
+		earliest.Time = time.Unix(0, 0)
+	}
+
+	return &identify{
+		RepositoryName:    svc.RepositoryName,
+		BaseURL:           svc.BaseURL,
+		ProtocolVersion:   protocolVersion,
+		AdminEmails:       svc.AdminEmails,
+		EarliestDatestamp: formatDatestamp(earliest.Time),
+		DeletedRecord:     deletedRecord,
+		Granularity:       granularity,
+	}, nil
+}
+
+func (svc *Service) listMetadataFormats(ctx context.Context, identifier string) (*listFormats, error) {
+	if identifier != "" {
+		if _, err := svc.lookupRecord(ctx, identifier); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := svc.dublinCoreMappings(ctx); err != nil {
+		var protocolErr *protocolError
+		if errors.As(err, &protocolErr) {
+			return nil, newProtocolError(errNoMetadataFormats, "no metadata formats available")
+		}
+		return nil, err
+	}
+
+	return &listFormats{
+		Formats: []metadataFormat{{
+			MetadataPrefix:    metadataPrefixDC,
+			Schema:            schemaOAIDC,
+			MetadataNamespace: namespaceOAIDC,
+		}},
+	}, nil
+}
+
+func (svc *Service) listSets(ctx context.Context, token string) (*listSets, error) {
+	// All sets are returned at once, hence there are no resumption tokens to continue with.
+	if token != "" {
+		return nil, newProtocolError(errBadResumptionToken, "unknown resumption token")
+	}
+
+	entityNames, err := svc.EntityRepo.GetEntityTypeNames(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	sets := make([]set, len(entityNames))
+	for i, name := range entityNames {
+		sets[i] = set{SetSpec: name, SetName: name}
+	}
+	return &listSets{Sets: sets}, nil
+}
+
+func (svc *Service) getRecord(ctx context.Context, identifier string, metadataPrefix string) (*getRecord, error) {
+	mappings, err := svc.metadataFormat(ctx, metadataPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := svc.lookupRecord(ctx, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := svc.makeRecord(ctx, datamodel.New(svc.DB), row, mappings)
+	if err != nil {
+		return nil, err
+	}
+	return &getRecord{Record: *r}, nil
+}
+
+/*
+listRecords returns one page of records, ordered by entity type and business ID. If there are more records, a
+resumption token pointing after the last returned record is returned as well. Metadata is only added if requested.
+*/
+func (svc *Service) listRecords(ctx context.Context, args harvestArgs, withMetadata bool) ([]record, *resumptionToken, error) {
+	mappings, err := svc.metadataFormat(ctx, args.MetadataPrefix)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	entityNames, err := svc.EntityRepo.GetEntityTypeNames(ctx, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	if args.Set != "" {
+		if !utils.Contains(entityNames, args.Set) {
+			return nil, nil, newProtocolError(errNoRecordsMatch, "unknown set: %s", args.Set)
+		}
+		entityNames = []string{args.Set}
+	}
+
+	pageSize := svc.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	queries := datamodel.New(svc.DB)
+	visibility, err := svc.Visibility.Resolve(ctx, queries, svc.harvesterClaims())
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, fmt.Sprintf("failed to resolve visibility rules: %s", err.Error()))
+	}
+
+	rows, err := queries.DbOaiListRecords(ctx, datamodel.DbOaiListRecordsParams{
+		EntityNames:     entityNames,
+		AfterEntityName: args.AfterEntityName,
+		AfterBusinessID: args.AfterBusinessID,
+		FromTime:        optionalTimestamp(args.From),
+		UntilTime:       optionalTimestamp(args.Until),
+		Visibility:      visibility,
+		MaxRecords:      int32(pageSize + 1),
+	})
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, fmt.Sprintf("failed to list records: %s", err.Error()))
+	}
+	if len(rows) == 0 {
+		return nil, nil, newProtocolError(errNoRecordsMatch, "no records match the request")
+	}
+
+	size := len(rows)
+	if size > pageSize {
+		size = pageSize
+	}
+
+	records := make([]record, size)
+	for i, row := range rows[:size] {
+		if !withMetadata {
+			records[i] = record{Header: svc.makeHeader(row)}
+			continue
+		}
+		r, recordErr := svc.makeRecord(ctx, queries, row, mappings)
+		if recordErr != nil {
+			return nil, nil, recordErr
+		}
+		records[i] = *r
+	}
+
+	// The last page of a list which was split is marked with an empty token.
+	var token *resumptionToken
+	if len(rows) > pageSize {
+		next := args
+		next.AfterEntityName = rows[size-1].EntityName
+		next.AfterBusinessID = rows[size-1].BusinessID
+		next.Cursor = args.Cursor + size
+		token = &resumptionToken{Cursor: args.Cursor, Token: encodeResumptionToken(next)}
+	} else if args.Cursor > 0 {
+		token = &resumptionToken{Cursor: args.Cursor}
+	}
+
+	return records, token, nil
+}
+
+// lookupRecord returns the record with the given OAI identifier, provided it belongs to a focal entity type.
+func (svc *Service) lookupRecord(ctx context.Context, identifier string) (datamodel.OaiRecord, error) {
+	entityName, businessID, err := parseRecordIdentifier(svc.RepositoryIdentifier, identifier)
+	if err != nil {
+		return datamodel.OaiRecord{}, err
+	}
+
+	entityNames, err := svc.EntityRepo.GetEntityTypeNames(ctx, true)
+	if err != nil {
+		return datamodel.OaiRecord{}, err
+	}
+	if !utils.Contains(entityNames, entityName) {
+		return datamodel.OaiRecord{}, newProtocolError(errIDDoesNotExist, "unknown identifier: %s", identifier)
+	}
+
+	queries := datamodel.New(svc.DB)
+	visibility, err := svc.Visibility.Resolve(ctx, queries, svc.harvesterClaims())
+	if err != nil {
+		return datamodel.OaiRecord{}, status.Error(codes.Internal, fmt.Sprintf("failed to resolve visibility rules: %s", err.Error()))
+	}
+
+	// Records the harvesters must not see are reported as unknown.
+	row, err := queries.DbOaiGetRecord(ctx, datamodel.DbOaiGetRecordParams{
+		EntityName: entityName,
+		BusinessID: businessID,
+		Visibility: visibility,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return datamodel.OaiRecord{}, newProtocolError(errIDDoesNotExist, "unknown identifier: %s", identifier)
+		}
+		return datamodel.OaiRecord{}, status.Error(codes.Internal, fmt.Sprintf("failed to fetch record: %s", err.Error()))
+	}
+	return row, nil
+}
+
+func (svc *Service) makeHeader(row datamodel.OaiRecord) header {
+	h := header{
+		Identifier: recordIdentifier(svc.RepositoryIdentifier, row.EntityName, row.BusinessID),
+		Datestamp:  formatDatestamp(row.Datestamp.Time),
+		SetSpecs:   []string{row.EntityName},
+	}
+	if row.Deleted {
+		h.Status = statusDeleted
+	}
+	return h
+}
+
+func (svc *Service) makeRecord(ctx context.Context, queries *datamodel.Queries, row datamodel.OaiRecord, mappings []*sharedFields.VocabularyMapping_DublinCoreMapping) (*record, error) {
+	r := record{Header: svc.makeHeader(row)}
+	if row.Deleted {
+		return &r, nil
+	}
+
+	values, err := queries.DbGetItemValues(ctx, row.ItemID.String)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to fetch item values: %s", err.Error()))
+	}
+
+	r.Metadata, err = dublinCoreMetadata(mappings, values)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return &r, nil
+}
+
+// metadataFormat checks that the requested metadata format can be disseminated and returns the Dublin Core mapping.
+func (svc *Service) metadataFormat(ctx context.Context, metadataPrefix string) ([]*sharedFields.VocabularyMapping_DublinCoreMapping, error) {
+	if metadataPrefix != metadataPrefixDC {
+		return nil, newProtocolError(errCannotDisseminateFormat, "unsupported metadata format: %s", metadataPrefix)
+	}
+	return svc.dublinCoreMappings(ctx)
+}
+
+// dublinCoreMappings returns the configured Dublin Core mapping; without one, no metadata format is available.
+func (svc *Service) dublinCoreMappings(ctx context.Context) ([]*sharedFields.VocabularyMapping_DublinCoreMapping, error) {
+	mapping, err := svc.VocabularyRepo.GetVocabularyMapping(ctx)
+	if err != nil {
+		if errors.Is(err, vrepo.ErrNoVocabularyMapping) {
+			return nil, newProtocolError(errCannotDisseminateFormat, "no Dublin Core mapping configured")
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to load vocabulary mapping: %s", err.Error()))
+	}
+	if len(mapping.DublinCore) == 0 {
+		return nil, newProtocolError(errCannotDisseminateFormat, "no Dublin Core mapping configured")
+	}
+	return mapping.DublinCore, nil
+}
+
+func optionalTimestamp(t time.Time) pgtype.Timestamptz {
+	return pgtype.Timestamptz{Time: t, Valid: !t.IsZero()}
+}
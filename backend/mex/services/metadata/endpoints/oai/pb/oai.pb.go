@@ -0,0 +1,261 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.22.0
+// source: services/metadata/endpoints/oai/oai.proto
+
+package pbOai
+
+import (
+	_ "github.com/d4l-data4life/mex/mex/shared/known/securitypb"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The arguments of an OAI-PMH request, see http://www.openarchives.org/OAI/openarchivesprotocol.html.
+// The JSON names are the OAI-PMH argument names.
+type OaiRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Verb            string `protobuf:"bytes,1,opt,name=verb,proto3" json:"verb,omitempty"`
+	Identifier      string `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	MetadataPrefix  string `protobuf:"bytes,3,opt,name=metadata_prefix,json=metadataPrefix,proto3" json:"metadata_prefix,omitempty"`
+	From            string `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	Until           string `protobuf:"bytes,5,opt,name=until,proto3" json:"until,omitempty"`
+	Set             string `protobuf:"bytes,6,opt,name=set,proto3" json:"set,omitempty"`
+	ResumptionToken string `protobuf:"bytes,7,opt,name=resumption_token,json=resumptionToken,proto3" json:"resumption_token,omitempty"`
+}
+
+func (x *OaiRequest) Reset() {
+	*x = OaiRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_metadata_endpoints_oai_oai_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OaiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OaiRequest) ProtoMessage() {}
+
+func (x *OaiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_metadata_endpoints_oai_oai_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OaiRequest.ProtoReflect.Descriptor instead.
+func (*OaiRequest) Descriptor() ([]byte, []int) {
+	return file_services_metadata_endpoints_oai_oai_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OaiRequest) GetVerb() string {
+	if x != nil {
+		return x.Verb
+	}
+	return ""
+}
+
+func (x *OaiRequest) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *OaiRequest) GetMetadataPrefix() string {
+	if x != nil {
+		return x.MetadataPrefix
+	}
+	return ""
+}
+
+func (x *OaiRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *OaiRequest) GetUntil() string {
+	if x != nil {
+		return x.Until
+	}
+	return ""
+}
+
+func (x *OaiRequest) GetSet() string {
+	if x != nil {
+		return x.Set
+	}
+	return ""
+}
+
+func (x *OaiRequest) GetResumptionToken() string {
+	if x != nil {
+		return x.ResumptionToken
+	}
+	return ""
+}
+
+var File_services_metadata_endpoints_oai_oai_proto protoreflect.FileDescriptor
+
+var file_services_metadata_endpoints_oai_oai_proto_rawDesc = []byte{
+	0x0a, 0x29, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x2f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2f, 0x6f, 0x61,
+	0x69, 0x2f, 0x6f, 0x61, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x64, 0x34, 0x6c,
+	0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x6f, 0x61, 0x69, 0x1a, 0x12, 0x64, 0x34, 0x6c, 0x2f, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x74, 0x74, 0x70, 0x62, 0x6f, 0x64, 0x79, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65,
+	0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd0, 0x01, 0x0a, 0x0a, 0x4f, 0x61, 0x69, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x65, 0x72, 0x62, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x76, 0x65, 0x72, 0x62, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x50, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x73,
+	0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x65, 0x74, 0x12, 0x29, 0x0a,
+	0x10, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x32, 0xdc, 0x04, 0x0a, 0x03, 0x4f, 0x61, 0x69,
+	0x12, 0xd4, 0x04, 0x0a, 0x07, 0x48, 0x61, 0x72, 0x76, 0x65, 0x73, 0x74, 0x12, 0x17, 0x2e, 0x64,
+	0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x6f, 0x61, 0x69, 0x2e, 0x4f, 0x61, 0x69, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x22, 0x99, 0x04, 0x92, 0x41,
+	0xf5, 0x03, 0x0a, 0x03, 0x6f, 0x61, 0x69, 0x12, 0x30, 0x4f, 0x41, 0x49, 0x2d, 0x50, 0x4d, 0x48,
+	0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x68, 0x61,
+	0x72, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x6f, 0x63,
+	0x61, 0x6c, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x1a, 0xbb, 0x03, 0x49, 0x6d, 0x70, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x4f, 0x41, 0x49, 0x2d, 0x50,
+	0x4d, 0x48, 0x20, 0x32, 0x2e, 0x30, 0x20, 0x76, 0x65, 0x72, 0x62, 0x73, 0x20, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x79, 0x2c, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x2c, 0x20, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x65, 0x74, 0x73, 0x2c, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x73, 0x2c, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x2e, 0x20, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x6f, 0x63, 0x61, 0x6c, 0x20, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x61, 0x20, 0x62, 0x75, 0x73, 0x69,
+	0x6e, 0x65, 0x73, 0x73, 0x20, 0x49, 0x44, 0x2c, 0x20, 0x73, 0x65, 0x74, 0x73, 0x20, 0x61, 0x72,
+	0x65, 0x20, 0x74, 0x68, 0x65, 0x69, 0x72, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2c, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x20, 0x69, 0x73, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x20, 0x61,
+	0x73, 0x20, 0x44, 0x75, 0x62, 0x6c, 0x69, 0x6e, 0x20, 0x43, 0x6f, 0x72, 0x65, 0x20, 0x28, 0x6f,
+	0x61, 0x69, 0x5f, 0x64, 0x63, 0x29, 0x20, 0x75, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x20, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x20,
+	0x6e, 0x65, 0x78, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x20, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x20, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x20, 0x61,
+	0x72, 0x65, 0x20, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x58, 0x4d, 0x4c, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20,
+	0x61, 0x73, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x4f,
+	0x41, 0x49, 0x2d, 0x50, 0x4d, 0x48, 0x2e, 0x20, 0x4f, 0x6e, 0x6c, 0x79, 0x20, 0x61, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x20, 0x69, 0x66, 0x20, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x98, 0xf1, 0x04, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x16, 0x12, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x30, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x2f, 0x6f, 0x61, 0x69, 0x42, 0x4b, 0x5a, 0x49, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x34, 0x6c, 0x2d, 0x64, 0x61, 0x74, 0x61, 0x34, 0x6c,
+	0x69, 0x66, 0x65, 0x2f, 0x6d, 0x65, 0x78, 0x2f, 0x6d, 0x65, 0x78, 0x2f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x65, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2f, 0x6f, 0x61, 0x69, 0x2f, 0x70, 0x62, 0x3b, 0x70,
+	0x62, 0x4f, 0x61, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_services_metadata_endpoints_oai_oai_proto_rawDescOnce sync.Once
+	file_services_metadata_endpoints_oai_oai_proto_rawDescData = file_services_metadata_endpoints_oai_oai_proto_rawDesc
+)
+
+func file_services_metadata_endpoints_oai_oai_proto_rawDescGZIP() []byte {
+	file_services_metadata_endpoints_oai_oai_proto_rawDescOnce.Do(func() {
+		file_services_metadata_endpoints_oai_oai_proto_rawDescData = protoimpl.X.CompressGZIP(file_services_metadata_endpoints_oai_oai_proto_rawDescData)
+	})
+	return file_services_metadata_endpoints_oai_oai_proto_rawDescData
+}
+
+var file_services_metadata_endpoints_oai_oai_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_services_metadata_endpoints_oai_oai_proto_goTypes = []interface{}{
+	(*OaiRequest)(nil),        // 0: d4l.mex.oai.OaiRequest
+	(*httpbody.HttpBody)(nil), // 1: google.api.HttpBody
+}
+var file_services_metadata_endpoints_oai_oai_proto_depIdxs = []int32{
+	0, // 0: d4l.mex.oai.Oai.Harvest:input_type -> d4l.mex.oai.OaiRequest
+	1, // 1: d4l.mex.oai.Oai.Harvest:output_type -> google.api.HttpBody
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_services_metadata_endpoints_oai_oai_proto_init() }
+func file_services_metadata_endpoints_oai_oai_proto_init() {
+	if File_services_metadata_endpoints_oai_oai_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_services_metadata_endpoints_oai_oai_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OaiRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_services_metadata_endpoints_oai_oai_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_services_metadata_endpoints_oai_oai_proto_goTypes,
+		DependencyIndexes: file_services_metadata_endpoints_oai_oai_proto_depIdxs,
+		MessageInfos:      file_services_metadata_endpoints_oai_oai_proto_msgTypes,
+	}.Build()
+	File_services_metadata_endpoints_oai_oai_proto = out.File
+	file_services_metadata_endpoints_oai_oai_proto_rawDesc = nil
+	file_services_metadata_endpoints_oai_oai_proto_goTypes = nil
+	file_services_metadata_endpoints_oai_oai_proto_depIdxs = nil
+}
@@ -0,0 +1,110 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.22.0
+// source: services/metadata/endpoints/oai/oai.proto
+
+package pbOai
+
+import (
+	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Oai_Harvest_FullMethodName = "/d4l.mex.oai.Oai/Harvest"
+)
+
+// OaiClient is the client API for Oai service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OaiClient interface {
+	Harvest(ctx context.Context, in *OaiRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+}
+
+type oaiClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOaiClient(cc grpc.ClientConnInterface) OaiClient {
+	return &oaiClient{cc}
+}
+
+func (c *oaiClient) Harvest(ctx context.Context, in *OaiRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, Oai_Harvest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OaiServer is the server API for Oai service.
+// All implementations must embed UnimplementedOaiServer
+// for forward compatibility
+type OaiServer interface {
+	Harvest(context.Context, *OaiRequest) (*httpbody.HttpBody, error)
+	mustEmbedUnimplementedOaiServer()
+}
+
+// UnimplementedOaiServer must be embedded to have forward compatible implementations.
+type UnimplementedOaiServer struct {
+}
+
+func (UnimplementedOaiServer) Harvest(context.Context, *OaiRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Harvest not implemented")
+}
+func (UnimplementedOaiServer) mustEmbedUnimplementedOaiServer() {}
+
+// UnsafeOaiServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OaiServer will
+// result in compilation errors.
+type UnsafeOaiServer interface {
+	mustEmbedUnimplementedOaiServer()
+}
+
+func RegisterOaiServer(s grpc.ServiceRegistrar, srv OaiServer) {
+	s.RegisterService(&Oai_ServiceDesc, srv)
+}
+
+func _Oai_Harvest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OaiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OaiServer).Harvest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Oai_Harvest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OaiServer).Harvest(ctx, req.(*OaiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Oai_ServiceDesc is the grpc.ServiceDesc for Oai service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Oai_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "d4l.mex.oai.Oai",
+	HandlerType: (*OaiServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Harvest",
+			Handler:    _Oai_Harvest_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "services/metadata/endpoints/oai/oai.proto",
+}
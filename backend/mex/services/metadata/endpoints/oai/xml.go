@@ -0,0 +1,182 @@
+package oai
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	sharedFields "github.com/d4l-data4life/mex/mex/shared/fields"
+
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/datamodel"
+)
+
+const (
+	namespaceOAI    = "http://www.openarchives.org/OAI/2.0/"
+	namespaceXSI    = "http://www.w3.org/2001/XMLSchema-instance"
+	namespaceOAIDC  = "http://www.openarchives.org/OAI/2.0/oai_dc/"
+	namespaceDC     = "http://purl.org/dc/elements/1.1/"
+	schemaOAI       = "http://www.openarchives.org/OAI/2.0/OAI-PMH.xsd"
+	schemaOAIDC     = "http://www.openarchives.org/OAI/2.0/oai_dc.xsd"
+	statusDeleted   = "deleted"
+	dcElementPrefix = "dc:"
+)
+
+// The 15 elements of the Dublin Core Metadata Element Set, version 1.1
+var dublinCoreElements = map[string]bool{
+	"contributor": true, "coverage": true, "creator": true, "date": true, "description": true,
+	"format": true, "identifier": true, "language": true, "publisher": true, "relation": true,
+	"rights": true, "source": true, "subject": true, "title": true, "type": true,
+}
+
+type oaiPMH struct {
+	XMLName        xml.Name `xml:"OAI-PMH"`
+	Xmlns          string   `xml:"xmlns,attr"`
+	XmlnsXSI       string   `xml:"xmlns:xsi,attr"`
+	SchemaLocation string   `xml:"xsi:schemaLocation,attr"`
+
+	ResponseDate string       `xml:"responseDate"`
+	Request      oaiRequest   `xml:"request"`
+	Errors       []oaiError   `xml:"error"`
+	Identify     *identify    `xml:"Identify"`
+	Formats      *listFormats `xml:"ListMetadataFormats"`
+	Sets         *listSets    `xml:"ListSets"`
+	Identifiers  *listHeaders `xml:"ListIdentifiers"`
+	Records      *listRecords `xml:"ListRecords"`
+	Record       *getRecord   `xml:"GetRecord"`
+}
+
+type oaiRequest struct {
+	Verb            string `xml:"verb,attr,omitempty"`
+	Identifier      string `xml:"identifier,attr,omitempty"`
+	MetadataPrefix  string `xml:"metadataPrefix,attr,omitempty"`
+	From            string `xml:"from,attr,omitempty"`
+	Until           string `xml:"until,attr,omitempty"`
+	Set             string `xml:"set,attr,omitempty"`
+	ResumptionToken string `xml:"resumptionToken,attr,omitempty"`
+	BaseURL         string `xml:",chardata"`
+}
+
+type oaiError struct {
+	Code    string `xml:"code,attr"`
+	Message string `xml:",chardata"`
+}
+
+type identify struct {
+	RepositoryName    string   `xml:"repositoryName"`
+	BaseURL           string   `xml:"baseURL"`
+	ProtocolVersion   string   `xml:"protocolVersion"`
+	AdminEmails       []string `xml:"adminEmail"`
+	EarliestDatestamp string   `xml:"earliestDatestamp"`
+	DeletedRecord     string   `xml:"deletedRecord"`
+	Granularity       string   `xml:"granularity"`
+}
+
+type listFormats struct {
+	Formats []metadataFormat `xml:"metadataFormat"`
+}
+
+type metadataFormat struct {
+	MetadataPrefix    string `xml:"metadataPrefix"`
+	Schema            string `xml:"schema"`
+	MetadataNamespace string `xml:"metadataNamespace"`
+}
+
+type listSets struct {
+	Sets []set `xml:"set"`
+}
+
+type set struct {
+	SetSpec string `xml:"setSpec"`
+	SetName string `xml:"setName"`
+}
+
+type listHeaders struct {
+	Headers         []header         `xml:"header"`
+	ResumptionToken *resumptionToken `xml:"resumptionToken"`
+}
+
+type listRecords struct {
+	Records         []record         `xml:"record"`
+	ResumptionToken *resumptionToken `xml:"resumptionToken"`
+}
+
+type getRecord struct {
+	Record record `xml:"record"`
+}
+
+type record struct {
+	Header   header    `xml:"header"`
+	Metadata *metadata `xml:"metadata"`
+}
+
+type header struct {
+	Status     string   `xml:"status,attr,omitempty"`
+	Identifier string   `xml:"identifier"`
+	Datestamp  string   `xml:"datestamp"`
+	SetSpecs   []string `xml:"setSpec"`
+}
+
+type resumptionToken struct {
+	Cursor int    `xml:"cursor,attr"`
+	Token  string `xml:",chardata"`
+}
+
+type metadata struct {
+	DC oaiDC
+}
+
+type oaiDC struct {
+	XMLName        xml.Name `xml:"oai_dc:dc"`
+	XmlnsOAIDC     string   `xml:"xmlns:oai_dc,attr"`
+	XmlnsDC        string   `xml:"xmlns:dc,attr"`
+	XmlnsXSI       string   `xml:"xmlns:xsi,attr"`
+	SchemaLocation string   `xml:"xsi:schemaLocation,attr"`
+
+	Elements []dcElement
+}
+
+type dcElement struct {
+	XMLName  xml.Name
+	Language string `xml:"xml:lang,attr,omitempty"`
+	Value    string `xml:",chardata"`
+}
+
+func newOaiPMH() *oaiPMH {
+	return &oaiPMH{
+		Xmlns:          namespaceOAI,
+		XmlnsXSI:       namespaceXSI,
+		SchemaLocation: namespaceOAI + " " + schemaOAI,
+	}
+}
+
+/*
+dublinCoreMetadata maps the values of an item onto Dublin Core elements. A field can be mapped onto several elements;
+values of unmapped fields are left out. The elements are ordered like the values.
+*/
+func dublinCoreMetadata(mappings []*sharedFields.VocabularyMapping_DublinCoreMapping, values []datamodel.CurrentItemValue) (*metadata, error) {
+	elementsByField := make(map[string][]string)
+	for _, m := range mappings {
+		if !dublinCoreElements[m.Element] {
+			return nil, fmt.Errorf("field %s is mapped onto an unknown Dublin Core element: %s", m.FieldName, m.Element)
+		}
+		elementsByField[m.FieldName] = append(elementsByField[m.FieldName], m.Element)
+	}
+
+	dc := oaiDC{
+		XmlnsOAIDC:     namespaceOAIDC,
+		XmlnsDC:        namespaceDC,
+		XmlnsXSI:       namespaceXSI,
+		SchemaLocation: namespaceOAIDC + " " + schemaOAIDC,
+		Elements:       []dcElement{},
+	}
+	for _, v := range values {
+		for _, element := range elementsByField[v.FieldName] {
+			dc.Elements = append(dc.Elements, dcElement{
+				XMLName:  xml.Name{Local: dcElementPrefix + element},
+				Language: v.Language.String,
+				Value:    v.FieldValue,
+			})
+		}
+	}
+
+	return &metadata{DC: dc}, nil
+}
@@ -0,0 +1,71 @@
+package oai
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	sharedFields "github.com/d4l-data4life/mex/mex/shared/fields"
+
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/datamodel"
+)
+
+func Test_dublinCoreMetadata(t *testing.T) {
+	mappings := []*sharedFields.VocabularyMapping_DublinCoreMapping{
+		{FieldName: "title", Element: "title"},
+		{FieldName: "keyword", Element: "subject"},
+		{FieldName: "keyword", Element: "description"},
+	}
+
+	tests := []struct {
+		name     string
+		mappings []*sharedFields.VocabularyMapping_DublinCoreMapping
+		values   []datamodel.CurrentItemValue
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:     "No values",
+			mappings: mappings,
+			want:     `<metadata><oai_dc:dc xmlns:oai_dc="http://www.openarchives.org/OAI/2.0/oai_dc/" xmlns:dc="http://purl.org/dc/elements/1.1/" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:schemaLocation="http://www.openarchives.org/OAI/2.0/oai_dc/ http://www.openarchives.org/OAI/2.0/oai_dc.xsd"></oai_dc:dc></metadata>`,
+		},
+		{
+			name:     "Mapped values in order, unmapped values left out",
+			mappings: mappings,
+			values: []datamodel.CurrentItemValue{
+				{FieldName: "title", FieldValue: "Zahlen & Fakten", Language: pgtype.Text{String: "de", Valid: true}},
+				{FieldName: "internalNote", FieldValue: "secret"},
+				{FieldName: "keyword", FieldValue: "numbers"},
+			},
+			want: `<metadata><oai_dc:dc xmlns:oai_dc="http://www.openarchives.org/OAI/2.0/oai_dc/" xmlns:dc="http://purl.org/dc/elements/1.1/" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:schemaLocation="http://www.openarchives.org/OAI/2.0/oai_dc/ http://www.openarchives.org/OAI/2.0/oai_dc.xsd">` +
+				`<dc:title xml:lang="de">Zahlen &amp; Fakten</dc:title><dc:subject>numbers</dc:subject><dc:description>numbers</dc:description></oai_dc:dc></metadata>`,
+		},
+		{
+			name:     "Unknown element",
+			mappings: []*sharedFields.VocabularyMapping_DublinCoreMapping{{FieldName: "title", Element: "headline"}},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := dublinCoreMetadata(tt.mappings, tt.values)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("dublinCoreMetadata() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+
+			var sb strings.Builder
+			if err := xml.NewEncoder(&sb).Encode(got); err != nil {
+				t.Fatal(err)
+			}
+			if sb.String() != tt.want {
+				t.Errorf("dublinCoreMetadata() got = %v, want %v", sb.String(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+ALTER TABLE "item_values" ADD COLUMN "author" text;
+
+-- Values written before this migration were all created together with their item.
+UPDATE "item_values" iv SET "author" = i."owner" FROM "items" i WHERE iv."item_id" = i."id";
+
+
+CREATE OR REPLACE FUNCTION next_migration_version() RETURNS integer
+LANGUAGE plpgsql IMMUTABLE AS
+$$
+BEGIN
+    return 23;
+END;
+$$;
@@ -0,0 +1,53 @@
+-- Deletions of items with a business ID are recorded so that harvesters (OAI-PMH) learn about removed records and
+-- about records whose latest version was deleted.
+CREATE TABLE IF NOT EXISTS "business_id_deletions" (
+    "business_id" text        NOT NULL,
+    "entity_name" text        NOT NULL,
+    "deleted_at"  timestamptz NOT NULL,
+
+    PRIMARY KEY ("business_id", "entity_name")
+);
+
+CREATE OR REPLACE FUNCTION f_record_business_id_deletion() RETURNS trigger
+LANGUAGE plpgsql AS
+$$
+BEGIN
+    IF OLD."business_id" IS NOT NULL THEN
+        INSERT INTO "business_id_deletions" ("business_id", "entity_name", "deleted_at")
+        VALUES (OLD."business_id", OLD."entity_name", NOW())
+        ON CONFLICT ("business_id", "entity_name") DO UPDATE SET "deleted_at" = EXCLUDED."deleted_at";
+    END IF;
+    RETURN NULL;
+END;
+$$;
+
+DROP TRIGGER IF EXISTS "items_record_business_id_deletion" ON "items";
+CREATE TRIGGER "items_record_business_id_deletion" AFTER DELETE ON "items"
+FOR EACH ROW EXECUTE FUNCTION f_record_business_id_deletion();
+
+-- One record per business ID and entity type. The datestamp is the latest change of the record: the creation of its
+-- latest version, a revision of one of its values, or the deletion of a version. Records without any remaining
+-- version are deleted records.
+CREATE OR REPLACE VIEW "oai_records" AS (
+    SELECT
+        coalesce(l."business_id", d."business_id")                  AS "business_id",
+        coalesce(l."entity_name", d."entity_name")                  AS "entity_name",
+        l."item_id"                                                 AS "item_id",
+        greatest(l."created_at", v."changed_at", d."deleted_at")    AS "datestamp",
+        l."item_id" IS NULL                                         AS "deleted"
+    FROM "latest_items_with_business_id" l
+    FULL OUTER JOIN "business_id_deletions" d
+        ON d."business_id" = l."business_id" AND d."entity_name" = l."entity_name"
+    LEFT JOIN LATERAL (
+        SELECT max(iv."created_at") AS "changed_at" FROM "item_values" iv WHERE iv."item_id" = l."item_id"
+    ) v ON true
+);
+
+
+CREATE OR REPLACE FUNCTION next_migration_version() RETURNS integer
+LANGUAGE plpgsql IMMUTABLE AS
+$$
+BEGIN
+    return 24;
+END;
+$$;
@@ -0,0 +1,23 @@
+-- Outbox of business IDs which need to be (re-)indexed. Entries are written in the same transaction as the items, so
+-- that no committed item can be left out of the index. The index service consumes the outbox with at-least-once
+-- semantics: claimed entries are leased until "next_attempt_at" and only deleted after a successful indexing. Failed
+-- attempts are retried with a backoff; after too many attempts, an entry is dead-lettered and kept for inspection.
+CREATE TABLE IF NOT EXISTS "index_outbox" (
+    "id"               bigserial   PRIMARY KEY,
+    "business_id"      text        NOT NULL,
+    "created_at"       timestamptz NOT NULL DEFAULT NOW(),
+    "attempts"         integer     NOT NULL DEFAULT 0,
+    "next_attempt_at"  timestamptz NOT NULL DEFAULT NOW(),
+    "last_error"       text,
+    "dead_lettered_at" timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS "index_outbox_pending" ON "index_outbox" ("next_attempt_at") WHERE "dead_lettered_at" IS NULL;
+
+CREATE OR REPLACE FUNCTION next_migration_version() RETURNS integer
+LANGUAGE plpgsql IMMUTABLE AS
+$$
+BEGIN
+    return 25;
+END;
+$$;
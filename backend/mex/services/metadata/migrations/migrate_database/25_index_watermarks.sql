@@ -0,0 +1,106 @@
+-- Transaction up to which all changes have been loaded into a Solr collection: the xmin of the snapshot taken before
+-- loading, i.e. all transactions with a lower ID had ended by then. The incremental index update only re-indexes
+-- business IDs changed by transactions from the watermark onwards. (Timestamps cannot serve as watermark: changes carry
+-- the time of the statement, but become visible only after their commit.)
+CREATE TABLE IF NOT EXISTS "index_watermarks" (
+    "collection"        text   PRIMARY KEY,
+    "indexed_until_xid" bigint NOT NULL
+);
+
+-- Last transaction which changed a business ID (new version, value revision, relation, or deleted version), maintained
+-- by the triggers below. The table is new, so that no index has to be built on the (large) tables with the changes.
+CREATE TABLE IF NOT EXISTS "index_changes" (
+    "business_id" text NOT NULL PRIMARY KEY,
+    "changed_xid" xid8 NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS "index_changes_changed_xid" ON "index_changes" ("changed_xid");
+
+CREATE OR REPLACE FUNCTION f_record_index_change(p_business_id text) RETURNS void
+LANGUAGE plpgsql AS
+$$
+BEGIN
+    INSERT INTO "index_changes" ("business_id", "changed_xid")
+    VALUES (p_business_id, pg_current_xact_id())
+    ON CONFLICT ("business_id") DO UPDATE SET "changed_xid" = EXCLUDED."changed_xid";
+END;
+$$;
+
+-- Items: new versions, imputed or changed business IDs and deletions.
+CREATE OR REPLACE FUNCTION f_record_index_changes_of_items() RETURNS trigger
+LANGUAGE plpgsql AS
+$$
+BEGIN
+    IF TG_OP IN ('INSERT', 'UPDATE') AND NEW."business_id" IS NOT NULL THEN
+        PERFORM f_record_index_change(NEW."business_id");
+    END IF;
+    IF TG_OP IN ('UPDATE', 'DELETE') AND OLD."business_id" IS NOT NULL
+        AND (TG_OP = 'DELETE' OR OLD."business_id" IS DISTINCT FROM NEW."business_id") THEN
+        PERFORM f_record_index_change(OLD."business_id");
+    END IF;
+    RETURN NULL;
+END;
+$$;
+
+DROP TRIGGER IF EXISTS "items_record_index_changes" ON "items";
+CREATE TRIGGER "items_record_index_changes" AFTER INSERT OR UPDATE OF "business_id" OR DELETE ON "items"
+FOR EACH ROW EXECUTE FUNCTION f_record_index_changes_of_items();
+
+-- Item values and relations: once per statement for all business IDs concerned.
+CREATE OR REPLACE FUNCTION f_record_index_changes_of_values() RETURNS trigger
+LANGUAGE plpgsql AS
+$$
+BEGIN
+    PERFORM f_record_index_change(x."business_id")
+    FROM (
+        SELECT DISTINCT i."business_id"
+        FROM "changed_values" cv
+        JOIN "items" i ON i."id" = cv."item_id"
+        WHERE i."business_id" IS NOT NULL
+    ) x;
+    RETURN NULL;
+END;
+$$;
+
+DROP TRIGGER IF EXISTS "item_values_insert_record_index_changes" ON "item_values";
+CREATE TRIGGER "item_values_insert_record_index_changes" AFTER INSERT ON "item_values"
+REFERENCING NEW TABLE AS "changed_values"
+FOR EACH STATEMENT EXECUTE FUNCTION f_record_index_changes_of_values();
+
+DROP TRIGGER IF EXISTS "item_values_update_record_index_changes" ON "item_values";
+CREATE TRIGGER "item_values_update_record_index_changes" AFTER UPDATE ON "item_values"
+REFERENCING NEW TABLE AS "changed_values"
+FOR EACH STATEMENT EXECUTE FUNCTION f_record_index_changes_of_values();
+
+CREATE OR REPLACE FUNCTION f_record_index_changes_of_relations() RETURNS trigger
+LANGUAGE plpgsql AS
+$$
+BEGIN
+    PERFORM f_record_index_change(x."business_id")
+    FROM (
+        SELECT DISTINCT i."business_id"
+        FROM "changed_relations" cr
+        JOIN "items" i ON i."id" = cr."source_item_id"
+        WHERE i."business_id" IS NOT NULL
+    ) x;
+    RETURN NULL;
+END;
+$$;
+
+DROP TRIGGER IF EXISTS "relations_insert_record_index_changes" ON "relations";
+CREATE TRIGGER "relations_insert_record_index_changes" AFTER INSERT ON "relations"
+REFERENCING NEW TABLE AS "changed_relations"
+FOR EACH STATEMENT EXECUTE FUNCTION f_record_index_changes_of_relations();
+
+DROP TRIGGER IF EXISTS "relations_update_record_index_changes" ON "relations";
+CREATE TRIGGER "relations_update_record_index_changes" AFTER UPDATE ON "relations"
+REFERENCING NEW TABLE AS "changed_relations"
+FOR EACH STATEMENT EXECUTE FUNCTION f_record_index_changes_of_relations();
+
+CREATE OR REPLACE FUNCTION next_migration_version() RETURNS integer
+LANGUAGE plpgsql IMMUTABLE AS
+$$
+BEGIN
+    return 26;
+END;
+$$;
@@ -0,0 +1,19 @@
+-- Groups sharing the ownership of an item with its owner. With owner-scoped writes, members of these groups may
+-- update or delete the item as well.
+CREATE TABLE IF NOT EXISTS "item_owner_groups" (
+    "item_id"  text NOT NULL,
+    "group_id" text NOT NULL,
+
+    PRIMARY KEY ("item_id", "group_id"),
+    CONSTRAINT "fk_items_item_owner_groups" FOREIGN KEY ("item_id") REFERENCES "items"("id") ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS "item_owner_groups_group_id" ON "item_owner_groups" ("group_id");
+
+CREATE OR REPLACE FUNCTION next_migration_version() RETURNS integer
+LANGUAGE plpgsql IMMUTABLE AS
+$$
+BEGIN
+    return 27;
+END;
+$$;
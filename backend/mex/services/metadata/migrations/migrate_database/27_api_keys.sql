@@ -0,0 +1,24 @@
+-- Managed API keys. Only the SHA-256 hash of a key is stored; the key itself is shown once upon creation. The prefix
+-- (the non-secret start of the key) lets users tell their keys apart. A rotated key stays valid until its "expires_at"
+-- (the grace period given on rotation) and points to the key replacing it.
+CREATE TABLE IF NOT EXISTS "api_keys" (
+    "id"           text        PRIMARY KEY,
+    "name"         text        NOT NULL,
+    "key_prefix"   text        NOT NULL,
+    "key_hash"     text        NOT NULL UNIQUE,
+    "scopes"       text[]      NOT NULL DEFAULT '{}',
+    "created_by"   text        NOT NULL,
+    "created_at"   timestamptz NOT NULL DEFAULT NOW(),
+    "expires_at"   timestamptz,
+    "last_used_at" timestamptz,
+    "revoked_at"   timestamptz,
+    "rotated_to"   text
+);
+
+CREATE OR REPLACE FUNCTION next_migration_version() RETURNS integer
+LANGUAGE plpgsql IMMUTABLE AS
+$$
+BEGIN
+    return 28;
+END;
+$$;
@@ -0,0 +1,29 @@
+-- Audit trail of all mutating API calls of all services. Entries are only ever appended. The roles are those the
+-- caller's privileges were derived from; the target IDs are the IDs found in the request and response messages.
+CREATE TABLE IF NOT EXISTS "audit_log" (
+    "id"         bigserial   PRIMARY KEY,
+    "created_at" timestamptz NOT NULL DEFAULT NOW(),
+    "service"    text        NOT NULL,
+    "method"     text        NOT NULL,
+    "tenant_id"  text        NOT NULL,
+    "subject"    text        NOT NULL,
+    "app_id"     text        NOT NULL,
+    "roles"      text[]      NOT NULL DEFAULT '{}',
+    "resource"   text        NOT NULL,
+    "verb"       text        NOT NULL,
+    "target_ids" text[]      NOT NULL DEFAULT '{}',
+    "outcome"    text        NOT NULL,
+    "message"    text
+);
+
+CREATE INDEX IF NOT EXISTS "audit_log_created_at" ON "audit_log" ("created_at");
+CREATE INDEX IF NOT EXISTS "audit_log_subject" ON "audit_log" ("subject", "created_at");
+CREATE INDEX IF NOT EXISTS "audit_log_resource" ON "audit_log" ("resource", "created_at");
+
+CREATE OR REPLACE FUNCTION next_migration_version() RETURNS integer
+LANGUAGE plpgsql IMMUTABLE AS
+$$
+BEGIN
+    return 29;
+END;
+$$;
@@ -0,0 +1,32 @@
+-- Searches saved by users. The request is the JSON form of a search request; the identity is the owner as last
+-- authenticated (IDs, roles determined by the authenticator, groups, and scopes), from which the owner's privileges and
+-- visibility are resolved anew at each evaluation, so that changes of roles and visibility rules take effect.
+-- The search was last evaluated against the index as of the index watermark "evaluated_until_xid": the next evaluation
+-- only checks the items changed since then. The version guards against several query service instances evaluating the
+-- same search at once.
+CREATE TABLE IF NOT EXISTS "saved_searches" (
+    "id"                    text        PRIMARY KEY,
+    "owner"                 text        NOT NULL,
+    "name"                  text        NOT NULL,
+    "request"               jsonb       NOT NULL,
+    "identity"              jsonb       NOT NULL,
+    "notification_email"    text        NOT NULL DEFAULT '',
+    "notifications_enabled" boolean     NOT NULL DEFAULT TRUE,
+    "evaluated_until_xid"   bigint      NOT NULL DEFAULT 0,
+    "num_matched"           integer     NOT NULL DEFAULT 0,
+    "version"               integer     NOT NULL DEFAULT 1,
+    "created_at"            timestamptz NOT NULL DEFAULT NOW(),
+    "updated_at"            timestamptz NOT NULL DEFAULT NOW(),
+    "last_evaluated_at"     timestamptz,
+    "last_notified_at"      timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS "saved_searches_owner" ON "saved_searches" ("owner", "created_at");
+
+CREATE OR REPLACE FUNCTION next_migration_version() RETURNS integer
+LANGUAGE plpgsql IMMUTABLE AS
+$$
+BEGIN
+    return 30;
+END;
+$$;
@@ -0,0 +1,104 @@
+-- The OAI-PMH records used to be computed by a view, which had to determine the latest version and the latest value
+-- change of every record before it could filter by datestamp. They are now materialised in a table which is maintained
+-- by triggers on every write: the latest version of a record is looked up again and its datestamp is set to the time of
+-- the change. Records without any remaining version are deleted records.
+DROP VIEW IF EXISTS "oai_records";
+
+CREATE TABLE IF NOT EXISTS "oai_records" (
+    "business_id" text        NOT NULL,
+    "entity_name" text        NOT NULL,
+    "item_id"     text,
+    "datestamp"   timestamptz NOT NULL,
+    "deleted"     boolean     NOT NULL GENERATED ALWAYS AS ("item_id" IS NULL) STORED,
+
+    PRIMARY KEY ("entity_name", "business_id")
+);
+
+CREATE INDEX IF NOT EXISTS "oai_records_datestamp" ON "oai_records" ("datestamp");
+
+INSERT INTO "oai_records" ("business_id", "entity_name", "item_id", "datestamp")
+SELECT
+    coalesce(l."business_id", d."business_id"),
+    coalesce(l."entity_name", d."entity_name"),
+    l."item_id",
+    greatest(l."created_at", v."changed_at", d."deleted_at")
+FROM "latest_items_with_business_id" l
+FULL OUTER JOIN "business_id_deletions" d
+    ON d."business_id" = l."business_id" AND d."entity_name" = l."entity_name"
+LEFT JOIN LATERAL (
+    SELECT max(iv."created_at") AS "changed_at" FROM "item_values" iv WHERE iv."item_id" = l."item_id"
+) v ON true
+ON CONFLICT DO NOTHING;
+
+CREATE OR REPLACE FUNCTION f_touch_oai_record(p_business_id text, p_entity_name text) RETURNS void
+LANGUAGE plpgsql AS
+$$
+BEGIN
+    INSERT INTO "oai_records" ("business_id", "entity_name", "item_id", "datestamp")
+    VALUES (
+        p_business_id,
+        p_entity_name,
+        (SELECT i."id" FROM "items" i
+         WHERE i."business_id" = p_business_id AND i."entity_name" = p_entity_name
+         ORDER BY i."created_at" DESC LIMIT 1),
+        NOW()
+    )
+    ON CONFLICT ("entity_name", "business_id") DO UPDATE
+        SET "item_id" = EXCLUDED."item_id", "datestamp" = EXCLUDED."datestamp";
+END;
+$$;
+
+-- Items: new versions, imputed or changed business IDs and deletions (after the statement, so that the latest remaining
+-- version is found).
+CREATE OR REPLACE FUNCTION f_touch_oai_records_of_items() RETURNS trigger
+LANGUAGE plpgsql AS
+$$
+BEGIN
+    IF TG_OP IN ('INSERT', 'UPDATE') AND NEW."business_id" IS NOT NULL THEN
+        PERFORM f_touch_oai_record(NEW."business_id", NEW."entity_name");
+    END IF;
+    IF TG_OP IN ('UPDATE', 'DELETE') AND OLD."business_id" IS NOT NULL
+        AND (TG_OP = 'DELETE' OR OLD."business_id" IS DISTINCT FROM NEW."business_id") THEN
+        PERFORM f_touch_oai_record(OLD."business_id", OLD."entity_name");
+    END IF;
+    RETURN NULL;
+END;
+$$;
+
+DROP TRIGGER IF EXISTS "items_touch_oai_records" ON "items";
+CREATE TRIGGER "items_touch_oai_records" AFTER INSERT OR UPDATE OF "business_id" OR DELETE ON "items"
+FOR EACH ROW EXECUTE FUNCTION f_touch_oai_records_of_items();
+
+-- Item values: new values and revisions; once per statement for all records concerned.
+CREATE OR REPLACE FUNCTION f_touch_oai_records_of_values() RETURNS trigger
+LANGUAGE plpgsql AS
+$$
+BEGIN
+    PERFORM f_touch_oai_record(x."business_id", x."entity_name")
+    FROM (
+        SELECT DISTINCT i."business_id", i."entity_name"
+        FROM "changed_values" cv
+        JOIN "items" i ON i."id" = cv."item_id"
+        WHERE i."business_id" IS NOT NULL
+    ) x;
+    RETURN NULL;
+END;
+$$;
+
+DROP TRIGGER IF EXISTS "item_values_insert_touch_oai_records" ON "item_values";
+CREATE TRIGGER "item_values_insert_touch_oai_records" AFTER INSERT ON "item_values"
+REFERENCING NEW TABLE AS "changed_values"
+FOR EACH STATEMENT EXECUTE FUNCTION f_touch_oai_records_of_values();
+
+DROP TRIGGER IF EXISTS "item_values_update_touch_oai_records" ON "item_values";
+CREATE TRIGGER "item_values_update_touch_oai_records" AFTER UPDATE ON "item_values"
+REFERENCING NEW TABLE AS "changed_values"
+FOR EACH STATEMENT EXECUTE FUNCTION f_touch_oai_records_of_values();
+
+CREATE OR REPLACE FUNCTION next_migration_version() RETURNS integer
+LANGUAGE plpgsql IMMUTABLE AS
+$$
+BEGIN
+    return 31;
+END;
+$$;
@@ -0,0 +1,25 @@
+-- Visibility rules of restricted users, evaluated by the metadata read paths. The rules are passed as a JSON array of
+-- records {"entityTypes": [...], "fieldName": "...", "values": [...]}: an item is visible if it matches any record, i.e.
+-- it is of one of the record's entity types (if any are given) and has one of the values in the record's field (if a
+-- field is given). NULL stands for an unrestricted user, an empty array for a user who must not see anything.
+CREATE OR REPLACE FUNCTION f_item_visible(p_item_id text, p_entity_name text, p_visibility jsonb) RETURNS boolean
+LANGUAGE sql STABLE AS
+$$
+    SELECT p_visibility IS NULL OR EXISTS (
+        SELECT 1
+        FROM jsonb_to_recordset(p_visibility) AS r("entityTypes" text[], "fieldName" text, "values" text[])
+        WHERE (coalesce(cardinality(r."entityTypes"), 0) = 0 OR p_entity_name = ANY(r."entityTypes"))
+          AND (r."fieldName" IS NULL OR EXISTS (
+              SELECT 1 FROM "current_item_values" civ
+              WHERE civ."item_id" = p_item_id AND civ."field_name" = r."fieldName" AND civ."field_value" = ANY(r."values")
+          ))
+    );
+$$;
+
+CREATE OR REPLACE FUNCTION next_migration_version() RETURNS integer
+LANGUAGE plpgsql IMMUTABLE AS
+$$
+BEGIN
+    return 32;
+END;
+$$;
@@ -8,6 +8,16 @@
 // mex/services/metadata/migrations/migrate_database/19_remove_search_configs.sql
 // mex/services/metadata/migrations/migrate_database/20_remove_fields.sql
 // mex/services/metadata/migrations/migrate_database/21_blobstore.sql
+// mex/services/metadata/migrations/migrate_database/22_item_value_authors.sql
+// mex/services/metadata/migrations/migrate_database/23_business_id_deletions.sql
+// mex/services/metadata/migrations/migrate_database/24_index_outbox.sql
+// mex/services/metadata/migrations/migrate_database/25_index_watermarks.sql
+// mex/services/metadata/migrations/migrate_database/26_item_owner_groups.sql
+// mex/services/metadata/migrations/migrate_database/27_api_keys.sql
+// mex/services/metadata/migrations/migrate_database/28_audit_log.sql
+// mex/services/metadata/migrations/migrate_database/29_saved_searches.sql
+// mex/services/metadata/migrations/migrate_database/30_oai_records.sql
+// mex/services/metadata/migrations/migrate_database/31_item_visibility.sql
 // mex/services/metadata/migrations/migrate_database/init.sql
 package migrate_database
 
@@ -245,6 +255,206 @@ func _21_blobstoreSql() (*asset, error) {
 	return a, nil
 }
 
+var __22_item_value_authorsSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x90\xbd\x6e\xab\x40\x10\x46\xfb\x7d\x8a\x4f\x2b\x8a\x7b\x0b\xbb\x48\x4a\x94\x62\x0d\x6b\xc7\x12\x3f\xd6\x1a\x92\x12\x91\x78\x02\x23\x61\x70\x96\x31\xf8\xf1\x23\xdb\x51\x22\xa5\x1d\x1d\xcd\x39\xfa\x4c\x52\x58\x87\xc2\xac\x12\x0b\xcd\x42\xc7\x6a\xaa\xbb\x33\x8d\x1a\x26\x8e\x11\xe5\x49\x99\x66\xd0\xf5\x59\xda\xc1\x6b\x08\x5d\x24\x54\x6a\xb1\xc0\xcb\x8d\xc2\xec\x59\x84\x7a\xbc\xd1\xc7\xe0\x09\xd2\xf2\x88\x23\x37\xbe\x16\x1e\x7a\xcc\xe4\x09\x75\xd7\xe1\xdd\x53\x2d\x74\x80\x0c\x0d\x49\x4b\x1e\x33\x4b\x0b\x69\x89\x3d\xae\xd2\xa5\x2a\x77\xb1\x29\xfe\x26\xf0\x84\xbd\x2d\x7e\xf5\x4f\xe0\xa5\x1e\xe6\x9e\xbc\xc6\xda\xe5\xe9\x1d\x1f\x35\x18\xaf\xcf\xd6\x59\xf0\xb4\xbc\x9d\x2a\x3e\x7c\xd3\x7c\xd0\xa1\x52\x2a\x72\xf6\xfa\x3e\x77\x70\x76\x97\x98\xc8\x62\x5d\x66\x51\xb1\xcd\x33\xf4\x74\x91\xea\xa7\xb9\x9a\xc8\x8f\x3c\xf4\xff\xfe\xc3\xd9\xa2\x74\xd9\x1e\xdc\x0b\x35\xe4\x55\x62\xb2\x4d\x69\x36\x16\xa7\xee\xd4\x8c\x9f\x1d\xb6\x69\x5a\xde\x97\x33\x7b\x15\x04\x6a\x65\x37\xdb\x4c\x01\x80\x27\x39\xfb\x1e\x0f\x8f\xa1\xb2\x59\x1c\xaa\x20\x08\xd5\xd7\x00\xcd\xa2\x03\x69\x6a\x01\x00\x00")
+
+func _22_item_value_authorsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__22_item_value_authorsSql,
+		"22_item_value_authors.sql",
+	)
+}
+
+func _22_item_value_authorsSql() (*asset, error) {
+	bytes, err := _22_item_value_authorsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "22_item_value_authors.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __23_business_id_deletionsSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x55\xc1\x6e\xab\x38\x14\xdd\xf3\x15\x47\x28\x8b\x20\xa5\x59\x8c\x66\x35\xa8\x0b\x5e\x70\x5a\x66\x28\x8e\xc0\xbc\xf6\xad\x90\x5f\xf0\x4b\x2c\x11\xc8\x60\x87\xb6\xf3\xf5\x23\x83\x49\x43\xa6\xed\x94\x6e\x1a\xfb\xfa\xdc\xeb\x73\xcf\xb9\xbe\xb9\x41\x28\x2a\xa1\x65\x53\x2b\x34\xbf\x20\xb5\x38\x28\x3c\x4b\xbd\x07\xc7\xcf\x93\x92\xb5\x50\x0a\x51\x08\xde\x0a\xb4\x62\xdb\xb4\xa5\x28\xa1\x1a\xe8\x3d\xd7\xd8\xf3\xb6\x13\x4a\x8b\x56\x61\x4e\x83\xe8\x66\xf3\x70\xef\xa1\x12\xbc\xad\xc1\x7f\x36\x27\x8d\x56\x1c\x9a\x4e\x94\xf6\xa4\x02\xaf\x4b\xe7\xe6\xe6\xbc\x69\xe0\x14\x9e\xf7\x8d\x12\xa8\xb8\x16\x4a\xa3\x13\xad\x92\x4d\x8d\x67\xae\x50\x9a\xca\x44\xb9\x74\x56\x29\x09\x18\x01\x0b\xbe\xc5\x04\xd1\x1a\x09\x65\x20\x4f\x51\xc6\x32\xb8\x63\x91\x85\x2c\x8b\x72\xbc\x8a\x8b\xb9\x03\x60\xb2\xeb\x42\x8b\x17\x0d\xfb\x19\x88\x24\x8f\xe3\xc5\x10\x27\x6a\x2d\xf5\x6b\x51\xf3\x83\xf8\x34\xce\x96\x54\x70\xed\x02\x5a\x1e\x84\xd2\xfc\x70\xd4\xff\x5c\xc4\xf5\x80\x9b\x34\x7a\x08\xd2\x1f\xf8\x8b\xfc\xc0\x7c\x52\xc5\x62\x9a\xcc\x73\x3c\xdf\x19\x2f\x48\x53\xa4\x64\x13\x07\x2b\x82\x75\x9e\xac\x58\x44\x13\xfc\x2a\x06\xf2\x8a\xf7\x2e\x3a\xf7\x90\x12\x96\xa7\x49\x06\xdd\xca\xdd\x4e\xb4\x4e\x1c\x24\x77\x79\x70\x47\x70\xac\x8e\x3b\xf5\x77\x85\x20\x73\x66\x33\xe7\x1b\xb9\x8b\x92\xbe\xb4\x68\x0d\x1a\x87\xcb\x29\x35\x51\x76\xbe\x01\xd8\x3d\x19\x22\xcd\x5f\x94\x64\x24\x65\x88\x12\x46\x3f\xe6\xfa\xb3\x0b\x2e\x26\xa4\x79\x67\xe0\xef\x41\x9c\x93\x0c\xf3\xff\xd4\xb2\x18\xca\x9b\x62\x24\xf4\x71\xee\xbd\x1d\xa6\x09\x56\x34\x59\xc7\xd1\x8a\xfd\x0f\xbd\x08\x29\xf2\x4d\x68\xd8\xcd\x08\x9b\x36\xf0\x16\xe4\x69\x15\xe7\x21\x09\x97\x97\xeb\x7e\x9f\x86\x24\x21\xa2\xf5\xf0\xff\xc0\x71\xcf\x8e\xef\x90\x24\xf4\x9d\xd9\xcc\x77\x9c\x30\xa5\x1b\xb0\x34\xba\xbb\x23\xa9\x91\xe5\x28\xc9\xde\x44\x9f\xb5\xcd\x05\x4d\x6c\x98\xeb\x9f\xd5\x6d\x81\xbe\x72\x3c\x58\x33\x92\x22\x24\x31\x61\xe4\x02\xcb\x59\xd3\x14\x24\x58\xdd\x23\xa5\x8f\x20\x4f\x64\x95\xb3\xaf\x4b\xc9\x77\x8c\x37\x69\x3d\x1a\x1d\x47\xd1\x4e\x67\x40\x5d\x62\x20\x17\xfa\xf5\x28\x96\x60\x7b\x81\xb2\xf7\x2d\x3f\x1c\x21\x15\xf4\xfe\x6c\xe4\xed\x9e\xd7\x3b\x61\x66\x8a\x59\x1c\x10\xff\xe8\x03\xb6\xad\xe0\x26\xa3\xd9\x93\x5a\x99\xa4\x53\xf3\x2f\xc0\xd1\x8a\x4e\x2a\x1b\xd4\xd4\xc2\xc6\xa2\xe3\xd5\x49\xa8\x05\x9a\xb6\x87\x1a\x8b\x37\xdb\x7c\x3c\xbe\x44\x6a\xc7\x8d\x99\x63\x66\x0c\xf1\xfa\xd5\x8c\x22\x2e\x6b\x59\xef\x4c\x3e\x1b\xd9\x4f\x35\xdb\x79\x5b\xa2\x5a\xbe\x63\xc6\xef\x11\x79\x84\xdb\x70\x69\x09\x54\x2e\x82\xcc\x8e\x98\x8c\xc4\x64\xc5\xce\xca\xdc\x36\xbc\x12\x6a\x2b\xe6\xd5\xb5\xaa\xcb\xe9\x82\x37\x9e\x78\xfb\x82\xe9\x38\x73\x17\xef\xa2\x4e\x8d\x51\x4e\x17\x3e\x40\x9d\x9c\x39\xa3\x56\xcb\x5e\x37\xa6\x9a\x71\xe9\xcb\x5f\x60\x75\x3e\xad\x73\x67\x5a\x2b\x94\x36\x75\xf6\x6d\x1e\x1c\xb5\x40\xb7\x74\x07\x41\xd8\xdf\xe5\xc4\x71\xde\x88\x78\x16\xd3\x07\x55\x9a\x31\x65\x46\xd4\x57\x3f\x53\xa5\xcd\xe3\xf6\x80\xeb\x94\x3e\xc0\x1d\xe4\x56\x18\x58\x55\x18\x91\x5c\x3a\xc2\x45\x35\x84\x9a\x44\x34\x37\x46\xfb\x93\x46\xc9\x87\xd3\xaf\x3c\x57\x4a\x93\xeb\x26\xe3\x16\x57\x3a\x40\x90\x84\xd7\x4d\x1b\xa2\x2e\x57\x7a\xc8\x98\xac\xd9\x90\x3a\x0e\x18\x49\x83\xd8\x2a\xee\x4d\x75\x38\xf0\x97\xb9\xec\x26\x5c\x7b\x3d\x91\x17\x64\xdb\x3b\x9b\xcb\x16\x83\x7d\x5c\xc8\x0e\x8f\xf7\x24\x25\x90\xdd\x05\xbb\xb7\x97\x5c\xf7\xa9\x3c\x74\x66\xbc\xe8\xf6\x24\xfa\x77\xea\xb3\x87\xaa\x16\x2f\xba\x38\xc8\x5d\xdb\x9b\xbb\xb0\x16\xbb\x78\xa1\x64\xad\xc5\xbb\x2f\x54\xf4\xf0\x90\x0f\x6f\xfb\xf5\x5b\xd5\x0a\x7d\x6a\x6b\xfc\xf6\xbb\xef\x90\x24\xf4\x9d\xd9\xcc\x77\xfe\x1d\x00\x52\x7e\x41\x0a\xb3\x08\x00\x00")
+
+func _23_business_id_deletionsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__23_business_id_deletionsSql,
+		"23_business_id_deletions.sql",
+	)
+}
+
+func _23_business_id_deletionsSql() (*asset, error) {
+	bytes, err := _23_business_id_deletionsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "23_business_id_deletions.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __24_index_outboxSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x92\x41\x6f\xda\x4e\x10\xc5\xef\xfe\x14\x4f\x88\x03\x48\x31\xfa\xeb\x2f\xf5\x52\x4e\x4e\x58\x52\xab\xc4\x44\xc6\x28\xc9\x09\x2d\xde\x01\x56\xb1\x77\xe9\xee\x38\x21\xfd\xf4\xd5\x1a\x9b\x26\x4a\x55\xd5\x17\x5b\xde\x79\xf3\xde\xfc\x76\xe2\x18\xcb\x86\xb7\xf6\x04\xbb\xc3\xb6\xf1\xda\x90\xf7\x48\x67\x1e\xaf\x07\x5d\x1e\x60\x88\x14\xd8\x62\x4b\x18\x39\x8a\xc7\xda\x28\x3a\x91\x9a\x40\x18\x76\x9a\x3c\xa4\x23\xbc\x3a\xcd\x4c\x06\xda\x80\x0f\x04\x2f\x6b\x02\x3b\x69\xbc\x2c\x59\x5b\x03\xe9\xdb\xff\x9a\xa9\xf6\x57\xf0\x36\x8a\x63\xf0\x41\x32\x8c\x45\x69\xeb\x3a\xa8\x55\x7b\x8c\x52\x9a\xe0\x55\xd1\x8e\x61\x1b\x0e\xa9\x5a\x69\xb0\x9d\xa0\xe8\x3f\xe1\xc9\xbd\xe8\x92\x50\x5a\xe3\x9b\x9a\xce\x06\xf6\x3c\xc9\xab\xe6\x03\x24\xc7\x15\x49\xcf\xb1\x35\x25\x05\x43\x4f\xb5\x34\xac\x4b\xff\x15\x65\x25\x75\x4d\x0a\xf4\x6e\x86\x50\x4c\x0a\x8d\x61\x5d\x61\x60\xe8\xc4\x1b\xc9\x4c\xf5\x31\xbc\x07\x90\x46\xc1\x9a\xea\x0d\x8a\x2a\x0a\x69\xe5\x8e\xc9\x41\xc2\x37\x65\x49\xde\xef\x9a\xea\x9c\x4c\x9b\xfd\x04\x73\xa9\x2b\x52\xc1\xb5\xeb\x71\xe6\xe4\x28\xf8\xa9\x2e\x20\xb6\xb2\x7c\xb6\xbb\xdd\xb4\xeb\xc5\xd6\xa2\x96\xe6\xed\xa2\xb9\x82\x34\x6d\xc6\x37\x68\x0f\x45\x52\xc5\x15\x31\x93\x0b\xf6\x46\xe1\x99\x8e\x8c\x9d\x75\xd0\xc6\x1f\xa9\x45\x3d\x89\x6e\x72\x91\x14\x02\x45\x72\xbd\x10\x48\xe7\xc8\x96\x05\xc4\x63\xba\x2a\x56\x18\xb4\x01\x37\x67\x4a\x03\x8c\x22\x00\x18\x68\x35\xc0\xc7\x67\xab\xf7\x9e\x9c\x96\x15\x80\xfb\x3c\xbd\x4b\xf2\x27\x7c\x17\x4f\x57\xe7\xfa\x7e\x49\x36\x17\x21\xd3\x89\x7b\x6d\xb0\xcb\xd6\x8b\x45\x57\x5c\x3a\x92\x4c\xaa\x45\xd8\x15\xeb\x9a\x3c\xcb\xfa\xc8\x3f\x2f\xc5\x98\x89\x79\xb2\x5e\x14\xc8\x96\x0f\xa3\x71\x27\xed\x29\xf4\x42\x40\x1b\xa6\x3d\xb9\x0f\x3e\x17\xe9\x7f\x9d\xec\xd3\xcd\xfd\xbb\x63\x25\x3d\x6f\xc8\x39\xeb\x2e\x61\xe9\xc4\xdd\x61\xc0\xbf\xe9\xf1\xb7\x8d\xdf\xf5\x8d\xc6\xd3\xa8\x27\x9f\x66\x33\xf1\xf8\x37\xf2\x9b\x23\x19\xa5\xcd\x7e\x80\x65\xf6\xe9\x4e\x3e\x6d\xde\x18\x0f\xdf\x44\x2e\xfe\x14\x20\x5d\xb5\xb3\xfc\xb6\x5e\xe6\xc8\xc5\xfd\x22\xb9\x11\x98\xaf\xb3\x9b\x22\x5d\x66\x68\xfb\xd5\x7a\xef\x64\xd8\x8f\xcd\x0b\x39\xaf\xad\x19\x8d\x91\x8b\x62\x9d\x67\xab\x9e\x6a\xb4\x48\xb2\xdb\x75\x72\x2b\x70\xac\x8e\x7b\xff\xa3\x42\x7a\x77\xb7\x3e\xaf\x51\xb2\x8a\x86\xc3\xe8\x5a\xdc\xa6\x59\x0b\xc3\x11\x37\xce\xe0\xff\x2f\xd3\x48\x64\xb3\x69\x34\x1c\x4e\xa3\x5f\x03\x00\x78\xd2\x61\xb0\x49\x04\x00\x00")
+
+func _24_index_outboxSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__24_index_outboxSql,
+		"24_index_outbox.sql",
+	)
+}
+
+func _24_index_outboxSql() (*asset, error) {
+	bytes, err := _24_index_outboxSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "24_index_outbox.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __25_index_watermarksSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd5\x57\x4d\x6f\xe2\x48\x10\xbd\xf3\x2b\x4a\x68\xa4\x80\x44\x38\xec\x61\xb5\x4a\x34\x07\x0f\x6e\x88\x77\xc1\x46\xb6\xd9\xcc\x9c\xac\xc6\x6e\xa0\x35\xc6\x66\xbb\x9b\x8f\xfc\xfb\xad\x76\x1b\x63\x03\x93\x90\xec\x6a\x35\x8b\x94\x40\x70\xd5\xab\xaa\x57\xef\xb5\x9d\xfb\x7b\x08\x05\xcd\x24\x8d\x15\xcf\x33\xd8\x6e\x40\xe5\xb0\x5f\xf1\x78\x05\x34\x4d\x21\x5e\xd1\x6c\xc9\x24\xac\xe8\x8e\xc1\x9c\xb1\x0c\xd2\x9c\x26\x2c\x01\x9e\x61\x1c\x85\x20\x4f\x05\xc4\x79\x9a\xb2\x22\xff\x01\xd4\x8a\xc1\x61\xcd\x33\xc8\x17\xc5\x67\x99\xd1\x8d\x5c\xe5\x0a\x14\xfd\x8e\xd9\x73\xb6\xc8\x05\x6b\xdd\xdf\x17\x38\x3c\x5b\xf6\x80\xf7\x59\xbf\xa8\xa5\x4e\x7d\x48\xd8\x73\x85\x1d\x60\xd4\x9e\x09\x70\x6c\x6c\x20\x01\x96\xe9\xca\xf3\x17\x0d\x9c\xf5\x21\x44\x78\x9e\xc5\x82\xad\x59\xa6\x68\x8a\x9f\x13\x76\xc0\x09\x12\xaa\x18\xe4\x59\xfa\x02\x82\xdd\x17\x5f\x32\xa9\x2b\xce\xb7\x92\x67\x4c\x4a\x84\x93\xe5\x60\x06\xad\x5e\x77\x21\xf2\x75\xd1\xf8\x1e\x51\xc4\x9a\x8a\xef\x08\xb5\xa7\x22\x91\x7d\xe8\x84\x7c\xcd\xa4\xa2\xeb\x0d\xe6\xd3\x2c\xc3\xa9\x24\x13\x48\x0c\x95\xa7\xf0\x87\x8a\xb3\x98\x0a\xf1\xa2\x0b\x6b\x38\x85\xa9\x15\x27\x0a\x83\x75\xd3\x3d\xec\x49\x21\x27\x71\x8e\x17\x77\x5c\xf2\x79\x5a\x76\x4e\x17\x08\xa7\x83\xb9\xa6\x77\xbd\xe6\xaa\xdf\x6d\x0d\x7c\x62\x85\x04\x42\xeb\xcb\x98\x80\x33\x04\xd7\x0b\x81\x7c\x75\x82\x30\x80\x76\x31\x67\x54\x75\x21\xdb\xd0\x69\x01\xbe\xda\xa7\xe5\xb4\xa1\x7c\x29\x76\x50\xf8\x36\xf5\x9d\x89\xe5\x7f\x83\x3f\xc8\xb7\x9e\x89\x35\x64\x25\xd1\x36\x53\x3c\x8d\x0e\x3c\x69\xc3\x9c\x2f\x71\xd5\x45\x29\x77\x36\x1e\xb7\xba\x8f\x2d\x3d\xd2\x98\x4a\x55\x27\xae\x94\xcc\x91\x55\x5a\x27\x1b\x3a\x19\xdb\xc3\x8e\x09\x89\x81\x3d\xd8\xd1\x74\xcb\x70\x35\x7a\x5e\xfd\xb7\x60\x29\x55\xc5\xa7\x5c\x40\xc2\x52\xa6\x10\xa0\x8c\xee\xf6\x60\x4d\xb1\x3e\xfe\xb0\xa4\xd8\xe1\x8b\x61\x53\xf0\xe5\x12\x43\x90\x3b\x54\x88\x91\x82\xa2\x9a\x3d\x2e\x01\xab\xf5\x40\xe6\x18\x48\x15\x64\x79\xa9\x8b\x15\x2e\x09\x25\x3b\x47\x19\x6f\x79\xaa\x90\xe6\x02\xa9\x93\x52\xb1\x64\x5d\x93\x5d\xca\x4e\x7f\x5f\x2e\xb1\x7f\x03\xe9\x65\x68\xc5\xf8\x71\xf4\x48\xf3\x57\x70\x7d\x64\xef\x0a\xe5\x25\x63\x86\x6c\xfc\xf5\x5b\x93\xea\xb2\xba\xe3\xda\xe4\xeb\xab\xd5\xa3\x06\x90\xe7\x5e\x36\xd7\x28\x55\xc3\xf6\x7c\xf0\xc9\x74\x6c\x0d\x08\x0c\x67\xee\x20\x74\x30\x79\x11\x09\x14\xa5\x48\xa2\x3a\x48\x67\x13\xd5\x46\x2b\x26\xeb\x62\x6a\x38\xf3\xdd\x00\x76\x39\x4f\x5a\x63\xcb\x1d\xcd\xac\x11\x81\x4d\xba\x59\xca\xbf\x52\xb0\x82\xd6\xa7\x4f\xad\x2f\x64\xe4\xb8\xc5\xb8\x8e\x1b\x10\x3f\xc4\xb7\xd0\xbb\xd2\x60\x9d\xb8\x5e\x93\x9a\x6e\x91\xfe\xa7\x35\x9e\x91\x00\x9a\x8d\xf4\x60\xb3\x8c\xe2\xad\x10\x68\xa8\xe8\x80\x72\xc4\xef\x3a\x5d\x93\x80\xa3\x0c\x3c\x77\x38\x76\x06\xe1\x19\x7e\x17\x6c\x0f\x66\x53\x5b\x33\x10\x90\xf0\x6c\x11\x9f\x91\xe4\xc1\x78\x66\x13\xbb\xdf\xb8\xf0\xd8\x22\xae\xfd\x88\x33\x19\x17\x38\x68\x63\xf9\x00\x35\x79\x4b\x3c\xcf\xd6\x9b\xad\x96\x30\xaa\xb9\x3a\x64\xea\x27\x0f\xcd\x12\x23\x73\x1d\xde\x7f\xf7\x16\x64\x94\x2f\x22\xae\x0b\x77\x4e\xec\x97\x7e\xb8\x61\x01\x43\x08\x47\x91\x37\xc5\x15\x40\xe7\xce\xac\xe3\xae\x07\x77\x86\x89\xbb\x2e\x58\xae\x0d\x2e\x79\xee\x37\x55\xec\x04\x27\x0d\x87\x4f\xc4\x60\xe9\xd7\x94\xf8\x43\xcf\x9f\xfc\x40\x30\x17\x40\x28\x3b\x9d\x85\x24\x62\x27\x8f\x57\x3a\x2a\xfb\xc0\x8e\x6c\x32\x26\x55\x47\xde\xd8\xfe\x71\x47\x55\x33\x3a\xb2\x63\xc0\x3e\x57\x00\x9a\xd9\xab\xe9\x36\xba\xc8\x41\xa6\x61\xe8\x7b\x93\xcb\x99\xbb\xef\x19\xf4\xa2\xc0\x95\x41\xcd\xae\x8a\x8e\xeb\x32\xb2\x7d\x6c\x37\xf4\x9d\xd1\x88\xf8\x9a\x8c\xca\xdd\x7a\xc5\x57\x05\x50\xda\x5b\x5f\x47\x41\x1e\xcf\xa7\x12\xe1\xd5\x3c\x6b\x18\xea\x22\xc6\x84\xc8\x4b\xa9\x7f\x6f\x78\x76\x68\xe1\x25\xc3\x5e\xad\x52\x0b\xc7\x07\x62\x0d\x9e\xc0\xf7\x9e\xb1\x4b\x32\x98\x85\xef\xd1\xea\xc9\x31\xe6\x0e\x60\x8c\x70\x3c\xfc\xd1\x45\x79\x16\x33\xd8\xe0\x5d\xaf\xba\x3d\x02\x3e\x2b\x14\x4f\x06\xcd\x3b\xb7\x0e\x14\x78\x3f\xf8\xa0\x77\x4c\xf5\x8f\x98\xe7\x75\x0d\x1c\xce\x14\x50\xa4\x14\xda\xea\x54\x2a\x0a\x90\x55\x54\x5c\x25\x3d\xde\xcc\xa9\xe2\x8a\xb4\xea\xe4\x31\x1d\xb7\x21\xde\x55\x01\xbf\x7b\x4e\xb5\x19\xe0\x7a\x4d\x08\x65\x4e\xae\x78\xd7\x2f\x2e\x34\x00\x9f\x9f\x88\x4f\xce\xcb\x5d\x98\xa8\x0b\x87\x0f\x6b\xb5\xec\x12\x39\xc1\x67\x22\xf5\x86\x70\x8f\x23\x5d\x97\xef\x4d\x50\x4d\x2d\x9f\xe1\xb6\x7c\x32\xc4\x81\xdd\x81\xe3\x8e\xb4\xb5\xcb\xdb\xb7\x15\x5c\x90\x7a\x92\x75\x10\x62\x23\x13\xe2\x86\xef\x11\xf7\x51\x4c\x37\x92\x63\x1e\x4e\xff\x15\x72\x5e\x85\x32\xe4\x1c\xed\xfd\x13\x90\xf3\x21\xa7\x56\x87\xc3\xff\xc9\xac\x55\xd3\xe8\x57\x71\x93\x5f\x45\xbf\x2d\xf3\xad\x88\x59\xf4\x1f\xdb\xb6\x6a\xf5\x6d\xd3\x9e\xa6\xba\x54\xe5\x8d\x30\x17\x86\x3d\x61\xde\xa0\xc8\x5a\xf0\x3f\x13\x65\x4d\x54\x37\x51\xf3\xa6\x65\x6f\xa3\xe6\x7d\x76\xfd\x19\xa8\x79\xc5\xb2\x19\x3e\xfa\x47\x6b\xbe\x14\x45\x78\x54\x3e\xfb\xd6\x5c\x8a\xff\xb2\xb1\xab\x2e\x75\x26\x93\xd9\x71\x82\xa6\x5f\x05\x53\x5b\x91\xc1\x2f\xbf\xd6\x94\xfb\x37\x6c\xe2\x19\x36\x9d\x10\x00\x00")
+
+func _25_index_watermarksSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__25_index_watermarksSql,
+		"25_index_watermarks.sql",
+	)
+}
+
+func _25_index_watermarksSql() (*asset, error) {
+	bytes, err := _25_index_watermarksSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "25_index_watermarks.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __26_item_owner_groupsSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x91\x3f\x6f\xdb\x30\x14\xc4\x77\x7e\x8a\x83\xe0\xc1\x01\xec\x0c\x5d\x3a\x78\x62\xe4\x67\x43\xa8\x4c\x05\x94\x84\x26\x93\xa0\xd6\x2f\x12\x51\xfd\x2b\x49\xd7\xe9\xb7\x2f\x44\xa5\x4d\x10\x04\xe8\x46\xe2\xf7\xde\x1d\xef\xb8\xdd\xe2\x68\xc7\xcb\xe4\xe0\xda\xda\x9a\xa1\x81\x6f\x19\xe3\x75\x60\xeb\x5a\x33\x61\x7c\x42\x3d\xc0\x78\xee\x71\x35\xbe\x85\xf1\x6e\xa1\xb7\xf8\x3a\xdf\xc3\x79\xeb\xbe\x8f\x13\x9f\x71\xb5\xc6\xb3\xdb\xa0\xe7\xfe\x1b\x5b\x37\x2f\xfb\x96\x1d\xa3\x59\x2c\xfa\xfa\xb7\xd8\x6e\x71\x99\xce\xb5\x67\x8c\x16\x67\xee\xd8\x73\xb0\x0c\x16\xb5\xc3\x95\xbb\xee\x56\xc4\x9a\x64\x41\x28\xe4\x5d\x4a\x48\x0e\x50\x59\x01\x7a\x48\xf2\x22\x47\x34\x4f\x56\xc1\xb7\x5a\x74\x23\xac\x05\x80\x17\x62\xce\x11\xe0\xf9\xd9\x87\x25\x55\xa6\xe9\x66\xa1\x61\x38\xe0\x77\x34\xe0\x7b\x9d\x9c\xa4\x7e\xc4\x17\x7a\xc4\xfa\x9f\xd2\xe6\xcd\xda\xcd\xa2\x13\x67\x2a\x2f\xb4\x4c\x54\x81\xe8\xe9\x47\x35\x4f\xba\xea\x83\x37\x1d\x32\x4d\xc9\x51\xbd\x13\xbc\x81\xa6\x03\x69\x52\x31\xbd\x44\x71\xd1\x3a\x0a\x20\x53\xd8\x53\x4a\x05\x21\x96\x79\x2c\xf7\x24\x6e\x76\xe2\x6f\x13\x89\xda\xd3\xc3\x7f\x9b\xa8\x5e\x33\x66\xea\x03\x1e\x61\xfd\x26\xcf\xab\x7a\xa6\xa1\xe9\x3e\x95\x31\xe1\x50\xaa\xb8\x48\x32\x85\x81\x9f\x7d\xd5\x9b\xc6\xd6\xde\x8c\x43\xf5\x8b\xad\x33\xe3\xb0\x9e\x03\x14\xa5\x56\x39\xcc\xe0\xb9\x61\x2b\x52\xa9\x8e\xa5\x3c\x12\xa6\x6e\x6a\xdc\xcf\x0e\xc9\xe9\x54\x2e\x3f\x27\x73\xb1\x5a\x89\x3b\x3a\x26\x2a\x74\x67\xd9\x5f\xec\x80\x4f\x9f\x77\x82\xd4\x7e\x27\x56\xab\x9d\xf8\x33\x00\xe8\x70\x63\x32\x82\x02\x00\x00")
+
+func _26_item_owner_groupsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__26_item_owner_groupsSql,
+		"26_item_owner_groups.sql",
+	)
+}
+
+func _26_item_owner_groupsSql() (*asset, error) {
+	bytes, err := _26_item_owner_groupsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "26_item_owner_groups.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __27_api_keysSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x92\xdf\x6a\xdb\x4c\x10\xc5\xef\xf5\x14\x07\x61\x88\x0d\x9f\x7d\x11\xf8\x4a\xc1\x57\x4a\xb2\x49\x45\x6d\x39\x95\x25\xda\x50\x8a\xd8\x5a\x13\x79\x89\xbc\xbb\xdd\x1d\xbb\x76\x4b\xdf\xbd\xac\x2c\xa7\x7f\xd2\x10\x5d\x89\x99\xdf\x39\x73\xa4\x99\xf1\x18\x73\xa9\x65\x43\x35\x92\xdb\x14\x0f\x74\xf0\x13\x2c\x74\x7b\x00\xaf\x09\xcb\x37\xc9\xf8\xfc\xff\x57\x58\x4b\xbf\x86\xb9\x87\x0c\x00\x94\x87\x67\xe3\xa8\x9e\x76\x50\x57\x62\x4f\xed\x7d\xd7\x59\x9b\xaf\x1a\x46\xaf\x08\x5b\x6b\x34\x56\x8e\x24\x2b\xa3\x27\x28\xd6\x04\xeb\xe8\x5e\xed\xa3\xf1\x18\xc3\x20\xd5\x46\x8f\x3d\xad\x1c\x31\x3c\x4b\xc7\x61\x46\x6f\x39\x42\x4b\xec\xb1\xf5\xe4\x3c\x98\xda\x36\xcc\x52\x2e\x04\xf0\x90\x56\x3a\x9e\x20\x81\x33\x2c\x99\xea\x50\x0d\x0e\x07\x8f\x9d\x6c\x55\x8d\xad\x66\xd5\x42\xb1\x47\x4c\x7b\xab\x1c\xf9\x4a\x72\xfc\x38\xb7\x71\x72\x45\xb0\xe4\x94\xa9\xd1\xa8\x1d\x85\xc4\x47\x33\x65\xf4\x08\x52\xd7\xb0\x46\x69\xf6\x60\xf3\xf8\x91\x8e\x6c\x2b\x57\x4a\x37\x50\x3c\x89\x2e\x73\x91\x14\x02\x45\x72\x31\x13\x48\xaf\x91\x2d\x0a\x88\x0f\xe9\xb2\x58\x22\x96\x56\x55\x21\x68\x8c\x61\x04\x00\xb1\xaa\x63\xfc\x7a\x98\xf6\x7c\x7a\xbf\xcd\xd3\x79\x92\xdf\xe1\xad\xb8\xfb\xef\xc8\x6a\xb9\xa1\xf8\x9f\x6c\x18\x91\x95\xb3\x59\x0f\x3e\xd0\xa1\x3a\xfe\xd0\xf8\x65\x30\xac\x30\x7e\xd6\x11\x65\x96\xbe\x2b\x45\xcf\xfb\x95\xb1\xe4\x4f\x19\x02\xff\xf1\xd3\x5f\xfc\x95\xb8\x4e\xca\x59\x81\xb3\xef\x3f\xce\x7a\x55\xb7\x69\xaa\xab\xcf\x87\x17\xe2\x9c\x40\xc9\x1d\xa8\x36\xe4\x59\x6e\x2c\x7f\x7b\x6a\x9f\x2d\xde\x0f\x47\xbd\xec\xb7\x45\xfe\x29\xeb\xfb\xad\xf4\x5c\x6d\x7d\x6f\xfc\xb4\xef\x68\x67\x1e\xa8\x7e\x56\xdf\xdf\x52\xc5\xe6\x94\x3f\x1a\x4d\xa3\xd3\x9e\x17\x39\x72\x71\x3b\x4b\x2e\x05\xae\xcb\xec\xb2\x48\x17\x19\x34\xed\xb9\xda\xa8\xc6\x75\x67\x53\xed\xc8\x79\x65\xf4\x70\x84\x5c\x14\x65\x9e\x2d\xa1\x34\x53\x43\x2e\x9a\x25\xd9\x4d\x99\xdc\x08\xd8\xd6\x36\xfe\x4b\x8b\x74\x3e\x2f\x8f\x97\x93\x2c\xa3\xc1\x20\xba\x10\x37\x69\xd6\xc5\x74\xc4\x5b\xa7\x71\xfe\x7a\x1a\x89\xec\x6a\x1a\x0d\x06\xd3\xe8\xe7\x00\x4c\xd1\xcd\xb1\xa5\x03\x00\x00")
+
+func _27_api_keysSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__27_api_keysSql,
+		"27_api_keys.sql",
+	)
+}
+
+func _27_api_keysSql() (*asset, error) {
+	bytes, err := _27_api_keysSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "27_api_keys.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __28_audit_logSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x93\x5f\x4b\xdc\x4c\x14\xc6\xef\xf3\x29\x1e\xc2\x82\x2e\xb8\x5e\xbc\x77\x2f\x7b\x15\xdd\x51\x42\xd7\xac\x64\x13\xaa\x94\x12\xc6\xe4\x98\x9d\x32\x99\x89\x33\x27\xa9\x6d\xe9\x77\x2f\xc6\x64\xab\x48\xb7\xd2\x5c\x05\xce\xef\x3c\xe7\xdf\x33\x8b\x05\xa2\xae\x52\x0c\x76\x52\x69\xd8\x7b\x48\xad\xd1\x74\x2c\x59\x99\x1a\xd1\x75\x8c\x52\x6a\xed\xa7\x88\x27\xd7\xab\x92\xfc\x29\x84\x61\xa7\xc8\x43\x3a\x82\x35\xfa\x1b\xa8\x27\x07\xd9\xb6\x64\x2a\xaa\x4e\x91\xed\x08\xce\xea\x91\xe0\x9d\xf5\x04\xde\x51\xb0\x58\x0c\x8a\xe4\x8e\x3c\x5a\xa7\x7a\xa5\xa9\x26\x8f\xaf\xe4\x08\x15\x39\xd5\x53\x85\x7b\x67\x9b\xe5\x13\x0d\x96\xae\x26\x46\xbc\x9a\x64\x68\xf8\xbf\xb7\x9d\xa9\xa0\xcc\xc0\x38\x7a\xe8\xc8\x33\xa4\xa9\xe0\xc8\xb7\xd6\x78\x42\x43\xde\xcb\x9a\xfc\x69\x70\x9e\x8a\x28\x13\xc8\xa2\xb3\xb5\x40\x7c\x81\x64\x93\x41\xdc\xc4\xdb\x6c\x8b\x50\x3e\xcd\x5e\x68\x5b\x87\x38\x0e\x00\x20\x54\x55\x88\xe9\xbb\x53\xb5\x27\xa7\xa4\x06\x70\x9d\xc6\x57\x51\x7a\x8b\x0f\xe2\xf6\xe4\x99\x2c\x1d\x49\xa6\xaa\x90\x1c\x82\x55\x43\x9e\x65\xd3\xf2\xf7\x41\x3f\xc9\xd7\x6b\xac\xc4\x45\x94\xaf\x33\x24\x9b\x8f\xc7\xf3\x31\x69\xdc\xdf\x50\x83\xe9\x91\xa7\x52\x53\xd2\x88\x35\xc4\x3b\x3b\x76\x72\x00\x63\x32\xd2\x70\x31\xf4\x7c\x00\xf3\xdd\xdd\x17\x2a\xf9\x6f\x45\x65\xdb\x16\xd3\xf8\x07\xb0\xe1\xa8\xe3\x92\x9e\xb0\x4f\x9f\x5f\x63\xfb\xb9\x8f\x7e\xfc\x3c\x9a\x72\xc8\xdb\xce\x95\x14\x1e\x96\xee\xc9\xdd\x4d\xeb\x3f\x80\x3d\x9b\xa2\x50\x95\x0f\xdf\xdd\x81\xed\xb8\xb4\xcd\x3b\x16\x3f\xd8\x66\x8f\x05\xf3\x65\x30\x39\x28\x4e\x56\xe2\xe6\x8f\x0e\x2a\x5e\xfa\x61\x93\xbc\xf6\xd6\x4b\xb3\xcc\x97\xef\x14\xdc\x9f\xed\x8d\xda\x14\x39\xc1\x3f\x09\xff\xbe\xc6\x1b\xe5\x7d\xe8\x8d\xf4\xa4\xbd\x49\x91\x8a\xeb\x75\x74\x2e\x70\x91\x27\xe7\x59\xbc\x49\x60\xe8\x91\x8b\x46\xd5\x4e\xb2\xb2\xa6\xe8\xc9\x79\x65\xcd\xf1\x1c\xa9\xc8\xf2\x34\xd9\x42\x19\xa6\x9a\x5c\xb0\x8e\x92\xcb\x3c\xba\x14\x68\x75\x5b\xfb\x07\x8d\xf8\xea\x2a\x7f\x7e\x99\xd1\x36\x98\xcd\x82\x33\x71\x19\x27\x83\x67\x1c\x71\xe7\x0c\xfe\xfb\x7f\x19\x88\x64\xb5\x0c\x66\xb3\x65\xf0\x6b\x00\xd6\xce\x1f\x7b\xae\x04\x00\x00")
+
+func _28_audit_logSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__28_audit_logSql,
+		"28_audit_log.sql",
+	)
+}
+
+func _28_audit_logSql() (*asset, error) {
+	bytes, err := _28_audit_logSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "28_audit_log.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __29_saved_searchesSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa5\x54\x4d\x6f\xda\x40\x10\xbd\xfb\x57\x8c\xac\x48\x01\x09\xa2\x54\xbd\x95\x93\x13\x9c\xc8\x2d\x31\x11\x18\x35\x39\x59\x8b\x3d\x98\x6d\xec\x5d\xb2\xbb\x86\xd0\x5f\xdf\x59\x7f\x10\x27\x21\xa8\x55\x7d\xdb\xdd\x79\xf3\xde\xcc\x9b\xf1\x70\x08\x73\x64\x2a\x59\xa3\x06\xcd\xb6\x98\xc2\x72\x0f\xa5\x46\xa5\x2f\x20\x5a\x23\x28\x7c\x2e\x51\x1b\xe0\x1a\x0c\x1d\xbf\xcf\xa7\x21\xac\xa4\x2a\x40\xae\x80\x81\xae\xa0\x6d\xd0\xa8\x0a\xe1\x29\x0a\xc3\xcd\xbe\x85\xc8\x9d\x40\x05\x4c\x43\xce\xb4\x71\x86\x43\x60\x25\x5d\x53\x48\xc2\x0c\xd1\xf5\x82\xb1\x1e\x80\x92\x39\x09\x48\xd1\xa0\x2a\xb8\xa8\x55\x58\x70\x27\x56\xaa\x01\x64\x4a\x96\x1b\x0a\x67\x22\x05\x9d\xc8\x0d\xea\xfe\x00\x56\x4a\x16\xb0\x5b\x73\x12\x72\xe0\x3b\xd7\xb0\x51\x7c\xcb\x73\xcc\x28\x2f\x85\x5b\xe2\x2d\xd7\x7c\xc9\x73\xab\x8d\x29\x5b\x9a\x96\xb9\xad\x98\x09\xdc\x01\x33\x80\x8c\x52\xe0\x96\xe5\x25\x33\x5c\x8a\x01\x68\x49\x19\xe9\x21\x59\x33\x61\xf3\x50\xcd\xb5\x50\xcb\xdf\xc9\xa6\x4a\x7b\x69\xd8\x13\x02\xae\x56\x98\x98\x0b\x4b\x67\xdb\xd7\x34\x68\xd7\x94\xdf\x66\xb7\xa4\x19\xe3\x82\x6e\xaa\x96\x89\x14\x5f\x6c\x8b\x88\xe0\xf5\xbc\x63\xb6\x1b\x4c\x3d\x81\x7b\x80\xc5\x25\x35\x23\x8f\x5f\x78\xea\x7e\xab\x42\x05\xbe\x98\x8e\x66\xcb\x2b\x45\xbe\x27\xc9\x98\x3c\xd5\x06\x70\x83\x85\x6e\x6a\xa0\xb6\x71\x91\xa0\xbd\x17\xb5\xc1\x5b\xb2\x9a\x80\x90\x95\x4c\xa5\xfa\x20\x4b\x23\x3d\xb0\x1c\xc8\x58\xb5\xa7\x93\xda\xf2\xc4\x0a\xd3\x86\x11\x5e\x1f\x28\x45\x66\x73\x59\x5a\xcd\x8a\x43\xbd\xd4\x33\x49\x61\x17\xce\xf5\xcc\xf7\x22\x1f\x22\xef\x6a\xe2\x43\x70\x03\xe1\x34\x02\xff\x21\x98\x47\x73\x70\xab\x71\x8b\x75\x33\x7d\x2e\xf4\x1c\xa0\xcf\xa5\xd2\xe0\xc8\x67\x6c\xa1\xcd\x77\x3f\x0b\xee\xbc\xd9\x23\xfc\xf0\x1f\x07\x35\xa8\x32\xdd\x3d\x09\xb2\xd4\xe1\x62\x32\x69\x10\x82\xe4\xba\xf0\x2f\x88\x66\xca\xdf\x83\x7e\x69\x29\x96\xc7\x11\xed\x2a\xb8\x7f\x8d\x10\xd2\xf0\x95\x9d\x76\xb2\x24\xc6\x82\xf1\xdc\xfd\x4c\x15\x8c\xfd\x1b\x6f\x31\x89\xe0\xfc\xfc\x08\x58\xc7\x28\xd8\x32\x47\x6a\xe6\x52\xd2\xcc\x32\x71\x1c\x1c\xcd\x16\x7e\x03\x3f\x36\x64\x74\xbf\xe4\x19\x17\xe6\x13\xee\xcb\x96\xba\x2c\xe2\x82\x19\x32\xf2\x8d\x7b\x04\xa4\x05\x54\xa7\xb1\xcd\x04\xbe\xef\xeb\x49\xec\x97\x06\x9b\x28\xac\x14\xb3\xb7\xb6\x18\x5e\x90\x53\xac\xd8\x98\xdf\x1f\xb1\xe1\xf4\x67\xaf\xdf\xe0\xcb\x4d\xfa\x5f\x78\xbb\xd4\xf1\x6b\xe3\xda\x34\x1d\x7c\x37\xb0\x36\xa8\x4b\xd7\x09\x74\xfa\x23\xa7\xdd\x97\x20\x1c\xfb\x0f\xa7\xf7\x25\x6e\x46\x9e\xfe\xc7\x1f\x37\xa9\xd9\x87\xc1\x9b\x06\x75\xf2\x4f\x67\x30\xf3\xef\x27\xde\xb5\x0f\x37\x8b\xf0\x3a\x0a\x28\x8b\xfd\x93\xc4\x05\xcf\x54\x3d\x7c\x8d\x2b\xbd\x3e\x45\x46\x8b\x59\x38\x6f\x0d\x71\x26\x5e\x78\xbb\xf0\x6e\x7d\xd8\xe4\x9b\x4c\x3f\xe7\x10\xdc\xdd\x2d\xea\x0d\xf7\xe6\xce\xd9\x99\x73\xe5\xdf\x06\x61\x55\xb6\x42\x53\x2a\x01\x5f\x2f\x47\x8e\x1f\x8e\x47\xf4\x38\x72\xfe\x00\xc1\xe5\xa0\xe2\x72\x06\x00\x00")
+
+func _29_saved_searchesSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__29_saved_searchesSql,
+		"29_saved_searches.sql",
+	)
+}
+
+func _29_saved_searchesSql() (*asset, error) {
+	bytes, err := _29_saved_searchesSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "29_saved_searches.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __30_oai_recordsSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x57\x4d\x6f\xa3\x4a\x16\xdd\xf3\x2b\x8e\xac\x48\x31\x12\xb1\xd4\x9a\x5d\x5b\x59\xd0\xa6\xec\x30\x83\x8b\x08\x70\x27\x59\xa1\x8a\x29\xdb\xa5\xc1\xe0\x81\xb2\x93\xcc\xaf\x7f\x2a\x28\xbe\xec\x24\x9d\xd7\xef\x49\x2f\xf2\xc2\x54\x6e\xdd\x8f\x73\xcf\xbd\x07\xdf\xdc\x20\xda\x71\xf8\xb6\x7b\x73\xbf\xbc\x43\xc1\xd7\x79\x91\x94\x38\x96\x3c\x81\xcc\xf1\xcc\xb1\xce\xf7\x87\xa3\xe4\x09\x9e\xdf\xc0\x70\x12\xfc\xc5\xc2\xcb\x4e\xac\x77\xd8\xb1\xca\x26\xe1\x92\x17\x7b\x91\x71\xc8\x1d\x47\xca\x24\x2f\x25\x4e\xbc\x28\x45\x9e\x81\x65\xc9\xe0\x98\xa5\x47\x6e\xdc\xdc\x60\xbd\x63\xd9\x96\x23\xdf\x80\x9f\x78\xf1\xa6\x03\xe3\x99\x6f\xf2\x82\x43\x48\xac\xf3\x63\x9a\x60\x23\x52\xc9\x0b\x15\x3a\xa9\x1c\xb0\xfd\x61\xa2\x12\x7e\x03\x2b\x38\xb2\xfc\x05\x7b\x26\x79\x21\x58\x2a\x54\xc6\x22\x03\x83\x64\xcf\x29\xd7\x29\x8a\x12\x7b\x26\x32\xc9\x44\xc6\x13\x15\xf7\xf9\x0d\xb2\x10\xdb\x2d\x2f\x4a\xe4\x99\x0e\xfe\x52\x08\xc9\xbf\xbf\x97\x7e\xbe\x01\x6b\x72\x13\x25\xd2\x3c\xff\x2f\x4f\x70\x3c\x80\x6d\x99\x0a\x96\x25\x10\xb2\xec\x92\x83\x28\x51\x72\xa9\x60\x51\xde\xa4\xd8\xab\x1a\x55\x60\xf5\x58\x17\x3d\x41\xa0\x51\x7e\x11\x72\x97\x1f\x25\x58\xa6\x00\x50\x89\x8a\x6c\xdb\x41\x57\x70\x24\x3c\xe5\x0a\x7b\xdd\x97\x89\xe1\x04\xfe\x3d\x7e\xba\xe4\x01\xee\x1c\xe4\xd1\x0d\xa3\x10\xa3\x9c\x89\x58\x5b\x8c\xa6\x86\x31\x0b\x88\x1d\x11\x44\xf6\x0f\x8f\x28\x33\xea\x47\xef\x9a\x62\x6c\x00\xc0\xe8\xf9\x58\x8a\x8c\x97\x65\x2c\x92\x11\x24\x7f\x95\xd0\x7f\xea\x22\x5d\x79\x9e\x55\xdb\xf1\x4c\x0a\xf9\x16\x67\x6c\xcf\x3f\xb5\x13\x92\xef\x2b\x5f\xea\x49\xd9\xe9\xf3\x16\xa5\x91\x3a\x17\xfb\x1a\x32\xf9\xff\xf3\xfb\xba\x68\x65\x05\x3c\xe7\x79\xca\x59\x36\x88\x83\x05\xa1\x24\xb0\x23\xe2\xc0\xf6\x1e\xec\xa7\x10\x76\x88\x71\x17\xd7\x0d\x2b\x33\x13\x61\xe4\x07\xc4\xb1\x8c\xca\xef\x7d\xe0\x2e\xed\xe0\x09\xff\x21\x4f\x18\x0f\x8a\xb1\x86\x18\x98\x86\xd9\xa1\xe8\x52\x87\x3c\x7e\x82\x62\xdc\xab\xca\xa7\xe7\x00\x8f\xba\xff\x2a\x9f\x2e\x0d\x49\x10\xc1\xa5\x91\x7f\x61\xd9\xcf\xc0\x1a\x82\x6d\x75\x98\x5a\x7d\x18\x4d\x23\x24\x1e\x99\x45\x55\x79\xeb\x9c\xa5\xbc\x5c\xf3\x71\x3a\x19\x54\x63\x21\x19\x1e\x98\xd6\x85\xfd\x30\x58\x32\x3c\xd0\xf6\xe9\xa4\x4b\xa2\x3a\xd8\x16\xbc\x2a\x4e\x39\x58\x57\xdf\x93\x98\xc9\x91\x85\xd3\x64\x54\x33\x5d\x3f\x27\x93\xa6\xa5\xea\xd9\x34\xe6\x81\xbf\xc4\xa8\x5e\x14\xb1\xf2\x59\xc6\x6a\x12\xe2\x7e\x92\x48\x8d\xb9\xea\xb4\xbf\x8a\x48\x80\x7f\xfb\x2e\x1d\xf4\x28\xae\x1c\x8a\x3c\x2b\x47\x48\xaa\x6c\x7c\x7a\x5e\x27\x6e\x71\x06\x05\x6c\xea\x9c\x57\x57\x5b\xf5\x4f\x0c\x8f\xcc\xa3\x3a\xa4\x67\x47\x24\xb0\x3d\x3d\x28\x35\xd8\xd8\xb3\xd7\xb1\x38\x0d\x6a\x36\x15\x01\xfb\x45\xa3\xae\x51\x15\x17\x9f\x58\x7a\xe4\xe5\x08\xe2\x84\x87\x3b\x12\x10\x88\x53\x07\x25\x6e\xfb\xc0\x1a\x26\x4e\xf0\x29\x64\x71\xe4\x86\x4f\x31\xf3\xe9\xdc\x73\x67\x11\x1c\x5f\x91\xff\xce\xa5\x8b\x8e\x99\x7e\x80\x80\xdc\x7b\xf6\x8c\x60\xbe\xa2\xb3\xc8\xf5\x29\x36\xb1\xcc\x8f\xeb\x5d\xdc\x71\x6b\x7c\xe8\xe3\x5a\x8d\xad\x85\x43\xdc\x2b\xb8\x3a\x33\x11\x90\x68\x15\xd0\x10\xa7\x5c\x24\x86\x67\xd3\xc5\xca\x5e\x10\x1c\xd2\xc3\xb6\xfc\x5f\x0a\x3b\x34\xae\xae\x8c\x1f\x64\xe1\xd2\x0a\x8b\xbf\x9d\xc9\xca\xe9\x4f\xdb\x5b\x91\x50\xa3\xad\x3e\x83\xdc\xad\xde\x71\xcf\x67\x77\x3c\xd6\x0d\x12\x93\x91\x48\xfa\x2d\x50\xe0\xb7\x56\x4d\x13\x2e\xc8\x32\x04\x4a\x51\x45\x5c\x50\x65\x10\xb9\x73\xe9\x07\x0e\x09\xf0\xe3\x09\x62\x40\x0b\x38\x24\x9c\xc1\x73\x97\x6e\x84\x6f\x7a\x8c\xd4\x87\xfa\x0f\x63\xb3\x7a\x32\x1b\xee\xb6\x9d\xfe\x7c\x33\x29\x22\xac\xee\x1d\x3b\x22\xad\xb3\x90\x44\x1d\xa8\xb8\x05\x79\x9c\x79\x2b\x87\x38\x93\xf7\x91\x1e\x58\x74\xc7\x53\x83\x50\x67\x6a\x5c\x5d\x4d\x0d\xa5\x56\xae\x9a\xca\xef\xc8\xf8\x4b\x23\x47\xa5\x05\xa1\x5f\x04\xf2\x42\x2b\x59\x82\x26\x39\xb8\x4e\x59\xa9\x61\x3b\x97\x18\xb3\x8d\x92\x6e\xa5\x7b\xa5\x64\x92\xef\x79\x26\x2d\x94\x4a\x18\x99\xec\x6b\x6d\xab\x7c\x2a\x70\x23\x7e\xa2\xc4\x26\x3f\x66\x89\x39\xf9\x73\x74\x2f\xe3\x7c\x53\xef\x94\x71\x47\x69\xad\xf8\x5f\x60\xf5\x1c\xd1\x22\xf6\xef\xe1\x52\x8c\xaf\x6b\x8e\x5f\x5b\xb8\xae\x21\xbf\x36\xab\x05\x42\xc9\xc3\x19\x77\xdc\xb0\x93\xa6\xe8\x8e\xd0\xb6\x37\xf7\x24\x98\xfb\xc1\xf2\x9d\x3c\xc7\x17\x5e\x2c\x54\x47\xfd\xee\x9b\xd3\xca\x13\xa1\x0e\xdc\xf9\xf4\x9d\x0c\x75\x5e\x16\xae\x1d\xe2\x91\x36\x43\xdf\x73\x3e\xce\xb0\x4d\x4e\xd5\x32\xae\xcb\xbd\x6d\x1d\xc0\x0f\xde\xbf\xee\xb8\x61\xe4\xd2\x59\x54\x6f\xb6\x8b\xec\xcd\x2f\x17\x7e\xe1\xdd\xaa\x03\xfe\xa2\xf0\xba\x97\x15\xc6\x7d\xae\x56\xef\x43\x51\xe0\x2e\x16\x24\xe8\xbf\x12\x55\x14\xb8\x08\x5e\x8e\xd4\x76\xd5\x3b\x61\xda\x30\xab\xb9\xfe\xf1\x25\x7b\xae\x54\x48\xef\x3c\x3f\xd0\x23\x08\x7f\x3e\x1c\x4f\x05\x5e\x8d\x63\x2f\x8c\x31\xf7\x03\x10\x7b\x76\x87\xc0\x7f\x00\x79\x24\xb3\x55\xf4\x65\x0a\x77\xd3\x88\x5a\x46\xf4\x4c\x56\xdf\xab\x81\x2b\xf8\x49\x54\xf3\x39\x45\x9e\xad\x39\x0e\xbc\xe8\xe6\x0d\x9b\xbc\x00\x4b\xd3\xe6\xfd\x11\x6b\x65\x53\x64\x3c\xf9\x9d\xb1\xaa\x33\xf8\x9d\xb9\xfa\x84\x0e\xaf\xe7\x64\x78\x3d\xa3\x42\xd5\xfd\x8a\x74\x9d\x2e\xe8\x45\xdf\x72\xf2\x6c\x99\x5b\xe7\x9b\xbb\xbd\x58\xf9\x69\x85\xba\x51\xe6\xf5\xa9\x35\xa8\x64\x5f\x77\x0e\x42\xb5\x51\xab\xc9\x2d\xd6\x3d\xd9\x36\x3e\x17\x93\xf3\x71\x33\xf1\xfa\xdb\x2c\xd6\xb0\xc7\x22\x2b\x79\x21\x3f\xa3\xb4\xb6\xfc\x80\xd8\xbf\xf6\x33\x64\xf9\x99\x53\x23\x20\x73\x12\x10\x3a\x73\xe9\x42\x6d\x2a\xfd\xf3\xa2\xff\xde\xd3\x58\xb6\x84\x0f\x23\x3b\x22\x4b\x42\xa3\x2f\xd3\xbe\xa1\xd8\x17\x31\x39\x1e\x94\x82\xfd\x75\x4c\x3e\xf6\x53\x63\xd2\xcc\xfb\x3f\x8d\xc9\x27\x33\x9b\xf1\x57\x19\xef\xc5\xb6\x60\x4a\x7e\x63\xad\xa3\xbd\x61\x15\x99\xe4\xef\x0e\xab\xbb\x5c\xae\x9a\xc4\x87\x63\x5b\x70\x79\x2c\x32\xfc\xeb\xdb\xd4\x20\xd4\x99\x1a\x57\x57\x53\xe3\x8f\x01\x00\x5d\x41\xed\xd5\x31\x10\x00\x00")
+
+func _30_oai_recordsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__30_oai_recordsSql,
+		"30_oai_records.sql",
+	)
+}
+
+func _30_oai_recordsSql() (*asset, error) {
+	bytes, err := _30_oai_recordsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "30_oai_records.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __31_item_visibilitySql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x52\x5f\x6f\x9b\x3a\x1c\x7d\xe7\x53\x1c\xa1\x48\x17\xa4\x04\xf5\xde\xfb\xd6\xa8\x0f\x34\xa5\x5d\xa6\x94\x4c\x40\xf6\x47\xd5\x84\x1c\xf8\x91\x78\x02\x9b\xd9\x4e\xd6\x68\xda\x77\x9f\x0c\xac\x09\x9d\xd4\x47\x9b\xc3\xf9\xe7\x33\x9b\xe1\x23\xd7\x7c\xcb\x6b\x6e\x4e\x50\x87\x9a\x34\x64\x05\x45\xda\x28\x5e\x18\x2a\x71\xd0\xa4\xf4\x14\x74\x64\xf5\x81\xd9\x8b\xed\x09\x66\x4f\x68\xc8\xb0\x92\x19\x06\x45\xac\x44\xcb\xcc\x5e\x07\xc8\xf6\x34\x90\x30\x45\x68\x99\xd6\x54\x82\x69\x30\xbc\x4f\xd7\x31\x98\x52\xec\x04\x59\x39\xb3\x19\x14\x15\x52\x95\x1a\x3f\x5d\x12\x86\x9b\x53\x76\x6a\x49\xbb\xd7\x78\x0a\x82\xe0\xeb\x14\x6e\xc5\xa9\x2e\x63\xd6\x90\x7b\x0d\x37\x08\x02\x77\x0a\xd7\x7a\x38\x83\x7e\x5d\x83\x09\x70\x43\x0d\xb8\xc6\xd1\xc6\xa8\x09\xbc\x02\x37\x68\x98\x29\xf6\xd6\x86\x38\x0d\x4a\x53\xf0\x80\x02\xab\xcc\x8d\xc5\xcb\x0a\x52\x90\x0d\x6b\xd3\xf4\x98\x7f\x34\x7a\x33\x30\xd6\x0d\x3c\x5e\x75\x0c\x36\xcc\x8e\x1f\x49\xf8\x60\xa2\xc4\x9e\xe9\xcb\x7f\x7b\x57\xe0\x62\xcc\xd4\x05\xe8\x29\xac\x6a\x7f\xe4\x7a\x20\x0a\x10\x6f\x56\x2b\x68\xc3\x44\xa9\x51\x49\x65\xb3\x1c\xc4\xab\xe2\xa7\xf6\x96\x9a\xd6\x9c\x86\xee\x3a\x60\xf7\x09\x3f\xf6\x12\xcd\x41\x1b\x08\x69\xa0\x89\xac\x53\xb3\xe7\x62\x17\x38\x8b\x24\x0a\xb3\x08\xeb\x04\x49\xf4\x61\x15\x2e\x22\xdc\x6f\xe2\x45\xb6\x5c\xc7\xa8\x72\x5b\x58\x3e\xb4\xe5\xb5\xfd\x91\x97\x30\xf4\x6c\xa6\x68\xf3\xbe\x80\x5c\xb0\x86\x5e\xee\x8e\xe7\x89\x7c\xd3\x52\x6c\x7d\x24\x51\xb6\x49\xe2\x14\x5b\x29\x6b\x62\xc2\x59\x85\xf1\xc3\x26\x7c\x88\xa0\xbf\xd7\x48\xb3\xf0\x76\x15\x21\x4c\x9d\xc9\xc4\x01\x80\x34\x5a\x45\x8b\x6c\x4c\xb4\x4c\xfb\x06\xd6\x09\xa2\xcf\xcb\x34\x4b\xe1\x75\xd8\x0b\xfc\xbf\x2f\x17\xf7\xc9\xfa\xb1\x97\xce\x8d\xcc\x87\xe9\x90\xf1\x2e\x19\x7d\x84\x29\x94\x37\x9a\x53\x97\xe0\x69\x3c\xa7\x21\xd5\x9f\x31\x0d\x10\xff\x45\xeb\xd3\xbb\x28\x89\xe0\x15\x92\xd5\xa4\x0b\xf2\x0a\xa6\x4a\x2e\x98\x95\xf0\x54\x30\xa2\xf7\xa7\xb8\xf2\x71\x83\x2b\x5b\xf5\xb8\xba\x1b\x84\xf1\x97\xbf\xf0\x67\x15\x20\x8c\xef\x60\x01\x17\xce\xde\xea\x64\xdc\x4c\xdf\x88\x5b\x1c\x94\x22\x61\x86\x37\x1d\x02\x15\xfc\xf8\xea\xaf\x3e\x52\xc1\x8f\x81\x3b\x3c\xb7\x8b\x1b\x9c\xdf\xde\x5a\xe9\xbe\x76\x5e\xba\x00\x16\x30\x36\xf7\x0a\xd4\xd5\xe7\x9e\x73\x0e\x75\x5e\x26\xf4\xfb\x83\x3f\x77\x26\x93\xb9\xf3\xd6\x2a\x05\x3d\x9b\xbc\xe1\x3b\xc5\x0c\x97\x22\x3f\x92\xd2\x5c\x0a\xef\xbc\x33\x2e\x0c\xed\x48\x9d\x77\xd6\xd6\xed\xce\x6e\x6d\xf9\xf8\xb8\xb9\x9c\xdb\x6d\xf4\xb0\x8c\x3b\x59\x45\xe6\xa0\x04\xfe\xff\x6f\xee\x44\xf1\xdd\xdc\x99\x4c\xe6\xce\xef\x01\x00\x36\xfe\x61\xc7\xef\x04\x00\x00")
+
+func _31_item_visibilitySqlBytes() ([]byte, error) {
+	return bindataRead(
+		__31_item_visibilitySql,
+		"31_item_visibility.sql",
+	)
+}
+
+func _31_item_visibilitySql() (*asset, error) {
+	bytes, err := _31_item_visibilitySqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "31_item_visibility.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _initSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\xcc\xb1\x6a\xc3\x30\x10\x06\xe0\xb9\xf7\x14\x3f\xc1\x43\x0b\x5d\x3a\x6b\x52\xdc\x8b\x2b\xb0\xe5\x22\x9d\xa1\x9b\x71\x83\x70\x04\x8e\xe2\xca\x4a\xf1\xe3\x77\x68\xe6\x0f\xbe\xda\xb1\x16\x86\xaf\x3f\xb8\xd3\x30\x27\xd8\x5e\xc0\x5f\xc6\x8b\xc7\xe1\x1a\xf6\x83\x22\xf2\x2c\xd8\xc2\x94\xcf\x97\x71\x9d\xca\x05\xd2\xff\xd3\xeb\x7a\xff\x5e\xe2\x59\x11\x3d\x96\xde\xc1\xf1\x67\xab\x6b\xc6\x69\xb0\xb5\x98\xde\x22\x85\xbd\x8c\xd7\x38\xe7\xa9\xc4\x5b\x1a\x7f\x43\xde\xe2\x2d\x3d\xbf\xc0\xb1\x0c\xce\x7a\xc4\x54\xc2\x1c\x32\x69\x8f\xaa\xa2\x23\x37\xc6\xd2\x53\x0e\xe5\x9e\x13\xde\x14\xb1\x7d\x57\x55\x45\xad\xb6\xcd\xa0\x1b\xc6\xba\xac\xf3\xf6\xb3\xc0\x74\xdd\x20\xfa\xd8\xb2\xa2\xbf\x00\x00\x00\xff\xff\x0b\xd0\x6b\xd9\xc4\x00\x00\x00")
 
 func initSqlBytes() ([]byte, error) {
@@ -325,6 +535,16 @@ var _bindata = map[string]func() (*asset, error){
 	"19_remove_search_configs.sql": _19_remove_search_configsSql,
 	"20_remove_fields.sql":         _20_remove_fieldsSql,
 	"21_blobstore.sql":             _21_blobstoreSql,
+	"22_item_value_authors.sql":    _22_item_value_authorsSql,
+	"23_business_id_deletions.sql": _23_business_id_deletionsSql,
+	"24_index_outbox.sql":          _24_index_outboxSql,
+	"25_index_watermarks.sql":      _25_index_watermarksSql,
+	"26_item_owner_groups.sql":     _26_item_owner_groupsSql,
+	"27_api_keys.sql":              _27_api_keysSql,
+	"28_audit_log.sql":             _28_audit_logSql,
+	"29_saved_searches.sql":        _29_saved_searchesSql,
+	"30_oai_records.sql":           _30_oai_recordsSql,
+	"31_item_visibility.sql":       _31_item_visibilitySql,
 	"init.sql":                     initSql,
 }
 
@@ -377,6 +597,16 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"19_remove_search_configs.sql": &bintree{_19_remove_search_configsSql, map[string]*bintree{}},
 	"20_remove_fields.sql":         &bintree{_20_remove_fieldsSql, map[string]*bintree{}},
 	"21_blobstore.sql":             &bintree{_21_blobstoreSql, map[string]*bintree{}},
+	"22_item_value_authors.sql":    &bintree{_22_item_value_authorsSql, map[string]*bintree{}},
+	"23_business_id_deletions.sql": &bintree{_23_business_id_deletionsSql, map[string]*bintree{}},
+	"24_index_outbox.sql":          &bintree{_24_index_outboxSql, map[string]*bintree{}},
+	"25_index_watermarks.sql":      &bintree{_25_index_watermarksSql, map[string]*bintree{}},
+	"26_item_owner_groups.sql":     &bintree{_26_item_owner_groupsSql, map[string]*bintree{}},
+	"27_api_keys.sql":              &bintree{_27_api_keysSql, map[string]*bintree{}},
+	"28_audit_log.sql":             &bintree{_28_audit_logSql, map[string]*bintree{}},
+	"29_saved_searches.sql":        &bintree{_29_saved_searchesSql, map[string]*bintree{}},
+	"30_oai_records.sql":           &bintree{_30_oai_recordsSql, map[string]*bintree{}},
+	"31_item_visibility.sql":       &bintree{_31_item_visibilitySql, map[string]*bintree{}},
 	"init.sql":                     &bintree{initSql, map[string]*bintree{}},
 }}
 
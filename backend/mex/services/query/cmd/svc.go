@@ -8,9 +8,12 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 
 	"github.com/d4l-data4life/mex/mex/shared/cfg"
+	"github.com/d4l-data4life/mex/mex/shared/constants"
 	"github.com/d4l-data4life/mex/mex/shared/interceptors"
 	L "github.com/d4l-data4life/mex/mex/shared/log"
 	"github.com/d4l-data4life/mex/mex/shared/log/emit"
+	"github.com/d4l-data4life/mex/mex/shared/mail/flowmailer"
+	"github.com/d4l-data4life/mex/mex/shared/rdb"
 	"github.com/d4l-data4life/mex/mex/shared/searchconfig"
 	"github.com/d4l-data4life/mex/mex/shared/searchconfig/screpo"
 	"github.com/d4l-data4life/mex/mex/shared/svcutils"
@@ -19,6 +22,8 @@ import (
 	"github.com/d4l-data4life/mex/mex/services/metadata/business/fields/frepo"
 	"github.com/d4l-data4life/mex/mex/services/metadata/business/fields/hooks"
 
+	"github.com/d4l-data4life/mex/mex/services/query/endpoints/savedsearches"
+	pbSavedSearches "github.com/d4l-data4life/mex/mex/services/query/endpoints/savedsearches/pb"
 	"github.com/d4l-data4life/mex/mex/services/query/endpoints/search"
 	"github.com/d4l-data4life/mex/mex/services/query/endpoints/search/pb"
 )
@@ -148,5 +153,37 @@ func setup(ctx context.Context, opts svcutils.SetupOpts, strictConfigParsing boo
 		return err
 	}
 
+	mailer, err := flowmailer.NewMailer(ctx, opts.Log, opts.Config, opts.Redis)
+	if err != nil {
+		return err
+	}
+
+	savedSearchesService := savedsearches.Service{
+		Log: opts.Log,
+
+		Store:    &savedsearches.Store{DB: opts.DBPool},
+		Searcher: &searchService,
+		Mailer:   mailer,
+		PrivMgr:  opts.PrivMgr,
+
+		SolrCollection: opts.Config.Solr.Collection,
+
+		ConfigServiceOrigin:  opts.Config.Services.Config.Origin,
+		TemplateName:         opts.Config.SavedSearches.NotificationTemplate,
+		MaxSavedSearches:     int(opts.Config.SavedSearches.MaxSavedSearches),
+		NotificationsEnabled: opts.Config.SavedSearches.NotificationsEnabled,
+		EvaluationDelay:      opts.Config.SavedSearches.EvaluationDelay.AsDuration(),
+	}
+	topicIndexUpdate := rdb.NewTopic(ctx, opts.Log, opts.Redis, fmt.Sprintf("%s/%s", opts.Config.Redis.PubSubPrefix, constants.IndexUpdateChannelNameSuffix))
+	topicIndexUpdate.Subscribe(&savedSearchesService)
+	go savedSearchesService.RunEvaluator(ctx)
+
+	pbSavedSearches.RegisterSavedSearchesServer(opts.GRPCServer, &savedSearchesService)
+
+	err = pbSavedSearches.RegisterSavedSearchesHandlerFromEndpoint(ctx, opts.HTTPMux, opts.Config.Web.GrpcHost, opts.GRPCOpts)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -0,0 +1,248 @@
+package savedsearches
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/d4l-data4life/mex/mex/shared/auth"
+	"github.com/d4l-data4life/mex/mex/shared/constants"
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+	"github.com/d4l-data4life/mex/mex/shared/mail"
+	sharedSolr "github.com/d4l-data4life/mex/mex/shared/solr"
+	"github.com/d4l-data4life/mex/mex/shared/uuid"
+
+	pbSearch "github.com/d4l-data4life/mex/mex/services/query/endpoints/search/pb"
+)
+
+func (svc *Service) triggerChan() chan struct{} {
+	svc.initOnce.Do(func() {
+		svc.trigger = make(chan struct{}, 1)
+	})
+	return svc.trigger
+}
+
+// This method makes the Service an rdb.TopicSubscriber; it schedules an evaluation after each index update.
+func (svc *Service) Message(_ context.Context, topic string, _ string) {
+	if !strings.HasSuffix(topic, constants.IndexUpdateChannelNameSuffix) {
+		return
+	}
+
+	// A pending evaluation covers this update as well.
+	select {
+	case svc.triggerChan() <- struct{}{}:
+	default:
+	}
+}
+
+/*
+RunEvaluator re-evaluates the subscribed saved searches after index updates until the context is done. It waits for
+the evaluation delay after an update, so that bursts of updates (e.g. of single items) lead to a single evaluation.
+*/
+func (svc *Service) RunEvaluator(ctx context.Context) {
+	if !svc.NotificationsEnabled {
+		svc.Log.Info(ctx, L.Message("saved search notifications disabled"))
+		return
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-svc.triggerChan():
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(svc.EvaluationDelay):
+		}
+
+		// Updates during the delay are covered by this evaluation.
+		select {
+		case <-svc.triggerChan():
+		default:
+		}
+
+		svc.EvaluateAll(ctx)
+	}
+}
+
+// EvaluateAll re-evaluates all saved searches whose notifications are enabled and notifies about matching changes.
+func (svc *Service) EvaluateAll(ctx context.Context) {
+	searches, err := svc.Store.ListSubscribed(ctx)
+	if err != nil {
+		svc.Log.Error(ctx, L.Messagef("could not list saved searches: %s", err.Error()))
+		return
+	}
+
+	notified := 0
+	for _, search := range searches {
+		sent, err := svc.evaluate(ctx, search)
+		if err != nil {
+			svc.Log.Warn(ctx, L.Messagef("could not evaluate saved search %s: %s", search.ID, err.Error()))
+			continue
+		}
+		if sent {
+			notified++
+		}
+	}
+	svc.Log.Info(ctx, L.Messagef("saved searches evaluated: %d, notified: %d", len(searches), notified))
+}
+
+/*
+evaluate runs the saved search with the owner's claims, resolved anew from the owner's identity, on the items added or
+changed since the last evaluation, i.e. indexed from the watermark recorded then up to the current index watermark, and
+notifies about the matching ones. The evaluation is recorded before the mail is sent, so that only one query service
+replica notifies.
+*/
+func (svc *Service) evaluate(ctx context.Context, search *savedSearch) (bool, error) {
+	watermark, indexed, err := svc.Store.IndexWatermark(ctx, svc.SolrCollection)
+	if err != nil {
+		return false, err
+	}
+	if !indexed || watermark <= search.EvaluatedUntilXid {
+		// Nothing has been indexed since the last evaluation.
+		return false, nil
+	}
+
+	userCtx, err := svc.ownerContext(ctx, search)
+	if err != nil {
+		return false, err
+	}
+
+	itemIDs, err := svc.Store.ChangedItemIDs(ctx, search.EvaluatedUntilXid, watermark)
+	if err != nil {
+		return false, err
+	}
+	matches, err := svc.findMatches(userCtx, search.Request, itemIDs)
+	if err != nil {
+		return false, err
+	}
+	numMatched, err := svc.countMatches(userCtx, search.Request)
+	if err != nil {
+		return false, err
+	}
+
+	recorded, err := svc.Store.RecordEvaluation(ctx, search, watermark, numMatched, len(matches) > 0)
+	if err != nil {
+		return false, err
+	}
+	if !recorded || len(matches) == 0 {
+		return false, nil
+	}
+
+	err = svc.sendNotification(ctx, search, matches)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ownerContext returns a context with the owner's claims, provided that the owner may still search.
+func (svc *Service) ownerContext(ctx context.Context, search *savedSearch) (context.Context, error) {
+	claims, err := svc.PrivMgr.ResolveClaims(search.Identity)
+	if err != nil {
+		return nil, err
+	}
+	if !svc.maySearch(claims) {
+		return nil, fmt.Errorf("owner %s may no longer search", search.Owner)
+	}
+
+	userCtx := context.WithValue(ctx, constants.ContextKeyUserID, search.Owner)
+	return context.WithValue(userCtx, constants.ContextKeyUserClaims, claims), nil
+}
+
+// maySearch reports whether the claims grant the privileges required for searching.
+func (svc *Service) maySearch(claims *auth.Claims) bool {
+	for _, privilege := range auth.RequiredPrivileges(pbSearch.Search_Search_FullMethodName) {
+		mask := svc.PrivMgr.MustPrivMask(privilege.Resource, privilege.Verb)
+		if claims.Privileges&mask != mask {
+			return false
+		}
+	}
+	return true
+}
+
+func (svc *Service) sendNotification(ctx context.Context, search *savedSearch, matches []*sharedSolr.DocItem) error {
+	mailTemplate, err := mail.RetrieveTemplate(svc.ConfigServiceOrigin, svc.TemplateName)
+	if err != nil {
+		return err
+	}
+
+	formData := mail.FormData{
+		"savedSearchName":   search.Name,
+		"notificationEmail": search.NotificationEmail,
+	}
+
+	sender, err := mailTemplate.DetermineSenderNameAndEmail(formData)
+	if err != nil {
+		return err
+	}
+
+	// The owner is always addressed; further recipients (e.g. in BCC) can be given by the template.
+	recipients := mail.RecipientsContacts{
+		mail.RecipientType_TO: {{Email: search.NotificationEmail}},
+	}
+	if len(mailTemplate.Recipients) > 0 {
+		templateRecipients, err := mailTemplate.DetermineRecipientsNameAndEmail(formData, nil, nil)
+		if err != nil {
+			return err
+		}
+		for t, contacts := range templateRecipients {
+			recipients[t] = append(recipients[t], contacts...)
+		}
+	}
+
+	orderID := uuid.MustNewV4()
+
+	order := mail.MailOrder{
+		OrderId:        orderID,
+		TemplateEngine: mailTemplate.TemplateEngine,
+
+		Subject:  mailTemplate.Subject,
+		TextBody: mailTemplate.TextBody,
+		HtmlBody: mailTemplate.HtmlBody,
+
+		Sender: sender,
+
+		RecipientsTo:  recipients[mail.RecipientType_TO],
+		RecipientsCc:  recipients[mail.RecipientType_CC],
+		RecipientsBcc: recipients[mail.RecipientType_BCC],
+	}
+
+	_, err = svc.Mailer.SendMails(ctx, &order, map[string]any{
+		"form": formData,
+		"savedSearch": map[string]any{
+			"id":    search.ID,
+			"name":  search.Name,
+			"query": search.Request.Query,
+		},
+		"items":   toMailItems(matches),
+		"orderId": orderID,
+	})
+	if err != nil {
+		return fmt.Errorf("no mails could be sent: %s", err.Error())
+	}
+
+	svc.Log.Info(ctx, L.Messagef("saved search %s: notified about %d added or changed items (order %s)", search.ID, len(matches), orderID))
+	return nil
+}
+
+// toMailItems converts the items into the form used in the mail templates, e.g. {{ index .items 0 "values" "title" }}.
+func toMailItems(items []*sharedSolr.DocItem) []map[string]any {
+	mailItems := make([]map[string]any, len(items))
+	for i, item := range items {
+		values := mail.Item{}
+		for _, v := range item.Values {
+			values[v.FieldName] = append(values[v.FieldName], v.FieldValue)
+		}
+		mailItems[i] = map[string]any{
+			"itemId":     item.ItemId,
+			"entityType": item.EntityType,
+			"values":     values,
+		}
+	}
+	return mailItems
+}
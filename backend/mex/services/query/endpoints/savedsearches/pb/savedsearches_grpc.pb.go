@@ -0,0 +1,257 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.22.0
+// source: services/query/endpoints/savedsearches/savedsearches.proto
+
+package pbSavedSearches
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	SavedSearches_CreateSavedSearch_FullMethodName      = "/d4l.mex.savedsearches.SavedSearches/CreateSavedSearch"
+	SavedSearches_ListSavedSearches_FullMethodName      = "/d4l.mex.savedsearches.SavedSearches/ListSavedSearches"
+	SavedSearches_UpdateSavedSearch_FullMethodName      = "/d4l.mex.savedsearches.SavedSearches/UpdateSavedSearch"
+	SavedSearches_DeleteSavedSearch_FullMethodName      = "/d4l.mex.savedsearches.SavedSearches/DeleteSavedSearch"
+	SavedSearches_UnsubscribeSavedSearch_FullMethodName = "/d4l.mex.savedsearches.SavedSearches/UnsubscribeSavedSearch"
+)
+
+// SavedSearchesClient is the client API for SavedSearches service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SavedSearchesClient interface {
+	CreateSavedSearch(ctx context.Context, in *CreateSavedSearchRequest, opts ...grpc.CallOption) (*CreateSavedSearchResponse, error)
+	ListSavedSearches(ctx context.Context, in *ListSavedSearchesRequest, opts ...grpc.CallOption) (*ListSavedSearchesResponse, error)
+	UpdateSavedSearch(ctx context.Context, in *UpdateSavedSearchRequest, opts ...grpc.CallOption) (*UpdateSavedSearchResponse, error)
+	DeleteSavedSearch(ctx context.Context, in *DeleteSavedSearchRequest, opts ...grpc.CallOption) (*DeleteSavedSearchResponse, error)
+	UnsubscribeSavedSearch(ctx context.Context, in *UnsubscribeSavedSearchRequest, opts ...grpc.CallOption) (*UnsubscribeSavedSearchResponse, error)
+}
+
+type savedSearchesClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSavedSearchesClient(cc grpc.ClientConnInterface) SavedSearchesClient {
+	return &savedSearchesClient{cc}
+}
+
+func (c *savedSearchesClient) CreateSavedSearch(ctx context.Context, in *CreateSavedSearchRequest, opts ...grpc.CallOption) (*CreateSavedSearchResponse, error) {
+	out := new(CreateSavedSearchResponse)
+	err := c.cc.Invoke(ctx, SavedSearches_CreateSavedSearch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *savedSearchesClient) ListSavedSearches(ctx context.Context, in *ListSavedSearchesRequest, opts ...grpc.CallOption) (*ListSavedSearchesResponse, error) {
+	out := new(ListSavedSearchesResponse)
+	err := c.cc.Invoke(ctx, SavedSearches_ListSavedSearches_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *savedSearchesClient) UpdateSavedSearch(ctx context.Context, in *UpdateSavedSearchRequest, opts ...grpc.CallOption) (*UpdateSavedSearchResponse, error) {
+	out := new(UpdateSavedSearchResponse)
+	err := c.cc.Invoke(ctx, SavedSearches_UpdateSavedSearch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *savedSearchesClient) DeleteSavedSearch(ctx context.Context, in *DeleteSavedSearchRequest, opts ...grpc.CallOption) (*DeleteSavedSearchResponse, error) {
+	out := new(DeleteSavedSearchResponse)
+	err := c.cc.Invoke(ctx, SavedSearches_DeleteSavedSearch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *savedSearchesClient) UnsubscribeSavedSearch(ctx context.Context, in *UnsubscribeSavedSearchRequest, opts ...grpc.CallOption) (*UnsubscribeSavedSearchResponse, error) {
+	out := new(UnsubscribeSavedSearchResponse)
+	err := c.cc.Invoke(ctx, SavedSearches_UnsubscribeSavedSearch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SavedSearchesServer is the server API for SavedSearches service.
+// All implementations must embed UnimplementedSavedSearchesServer
+// for forward compatibility
+type SavedSearchesServer interface {
+	CreateSavedSearch(context.Context, *CreateSavedSearchRequest) (*CreateSavedSearchResponse, error)
+	ListSavedSearches(context.Context, *ListSavedSearchesRequest) (*ListSavedSearchesResponse, error)
+	UpdateSavedSearch(context.Context, *UpdateSavedSearchRequest) (*UpdateSavedSearchResponse, error)
+	DeleteSavedSearch(context.Context, *DeleteSavedSearchRequest) (*DeleteSavedSearchResponse, error)
+	UnsubscribeSavedSearch(context.Context, *UnsubscribeSavedSearchRequest) (*UnsubscribeSavedSearchResponse, error)
+	mustEmbedUnimplementedSavedSearchesServer()
+}
+
+// UnimplementedSavedSearchesServer must be embedded to have forward compatible implementations.
+type UnimplementedSavedSearchesServer struct {
+}
+
+func (UnimplementedSavedSearchesServer) CreateSavedSearch(context.Context, *CreateSavedSearchRequest) (*CreateSavedSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSavedSearch not implemented")
+}
+func (UnimplementedSavedSearchesServer) ListSavedSearches(context.Context, *ListSavedSearchesRequest) (*ListSavedSearchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSavedSearches not implemented")
+}
+func (UnimplementedSavedSearchesServer) UpdateSavedSearch(context.Context, *UpdateSavedSearchRequest) (*UpdateSavedSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSavedSearch not implemented")
+}
+func (UnimplementedSavedSearchesServer) DeleteSavedSearch(context.Context, *DeleteSavedSearchRequest) (*DeleteSavedSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSavedSearch not implemented")
+}
+func (UnimplementedSavedSearchesServer) UnsubscribeSavedSearch(context.Context, *UnsubscribeSavedSearchRequest) (*UnsubscribeSavedSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribeSavedSearch not implemented")
+}
+func (UnimplementedSavedSearchesServer) mustEmbedUnimplementedSavedSearchesServer() {}
+
+// UnsafeSavedSearchesServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SavedSearchesServer will
+// result in compilation errors.
+type UnsafeSavedSearchesServer interface {
+	mustEmbedUnimplementedSavedSearchesServer()
+}
+
+func RegisterSavedSearchesServer(s grpc.ServiceRegistrar, srv SavedSearchesServer) {
+	s.RegisterService(&SavedSearches_ServiceDesc, srv)
+}
+
+func _SavedSearches_CreateSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSavedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SavedSearchesServer).CreateSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SavedSearches_CreateSavedSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SavedSearchesServer).CreateSavedSearch(ctx, req.(*CreateSavedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SavedSearches_ListSavedSearches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSavedSearchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SavedSearchesServer).ListSavedSearches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SavedSearches_ListSavedSearches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SavedSearchesServer).ListSavedSearches(ctx, req.(*ListSavedSearchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SavedSearches_UpdateSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSavedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SavedSearchesServer).UpdateSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SavedSearches_UpdateSavedSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SavedSearchesServer).UpdateSavedSearch(ctx, req.(*UpdateSavedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SavedSearches_DeleteSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSavedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SavedSearchesServer).DeleteSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SavedSearches_DeleteSavedSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SavedSearchesServer).DeleteSavedSearch(ctx, req.(*DeleteSavedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SavedSearches_UnsubscribeSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeSavedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SavedSearchesServer).UnsubscribeSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SavedSearches_UnsubscribeSavedSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SavedSearchesServer).UnsubscribeSavedSearch(ctx, req.(*UnsubscribeSavedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SavedSearches_ServiceDesc is the grpc.ServiceDesc for SavedSearches service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SavedSearches_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "d4l.mex.savedsearches.SavedSearches",
+	HandlerType: (*SavedSearchesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateSavedSearch",
+			Handler:    _SavedSearches_CreateSavedSearch_Handler,
+		},
+		{
+			MethodName: "ListSavedSearches",
+			Handler:    _SavedSearches_ListSavedSearches_Handler,
+		},
+		{
+			MethodName: "UpdateSavedSearch",
+			Handler:    _SavedSearches_UpdateSavedSearch_Handler,
+		},
+		{
+			MethodName: "DeleteSavedSearch",
+			Handler:    _SavedSearches_DeleteSavedSearch_Handler,
+		},
+		{
+			MethodName: "UnsubscribeSavedSearch",
+			Handler:    _SavedSearches_UnsubscribeSavedSearch_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "services/query/endpoints/savedsearches/savedsearches.proto",
+}
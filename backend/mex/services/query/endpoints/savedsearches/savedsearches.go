@@ -0,0 +1,321 @@
+package savedsearches
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	netMail "net/mail"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/d4l-data4life/mex/mex/shared/auth"
+	E "github.com/d4l-data4life/mex/mex/shared/errstat"
+	"github.com/d4l-data4life/mex/mex/shared/hints"
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+	"github.com/d4l-data4life/mex/mex/shared/mail"
+	sharedSolr "github.com/d4l-data4life/mex/mex/shared/solr"
+
+	pbSavedSearches "github.com/d4l-data4life/mex/mex/services/query/endpoints/savedsearches/pb"
+	pbSearch "github.com/d4l-data4life/mex/mex/services/query/endpoints/search/pb"
+)
+
+// Notifications report at most this many items, so that mass updates do not lead to huge mails.
+const maxReportedMatches = 100
+
+// Searcher executes search requests on behalf of the user whose claims are in the context.
+type Searcher interface {
+	Search(ctx context.Context, request *pbSearch.SearchRequest) (*pbSearch.SearchResponse, error)
+	// SearchItems restricts the search to the items with the given IDs (at most MaxDocLimit).
+	SearchItems(ctx context.Context, request *pbSearch.SearchRequest, itemIDs []string) (*pbSearch.SearchResponse, error)
+}
+
+type Service struct {
+	Log L.Logger
+
+	Store    *Store
+	Searcher Searcher
+	Mailer   mail.Mailer
+	// Resolves the owners' claims at evaluation time, so that changed roles and visibility rules take effect.
+	PrivMgr *auth.PrivMgr
+	// The collection whose index watermark tells which items have been indexed.
+	SolrCollection string
+
+	ConfigServiceOrigin  string
+	TemplateName         string
+	MaxSavedSearches     int
+	NotificationsEnabled bool
+	EvaluationDelay      time.Duration
+
+	initOnce sync.Once
+	trigger  chan struct{}
+
+	pbSavedSearches.UnimplementedSavedSearchesServer
+}
+
+func (svc *Service) CreateSavedSearch(ctx context.Context, request *pbSavedSearches.CreateSavedSearchRequest) (*pbSavedSearches.CreateSavedSearchResponse, error) {
+	user, err := auth.GetAuthenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validate(request.Name, request.Request, request.NotificationEmail, request.NotificationsEnabled, user.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := svc.Store.Count(ctx, user.UserId)
+	if err != nil {
+		return nil, E.MakeGRPCStatus(codes.Internal, "could not count saved searches", E.Cause(err)).Err()
+	}
+	if svc.MaxSavedSearches > 0 && count >= svc.MaxSavedSearches {
+		return nil, E.MakeGRPCStatus(codes.ResourceExhausted, fmt.Sprintf("at most %d searches can be saved", svc.MaxSavedSearches)).Err()
+	}
+
+	// The items indexed now are the baseline; only items added or changed later on are reported.
+	watermark, numMatched, err := svc.baseline(ctx, request.Request)
+	if err != nil {
+		return nil, E.MakeGRPCStatus(E.CodeFrom(err), "could not evaluate search", E.Cause(err)).Err()
+	}
+
+	search, err := svc.Store.Create(ctx, &savedSearch{
+		Owner:                user.UserId,
+		Name:                 request.Name,
+		Request:              request.Request,
+		Identity:             user,
+		NotificationEmail:    request.NotificationEmail,
+		NotificationsEnabled: request.NotificationsEnabled,
+		EvaluatedUntilXid:    watermark,
+		NumMatched:           numMatched,
+	})
+	if err != nil {
+		return nil, E.MakeGRPCStatus(codes.Internal, "could not save search", E.Cause(err)).Err()
+	}
+	svc.Log.Info(ctx, L.Messagef("search saved: %s (%s)", search.ID, search.Name))
+
+	hints.HintHTTPStatusCode(ctx, http.StatusCreated)
+	return &pbSavedSearches.CreateSavedSearchResponse{SavedSearch: toProto(search)}, nil
+}
+
+func (svc *Service) ListSavedSearches(ctx context.Context, _ *pbSavedSearches.ListSavedSearchesRequest) (*pbSavedSearches.ListSavedSearchesResponse, error) {
+	user, err := auth.GetMexUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	searches, err := svc.Store.List(ctx, user.UserId)
+	if err != nil {
+		return nil, E.MakeGRPCStatus(codes.Internal, "could not list saved searches", E.Cause(err)).Err()
+	}
+
+	response := pbSavedSearches.ListSavedSearchesResponse{SavedSearches: make([]*pbSavedSearches.SavedSearch, len(searches))}
+	for i, search := range searches {
+		response.SavedSearches[i] = toProto(search)
+	}
+	return &response, nil
+}
+
+func (svc *Service) UpdateSavedSearch(ctx context.Context, request *pbSavedSearches.UpdateSavedSearchRequest) (*pbSavedSearches.UpdateSavedSearchResponse, error) {
+	user, err := auth.GetAuthenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validate(request.Name, request.Request, request.NotificationEmail, request.NotificationsEnabled, user.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	search, err := svc.Store.Get(ctx, request.Id, user.UserId)
+	if err != nil {
+		return nil, E.MakeGRPCStatus(E.CodeFrom(err), "could not read saved search", E.DevMessage(err.Error())).Err()
+	}
+
+	if !proto.Equal(search.Request, request.Request) {
+		watermark, numMatched, err := svc.baseline(ctx, request.Request)
+		if err != nil {
+			return nil, E.MakeGRPCStatus(E.CodeFrom(err), "could not evaluate search", E.Cause(err)).Err()
+		}
+		now := time.Now()
+		search.Request = request.Request
+		search.EvaluatedUntilXid = watermark
+		search.NumMatched = numMatched
+		search.LastEvaluatedAt = &now
+	}
+
+	search.Name = request.Name
+	search.Identity = user
+	search.NotificationEmail = request.NotificationEmail
+	search.NotificationsEnabled = request.NotificationsEnabled
+
+	search, err = svc.Store.Update(ctx, search)
+	if err != nil {
+		return nil, E.MakeGRPCStatus(E.CodeFrom(err), "could not update saved search", E.DevMessage(err.Error())).Err()
+	}
+	svc.Log.Info(ctx, L.Messagef("saved search updated: %s (%s)", search.ID, search.Name))
+
+	return &pbSavedSearches.UpdateSavedSearchResponse{SavedSearch: toProto(search)}, nil
+}
+
+func (svc *Service) DeleteSavedSearch(ctx context.Context, request *pbSavedSearches.DeleteSavedSearchRequest) (*pbSavedSearches.DeleteSavedSearchResponse, error) {
+	user, err := auth.GetMexUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = svc.Store.Delete(ctx, request.Id, user.UserId)
+	if err != nil {
+		return nil, E.MakeGRPCStatus(E.CodeFrom(err), "could not delete saved search", E.DevMessage(err.Error())).Err()
+	}
+	svc.Log.Info(ctx, L.Messagef("saved search deleted: %s", request.Id))
+
+	hints.HintHTTPStatusCode(ctx, http.StatusNoContent)
+	return &pbSavedSearches.DeleteSavedSearchResponse{}, nil
+}
+
+func (svc *Service) UnsubscribeSavedSearch(ctx context.Context, request *pbSavedSearches.UnsubscribeSavedSearchRequest) (*pbSavedSearches.UnsubscribeSavedSearchResponse, error) {
+	user, err := auth.GetMexUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	search, err := svc.Store.Unsubscribe(ctx, request.Id, user.UserId)
+	if err != nil {
+		return nil, E.MakeGRPCStatus(E.CodeFrom(err), "could not unsubscribe saved search", E.DevMessage(err.Error())).Err()
+	}
+	svc.Log.Info(ctx, L.Messagef("saved search unsubscribed: %s (%s)", search.ID, search.Name))
+
+	return &pbSavedSearches.UnsubscribeSavedSearchResponse{SavedSearch: toProto(search)}, nil
+}
+
+/*
+baseline returns the index watermark from which on added or changed items are to be evaluated, and the number of items
+matching the search now. If nothing has been indexed yet, the first index update covers the items changed from now on.
+*/
+func (svc *Service) baseline(ctx context.Context, request *pbSearch.SearchRequest) (int64, int, error) {
+	watermark, indexed, err := svc.Store.IndexWatermark(ctx, svc.SolrCollection)
+	if err != nil {
+		return 0, 0, err
+	}
+	if !indexed {
+		watermark, err = svc.Store.NextIndexWatermark(ctx)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	numMatched, err := svc.countMatches(ctx, request)
+	if err != nil {
+		return 0, 0, err
+	}
+	return watermark, numMatched, nil
+}
+
+// countMatches runs the search with the claims in the context and returns the number of matching items.
+func (svc *Service) countMatches(ctx context.Context, request *pbSearch.SearchRequest) (int, error) {
+	countRequest := evaluationRequest(request)
+	countRequest.Limit = 0
+	response, err := svc.Searcher.Search(ctx, countRequest)
+	if err != nil {
+		return 0, err
+	}
+	return int(response.NumFound), nil
+}
+
+/*
+findMatches runs the search with the claims in the context, restricted to the items with the given IDs, and returns
+the matching items, at most maxReportedMatches of them. Facets and highlighting of the saved request are ignored.
+*/
+func (svc *Service) findMatches(ctx context.Context, request *pbSearch.SearchRequest, itemIDs []string) ([]*sharedSolr.DocItem, error) {
+	batchRequest := evaluationRequest(request)
+	matches := []*sharedSolr.DocItem{}
+	for start := 0; start < len(itemIDs) && len(matches) < maxReportedMatches; start += sharedSolr.MaxDocLimit {
+		end := start + sharedSolr.MaxDocLimit
+		if end > len(itemIDs) {
+			end = len(itemIDs)
+		}
+		response, err := svc.Searcher.SearchItems(ctx, batchRequest, itemIDs[start:end])
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, response.Items...)
+	}
+
+	if len(matches) > maxReportedMatches {
+		matches = matches[:maxReportedMatches]
+	}
+	return matches, nil
+}
+
+// evaluationRequest returns the request for one batch of items, each batch fitting into a single page.
+func evaluationRequest(request *pbSearch.SearchRequest) *pbSearch.SearchRequest {
+	evalRequest := proto.Clone(request).(*pbSearch.SearchRequest)
+	evalRequest.Limit = sharedSolr.MaxDocLimit
+	evalRequest.Offset = 0
+	evalRequest.Cursor = ""
+	evalRequest.Facets = nil
+	evalRequest.HighlightFields = nil
+	evalRequest.AutoHighlight = false
+	return evalRequest
+}
+
+func validate(name string, request *pbSearch.SearchRequest, notificationEmail string, notificationsEnabled bool, ownEmail string) error {
+	if name == "" {
+		return E.MakeGRPCStatus(codes.InvalidArgument, "saved search name missing").Err()
+	}
+
+	if request == nil {
+		return E.MakeGRPCStatus(codes.InvalidArgument, "search request missing").Err()
+	}
+
+	if notificationsEnabled && notificationEmail == "" {
+		return E.MakeGRPCStatus(codes.InvalidArgument, "notification email address missing").Err()
+	}
+
+	if notificationEmail != "" {
+		return validateNotificationEmail(notificationEmail, ownEmail)
+	}
+	return nil
+}
+
+// validateNotificationEmail checks that notifications go to the user's own email address as asserted by the identity
+// provider, so that saved searches cannot be used to send mails to arbitrary addresses.
+func validateNotificationEmail(notificationEmail string, ownEmail string) error {
+	address, err := netMail.ParseAddress(notificationEmail)
+	if err != nil {
+		return E.MakeGRPCStatus(codes.InvalidArgument, fmt.Sprintf("invalid notification email address: %s", err.Error())).Err()
+	}
+	if ownEmail == "" {
+		return E.MakeGRPCStatus(codes.FailedPrecondition, "no email address is known for the user").Err()
+	}
+	if !strings.EqualFold(address.Address, ownEmail) {
+		return E.MakeGRPCStatus(codes.PermissionDenied, "notifications can only be sent to the user's own email address").Err()
+	}
+	return nil
+}
+
+func toProto(search *savedSearch) *pbSavedSearches.SavedSearch {
+	return &pbSavedSearches.SavedSearch{
+		Id:                   search.ID,
+		Name:                 search.Name,
+		Request:              search.Request,
+		NotificationEmail:    search.NotificationEmail,
+		NotificationsEnabled: search.NotificationsEnabled,
+		NumMatched:           uint32(search.NumMatched),
+		CreatedAt:            timestamppb.New(search.CreatedAt),
+		UpdatedAt:            timestamppb.New(search.UpdatedAt),
+		LastEvaluatedAt:      optionalTimestamp(search.LastEvaluatedAt),
+		LastNotifiedAt:       optionalTimestamp(search.LastNotifiedAt),
+	}
+}
+
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
@@ -0,0 +1,270 @@
+package savedsearches
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/d4l-data4life/mex/mex/shared/auth"
+	"github.com/d4l-data4life/mex/mex/shared/db"
+	"github.com/d4l-data4life/mex/mex/shared/known/securitypb"
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+	sharedSolr "github.com/d4l-data4life/mex/mex/shared/solr"
+
+	pbSearch "github.com/d4l-data4life/mex/mex/services/query/endpoints/search/pb"
+)
+
+func Test_evaluationRequest(t *testing.T) {
+	request := &pbSearch.SearchRequest{
+		Query:           "virus",
+		Fields:          []string{"title"},
+		Limit:           10,
+		Offset:          20,
+		HighlightFields: []string{"title"},
+		AutoHighlight:   true,
+		Cursor:          sharedSolr.CursorMarkStart,
+	}
+
+	got := evaluationRequest(request)
+	if got.Query != "virus" || got.Limit != sharedSolr.MaxDocLimit || got.Offset != 0 || got.Cursor != "" || got.HighlightFields != nil || got.AutoHighlight {
+		t.Errorf("evaluationRequest() = %v", got)
+	}
+	if !reflect.DeepEqual(got.Fields, []string{"title"}) {
+		t.Errorf("evaluationRequest() fields = %v", got.Fields)
+	}
+	if request.Limit != 10 || request.Offset != 20 || request.Cursor != sharedSolr.CursorMarkStart {
+		t.Errorf("evaluationRequest() modified the saved request")
+	}
+}
+
+// itemSearcher matches the items whose IDs are in the given set and records the batches of item IDs searched.
+type itemSearcher struct {
+	matching map[string]bool
+	numFound uint32
+	batches  []int
+}
+
+func (searcher *itemSearcher) Search(_ context.Context, _ *pbSearch.SearchRequest) (*pbSearch.SearchResponse, error) {
+	return &pbSearch.SearchResponse{NumFound: searcher.numFound}, nil
+}
+
+func (searcher *itemSearcher) SearchItems(_ context.Context, _ *pbSearch.SearchRequest, itemIDs []string) (*pbSearch.SearchResponse, error) {
+	searcher.batches = append(searcher.batches, len(itemIDs))
+	response := &pbSearch.SearchResponse{}
+	for _, id := range itemIDs {
+		if searcher.matching[id] {
+			response.Items = append(response.Items, sharedSolr.NewDocItem(id))
+		}
+	}
+	return response, nil
+}
+
+func itemIDs(n int) []string {
+	ids := make([]string, n)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("i%d", i)
+	}
+	return ids
+}
+
+func matchAll(ids []string) map[string]bool {
+	matching := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		matching[id] = true
+	}
+	return matching
+}
+
+func TestService_findMatches(t *testing.T) {
+	tests := []struct {
+		name        string
+		itemIDs     []string
+		matching    map[string]bool
+		wantMatches int
+		wantBatches []int
+	}{
+		{
+			name:        "No changed items",
+			itemIDs:     []string{},
+			wantMatches: 0,
+			wantBatches: nil,
+		},
+		{
+			name:        "The changed items are searched in batches",
+			itemIDs:     itemIDs(2500),
+			matching:    map[string]bool{"i1": true, "i1999": true, "i2499": true},
+			wantMatches: 3,
+			wantBatches: []int{1000, 1000, 500},
+		},
+		{
+			name:        "The number of reported matches is capped",
+			itemIDs:     itemIDs(2500),
+			matching:    matchAll(itemIDs(2500)),
+			wantMatches: maxReportedMatches,
+			wantBatches: []int{1000},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			searcher := &itemSearcher{matching: tt.matching}
+			svc := &Service{Searcher: searcher}
+
+			matches, err := svc.findMatches(context.Background(), &pbSearch.SearchRequest{Query: "virus"}, tt.itemIDs)
+			if err != nil {
+				t.Fatalf("findMatches() error = %v", err)
+			}
+			if len(matches) != tt.wantMatches {
+				t.Errorf("findMatches() = %d matches, want %d", len(matches), tt.wantMatches)
+			}
+			if !reflect.DeepEqual(searcher.batches, tt.wantBatches) {
+				t.Errorf("findMatches() batches = %v, want %v", searcher.batches, tt.wantBatches)
+			}
+		})
+	}
+}
+
+func TestService_maySearch(t *testing.T) {
+	svc := &Service{PrivMgr: auth.NewPrivMgr()}
+
+	tests := []struct {
+		name  string
+		roles []string
+		want  bool
+	}{
+		{name: "Consumer", roles: []string{auth.RoleConsumer}, want: true},
+		{name: "No roles (anymore)", roles: []string{}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := svc.PrivMgr.ResolveClaims(&securitypb.UserWithRoles{UserId: "u1", Roles: tt.roles})
+			if err != nil {
+				t.Fatalf("ResolveClaims() error = %v", err)
+			}
+			if got := svc.maySearch(claims); got != tt.want {
+				t.Errorf("maySearch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_validate(t *testing.T) {
+	tests := []struct {
+		name                 string
+		searchName           string
+		request              *pbSearch.SearchRequest
+		notificationEmail    string
+		notificationsEnabled bool
+		ownEmail             string
+		wantCode             codes.Code
+	}{
+		{name: "Valid", searchName: "Viruses", request: &pbSearch.SearchRequest{}, notificationEmail: "jane@example.org", notificationsEnabled: true, ownEmail: "jane@example.org", wantCode: codes.OK},
+		{name: "Own email in a different case", searchName: "Viruses", request: &pbSearch.SearchRequest{}, notificationEmail: "Jane <Jane@Example.org>", ownEmail: "jane@example.org", wantCode: codes.OK},
+		{name: "Valid without notifications", searchName: "Viruses", request: &pbSearch.SearchRequest{}, wantCode: codes.OK},
+		{name: "No name", request: &pbSearch.SearchRequest{}, wantCode: codes.InvalidArgument},
+		{name: "No request", searchName: "Viruses", wantCode: codes.InvalidArgument},
+		{name: "No email", searchName: "Viruses", request: &pbSearch.SearchRequest{}, notificationsEnabled: true, wantCode: codes.InvalidArgument},
+		{name: "Invalid email", searchName: "Viruses", request: &pbSearch.SearchRequest{}, notificationEmail: "jane", ownEmail: "jane@example.org", wantCode: codes.InvalidArgument},
+		{name: "Someone else's email", searchName: "Viruses", request: &pbSearch.SearchRequest{}, notificationEmail: "john@example.org", ownEmail: "jane@example.org", wantCode: codes.PermissionDenied},
+		{name: "No own email known", searchName: "Viruses", request: &pbSearch.SearchRequest{}, notificationEmail: "jane@example.org", wantCode: codes.FailedPrecondition},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validate(tt.searchName, tt.request, tt.notificationEmail, tt.notificationsEnabled, tt.ownEmail)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Errorf("validate() error = %v, want code %v", err, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestService_evaluate(t *testing.T) {
+	tests := []struct {
+		name           string
+		watermark      [][]any
+		matching       map[string]bool
+		recorded       int64
+		wantStatements []string
+		wantRecord     []any
+		wantNotified   bool
+	}{
+		{
+			name:           "Nothing indexed yet",
+			watermark:      [][]any{},
+			wantStatements: []string{"DbGetIndexWatermark"},
+		},
+		{
+			name:           "Nothing indexed since the last evaluation",
+			watermark:      [][]any{{int64(100)}},
+			wantStatements: []string{"DbGetIndexWatermark"},
+		},
+		{
+			name:           "No changed item matches",
+			watermark:      [][]any{{int64(120)}},
+			recorded:       1,
+			wantStatements: []string{"DbGetIndexWatermark", "DbListChangedItemIDs", "DbRecordSavedSearchEvaluation"},
+			wantRecord:     []any{int64(120), int32(7), false, "s1", int32(3)},
+		},
+		{
+			name:           "Matching changes are not notified if someone else evaluated the search meanwhile",
+			watermark:      [][]any{{int64(120)}},
+			matching:       map[string]bool{"i2": true},
+			recorded:       0,
+			wantStatements: []string{"DbGetIndexWatermark", "DbListChangedItemIDs", "DbRecordSavedSearchEvaluation"},
+			wantRecord:     []any{int64(120), int32(7), true, "s1", int32(3)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := db.NewMockTx(func(stmt db.MockStatement) db.MockResult {
+				switch stmt.Name {
+				case "DbGetIndexWatermark":
+					return db.MockResult{Rows: tt.watermark}
+				case "DbListChangedItemIDs":
+					return db.MockResult{Rows: [][]any{{"i1"}, {"i2"}}}
+				case "DbRecordSavedSearchEvaluation":
+					return db.MockResult{RowsAffected: tt.recorded}
+				}
+				return db.MockResult{}
+			})
+			svc := &Service{
+				Log:            &L.NullLogger{},
+				Store:          &Store{DB: tx},
+				Searcher:       &itemSearcher{matching: tt.matching, numFound: 7},
+				PrivMgr:        auth.NewPrivMgr(),
+				SolrCollection: "mex",
+			}
+			search := &savedSearch{
+				ID:                "s1",
+				Owner:             "u1",
+				Request:           &pbSearch.SearchRequest{Query: "virus"},
+				Identity:          &securitypb.UserWithRoles{UserId: "u1", Roles: []string{auth.RoleConsumer}},
+				EvaluatedUntilXid: 100,
+				Version:           3,
+			}
+
+			notified, err := svc.evaluate(context.Background(), search)
+			if err != nil {
+				t.Fatalf("evaluate() error = %v", err)
+			}
+			if notified != tt.wantNotified {
+				t.Errorf("evaluate() = %v, want %v", notified, tt.wantNotified)
+			}
+			if got := tx.Statements(); !reflect.DeepEqual(got, tt.wantStatements) {
+				t.Errorf("evaluate() statements = %v, want %v", got, tt.wantStatements)
+			}
+			if tt.wantRecord == nil {
+				return
+			}
+			if got := tx.Executed("DbListChangedItemIDs")[0].Args; !reflect.DeepEqual(got, []any{int64(100), int64(120)}) {
+				t.Errorf("evaluate() listed changes between %v", got)
+			}
+			if got := tx.Executed("DbRecordSavedSearchEvaluation")[0].Args; !reflect.DeepEqual(got, tt.wantRecord) {
+				t.Errorf("evaluate() recorded %v, want %v", got, tt.wantRecord)
+			}
+		})
+	}
+}
@@ -0,0 +1,276 @@
+package savedsearches
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/d4l-data4life/mex/mex/shared/db"
+	"github.com/d4l-data4life/mex/mex/shared/errstat"
+	"github.com/d4l-data4life/mex/mex/shared/known/securitypb"
+	"github.com/d4l-data4life/mex/mex/shared/uuid"
+
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/datamodel"
+	pbSearch "github.com/d4l-data4life/mex/mex/services/query/endpoints/search/pb"
+)
+
+// savedSearch is a saved search as stored in Postgres (table saved_searches, created by the metadata service migrations).
+type savedSearch struct {
+	ID      string
+	Owner   string
+	Name    string
+	Request *pbSearch.SearchRequest
+	// The owner as last authenticated; the claims are resolved from it at each evaluation.
+	Identity             *securitypb.UserWithRoles
+	NotificationEmail    string
+	NotificationsEnabled bool
+	// The index watermark as of the last evaluation; items changed from there on have not been evaluated yet.
+	EvaluatedUntilXid int64
+	NumMatched        int
+	Version           int32
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	LastEvaluatedAt   *time.Time
+	LastNotifiedAt    *time.Time
+}
+
+// Store persists the saved searches.
+type Store struct {
+	DB db.Pool
+}
+
+// Create stores a new saved search.
+func (store *Store) Create(ctx context.Context, search *savedSearch) (*savedSearch, error) {
+	request, identity, err := marshalRequestAndIdentity(search)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := datamodel.New(store.DB).DbCreateSavedSearch(ctx, datamodel.DbCreateSavedSearchParams{
+		ID:                   uuid.MustNewV4(),
+		Owner:                search.Owner,
+		Name:                 search.Name,
+		Request:              request,
+		Identity:             identity,
+		NotificationEmail:    search.NotificationEmail,
+		NotificationsEnabled: search.NotificationsEnabled,
+		EvaluatedUntilXid:    search.EvaluatedUntilXid,
+		NumMatched:           int32(search.NumMatched),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error storing saved search: %w", err)
+	}
+	return fromRow(row)
+}
+
+// Count returns the number of searches saved by the given owner.
+func (store *Store) Count(ctx context.Context, owner string) (int, error) {
+	count, err := datamodel.New(store.DB).DbCountSavedSearches(ctx, owner)
+	if err != nil {
+		return 0, fmt.Errorf("error counting saved searches: %w", err)
+	}
+	return int(count), nil
+}
+
+// List returns the searches saved by the given owner.
+func (store *Store) List(ctx context.Context, owner string) ([]*savedSearch, error) {
+	rows, err := datamodel.New(store.DB).DbListSavedSearches(ctx, owner)
+	if err != nil {
+		return nil, fmt.Errorf("error listing saved searches: %w", err)
+	}
+	return fromRows(rows)
+}
+
+// ListSubscribed returns the searches of all owners whose notifications are enabled.
+func (store *Store) ListSubscribed(ctx context.Context) ([]*savedSearch, error) {
+	rows, err := datamodel.New(store.DB).DbListSubscribedSavedSearches(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing saved searches: %w", err)
+	}
+	return fromRows(rows)
+}
+
+// Get returns the saved search with the given ID if it belongs to the given owner.
+func (store *Store) Get(ctx context.Context, id string, owner string) (*savedSearch, error) {
+	row, err := datamodel.New(store.DB).DbGetSavedSearch(ctx, datamodel.DbGetSavedSearchParams{ID: id, Owner: owner})
+	if err == pgx.ErrNoRows {
+		return nil, errstat.MakeGRPCStatus(codes.NotFound, fmt.Sprintf("saved search not found: %s", id)).Err()
+	} else if err != nil {
+		return nil, fmt.Errorf("error reading saved search: %s: %w", id, err)
+	}
+	return fromRow(row)
+}
+
+/*
+Update replaces the editable properties, the owner's identity, and the evaluation state of the saved search. It fails
+with Aborted if the search was changed (or evaluated) since it was read.
+*/
+func (store *Store) Update(ctx context.Context, search *savedSearch) (*savedSearch, error) {
+	request, identity, err := marshalRequestAndIdentity(search)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := datamodel.New(store.DB).DbUpdateSavedSearch(ctx, datamodel.DbUpdateSavedSearchParams{
+		ID:                   search.ID,
+		Version:              search.Version,
+		Name:                 search.Name,
+		Request:              request,
+		Identity:             identity,
+		NotificationEmail:    search.NotificationEmail,
+		NotificationsEnabled: search.NotificationsEnabled,
+		EvaluatedUntilXid:    search.EvaluatedUntilXid,
+		NumMatched:           int32(search.NumMatched),
+		LastEvaluatedAt:      toTimestamptz(search.LastEvaluatedAt),
+	})
+	if err == pgx.ErrNoRows {
+		return nil, errstat.MakeGRPCStatus(codes.Aborted, fmt.Sprintf("saved search was modified concurrently: %s", search.ID)).Err()
+	} else if err != nil {
+		return nil, fmt.Errorf("error updating saved search: %s: %w", search.ID, err)
+	}
+	return fromRow(row)
+}
+
+// Unsubscribe disables the notifications of the saved search.
+func (store *Store) Unsubscribe(ctx context.Context, id string, owner string) (*savedSearch, error) {
+	row, err := datamodel.New(store.DB).DbUnsubscribeSavedSearch(ctx, datamodel.DbUnsubscribeSavedSearchParams{ID: id, Owner: owner})
+	if err == pgx.ErrNoRows {
+		return nil, errstat.MakeGRPCStatus(codes.NotFound, fmt.Sprintf("saved search not found: %s", id)).Err()
+	} else if err != nil {
+		return nil, fmt.Errorf("error unsubscribing saved search: %s: %w", id, err)
+	}
+	return fromRow(row)
+}
+
+// Delete removes the saved search.
+func (store *Store) Delete(ctx context.Context, id string, owner string) error {
+	deleted, err := datamodel.New(store.DB).DbDeleteSavedSearch(ctx, datamodel.DbDeleteSavedSearchParams{ID: id, Owner: owner})
+	if err != nil {
+		return fmt.Errorf("error deleting saved search: %s: %w", id, err)
+	}
+	if deleted == 0 {
+		return errstat.MakeGRPCStatus(codes.NotFound, fmt.Sprintf("saved search not found: %s", id)).Err()
+	}
+	return nil
+}
+
+/*
+RecordEvaluation stores the index watermark up to which the search has been evaluated and the number of matches. It
+returns false if the search was changed or evaluated by someone else since it was read; in that case, the caller must
+not notify, as the other party is responsible.
+*/
+func (store *Store) RecordEvaluation(ctx context.Context, search *savedSearch, evaluatedUntilXid int64, numMatched int, notified bool) (bool, error) {
+	recorded, err := datamodel.New(store.DB).DbRecordSavedSearchEvaluation(ctx, datamodel.DbRecordSavedSearchEvaluationParams{
+		EvaluatedUntilXid: evaluatedUntilXid,
+		NumMatched:        int32(numMatched),
+		Notified:          notified,
+		ID:                search.ID,
+		Version:           search.Version,
+	})
+	if err != nil {
+		return false, fmt.Errorf("error recording evaluation of saved search: %s: %w", search.ID, err)
+	}
+	return recorded == 1, nil
+}
+
+/*
+IndexWatermark returns the watermark of the index of the given Solr collection: the changes of all transactions before
+it have been indexed. It returns false if the collection has not been loaded yet.
+*/
+func (store *Store) IndexWatermark(ctx context.Context, collection string) (int64, bool, error) {
+	watermark, err := datamodel.New(store.DB).DbGetIndexWatermark(ctx, collection)
+	if err == pgx.ErrNoRows {
+		return 0, false, nil
+	} else if err != nil {
+		return 0, false, fmt.Errorf("error reading index watermark: %w", err)
+	}
+	return watermark, true, nil
+}
+
+// NextIndexWatermark returns the watermark of an index update starting now; later changes are not indexed by it.
+func (store *Store) NextIndexWatermark(ctx context.Context) (int64, error) {
+	watermark, err := datamodel.New(store.DB).DbNextIndexWatermark(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("error determining index watermark: %w", err)
+	}
+	return watermark, nil
+}
+
+// ChangedItemIDs returns the IDs of the latest items whose business IDs were changed between the given watermarks.
+func (store *Store) ChangedItemIDs(ctx context.Context, since int64, until int64) ([]string, error) {
+	itemIDs, err := datamodel.New(store.DB).DbListChangedItemIDs(ctx, datamodel.DbListChangedItemIDsParams{Since: since, Until: until})
+	if err != nil {
+		return nil, fmt.Errorf("error listing changed items: %w", err)
+	}
+	return itemIDs, nil
+}
+
+func marshalRequestAndIdentity(search *savedSearch) ([]byte, []byte, error) {
+	request, err := protojson.Marshal(search.Request)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error marshalling search request: %w", err)
+	}
+	identity, err := protojson.Marshal(search.Identity)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error marshalling owner identity: %w", err)
+	}
+	return request, identity, nil
+}
+
+func fromRows(rows []datamodel.SavedSearch) ([]*savedSearch, error) {
+	searches := make([]*savedSearch, 0, len(rows))
+	for _, row := range rows {
+		search, err := fromRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("error listing saved searches: %w", err)
+		}
+		searches = append(searches, search)
+	}
+	return searches, nil
+}
+
+func fromRow(row datamodel.SavedSearch) (*savedSearch, error) {
+	search := savedSearch{
+		ID:                   row.ID,
+		Owner:                row.Owner,
+		Name:                 row.Name,
+		Request:              &pbSearch.SearchRequest{},
+		Identity:             &securitypb.UserWithRoles{},
+		NotificationEmail:    row.NotificationEmail,
+		NotificationsEnabled: row.NotificationsEnabled,
+		EvaluatedUntilXid:    row.EvaluatedUntilXid,
+		NumMatched:           int(row.NumMatched),
+		Version:              row.Version,
+		CreatedAt:            row.CreatedAt.Time,
+		UpdatedAt:            row.UpdatedAt.Time,
+		LastEvaluatedAt:      fromTimestamptz(row.LastEvaluatedAt),
+		LastNotifiedAt:       fromTimestamptz(row.LastNotifiedAt),
+	}
+
+	if err := protojson.Unmarshal(row.Request, search.Request); err != nil {
+		return nil, fmt.Errorf("error unmarshalling search request: %w", err)
+	}
+	if err := protojson.Unmarshal(row.Identity, search.Identity); err != nil {
+		return nil, fmt.Errorf("error unmarshalling owner identity: %w", err)
+	}
+	return &search, nil
+}
+
+func fromTimestamptz(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+func toTimestamptz(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *t, Valid: true}
+}
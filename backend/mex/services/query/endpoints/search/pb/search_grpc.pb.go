@@ -8,6 +8,7 @@ package pb
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -19,7 +20,8 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Search_Search_FullMethodName = "/d4l.mex.search.Search/Search"
+	Search_Search_FullMethodName       = "/d4l.mex.search.Search/Search"
+	Search_ExportSearch_FullMethodName = "/d4l.mex.search.Search/ExportSearch"
 )
 
 // SearchClient is the client API for Search service.
@@ -27,6 +29,8 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type SearchClient interface {
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	// Streams one record per matching item. Via the REST gateway, the records are separated by newlines.
+	ExportSearch(ctx context.Context, in *ExportSearchRequest, opts ...grpc.CallOption) (Search_ExportSearchClient, error)
 }
 
 type searchClient struct {
@@ -46,11 +50,45 @@ func (c *searchClient) Search(ctx context.Context, in *SearchRequest, opts ...gr
 	return out, nil
 }
 
+func (c *searchClient) ExportSearch(ctx context.Context, in *ExportSearchRequest, opts ...grpc.CallOption) (Search_ExportSearchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Search_ServiceDesc.Streams[0], Search_ExportSearch_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &searchExportSearchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Search_ExportSearchClient interface {
+	Recv() (*httpbody.HttpBody, error)
+	grpc.ClientStream
+}
+
+type searchExportSearchClient struct {
+	grpc.ClientStream
+}
+
+func (x *searchExportSearchClient) Recv() (*httpbody.HttpBody, error) {
+	m := new(httpbody.HttpBody)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // SearchServer is the server API for Search service.
 // All implementations must embed UnimplementedSearchServer
 // for forward compatibility
 type SearchServer interface {
 	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	// Streams one record per matching item. Via the REST gateway, the records are separated by newlines.
+	ExportSearch(*ExportSearchRequest, Search_ExportSearchServer) error
 	mustEmbedUnimplementedSearchServer()
 }
 
@@ -61,6 +99,9 @@ type UnimplementedSearchServer struct {
 func (UnimplementedSearchServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
 }
+func (UnimplementedSearchServer) ExportSearch(*ExportSearchRequest, Search_ExportSearchServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportSearch not implemented")
+}
 func (UnimplementedSearchServer) mustEmbedUnimplementedSearchServer() {}
 
 // UnsafeSearchServer may be embedded to opt out of forward compatibility for this service.
@@ -92,6 +133,27 @@ func _Search_Search_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Search_ExportSearch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportSearchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SearchServer).ExportSearch(m, &searchExportSearchServer{stream})
+}
+
+type Search_ExportSearchServer interface {
+	Send(*httpbody.HttpBody) error
+	grpc.ServerStream
+}
+
+type searchExportSearchServer struct {
+	grpc.ServerStream
+}
+
+func (x *searchExportSearchServer) Send(m *httpbody.HttpBody) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Search_ServiceDesc is the grpc.ServiceDesc for Search service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -104,6 +166,12 @@ var Search_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Search_Search_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportSearch",
+			Handler:       _Search_ExportSearch_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "services/query/endpoints/search/search.proto",
 }
@@ -10,6 +10,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/d4l-data4life/mex/mex/shared/auth"
 	"github.com/d4l-data4life/mex/mex/shared/bi"
 	"github.com/d4l-data4life/mex/mex/shared/constants"
 	"github.com/d4l-data4life/mex/mex/shared/errstat"
@@ -48,21 +49,27 @@ type Service struct {
 
 // Search handles search queries
 func (svc *Service) Search(ctx context.Context, request *pb.SearchRequest) (*pb.SearchResponse, error) {
-	engineOpts := solr.QueryEngineOptions{
-		Log:                   svc.Log,
-		FieldRepo:             svc.FieldRepo,
-		SearchConfigRepo:      svc.SearchConfigRepo,
-		PostQueryHooks:        svc.PostQueryHooks,
-		TolerantErrorHandling: svc.TolerantErrorHandling,
+	return svc.search(ctx, request, nil)
+}
+
+/*
+SearchItems runs the search restricted to the items with the given IDs, e.g. to find out which of the items changed by
+an index update match a saved search. It is not exposed via the API. At most MaxDocLimit IDs can be given at once.
+*/
+func (svc *Service) SearchItems(ctx context.Context, request *pb.SearchRequest, itemIDs []string) (*pb.SearchResponse, error) {
+	if len(itemIDs) == 0 {
+		return &pb.SearchResponse{NumFoundExact: true}, nil
 	}
-	queryOpts := solr.QueryOptions{
-		SearchFocusName: request.SearchFocus,
-		MaxEditDistance: request.MaxEditDistance,
-		UseNgramField:   request.UseNgramField,
+	if len(itemIDs) > sharedSolr.MaxDocLimit {
+		return nil, errstat.MakeMexStatus(errstat.InvalidClientQuery, fmt.Sprintf("at most %d items can be searched at once", sharedSolr.MaxDocLimit)).Err()
 	}
-	queryEngine, err := solr.QueryEngineFactory(ctx, queryOpts, engineOpts)
+	return svc.search(ctx, request, itemIDs)
+}
+
+// search runs the search, restricted to the items with the given IDs if any are given
+func (svc *Service) search(ctx context.Context, request *pb.SearchRequest, itemIDs []string) (*pb.SearchResponse, error) {
+	queryEngine, err := svc.newQueryEngine(ctx, request, itemIDs)
 	if err != nil {
-		svc.Log.Error(ctx, L.Messagef("failed to create query engine: %s", err.Error()))
 		return nil, err
 	}
 
@@ -96,6 +103,11 @@ func (svc *Service) Search(ctx context.Context, request *pb.SearchRequest) (*pb.
 		svc.Log.Error(ctx, L.Messagef("error executing main Solr query: %s", err.Error()))
 		return nil, errstat.MakeMexStatus(errstat.SolrQueryFailedInternal, fmt.Sprintf("solr query failed: %s", err.Error())).Err()
 	}
+	if statusCode == http.StatusBadRequest && request.Cursor != "" {
+		// Solr rejects cursor marks it cannot parse, e.g. ones returned for a different sorting
+		svc.Log.Warn(ctx, L.Message(svc.getExtendedErrorMsg("main solr query rejected cursor", solrResponse)))
+		return nil, errstat.MakeMexStatus(errstat.InvalidClientQuery, "invalid cursor").Err()
+	}
 	if statusCode != http.StatusOK {
 		errMsg := fmt.Sprintf("main solr query failed with status code %d", statusCode)
 		extendedErrMsg := svc.getExtendedErrorMsg(errMsg, solrResponse)
@@ -118,7 +130,44 @@ func (svc *Service) Search(ctx context.Context, request *pb.SearchRequest) (*pb.
 	}))
 
 	svc.Log.Info(ctx, L.Message("extracting result from Solr response"))
-	return queryEngine.CreateResponse(ctx, solrResponse, request.Facets, queryDiagnostics)
+	response, err := queryEngine.CreateResponse(ctx, solrResponse, request.Facets, queryDiagnostics)
+	if err != nil {
+		return nil, err
+	}
+	// Solr returns the passed cursor mark once all results have been read.
+	if request.Cursor != "" && solrResponse.NextCursorMark != request.Cursor {
+		response.NextCursor = solrResponse.NextCursorMark
+	}
+	return response, nil
+}
+
+// newQueryEngine creates a query engine for the request, restricted to the items visible to the user (and to the items
+// with the given IDs if any are given)
+func (svc *Service) newQueryEngine(ctx context.Context, request *pb.SearchRequest, itemIDs []string) (*solr.QueryEngine, error) {
+	engineOpts := solr.QueryEngineOptions{
+		Log:                   svc.Log,
+		FieldRepo:             svc.FieldRepo,
+		SearchConfigRepo:      svc.SearchConfigRepo,
+		PostQueryHooks:        svc.PostQueryHooks,
+		TolerantErrorHandling: svc.TolerantErrorHandling,
+	}
+	user, err := auth.GetMexUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	queryOpts := solr.QueryOptions{
+		SearchFocusName:  request.SearchFocus,
+		MaxEditDistance:  request.MaxEditDistance,
+		UseNgramField:    request.UseNgramField,
+		VisibilityFilter: solr.VisibilityFilter(user),
+		ItemFilter:       solr.ItemFilter(itemIDs),
+	}
+	queryEngine, err := solr.QueryEngineFactory(ctx, queryOpts, engineOpts)
+	if err != nil {
+		svc.Log.Error(ctx, L.Messagef("failed to create query engine: %s", err.Error()))
+		return nil, err
+	}
+	return queryEngine, nil
 }
 
 // getDateRanges returns the min-max ranges of all datetime fields for which year-range facets was requested
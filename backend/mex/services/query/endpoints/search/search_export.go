@@ -0,0 +1,124 @@
+package search
+
+import (
+	"fmt"
+	"net/http"
+
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/d4l-data4life/mex/mex/shared/bi"
+	"github.com/d4l-data4life/mex/mex/shared/errstat"
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+	sharedSolr "github.com/d4l-data4life/mex/mex/shared/solr"
+	"github.com/d4l-data4life/mex/mex/shared/utils"
+
+	"github.com/d4l-data4life/mex/mex/services/query/endpoints/search/pb"
+	"github.com/d4l-data4life/mex/mex/services/query/export"
+)
+
+/*
+ExportSearch streams all items matching the search request in the requested format. The result set is read page by
+page using Solr cursor marks, so that it is consistent and deep pages are as cheap as the first one. Search focus,
+axis constraints and the user's visibility apply just as for the Search RPC; paging, facets and highlighting of the
+request are ignored.
+*/
+func (svc *Service) ExportSearch(request *pb.ExportSearchRequest, stream pb.Search_ExportSearchServer) error {
+	ctx := stream.Context()
+
+	if request.Request == nil {
+		return errstat.MakeGRPCStatus(codes.InvalidArgument, "no search request given").Err()
+	}
+	searchRequest := exportPageRequest(request.Request)
+	if request.Format == pb.ExportFormat_BIBTEX || request.Format == pb.ExportFormat_RIS {
+		for _, fieldName := range export.RequiredFields(request.CitationFields) {
+			if !utils.Contains(searchRequest.Fields, fieldName) {
+				searchRequest.Fields = append(searchRequest.Fields, fieldName)
+			}
+		}
+	}
+
+	encoder, err := export.NewEncoder(request.Format, searchRequest.Fields, request.CitationFields)
+	if err != nil {
+		return errstat.MakeMexStatus(errstat.InvalidClientQuery, fmt.Sprintf("invalid export request: %s", err.Error())).Err()
+	}
+
+	queryEngine, err := svc.newQueryEngine(ctx, searchRequest, nil)
+	if err != nil {
+		return err
+	}
+
+	svc.Log.BIEvent(ctx, L.BIActivity("search-export"), L.BIData(bi.SearchRequestInfo{
+		QueryLength:          len(searchRequest.Query),
+		AxisConstraintsCount: len(searchRequest.AxisConstraints),
+	}))
+
+	solrQueryBody, _, err := queryEngine.CreateSolrQuery(ctx, searchRequest, nil)
+	if err != nil {
+		svc.Log.Error(ctx, L.Messagef("error creating export Solr query: %s", err.Error()))
+		return errstat.MakeGRPCStatus(errstat.CodeFrom(err), "could not create Solr query", errstat.Cause(err)).Err()
+	}
+
+	header, err := encoder.Header()
+	if err != nil {
+		return status.Error(codes.Internal, fmt.Sprintf("failed to serialize export header: %s", err.Error()))
+	}
+	if header != nil {
+		if err := stream.Send(&httpbody.HttpBody{ContentType: encoder.ContentType(), Data: header}); err != nil {
+			return err
+		}
+	}
+
+	exported := 0
+	for {
+		solrResponse, statusCode, err := svc.Solr.DoJSONQuery(ctx, nil, solrQueryBody)
+		if err != nil {
+			svc.Log.Error(ctx, L.Messagef("error executing export Solr query: %s", err.Error()))
+			return errstat.MakeMexStatus(errstat.SolrQueryFailedInternal, fmt.Sprintf("solr query failed: %s", err.Error())).Err()
+		}
+		if statusCode != http.StatusOK {
+			errMsg := fmt.Sprintf("export solr query failed with status code %d", statusCode)
+			svc.Log.Error(ctx, L.Message(svc.getExtendedErrorMsg(errMsg, solrResponse)))
+			return status.Error(codes.Internal, errMsg)
+		}
+
+		page, err := queryEngine.CreateResponse(ctx, solrResponse, nil, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range page.Items {
+			data, err := encoder.Encode(item)
+			if err != nil {
+				return status.Error(codes.Internal, fmt.Sprintf("failed to serialize item %s: %s", item.ItemId, err.Error()))
+			}
+			if err := stream.Send(&httpbody.HttpBody{ContentType: encoder.ContentType(), Data: data}); err != nil {
+				return err
+			}
+		}
+		exported += len(page.Items)
+
+		// Solr returns the passed cursor mark once all results have been read.
+		if solrResponse.NextCursorMark == "" || solrResponse.NextCursorMark == solrQueryBody.Params.CursorMark {
+			break
+		}
+		solrQueryBody.Params.CursorMark = solrResponse.NextCursorMark
+	}
+
+	svc.Log.Info(ctx, L.Messagef("search export done: %d items", exported))
+	return nil
+}
+
+// exportPageRequest returns the request used for reading the pages of an export.
+func exportPageRequest(request *pb.SearchRequest) *pb.SearchRequest {
+	pageRequest := proto.Clone(request).(*pb.SearchRequest)
+	pageRequest.Limit = sharedSolr.MaxDocLimit
+	pageRequest.Offset = 0
+	pageRequest.Cursor = sharedSolr.CursorMarkStart
+	pageRequest.Facets = nil
+	pageRequest.HighlightFields = nil
+	pageRequest.AutoHighlight = false
+	return pageRequest
+}
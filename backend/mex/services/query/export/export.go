@@ -0,0 +1,267 @@
+/*
+Package export serializes search results (DocItems) into the formats offered for download: JSON lines, CSV and the
+citation formats BibTeX and RIS. Each item is encoded into one self-contained record, so that records can be streamed.
+*/
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/d4l-data4life/mex/mex/shared/solr"
+	"github.com/d4l-data4life/mex/mex/shared/utils"
+
+	"github.com/d4l-data4life/mex/mex/services/query/endpoints/search/pb"
+)
+
+const (
+	ContentTypeNDJSON = "application/x-ndjson"
+	ContentTypeCSV    = "text/csv"
+	ContentTypeBibTeX = "application/x-bibtex"
+	ContentTypeRIS    = "application/x-research-info-systems"
+
+	// MultiValueSeparator separates multiple values of a field within a CSV cell
+	MultiValueSeparator = "; "
+)
+
+// Citation properties which can be mapped to MEx fields for the citation formats
+const (
+	CitationTitle     = "title"
+	CitationAuthor    = "author"
+	CitationYear      = "year"
+	CitationPublisher = "publisher"
+	CitationDOI       = "doi"
+	CitationURL       = "url"
+	CitationAbstract  = "abstract"
+	CitationKeywords  = "keywords"
+)
+
+var citationProperties = []string{
+	CitationTitle, CitationAuthor, CitationYear, CitationPublisher, CitationDOI, CitationURL, CitationAbstract, CitationKeywords,
+}
+
+var yearPattern = regexp.MustCompile(`\d{4}`)
+
+// Encoder turns items into records of an export format.
+type Encoder interface {
+	ContentType() string
+	// Header returns the record preceding all items, or nil if the format has none.
+	Header() ([]byte, error)
+	Encode(item *solr.DocItem) ([]byte, error)
+}
+
+/*
+NewEncoder returns the encoder for the given format. The fields determine the CSV columns; the citation fields map
+citation properties to the MEx fields providing their values.
+*/
+func NewEncoder(format pb.ExportFormat, fields []string, citationFields map[string]string) (Encoder, error) {
+	switch format {
+	case pb.ExportFormat_JSONL:
+		return &jsonlEncoder{}, nil
+	case pb.ExportFormat_CSV:
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("no fields given for CSV export")
+		}
+		return &csvEncoder{fields: fields}, nil
+	case pb.ExportFormat_BIBTEX, pb.ExportFormat_RIS:
+		if err := validateCitationFields(citationFields); err != nil {
+			return nil, err
+		}
+		if format == pb.ExportFormat_BIBTEX {
+			return &bibtexEncoder{citationFields: citationFields}, nil
+		}
+		return &risEncoder{citationFields: citationFields}, nil
+	default:
+		return nil, fmt.Errorf("unsupported export format: %s", format)
+	}
+}
+
+// RequiredFields returns the MEx fields needed to fill the citation properties.
+func RequiredFields(citationFields map[string]string) []string {
+	var fields []string
+	for _, property := range citationProperties {
+		if fieldName, ok := citationFields[property]; ok {
+			fields = append(fields, fieldName)
+		}
+	}
+	return fields
+}
+
+func validateCitationFields(citationFields map[string]string) error {
+	if len(citationFields) == 0 {
+		return fmt.Errorf("no citation fields given")
+	}
+	for property, fieldName := range citationFields {
+		if !utils.Contains(citationProperties, property) {
+			return fmt.Errorf("unknown citation property: %s (allowed: %s)", property, strings.Join(citationProperties, ", "))
+		}
+		if fieldName == "" {
+			return fmt.Errorf("no field given for citation property: %s", property)
+		}
+	}
+	if _, ok := citationFields[CitationTitle]; !ok {
+		return fmt.Errorf("no field given for citation property: %s", CitationTitle)
+	}
+	return nil
+}
+
+type jsonlEncoder struct{}
+
+func (enc *jsonlEncoder) ContentType() string { return ContentTypeNDJSON }
+
+func (enc *jsonlEncoder) Header() ([]byte, error) { return nil, nil }
+
+func (enc *jsonlEncoder) Encode(item *solr.DocItem) ([]byte, error) {
+	return protojson.Marshal(item)
+}
+
+type csvEncoder struct {
+	fields []string
+}
+
+func (enc *csvEncoder) ContentType() string { return ContentTypeCSV }
+
+func (enc *csvEncoder) Header() ([]byte, error) {
+	return csvLine(append([]string{"itemId", "entityType"}, enc.fields...))
+}
+
+func (enc *csvEncoder) Encode(item *solr.DocItem) ([]byte, error) {
+	values := valuesByField(item)
+	record := []string{item.ItemId, item.EntityType}
+	for _, fieldName := range enc.fields {
+		record = append(record, strings.Join(values[fieldName], MultiValueSeparator))
+	}
+	return csvLine(record)
+}
+
+// csvLine returns the record as CSV line without line break, which is added by the stream.
+func csvLine(record []string) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(record); err != nil {
+		return nil, err
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+type bibtexEncoder struct {
+	citationFields map[string]string
+}
+
+func (enc *bibtexEncoder) ContentType() string { return ContentTypeBibTeX }
+
+func (enc *bibtexEncoder) Header() ([]byte, error) { return nil, nil }
+
+func (enc *bibtexEncoder) Encode(item *solr.DocItem) ([]byte, error) {
+	citation := citationValues(item, enc.citationFields)
+
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "@misc{%s", item.ItemId)
+	for _, property := range citationProperties {
+		values := citation[property]
+		if len(values) == 0 {
+			continue
+		}
+
+		var value string
+		switch property {
+		case CitationAuthor:
+			value = strings.Join(values, " and ")
+		case CitationKeywords:
+			value = strings.Join(values, ", ")
+		default:
+			value = values[0]
+		}
+		fmt.Fprintf(&buf, ",\n  %s = {%s}", property, escapeBibTeX(value))
+	}
+	buf.WriteString("\n}\n")
+	return []byte(buf.String()), nil
+}
+
+var bibtexEscaper = strings.NewReplacer(
+	`\`, `\textbackslash{}`,
+	`{`, `\{`,
+	`}`, `\}`,
+	`&`, `\&`,
+	`%`, `\%`,
+	`$`, `\$`,
+	`#`, `\#`,
+	`_`, `\_`,
+)
+
+func escapeBibTeX(s string) string {
+	return bibtexEscaper.Replace(s)
+}
+
+type risEncoder struct {
+	citationFields map[string]string
+}
+
+var risTags = map[string]string{
+	CitationTitle:     "TI",
+	CitationAuthor:    "AU",
+	CitationYear:      "PY",
+	CitationPublisher: "PB",
+	CitationDOI:       "DO",
+	CitationURL:       "UR",
+	CitationAbstract:  "AB",
+	CitationKeywords:  "KW",
+}
+
+func (enc *risEncoder) ContentType() string { return ContentTypeRIS }
+
+func (enc *risEncoder) Header() ([]byte, error) { return nil, nil }
+
+func (enc *risEncoder) Encode(item *solr.DocItem) ([]byte, error) {
+	citation := citationValues(item, enc.citationFields)
+
+	var buf strings.Builder
+	buf.WriteString("TY  - GEN\n")
+	fmt.Fprintf(&buf, "ID  - %s\n", item.ItemId)
+	for _, property := range citationProperties {
+		values := citation[property]
+		if property != CitationAuthor && property != CitationKeywords && len(values) > 1 {
+			values = values[:1]
+		}
+		for _, value := range values {
+			fmt.Fprintf(&buf, "%s  - %s\n", risTags[property], strings.ReplaceAll(value, "\n", " "))
+		}
+	}
+	buf.WriteString("ER  - \n")
+	return []byte(buf.String()), nil
+}
+
+// citationValues returns the values of the citation properties; years are reduced to the year of the first date.
+func citationValues(item *solr.DocItem, citationFields map[string]string) map[string][]string {
+	values := valuesByField(item)
+	citation := make(map[string][]string)
+	for property, fieldName := range citationFields {
+		citation[property] = values[fieldName]
+	}
+
+	if years := citation[CitationYear]; len(years) > 0 {
+		if year := yearPattern.FindString(years[0]); year != "" {
+			citation[CitationYear] = []string{year}
+		} else {
+			delete(citation, CitationYear)
+		}
+	}
+	return citation
+}
+
+func valuesByField(item *solr.DocItem) map[string][]string {
+	values := make(map[string][]string)
+	for _, v := range item.Values {
+		values[v.FieldName] = append(values[v.FieldName], v.FieldValue)
+	}
+	return values
+}
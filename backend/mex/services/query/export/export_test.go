@@ -0,0 +1,103 @@
+package export
+
+import (
+	"testing"
+
+	"github.com/d4l-data4life/mex/mex/shared/solr"
+
+	"github.com/d4l-data4life/mex/mex/services/query/endpoints/search/pb"
+)
+
+var testItem = &solr.DocItem{
+	ItemId:     "item-1",
+	EntityType: "Resource",
+	Values: []*solr.DocValue{
+		{FieldName: "author", FieldValue: "Doe, Jane"},
+		{FieldName: "author", FieldValue: "Roe, Richard"},
+		{FieldName: "created", FieldValue: "2021-03-04T00:00:00Z"},
+		{FieldName: "title", FieldValue: "Viruses & {bacteria}", Language: "en"},
+		{FieldName: "title", FieldValue: "Viren und Bakterien", Language: "de"},
+	},
+}
+
+var testCitationFields = map[string]string{
+	CitationTitle:  "title",
+	CitationAuthor: "author",
+	CitationYear:   "created",
+}
+
+func TestEncoder_Encode(t *testing.T) {
+	tests := []struct {
+		name           string
+		format         pb.ExportFormat
+		fields         []string
+		citationFields map[string]string
+		wantHeader     string
+		want           string
+	}{
+		{
+			name:       "CSV with multiple values and quoting",
+			format:     pb.ExportFormat_CSV,
+			fields:     []string{"title", "created", "unknown"},
+			wantHeader: "itemId,entityType,title,created,unknown",
+			want:       `item-1,Resource,Viruses & {bacteria}; Viren und Bakterien,2021-03-04T00:00:00Z,`,
+		},
+		{
+			name:           "BibTeX with escaping",
+			format:         pb.ExportFormat_BIBTEX,
+			citationFields: testCitationFields,
+			want:           "@misc{item-1,\n  title = {Viruses \\& \\{bacteria\\}},\n  author = {Doe, Jane and Roe, Richard},\n  year = {2021}\n}\n",
+		},
+		{
+			name:           "RIS with repeated authors",
+			format:         pb.ExportFormat_RIS,
+			citationFields: testCitationFields,
+			want:           "TY  - GEN\nID  - item-1\nTI  - Viruses & {bacteria}\nAU  - Doe, Jane\nAU  - Roe, Richard\nPY  - 2021\nER  - \n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoder, err := NewEncoder(tt.format, tt.fields, tt.citationFields)
+			if err != nil {
+				t.Fatalf("NewEncoder() error = %v", err)
+			}
+
+			header, err := encoder.Header()
+			if err != nil {
+				t.Fatalf("Header() error = %v", err)
+			}
+			if string(header) != tt.wantHeader {
+				t.Errorf("Header() = %q, want %q", header, tt.wantHeader)
+			}
+
+			got, err := encoder.Encode(testItem)
+			if err != nil {
+				t.Fatalf("Encode() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Encode() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewEncoder_invalid(t *testing.T) {
+	tests := []struct {
+		name           string
+		format         pb.ExportFormat
+		fields         []string
+		citationFields map[string]string
+	}{
+		{name: "CSV without fields", format: pb.ExportFormat_CSV},
+		{name: "BibTeX without citation fields", format: pb.ExportFormat_BIBTEX},
+		{name: "RIS with unknown citation property", format: pb.ExportFormat_RIS, citationFields: map[string]string{"title": "title", "journal": "x"}},
+		{name: "RIS without title", format: pb.ExportFormat_RIS, citationFields: map[string]string{"author": "author"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewEncoder(tt.format, tt.fields, tt.citationFields); err == nil {
+				t.Errorf("NewEncoder() expected error")
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package solr
+
+import (
+	"github.com/d4l-data4life/mex/mex/shared/solr"
+)
+
+// ItemFilter returns the Solr filter restricting the search results to the items with the given IDs, or an empty
+// string if no IDs are given.
+func ItemFilter(itemIDs []string) string {
+	if len(itemIDs) == 0 {
+		return ""
+	}
+	return anyValueClause(solr.DefaultUniqueKey, itemIDs)
+}
@@ -0,0 +1,31 @@
+package solr
+
+import (
+	"testing"
+)
+
+func TestItemFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		itemIDs []string
+		want    string
+	}{
+		{
+			name:    "No IDs do not restrict the results",
+			itemIDs: nil,
+			want:    "",
+		},
+		{
+			name:    "The items are matched by their ID",
+			itemIDs: []string{"a1", "b2"},
+			want:    `id:("a1" OR "b2")`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ItemFilter(tt.itemIDs); got != tt.want {
+				t.Errorf("ItemFilter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -48,12 +48,17 @@ type QueryEngine struct {
 	searchConfigRepo  searchconfig.SearchConfigRepo // Access to ordinal axes and search foci
 	returnFieldMapper *fieldMapper                  // Maps MEx field requested by clients (return fields & highlighting) to the underlying Solr fields
 	postQueryHooks    hooks.PostQueryHooks          // Type-specific tasks to be run before returning result to client
+
+	visibilityFilter string // Restricts the results to the items visible to the user (empty if unrestricted)
+	itemFilter       string // Restricts the results to the given items (empty if unrestricted)
 }
 
 type QueryOptions struct {
-	SearchFocusName string
-	MaxEditDistance uint32
-	UseNgramField   bool
+	SearchFocusName  string
+	MaxEditDistance  uint32
+	UseNgramField    bool
+	VisibilityFilter string
+	ItemFilter       string
 }
 
 type QueryEngineOptions struct {
@@ -87,6 +92,8 @@ func QueryEngineFactory(ctx context.Context, queryOpts QueryOptions, engineOpts
 	if err != nil {
 		return nil, errstat.MakeMexStatus(errstat.QueryEngineCreationFailedInternal, fmt.Sprintf("could not create Solr query engine: %s", err.Error())).Err()
 	}
+	queryEngine.visibilityFilter = queryOpts.VisibilityFilter
+	queryEngine.itemFilter = queryOpts.ItemFilter
 	return queryEngine, err
 }
 
@@ -119,7 +126,10 @@ func (qe *QueryEngine) CreateSolrQuery(ctx context.Context, searchRequest *pb.Se
 		mexFieldToMexKindMap[fd.Name()] = fd.Kind()
 	}
 	queryBody := getBaseQueryBody()
-	setPaging(queryBody, searchRequest)
+	pagingErr := setPaging(queryBody, searchRequest)
+	if pagingErr != nil {
+		return nil, &solr.Diagnostics{}, pagingErr
+	}
 	diagnostics, isPhraseOnlyQuery, queryErr := qe.setQuery(queryBody, searchRequest, qe.Converter)
 	if queryErr != nil {
 		return nil, diagnostics, queryErr
@@ -128,6 +138,13 @@ func (qe *QueryEngine) CreateSolrQuery(ctx context.Context, searchRequest *pb.Se
 	if constraintErr != nil {
 		return nil, nil, constraintErr
 	}
+	// The visibility and item filters are not tagged and hence also apply to all facets.
+	if qe.visibilityFilter != "" {
+		queryBody.Filter = append(queryBody.Filter, qe.visibilityFilter)
+	}
+	if qe.itemFilter != "" {
+		queryBody.Filter = append(queryBody.Filter, qe.itemFilter)
+	}
 	sortErr := qe.setSorting(ctx, queryBody, searchRequest)
 	if sortErr != nil {
 		return nil, nil, sortErr
@@ -161,16 +178,30 @@ func getBaseQueryBody() *solr.QueryBody {
 	return &queryBody
 }
 
-// setPaging adds paging information to the passed Solr search request
-func setPaging(queryBody *solr.QueryBody, searchRequest *pb.SearchRequest) {
+/*
+setPaging adds paging information to the passed Solr search request. If a cursor is given, Solr's cursor-based paging
+is used, which does not allow an offset.
+*/
+func setPaging(queryBody *solr.QueryBody, searchRequest *pb.SearchRequest) error {
 	// Cap limit at max allowed value
 	limit := math.Min(float64(searchRequest.GetLimit()), solr.MaxDocLimit)
 	queryBody.Limit = uint32(limit)
+	if searchRequest.GetCursor() != "" {
+		if searchRequest.GetOffset() != 0 {
+			return errstat.MakeMexStatus(errstat.InvalidClientQuery, "an offset cannot be combined with a cursor").Err()
+		}
+		queryBody.Params.CursorMark = searchRequest.GetCursor()
+		return nil
+	}
 	// Here, the Go default (0) happens to be correct
 	queryBody.Offset = searchRequest.GetOffset()
+	return nil
 }
 
-// setPaging adds sorting information to the passed Solr search request
+/*
+setSorting adds sorting information to the passed Solr search request. For cursor-based paging, Solr requires the sort
+to end with the unique key as tiebreak, so it is appended in this case.
+*/
 func (qe *QueryEngine) setSorting(ctx context.Context, queryBody *solr.QueryBody, searchRequest *pb.SearchRequest) error {
 	if searchRequest.GetSorting() != nil {
 		requestedAxis := searchRequest.GetSorting().GetAxis()
@@ -201,6 +232,15 @@ func (qe *QueryEngine) setSorting(ctx context.Context, queryBody *solr.QueryBody
 		solrBackingFieldName := solr.GetOrdinalAxisSortFieldName(requestedAxis)
 		queryBody.Sort = fmt.Sprintf("%s %s", solrBackingFieldName, sortOrder)
 	}
+
+	if searchRequest.GetCursor() != "" {
+		tiebreak := fmt.Sprintf("%s asc", solr.DefaultUniqueKey)
+		if queryBody.Sort == "" {
+			queryBody.Sort = fmt.Sprintf("score desc, %s", tiebreak)
+		} else {
+			queryBody.Sort = fmt.Sprintf("%s, %s", queryBody.Sort, tiebreak)
+		}
+	}
 	return nil
 }
 
@@ -315,8 +315,31 @@ func Test_createSolrQueryBody_paging(t *testing.T) {
 			},
 			checks: &[]testutils.BodyCheck{
 				testutils.CheckOffset(5),
+				testutils.CheckCursorMark(""),
 			},
 		},
+		{
+			name: "Paging: Cursor is mapped to the cursor mark",
+			searchRequest: &pb.SearchRequest{
+				Query:  testQuery,
+				Limit:  25,
+				Cursor: solr.CursorMarkStart,
+			},
+			checks: &[]testutils.BodyCheck{
+				testutils.CheckLimit(25),
+				testutils.CheckOffset(0),
+				testutils.CheckCursorMark(solr.CursorMarkStart),
+			},
+		},
+		{
+			name: "Paging: Offset > 0 combined with a cursor causes an error",
+			searchRequest: &pb.SearchRequest{
+				Query:  testQuery,
+				Offset: 5,
+				Cursor: "AoE/BWl0ZW0tMQ==",
+			},
+			wantErr: true,
+		},
 	}
 
 	log := &L.NullLogger{}
@@ -408,6 +431,30 @@ func Test_createSolrQueryBody_sorting(t *testing.T) {
 			checks:  nil,
 			wantErr: true,
 		},
+		{
+			name: "Sorting: With a cursor, results are sorted by score with the unique key as tiebreak by default",
+			searchRequest: &pb.SearchRequest{
+				Query:  testQuery,
+				Cursor: solr.CursorMarkStart,
+			},
+			checks: &[]testutils.BodyCheck{
+				testutils.CheckSorting("score", "desc, id asc"),
+			},
+		},
+		{
+			name: "Sorting: With a cursor, the unique key is appended as tiebreak to the requested sorting",
+			searchRequest: &pb.SearchRequest{
+				Query:  testQuery,
+				Cursor: solr.CursorMarkStart,
+				Sorting: &solr.Sorting{
+					Axis:  "general",
+					Order: "desc",
+				},
+			},
+			checks: &[]testutils.BodyCheck{
+				testutils.CheckSorting(solr.GetOrdinalAxisSortFieldName("general"), "desc, id asc"),
+			},
+		},
 	}
 
 	log := &L.NullLogger{}
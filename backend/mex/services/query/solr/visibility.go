@@ -0,0 +1,49 @@
+package solr
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/d4l-data4life/mex/mex/shared/auth"
+	"github.com/d4l-data4life/mex/mex/shared/solr"
+
+	"github.com/d4l-data4life/mex/mex/services/query/parser"
+)
+
+/*
+VisibilityFilter returns the Solr filter restricting the search results to the items the user may see, or an empty
+string if the user is not restricted. A document is visible if it matches any of the visibility rules of the user.
+A rule matches if the document is of one of the rule's entity types (if any are given) and lies in one of the rule's
+sub-trees of the hierarchy axis (if any are given).
+*/
+func VisibilityFilter(claims *auth.Claims) string {
+	if claims == nil || !claims.VisibilityRestricted {
+		return ""
+	}
+
+	var ruleClauses []string
+	for _, rule := range claims.Visibility {
+		var clauses []string
+		if len(rule.EntityTypes) > 0 {
+			clauses = append(clauses, anyValueClause(solr.ItemEntityNameField, rule.EntityTypes))
+		}
+		if rule.HierarchyAxis != "" && len(rule.Subtrees) > 0 {
+			clauses = append(clauses, anyValueClause(solr.GetOrdinalAxisFacetAndFilterFieldName(rule.HierarchyAxis), rule.Subtrees))
+		}
+		if len(clauses) == 0 {
+			// A rule without restrictions makes everything visible.
+			return ""
+		}
+		ruleClauses = append(ruleClauses, fmt.Sprintf("(%s)", strings.Join(clauses, " AND ")))
+	}
+	return strings.Join(ruleClauses, " OR ")
+}
+
+// anyValueClause returns a Solr clause matching documents which have one of the values in the given field
+func anyValueClause(fieldName string, values []string) string {
+	terms := make([]string, len(values))
+	for i, val := range values {
+		terms[i] = fmt.Sprintf(`"%s"`, parser.SanitizeTerm(val))
+	}
+	return fmt.Sprintf("%s:(%s)", parser.SanitizeTerm(fieldName), strings.Join(terms, " OR "))
+}
@@ -0,0 +1,79 @@
+package solr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/d4l-data4life/mex/mex/shared/auth"
+	"github.com/d4l-data4life/mex/mex/shared/solr"
+)
+
+func TestVisibilityFilter(t *testing.T) {
+	unitField := solr.GetOrdinalAxisFacetAndFilterFieldName("unit")
+
+	tests := []struct {
+		name   string
+		claims *auth.Claims
+		want   string
+	}{
+		{
+			name:   "No claims",
+			claims: nil,
+			want:   "",
+		},
+		{
+			name: "Unrestricted user",
+			claims: &auth.Claims{
+				Visibility: []*auth.VisibilityRule{{EntityTypes: []string{"Resource"}}},
+			},
+			want: "",
+		},
+		{
+			name: "Entity types only",
+			claims: &auth.Claims{
+				VisibilityRestricted: true,
+				Visibility:           []*auth.VisibilityRule{{EntityTypes: []string{"Resource", "Activity"}}},
+			},
+			want: `(entityName:("Resource" OR "Activity"))`,
+		},
+		{
+			name: "Entity types and sub-trees of a rule are combined with AND",
+			claims: &auth.Claims{
+				VisibilityRestricted: true,
+				Visibility: []*auth.VisibilityRule{
+					{EntityTypes: []string{"Resource"}, HierarchyAxis: "unit", Subtrees: []string{"u1"}},
+				},
+			},
+			want: fmt.Sprintf(`(entityName:("Resource") AND %s:("u1"))`, unitField),
+		},
+		{
+			name: "Rules are combined with OR",
+			claims: &auth.Claims{
+				VisibilityRestricted: true,
+				Visibility: []*auth.VisibilityRule{
+					{EntityTypes: []string{"Resource"}},
+					{HierarchyAxis: "unit", Subtrees: []string{"u1", "u2"}},
+				},
+			},
+			want: fmt.Sprintf(`(entityName:("Resource")) OR (%s:("u1" OR "u2"))`, unitField),
+		},
+		{
+			name: "A rule without restrictions makes everything visible",
+			claims: &auth.Claims{
+				VisibilityRestricted: true,
+				Visibility: []*auth.VisibilityRule{
+					{EntityTypes: []string{"Resource"}},
+					{HierarchyAxis: "unit"},
+				},
+			},
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VisibilityFilter(tt.claims); got != tt.want {
+				t.Errorf("VisibilityFilter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package audit
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/d4l-data4life/mex/mex/shared/auth"
+	"github.com/d4l-data4life/mex/mex/shared/utils"
+)
+
+// Entry is a single record of the audit trail.
+type Entry struct {
+	ID        int64     `json:"id,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+	Service   string    `json:"service"`
+	Method    string    `json:"method"`
+	TenantID  string    `json:"tenantId"`
+	Subject   string    `json:"subject"`
+	AppID     string    `json:"appId"`
+	Roles     []string  `json:"roles"`
+	Resource  string    `json:"resource"`
+	Verb      string    `json:"verb"`
+	TargetIDs []string  `json:"targetIds"`
+	Outcome   string    `json:"outcome"`
+	Message   string    `json:"message,omitempty"`
+}
+
+// Sink persists audit entries.
+type Sink interface {
+	Record(ctx context.Context, entry *Entry) error
+}
+
+// Verbs of privileges which do not change anything; methods requiring only those are not audited.
+var readVerbs = []string{auth.VerbRead, auth.VerbQuery}
+
+/*
+mutatingPrivilege returns the first privilege required by the method which allows changing data. Methods without
+such a privilege (reads, searches, and unauthenticated methods) are not audited.
+*/
+func mutatingPrivilege(fullMethod string) (string, string, bool) {
+	for _, privilege := range auth.RequiredPrivileges(fullMethod) {
+		if !utils.Contains(readVerbs, privilege.Verb) {
+			return privilege.Resource, privilege.Verb, true
+		}
+	}
+	return "", "", false
+}
+
+/*
+targetIDs collects the IDs found in the top-level fields of the given messages (typically request and response):
+the values of string fields named "id" or ending in "_id", and of repeated string fields ending in "_ids".
+*/
+func targetIDs(messages ...any) []string {
+	ids := []string{}
+	for _, m := range messages {
+		message, ok := m.(proto.Message)
+		if !ok || message == nil {
+			continue
+		}
+
+		reflected := message.ProtoReflect()
+		if !reflected.IsValid() {
+			continue
+		}
+		fields := reflected.Descriptor().Fields()
+		for i := 0; i < fields.Len(); i++ {
+			field := fields.Get(i)
+			if field.Kind() != protoreflect.StringKind || !reflected.Has(field) {
+				continue
+			}
+			name := string(field.Name())
+			switch {
+			case field.IsList() && strings.HasSuffix(name, "_ids"):
+				list := reflected.Get(field).List()
+				for j := 0; j < list.Len(); j++ {
+					ids = appendID(ids, list.Get(j).String())
+				}
+			case !field.IsList() && !field.IsMap() && (name == "id" || strings.HasSuffix(name, "_id")):
+				ids = appendID(ids, reflected.Get(field).String())
+			}
+		}
+	}
+	return ids
+}
+
+func appendID(ids []string, id string) []string {
+	if id == "" || utils.Contains(ids, id) {
+		return ids
+	}
+	return append(ids, id)
+}
@@ -0,0 +1,50 @@
+package audit
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/d4l-data4life/mex/mex/shared/known/jobspb"
+)
+
+func TestTargetIDs(t *testing.T) {
+	tests := []struct {
+		name     string
+		messages []any
+		want     []string
+	}{
+		{
+			name:     "ID in response",
+			messages: []any{&jobspb.CreateJobRequest{Title: "t"}, &jobspb.CreateJobResponse{JobId: "j1"}},
+			want:     []string{"j1"},
+		},
+		{
+			name:     "Repeated IDs, duplicates removed",
+			messages: []any{&jobspb.AddJobItemsRequest{JobId: "j1", ItemIds: []string{"i1", "i2", "i1"}}, &jobspb.AddJobItemsResponse{JobId: "j1", ItemCount: 2}},
+			want:     []string{"j1", "i1", "i2"},
+		},
+		{
+			name:     "No response (e.g. after an error)",
+			messages: []any{&jobspb.GetJobLogsRequest{JobId: "j1"}, nil},
+			want:     []string{"j1"},
+		},
+		{
+			name:     "Fields not holding IDs",
+			messages: []any{&jobspb.AddJobLogsRequest{Logs: []string{"l1"}}},
+			want:     []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := targetIDs(tt.messages...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("targetIDs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMutatingPrivilege_unannotatedMethod(t *testing.T) {
+	if _, _, ok := mutatingPrivilege("/d4l.mex.unknown.Service/Method"); ok {
+		t.Errorf("mutatingPrivilege() = true for a method without privileges")
+	}
+}
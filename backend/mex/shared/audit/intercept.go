@@ -0,0 +1,83 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/d4l-data4life/mex/mex/shared/auth"
+	"github.com/d4l-data4life/mex/mex/shared/interceptors"
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+)
+
+/*
+NewInterceptor returns an interceptor recording every call of a mutating method in the audit trail. It must run before
+the auth interceptor so that calls rejected there are recorded as well; the caller is taken from the claims reported
+by the auth interceptor (see auth.WithClaimsObserver) and is empty for calls failing authentication.
+A failure to record an entry is logged but does not fail the call, which has already been carried out.
+*/
+func NewInterceptor(sink Sink, service string, log L.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resource, verb, ok := mutatingPrivilege(info.FullMethod)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		var claims *auth.Claims
+		resp, err := handler(auth.WithClaimsObserver(ctx, func(c *auth.Claims) { claims = c }), req)
+
+		entry := newEntry(claims, service, info.FullMethod, resource, verb, err)
+		entry.TargetIDs = targetIDs(req, resp)
+		if recordErr := sink.Record(ctx, entry); recordErr != nil {
+			log.Error(ctx, L.Messagef("could not record audit entry for %s: %s", info.FullMethod, recordErr.Error()))
+		}
+
+		return resp, err
+	}
+}
+
+// NewStreamInterceptor is the streaming variant of NewInterceptor; the target IDs of streamed messages are not recorded.
+func NewStreamInterceptor(sink Sink, service string, log L.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		resource, verb, ok := mutatingPrivilege(info.FullMethod)
+		if !ok {
+			return handler(srv, ss)
+		}
+
+		ctx := ss.Context()
+		var claims *auth.Claims
+		err := handler(srv, interceptors.WrapServerStream(ss, auth.WithClaimsObserver(ctx, func(c *auth.Claims) { claims = c })))
+
+		if recordErr := sink.Record(ctx, newEntry(claims, service, info.FullMethod, resource, verb, err)); recordErr != nil {
+			log.Error(ctx, L.Messagef("could not record audit entry for %s: %s", info.FullMethod, recordErr.Error()))
+		}
+
+		return err
+	}
+}
+
+func newEntry(claims *auth.Claims, service string, fullMethod string, resource string, verb string, err error) *Entry {
+	entry := Entry{
+		CreatedAt: time.Now(),
+		Service:   service,
+		Method:    fullMethod,
+		Roles:     []string{},
+		Resource:  resource,
+		Verb:      verb,
+		TargetIDs: []string{},
+	}
+
+	if claims != nil {
+		entry.TenantID = claims.TenantId
+		entry.Subject = claims.UserId
+		entry.AppID = claims.AppId
+		entry.Roles = append(entry.Roles, claims.Roles...)
+	}
+
+	st := status.Convert(err)
+	entry.Outcome = st.Code().String()
+	entry.Message = st.Message()
+	return &entry
+}
@@ -0,0 +1,92 @@
+package audit
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/d4l-data4life/mex/mex/shared/auth"
+	"github.com/d4l-data4life/mex/mex/shared/known/securitypb"
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+
+	itemspb "github.com/d4l-data4life/mex/mex/services/metadata/endpoints/items/pb"
+)
+
+type memorySink struct {
+	entries []*Entry
+}
+
+func (sink *memorySink) Record(_ context.Context, entry *Entry) error {
+	sink.entries = append(sink.entries, entry)
+	return nil
+}
+
+type staticAuthenticator struct {
+	user *securitypb.UserWithRoles
+}
+
+func (a staticAuthenticator) Authenticate(_ context.Context, _ any) (*securitypb.UserWithRoles, error) {
+	if a.user == nil {
+		return nil, status.Error(codes.Unauthenticated, "no token")
+	}
+	return a.user, nil
+}
+
+func TestNewInterceptor_beforeAuth(t *testing.T) {
+	tests := []struct {
+		name        string
+		user        *securitypb.UserWithRoles
+		wantOutcome string
+		wantSubject string
+	}{
+		{
+			name:        "Permitted call",
+			user:        &securitypb.UserWithRoles{UserId: "u1", Roles: []string{auth.RoleProducer}},
+			wantOutcome: codes.OK.String(),
+			wantSubject: "u1",
+		},
+		{
+			name:        "Call denied for lack of privileges",
+			user:        &securitypb.UserWithRoles{UserId: "u1", Roles: []string{auth.RoleConsumer}},
+			wantOutcome: codes.PermissionDenied.String(),
+			wantSubject: "u1",
+		},
+		{
+			name:        "Unauthenticated call",
+			wantOutcome: codes.Unauthenticated.String(),
+			wantSubject: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink := &memorySink{}
+			auditInterceptor := NewInterceptor(sink, "metadata", &L.NullLogger{})
+			authInterceptor := auth.NewInterceptor(auth.RequestAuthenticatorRegistry{
+				securitypb.AuthenticationType_BEARER_TOKEN: staticAuthenticator{user: tt.user},
+			}, auth.NewPrivMgr())
+
+			info := &grpc.UnaryServerInfo{FullMethod: itemspb.Items_CreateItem_FullMethodName}
+			handler := func(ctx context.Context, req any) (any, error) {
+				return authInterceptor(ctx, req, info, func(_ context.Context, _ any) (any, error) {
+					return &itemspb.CreateItemResponse{ItemId: "i1"}, nil
+				})
+			}
+
+			_, _ = auditInterceptor(context.Background(), &itemspb.CreateItemRequest{}, info, handler)
+
+			if len(sink.entries) != 1 {
+				t.Fatalf("recorded %d entries, want 1", len(sink.entries))
+			}
+			entry := sink.entries[0]
+			if entry.Outcome != tt.wantOutcome {
+				t.Errorf("outcome = %s, want %s", entry.Outcome, tt.wantOutcome)
+			}
+			if entry.Subject != tt.wantSubject {
+				t.Errorf("subject = %q, want %q", entry.Subject, tt.wantSubject)
+			}
+		})
+	}
+}
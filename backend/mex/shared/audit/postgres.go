@@ -0,0 +1,94 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/d4l-data4life/mex/mex/shared/db"
+
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/datamodel"
+)
+
+// Filter selects audit entries; empty fields do not restrict the selection.
+type Filter struct {
+	Subject  string
+	Resource string
+	From     *time.Time
+	To       *time.Time
+}
+
+// PostgresStore keeps the audit trail in Postgres (table audit_log, created by the metadata service migrations).
+type PostgresStore struct {
+	DB db.Pool
+}
+
+func (store *PostgresStore) Record(ctx context.Context, entry *Entry) error {
+	err := datamodel.New(store.DB).DbCreateAuditEntry(ctx, datamodel.DbCreateAuditEntryParams{
+		CreatedAt: pgtype.Timestamptz{Time: entry.CreatedAt, Valid: true},
+		Service:   entry.Service,
+		Method:    entry.Method,
+		TenantID:  entry.TenantID,
+		Subject:   entry.Subject,
+		AppID:     entry.AppID,
+		Roles:     entry.Roles,
+		Resource:  entry.Resource,
+		Verb:      entry.Verb,
+		TargetIds: entry.TargetIDs,
+		Outcome:   entry.Outcome,
+		Message:   toText(entry.Message),
+	})
+	if err != nil {
+		return fmt.Errorf("error storing audit entry: %w", err)
+	}
+	return nil
+}
+
+// Query returns up to limit entries matching the filter, in the order of their recording, starting after the given ID.
+func (store *PostgresStore) Query(ctx context.Context, filter Filter, afterID int64, limit int) ([]*Entry, error) {
+	rows, err := datamodel.New(store.DB).DbListAuditEntries(ctx, datamodel.DbListAuditEntriesParams{
+		AfterID:     afterID,
+		Subject:     toText(filter.Subject),
+		Resource:    toText(filter.Resource),
+		CreatedFrom: toTimestamptz(filter.From),
+		CreatedTo:   toTimestamptz(filter.To),
+		MaxEntries:  int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error querying audit log: %w", err)
+	}
+
+	entries := make([]*Entry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, &Entry{
+			ID:        row.ID,
+			CreatedAt: row.CreatedAt.Time,
+			Service:   row.Service,
+			Method:    row.Method,
+			TenantID:  row.TenantID,
+			Subject:   row.Subject,
+			AppID:     row.AppID,
+			Roles:     row.Roles,
+			Resource:  row.Resource,
+			Verb:      row.Verb,
+			TargetIDs: row.TargetIds,
+			Outcome:   row.Outcome,
+			Message:   row.Message.String,
+		})
+	}
+	return entries, nil
+}
+
+// toText maps the empty string to NULL.
+func toText(s string) pgtype.Text {
+	return pgtype.Text{String: s, Valid: s != ""}
+}
+
+func toTimestamptz(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *t, Valid: true}
+}
@@ -0,0 +1,197 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+	"github.com/d4l-data4life/mex/mex/shared/uuid"
+)
+
+const (
+	forwarderGroupName = "audit-forwarder"
+	forwarderBatchSize = 100
+	forwarderBlockTime = 5 * time.Second
+
+	// Entries pending with another consumer for longer than this (e.g. one of a replica which has gone) are claimed.
+	forwarderClaimIdle = time.Minute
+
+	// Consumers without pending entries which have been idle for longer than this are removed from the group.
+	forwarderConsumerIdle = time.Hour
+
+	// Approximate maximum length of the stream; should the forwarders fall this far behind, the oldest entries are lost.
+	redisSinkMaxLen = 100000
+)
+
+/*
+RedisSink queues audit entries in a Redis stream. It is used by services without database access; the entries are
+moved to the audit log in the database by a Forwarder.
+*/
+type RedisSink struct {
+	Redis  *redis.Client
+	Stream string
+}
+
+func (sink *RedisSink) Record(ctx context.Context, entry *Entry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	return sink.Redis.XAdd(ctx, &redis.XAddArgs{
+		Stream: sink.Stream,
+		MaxLen: redisSinkMaxLen,
+		Approx: true,
+		Values: []string{"entry", string(data)},
+	}).Err()
+}
+
+/*
+Forwarder moves the audit entries queued in a Redis stream to the target sink. Several forwarders (e.g. replicas of
+a service) share the work via a consumer group; an entry is only removed from the stream once it has been recorded.
+Entries left pending by a forwarder which has gone are claimed by the others.
+*/
+type Forwarder struct {
+	Redis  *redis.Client
+	Stream string
+	Target Sink
+	Log    L.Logger
+}
+
+// Run forwards entries until the context is cancelled.
+func (fwd *Forwarder) Run(ctx context.Context) {
+	err := fwd.Redis.XGroupCreateMkStream(ctx, fwd.Stream, forwarderGroupName, "0").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		fwd.Log.Error(ctx, L.Messagef("could not create audit consumer group: %s", err.Error()))
+		return
+	}
+
+	// Every run is a consumer of its own (host names need not be unique, and a restarted pod may get another one);
+	// the entries of earlier runs are taken over by claiming them.
+	hostname, _ := os.Hostname()
+	consumer := fmt.Sprintf("%s-%s", hostname, uuid.MustNewV4())
+
+	var lastClaim time.Time
+	lastID := ">"
+	for ctx.Err() == nil {
+		if time.Since(lastClaim) >= forwarderClaimIdle {
+			fwd.claim(ctx, consumer)
+			lastClaim = time.Now()
+		}
+
+		streams, err := fwd.Redis.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    forwarderGroupName,
+			Consumer: consumer,
+			Streams:  []string{fwd.Stream, lastID},
+			Count:    forwarderBatchSize,
+			Block:    forwarderBlockTime,
+		}).Result()
+		if err == redis.Nil || ctx.Err() != nil {
+			continue
+		}
+		if err != nil {
+			fwd.Log.Warn(ctx, L.Messagef("could not read audit entries: %s", err.Error()))
+			time.Sleep(forwarderBlockTime)
+			continue
+		}
+
+		messages := []redis.XMessage{}
+		for _, stream := range streams {
+			messages = append(messages, stream.Messages...)
+		}
+
+		switch {
+		case !fwd.forwardAll(ctx, messages):
+			// Retry the unacknowledged entries after a pause.
+			lastID = "0"
+			time.Sleep(forwarderBlockTime)
+		case len(messages) == 0 && lastID == "0":
+			// Retries done; continue with new entries.
+			lastID = ">"
+		}
+	}
+}
+
+/*
+claim takes over the entries which have been pending with other consumers for too long and forwards them. Then it
+removes the consumers which have been idle for long and have no pending entries; a removed consumer which is still
+running is re-created by its next read.
+*/
+func (fwd *Forwarder) claim(ctx context.Context, consumer string) {
+	start := "0-0"
+	for {
+		messages, next, err := fwd.Redis.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+			Stream:   fwd.Stream,
+			Group:    forwarderGroupName,
+			MinIdle:  forwarderClaimIdle,
+			Start:    start,
+			Count:    forwarderBatchSize,
+			Consumer: consumer,
+		}).Result()
+		if err != nil {
+			fwd.Log.Warn(ctx, L.Messagef("could not claim pending audit entries: %s", err.Error()))
+			return
+		}
+		// Entries failing again stay pending with this consumer and are retried with its own ones.
+		fwd.forwardAll(ctx, messages)
+		if next == "0-0" {
+			break
+		}
+		start = next
+	}
+
+	consumers, err := fwd.Redis.XInfoConsumers(ctx, fwd.Stream, forwarderGroupName).Result()
+	if err != nil {
+		fwd.Log.Warn(ctx, L.Messagef("could not list audit consumers: %s", err.Error()))
+		return
+	}
+	for _, c := range consumers {
+		if c.Name == consumer || c.Pending > 0 || time.Duration(c.Idle)*time.Millisecond < forwarderConsumerIdle {
+			continue
+		}
+		if err := fwd.Redis.XGroupDelConsumer(ctx, fwd.Stream, forwarderGroupName, c.Name).Err(); err != nil {
+			fwd.Log.Warn(ctx, L.Messagef("could not remove audit consumer %s: %s", c.Name, err.Error()))
+		}
+	}
+}
+
+// forwardAll forwards the given entries and reports whether all of them succeeded.
+func (fwd *Forwarder) forwardAll(ctx context.Context, messages []redis.XMessage) bool {
+	ok := true
+	for _, message := range messages {
+		if err := fwd.forward(ctx, message); err != nil {
+			fwd.Log.Warn(ctx, L.Messagef("could not forward audit entry %s: %s", message.ID, err.Error()))
+			ok = false
+		}
+	}
+	return ok
+}
+
+// forward records the entry in the target sink and removes it from the stream; malformed entries are dropped.
+func (fwd *Forwarder) forward(ctx context.Context, message redis.XMessage) error {
+	var entry Entry
+	err := fmt.Errorf("no entry field")
+	if data, ok := message.Values["entry"].(string); ok {
+		err = json.Unmarshal([]byte(data), &entry)
+	}
+
+	if err == nil {
+		err = fwd.Target.Record(ctx, &entry)
+		if err != nil {
+			return err
+		}
+	} else {
+		fwd.Log.Error(ctx, L.Messagef("dropping malformed audit entry %s: %s", message.ID, err.Error()))
+	}
+
+	pipe := fwd.Redis.TxPipeline()
+	pipe.XAck(ctx, fwd.Stream, forwarderGroupName, message.ID)
+	pipe.XDel(ctx, fwd.Stream, message.ID)
+	_, err = pipe.Exec(ctx)
+	return err
+}
@@ -0,0 +1,60 @@
+package apikeys
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+)
+
+const (
+	// Managed keys are recognizable by this prefix (unlike the keys configured via the service configuration).
+	keyPrefix = "mex_"
+
+	keySecretLength = 24
+	// Number of characters of a key kept in plain text to tell keys apart
+	keyDisplayLength = 12
+)
+
+// APIKey holds the meta data of a managed API key; the key itself is never stored.
+type APIKey struct {
+	ID        string
+	Name      string
+	KeyPrefix string
+	Scopes    []string
+	CreatedBy string
+	CreatedAt time.Time
+
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	RotatedTo  string
+}
+
+// Active tells whether the key can be used at the given time.
+func (key *APIKey) Active(now time.Time) bool {
+	if key.RevokedAt != nil {
+		return false
+	}
+	return key.ExpiresAt == nil || now.Before(*key.ExpiresAt)
+}
+
+// IsManagedKey tells whether the key looks like a managed key (without checking if the key exists).
+func IsManagedKey(key string) bool {
+	return strings.HasPrefix(key, keyPrefix)
+}
+
+// newKey returns a random key and its hash
+func newKey() (string, string) {
+	t := make([]byte, keySecretLength)
+	_, _ = rand.Read(t)
+	key := keyPrefix + hex.EncodeToString(t)
+	return key, hashKey(key)
+}
+
+// hashKey returns the hash under which a key is stored. As the keys are random, a plain hash suffices.
+func hashKey(key string) string {
+	h := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(h[:])
+}
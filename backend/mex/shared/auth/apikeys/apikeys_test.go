@@ -0,0 +1,46 @@
+package apikeys
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAPIKey_Active(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Minute)
+
+	tests := []struct {
+		name   string
+		apiKey APIKey
+		want   bool
+	}{
+		{name: "Without expiry", apiKey: APIKey{}, want: true},
+		{name: "Not yet expired", apiKey: APIKey{ExpiresAt: &future}, want: true},
+		{name: "Expired", apiKey: APIKey{ExpiresAt: &past}, want: false},
+		{name: "Revoked", apiKey: APIKey{ExpiresAt: &future, RevokedAt: &past}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.apiKey.Active(now); got != tt.want {
+				t.Errorf("Active() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewKey(t *testing.T) {
+	key1, hash1 := newKey()
+	key2, hash2 := newKey()
+
+	if !IsManagedKey(key1) || !strings.HasPrefix(key1, "mex_") {
+		t.Errorf("newKey() = %s, not a managed key", key1)
+	}
+	if key1 == key2 || hash1 == hash2 {
+		t.Errorf("newKey() returned the same key twice")
+	}
+	if hashKey(key1) != hash1 || strings.Contains(hash1, key1[len(keyPrefix):]) {
+		t.Errorf("newKey() hash = %s, want hash of key", hash1)
+	}
+}
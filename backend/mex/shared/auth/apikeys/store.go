@@ -0,0 +1,214 @@
+package apikeys
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+
+	"github.com/d4l-data4life/mex/mex/shared/db"
+	"github.com/d4l-data4life/mex/mex/shared/errstat"
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+	"github.com/d4l-data4life/mex/mex/shared/uuid"
+
+	"github.com/d4l-data4life/mex/mex/services/metadata/business/datamodel"
+)
+
+const lastUsedGranularity = time.Minute
+
+// Store persists managed API keys in Postgres (table api_keys, created by the metadata service migrations).
+type Store struct {
+	DB  db.Pool
+	Log L.Logger
+}
+
+// Create stores a new key and returns its meta data together with the key, which is not retrievable later on.
+func (store *Store) Create(ctx context.Context, name string, scopes []string, createdBy string, expiresAt *time.Time) (*APIKey, string, error) {
+	key, keyHash := newKey()
+	row, err := datamodel.New(store.DB).DbCreateApiKey(ctx, datamodel.DbCreateApiKeyParams{
+		ID:        uuid.MustNewV4(),
+		Name:      name,
+		KeyPrefix: key[:keyDisplayLength],
+		KeyHash:   keyHash,
+		Scopes:    scopes,
+		CreatedBy: createdBy,
+		ExpiresAt: toTimestamptz(expiresAt),
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("error storing API key: %w", err)
+	}
+	return fromRow(row), key, nil
+}
+
+// List returns all keys, or only those created by the given user (if not empty); inactive keys are only included if asked for.
+func (store *Store) List(ctx context.Context, includeInactive bool, createdBy string) ([]*APIKey, error) {
+	rows, err := datamodel.New(store.DB).DbListApiKeys(ctx, pgtype.Text{String: createdBy, Valid: createdBy != ""})
+	if err != nil {
+		return nil, fmt.Errorf("error listing API keys: %w", err)
+	}
+
+	now := time.Now()
+	apiKeys := []*APIKey{}
+	for _, row := range rows {
+		apiKey := fromRow(row)
+		if includeInactive || apiKey.Active(now) {
+			apiKeys = append(apiKeys, apiKey)
+		}
+	}
+	return apiKeys, nil
+}
+
+// Get returns the key with the given ID.
+func (store *Store) Get(ctx context.Context, id string) (*APIKey, error) {
+	row, err := datamodel.New(store.DB).DbGetApiKey(ctx, id)
+	if err == pgx.ErrNoRows {
+		return nil, errstat.MakeGRPCStatus(codes.NotFound, fmt.Sprintf("API key not found: %s", id)).Err()
+	} else if err != nil {
+		return nil, fmt.Errorf("error reading API key: %s: %w", id, err)
+	}
+	return fromRow(row), nil
+}
+
+// Revoke makes the key unusable immediately. Revoking a revoked key is no error.
+func (store *Store) Revoke(ctx context.Context, id string) (*APIKey, error) {
+	err := datamodel.New(store.DB).DbRevokeApiKey(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error revoking API key: %s: %w", id, err)
+	}
+	return store.Get(ctx, id)
+}
+
+/*
+Rotate replaces the key by a new one with the same name, scopes, creator and expiry (so that the key stays under the
+control of its creator). The old key stays valid for the grace period so that clients can switch over without
+downtime. It returns the new key's meta data and the new key.
+*/
+func (store *Store) Rotate(ctx context.Context, id string, gracePeriod time.Duration) (*APIKey, string, error) {
+	tx, err := store.DB.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, "", fmt.Errorf("error beginning transaction: %w", err)
+	}
+
+	txCommit := false
+	defer finishTx(ctx, tx, store.Log, &txCommit)
+
+	queries := datamodel.New(tx)
+	oldRow, err := queries.DbGetApiKeyForUpdate(ctx, id)
+	if err == pgx.ErrNoRows {
+		return nil, "", errstat.MakeGRPCStatus(codes.NotFound, fmt.Sprintf("API key not found: %s", id)).Err()
+	} else if err != nil {
+		return nil, "", fmt.Errorf("error reading API key: %s: %w", id, err)
+	}
+
+	oldKey := fromRow(oldRow)
+	now := time.Now()
+	if !oldKey.Active(now) || oldKey.RotatedTo != "" {
+		return nil, "", errstat.MakeGRPCStatus(codes.FailedPrecondition, fmt.Sprintf("API key is not active or already rotated: %s", id)).Err()
+	}
+
+	key, keyHash := newKey()
+	rotatedRow, err := queries.DbCreateApiKey(ctx, datamodel.DbCreateApiKeyParams{
+		ID:        uuid.MustNewV4(),
+		Name:      oldKey.Name,
+		KeyPrefix: key[:keyDisplayLength],
+		KeyHash:   keyHash,
+		Scopes:    oldKey.Scopes,
+		CreatedBy: oldKey.CreatedBy,
+		ExpiresAt: oldRow.ExpiresAt,
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("error storing API key: %w", err)
+	}
+
+	graceEnd := now.Add(gracePeriod)
+	if oldKey.ExpiresAt != nil && oldKey.ExpiresAt.Before(graceEnd) {
+		graceEnd = *oldKey.ExpiresAt
+	}
+	err = queries.DbRotateApiKey(ctx, datamodel.DbRotateApiKeyParams{
+		ID:        id,
+		RotatedTo: pgtype.Text{String: rotatedRow.ID, Valid: true},
+		ExpiresAt: toTimestamptz(&graceEnd),
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("error rotating API key: %s: %w", id, err)
+	}
+
+	txCommit = true
+	return fromRow(rotatedRow), key, nil
+}
+
+/*
+Lookup returns the active key matching the given key, or nil if there is none. The time of last use is recorded with a
+granularity of a minute, so that frequently used keys do not cause a write per request.
+*/
+func (store *Store) Lookup(ctx context.Context, key string) (*APIKey, error) {
+	queries := datamodel.New(store.DB)
+	row, err := queries.DbGetApiKeyByHash(ctx, hashKey(key))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error looking up API key: %w", err)
+	}
+
+	apiKey := fromRow(row)
+	now := time.Now()
+	if !apiKey.Active(now) {
+		return nil, nil
+	}
+
+	if apiKey.LastUsedAt == nil || now.Sub(*apiKey.LastUsedAt) >= lastUsedGranularity {
+		err = queries.DbTouchApiKey(ctx, apiKey.ID)
+		if err != nil && store.Log != nil {
+			store.Log.Warn(ctx, L.Messagef("could not record use of API key %s: %s", apiKey.ID, err.Error()))
+		}
+	}
+	return apiKey, nil
+}
+
+func fromRow(row datamodel.ApiKey) *APIKey {
+	return &APIKey{
+		ID:         row.ID,
+		Name:       row.Name,
+		KeyPrefix:  row.KeyPrefix,
+		Scopes:     row.Scopes,
+		CreatedBy:  row.CreatedBy,
+		CreatedAt:  row.CreatedAt.Time,
+		ExpiresAt:  fromTimestamptz(row.ExpiresAt),
+		LastUsedAt: fromTimestamptz(row.LastUsedAt),
+		RevokedAt:  fromTimestamptz(row.RevokedAt),
+		RotatedTo:  row.RotatedTo.String,
+	}
+}
+
+func fromTimestamptz(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+func toTimestamptz(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *t, Valid: true}
+}
+
+func finishTx(ctx context.Context, tx pgx.Tx, log L.Logger, commit *bool) {
+	if *commit {
+		if err := tx.Commit(ctx); err != nil {
+			if log != nil {
+				log.Error(ctx, L.Messagef("commit error: %s", err.Error()))
+			}
+		}
+	} else {
+		if err := tx.Rollback(ctx); err != nil {
+			if log != nil {
+				log.Error(ctx, L.Messagef("rollback error: %s", err.Error()))
+			}
+		}
+	}
+}
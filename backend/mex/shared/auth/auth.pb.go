@@ -25,10 +25,16 @@ type Claims struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TenantId   string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	AppId      string `protobuf:"bytes,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
-	UserId     string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Privileges uint64 `protobuf:"varint,4,opt,name=privileges,proto3" json:"privileges,omitempty"`
+	TenantId   string   `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	AppId      string   `protobuf:"bytes,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	UserId     string   `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Privileges uint64   `protobuf:"varint,4,opt,name=privileges,proto3" json:"privileges,omitempty"`
+	Groups     []string `protobuf:"bytes,5,rep,name=groups,proto3" json:"groups,omitempty"`
+	// If set, the user may only see items matching at least one of the visibility rules
+	VisibilityRestricted bool              `protobuf:"varint,6,opt,name=visibility_restricted,json=visibilityRestricted,proto3" json:"visibility_restricted,omitempty"`
+	Visibility           []*VisibilityRule `protobuf:"bytes,7,rep,name=visibility,proto3" json:"visibility,omitempty"`
+	// Names of the roles the privileges were derived from (for auditing)
+	Roles []string `protobuf:"bytes,8,rep,name=roles,proto3" json:"roles,omitempty"`
 }
 
 func (x *Claims) Reset() {
@@ -91,6 +97,149 @@ func (x *Claims) GetPrivileges() uint64 {
 	return 0
 }
 
+func (x *Claims) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *Claims) GetVisibilityRestricted() bool {
+	if x != nil {
+		return x.VisibilityRestricted
+	}
+	return false
+}
+
+func (x *Claims) GetVisibility() []*VisibilityRule {
+	if x != nil {
+		return x.Visibility
+	}
+	return nil
+}
+
+func (x *Claims) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// VisibilityRule grants read access to the items matching all of its (non-empty) constraints.
+type VisibilityRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Entity types of the visible items
+	EntityTypes []string `protobuf:"bytes,1,rep,name=entity_types,json=entityTypes,proto3" json:"entity_types,omitempty"`
+	// Hierarchy axis (from the search configuration) on which the visible sub-trees are given
+	HierarchyAxis string `protobuf:"bytes,2,opt,name=hierarchy_axis,json=hierarchyAxis,proto3" json:"hierarchy_axis,omitempty"`
+	// Nodes of the hierarchy axis whose sub-trees are visible
+	Subtrees []string `protobuf:"bytes,3,rep,name=subtrees,proto3" json:"subtrees,omitempty"`
+}
+
+func (x *VisibilityRule) Reset() {
+	*x = VisibilityRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_auth_auth_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VisibilityRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisibilityRule) ProtoMessage() {}
+
+func (x *VisibilityRule) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_auth_auth_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisibilityRule.ProtoReflect.Descriptor instead.
+func (*VisibilityRule) Descriptor() ([]byte, []int) {
+	return file_shared_auth_auth_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *VisibilityRule) GetEntityTypes() []string {
+	if x != nil {
+		return x.EntityTypes
+	}
+	return nil
+}
+
+func (x *VisibilityRule) GetHierarchyAxis() string {
+	if x != nil {
+		return x.HierarchyAxis
+	}
+	return ""
+}
+
+func (x *VisibilityRule) GetSubtrees() []string {
+	if x != nil {
+		return x.Subtrees
+	}
+	return nil
+}
+
+type VisibilityRules struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Map from group ID to the rule applying to the members of the group
+	GroupRules map[string]*VisibilityRule `protobuf:"bytes,1,rep,name=group_rules,json=groupRules,proto3" json:"group_rules,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *VisibilityRules) Reset() {
+	*x = VisibilityRules{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_auth_auth_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VisibilityRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisibilityRules) ProtoMessage() {}
+
+func (x *VisibilityRules) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_auth_auth_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisibilityRules.ProtoReflect.Descriptor instead.
+func (*VisibilityRules) Descriptor() ([]byte, []int) {
+	return file_shared_auth_auth_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *VisibilityRules) GetGroupRules() map[string]*VisibilityRule {
+	if x != nil {
+		return x.GroupRules
+	}
+	return nil
+}
+
 type ApiKeys struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -103,7 +252,7 @@ type ApiKeys struct {
 func (x *ApiKeys) Reset() {
 	*x = ApiKeys{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shared_auth_auth_proto_msgTypes[1]
+		mi := &file_shared_auth_auth_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -116,7 +265,7 @@ func (x *ApiKeys) String() string {
 func (*ApiKeys) ProtoMessage() {}
 
 func (x *ApiKeys) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_auth_auth_proto_msgTypes[1]
+	mi := &file_shared_auth_auth_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -129,7 +278,7 @@ func (x *ApiKeys) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApiKeys.ProtoReflect.Descriptor instead.
 func (*ApiKeys) Descriptor() ([]byte, []int) {
-	return file_shared_auth_auth_proto_rawDescGZIP(), []int{1}
+	return file_shared_auth_auth_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ApiKeys) GetKeysRoles() map[string]string {
@@ -139,31 +288,250 @@ func (x *ApiKeys) GetKeysRoles() map[string]string {
 	return nil
 }
 
+// RolesConfig holds the roles defined in the config repository in addition to the built-in producer and consumer roles.
+type RolesConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Roles []*RoleDef `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	// Map from group ID to the names of the roles granted to the members of the group
+	GroupRoles map[string]*RoleNames `protobuf:"bytes,2,rep,name=group_roles,json=groupRoles,proto3" json:"group_roles,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *RolesConfig) Reset() {
+	*x = RolesConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_auth_auth_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RolesConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolesConfig) ProtoMessage() {}
+
+func (x *RolesConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_auth_auth_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolesConfig.ProtoReflect.Descriptor instead.
+func (*RolesConfig) Descriptor() ([]byte, []int) {
+	return file_shared_auth_auth_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RolesConfig) GetRoles() []*RoleDef {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *RolesConfig) GetGroupRoles() map[string]*RoleNames {
+	if x != nil {
+		return x.GroupRoles
+	}
+	return nil
+}
+
+type RoleDef struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Privileges in the form <resource>/<verb>, e.g. "items/read"
+	Privileges []string `protobuf:"bytes,3,rep,name=privileges,proto3" json:"privileges,omitempty"`
+}
+
+func (x *RoleDef) Reset() {
+	*x = RoleDef{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_auth_auth_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleDef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleDef) ProtoMessage() {}
+
+func (x *RoleDef) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_auth_auth_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleDef.ProtoReflect.Descriptor instead.
+func (*RoleDef) Descriptor() ([]byte, []int) {
+	return file_shared_auth_auth_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RoleDef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RoleDef) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RoleDef) GetPrivileges() []string {
+	if x != nil {
+		return x.Privileges
+	}
+	return nil
+}
+
+type RoleNames struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (x *RoleNames) Reset() {
+	*x = RoleNames{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_auth_auth_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleNames) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleNames) ProtoMessage() {}
+
+func (x *RoleNames) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_auth_auth_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleNames.ProtoReflect.Descriptor instead.
+func (*RoleNames) Descriptor() ([]byte, []int) {
+	return file_shared_auth_auth_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RoleNames) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
 var File_shared_auth_auth_proto protoreflect.FileDescriptor
 
 var file_shared_auth_auth_proto_rawDesc = []byte{
 	0x0a, 0x16, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x61, 0x75,
 	0x74, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65,
-	0x78, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x22, 0x75, 0x0a, 0x06, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73,
-	0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x15, 0x0a,
-	0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
-	0x70, 0x70, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a,
-	0x0a, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x73, 0x22, 0x8c, 0x01,
-	0x0a, 0x07, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x43, 0x0a, 0x0a, 0x6b, 0x65, 0x79,
-	0x73, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e,
-	0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x41, 0x70, 0x69,
-	0x4b, 0x65, 0x79, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x1a, 0x3c,
-	0x0a, 0x0e, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x43, 0x5a, 0x41,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x65, 0x73, 0x75, 0x6e,
-	0x64, 0x68, 0x65, 0x69, 0x74, 0x73, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x72, 0x6b, 0x69, 0x2d,
-	0x6d, 0x65, 0x78, 0x2d, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x6d, 0x65, 0x78,
+	0x78, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x22, 0x96, 0x02, 0x0a, 0x06, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x15,
+	0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x33, 0x0a, 0x15, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x65, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x0a, 0x76,
+	0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x56,
+	0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0a, 0x76,
+	0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c,
+	0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22,
+	0x76, 0x0a, 0x0e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x68, 0x69, 0x65, 0x72, 0x61, 0x72, 0x63, 0x68,
+	0x79, 0x5f, 0x61, 0x78, 0x69, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x68, 0x69,
+	0x65, 0x72, 0x61, 0x72, 0x63, 0x68, 0x79, 0x41, 0x78, 0x69, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x75, 0x62, 0x74, 0x72, 0x65, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x75, 0x62, 0x74, 0x72, 0x65, 0x65, 0x73, 0x22, 0xbe, 0x01, 0x0a, 0x0f, 0x56, 0x69, 0x73, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x4e, 0x0a, 0x0b, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e,
+	0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x2e,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0x5b, 0x0a, 0x0f, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x56,
+	0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8c, 0x01, 0x0a, 0x07, 0x41, 0x70, 0x69,
+	0x4b, 0x65, 0x79, 0x73, 0x12, 0x43, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x73, 0x5f, 0x72, 0x6f, 0x6c,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d,
+	0x65, 0x78, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x73, 0x2e,
+	0x4b, 0x65, 0x79, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09,
+	0x6b, 0x65, 0x79, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x1a, 0x3c, 0x0a, 0x0e, 0x4b, 0x65, 0x79,
+	0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xde, 0x01, 0x0a, 0x0b, 0x52, 0x6f, 0x6c, 0x65,
+	0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2b, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x52, 0x05, 0x72,
+	0x6f, 0x6c, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x72, 0x6f,
+	0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x64, 0x34, 0x6c, 0x2e,
+	0x6d, 0x65, 0x78, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x73,
+	0x1a, 0x56, 0x0a, 0x0f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5f, 0x0a, 0x07, 0x52, 0x6f, 0x6c, 0x65,
+	0x44, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x69,
+	0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x73, 0x22, 0x21, 0x0a, 0x09, 0x52, 0x6f, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x42, 0x33, 0x5a, 0x31,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x34, 0x6c, 0x2d, 0x64,
+	0x61, 0x74, 0x61, 0x34, 0x6c, 0x69, 0x66, 0x65, 0x2f, 0x6d, 0x65, 0x78, 0x2f, 0x6d, 0x65, 0x78,
 	0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x3b, 0x61, 0x75, 0x74,
 	0x68, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
@@ -180,19 +548,32 @@ func file_shared_auth_auth_proto_rawDescGZIP() []byte {
 	return file_shared_auth_auth_proto_rawDescData
 }
 
-var file_shared_auth_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_shared_auth_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_shared_auth_auth_proto_goTypes = []interface{}{
-	(*Claims)(nil),  // 0: d4l.mex.auth.Claims
-	(*ApiKeys)(nil), // 1: d4l.mex.auth.ApiKeys
-	nil,             // 2: d4l.mex.auth.ApiKeys.KeysRolesEntry
+	(*Claims)(nil),          // 0: d4l.mex.auth.Claims
+	(*VisibilityRule)(nil),  // 1: d4l.mex.auth.VisibilityRule
+	(*VisibilityRules)(nil), // 2: d4l.mex.auth.VisibilityRules
+	(*ApiKeys)(nil),         // 3: d4l.mex.auth.ApiKeys
+	(*RolesConfig)(nil),     // 4: d4l.mex.auth.RolesConfig
+	(*RoleDef)(nil),         // 5: d4l.mex.auth.RoleDef
+	(*RoleNames)(nil),       // 6: d4l.mex.auth.RoleNames
+	nil,                     // 7: d4l.mex.auth.VisibilityRules.GroupRulesEntry
+	nil,                     // 8: d4l.mex.auth.ApiKeys.KeysRolesEntry
+	nil,                     // 9: d4l.mex.auth.RolesConfig.GroupRolesEntry
 }
 var file_shared_auth_auth_proto_depIdxs = []int32{
-	2, // 0: d4l.mex.auth.ApiKeys.keys_roles:type_name -> d4l.mex.auth.ApiKeys.KeysRolesEntry
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1, // 0: d4l.mex.auth.Claims.visibility:type_name -> d4l.mex.auth.VisibilityRule
+	7, // 1: d4l.mex.auth.VisibilityRules.group_rules:type_name -> d4l.mex.auth.VisibilityRules.GroupRulesEntry
+	8, // 2: d4l.mex.auth.ApiKeys.keys_roles:type_name -> d4l.mex.auth.ApiKeys.KeysRolesEntry
+	5, // 3: d4l.mex.auth.RolesConfig.roles:type_name -> d4l.mex.auth.RoleDef
+	9, // 4: d4l.mex.auth.RolesConfig.group_roles:type_name -> d4l.mex.auth.RolesConfig.GroupRolesEntry
+	1, // 5: d4l.mex.auth.VisibilityRules.GroupRulesEntry.value:type_name -> d4l.mex.auth.VisibilityRule
+	6, // 6: d4l.mex.auth.RolesConfig.GroupRolesEntry.value:type_name -> d4l.mex.auth.RoleNames
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_shared_auth_auth_proto_init() }
@@ -214,6 +595,30 @@ func file_shared_auth_auth_proto_init() {
 			}
 		}
 		file_shared_auth_auth_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VisibilityRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_auth_auth_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VisibilityRules); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_auth_auth_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ApiKeys); i {
 			case 0:
 				return &v.state
@@ -225,6 +630,42 @@ func file_shared_auth_auth_proto_init() {
 				return nil
 			}
 		}
+		file_shared_auth_auth_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RolesConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_auth_auth_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleDef); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_auth_auth_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleNames); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -232,7 +673,7 @@ func file_shared_auth_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_shared_auth_auth_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -8,12 +8,20 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/d4l-data4life/mex/mex/shared/auth"
+	"github.com/d4l-data4life/mex/mex/shared/auth/apikeys"
 	"github.com/d4l-data4life/mex/mex/shared/known/securitypb"
 )
 
 type APIKeyAuthenticator struct {
 	tenantID string
 	keyRoles *auth.ApiKeys
+
+	managedKeys ManagedKeyLookup
+}
+
+// ManagedKeyLookup finds the active managed API key matching a key (cf. apikeys.Store).
+type ManagedKeyLookup interface {
+	Lookup(ctx context.Context, key string) (*apikeys.APIKey, error)
 }
 
 func NewAPIKeyAuthenticator(tenantID string, keysPrivilgesBytes []byte) (*APIKeyAuthenticator, error) {
@@ -48,7 +56,7 @@ func (a *APIKeyAuthenticator) Authenticate(ctx context.Context, request any) (*s
 
 	roleName, ok := a.keyRoles.KeysRoles[authHeader.tokenValue]
 	if !ok {
-		return nil, status.Error(codes.Unauthenticated, "unknown API key")
+		return a.authenticateManagedKey(ctx, authHeader.tokenValue)
 	}
 
 	user := securitypb.UserWithRoles{
@@ -59,6 +67,34 @@ func (a *APIKeyAuthenticator) Authenticate(ctx context.Context, request any) (*s
 	return &user, nil
 }
 
+// SetManagedKeys enables authentication with managed API keys in addition to the configured ones.
+func (a *APIKeyAuthenticator) SetManagedKeys(managedKeys ManagedKeyLookup) {
+	a.managedKeys = managedKeys
+}
+
+// authenticateManagedKey grants the scopes of a managed key; the user ID is derived from the key ID, as key names need
+// not be unique.
+func (a *APIKeyAuthenticator) authenticateManagedKey(ctx context.Context, key string) (*securitypb.UserWithRoles, error) {
+	if a.managedKeys == nil || !apikeys.IsManagedKey(key) {
+		return nil, status.Error(codes.Unauthenticated, "unknown API key")
+	}
+
+	apiKey, err := a.managedKeys.Lookup(ctx, key)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	if apiKey == nil {
+		return nil, status.Error(codes.Unauthenticated, "unknown API key")
+	}
+
+	return &securitypb.UserWithRoles{
+		TenantId:   a.tenantID,
+		UserId:     "apikey:" + apiKey.ID,
+		Roles:      []string{},
+		Privileges: apiKey.Scopes,
+	}, nil
+}
+
 func (a *APIKeyAuthenticator) Count() int {
 	if a.keyRoles == nil {
 		return 0
@@ -17,8 +17,9 @@ import (
 )
 
 type jwtAuthenticator struct {
-	validator keys.TokenValidator
-	clientID  string
+	validator   keys.TokenValidator
+	revocations auth.RevocationChecker
+	clientID    string
 
 	consumerGroupID string
 	producerGroupID string
@@ -29,9 +30,11 @@ type tokenHeader struct {
 	tokenValue string
 }
 
-func NewJWTAuthenticator(validator keys.TokenValidator, clientID string, consumerGroupID string, producerGroupID string) (auth.RequestAuthenticator, error) {
+// NewJWTAuthenticator returns an authenticator for Bearer tokens; revoked tokens are rejected if revocations is not nil.
+func NewJWTAuthenticator(validator keys.TokenValidator, revocations auth.RevocationChecker, clientID string, consumerGroupID string, producerGroupID string) (auth.RequestAuthenticator, error) {
 	return &jwtAuthenticator{
 		validator:       validator,
+		revocations:     revocations,
 		clientID:        clientID,
 		consumerGroupID: consumerGroupID,
 		producerGroupID: producerGroupID,
@@ -53,6 +56,16 @@ func (a *jwtAuthenticator) Authenticate(ctx context.Context, request any) (*secu
 		return nil, status.Error(codes.Unauthenticated, err.Error())
 	}
 
+	if a.revocations != nil {
+		revoked, err := a.revocations.IsRevoked(ctx, (*token).JwtID(), (*token).Subject(), (*token).IssuedAt())
+		if err != nil {
+			return nil, status.Error(codes.Unavailable, fmt.Sprintf("could not check token revocation: %s", err.Error()))
+		}
+		if revoked {
+			return nil, status.Error(codes.Unauthenticated, "token has been revoked")
+		}
+	}
+
 	user := securitypb.UserWithRoles{}
 
 	if value, ok := (*token).Get("appid"); ok {
@@ -84,9 +97,35 @@ func (a *jwtAuthenticator) Authenticate(ctx context.Context, request any) (*secu
 	}
 
 	user.Roles = roles
+	user.Groups = tokenGroups(token)
+	if value, ok := (*token).Get("email"); ok {
+		if email, ok := value.(string); ok {
+			user.Email = email
+		}
+	}
 	return &user, nil
 }
 
+// tokenGroups returns the IDs of the groups the user is member of; technical users usually have none
+func tokenGroups(token *jwt.Token) []string {
+	groups := []string{}
+	if rawGroups, ok := (*token).Get("groups"); ok {
+		if values, ok := rawGroups.([]interface{}); ok {
+			for _, value := range values {
+				if group, ok := value.(string); ok {
+					groups = append(groups, group)
+				}
+			}
+		}
+	}
+	return groups
+}
+
+/*
+determineRoles returns the built-in roles of the token's user. The consumer and producer group IDs of the OAuth config
+map to the consumer and producer roles; this mapping is fixed and not part of the roles file, whose group mappings only
+add roles (see auth.PrivMgr). Technical users (acr "1") have both built-in roles.
+*/
 func determineRoles(consumerGroupID, producerGroupID string, token *jwt.Token, claim string) ([]string, error) {
 	if acr, ok := (*token).Get(claim); ok {
 		// See for values: https://docs.microsoft.com/en-us/azure/active-directory/develop/access-tokens
@@ -15,10 +15,35 @@ import (
 
 	"github.com/d4l-data4life/mex/mex/shared/constants"
 	"github.com/d4l-data4life/mex/mex/shared/errstat"
+	"github.com/d4l-data4life/mex/mex/shared/interceptors"
 	"github.com/d4l-data4life/mex/mex/shared/known/securitypb"
 )
 
-func NewInterceptor(registry RequestAuthenticatorRegistry, privMgr *PrivMgr) grpc.UnaryServerInterceptor {
+// Method name prefix of the gRPC server reflection service, which is registered in all services and
+// carries no security annotations.
+const reflectionMethodPrefix = "/grpc.reflection."
+
+type claimsObserverKey struct{}
+
+/*
+WithClaimsObserver returns a context for which the auth interceptor passes the claims of the authenticated caller to
+the given function, also if the call is then denied for lack of privileges. This allows interceptors running before
+the auth interceptor (e.g. the audit trail) to learn who made a rejected call.
+*/
+func WithClaimsObserver(ctx context.Context, observe func(*Claims)) context.Context {
+	return context.WithValue(ctx, claimsObserverKey{}, observe)
+}
+
+type authorizer struct {
+	registry RequestAuthenticatorRegistry
+	privMgr  *PrivMgr
+
+	// Cache the methods' privilege masks
+	mu               sync.Mutex
+	methodPrivileges map[string]PrivMask
+}
+
+func newAuthorizer(registry RequestAuthenticatorRegistry, privMgr *PrivMgr) *authorizer {
 	if registry == nil {
 		panic("registry is nil")
 	}
@@ -27,69 +52,113 @@ func NewInterceptor(registry RequestAuthenticatorRegistry, privMgr *PrivMgr) grp
 		panic("privilege manager is nil")
 	}
 
-	// Cache the methods' privilege masks
-	mu := sync.Mutex{}
-	methodPrivileges := map[string]PrivMask{}
+	return &authorizer{
+		registry:         registry,
+		privMgr:          privMgr,
+		methodPrivileges: map[string]PrivMask{},
+	}
+}
+
+func NewInterceptor(registry RequestAuthenticatorRegistry, privMgr *PrivMgr) grpc.UnaryServerInterceptor {
+	a := newAuthorizer(registry, privMgr)
 
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
-		authnType, err := getMethodAnnotation[securitypb.AuthenticationType](info.FullMethod, securitypb.E_AuthnType)
+		ctx, err := a.authorize(ctx, info.FullMethod, req)
 		if err != nil {
-			panic("no security annotation: " + info.FullMethod)
+			return nil, err
 		}
 
-		requestAuthenticator, ok := registry[*authnType]
-		if !ok {
-			return nil, fmt.Errorf("unknown authentication type: %v", *authnType)
+		return handler(ctx, req)
+	}
+}
+
+// NewStreamInterceptor is the streaming counterpart of NewInterceptor.
+// Since the stream messages are not known when the stream is opened, authentication is based on the metadata only.
+func NewStreamInterceptor(registry RequestAuthenticatorRegistry, privMgr *PrivMgr) grpc.StreamServerInterceptor {
+	a := newAuthorizer(registry, privMgr)
+
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if strings.HasPrefix(info.FullMethod, reflectionMethodPrefix) {
+			return handler(srv, ss)
 		}
 
-		userWithRoles, err := requestAuthenticator.Authenticate(ctx, req)
+		ctx, err := a.authorize(ss.Context(), info.FullMethod, nil)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
-		// At this point we are properly authenticated.
-		// Now let's authorize, that is, check the required vs actual privileges.
+		return handler(srv, interceptors.WrapServerStream(ss, ctx))
+	}
+}
 
-		userPrivilegesMask, err := privMgr.ResolveRoles(userWithRoles.Roles)
-		if err != nil {
-			return nil, err
-		}
+// authorize authenticates the request and checks the privileges required by the method.
+// It returns a context enriched with the user claims.
+func (a *authorizer) authorize(ctx context.Context, fullMethod string, req any) (context.Context, error) {
+	authnType, err := getMethodAnnotation[securitypb.AuthenticationType](fullMethod, securitypb.E_AuthnType)
+	if err != nil {
+		panic("no security annotation: " + fullMethod)
+	}
 
-		// Prevent concurrent modifications to the `methodPrivileges` map below when
-		// handling concurrent requests.
-		mu.Lock()
-		defer mu.Unlock()
+	requestAuthenticator, ok := a.registry[*authnType]
+	if !ok {
+		return nil, fmt.Errorf("unknown authentication type: %v", *authnType)
+	}
 
-		requiredPrivilegesMask, ok := methodPrivileges[info.FullMethod]
-		if !ok {
-			requiredPrivileges, err := getMethodAnnotation[[]*securitypb.Privilege](info.FullMethod, securitypb.E_RequiredPrivileges)
-			if err != nil {
-				requiredPrivileges = &[]*securitypb.Privilege{}
-			}
+	userWithRoles, err := requestAuthenticator.Authenticate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
 
-			for _, requiredPrivilege := range *requiredPrivileges {
-				requiredPrivilegesMask |= privMgr.MustPrivMask(requiredPrivilege.Resource, requiredPrivilege.Verb)
-			}
+	// At this point we are properly authenticated.
+	// Now let's authorize, that is, check the required vs actual privileges.
 
-			methodPrivileges[info.FullMethod] = requiredPrivilegesMask
-		}
+	mexUser, err := a.privMgr.ResolveClaims(userWithRoles)
+	if err != nil {
+		return nil, err
+	}
+	if observe, ok := ctx.Value(claimsObserverKey{}).(func(*Claims)); ok {
+		observe(mexUser)
+	}
 
-		if userPrivilegesMask&requiredPrivilegesMask != requiredPrivilegesMask {
-			return nil, errstat.MakeGRPCStatus(codes.PermissionDenied, "not enough privileges").Err()
-		}
+	requiredPrivilegesMask := a.requiredPrivileges(fullMethod)
 
-		mexUser := Claims{
-			TenantId:   userWithRoles.TenantId,
-			AppId:      userWithRoles.AppId,
-			UserId:     userWithRoles.UserId,
-			Privileges: userPrivilegesMask,
+	if mexUser.Privileges&requiredPrivilegesMask != requiredPrivilegesMask {
+		return nil, errstat.MakeGRPCStatus(codes.PermissionDenied, "not enough privileges").Err()
+	}
+
+	ctx = context.WithValue(ctx, constants.ContextKeyUser, userWithRoles)
+	ctx = context.WithValue(ctx, constants.ContextKeyUserClaims, mexUser)
+	ctx = context.WithValue(ctx, constants.ContextKeyUserID, userWithRoles.UserId)
+	ctx = context.WithValue(ctx, constants.ContextKeyTenantID, userWithRoles.TenantId)
+
+	return ctx, nil
+}
+
+func (a *authorizer) requiredPrivileges(fullMethod string) PrivMask {
+	// Prevent concurrent modifications to the `methodPrivileges` map below when
+	// handling concurrent requests.
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	requiredPrivilegesMask, ok := a.methodPrivileges[fullMethod]
+	if !ok {
+		for _, requiredPrivilege := range RequiredPrivileges(fullMethod) {
+			requiredPrivilegesMask |= a.privMgr.MustPrivMask(requiredPrivilege.Resource, requiredPrivilege.Verb)
 		}
-		ctx = context.WithValue(ctx, constants.ContextKeyUserClaims, &mexUser)
-		ctx = context.WithValue(ctx, constants.ContextKeyUserID, userWithRoles.UserId)
-		ctx = context.WithValue(ctx, constants.ContextKeyTenantID, userWithRoles.TenantId)
 
-		return handler(ctx, req)
+		a.methodPrivileges[fullMethod] = requiredPrivilegesMask
+	}
+
+	return requiredPrivilegesMask
+}
+
+// RequiredPrivileges returns the privileges required by the method according to its security annotation.
+func RequiredPrivileges(fullMethod string) []*securitypb.Privilege {
+	requiredPrivileges, err := getMethodAnnotation[[]*securitypb.Privilege](fullMethod, securitypb.E_RequiredPrivileges)
+	if err != nil {
+		return []*securitypb.Privilege{}
 	}
+	return *requiredPrivileges
 }
 
 func getMethodAnnotation[T any](methodName string, extType protoreflect.ExtensionType) (*T, error) {
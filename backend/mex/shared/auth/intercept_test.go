@@ -0,0 +1,83 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/d4l-data4life/mex/mex/shared/known/securitypb"
+
+	itemspb "github.com/d4l-data4life/mex/mex/services/metadata/endpoints/items/pb"
+)
+
+type staticAuthenticator struct {
+	user *securitypb.UserWithRoles
+}
+
+func (a staticAuthenticator) Authenticate(_ context.Context, _ any) (*securitypb.UserWithRoles, error) {
+	if a.user == nil {
+		return nil, status.Error(codes.Unauthenticated, "no token")
+	}
+	return a.user, nil
+}
+
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context { return s.ctx }
+
+func TestNewStreamInterceptor(t *testing.T) {
+	tests := []struct {
+		name     string
+		user     *securitypb.UserWithRoles
+		method   string
+		wantCode codes.Code
+	}{
+		{
+			name:   "Producer may create items",
+			user:   &securitypb.UserWithRoles{UserId: "u1", Roles: []string{RoleProducer}},
+			method: itemspb.Items_CreateItemsStream_FullMethodName,
+		},
+		{
+			name:     "Consumer lacks the create privilege",
+			user:     &securitypb.UserWithRoles{UserId: "u1", Roles: []string{RoleConsumer}},
+			method:   itemspb.Items_CreateItemsStream_FullMethodName,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "Unauthenticated",
+			method:   itemspb.Items_CreateItemsStream_FullMethodName,
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:   "Reflection is not checked",
+			method: "/grpc.reflection.v1.ServerReflection/ServerReflectionInfo",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interceptor := NewStreamInterceptor(RequestAuthenticatorRegistry{
+				securitypb.AuthenticationType_BEARER_TOKEN: staticAuthenticator{user: tt.user},
+			}, NewPrivMgr())
+
+			var gotUserID string
+			handler := func(_ any, ss grpc.ServerStream) error {
+				gotUserID = GetUserID(ss.Context())
+				return nil
+			}
+
+			err := interceptor(nil, &contextStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: tt.method}, handler)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("interceptor error = %v, want code %v", err, tt.wantCode)
+			}
+			if tt.wantCode == codes.OK && tt.user != nil && gotUserID != tt.user.UserId {
+				t.Errorf("handler context user = %q, want %q", gotUserID, tt.user.UserId)
+			}
+		})
+	}
+}
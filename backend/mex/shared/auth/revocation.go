@@ -0,0 +1,166 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// RevocationChecker tells whether an access token has been revoked before its expiry.
+type RevocationChecker interface {
+	IsRevoked(ctx context.Context, tokenID string, subject string, issuedAt time.Time) (bool, error)
+}
+
+/*
+RevocationList is the list of revoked access tokens shared by all services via Redis. Single tokens are revoked by
+their JWT ID; all tokens of a subject (logout everywhere) are revoked by recording the time of the logout, so that
+every token of the subject issued up to then is rejected. Entries expire once the tokens they refer to have expired.
+
+With a cache TTL, the results of the checks are cached locally, so that not every request costs a Redis round-trip.
+Each revocation is announced on the revocations topic, upon which the cached results are dropped (see Message). As
+announcements may get lost, the cache TTL bounds how long a revoked token may still be accepted.
+*/
+type RevocationList struct {
+	Redis     *redis.Client
+	TopicName string
+	CacheTTL  time.Duration
+
+	mu    sync.Mutex
+	cache map[string]cachedRevocationCheck
+	// Incremented on each announcement, so that checks running meanwhile do not cache their (outdated) results
+	generation uint64
+}
+
+type cachedRevocationCheck struct {
+	revoked   bool
+	expiresAt time.Time
+}
+
+// The cache is emptied once it holds that many results.
+const maxCachedRevocationChecks = 10000
+
+/*
+NewRevocationList returns a revocation list which announces revocations on the given topic (if any) and caches check
+results for the given TTL (0: no caching).
+*/
+func NewRevocationList(rdb *redis.Client, topicName string, cacheTTL time.Duration) *RevocationList {
+	return &RevocationList{
+		Redis:     rdb,
+		TopicName: topicName,
+		CacheTTL:  cacheTTL,
+		cache:     map[string]cachedRevocationCheck{},
+	}
+}
+
+func redisRevokedTokenKey(tokenID string) string {
+	return fmt.Sprintf("oauth:revoked:jti:%s", tokenID)
+}
+
+func redisRevokedSubjectKey(subject string) string {
+	return fmt.Sprintf("oauth:revoked:sub:%s", subject)
+}
+
+// RevokeToken revokes the access token with the given JWT ID until it expires.
+func (list *RevocationList) RevokeToken(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	lifetime := time.Until(expiresAt)
+	if lifetime <= 0 {
+		// Expired tokens are rejected anyway.
+		return nil
+	}
+	err := list.Redis.Set(ctx, redisRevokedTokenKey(tokenID), expiresAt.Unix(), lifetime).Err()
+	if err != nil {
+		return err
+	}
+	list.announce(ctx, tokenID)
+	return nil
+}
+
+/*
+RevokeSubject revokes all access tokens of the subject issued up to now. The lifetime must be at least the validity of
+access tokens, as tokens issued before the revocation are accepted again once the entry has expired.
+*/
+func (list *RevocationList) RevokeSubject(ctx context.Context, subject string, lifetime time.Duration) error {
+	err := list.Redis.Set(ctx, redisRevokedSubjectKey(subject), time.Now().Unix(), lifetime).Err()
+	if err != nil {
+		return err
+	}
+	list.announce(ctx, subject)
+	return nil
+}
+
+// announce tells the other services to drop their cached check results; if that fails, the results expire in time.
+func (list *RevocationList) announce(ctx context.Context, revoked string) {
+	if list.TopicName == "" {
+		return
+	}
+	_ = list.Redis.Publish(ctx, list.TopicName, revoked).Err()
+}
+
+// Message drops the cached check results when a revocation is announced.
+func (list *RevocationList) Message(_ context.Context, _ string, _ string) {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	list.cache = map[string]cachedRevocationCheck{}
+	list.generation++
+}
+
+// IsRevoked checks both the token and its subject against the revocation list.
+func (list *RevocationList) IsRevoked(ctx context.Context, tokenID string, subject string, issuedAt time.Time) (bool, error) {
+	if list.CacheTTL <= 0 {
+		return list.isRevoked(ctx, tokenID, subject, issuedAt)
+	}
+
+	key := fmt.Sprintf("%s\x00%s\x00%d", tokenID, subject, issuedAt.Unix())
+	list.mu.Lock()
+	cached, ok := list.cache[key]
+	generation := list.generation
+	list.mu.Unlock()
+	if ok && time.Now().Before(cached.expiresAt) {
+		return cached.revoked, nil
+	}
+
+	revoked, err := list.isRevoked(ctx, tokenID, subject, issuedAt)
+	if err != nil {
+		return false, err
+	}
+
+	list.mu.Lock()
+	defer list.mu.Unlock()
+	if list.generation != generation {
+		return revoked, nil
+	}
+	if len(list.cache) >= maxCachedRevocationChecks {
+		list.cache = map[string]cachedRevocationCheck{}
+	}
+	list.cache[key] = cachedRevocationCheck{revoked: revoked, expiresAt: time.Now().Add(list.CacheTTL)}
+	return revoked, nil
+}
+
+func (list *RevocationList) isRevoked(ctx context.Context, tokenID string, subject string, issuedAt time.Time) (bool, error) {
+	pipe := list.Redis.Pipeline()
+	cmdExists := pipe.Exists(ctx, redisRevokedTokenKey(tokenID))
+	cmdGet := pipe.Get(ctx, redisRevokedSubjectKey(subject))
+	_, err := pipe.Exec(ctx)
+	if err != nil && err != redis.Nil {
+		return false, err
+	}
+
+	if tokenID != "" && cmdExists.Val() > 0 {
+		return true, nil
+	}
+
+	if cmdGet.Err() == redis.Nil {
+		return false, nil
+	}
+	revokedAt, err := strconv.ParseInt(cmdGet.Val(), 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("malformed subject revocation entry: %w", err)
+	}
+	// JWT timestamps have a resolution of seconds, so tokens issued in the second of the logout are revoked, too.
+	return issuedAt.Unix() <= revokedAt, nil
+}
@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+func TestRevocationList_IsRevoked_cache(t *testing.T) {
+	issuedAt := time.Unix(1700000000, 0)
+
+	tests := []struct {
+		name     string
+		cacheTTL time.Duration
+		cached   *cachedRevocationCheck
+		announce bool
+		want     bool
+		wantErr  bool
+	}{
+		{
+			name:     "Cached result",
+			cacheTTL: time.Minute,
+			cached:   &cachedRevocationCheck{revoked: true, expiresAt: time.Now().Add(time.Minute)},
+			want:     true,
+		},
+		{
+			name:     "Expired cached result is checked again",
+			cacheTTL: time.Minute,
+			cached:   &cachedRevocationCheck{revoked: true, expiresAt: time.Now().Add(-time.Second)},
+			wantErr:  true,
+		},
+		{
+			name:     "Announced revocation drops the cached results",
+			cacheTTL: time.Minute,
+			cached:   &cachedRevocationCheck{revoked: false, expiresAt: time.Now().Add(time.Minute)},
+			announce: true,
+			wantErr:  true,
+		},
+		{
+			name:     "Without cache TTL, every check goes to Redis",
+			cacheTTL: 0,
+			cached:   &cachedRevocationCheck{revoked: false, expiresAt: time.Now().Add(time.Minute)},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Nothing listens there, so every check reaching Redis fails.
+			rdb := redis.NewClient(&redis.Options{Addr: "127.0.0.1:1", MaxRetries: -1, DialTimeout: 100 * time.Millisecond})
+			defer rdb.Close()
+
+			list := NewRevocationList(rdb, "", tt.cacheTTL)
+			list.cache["jti-1\x00user-1\x001700000000"] = *tt.cached
+			if tt.announce {
+				list.Message(context.Background(), "mex/mex-token-revocation", "user-1")
+			}
+
+			got, err := list.IsRevoked(context.Background(), "jti-1", "user-1", issuedAt)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("IsRevoked() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("IsRevoked() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,6 +2,9 @@ package auth
 
 import (
 	"fmt"
+	"sort"
+	"strings"
+	"sync"
 
 	"github.com/d4l-data4life/mex/mex/shared/known/securitypb"
 )
@@ -12,13 +15,18 @@ const (
 )
 
 const (
-	ResourceIndex  = "index"
-	ResourceItems  = "items"
-	ResourceConfig = "config"
-	ResourceJobs   = "jobs"
-	ResourceBlobs  = "blobs"
-	ResourceStatus = "status"
-	ResourceNotify = "notify"
+	ResourceIndex    = "index"
+	ResourceItems    = "items"
+	ResourceConfig   = "config"
+	ResourceJobs     = "jobs"
+	ResourceBlobs    = "blobs"
+	ResourceStatus   = "status"
+	ResourceNotify   = "notify"
+	ResourceSessions = "sessions"
+	ResourceAPIKeys  = "apikeys"
+	ResourceAudit    = "audit"
+
+	ResourceSavedSearches = "savedsearches"
 )
 
 const (
@@ -28,19 +36,28 @@ const (
 	VerbDelete = "delete"
 	VerbQuery  = "query"
 	VerbSend   = "send"
+
+	// Reading all items regardless of the visibility rules
+	VerbReadAll = "readall"
+	// Managing the resources of all users (and not only the own ones)
+	VerbAdmin = "admin"
 )
 
 type PrivMask = uint64
 
 type PrivMgr struct {
+	// Roles and group roles can be replaced at runtime
+	mu         sync.RWMutex
 	roles      map[string]*securitypb.Role
+	groupRoles map[string][]string
 	privileges []*securitypb.Privilege
+
+	// Visibility rules by group ID
+	visibilityRules map[string]*VisibilityRule
 }
 
 func NewPrivMgr() *PrivMgr {
-	mgr := PrivMgr{
-		roles: map[string]*securitypb.Role{},
-	}
+	mgr := PrivMgr{}
 
 	mgr.privileges = []*securitypb.Privilege{
 		{Resource: ResourceItems, Verb: VerbCreate},
@@ -65,6 +82,27 @@ func NewPrivMgr() *PrivMgr {
 
 		{Resource: ResourceStatus, Verb: VerbRead},
 		{Resource: ResourceNotify, Verb: VerbSend},
+
+		// New privileges must be appended, so that the existing ones keep their bit.
+		{Resource: ResourceItems, Verb: VerbUpdate},
+
+		{Resource: ResourceSessions, Verb: VerbRead},
+		{Resource: ResourceSessions, Verb: VerbDelete},
+
+		{Resource: ResourceAPIKeys, Verb: VerbCreate},
+		{Resource: ResourceAPIKeys, Verb: VerbRead},
+		{Resource: ResourceAPIKeys, Verb: VerbUpdate},
+		{Resource: ResourceAPIKeys, Verb: VerbDelete},
+
+		{Resource: ResourceAudit, Verb: VerbRead},
+
+		{Resource: ResourceSavedSearches, Verb: VerbCreate},
+		{Resource: ResourceSavedSearches, Verb: VerbRead},
+		{Resource: ResourceSavedSearches, Verb: VerbUpdate},
+		{Resource: ResourceSavedSearches, Verb: VerbDelete},
+
+		{Resource: ResourceItems, Verb: VerbReadAll},
+		{Resource: ResourceAPIKeys, Verb: VerbAdmin},
 	}
 
 	// Assign each privilege its bit mask based on the bit index.
@@ -72,23 +110,40 @@ func NewPrivMgr() *PrivMgr {
 		priv.Mask = 1 << b
 	}
 
-	mgr.roles[RoleConsumer] = &securitypb.Role{
+	mgr.roles = mgr.builtInRoles()
+	mgr.groupRoles = map[string][]string{}
+
+	return &mgr
+}
+
+// builtInRoles returns the roles which are always available; they can be redefined in the config repository
+func (mgr *PrivMgr) builtInRoles() map[string]*securitypb.Role {
+	roles := map[string]*securitypb.Role{}
+
+	roles[RoleConsumer] = &securitypb.Role{
 		Name:        RoleConsumer,
 		Description: "Read-only consumer role",
 		Mask: 0 |
 			mgr.MustPrivMask(ResourceItems, VerbRead) |
 			mgr.MustPrivMask(ResourceIndex, VerbQuery) |
 			mgr.MustPrivMask(ResourceConfig, VerbRead) |
-			mgr.MustPrivMask(ResourceNotify, VerbSend),
+			mgr.MustPrivMask(ResourceNotify, VerbSend) |
+
+			mgr.MustPrivMask(ResourceSavedSearches, VerbCreate) |
+			mgr.MustPrivMask(ResourceSavedSearches, VerbRead) |
+			mgr.MustPrivMask(ResourceSavedSearches, VerbUpdate) |
+			mgr.MustPrivMask(ResourceSavedSearches, VerbDelete),
 	}
 
-	mgr.roles[RoleProducer] = &securitypb.Role{
+	roles[RoleProducer] = &securitypb.Role{
 		Name:        RoleProducer,
 		Description: "Read-write producer role",
 		Mask: 0 |
 			mgr.MustPrivMask(ResourceItems, VerbCreate) |
 			mgr.MustPrivMask(ResourceItems, VerbRead) |
+			mgr.MustPrivMask(ResourceItems, VerbUpdate) |
 			mgr.MustPrivMask(ResourceItems, VerbDelete) |
+			mgr.MustPrivMask(ResourceItems, VerbReadAll) |
 
 			mgr.MustPrivMask(ResourceIndex, VerbCreate) |
 			mgr.MustPrivMask(ResourceIndex, VerbUpdate) |
@@ -107,10 +162,26 @@ func NewPrivMgr() *PrivMgr {
 			mgr.MustPrivMask(ResourceBlobs, VerbDelete) |
 
 			mgr.MustPrivMask(ResourceStatus, VerbRead) |
-			mgr.MustPrivMask(ResourceNotify, VerbSend),
+			mgr.MustPrivMask(ResourceNotify, VerbSend) |
+
+			mgr.MustPrivMask(ResourceSessions, VerbRead) |
+			mgr.MustPrivMask(ResourceSessions, VerbDelete) |
+
+			mgr.MustPrivMask(ResourceAPIKeys, VerbCreate) |
+			mgr.MustPrivMask(ResourceAPIKeys, VerbRead) |
+			mgr.MustPrivMask(ResourceAPIKeys, VerbUpdate) |
+			mgr.MustPrivMask(ResourceAPIKeys, VerbDelete) |
+			mgr.MustPrivMask(ResourceAPIKeys, VerbAdmin) |
+
+			mgr.MustPrivMask(ResourceAudit, VerbRead) |
+
+			mgr.MustPrivMask(ResourceSavedSearches, VerbCreate) |
+			mgr.MustPrivMask(ResourceSavedSearches, VerbRead) |
+			mgr.MustPrivMask(ResourceSavedSearches, VerbUpdate) |
+			mgr.MustPrivMask(ResourceSavedSearches, VerbDelete),
 	}
 
-	return &mgr
+	return roles
 }
 
 func (mgr *PrivMgr) MustPrivMask(resource string, verb string) uint64 {
@@ -124,6 +195,9 @@ func (mgr *PrivMgr) MustPrivMask(resource string, verb string) uint64 {
 }
 
 func (mgr *PrivMgr) ResolveRoles(roleNames []string) (PrivMask, error) {
+	mgr.mu.RLock()
+	defer mgr.mu.RUnlock()
+
 	var mask uint64
 
 	for _, roleName := range roleNames {
@@ -136,3 +210,137 @@ func (mgr *PrivMgr) ResolveRoles(roleNames []string) (PrivMask, error) {
 
 	return mask, nil
 }
+
+/*
+ResolveClaims derives the claims of an authenticated user: the privileges of the roles determined by the authenticator
+and of the roles mapped to the user's groups, the privileges granted directly (e.g. the scopes of an API key), and the
+visibility rules of the groups.
+*/
+func (mgr *PrivMgr) ResolveClaims(user *securitypb.UserWithRoles) (*Claims, error) {
+	roleNames := append(append([]string{}, user.Roles...), mgr.GroupRoles(user.Groups)...)
+
+	privileges, err := mgr.ResolveRoles(roleNames)
+	if err != nil {
+		return nil, err
+	}
+
+	scopes, err := mgr.ResolvePrivileges(user.Privileges)
+	if err != nil {
+		return nil, err
+	}
+	privileges |= scopes
+
+	visibilityRestricted, visibility := mgr.ResolveVisibility(privileges, user.Groups)
+
+	return &Claims{
+		TenantId:             user.TenantId,
+		AppId:                user.AppId,
+		UserId:               user.UserId,
+		Privileges:           privileges,
+		Groups:               user.Groups,
+		VisibilityRestricted: visibilityRestricted,
+		Visibility:           visibility,
+		Roles:                roleNames,
+	}, nil
+}
+
+// ResolvePrivileges returns the combined mask of privileges given as <resource>/<verb>
+func (mgr *PrivMgr) ResolvePrivileges(privileges []string) (PrivMask, error) {
+	var mask PrivMask
+	for _, privilege := range privileges {
+		privMask, err := mgr.privMask(privilege)
+		if err != nil {
+			return 0, err
+		}
+		mask |= privMask
+	}
+	return mask, nil
+}
+
+// privMask returns the mask of a privilege given as <resource>/<verb>
+func (mgr *PrivMgr) privMask(privilege string) (PrivMask, error) {
+	resource, verb, ok := strings.Cut(privilege, "/")
+	if !ok {
+		return 0, fmt.Errorf("malformed privilege (expected <resource>/<verb>): %s", privilege)
+	}
+
+	for _, priv := range mgr.privileges {
+		if priv.Resource == resource && priv.Verb == verb {
+			return priv.Mask, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown privilege: %s", privilege)
+}
+
+/*
+SetRolesConfig replaces the configured roles and the group-to-role mappings. The built-in roles stay available unless
+they are redefined. The configuration is validated first; if it is invalid, the current roles are kept.
+*/
+func (mgr *PrivMgr) SetRolesConfig(config *RolesConfig) error {
+	roles := mgr.builtInRoles()
+	defined := map[string]bool{}
+
+	for _, roleDef := range config.GetRoles() {
+		if roleDef.Name == "" {
+			return fmt.Errorf("role without name")
+		}
+		if defined[roleDef.Name] {
+			return fmt.Errorf("role defined more than once: %s", roleDef.Name)
+		}
+		defined[roleDef.Name] = true
+
+		var mask PrivMask
+		for _, privilege := range roleDef.Privileges {
+			privMask, err := mgr.privMask(privilege)
+			if err != nil {
+				return fmt.Errorf("role %s: %s", roleDef.Name, err.Error())
+			}
+			mask |= privMask
+		}
+		roles[roleDef.Name] = &securitypb.Role{
+			Name:        roleDef.Name,
+			Description: roleDef.Description,
+			Mask:        mask,
+		}
+	}
+
+	groupRoles := map[string][]string{}
+	for groupID, roleNames := range config.GetGroupRoles() {
+		if groupID == "" {
+			return fmt.Errorf("roles mapped to empty group ID")
+		}
+		for _, roleName := range roleNames.GetNames() {
+			if _, ok := roles[roleName]; !ok {
+				return fmt.Errorf("group %s: role not found: %s", groupID, roleName)
+			}
+		}
+		groupRoles[groupID] = roleNames.GetNames()
+	}
+
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	mgr.roles = roles
+	mgr.groupRoles = groupRoles
+	return nil
+}
+
+// GroupRoles returns the (sorted) names of the roles granted to the members of the given groups
+func (mgr *PrivMgr) GroupRoles(groups []string) []string {
+	mgr.mu.RLock()
+	defer mgr.mu.RUnlock()
+
+	roleNames := map[string]bool{}
+	for _, group := range groups {
+		for _, roleName := range mgr.groupRoles[group] {
+			roleNames[roleName] = true
+		}
+	}
+
+	result := make([]string, 0, len(roleNames))
+	for roleName := range roleNames {
+		result = append(result, roleName)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,99 @@
+package auth
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPrivMgr_SetRolesConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		wantErr bool
+	}{
+		{
+			name:   "Empty config",
+			config: `{}`,
+		},
+		{
+			name:   "Role with privileges and group mapping",
+			config: `{"roles": [{"name": "curator", "privileges": ["items/read", "items/update"]}], "groupRoles": {"g1": {"names": ["curator", "consumer"]}}}`,
+		},
+		{
+			name:    "Unknown privilege",
+			config:  `{"roles": [{"name": "curator", "privileges": ["items/publish"]}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "Malformed privilege",
+			config:  `{"roles": [{"name": "curator", "privileges": ["items"]}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "Duplicate role",
+			config:  `{"roles": [{"name": "curator"}, {"name": "curator"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "Role without name",
+			config:  `{"roles": [{"privileges": ["items/read"]}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "Group mapped to undefined role",
+			config:  `{"groupRoles": {"g1": {"names": ["curator"]}}}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, err := ParseRolesConfig([]byte(tt.config), true)
+			if err != nil {
+				t.Fatalf("ParseRolesConfig() error = %v", err)
+			}
+			if err := NewPrivMgr().SetRolesConfig(config); (err != nil) != tt.wantErr {
+				t.Errorf("SetRolesConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPrivMgr_ConfiguredRoles(t *testing.T) {
+	mgr := NewPrivMgr()
+	config, err := ParseRolesConfig([]byte(`{
+		"roles": [{"name": "curator", "privileges": ["items/read", "items/update"]}],
+		"groupRoles": {"g1": {"names": ["curator"]}, "g2": {"names": ["curator", "consumer"]}}
+	}`), true)
+	if err != nil {
+		t.Fatalf("ParseRolesConfig() error = %v", err)
+	}
+	if err := mgr.SetRolesConfig(config); err != nil {
+		t.Fatalf("SetRolesConfig() error = %v", err)
+	}
+
+	if got, want := mgr.GroupRoles([]string{"g2", "g1", "g3"}), []string{"consumer", "curator"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("GroupRoles() = %v, want %v", got, want)
+	}
+
+	mask, err := mgr.ResolveRoles([]string{"curator"})
+	if err != nil {
+		t.Fatalf("ResolveRoles() error = %v", err)
+	}
+	if want := mgr.MustPrivMask(ResourceItems, VerbRead) | mgr.MustPrivMask(ResourceItems, VerbUpdate); mask != want {
+		t.Errorf("ResolveRoles() = %b, want %b", mask, want)
+	}
+
+	// Built-in roles remain available.
+	if _, err := mgr.ResolveRoles([]string{RoleProducer}); err != nil {
+		t.Errorf("ResolveRoles() error = %v", err)
+	}
+
+	// An invalid config leaves the previous roles in effect.
+	invalid, _ := ParseRolesConfig([]byte(`{"roles": [{"name": "operator", "privileges": ["nope/nope"]}]}`), true)
+	if err := mgr.SetRolesConfig(invalid); err == nil {
+		t.Fatalf("SetRolesConfig() expected error")
+	}
+	if _, err := mgr.ResolveRoles([]string{"curator"}); err != nil {
+		t.Errorf("ResolveRoles() after invalid config: error = %v", err)
+	}
+}
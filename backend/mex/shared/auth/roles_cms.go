@@ -0,0 +1,98 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/d4l-data4life/mex/mex/shared/constants"
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+)
+
+const (
+	// Config service path of the roles file (roles/index.json in the config repository)
+	rolesConfigPath = "/api/v0/config/files/roles"
+
+	// The roles are (re)loaded while handling config updates, so a hanging config service must not block for long.
+	rolesConfigTimeout = 5 * time.Second
+)
+
+/*
+RolesLoader keeps the roles of a privilege manager in sync with the roles file of the config repository. The roles are
+loaded on startup and reloaded whenever a config update is announced. An invalid roles file is rejected and the
+previous roles stay in effect; a missing roles file leaves only the built-in roles.
+*/
+type RolesLoader struct {
+	Log     L.Logger
+	PrivMgr *PrivMgr
+
+	OriginCMS           string
+	StrictConfigParsing bool
+}
+
+// Load fetches, validates and applies the roles file
+func (loader *RolesLoader) Load(ctx context.Context) error {
+	config, err := loader.fetchRolesConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = loader.PrivMgr.SetRolesConfig(config)
+	if err != nil {
+		return fmt.Errorf("invalid roles config: %s", err.Error())
+	}
+	loader.Log.Info(ctx, L.Messagef("loaded roles config: %d role(s), %d group mapping(s)", len(config.Roles), len(config.GroupRoles)))
+	return nil
+}
+
+// Message reloads the roles on config updates.
+func (loader *RolesLoader) Message(ctx context.Context, topic string, _ string) {
+	if !strings.HasSuffix(topic, constants.ConfigUpdateChannelNameSuffix) {
+		return
+	}
+
+	if err := loader.Load(ctx); err != nil {
+		loader.Log.Error(ctx, L.Messagef("could not reload roles, keeping the previous ones: %s", err.Error()))
+	}
+}
+
+func (loader *RolesLoader) fetchRolesConfig(ctx context.Context) (*RolesConfig, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, loader.OriginCMS+rolesConfigPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	client := &http.Client{Timeout: rolesConfigTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return &RolesConfig{}, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not fetch roles configuration from CMS - got response status code %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRolesConfig(data, loader.StrictConfigParsing)
+}
+
+// ParseRolesConfig reads a roles configuration from its JSON representation.
+func ParseRolesConfig(data []byte, strictConfigParsing bool) (*RolesConfig, error) {
+	var config RolesConfig
+	err := protojson.UnmarshalOptions{DiscardUnknown: !strictConfigParsing}.Unmarshal(data, &config)
+	if err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/d4l-data4life/mex/mex/shared/constants"
+	"github.com/d4l-data4life/mex/mex/shared/known/securitypb"
 )
 
 func GetMexUser(ctx context.Context) (*Claims, error) {
@@ -25,6 +26,15 @@ func GetMexUser(ctx context.Context) (*Claims, error) {
 	return claims, nil
 }
 
+// GetAuthenticatedUser returns the user as determined by the authenticator, from which the claims were derived.
+func GetAuthenticatedUser(ctx context.Context) (*securitypb.UserWithRoles, error) {
+	user, ok := ctx.Value(constants.ContextKeyUser).(*securitypb.UserWithRoles)
+	if !ok {
+		return nil, status.New(codes.Unauthenticated, "no user in request").Err()
+	}
+	return user, nil
+}
+
 func GetUserID(ctx context.Context) string {
 	if ctx == nil {
 		return ""
@@ -0,0 +1,50 @@
+package auth
+
+import (
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// ParseVisibilityRules reads the visibility rules from their JSON representation; no data means no rules.
+func ParseVisibilityRules(data []byte) (*VisibilityRules, error) {
+	if len(data) == 0 {
+		return &VisibilityRules{}, nil
+	}
+
+	var rules VisibilityRules
+	err := protojson.Unmarshal(data, &rules)
+	if err != nil {
+		return nil, err
+	}
+	return &rules, nil
+}
+
+// SetVisibilityRules sets the rules restricting which items the members of certain groups can see.
+func (mgr *PrivMgr) SetVisibilityRules(rules *VisibilityRules) {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	mgr.visibilityRules = rules.GetGroupRules()
+}
+
+/*
+ResolveVisibility determines the items a user with the given privileges can see. Users with the items/readall privilege
+(such as producers) and users without any group with a visibility rule are not restricted. Otherwise, the user sees
+the items matching any of the rules of their groups.
+*/
+func (mgr *PrivMgr) ResolveVisibility(privileges PrivMask, groups []string) (bool, []*VisibilityRule) {
+	readAll := mgr.MustPrivMask(ResourceItems, VerbReadAll)
+	if privileges&readAll == readAll {
+		return false, nil
+	}
+
+	mgr.mu.RLock()
+	defer mgr.mu.RUnlock()
+
+	var rules []*VisibilityRule
+	for _, group := range groups {
+		if rule, ok := mgr.visibilityRules[group]; ok {
+			rules = append(rules, rule)
+		}
+	}
+	return len(rules) > 0, rules
+}
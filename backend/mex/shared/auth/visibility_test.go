@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"testing"
+)
+
+func TestPrivMgr_ResolveVisibility(t *testing.T) {
+	mgr := NewPrivMgr()
+	mgr.SetVisibilityRules(&VisibilityRules{GroupRules: map[string]*VisibilityRule{
+		"g1": {EntityTypes: []string{"Resource"}},
+		"g2": {HierarchyAxis: "unit", Subtrees: []string{"u1"}},
+	}})
+
+	consumer, _ := mgr.ResolveRoles([]string{RoleConsumer})
+	producer, _ := mgr.ResolveRoles([]string{RoleProducer})
+
+	tests := []struct {
+		name           string
+		privileges     PrivMask
+		groups         []string
+		wantRestricted bool
+		wantRules      int
+	}{
+		{
+			name:       "User without a group with rules is not restricted",
+			privileges: consumer,
+			groups:     []string{"g3"},
+		},
+		{
+			name:           "Rules of all groups apply",
+			privileges:     consumer,
+			groups:         []string{"g1", "g2", "g3"},
+			wantRestricted: true,
+			wantRules:      2,
+		},
+		{
+			name:       "Producers are not restricted",
+			privileges: producer,
+			groups:     []string{"g1"},
+		},
+		{
+			name:       "The readall privilege lifts the restriction of any role",
+			privileges: consumer | mgr.MustPrivMask(ResourceItems, VerbReadAll),
+			groups:     []string{"g1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			restricted, rules := mgr.ResolveVisibility(tt.privileges, tt.groups)
+			if restricted != tt.wantRestricted || len(rules) != tt.wantRules {
+				t.Errorf("ResolveVisibility() = %v, %d rules, want %v, %d rules", restricted, len(rules), tt.wantRestricted, tt.wantRules)
+			}
+		})
+	}
+}
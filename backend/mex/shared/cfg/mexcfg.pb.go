@@ -121,6 +121,58 @@ func (RepoType) EnumDescriptor() ([]byte, []int) {
 	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{1}
 }
 
+type ConfigSourceType int32
+
+const (
+	ConfigSourceType_GITHUB ConfigSourceType = 0
+	ConfigSourceType_LOCAL  ConfigSourceType = 1
+	ConfigSourceType_HTTP   ConfigSourceType = 2
+	ConfigSourceType_OCI    ConfigSourceType = 3
+)
+
+// Enum value maps for ConfigSourceType.
+var (
+	ConfigSourceType_name = map[int32]string{
+		0: "GITHUB",
+		1: "LOCAL",
+		2: "HTTP",
+		3: "OCI",
+	}
+	ConfigSourceType_value = map[string]int32{
+		"GITHUB": 0,
+		"LOCAL":  1,
+		"HTTP":   2,
+		"OCI":    3,
+	}
+)
+
+func (x ConfigSourceType) Enum() *ConfigSourceType {
+	p := new(ConfigSourceType)
+	*p = x
+	return p
+}
+
+func (x ConfigSourceType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConfigSourceType) Descriptor() protoreflect.EnumDescriptor {
+	return file_shared_cfg_mexcfg_proto_enumTypes[2].Descriptor()
+}
+
+func (ConfigSourceType) Type() protoreflect.EnumType {
+	return &file_shared_cfg_mexcfg_proto_enumTypes[2]
+}
+
+func (x ConfigSourceType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConfigSourceType.Descriptor instead.
+func (ConfigSourceType) EnumDescriptor() ([]byte, []int) {
+	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{2}
+}
+
 type EmailerType int32
 
 const (
@@ -151,11 +203,11 @@ func (x EmailerType) String() string {
 }
 
 func (EmailerType) Descriptor() protoreflect.EnumDescriptor {
-	return file_shared_cfg_mexcfg_proto_enumTypes[2].Descriptor()
+	return file_shared_cfg_mexcfg_proto_enumTypes[3].Descriptor()
 }
 
 func (EmailerType) Type() protoreflect.EnumType {
-	return &file_shared_cfg_mexcfg_proto_enumTypes[2]
+	return &file_shared_cfg_mexcfg_proto_enumTypes[3]
 }
 
 func (x EmailerType) Number() protoreflect.EnumNumber {
@@ -164,7 +216,7 @@ func (x EmailerType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EmailerType.Descriptor instead.
 func (EmailerType) EnumDescriptor() ([]byte, []int) {
-	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{2}
+	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{3}
 }
 
 // Main configuration message for MEx.
@@ -173,27 +225,31 @@ type MexConfig struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Version      *Version                `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
-	TenantId     string                  `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	Web          *MexConfig_Web          `protobuf:"bytes,10,opt,name=web,proto3" json:"web,omitempty"`
-	Db           *MexConfig_Postgres     `protobuf:"bytes,20,opt,name=db,proto3" json:"db,omitempty"`
-	Solr         *MexConfig_Solr         `protobuf:"bytes,30,opt,name=solr,proto3" json:"solr,omitempty"`
-	Redis        *MexConfig_Redis        `protobuf:"bytes,40,opt,name=redis,proto3" json:"redis,omitempty"`
-	Oauth        *MexConfig_OAuth        `protobuf:"bytes,50,opt,name=oauth,proto3" json:"oauth,omitempty"`
-	Codings      *MexConfig_Codings      `protobuf:"bytes,60,opt,name=codings,proto3" json:"codings,omitempty"`
-	EntityTypes  *MexConfig_EntityTypes  `protobuf:"bytes,70,opt,name=entity_types,json=entityTypes,proto3" json:"entity_types,omitempty"`
-	FieldDefs    *MexConfig_FieldDefs    `protobuf:"bytes,80,opt,name=field_defs,json=fieldDefs,proto3" json:"field_defs,omitempty"`
-	SearchConfig *MexConfig_SearchConfig `protobuf:"bytes,90,opt,name=search_config,json=searchConfig,proto3" json:"search_config,omitempty"`
-	Jwks         *MexConfig_Jwks         `protobuf:"bytes,100,opt,name=jwks,proto3" json:"jwks,omitempty"`
-	Jobs         *MexConfig_Jobs         `protobuf:"bytes,110,opt,name=jobs,proto3" json:"jobs,omitempty"`
-	AutoIndexer  *MexConfig_AutoIndexer  `protobuf:"bytes,120,opt,name=auto_indexer,json=autoIndexer,proto3" json:"auto_indexer,omitempty"`
-	Indexing     *MexConfig_Indexing     `protobuf:"bytes,130,opt,name=indexing,proto3" json:"indexing,omitempty"`
-	Logging      *MexConfig_Logging      `protobuf:"bytes,140,opt,name=logging,proto3" json:"logging,omitempty"`
-	Telemetry    *MexConfig_Telemetry    `protobuf:"bytes,150,opt,name=telemetry,proto3" json:"telemetry,omitempty"`
-	Auth         *MexConfig_Auth         `protobuf:"bytes,160,opt,name=auth,proto3" json:"auth,omitempty"`
-	Services     *MexConfig_Services     `protobuf:"bytes,170,opt,name=services,proto3" json:"services,omitempty"`
-	Strictness   *MexConfig_Strictness   `protobuf:"bytes,180,opt,name=strictness,proto3" json:"strictness,omitempty"`
-	Notify       *MexConfig_Notify       `protobuf:"bytes,190,opt,name=notify,proto3" json:"notify,omitempty"`
+	Version       *Version                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	TenantId      string                   `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Web           *MexConfig_Web           `protobuf:"bytes,10,opt,name=web,proto3" json:"web,omitempty"`
+	Db            *MexConfig_Postgres      `protobuf:"bytes,20,opt,name=db,proto3" json:"db,omitempty"`
+	Solr          *MexConfig_Solr          `protobuf:"bytes,30,opt,name=solr,proto3" json:"solr,omitempty"`
+	Redis         *MexConfig_Redis         `protobuf:"bytes,40,opt,name=redis,proto3" json:"redis,omitempty"`
+	Oauth         *MexConfig_OAuth         `protobuf:"bytes,50,opt,name=oauth,proto3" json:"oauth,omitempty"`
+	Codings       *MexConfig_Codings       `protobuf:"bytes,60,opt,name=codings,proto3" json:"codings,omitempty"`
+	EntityTypes   *MexConfig_EntityTypes   `protobuf:"bytes,70,opt,name=entity_types,json=entityTypes,proto3" json:"entity_types,omitempty"`
+	FieldDefs     *MexConfig_FieldDefs     `protobuf:"bytes,80,opt,name=field_defs,json=fieldDefs,proto3" json:"field_defs,omitempty"`
+	SearchConfig  *MexConfig_SearchConfig  `protobuf:"bytes,90,opt,name=search_config,json=searchConfig,proto3" json:"search_config,omitempty"`
+	Jwks          *MexConfig_Jwks          `protobuf:"bytes,100,opt,name=jwks,proto3" json:"jwks,omitempty"`
+	Jobs          *MexConfig_Jobs          `protobuf:"bytes,110,opt,name=jobs,proto3" json:"jobs,omitempty"`
+	AutoIndexer   *MexConfig_AutoIndexer   `protobuf:"bytes,120,opt,name=auto_indexer,json=autoIndexer,proto3" json:"auto_indexer,omitempty"`
+	Indexing      *MexConfig_Indexing      `protobuf:"bytes,130,opt,name=indexing,proto3" json:"indexing,omitempty"`
+	Logging       *MexConfig_Logging       `protobuf:"bytes,140,opt,name=logging,proto3" json:"logging,omitempty"`
+	Telemetry     *MexConfig_Telemetry     `protobuf:"bytes,150,opt,name=telemetry,proto3" json:"telemetry,omitempty"`
+	Auth          *MexConfig_Auth          `protobuf:"bytes,160,opt,name=auth,proto3" json:"auth,omitempty"`
+	Services      *MexConfig_Services      `protobuf:"bytes,170,opt,name=services,proto3" json:"services,omitempty"`
+	Strictness    *MexConfig_Strictness    `protobuf:"bytes,180,opt,name=strictness,proto3" json:"strictness,omitempty"`
+	Notify        *MexConfig_Notify        `protobuf:"bytes,190,opt,name=notify,proto3" json:"notify,omitempty"`
+	Oai           *MexConfig_Oai           `protobuf:"bytes,200,opt,name=oai,proto3" json:"oai,omitempty"`
+	AccessControl *MexConfig_AccessControl `protobuf:"bytes,210,opt,name=access_control,json=accessControl,proto3" json:"access_control,omitempty"`
+	SavedSearches *MexConfig_SavedSearches `protobuf:"bytes,220,opt,name=saved_searches,json=savedSearches,proto3" json:"saved_searches,omitempty"`
+	Ingestion     *MexConfig_Ingestion     `protobuf:"bytes,230,opt,name=ingestion,proto3" json:"ingestion,omitempty"`
 }
 
 func (x *MexConfig) Reset() {
@@ -375,6 +431,34 @@ func (x *MexConfig) GetNotify() *MexConfig_Notify {
 	return nil
 }
 
+func (x *MexConfig) GetOai() *MexConfig_Oai {
+	if x != nil {
+		return x.Oai
+	}
+	return nil
+}
+
+func (x *MexConfig) GetAccessControl() *MexConfig_AccessControl {
+	if x != nil {
+		return x.AccessControl
+	}
+	return nil
+}
+
+func (x *MexConfig) GetSavedSearches() *MexConfig_SavedSearches {
+	if x != nil {
+		return x.SavedSearches
+	}
+	return nil
+}
+
+func (x *MexConfig) GetIngestion() *MexConfig_Ingestion {
+	if x != nil {
+		return x.Ingestion
+	}
+	return nil
+}
+
 type Version struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -690,16 +774,18 @@ type MexConfig_Solr struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Origin             string               `protobuf:"bytes,1,opt,name=origin,proto3" json:"origin,omitempty"`
-	Collection         string               `protobuf:"bytes,2,opt,name=collection,proto3" json:"collection,omitempty"`
-	ConfigsetName      string               `protobuf:"bytes,3,opt,name=configset_name,json=configsetName,proto3" json:"configset_name,omitempty"`
-	ConnectionAttempts uint32               `protobuf:"varint,4,opt,name=connection_attempts,json=connectionAttempts,proto3" json:"connection_attempts,omitempty"`
-	ConnectionPause    *durationpb.Duration `protobuf:"bytes,5,opt,name=connection_pause,json=connectionPause,proto3" json:"connection_pause,omitempty"`
-	BasicauthUser      string               `protobuf:"bytes,6,opt,name=basicauth_user,json=basicauthUser,proto3" json:"basicauth_user,omitempty"`
-	BasicauthPassword  string               `protobuf:"bytes,7,opt,name=basicauth_password,json=basicauthPassword,proto3" json:"basicauth_password,omitempty"`
-	IndexBatchSize     uint32               `protobuf:"varint,8,opt,name=index_batch_size,json=indexBatchSize,proto3" json:"index_batch_size,omitempty"`
-	CommitWithin       *durationpb.Duration `protobuf:"bytes,9,opt,name=commit_within,json=commitWithin,proto3" json:"commit_within,omitempty"`
-	ReplicationFactor  uint32               `protobuf:"varint,10,opt,name=replication_factor,json=replicationFactor,proto3" json:"replication_factor,omitempty"`
+	Origin              string               `protobuf:"bytes,1,opt,name=origin,proto3" json:"origin,omitempty"`
+	Collection          string               `protobuf:"bytes,2,opt,name=collection,proto3" json:"collection,omitempty"`
+	ConfigsetName       string               `protobuf:"bytes,3,opt,name=configset_name,json=configsetName,proto3" json:"configset_name,omitempty"`
+	ConnectionAttempts  uint32               `protobuf:"varint,4,opt,name=connection_attempts,json=connectionAttempts,proto3" json:"connection_attempts,omitempty"`
+	ConnectionPause     *durationpb.Duration `protobuf:"bytes,5,opt,name=connection_pause,json=connectionPause,proto3" json:"connection_pause,omitempty"`
+	BasicauthUser       string               `protobuf:"bytes,6,opt,name=basicauth_user,json=basicauthUser,proto3" json:"basicauth_user,omitempty"`
+	BasicauthPassword   string               `protobuf:"bytes,7,opt,name=basicauth_password,json=basicauthPassword,proto3" json:"basicauth_password,omitempty"`
+	IndexBatchSize      uint32               `protobuf:"varint,8,opt,name=index_batch_size,json=indexBatchSize,proto3" json:"index_batch_size,omitempty"`
+	CommitWithin        *durationpb.Duration `protobuf:"bytes,9,opt,name=commit_within,json=commitWithin,proto3" json:"commit_within,omitempty"`
+	ReplicationFactor   uint32               `protobuf:"varint,10,opt,name=replication_factor,json=replicationFactor,proto3" json:"replication_factor,omitempty"`
+	BlueGreen           bool                 `protobuf:"varint,11,opt,name=blue_green,json=blueGreen,proto3" json:"blue_green,omitempty"`
+	RetainedCollections uint32               `protobuf:"varint,12,opt,name=retained_collections,json=retainedCollections,proto3" json:"retained_collections,omitempty"`
 }
 
 func (x *MexConfig_Solr) Reset() {
@@ -804,6 +890,20 @@ func (x *MexConfig_Solr) GetReplicationFactor() uint32 {
 	return 0
 }
 
+func (x *MexConfig_Solr) GetBlueGreen() bool {
+	if x != nil {
+		return x.BlueGreen
+	}
+	return false
+}
+
+func (x *MexConfig_Solr) GetRetainedCollections() uint32 {
+	if x != nil {
+		return x.RetainedCollections
+	}
+	return 0
+}
+
 type MexConfig_Redis struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -925,6 +1025,7 @@ type MexConfig_OAuth struct {
 	ConsumerGroupId             string                  `protobuf:"bytes,3,opt,name=consumer_group_id,json=consumerGroupId,proto3" json:"consumer_group_id,omitempty"`
 	InternalAuthServiceHostname string                  `protobuf:"bytes,4,opt,name=internal_auth_service_hostname,json=internalAuthServiceHostname,proto3" json:"internal_auth_service_hostname,omitempty"`
 	Server                      *MexConfig_OAuth_Server `protobuf:"bytes,5,opt,name=server,proto3" json:"server,omitempty"`
+	RevocationCacheTtl          *durationpb.Duration    `protobuf:"bytes,6,opt,name=revocation_cache_ttl,json=revocationCacheTtl,proto3" json:"revocation_cache_ttl,omitempty"`
 }
 
 func (x *MexConfig_OAuth) Reset() {
@@ -994,6 +1095,13 @@ func (x *MexConfig_OAuth) GetServer() *MexConfig_OAuth_Server {
 	return nil
 }
 
+func (x *MexConfig_OAuth) GetRevocationCacheTtl() *durationpb.Duration {
+	if x != nil {
+		return x.RevocationCacheTtl
+	}
+	return nil
+}
+
 type MexConfig_Codings struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1297,7 +1405,13 @@ type MexConfig_AutoIndexer struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SetExpiration *durationpb.Duration `protobuf:"bytes,1,opt,name=set_expiration,json=setExpiration,proto3" json:"set_expiration,omitempty"`
+	SetExpiration         *durationpb.Duration `protobuf:"bytes,1,opt,name=set_expiration,json=setExpiration,proto3" json:"set_expiration,omitempty"`
+	OutboxPollInterval    *durationpb.Duration `protobuf:"bytes,2,opt,name=outbox_poll_interval,json=outboxPollInterval,proto3" json:"outbox_poll_interval,omitempty"`
+	OutboxBatchSize       uint32               `protobuf:"varint,3,opt,name=outbox_batch_size,json=outboxBatchSize,proto3" json:"outbox_batch_size,omitempty"`
+	OutboxMaxAttempts     uint32               `protobuf:"varint,4,opt,name=outbox_max_attempts,json=outboxMaxAttempts,proto3" json:"outbox_max_attempts,omitempty"`
+	OutboxRetryBackoff    *durationpb.Duration `protobuf:"bytes,5,opt,name=outbox_retry_backoff,json=outboxRetryBackoff,proto3" json:"outbox_retry_backoff,omitempty"`
+	OutboxMaxRetryBackoff *durationpb.Duration `protobuf:"bytes,6,opt,name=outbox_max_retry_backoff,json=outboxMaxRetryBackoff,proto3" json:"outbox_max_retry_backoff,omitempty"`
+	OutboxLease           *durationpb.Duration `protobuf:"bytes,7,opt,name=outbox_lease,json=outboxLease,proto3" json:"outbox_lease,omitempty"`
 }
 
 func (x *MexConfig_AutoIndexer) Reset() {
@@ -1339,6 +1453,48 @@ func (x *MexConfig_AutoIndexer) GetSetExpiration() *durationpb.Duration {
 	return nil
 }
 
+func (x *MexConfig_AutoIndexer) GetOutboxPollInterval() *durationpb.Duration {
+	if x != nil {
+		return x.OutboxPollInterval
+	}
+	return nil
+}
+
+func (x *MexConfig_AutoIndexer) GetOutboxBatchSize() uint32 {
+	if x != nil {
+		return x.OutboxBatchSize
+	}
+	return 0
+}
+
+func (x *MexConfig_AutoIndexer) GetOutboxMaxAttempts() uint32 {
+	if x != nil {
+		return x.OutboxMaxAttempts
+	}
+	return 0
+}
+
+func (x *MexConfig_AutoIndexer) GetOutboxRetryBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.OutboxRetryBackoff
+	}
+	return nil
+}
+
+func (x *MexConfig_AutoIndexer) GetOutboxMaxRetryBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.OutboxMaxRetryBackoff
+	}
+	return nil
+}
+
+func (x *MexConfig_AutoIndexer) GetOutboxLease() *durationpb.Duration {
+	if x != nil {
+		return x.OutboxLease
+	}
+	return nil
+}
+
 type MexConfig_Indexing struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1386,6 +1542,61 @@ func (x *MexConfig_Indexing) GetDuplicationDetectionAlgorithm() DuplicateDetecti
 	return DuplicateDetectionAlgorithm_SIMPLE
 }
 
+type MexConfig_Ingestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamChunkSize   uint32               `protobuf:"varint,1,opt,name=stream_chunk_size,json=streamChunkSize,proto3" json:"stream_chunk_size,omitempty"`
+	StreamLockTimeout *durationpb.Duration `protobuf:"bytes,2,opt,name=stream_lock_timeout,json=streamLockTimeout,proto3" json:"stream_lock_timeout,omitempty"`
+}
+
+func (x *MexConfig_Ingestion) Reset() {
+	*x = MexConfig_Ingestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_cfg_mexcfg_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MexConfig_Ingestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MexConfig_Ingestion) ProtoMessage() {}
+
+func (x *MexConfig_Ingestion) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_cfg_mexcfg_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MexConfig_Ingestion.ProtoReflect.Descriptor instead.
+func (*MexConfig_Ingestion) Descriptor() ([]byte, []int) {
+	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{0, 13}
+}
+
+func (x *MexConfig_Ingestion) GetStreamChunkSize() uint32 {
+	if x != nil {
+		return x.StreamChunkSize
+	}
+	return 0
+}
+
+func (x *MexConfig_Ingestion) GetStreamLockTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.StreamLockTimeout
+	}
+	return nil
+}
+
 type MexConfig_Logging struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1401,7 +1612,7 @@ type MexConfig_Logging struct {
 func (x *MexConfig_Logging) Reset() {
 	*x = MexConfig_Logging{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shared_cfg_mexcfg_proto_msgTypes[15]
+		mi := &file_shared_cfg_mexcfg_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1414,7 +1625,7 @@ func (x *MexConfig_Logging) String() string {
 func (*MexConfig_Logging) ProtoMessage() {}
 
 func (x *MexConfig_Logging) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_cfg_mexcfg_proto_msgTypes[15]
+	mi := &file_shared_cfg_mexcfg_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1427,7 +1638,7 @@ func (x *MexConfig_Logging) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MexConfig_Logging.ProtoReflect.Descriptor instead.
 func (*MexConfig_Logging) Descriptor() ([]byte, []int) {
-	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{0, 13}
+	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{0, 14}
 }
 
 func (x *MexConfig_Logging) GetLogLevelGrpc() string {
@@ -1477,7 +1688,7 @@ type MexConfig_Telemetry struct {
 func (x *MexConfig_Telemetry) Reset() {
 	*x = MexConfig_Telemetry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shared_cfg_mexcfg_proto_msgTypes[16]
+		mi := &file_shared_cfg_mexcfg_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1490,7 +1701,7 @@ func (x *MexConfig_Telemetry) String() string {
 func (*MexConfig_Telemetry) ProtoMessage() {}
 
 func (x *MexConfig_Telemetry) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_cfg_mexcfg_proto_msgTypes[16]
+	mi := &file_shared_cfg_mexcfg_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1503,7 +1714,7 @@ func (x *MexConfig_Telemetry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MexConfig_Telemetry.ProtoReflect.Descriptor instead.
 func (*MexConfig_Telemetry) Descriptor() ([]byte, []int) {
-	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{0, 14}
+	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{0, 15}
 }
 
 func (x *MexConfig_Telemetry) GetPingerUpdateInterval() *durationpb.Duration {
@@ -1531,7 +1742,7 @@ type MexConfig_Auth struct {
 func (x *MexConfig_Auth) Reset() {
 	*x = MexConfig_Auth{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shared_cfg_mexcfg_proto_msgTypes[17]
+		mi := &file_shared_cfg_mexcfg_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1544,7 +1755,7 @@ func (x *MexConfig_Auth) String() string {
 func (*MexConfig_Auth) ProtoMessage() {}
 
 func (x *MexConfig_Auth) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_cfg_mexcfg_proto_msgTypes[17]
+	mi := &file_shared_cfg_mexcfg_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1557,7 +1768,7 @@ func (x *MexConfig_Auth) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MexConfig_Auth.ProtoReflect.Descriptor instead.
 func (*MexConfig_Auth) Descriptor() ([]byte, []int) {
-	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{0, 15}
+	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{0, 16}
 }
 
 func (x *MexConfig_Auth) GetApiKeysRoles() []byte {
@@ -1579,7 +1790,7 @@ type MexConfig_Strictness struct {
 func (x *MexConfig_Strictness) Reset() {
 	*x = MexConfig_Strictness{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shared_cfg_mexcfg_proto_msgTypes[18]
+		mi := &file_shared_cfg_mexcfg_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1592,7 +1803,7 @@ func (x *MexConfig_Strictness) String() string {
 func (*MexConfig_Strictness) ProtoMessage() {}
 
 func (x *MexConfig_Strictness) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_cfg_mexcfg_proto_msgTypes[18]
+	mi := &file_shared_cfg_mexcfg_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1605,7 +1816,7 @@ func (x *MexConfig_Strictness) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MexConfig_Strictness.ProtoReflect.Descriptor instead.
 func (*MexConfig_Strictness) Descriptor() ([]byte, []int) {
-	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{0, 16}
+	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{0, 17}
 }
 
 func (x *MexConfig_Strictness) GetSearch() *MexConfig_Strictness_Search {
@@ -1634,7 +1845,7 @@ type MexConfig_Notify struct {
 func (x *MexConfig_Notify) Reset() {
 	*x = MexConfig_Notify{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shared_cfg_mexcfg_proto_msgTypes[19]
+		mi := &file_shared_cfg_mexcfg_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1647,7 +1858,7 @@ func (x *MexConfig_Notify) String() string {
 func (*MexConfig_Notify) ProtoMessage() {}
 
 func (x *MexConfig_Notify) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_cfg_mexcfg_proto_msgTypes[19]
+	mi := &file_shared_cfg_mexcfg_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1660,7 +1871,7 @@ func (x *MexConfig_Notify) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MexConfig_Notify.ProtoReflect.Descriptor instead.
 func (*MexConfig_Notify) Descriptor() ([]byte, []int) {
-	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{0, 17}
+	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{0, 18}
 }
 
 func (x *MexConfig_Notify) GetEmailerType() EmailerType {
@@ -1677,33 +1888,37 @@ func (x *MexConfig_Notify) GetFlowmailer() *MexConfig_Notify_Flowmailer {
 	return nil
 }
 
-type MexConfig_Services struct {
+type MexConfig_Oai struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BiEventsFilter *MexConfig_Services_BIEventsFilter `protobuf:"bytes,1,opt,name=bi_events_filter,json=biEventsFilter,proto3" json:"bi_events_filter,omitempty"`
-	Blobs          *MexConfig_Services_Blobs          `protobuf:"bytes,2,opt,name=blobs,proto3" json:"blobs,omitempty"`
-	Config         *MexConfig_Services_Config         `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	Enabled              bool     `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	RepositoryName       string   `protobuf:"bytes,2,opt,name=repository_name,json=repositoryName,proto3" json:"repository_name,omitempty"`
+	BaseUrl              string   `protobuf:"bytes,3,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"`
+	RepositoryIdentifier string   `protobuf:"bytes,4,opt,name=repository_identifier,json=repositoryIdentifier,proto3" json:"repository_identifier,omitempty"`
+	AdminEmails          []string `protobuf:"bytes,5,rep,name=admin_emails,json=adminEmails,proto3" json:"admin_emails,omitempty"`
+	PageSize             uint32   `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	HarvesterGroups      []string `protobuf:"bytes,7,rep,name=harvester_groups,json=harvesterGroups,proto3" json:"harvester_groups,omitempty"`
 }
 
-func (x *MexConfig_Services) Reset() {
-	*x = MexConfig_Services{}
+func (x *MexConfig_Oai) Reset() {
+	*x = MexConfig_Oai{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shared_cfg_mexcfg_proto_msgTypes[20]
+		mi := &file_shared_cfg_mexcfg_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MexConfig_Services) String() string {
+func (x *MexConfig_Oai) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MexConfig_Services) ProtoMessage() {}
+func (*MexConfig_Oai) ProtoMessage() {}
 
-func (x *MexConfig_Services) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_cfg_mexcfg_proto_msgTypes[20]
+func (x *MexConfig_Oai) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_cfg_mexcfg_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1714,60 +1929,87 @@ func (x *MexConfig_Services) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MexConfig_Services.ProtoReflect.Descriptor instead.
-func (*MexConfig_Services) Descriptor() ([]byte, []int) {
-	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{0, 18}
+// Deprecated: Use MexConfig_Oai.ProtoReflect.Descriptor instead.
+func (*MexConfig_Oai) Descriptor() ([]byte, []int) {
+	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{0, 19}
 }
 
-func (x *MexConfig_Services) GetBiEventsFilter() *MexConfig_Services_BIEventsFilter {
+func (x *MexConfig_Oai) GetEnabled() bool {
 	if x != nil {
-		return x.BiEventsFilter
+		return x.Enabled
 	}
-	return nil
+	return false
 }
 
-func (x *MexConfig_Services) GetBlobs() *MexConfig_Services_Blobs {
+func (x *MexConfig_Oai) GetRepositoryName() string {
 	if x != nil {
-		return x.Blobs
+		return x.RepositoryName
+	}
+	return ""
+}
+
+func (x *MexConfig_Oai) GetBaseUrl() string {
+	if x != nil {
+		return x.BaseUrl
+	}
+	return ""
+}
+
+func (x *MexConfig_Oai) GetRepositoryIdentifier() string {
+	if x != nil {
+		return x.RepositoryIdentifier
+	}
+	return ""
+}
+
+func (x *MexConfig_Oai) GetAdminEmails() []string {
+	if x != nil {
+		return x.AdminEmails
 	}
 	return nil
 }
 
-func (x *MexConfig_Services) GetConfig() *MexConfig_Services_Config {
+func (x *MexConfig_Oai) GetPageSize() uint32 {
 	if x != nil {
-		return x.Config
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *MexConfig_Oai) GetHarvesterGroups() []string {
+	if x != nil {
+		return x.HarvesterGroups
 	}
 	return nil
 }
 
-type MexConfig_Web_CACerts struct {
+type MexConfig_AccessControl struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AdditionalCaCertsFiles []string             `protobuf:"bytes,10,rep,name=additional_ca_certs_files,json=additionalCaCertsFiles,proto3" json:"additional_ca_certs_files,omitempty"`
-	AdditionalCaCertsPem   []byte               `protobuf:"bytes,11,opt,name=additional_ca_certs_pem,json=additionalCaCertsPem,proto3" json:"additional_ca_certs_pem,omitempty"`
-	AccessAttempts         uint32               `protobuf:"varint,2,opt,name=access_attempts,json=accessAttempts,proto3" json:"access_attempts,omitempty"`
-	AccessPause            *durationpb.Duration `protobuf:"bytes,3,opt,name=access_pause,json=accessPause,proto3" json:"access_pause,omitempty"`
+	OwnerScopedWrites bool     `protobuf:"varint,1,opt,name=owner_scoped_writes,json=ownerScopedWrites,proto3" json:"owner_scoped_writes,omitempty"`
+	OwnerGroups       []string `protobuf:"bytes,2,rep,name=owner_groups,json=ownerGroups,proto3" json:"owner_groups,omitempty"`
+	VisibilityRules   []byte   `protobuf:"bytes,3,opt,name=visibility_rules,json=visibilityRules,proto3" json:"visibility_rules,omitempty"`
 }
 
-func (x *MexConfig_Web_CACerts) Reset() {
-	*x = MexConfig_Web_CACerts{}
+func (x *MexConfig_AccessControl) Reset() {
+	*x = MexConfig_AccessControl{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shared_cfg_mexcfg_proto_msgTypes[21]
+		mi := &file_shared_cfg_mexcfg_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MexConfig_Web_CACerts) String() string {
+func (x *MexConfig_AccessControl) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MexConfig_Web_CACerts) ProtoMessage() {}
+func (*MexConfig_AccessControl) ProtoMessage() {}
 
-func (x *MexConfig_Web_CACerts) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_cfg_mexcfg_proto_msgTypes[21]
+func (x *MexConfig_AccessControl) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_cfg_mexcfg_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1778,65 +2020,60 @@ func (x *MexConfig_Web_CACerts) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MexConfig_Web_CACerts.ProtoReflect.Descriptor instead.
-func (*MexConfig_Web_CACerts) Descriptor() ([]byte, []int) {
-	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{0, 0, 0}
+// Deprecated: Use MexConfig_AccessControl.ProtoReflect.Descriptor instead.
+func (*MexConfig_AccessControl) Descriptor() ([]byte, []int) {
+	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{0, 20}
 }
 
-func (x *MexConfig_Web_CACerts) GetAdditionalCaCertsFiles() []string {
+func (x *MexConfig_AccessControl) GetOwnerScopedWrites() bool {
 	if x != nil {
-		return x.AdditionalCaCertsFiles
+		return x.OwnerScopedWrites
 	}
-	return nil
+	return false
 }
 
-func (x *MexConfig_Web_CACerts) GetAdditionalCaCertsPem() []byte {
+func (x *MexConfig_AccessControl) GetOwnerGroups() []string {
 	if x != nil {
-		return x.AdditionalCaCertsPem
+		return x.OwnerGroups
 	}
 	return nil
 }
 
-func (x *MexConfig_Web_CACerts) GetAccessAttempts() uint32 {
-	if x != nil {
-		return x.AccessAttempts
-	}
-	return 0
-}
-
-func (x *MexConfig_Web_CACerts) GetAccessPause() *durationpb.Duration {
+func (x *MexConfig_AccessControl) GetVisibilityRules() []byte {
 	if x != nil {
-		return x.AccessPause
+		return x.VisibilityRules
 	}
 	return nil
 }
 
-type MexConfig_Web_IPFilter struct {
+type MexConfig_SavedSearches struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Enabled    bool     `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	AllowedIps []string `protobuf:"bytes,2,rep,name=allowed_ips,json=allowedIps,proto3" json:"allowed_ips,omitempty"`
+	NotificationsEnabled bool                 `protobuf:"varint,1,opt,name=notifications_enabled,json=notificationsEnabled,proto3" json:"notifications_enabled,omitempty"`
+	NotificationTemplate string               `protobuf:"bytes,2,opt,name=notification_template,json=notificationTemplate,proto3" json:"notification_template,omitempty"`
+	MaxSavedSearches     uint32               `protobuf:"varint,3,opt,name=max_saved_searches,json=maxSavedSearches,proto3" json:"max_saved_searches,omitempty"`
+	EvaluationDelay      *durationpb.Duration `protobuf:"bytes,4,opt,name=evaluation_delay,json=evaluationDelay,proto3" json:"evaluation_delay,omitempty"`
 }
 
-func (x *MexConfig_Web_IPFilter) Reset() {
-	*x = MexConfig_Web_IPFilter{}
+func (x *MexConfig_SavedSearches) Reset() {
+	*x = MexConfig_SavedSearches{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shared_cfg_mexcfg_proto_msgTypes[22]
+		mi := &file_shared_cfg_mexcfg_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MexConfig_Web_IPFilter) String() string {
+func (x *MexConfig_SavedSearches) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MexConfig_Web_IPFilter) ProtoMessage() {}
+func (*MexConfig_SavedSearches) ProtoMessage() {}
 
-func (x *MexConfig_Web_IPFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_cfg_mexcfg_proto_msgTypes[22]
+func (x *MexConfig_SavedSearches) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_cfg_mexcfg_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1847,7 +2084,210 @@ func (x *MexConfig_Web_IPFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MexConfig_Web_IPFilter.ProtoReflect.Descriptor instead.
+// Deprecated: Use MexConfig_SavedSearches.ProtoReflect.Descriptor instead.
+func (*MexConfig_SavedSearches) Descriptor() ([]byte, []int) {
+	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{0, 21}
+}
+
+func (x *MexConfig_SavedSearches) GetNotificationsEnabled() bool {
+	if x != nil {
+		return x.NotificationsEnabled
+	}
+	return false
+}
+
+func (x *MexConfig_SavedSearches) GetNotificationTemplate() string {
+	if x != nil {
+		return x.NotificationTemplate
+	}
+	return ""
+}
+
+func (x *MexConfig_SavedSearches) GetMaxSavedSearches() uint32 {
+	if x != nil {
+		return x.MaxSavedSearches
+	}
+	return 0
+}
+
+func (x *MexConfig_SavedSearches) GetEvaluationDelay() *durationpb.Duration {
+	if x != nil {
+		return x.EvaluationDelay
+	}
+	return nil
+}
+
+type MexConfig_Services struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BiEventsFilter *MexConfig_Services_BIEventsFilter `protobuf:"bytes,1,opt,name=bi_events_filter,json=biEventsFilter,proto3" json:"bi_events_filter,omitempty"`
+	Blobs          *MexConfig_Services_Blobs          `protobuf:"bytes,2,opt,name=blobs,proto3" json:"blobs,omitempty"`
+	Config         *MexConfig_Services_Config         `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *MexConfig_Services) Reset() {
+	*x = MexConfig_Services{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_cfg_mexcfg_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MexConfig_Services) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MexConfig_Services) ProtoMessage() {}
+
+func (x *MexConfig_Services) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_cfg_mexcfg_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MexConfig_Services.ProtoReflect.Descriptor instead.
+func (*MexConfig_Services) Descriptor() ([]byte, []int) {
+	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{0, 22}
+}
+
+func (x *MexConfig_Services) GetBiEventsFilter() *MexConfig_Services_BIEventsFilter {
+	if x != nil {
+		return x.BiEventsFilter
+	}
+	return nil
+}
+
+func (x *MexConfig_Services) GetBlobs() *MexConfig_Services_Blobs {
+	if x != nil {
+		return x.Blobs
+	}
+	return nil
+}
+
+func (x *MexConfig_Services) GetConfig() *MexConfig_Services_Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type MexConfig_Web_CACerts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdditionalCaCertsFiles []string             `protobuf:"bytes,10,rep,name=additional_ca_certs_files,json=additionalCaCertsFiles,proto3" json:"additional_ca_certs_files,omitempty"`
+	AdditionalCaCertsPem   []byte               `protobuf:"bytes,11,opt,name=additional_ca_certs_pem,json=additionalCaCertsPem,proto3" json:"additional_ca_certs_pem,omitempty"`
+	AccessAttempts         uint32               `protobuf:"varint,2,opt,name=access_attempts,json=accessAttempts,proto3" json:"access_attempts,omitempty"`
+	AccessPause            *durationpb.Duration `protobuf:"bytes,3,opt,name=access_pause,json=accessPause,proto3" json:"access_pause,omitempty"`
+}
+
+func (x *MexConfig_Web_CACerts) Reset() {
+	*x = MexConfig_Web_CACerts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_cfg_mexcfg_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MexConfig_Web_CACerts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MexConfig_Web_CACerts) ProtoMessage() {}
+
+func (x *MexConfig_Web_CACerts) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_cfg_mexcfg_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MexConfig_Web_CACerts.ProtoReflect.Descriptor instead.
+func (*MexConfig_Web_CACerts) Descriptor() ([]byte, []int) {
+	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+func (x *MexConfig_Web_CACerts) GetAdditionalCaCertsFiles() []string {
+	if x != nil {
+		return x.AdditionalCaCertsFiles
+	}
+	return nil
+}
+
+func (x *MexConfig_Web_CACerts) GetAdditionalCaCertsPem() []byte {
+	if x != nil {
+		return x.AdditionalCaCertsPem
+	}
+	return nil
+}
+
+func (x *MexConfig_Web_CACerts) GetAccessAttempts() uint32 {
+	if x != nil {
+		return x.AccessAttempts
+	}
+	return 0
+}
+
+func (x *MexConfig_Web_CACerts) GetAccessPause() *durationpb.Duration {
+	if x != nil {
+		return x.AccessPause
+	}
+	return nil
+}
+
+type MexConfig_Web_IPFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled    bool     `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	AllowedIps []string `protobuf:"bytes,2,rep,name=allowed_ips,json=allowedIps,proto3" json:"allowed_ips,omitempty"`
+}
+
+func (x *MexConfig_Web_IPFilter) Reset() {
+	*x = MexConfig_Web_IPFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_cfg_mexcfg_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MexConfig_Web_IPFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MexConfig_Web_IPFilter) ProtoMessage() {}
+
+func (x *MexConfig_Web_IPFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_cfg_mexcfg_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MexConfig_Web_IPFilter.ProtoReflect.Descriptor instead.
 func (*MexConfig_Web_IPFilter) Descriptor() ([]byte, []int) {
 	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{0, 0, 1}
 }
@@ -1880,7 +2320,7 @@ type MexConfig_Web_RateLimiting struct {
 func (x *MexConfig_Web_RateLimiting) Reset() {
 	*x = MexConfig_Web_RateLimiting{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shared_cfg_mexcfg_proto_msgTypes[23]
+		mi := &file_shared_cfg_mexcfg_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1893,7 +2333,7 @@ func (x *MexConfig_Web_RateLimiting) String() string {
 func (*MexConfig_Web_RateLimiting) ProtoMessage() {}
 
 func (x *MexConfig_Web_RateLimiting) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_cfg_mexcfg_proto_msgTypes[23]
+	mi := &file_shared_cfg_mexcfg_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1942,24 +2382,25 @@ type MexConfig_OAuth_Server struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Enabled               bool                 `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	ClientSecrets         []string             `protobuf:"bytes,2,rep,name=client_secrets,json=clientSecrets,proto3" json:"client_secrets,omitempty"`
-	RedirectUris          []string             `protobuf:"bytes,3,rep,name=redirect_uris,json=redirectUris,proto3" json:"redirect_uris,omitempty"`
-	GrantFlows            []string             `protobuf:"bytes,4,rep,name=grant_flows,json=grantFlows,proto3" json:"grant_flows,omitempty"`
-	SigningPrivateKeyFile string               `protobuf:"bytes,5,opt,name=signing_private_key_file,json=signingPrivateKeyFile,proto3" json:"signing_private_key_file,omitempty"`
-	KeyFileAccessAttempts uint32               `protobuf:"varint,6,opt,name=key_file_access_attempts,json=keyFileAccessAttempts,proto3" json:"key_file_access_attempts,omitempty"`
-	KeyFileAccessPause    *durationpb.Duration `protobuf:"bytes,7,opt,name=key_file_access_pause,json=keyFileAccessPause,proto3" json:"key_file_access_pause,omitempty"`
-	SigningPrivateKeyPem  []byte               `protobuf:"bytes,8,opt,name=signing_private_key_pem,json=signingPrivateKeyPem,proto3" json:"signing_private_key_pem,omitempty"`
-	SignatureAlg          string               `protobuf:"bytes,9,opt,name=signature_alg,json=signatureAlg,proto3" json:"signature_alg,omitempty"`
-	AuthCodeValidity      *durationpb.Duration `protobuf:"bytes,10,opt,name=auth_code_validity,json=authCodeValidity,proto3" json:"auth_code_validity,omitempty"`
-	AccessTokenValidity   *durationpb.Duration `protobuf:"bytes,11,opt,name=access_token_validity,json=accessTokenValidity,proto3" json:"access_token_validity,omitempty"`
-	RefreshTokenValidity  *durationpb.Duration `protobuf:"bytes,12,opt,name=refresh_token_validity,json=refreshTokenValidity,proto3" json:"refresh_token_validity,omitempty"`
+	Enabled               bool                             `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	ClientSecrets         []string                         `protobuf:"bytes,2,rep,name=client_secrets,json=clientSecrets,proto3" json:"client_secrets,omitempty"`
+	RedirectUris          []string                         `protobuf:"bytes,3,rep,name=redirect_uris,json=redirectUris,proto3" json:"redirect_uris,omitempty"`
+	GrantFlows            []string                         `protobuf:"bytes,4,rep,name=grant_flows,json=grantFlows,proto3" json:"grant_flows,omitempty"`
+	SigningPrivateKeyFile string                           `protobuf:"bytes,5,opt,name=signing_private_key_file,json=signingPrivateKeyFile,proto3" json:"signing_private_key_file,omitempty"`
+	KeyFileAccessAttempts uint32                           `protobuf:"varint,6,opt,name=key_file_access_attempts,json=keyFileAccessAttempts,proto3" json:"key_file_access_attempts,omitempty"`
+	KeyFileAccessPause    *durationpb.Duration             `protobuf:"bytes,7,opt,name=key_file_access_pause,json=keyFileAccessPause,proto3" json:"key_file_access_pause,omitempty"`
+	SigningPrivateKeyPem  []byte                           `protobuf:"bytes,8,opt,name=signing_private_key_pem,json=signingPrivateKeyPem,proto3" json:"signing_private_key_pem,omitempty"`
+	SignatureAlg          string                           `protobuf:"bytes,9,opt,name=signature_alg,json=signatureAlg,proto3" json:"signature_alg,omitempty"`
+	AuthCodeValidity      *durationpb.Duration             `protobuf:"bytes,10,opt,name=auth_code_validity,json=authCodeValidity,proto3" json:"auth_code_validity,omitempty"`
+	AccessTokenValidity   *durationpb.Duration             `protobuf:"bytes,11,opt,name=access_token_validity,json=accessTokenValidity,proto3" json:"access_token_validity,omitempty"`
+	RefreshTokenValidity  *durationpb.Duration             `protobuf:"bytes,12,opt,name=refresh_token_validity,json=refreshTokenValidity,proto3" json:"refresh_token_validity,omitempty"`
+	Upstream              *MexConfig_OAuth_Server_Upstream `protobuf:"bytes,13,opt,name=upstream,proto3" json:"upstream,omitempty"`
 }
 
 func (x *MexConfig_OAuth_Server) Reset() {
 	*x = MexConfig_OAuth_Server{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shared_cfg_mexcfg_proto_msgTypes[24]
+		mi := &file_shared_cfg_mexcfg_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1972,7 +2413,7 @@ func (x *MexConfig_OAuth_Server) String() string {
 func (*MexConfig_OAuth_Server) ProtoMessage() {}
 
 func (x *MexConfig_OAuth_Server) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_cfg_mexcfg_proto_msgTypes[24]
+	mi := &file_shared_cfg_mexcfg_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2072,6 +2513,132 @@ func (x *MexConfig_OAuth_Server) GetRefreshTokenValidity() *durationpb.Duration
 	return nil
 }
 
+func (x *MexConfig_OAuth_Server) GetUpstream() *MexConfig_OAuth_Server_Upstream {
+	if x != nil {
+		return x.Upstream
+	}
+	return nil
+}
+
+type MexConfig_OAuth_Server_Upstream struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Issuer              string               `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	ClientId            string               `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret        string               `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	RedirectUri         string               `protobuf:"bytes,4,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"`
+	Scopes              []string             `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	RolesClaim          string               `protobuf:"bytes,6,opt,name=roles_claim,json=rolesClaim,proto3" json:"roles_claim,omitempty"`
+	ProducerClaimValues []string             `protobuf:"bytes,7,rep,name=producer_claim_values,json=producerClaimValues,proto3" json:"producer_claim_values,omitempty"`
+	ConsumerClaimValues []string             `protobuf:"bytes,8,rep,name=consumer_claim_values,json=consumerClaimValues,proto3" json:"consumer_claim_values,omitempty"`
+	ForwardClaimValues  bool                 `protobuf:"varint,9,opt,name=forward_claim_values,json=forwardClaimValues,proto3" json:"forward_claim_values,omitempty"`
+	LoginValidity       *durationpb.Duration `protobuf:"bytes,10,opt,name=login_validity,json=loginValidity,proto3" json:"login_validity,omitempty"`
+}
+
+func (x *MexConfig_OAuth_Server_Upstream) Reset() {
+	*x = MexConfig_OAuth_Server_Upstream{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_cfg_mexcfg_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MexConfig_OAuth_Server_Upstream) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MexConfig_OAuth_Server_Upstream) ProtoMessage() {}
+
+func (x *MexConfig_OAuth_Server_Upstream) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_cfg_mexcfg_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MexConfig_OAuth_Server_Upstream.ProtoReflect.Descriptor instead.
+func (*MexConfig_OAuth_Server_Upstream) Descriptor() ([]byte, []int) {
+	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{0, 4, 0, 0}
+}
+
+func (x *MexConfig_OAuth_Server_Upstream) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *MexConfig_OAuth_Server_Upstream) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *MexConfig_OAuth_Server_Upstream) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *MexConfig_OAuth_Server_Upstream) GetRedirectUri() string {
+	if x != nil {
+		return x.RedirectUri
+	}
+	return ""
+}
+
+func (x *MexConfig_OAuth_Server_Upstream) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *MexConfig_OAuth_Server_Upstream) GetRolesClaim() string {
+	if x != nil {
+		return x.RolesClaim
+	}
+	return ""
+}
+
+func (x *MexConfig_OAuth_Server_Upstream) GetProducerClaimValues() []string {
+	if x != nil {
+		return x.ProducerClaimValues
+	}
+	return nil
+}
+
+func (x *MexConfig_OAuth_Server_Upstream) GetConsumerClaimValues() []string {
+	if x != nil {
+		return x.ConsumerClaimValues
+	}
+	return nil
+}
+
+func (x *MexConfig_OAuth_Server_Upstream) GetForwardClaimValues() bool {
+	if x != nil {
+		return x.ForwardClaimValues
+	}
+	return false
+}
+
+func (x *MexConfig_OAuth_Server_Upstream) GetLoginValidity() *durationpb.Duration {
+	if x != nil {
+		return x.LoginValidity
+	}
+	return nil
+}
+
 type MexConfig_Strictness_Search struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2083,7 +2650,7 @@ type MexConfig_Strictness_Search struct {
 func (x *MexConfig_Strictness_Search) Reset() {
 	*x = MexConfig_Strictness_Search{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shared_cfg_mexcfg_proto_msgTypes[25]
+		mi := &file_shared_cfg_mexcfg_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2096,7 +2663,7 @@ func (x *MexConfig_Strictness_Search) String() string {
 func (*MexConfig_Strictness_Search) ProtoMessage() {}
 
 func (x *MexConfig_Strictness_Search) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_cfg_mexcfg_proto_msgTypes[25]
+	mi := &file_shared_cfg_mexcfg_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2109,7 +2676,7 @@ func (x *MexConfig_Strictness_Search) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MexConfig_Strictness_Search.ProtoReflect.Descriptor instead.
 func (*MexConfig_Strictness_Search) Descriptor() ([]byte, []int) {
-	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{0, 16, 0}
+	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{0, 17, 0}
 }
 
 func (x *MexConfig_Strictness_Search) GetToleratePartialFailures() bool {
@@ -2134,7 +2701,7 @@ type MexConfig_Strictness_StrictJSONParsing struct {
 func (x *MexConfig_Strictness_StrictJSONParsing) Reset() {
 	*x = MexConfig_Strictness_StrictJSONParsing{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shared_cfg_mexcfg_proto_msgTypes[26]
+		mi := &file_shared_cfg_mexcfg_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2147,7 +2714,7 @@ func (x *MexConfig_Strictness_StrictJSONParsing) String() string {
 func (*MexConfig_Strictness_StrictJSONParsing) ProtoMessage() {}
 
 func (x *MexConfig_Strictness_StrictJSONParsing) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_cfg_mexcfg_proto_msgTypes[26]
+	mi := &file_shared_cfg_mexcfg_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2160,7 +2727,7 @@ func (x *MexConfig_Strictness_StrictJSONParsing) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use MexConfig_Strictness_StrictJSONParsing.ProtoReflect.Descriptor instead.
 func (*MexConfig_Strictness_StrictJSONParsing) Descriptor() ([]byte, []int) {
-	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{0, 16, 1}
+	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{0, 17, 1}
 }
 
 func (x *MexConfig_Strictness_StrictJSONParsing) GetAuth() bool {
@@ -2214,7 +2781,7 @@ type MexConfig_Notify_Flowmailer struct {
 func (x *MexConfig_Notify_Flowmailer) Reset() {
 	*x = MexConfig_Notify_Flowmailer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shared_cfg_mexcfg_proto_msgTypes[27]
+		mi := &file_shared_cfg_mexcfg_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2227,7 +2794,7 @@ func (x *MexConfig_Notify_Flowmailer) String() string {
 func (*MexConfig_Notify_Flowmailer) ProtoMessage() {}
 
 func (x *MexConfig_Notify_Flowmailer) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_cfg_mexcfg_proto_msgTypes[27]
+	mi := &file_shared_cfg_mexcfg_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2240,7 +2807,7 @@ func (x *MexConfig_Notify_Flowmailer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MexConfig_Notify_Flowmailer.ProtoReflect.Descriptor instead.
 func (*MexConfig_Notify_Flowmailer) Descriptor() ([]byte, []int) {
-	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{0, 17, 0}
+	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{0, 18, 0}
 }
 
 func (x *MexConfig_Notify_Flowmailer) GetOriginOauth() string {
@@ -2298,7 +2865,7 @@ type MexConfig_Services_BIEventsFilter struct {
 func (x *MexConfig_Services_BIEventsFilter) Reset() {
 	*x = MexConfig_Services_BIEventsFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shared_cfg_mexcfg_proto_msgTypes[28]
+		mi := &file_shared_cfg_mexcfg_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2311,7 +2878,7 @@ func (x *MexConfig_Services_BIEventsFilter) String() string {
 func (*MexConfig_Services_BIEventsFilter) ProtoMessage() {}
 
 func (x *MexConfig_Services_BIEventsFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_cfg_mexcfg_proto_msgTypes[28]
+	mi := &file_shared_cfg_mexcfg_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2324,7 +2891,7 @@ func (x *MexConfig_Services_BIEventsFilter) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use MexConfig_Services_BIEventsFilter.ProtoReflect.Descriptor instead.
 func (*MexConfig_Services_BIEventsFilter) Descriptor() ([]byte, []int) {
-	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{0, 18, 0}
+	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{0, 22, 0}
 }
 
 func (x *MexConfig_Services_BIEventsFilter) GetOrigin() string {
@@ -2359,7 +2926,7 @@ type MexConfig_Services_Blobs struct {
 func (x *MexConfig_Services_Blobs) Reset() {
 	*x = MexConfig_Services_Blobs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shared_cfg_mexcfg_proto_msgTypes[29]
+		mi := &file_shared_cfg_mexcfg_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2372,7 +2939,237 @@ func (x *MexConfig_Services_Blobs) String() string {
 func (*MexConfig_Services_Blobs) ProtoMessage() {}
 
 func (x *MexConfig_Services_Blobs) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_cfg_mexcfg_proto_msgTypes[29]
+	mi := &file_shared_cfg_mexcfg_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MexConfig_Services_Blobs.ProtoReflect.Descriptor instead.
+func (*MexConfig_Services_Blobs) Descriptor() ([]byte, []int) {
+	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{0, 22, 1}
+}
+
+func (x *MexConfig_Services_Blobs) GetMasterTableName() string {
+	if x != nil {
+		return x.MasterTableName
+	}
+	return ""
+}
+
+type MexConfig_Services_Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Origin        string                            `protobuf:"bytes,1,opt,name=origin,proto3" json:"origin,omitempty"`
+	EnvPath       string                            `protobuf:"bytes,2,opt,name=env_path,json=envPath,proto3" json:"env_path,omitempty"`
+	ApiKeys       []string                          `protobuf:"bytes,3,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
+	Github        *MexConfig_Services_Config_Github `protobuf:"bytes,5,opt,name=github,proto3" json:"github,omitempty"`
+	UpdateTimeout *durationpb.Duration              `protobuf:"bytes,6,opt,name=update_timeout,json=updateTimeout,proto3" json:"update_timeout,omitempty"`
+	AutoRollback  bool                              `protobuf:"varint,7,opt,name=auto_rollback,json=autoRollback,proto3" json:"auto_rollback,omitempty"`
+	Source        ConfigSourceType                  `protobuf:"varint,8,opt,name=source,proto3,enum=d4l.mex.cfg.ConfigSourceType" json:"source,omitempty"`
+	Local         *MexConfig_Services_Config_Local  `protobuf:"bytes,9,opt,name=local,proto3" json:"local,omitempty"`
+	Http          *MexConfig_Services_Config_Http   `protobuf:"bytes,10,opt,name=http,proto3" json:"http,omitempty"`
+	Oci           *MexConfig_Services_Config_Oci    `protobuf:"bytes,11,opt,name=oci,proto3" json:"oci,omitempty"`
+}
+
+func (x *MexConfig_Services_Config) Reset() {
+	*x = MexConfig_Services_Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_cfg_mexcfg_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MexConfig_Services_Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MexConfig_Services_Config) ProtoMessage() {}
+
+func (x *MexConfig_Services_Config) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_cfg_mexcfg_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MexConfig_Services_Config.ProtoReflect.Descriptor instead.
+func (*MexConfig_Services_Config) Descriptor() ([]byte, []int) {
+	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{0, 22, 2}
+}
+
+func (x *MexConfig_Services_Config) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *MexConfig_Services_Config) GetEnvPath() string {
+	if x != nil {
+		return x.EnvPath
+	}
+	return ""
+}
+
+func (x *MexConfig_Services_Config) GetApiKeys() []string {
+	if x != nil {
+		return x.ApiKeys
+	}
+	return nil
+}
+
+func (x *MexConfig_Services_Config) GetGithub() *MexConfig_Services_Config_Github {
+	if x != nil {
+		return x.Github
+	}
+	return nil
+}
+
+func (x *MexConfig_Services_Config) GetUpdateTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.UpdateTimeout
+	}
+	return nil
+}
+
+func (x *MexConfig_Services_Config) GetAutoRollback() bool {
+	if x != nil {
+		return x.AutoRollback
+	}
+	return false
+}
+
+func (x *MexConfig_Services_Config) GetSource() ConfigSourceType {
+	if x != nil {
+		return x.Source
+	}
+	return ConfigSourceType_GITHUB
+}
+
+func (x *MexConfig_Services_Config) GetLocal() *MexConfig_Services_Config_Local {
+	if x != nil {
+		return x.Local
+	}
+	return nil
+}
+
+func (x *MexConfig_Services_Config) GetHttp() *MexConfig_Services_Config_Http {
+	if x != nil {
+		return x.Http
+	}
+	return nil
+}
+
+func (x *MexConfig_Services_Config) GetOci() *MexConfig_Services_Config_Oci {
+	if x != nil {
+		return x.Oci
+	}
+	return nil
+}
+
+type MexConfig_Services_Config_Github struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RepoName          string `protobuf:"bytes,1,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	DefaultBranchName string `protobuf:"bytes,2,opt,name=default_branch_name,json=defaultBranchName,proto3" json:"default_branch_name,omitempty"`
+	DeployKeyPem      []byte `protobuf:"bytes,3,opt,name=deploy_key_pem,json=deployKeyPem,proto3" json:"deploy_key_pem,omitempty"`
+}
+
+func (x *MexConfig_Services_Config_Github) Reset() {
+	*x = MexConfig_Services_Config_Github{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_cfg_mexcfg_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MexConfig_Services_Config_Github) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MexConfig_Services_Config_Github) ProtoMessage() {}
+
+func (x *MexConfig_Services_Config_Github) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_cfg_mexcfg_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MexConfig_Services_Config_Github.ProtoReflect.Descriptor instead.
+func (*MexConfig_Services_Config_Github) Descriptor() ([]byte, []int) {
+	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{0, 22, 2, 0}
+}
+
+func (x *MexConfig_Services_Config_Github) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *MexConfig_Services_Config_Github) GetDefaultBranchName() string {
+	if x != nil {
+		return x.DefaultBranchName
+	}
+	return ""
+}
+
+func (x *MexConfig_Services_Config_Github) GetDeployKeyPem() []byte {
+	if x != nil {
+		return x.DeployKeyPem
+	}
+	return nil
+}
+
+type MexConfig_Services_Config_Local struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path         string               `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	PollInterval *durationpb.Duration `protobuf:"bytes,2,opt,name=poll_interval,json=pollInterval,proto3" json:"poll_interval,omitempty"`
+}
+
+func (x *MexConfig_Services_Config_Local) Reset() {
+	*x = MexConfig_Services_Config_Local{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_cfg_mexcfg_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MexConfig_Services_Config_Local) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MexConfig_Services_Config_Local) ProtoMessage() {}
+
+func (x *MexConfig_Services_Config_Local) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_cfg_mexcfg_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2383,47 +3180,50 @@ func (x *MexConfig_Services_Blobs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MexConfig_Services_Blobs.ProtoReflect.Descriptor instead.
-func (*MexConfig_Services_Blobs) Descriptor() ([]byte, []int) {
-	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{0, 18, 1}
+// Deprecated: Use MexConfig_Services_Config_Local.ProtoReflect.Descriptor instead.
+func (*MexConfig_Services_Config_Local) Descriptor() ([]byte, []int) {
+	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{0, 22, 2, 1}
 }
 
-func (x *MexConfig_Services_Blobs) GetMasterTableName() string {
+func (x *MexConfig_Services_Config_Local) GetPath() string {
 	if x != nil {
-		return x.MasterTableName
+		return x.Path
 	}
 	return ""
 }
 
-type MexConfig_Services_Config struct {
+func (x *MexConfig_Services_Config_Local) GetPollInterval() *durationpb.Duration {
+	if x != nil {
+		return x.PollInterval
+	}
+	return nil
+}
+
+type MexConfig_Services_Config_Http struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Origin        string                            `protobuf:"bytes,1,opt,name=origin,proto3" json:"origin,omitempty"`
-	EnvPath       string                            `protobuf:"bytes,2,opt,name=env_path,json=envPath,proto3" json:"env_path,omitempty"`
-	ApiKeys       []string                          `protobuf:"bytes,3,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
-	Github        *MexConfig_Services_Config_Github `protobuf:"bytes,5,opt,name=github,proto3" json:"github,omitempty"`
-	UpdateTimeout *durationpb.Duration              `protobuf:"bytes,6,opt,name=update_timeout,json=updateTimeout,proto3" json:"update_timeout,omitempty"`
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
 }
 
-func (x *MexConfig_Services_Config) Reset() {
-	*x = MexConfig_Services_Config{}
+func (x *MexConfig_Services_Config_Http) Reset() {
+	*x = MexConfig_Services_Config_Http{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shared_cfg_mexcfg_proto_msgTypes[30]
+		mi := &file_shared_cfg_mexcfg_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MexConfig_Services_Config) String() string {
+func (x *MexConfig_Services_Config_Http) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MexConfig_Services_Config) ProtoMessage() {}
+func (*MexConfig_Services_Config_Http) ProtoMessage() {}
 
-func (x *MexConfig_Services_Config) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_cfg_mexcfg_proto_msgTypes[30]
+func (x *MexConfig_Services_Config_Http) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_cfg_mexcfg_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2434,73 +3234,46 @@ func (x *MexConfig_Services_Config) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MexConfig_Services_Config.ProtoReflect.Descriptor instead.
-func (*MexConfig_Services_Config) Descriptor() ([]byte, []int) {
-	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{0, 18, 2}
-}
-
-func (x *MexConfig_Services_Config) GetOrigin() string {
-	if x != nil {
-		return x.Origin
-	}
-	return ""
+// Deprecated: Use MexConfig_Services_Config_Http.ProtoReflect.Descriptor instead.
+func (*MexConfig_Services_Config_Http) Descriptor() ([]byte, []int) {
+	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{0, 22, 2, 2}
 }
 
-func (x *MexConfig_Services_Config) GetEnvPath() string {
+func (x *MexConfig_Services_Config_Http) GetUrl() string {
 	if x != nil {
-		return x.EnvPath
+		return x.Url
 	}
 	return ""
 }
 
-func (x *MexConfig_Services_Config) GetApiKeys() []string {
-	if x != nil {
-		return x.ApiKeys
-	}
-	return nil
-}
-
-func (x *MexConfig_Services_Config) GetGithub() *MexConfig_Services_Config_Github {
-	if x != nil {
-		return x.Github
-	}
-	return nil
-}
-
-func (x *MexConfig_Services_Config) GetUpdateTimeout() *durationpb.Duration {
-	if x != nil {
-		return x.UpdateTimeout
-	}
-	return nil
-}
-
-type MexConfig_Services_Config_Github struct {
+type MexConfig_Services_Config_Oci struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RepoName          string `protobuf:"bytes,1,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
-	DefaultBranchName string `protobuf:"bytes,2,opt,name=default_branch_name,json=defaultBranchName,proto3" json:"default_branch_name,omitempty"`
-	DeployKeyPem      []byte `protobuf:"bytes,3,opt,name=deploy_key_pem,json=deployKeyPem,proto3" json:"deploy_key_pem,omitempty"`
+	Reference string `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+	Username  string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password  string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	PlainHttp bool   `protobuf:"varint,4,opt,name=plain_http,json=plainHttp,proto3" json:"plain_http,omitempty"`
 }
 
-func (x *MexConfig_Services_Config_Github) Reset() {
-	*x = MexConfig_Services_Config_Github{}
+func (x *MexConfig_Services_Config_Oci) Reset() {
+	*x = MexConfig_Services_Config_Oci{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shared_cfg_mexcfg_proto_msgTypes[31]
+		mi := &file_shared_cfg_mexcfg_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MexConfig_Services_Config_Github) String() string {
+func (x *MexConfig_Services_Config_Oci) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MexConfig_Services_Config_Github) ProtoMessage() {}
+func (*MexConfig_Services_Config_Oci) ProtoMessage() {}
 
-func (x *MexConfig_Services_Config_Github) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_cfg_mexcfg_proto_msgTypes[31]
+func (x *MexConfig_Services_Config_Oci) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_cfg_mexcfg_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2511,30 +3284,37 @@ func (x *MexConfig_Services_Config_Github) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MexConfig_Services_Config_Github.ProtoReflect.Descriptor instead.
-func (*MexConfig_Services_Config_Github) Descriptor() ([]byte, []int) {
-	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{0, 18, 2, 0}
+// Deprecated: Use MexConfig_Services_Config_Oci.ProtoReflect.Descriptor instead.
+func (*MexConfig_Services_Config_Oci) Descriptor() ([]byte, []int) {
+	return file_shared_cfg_mexcfg_proto_rawDescGZIP(), []int{0, 22, 2, 3}
 }
 
-func (x *MexConfig_Services_Config_Github) GetRepoName() string {
+func (x *MexConfig_Services_Config_Oci) GetReference() string {
 	if x != nil {
-		return x.RepoName
+		return x.Reference
 	}
 	return ""
 }
 
-func (x *MexConfig_Services_Config_Github) GetDefaultBranchName() string {
+func (x *MexConfig_Services_Config_Oci) GetUsername() string {
 	if x != nil {
-		return x.DefaultBranchName
+		return x.Username
 	}
 	return ""
 }
 
-func (x *MexConfig_Services_Config_Github) GetDeployKeyPem() []byte {
+func (x *MexConfig_Services_Config_Oci) GetPassword() string {
 	if x != nil {
-		return x.DeployKeyPem
+		return x.Password
 	}
-	return nil
+	return ""
+}
+
+func (x *MexConfig_Services_Config_Oci) GetPlainHttp() bool {
+	if x != nil {
+		return x.PlainHttp
+	}
+	return false
 }
 
 var File_shared_cfg_mexcfg_proto protoreflect.FileDescriptor
@@ -2545,346 +3325,404 @@ var file_shared_cfg_mexcfg_proto_rawDesc = []byte{
 	0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x1a, 0x10, 0x64, 0x34, 0x6c, 0x2f, 0x63, 0x6f, 0x6e, 0x66,
 	0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8e, 0x54, 0x0a, 0x09, 0x4d, 0x65, 0x78,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2e, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65,
-	0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x92, 0xe2, 0x09, 0x01, 0x2a,
-	0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x03, 0x77, 0x65,
-	0x62, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65,
-	0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x57, 0x65, 0x62, 0x52, 0x03, 0x77, 0x65, 0x62, 0x12, 0x2f, 0x0a, 0x02, 0x64, 0x62, 0x18, 0x14,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63,
-	0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x6f, 0x73,
-	0x74, 0x67, 0x72, 0x65, 0x73, 0x52, 0x02, 0x64, 0x62, 0x12, 0x2f, 0x0a, 0x04, 0x73, 0x6f, 0x6c,
-	0x72, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65,
-	0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x53, 0x6f, 0x6c, 0x72, 0x52, 0x04, 0x73, 0x6f, 0x6c, 0x72, 0x12, 0x32, 0x0a, 0x05, 0x72, 0x65,
-	0x64, 0x69, 0x73, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x34, 0x6c, 0x2e,
-	0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x2e, 0x52, 0x65, 0x64, 0x69, 0x73, 0x52, 0x05, 0x72, 0x65, 0x64, 0x69, 0x73, 0x12, 0x32,
-	0x0a, 0x05, 0x6f, 0x61, 0x75, 0x74, 0x68, 0x18, 0x32, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa1, 0x8c, 0x01, 0x0a, 0x09, 0x4d, 0x65,
+	0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2e, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d,
+	0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x92, 0xe2, 0x09, 0x01,
+	0x2a, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x03, 0x77,
+	0x65, 0x62, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d,
+	0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x57, 0x65, 0x62, 0x52, 0x03, 0x77, 0x65, 0x62, 0x12, 0x2f, 0x0a, 0x02, 0x64, 0x62, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e,
+	0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x6f,
+	0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x52, 0x02, 0x64, 0x62, 0x12, 0x2f, 0x0a, 0x04, 0x73, 0x6f,
+	0x6c, 0x72, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d,
+	0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x53, 0x6f, 0x6c, 0x72, 0x52, 0x04, 0x73, 0x6f, 0x6c, 0x72, 0x12, 0x32, 0x0a, 0x05, 0x72,
+	0x65, 0x64, 0x69, 0x73, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x34, 0x6c,
+	0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x52, 0x65, 0x64, 0x69, 0x73, 0x52, 0x05, 0x72, 0x65, 0x64, 0x69, 0x73, 0x12,
+	0x32, 0x0a, 0x05, 0x6f, 0x61, 0x75, 0x74, 0x68, 0x18, 0x32, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x52, 0x05, 0x6f, 0x61,
+	0x75, 0x74, 0x68, 0x12, 0x38, 0x0a, 0x07, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x3c,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63,
+	0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x6f, 0x64,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x07, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x45, 0x0a,
+	0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x46, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66,
+	0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x64, 0x65,
+	0x66, 0x73, 0x18, 0x50, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d,
+	0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x65, 0x66, 0x73, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x44, 0x65, 0x66, 0x73, 0x12, 0x48, 0x0a, 0x0d, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x64,
+	0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x0c, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x2f, 0x0a, 0x04, 0x6a, 0x77, 0x6b, 0x73, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
 	0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x52, 0x05, 0x6f, 0x61, 0x75,
-	0x74, 0x68, 0x12, 0x38, 0x0a, 0x07, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x3c, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66,
-	0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x6f, 0x64, 0x69,
-	0x6e, 0x67, 0x73, 0x52, 0x07, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x45, 0x0a, 0x0c,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x46, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67,
-	0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79,
-	0x70, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x64, 0x65, 0x66,
-	0x73, 0x18, 0x50, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4a, 0x77, 0x6b, 0x73, 0x52, 0x04, 0x6a, 0x77, 0x6b, 0x73,
+	0x12, 0x2f, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x6e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x04, 0x6a, 0x6f, 0x62,
+	0x73, 0x12, 0x45, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x72, 0x18, 0x78, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65,
 	0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x65, 0x66, 0x73, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x44, 0x65, 0x66, 0x73, 0x12, 0x48, 0x0a, 0x0d, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x64, 0x34,
+	0x41, 0x75, 0x74, 0x6f, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x52, 0x0b, 0x61, 0x75, 0x74,
+	0x6f, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x08, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x69, 0x6e, 0x67, 0x18, 0x82, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x34,
 	0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x0c, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2f,
-	0x0a, 0x04, 0x6a, 0x77, 0x6b, 0x73, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64,
+	0x66, 0x69, 0x67, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x69, 0x6e, 0x67, 0x12, 0x39, 0x0a, 0x07, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e,
+	0x67, 0x18, 0x8c, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d,
+	0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e,
+	0x67, 0x12, 0x3f, 0x0a, 0x09, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x18, 0x96,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e,
+	0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x65,
+	0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52, 0x09, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74,
+	0x72, 0x79, 0x12, 0x30, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0xa0, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e,
+	0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x04,
+	0x61, 0x75, 0x74, 0x68, 0x12, 0x3c, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x18, 0xaa, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65,
+	0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x12, 0x42, 0x0a, 0x0a, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x6e, 0x65, 0x73, 0x73,
+	0x18, 0xb4, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65,
+	0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x63, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x0a, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x36, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79,
+	0x18, 0xbe, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65,
+	0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x2d,
+	0x0a, 0x03, 0x6f, 0x61, 0x69, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64,
 	0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4a, 0x77, 0x6b, 0x73, 0x52, 0x04, 0x6a, 0x77, 0x6b, 0x73, 0x12,
-	0x2f, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x6e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73,
-	0x12, 0x45, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
-	0x18, 0x78, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4f, 0x61, 0x69, 0x52, 0x03, 0x6f, 0x61, 0x69, 0x12, 0x4c, 0x0a,
+	0x0e, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x18,
+	0xd2, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78,
 	0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41,
-	0x75, 0x74, 0x6f, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x6f,
-	0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x08, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x69, 0x6e, 0x67, 0x18, 0x82, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x34, 0x6c,
-	0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x69, 0x6e, 0x67, 0x12, 0x39, 0x0a, 0x07, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67,
-	0x18, 0x8c, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65,
-	0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67,
-	0x12, 0x3f, 0x0a, 0x09, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x18, 0x96, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63,
-	0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x65, 0x6c,
-	0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52, 0x09, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72,
-	0x79, 0x12, 0x30, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0xa0, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d,
-	0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x04, 0x61,
-	0x75, 0x74, 0x68, 0x12, 0x3c, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18,
-	0xaa, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78,
-	0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x12, 0x42, 0x0a, 0x0a, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x18,
-	0xb4, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78,
-	0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53,
-	0x74, 0x72, 0x69, 0x63, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x0a, 0x73, 0x74, 0x72, 0x69, 0x63,
-	0x74, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x36, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x18,
-	0xbe, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78,
-	0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4e,
-	0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x1a, 0x89, 0x12,
-	0x0a, 0x03, 0x57, 0x65, 0x62, 0x12, 0xbc, 0x01, 0x0a, 0x0c, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x7e, 0x82, 0xe2, 0x09, 0x04, 0x0a, 0x02, 0x35,
-	0x73, 0x8a, 0xe2, 0x09, 0x72, 0x0a, 0x19, 0x48, 0x54, 0x54, 0x50, 0x20, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x20, 0x72, 0x65, 0x61, 0x64, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
-	0x12, 0x55, 0x54, 0x68, 0x69, 0x73, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x69, 0x73, 0x20,
-	0x74, 0x68, 0x65, 0x20, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x20, 0x64, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67,
-	0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x72, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x2c, 0x20, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x74,
-	0x68, 0x65, 0x20, 0x62, 0x6f, 0x64, 0x79, 0x52, 0x0b, 0x72, 0x65, 0x61, 0x64, 0x54, 0x69, 0x6d,
-	0x65, 0x6f, 0x75, 0x74, 0x12, 0xb5, 0x01, 0x0a, 0x0d, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75, 0x82, 0xe2, 0x09, 0x04, 0x0a, 0x02, 0x35,
-	0x73, 0x8a, 0xe2, 0x09, 0x69, 0x0a, 0x1a, 0x48, 0x54, 0x54, 0x50, 0x20, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x20, 0x77, 0x72, 0x69, 0x74, 0x65, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
-	0x74, 0x12, 0x4b, 0x54, 0x68, 0x69, 0x73, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x69, 0x73,
-	0x20, 0x74, 0x68, 0x65, 0x20, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x20, 0x64, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x20, 0x74, 0x69, 0x6d,
-	0x69, 0x6e, 0x67, 0x20, 0x6f, 0x75, 0x74, 0x20, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x20, 0x6f,
-	0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0c,
-	0x77, 0x72, 0x69, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0xca, 0x01, 0x0a,
-	0x0c, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x8b,
-	0x01, 0x82, 0xe2, 0x09, 0x04, 0x0a, 0x02, 0x35, 0x73, 0x8a, 0xe2, 0x09, 0x7f, 0x0a, 0x19, 0x48,
-	0x54, 0x54, 0x50, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x69, 0x64, 0x6c, 0x65,
-	0x20, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x62, 0x54, 0x68, 0x69, 0x73, 0x20, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x20, 0x69, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6d, 0x61, 0x78, 0x69,
-	0x6d, 0x75, 0x6d, 0x20, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x69,
-	0x6d, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x77, 0x61, 0x69, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74,
-	0x68, 0x65, 0x20, 0x6e, 0x65, 0x78, 0x74, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20,
-	0x77, 0x68, 0x65, 0x6e, 0x20, 0x6b, 0x65, 0x65, 0x70, 0x2d, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x73,
-	0x20, 0x61, 0x72, 0x65, 0x20, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x0b, 0x69, 0x64,
-	0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x37, 0x0a, 0x10, 0x6d, 0x61, 0x78,
-	0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x05, 0x42, 0x0d, 0x82, 0xe2, 0x09, 0x09, 0x0a, 0x07, 0x32, 0x30, 0x39, 0x37, 0x31,
-	0x35, 0x32, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x79, 0x74,
-	0x65, 0x73, 0x12, 0x33, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x62,
-	0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0d, 0x82, 0xe2, 0x09, 0x09,
-	0x0a, 0x07, 0x32, 0x30, 0x39, 0x37, 0x31, 0x35, 0x32, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x42, 0x6f,
-	0x64, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x5d, 0x0a, 0x08, 0x61, 0x70, 0x69, 0x5f, 0x68,
-	0x6f, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x42, 0x82, 0xe2, 0x09, 0x0e, 0x0a,
-	0x0c, 0x30, 0x2e, 0x30, 0x2e, 0x30, 0x2e, 0x30, 0x3a, 0x33, 0x30, 0x30, 0x30, 0x8a, 0xe2, 0x09,
-	0x2c, 0x12, 0x2a, 0x48, 0x6f, 0x73, 0x74, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x70, 0x6f, 0x72, 0x74,
-	0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x64,
-	0x20, 0x48, 0x54, 0x54, 0x50, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x61,
-	0x70, 0x69, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x9f, 0x01, 0x0a, 0x09, 0x67, 0x72, 0x70, 0x63, 0x5f,
-	0x68, 0x6f, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x81, 0x01, 0x82, 0xe2, 0x09,
-	0x0e, 0x0a, 0x0c, 0x30, 0x2e, 0x30, 0x2e, 0x30, 0x2e, 0x30, 0x3a, 0x39, 0x30, 0x30, 0x30, 0x8a,
-	0xe2, 0x09, 0x6b, 0x12, 0x69, 0x48, 0x6f, 0x73, 0x74, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x70, 0x6f,
-	0x72, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x67, 0x52, 0x50, 0x43, 0x20,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x77, 0x68, 0x6f, 0x73, 0x65, 0x20, 0x6d, 0x65,
-	0x74, 0x68, 0x6f, 0x64, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65,
-	0x64, 0x20, 0x76, 0x69, 0x61, 0x20, 0x61, 0x6e, 0x20, 0x48, 0x54, 0x54, 0x50, 0x2d, 0x67, 0x52,
-	0x50, 0x43, 0x20, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x20, 0x75, 0x6e, 0x64, 0x65, 0x72,
-	0x20, 0x60, 0x57, 0x65, 0x62, 0x2e, 0x41, 0x50, 0x49, 0x48, 0x6f, 0x73, 0x74, 0x60, 0x52, 0x08,
-	0x67, 0x72, 0x70, 0x63, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x0c, 0x6d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e,
-	0x82, 0xe2, 0x09, 0x0a, 0x0a, 0x08, 0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x0b,
-	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x3d, 0x0a, 0x08, 0x63,
-	0x61, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
-	0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x57, 0x65, 0x62, 0x2e, 0x43, 0x41, 0x43, 0x65, 0x72, 0x74,
-	0x73, 0x52, 0x07, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x73, 0x12, 0x40, 0x0a, 0x09, 0x69, 0x70,
-	0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x0d, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x4c, 0x0a, 0x0e, 0x73,
+	0x61, 0x76, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x18, 0xdc, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63,
+	0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x61, 0x76,
+	0x65, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x52, 0x0d, 0x73, 0x61, 0x76, 0x65,
+	0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x09, 0x69, 0x6e, 0x67,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0xe6, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
 	0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x57, 0x65, 0x62, 0x2e, 0x49, 0x50, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x52, 0x08, 0x69, 0x70, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x0d,
-	0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x0d, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66,
-	0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x57, 0x65, 0x62, 0x2e,
-	0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x72, 0x61,
-	0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x1a, 0x87, 0x06, 0x0a, 0x07, 0x43,
-	0x41, 0x43, 0x65, 0x72, 0x74, 0x73, 0x12, 0xea, 0x02, 0x0a, 0x19, 0x61, 0x64, 0x64, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x63, 0x61, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x73, 0x5f, 0x66,
-	0x69, 0x6c, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x42, 0xae, 0x02, 0x82, 0xe2, 0x09,
-	0x05, 0x0a, 0x03, 0xe2, 0x88, 0x85, 0x8a, 0xe2, 0x09, 0xa0, 0x02, 0x12, 0x58, 0x41, 0x64, 0x64,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x20, 0x43, 0x41, 0x20, 0x63, 0x65, 0x72, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x63, 0x6f, 0x6e, 0x73, 0x69,
-	0x64, 0x65, 0x72, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x6d, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x20,
-	0x48, 0x54, 0x54, 0x50, 0x53, 0x20, 0x6f, 0x72, 0x20, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x20, 0x54,
-	0x4c, 0x53, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x73, 0x1a, 0x67, 0x54, 0x68, 0x65, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x73,
-	0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x50, 0x45, 0x4d, 0x20, 0x66, 0x69, 0x6c,
-	0x65, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x69, 0x6e, 0x67, 0x6c,
-	0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
-	0x65, 0x72, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x61, 0x20, 0x73, 0x65, 0x74,
-	0x20, 0x6f, 0x66, 0x20, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x2d, 0x65, 0x6e, 0x63, 0x6f, 0x64,
-	0x65, 0x64, 0x20, 0x50, 0x45, 0x4d, 0x20, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x1a, 0x5b,
-	0x41, 0x6c, 0x6c, 0x20, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73,
-	0x20, 0x6f, 0x66, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x73, 0x75, 0x63, 0x68, 0x20, 0x50, 0x45, 0x4d,
-	0x20, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x74, 0x68, 0x65, 0x6e,
-	0x20, 0x61, 0x64, 0x64, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x72,
-	0x75, 0x73, 0x74, 0x65, 0x64, 0x20, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x65, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x54, 0x4c, 0x53, 0x2e, 0x52, 0x16, 0x61, 0x64, 0x64,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x43, 0x61, 0x43, 0x65, 0x72, 0x74, 0x73, 0x46, 0x69,
-	0x6c, 0x65, 0x73, 0x12, 0x8c, 0x02, 0x0a, 0x17, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x61, 0x6c, 0x5f, 0x63, 0x61, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x6d, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x0c, 0x42, 0xd4, 0x01, 0x82, 0xe2, 0x09, 0x02, 0x10, 0x01, 0x8a, 0xe2,
-	0x09, 0xc9, 0x01, 0x12, 0x2f, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x20,
-	0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x20, 0x73, 0x70, 0x65,
-	0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x50, 0x45, 0x4d, 0x20, 0x66, 0x6f,
-	0x72, 0x6d, 0x61, 0x74, 0x1a, 0x44, 0x54, 0x68, 0x69, 0x73, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x20, 0x63, 0x61, 0x6e, 0x20, 0x62, 0x65, 0x20, 0x73, 0x65, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x61,
-	0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x69, 0x73, 0x20,
-	0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x61,
-	0x20, 0x50, 0x45, 0x4d, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x1a, 0x50, 0x4d, 0x75, 0x6c, 0x74,
-	0x69, 0x70, 0x6c, 0x65, 0x20, 0x50, 0x45, 0x4d, 0x20, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x20,
-	0x61, 0x72, 0x65, 0x20, 0x70, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x20, 0x73, 0x6f, 0x20,
-	0x74, 0x68, 0x61, 0x74, 0x20, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x20, 0x63, 0x65,
-	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x62,
-	0x65, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2e, 0x52, 0x14, 0x61, 0x64,
-	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x43, 0x61, 0x43, 0x65, 0x72, 0x74, 0x73, 0x50,
-	0x65, 0x6d, 0x12, 0x31, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x61, 0x74, 0x74,
-	0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0x82, 0xe2, 0x09,
-	0x04, 0x0a, 0x02, 0x32, 0x30, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x41, 0x74, 0x74,
-	0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x46, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f,
-	0x70, 0x61, 0x75, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0x82, 0xe2, 0x09, 0x04, 0x0a, 0x02, 0x32, 0x73,
-	0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x61, 0x75, 0x73, 0x65, 0x3a, 0x05, 0x9a,
-	0xe2, 0x09, 0x01, 0x2a, 0x1a, 0x64, 0x0a, 0x08, 0x49, 0x50, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x12, 0x25, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x42, 0x0b, 0x82, 0xe2, 0x09, 0x07, 0x0a, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x07,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
-	0x65, 0x64, 0x5f, 0x69, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x09, 0x82, 0xe2,
-	0x09, 0x05, 0x0a, 0x03, 0xe2, 0x88, 0x85, 0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
-	0x49, 0x70, 0x73, 0x3a, 0x05, 0x9a, 0xe2, 0x09, 0x01, 0x2a, 0x1a, 0xd5, 0x01, 0x0a, 0x0c, 0x52,
-	0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a, 0x07, 0x65,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x42, 0x0b, 0x82, 0xe2,
-	0x09, 0x07, 0x0a, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x12, 0x3b, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x09, 0x69, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x89, 0x12, 0x0a, 0x03, 0x57,
+	0x65, 0x62, 0x12, 0xbc, 0x01, 0x0a, 0x0c, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x7e, 0x82, 0xe2, 0x09, 0x04, 0x0a, 0x02, 0x35, 0x73, 0x8a, 0xe2,
+	0x09, 0x72, 0x0a, 0x19, 0x48, 0x54, 0x54, 0x50, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x20, 0x72, 0x65, 0x61, 0x64, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x55, 0x54,
+	0x68, 0x69, 0x73, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x69, 0x73, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x20, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x72, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2c, 0x20, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x62, 0x6f, 0x64, 0x79, 0x52, 0x0b, 0x72, 0x65, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x12, 0xb5, 0x01, 0x0a, 0x0d, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75, 0x82, 0xe2, 0x09, 0x04, 0x0a, 0x02, 0x35, 0x73, 0x8a, 0xe2,
+	0x09, 0x69, 0x0a, 0x1a, 0x48, 0x54, 0x54, 0x50, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x20, 0x77, 0x72, 0x69, 0x74, 0x65, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x4b,
+	0x54, 0x68, 0x69, 0x73, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x69, 0x73, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x20, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x20, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x20, 0x74, 0x69, 0x6d, 0x69, 0x6e, 0x67,
+	0x20, 0x6f, 0x75, 0x74, 0x20, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0c, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0xca, 0x01, 0x0a, 0x0c, 0x69, 0x64,
+	0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x8b, 0x01, 0x82, 0xe2,
+	0x09, 0x04, 0x0a, 0x02, 0x35, 0x73, 0x8a, 0xe2, 0x09, 0x7f, 0x0a, 0x19, 0x48, 0x54, 0x54, 0x50,
+	0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x69, 0x64, 0x6c, 0x65, 0x20, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x62, 0x54, 0x68, 0x69, 0x73, 0x20, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x20, 0x69, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d,
+	0x20, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20,
+	0x74, 0x6f, 0x20, 0x77, 0x61, 0x69, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x6e, 0x65, 0x78, 0x74, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x77, 0x68, 0x65,
+	0x6e, 0x20, 0x6b, 0x65, 0x65, 0x70, 0x2d, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x73, 0x20, 0x61, 0x72,
+	0x65, 0x20, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x0b, 0x69, 0x64, 0x6c, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x37, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x0d, 0x82, 0xe2, 0x09, 0x09, 0x0a, 0x07, 0x32, 0x30, 0x39, 0x37, 0x31, 0x35, 0x32, 0x52,
+	0x0e, 0x6d, 0x61, 0x78, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x33, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0d, 0x82, 0xe2, 0x09, 0x09, 0x0a, 0x07, 0x32,
+	0x30, 0x39, 0x37, 0x31, 0x35, 0x32, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x42, 0x6f, 0x64, 0x79, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x12, 0x5d, 0x0a, 0x08, 0x61, 0x70, 0x69, 0x5f, 0x68, 0x6f, 0x73, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x42, 0x82, 0xe2, 0x09, 0x0e, 0x0a, 0x0c, 0x30, 0x2e,
+	0x30, 0x2e, 0x30, 0x2e, 0x30, 0x3a, 0x33, 0x30, 0x30, 0x30, 0x8a, 0xe2, 0x09, 0x2c, 0x12, 0x2a,
+	0x48, 0x6f, 0x73, 0x74, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x66, 0x6f,
+	0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x20, 0x48, 0x54,
+	0x54, 0x50, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x61, 0x70, 0x69, 0x48,
+	0x6f, 0x73, 0x74, 0x12, 0x9f, 0x01, 0x0a, 0x09, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x68, 0x6f, 0x73,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x81, 0x01, 0x82, 0xe2, 0x09, 0x0e, 0x0a, 0x0c,
+	0x30, 0x2e, 0x30, 0x2e, 0x30, 0x2e, 0x30, 0x3a, 0x39, 0x30, 0x30, 0x30, 0x8a, 0xe2, 0x09, 0x6b,
+	0x12, 0x69, 0x48, 0x6f, 0x73, 0x74, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x70, 0x6f, 0x72, 0x74, 0x20,
+	0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x67, 0x52, 0x50, 0x43, 0x20, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x20, 0x77, 0x68, 0x6f, 0x73, 0x65, 0x20, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x20, 0x76,
+	0x69, 0x61, 0x20, 0x61, 0x6e, 0x20, 0x48, 0x54, 0x54, 0x50, 0x2d, 0x67, 0x52, 0x50, 0x43, 0x20,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x20, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x20, 0x60, 0x57,
+	0x65, 0x62, 0x2e, 0x41, 0x50, 0x49, 0x48, 0x6f, 0x73, 0x74, 0x60, 0x52, 0x08, 0x67, 0x72, 0x70,
+	0x63, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x0c, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x82, 0xe2, 0x09,
+	0x0a, 0x0a, 0x08, 0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x0b, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x3d, 0x0a, 0x08, 0x63, 0x61, 0x5f, 0x63,
+	0x65, 0x72, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x64, 0x34, 0x6c,
+	0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x57, 0x65, 0x62, 0x2e, 0x43, 0x41, 0x43, 0x65, 0x72, 0x74, 0x73, 0x52, 0x07,
+	0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x73, 0x12, 0x40, 0x0a, 0x09, 0x69, 0x70, 0x5f, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x64, 0x34, 0x6c,
+	0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x57, 0x65, 0x62, 0x2e, 0x49, 0x50, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52,
+	0x08, 0x69, 0x70, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x0d, 0x72, 0x61, 0x74,
+	0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d,
+	0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x57, 0x65, 0x62, 0x2e, 0x52, 0x61, 0x74,
+	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x72, 0x61, 0x74, 0x65, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x1a, 0x87, 0x06, 0x0a, 0x07, 0x43, 0x41, 0x43, 0x65,
+	0x72, 0x74, 0x73, 0x12, 0xea, 0x02, 0x0a, 0x19, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x6c, 0x5f, 0x63, 0x61, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x42, 0xae, 0x02, 0x82, 0xe2, 0x09, 0x05, 0x0a, 0x03,
+	0xe2, 0x88, 0x85, 0x8a, 0xe2, 0x09, 0xa0, 0x02, 0x12, 0x58, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x20, 0x43, 0x41, 0x20, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x72,
+	0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x6d, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x20, 0x48, 0x54, 0x54,
+	0x50, 0x53, 0x20, 0x6f, 0x72, 0x20, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x20, 0x54, 0x4c, 0x53, 0x2d,
+	0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x1a, 0x67, 0x54, 0x68, 0x65, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x20, 0x6d, 0x75,
+	0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x50, 0x45, 0x4d, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x20,
+	0x61, 0x6e, 0x64, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x20, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x20,
+	0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x61, 0x20, 0x73, 0x65, 0x74, 0x20, 0x6f, 0x66,
+	0x20, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x2d, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x20,
+	0x50, 0x45, 0x4d, 0x20, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x1a, 0x5b, 0x41, 0x6c, 0x6c,
+	0x20, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x20, 0x6f, 0x66,
+	0x20, 0x61, 0x6c, 0x6c, 0x20, 0x73, 0x75, 0x63, 0x68, 0x20, 0x50, 0x45, 0x4d, 0x20, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x74, 0x68, 0x65, 0x6e, 0x20, 0x61, 0x64,
+	0x64, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x72, 0x75, 0x73, 0x74,
+	0x65, 0x64, 0x20, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x20,
+	0x66, 0x6f, 0x72, 0x20, 0x54, 0x4c, 0x53, 0x2e, 0x52, 0x16, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x43, 0x61, 0x43, 0x65, 0x72, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x12, 0x8c, 0x02, 0x0a, 0x17, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f,
+	0x63, 0x61, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x6d, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0c, 0x42, 0xd4, 0x01, 0x82, 0xe2, 0x09, 0x02, 0x10, 0x01, 0x8a, 0xe2, 0x09, 0xc9, 0x01,
+	0x12, 0x2f, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x20, 0x63, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x50, 0x45, 0x4d, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x1a, 0x44, 0x54, 0x68, 0x69, 0x73, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x63, 0x61,
+	0x6e, 0x20, 0x62, 0x65, 0x20, 0x73, 0x65, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x20, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x69, 0x73, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x50, 0x45,
+	0x4d, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x1a, 0x50, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c,
+	0x65, 0x20, 0x50, 0x45, 0x4d, 0x20, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x20, 0x61, 0x72, 0x65,
+	0x20, 0x70, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x20, 0x73, 0x6f, 0x20, 0x74, 0x68, 0x61,
+	0x74, 0x20, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x20, 0x63, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x62, 0x65, 0x20, 0x73,
+	0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2e, 0x52, 0x14, 0x61, 0x64, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x43, 0x61, 0x43, 0x65, 0x72, 0x74, 0x73, 0x50, 0x65, 0x6d, 0x12,
+	0x31, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0x82, 0xe2, 0x09, 0x04, 0x0a, 0x02,
+	0x32, 0x30, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x73, 0x12, 0x46, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x08, 0x82, 0xe2, 0x09, 0x04, 0x0a, 0x02, 0x32, 0x73, 0x52, 0x0b, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x61, 0x75, 0x73, 0x65, 0x3a, 0x05, 0x9a, 0xe2, 0x09, 0x01,
+	0x2a, 0x1a, 0x64, 0x0a, 0x08, 0x49, 0x50, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x25, 0x0a,
+	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x42, 0x0b,
+	0x82, 0xe2, 0x09, 0x07, 0x0a, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f,
+	0x69, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x09, 0x82, 0xe2, 0x09, 0x05, 0x0a,
+	0x03, 0xe2, 0x88, 0x85, 0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x49, 0x70, 0x73,
+	0x3a, 0x05, 0x9a, 0xe2, 0x09, 0x01, 0x2a, 0x1a, 0xd5, 0x01, 0x0a, 0x0c, 0x52, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x42, 0x0b, 0x82, 0xe2, 0x09, 0x07, 0x0a,
+	0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x3b, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0x82, 0xe2, 0x09, 0x04,
+	0x0a, 0x02, 0x31, 0x73, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1f, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x09, 0x82, 0xe2, 0x09,
+	0x05, 0x0a, 0x03, 0x31, 0x30, 0x30, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x39, 0x0a,
+	0x10, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x70, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0x82, 0xe2, 0x09, 0x0b, 0x0a, 0x09, 0x58,
+	0x2d, 0x52, 0x65, 0x61, 0x6c, 0x2d, 0x49, 0x70, 0x52, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x3a, 0x05, 0x9a, 0xe2, 0x09, 0x01, 0x2a, 0x3a,
+	0x05, 0x9a, 0xe2, 0x09, 0x01, 0x2a, 0x1a, 0x9e, 0x04, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x74, 0x67,
+	0x72, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0e, 0x82, 0xe2, 0x09, 0x0a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x67, 0x72, 0x65,
+	0x73, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x82, 0xe2, 0x09, 0x02, 0x10,
+	0x01, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x2b, 0x0a, 0x08, 0x68,
+	0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0x82,
+	0xe2, 0x09, 0x0b, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x68, 0x6f, 0x73, 0x74, 0x52, 0x08,
+	0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0a, 0x82, 0xe2, 0x09, 0x06, 0x0a, 0x04, 0x35, 0x34,
+	0x33, 0x32, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x22, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x82, 0xe2, 0x09, 0x0a, 0x0a, 0x08, 0x70, 0x6f,
+	0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x0b,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x10, 0x82, 0xe2, 0x09, 0x0c, 0x0a, 0x0a, 0x6d, 0x65, 0x78, 0x2c, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x2c, 0x0a, 0x08, 0x73, 0x73, 0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x11, 0x82, 0xe2, 0x09, 0x0d, 0x0a, 0x0b, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x2d,
+	0x66, 0x75, 0x6c, 0x6c, 0x52, 0x07, 0x73, 0x73, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x39, 0x0a,
+	0x13, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0x82, 0xe2, 0x09, 0x04,
+	0x0a, 0x02, 0x31, 0x30, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x4e, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0x82,
-	0xe2, 0x09, 0x04, 0x0a, 0x02, 0x31, 0x73, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
-	0x1f, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x09,
-	0x82, 0xe2, 0x09, 0x05, 0x0a, 0x03, 0x31, 0x30, 0x30, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x12, 0x39, 0x0a, 0x10, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x70, 0x5f, 0x68, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0x82, 0xe2, 0x09, 0x0b,
-	0x0a, 0x09, 0x58, 0x2d, 0x52, 0x65, 0x61, 0x6c, 0x2d, 0x49, 0x70, 0x52, 0x0e, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x49, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x3a, 0x05, 0x9a, 0xe2, 0x09,
-	0x01, 0x2a, 0x3a, 0x05, 0x9a, 0xe2, 0x09, 0x01, 0x2a, 0x1a, 0x9e, 0x04, 0x0a, 0x08, 0x50, 0x6f,
-	0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x82, 0xe2, 0x09, 0x0a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x74,
-	0x67, 0x72, 0x65, 0x73, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x08, 0x70, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x82, 0xe2,
-	0x09, 0x02, 0x10, 0x01, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x2b,
-	0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0f, 0x82, 0xe2, 0x09, 0x0b, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x68, 0x6f, 0x73,
-	0x74, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x70,
-	0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0a, 0x82, 0xe2, 0x09, 0x06, 0x0a,
-	0x04, 0x35, 0x34, 0x33, 0x32, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x22, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x82, 0xe2, 0x09, 0x0a, 0x0a,
-	0x08, 0x70, 0x6f, 0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x31, 0x0a, 0x0b, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x06,
-	0x20, 0x03, 0x28, 0x09, 0x42, 0x10, 0x82, 0xe2, 0x09, 0x0c, 0x0a, 0x0a, 0x6d, 0x65, 0x78, 0x2c,
-	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x61,
-	0x74, 0x68, 0x12, 0x2c, 0x0a, 0x08, 0x73, 0x73, 0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x82, 0xe2, 0x09, 0x0d, 0x0a, 0x0b, 0x76, 0x65, 0x72, 0x69,
-	0x66, 0x79, 0x2d, 0x66, 0x75, 0x6c, 0x6c, 0x52, 0x07, 0x73, 0x73, 0x6c, 0x4d, 0x6f, 0x64, 0x65,
-	0x12, 0x39, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61,
-	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0x82,
-	0xe2, 0x09, 0x04, 0x0a, 0x02, 0x31, 0x30, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x4e, 0x0a, 0x10, 0x63,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x42, 0x08, 0x82, 0xe2, 0x09, 0x04, 0x0a, 0x02, 0x32, 0x73, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x73,
-	0x6c, 0x6f, 0x77, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0b,
-	0x82, 0xe2, 0x09, 0x07, 0x0a, 0x05, 0x32, 0x30, 0x30, 0x6d, 0x73, 0x52, 0x0d, 0x73, 0x6c, 0x6f,
-	0x77, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x3a, 0x1e, 0x9a, 0xe2, 0x09, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x9a, 0xe2, 0x09, 0x05, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x9a, 0xe2, 0x09, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x1a, 0xd1, 0x04, 0x0a, 0x04, 0x53,
-	0x6f, 0x6c, 0x72, 0x12, 0x33, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x1b, 0x82, 0xe2, 0x09, 0x17, 0x0a, 0x15, 0x68, 0x74, 0x74, 0x70, 0x3a,
-	0x2f, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x68, 0x6f, 0x73, 0x74, 0x3a, 0x38, 0x39, 0x38, 0x33,
-	0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x29, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0x82, 0xe2,
-	0x09, 0x05, 0x0a, 0x03, 0x6d, 0x65, 0x78, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x65, 0x74,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x82, 0xe2, 0x09,
-	0x09, 0x0a, 0x07, 0x6d, 0x65, 0x78, 0x5f, 0x72, 0x6b, 0x69, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x13, 0x63, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0x82, 0xe2, 0x09, 0x04, 0x0a, 0x02, 0x31, 0x30,
-	0x52, 0x12, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65,
-	0x6d, 0x70, 0x74, 0x73, 0x12, 0x4e, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0x82, 0xe2, 0x09, 0x04, 0x0a,
-	0x02, 0x32, 0x73, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50,
-	0x61, 0x75, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x61, 0x73, 0x69, 0x63, 0x61, 0x75, 0x74,
-	0x68, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x61,
-	0x73, 0x69, 0x63, 0x61, 0x75, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x12, 0x62,
-	0x61, 0x73, 0x69, 0x63, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0x82, 0xe2, 0x09, 0x02, 0x10, 0x01, 0x9a,
-	0xe2, 0x09, 0x02, 0x08, 0x01, 0x52, 0x11, 0x62, 0x61, 0x73, 0x69, 0x63, 0x61, 0x75, 0x74, 0x68,
-	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x33, 0x0a, 0x10, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0d, 0x42, 0x09, 0x82, 0xe2, 0x09, 0x05, 0x0a, 0x03, 0x31, 0x30, 0x30, 0x52, 0x0e, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x4c, 0x0a,
-	0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
-	0x0c, 0x82, 0xe2, 0x09, 0x08, 0x0a, 0x06, 0x31, 0x30, 0x30, 0x30, 0x6d, 0x73, 0x52, 0x0c, 0x63,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x72,
-	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f,
-	0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x3a, 0x12, 0x9a, 0xe2, 0x09, 0x05,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x9a, 0xe2, 0x09, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x1a, 0xd6,
-	0x03, 0x0a, 0x05, 0x52, 0x65, 0x64, 0x69, 0x73, 0x12, 0x2b, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0x82, 0xe2, 0x09, 0x0b,
-	0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x68, 0x6f, 0x73, 0x74, 0x52, 0x08, 0x68, 0x6f, 0x73,
-	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0d, 0x42, 0x0a, 0x82, 0xe2, 0x09, 0x06, 0x0a, 0x04, 0x36, 0x33, 0x37, 0x39, 0x52,
-	0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x22, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x82, 0xe2, 0x09, 0x02, 0x10, 0x01, 0x52,
-	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x17, 0x0a, 0x02, 0x64, 0x62, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0x82, 0xe2, 0x09, 0x03, 0x0a, 0x01, 0x31, 0x52, 0x02,
-	0x64, 0x62, 0x12, 0x39, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x42,
-	0x08, 0x82, 0xe2, 0x09, 0x04, 0x0a, 0x02, 0x31, 0x30, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x4e, 0x0a,
-	0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x75, 0x73,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x42, 0x08, 0x82, 0xe2, 0x09, 0x04, 0x0a, 0x02, 0x32, 0x73, 0x52, 0x0f, 0x63, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x12, 0x5a, 0x0a,
-	0x15, 0x73, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f,
-	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0b, 0x82, 0xe2, 0x09, 0x07, 0x0a, 0x05, 0x32,
-	0x30, 0x30, 0x6d, 0x73, 0x52, 0x13, 0x73, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x47, 0x72,
-	0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x24, 0x0a, 0x07, 0x75, 0x73, 0x65,
-	0x5f, 0x74, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x42, 0x0b, 0x82, 0xe2, 0x09, 0x07,
-	0x0a, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x06, 0x75, 0x73, 0x65, 0x54, 0x6c, 0x73, 0x12,
-	0x2f, 0x0a, 0x0e, 0x70, 0x75, 0x62, 0x5f, 0x73, 0x75, 0x62, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69,
-	0x78, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0x82, 0xe2, 0x09, 0x05, 0x0a, 0x03, 0x6d,
-	0x65, 0x78, 0x52, 0x0c, 0x70, 0x75, 0x62, 0x53, 0x75, 0x62, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
-	0x3a, 0x05, 0x9a, 0xe2, 0x09, 0x01, 0x2a, 0x1a, 0xc7, 0x09, 0x0a, 0x05, 0x4f, 0x41, 0x75, 0x74,
-	0x68, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2a,
-	0x0a, 0x11, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6f,
-	0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x63, 0x0a, 0x1e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
-	0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e,
-	0x92, 0xe2, 0x09, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x92, 0xe2, 0x09, 0x05,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x92, 0xe2, 0x09, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x52, 0x1b,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x64, 0x34,
-	0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x1a, 0xfe, 0x06, 0x0a, 0x06, 0x53, 0x65, 0x72,
+	0xe2, 0x09, 0x04, 0x0a, 0x02, 0x32, 0x73, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x73, 0x6c, 0x6f, 0x77,
+	0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0b, 0x82, 0xe2, 0x09,
+	0x07, 0x0a, 0x05, 0x32, 0x30, 0x30, 0x6d, 0x73, 0x52, 0x0d, 0x73, 0x6c, 0x6f, 0x77, 0x54, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x3a, 0x1e, 0x9a, 0xe2, 0x09, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x9a, 0xe2, 0x09, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x9a, 0xe2,
+	0x09, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x1a, 0xa5, 0x07, 0x0a, 0x04, 0x53, 0x6f, 0x6c, 0x72,
+	0x12, 0x33, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x1b, 0x82, 0xe2, 0x09, 0x17, 0x0a, 0x15, 0x68, 0x74, 0x74, 0x70, 0x3a, 0x2f, 0x2f, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x68, 0x6f, 0x73, 0x74, 0x3a, 0x38, 0x39, 0x38, 0x33, 0x52, 0x06, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x29, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0x82, 0xe2, 0x09, 0x05, 0x0a,
+	0x03, 0x6d, 0x65, 0x78, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x34, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x65, 0x74, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x82, 0xe2, 0x09, 0x09, 0x0a, 0x07,
+	0x6d, 0x65, 0x78, 0x5f, 0x72, 0x6b, 0x69, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73,
+	0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x08, 0x82, 0xe2, 0x09, 0x04, 0x0a, 0x02, 0x31, 0x30, 0x52, 0x12, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x73, 0x12, 0x4e, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x70, 0x61, 0x75, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0x82, 0xe2, 0x09, 0x04, 0x0a, 0x02, 0x32, 0x73,
+	0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x75, 0x73,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x61, 0x73, 0x69, 0x63, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x61, 0x73, 0x69, 0x63,
+	0x61, 0x75, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x12, 0x62, 0x61, 0x73, 0x69,
+	0x63, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0x82, 0xe2, 0x09, 0x02, 0x10, 0x01, 0x9a, 0xe2, 0x09, 0x02,
+	0x08, 0x01, 0x52, 0x11, 0x62, 0x61, 0x73, 0x69, 0x63, 0x61, 0x75, 0x74, 0x68, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x33, 0x0a, 0x10, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x42,
+	0x09, 0x82, 0xe2, 0x09, 0x05, 0x0a, 0x03, 0x31, 0x30, 0x30, 0x52, 0x0e, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0c, 0x82, 0xe2,
+	0x09, 0x08, 0x0a, 0x06, 0x31, 0x30, 0x30, 0x30, 0x6d, 0x73, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0xa9, 0x01, 0x0a, 0x0a, 0x62, 0x6c, 0x75, 0x65,
+	0x5f, 0x67, 0x72, 0x65, 0x65, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x42, 0x89, 0x01, 0x82,
+	0xe2, 0x09, 0x07, 0x0a, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x8a, 0xe2, 0x09, 0x7a, 0x12, 0x78,
+	0x49, 0x66, 0x20, 0x74, 0x72, 0x75, 0x65, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x20,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x20, 0x61, 0x20, 0x53, 0x6f, 0x6c, 0x72, 0x20, 0x61, 0x6c, 0x69,
+	0x61, 0x73, 0x3b, 0x20, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x72, 0x65, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x73, 0x20, 0x66, 0x69, 0x6c, 0x6c, 0x20, 0x61, 0x20, 0x6e, 0x65, 0x77, 0x20, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x74, 0x68, 0x65,
+	0x6e, 0x20, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x61, 0x6c, 0x69,
+	0x61, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x69, 0x74, 0x52, 0x09, 0x62, 0x6c, 0x75, 0x65, 0x47, 0x72,
+	0x65, 0x65, 0x6e, 0x12, 0xa5, 0x01, 0x0a, 0x14, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64,
+	0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x72, 0x82, 0xe2, 0x09, 0x03, 0x0a, 0x01, 0x31, 0x8a, 0xe2, 0x09, 0x67, 0x12,
+	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x20, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20,
+	0x6b, 0x65, 0x70, 0x74, 0x20, 0x61, 0x66, 0x74, 0x65, 0x72, 0x20, 0x61, 0x20, 0x62, 0x6c, 0x75,
+	0x65, 0x2f, 0x67, 0x72, 0x65, 0x65, 0x6e, 0x20, 0x72, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2c,
+	0x20, 0x74, 0x6f, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x61, 0x6c,
+	0x69, 0x61, 0x73, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x62, 0x65, 0x20, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x64, 0x20, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x13, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x12, 0x9a, 0xe2, 0x09,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x9a, 0xe2, 0x09, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x1a,
+	0xd6, 0x03, 0x0a, 0x05, 0x52, 0x65, 0x64, 0x69, 0x73, 0x12, 0x2b, 0x0a, 0x08, 0x68, 0x6f, 0x73,
+	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0x82, 0xe2, 0x09,
+	0x0b, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x68, 0x6f, 0x73, 0x74, 0x52, 0x08, 0x68, 0x6f,
+	0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x0a, 0x82, 0xe2, 0x09, 0x06, 0x0a, 0x04, 0x36, 0x33, 0x37, 0x39,
+	0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x22, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x82, 0xe2, 0x09, 0x02, 0x10, 0x01,
+	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x17, 0x0a, 0x02, 0x64, 0x62,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0x82, 0xe2, 0x09, 0x03, 0x0a, 0x01, 0x31, 0x52,
+	0x02, 0x64, 0x62, 0x12, 0x39, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x42, 0x08, 0x82, 0xe2, 0x09, 0x04, 0x0a, 0x02, 0x31, 0x30, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x4e,
+	0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x08, 0x82, 0xe2, 0x09, 0x04, 0x0a, 0x02, 0x32, 0x73, 0x52, 0x0f, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x12, 0x5a,
+	0x0a, 0x15, 0x73, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x67, 0x72, 0x61, 0x63, 0x65,
+	0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0b, 0x82, 0xe2, 0x09, 0x07, 0x0a, 0x05,
+	0x32, 0x30, 0x30, 0x6d, 0x73, 0x52, 0x13, 0x73, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x47,
+	0x72, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x24, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x5f, 0x74, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x42, 0x0b, 0x82, 0xe2, 0x09,
+	0x07, 0x0a, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x06, 0x75, 0x73, 0x65, 0x54, 0x6c, 0x73,
+	0x12, 0x2f, 0x0a, 0x0e, 0x70, 0x75, 0x62, 0x5f, 0x73, 0x75, 0x62, 0x5f, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0x82, 0xe2, 0x09, 0x05, 0x0a, 0x03,
+	0x6d, 0x65, 0x78, 0x52, 0x0c, 0x70, 0x75, 0x62, 0x53, 0x75, 0x62, 0x50, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x3a, 0x05, 0x9a, 0xe2, 0x09, 0x01, 0x2a, 0x1a, 0xea, 0x14, 0x0a, 0x05, 0x4f, 0x41, 0x75,
+	0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x2a, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x5f, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x63,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x63, 0x0a, 0x1e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x1e, 0x92, 0xe2, 0x09, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x92, 0xe2, 0x09,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x92, 0xe2, 0x09, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x1b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x06,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x64,
+	0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0xbc, 0x02, 0x0a, 0x14, 0x72, 0x65,
+	0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x74,
+	0x74, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0xee, 0x01, 0x82, 0xe2, 0x09, 0x05, 0x0a, 0x03, 0x33, 0x30, 0x73, 0x8a,
+	0xe2, 0x09, 0xc2, 0x01, 0x12, 0xbf, 0x01, 0x48, 0x6f, 0x77, 0x20, 0x6c, 0x6f, 0x6e, 0x67, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x72, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x20, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x20, 0x69, 0x73, 0x20, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64,
+	0x20, 0x28, 0x30, 0x73, 0x3a, 0x20, 0x6e, 0x6f, 0x20, 0x63, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67,
+	0x29, 0x3b, 0x20, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x20, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x20, 0x77, 0x68,
+	0x65, 0x6e, 0x20, 0x61, 0x20, 0x72, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20,
+	0x69, 0x73, 0x20, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x64, 0x2c, 0x20, 0x73, 0x6f,
+	0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x62, 0x6f, 0x75, 0x6e, 0x64,
+	0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x20, 0x69, 0x66, 0x20, 0x61,
+	0x6e, 0x20, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x69,
+	0x73, 0x20, 0x6c, 0x6f, 0x73, 0x74, 0x92, 0xe2, 0x09, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x92, 0xe2, 0x09, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x92, 0xe2, 0x09, 0x05, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x12, 0x72, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x74, 0x6c, 0x1a, 0xe2, 0x0f, 0x0a, 0x06, 0x53, 0x65, 0x72,
 	0x76, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x08, 0x42, 0x0b, 0x82, 0xe2, 0x09, 0x07, 0x0a, 0x05, 0x66, 0x61, 0x6c, 0x73,
 	0x65, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x0e, 0x63, 0x6c,
@@ -2939,32 +3777,103 @@ var file_shared_cfg_mexcfg_proto_rawDesc = []byte{
 	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09,
 	0x82, 0xe2, 0x09, 0x05, 0x0a, 0x03, 0x31, 0x32, 0x68, 0x52, 0x14, 0x72, 0x65, 0x66, 0x72, 0x65,
-	0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x3a,
-	0x08, 0x9a, 0xe2, 0x09, 0x04, 0x61, 0x75, 0x74, 0x68, 0x3a, 0x26, 0x9a, 0xe2, 0x09, 0x04, 0x61,
-	0x75, 0x74, 0x68, 0x9a, 0xe2, 0x09, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x9a,
-	0xe2, 0x09, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x9a, 0xe2, 0x09, 0x05, 0x71, 0x75, 0x65, 0x72,
-	0x79, 0x1a, 0x28, 0x0a, 0x07, 0x43, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
-	0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x55, 0x72, 0x69, 0x1a, 0x6f, 0x0a, 0x0b, 0x45,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x09, 0x72, 0x65,
-	0x70, 0x6f, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
-	0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f,
-	0x54, 0x79, 0x70, 0x65, 0x42, 0x0c, 0x82, 0xe2, 0x09, 0x08, 0x0a, 0x06, 0x43, 0x41, 0x43, 0x48,
-	0x45, 0x44, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x3a, 0x1e, 0x9a, 0xe2,
-	0x09, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x9a, 0xe2, 0x09, 0x05, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x9a, 0xe2, 0x09, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x6d, 0x0a, 0x09,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x65, 0x66, 0x73, 0x12, 0x40, 0x0a, 0x09, 0x72, 0x65, 0x70,
-	0x6f, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x64,
-	0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x54,
-	0x79, 0x70, 0x65, 0x42, 0x0c, 0x82, 0xe2, 0x09, 0x08, 0x0a, 0x06, 0x43, 0x41, 0x43, 0x48, 0x45,
-	0x44, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x3a, 0x1e, 0x9a, 0xe2, 0x09,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x9a, 0xe2, 0x09, 0x05, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x9a, 0xe2, 0x09, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x5b, 0x0a, 0x0c, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x40, 0x0a, 0x09, 0x72,
-	0x65, 0x70, 0x6f, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
-	0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x52, 0x65, 0x70,
-	0x6f, 0x54, 0x79, 0x70, 0x65, 0x42, 0x0c, 0x82, 0xe2, 0x09, 0x08, 0x0a, 0x06, 0x43, 0x41, 0x43,
-	0x48, 0x45, 0x44, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x3a, 0x09, 0x9a,
+	0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12,
+	0x48, 0x0a, 0x08, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e,
+	0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x08, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x1a, 0x97, 0x08, 0x0a, 0x08, 0x55, 0x70,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x8a, 0x01, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x72, 0x8a, 0xe2, 0x09, 0x6e, 0x12, 0x6c, 0x49,
+	0x73, 0x73, 0x75, 0x65, 0x72, 0x20, 0x55, 0x52, 0x4c, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x6e, 0x20,
+	0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x20, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x44, 0x20,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x3b, 0x20, 0x69, 0x66, 0x20, 0x73, 0x65, 0x74, 0x2c, 0x20, 0x75, 0x73, 0x65, 0x72, 0x73, 0x20,
+	0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x20, 0x66, 0x6c, 0x6f, 0x77, 0x20, 0x6c,
+	0x6f, 0x67, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x72, 0x65, 0x52, 0x06, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x2b, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x82, 0xe2, 0x09, 0x02, 0x10, 0x01, 0x52,
+	0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x95, 0x01,
+	0x0a, 0x0c, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x72, 0x8a, 0xe2, 0x09, 0x6e, 0x12, 0x6c, 0x43, 0x61, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x20, 0x55, 0x52, 0x4c, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x61, 0x75, 0x74, 0x68, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x61, 0x73, 0x20,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x20, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x20, 0x28, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x69, 0x6e,
+	0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x30, 0x2f, 0x6f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x63,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x29, 0x52, 0x0b, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x55, 0x72, 0x69, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1a, 0x82, 0xe2, 0x09, 0x16, 0x0a, 0x14, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x64, 0x2c, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2c, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x81, 0x01, 0x0a, 0x0b, 0x72, 0x6f,
+	0x6c, 0x65, 0x73, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x60, 0x82, 0xe2, 0x09, 0x08, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x8a, 0xe2, 0x09,
+	0x50, 0x12, 0x4e, 0x49, 0x44, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x20, 0x77, 0x68, 0x6f, 0x73, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x61,
+	0x72, 0x65, 0x20, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x4d, 0x45, 0x78, 0x20, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x20, 0x61, 0x6e,
+	0x64, 0x20, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x52, 0x0a, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x3d, 0x0a,
+	0x15, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x42, 0x09, 0x82, 0xe2,
+	0x09, 0x05, 0x0a, 0x03, 0xe2, 0x88, 0x85, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65,
+	0x72, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x9e, 0x01, 0x0a,
+	0x15, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x42, 0x6a, 0x82, 0xe2,
+	0x09, 0x05, 0x0a, 0x03, 0xe2, 0x88, 0x85, 0x8a, 0xe2, 0x09, 0x5d, 0x12, 0x5b, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x69,
+	0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x20,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x3b, 0x20, 0x69, 0x66, 0x20, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2c,
+	0x20, 0x61, 0x6c, 0x6c, 0x20, 0x75, 0x73, 0x65, 0x72, 0x73, 0x20, 0x61, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x20, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x20, 0x67, 0x65, 0x74, 0x20, 0x69, 0x74, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x65, 0x72, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0xac, 0x01,
+	0x0a, 0x14, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x42, 0x7a, 0x82, 0xe2,
+	0x09, 0x07, 0x0a, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x8a, 0xe2, 0x09, 0x6b, 0x12, 0x69, 0x49,
+	0x66, 0x20, 0x74, 0x72, 0x75, 0x65, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x61, 0x64, 0x64,
+	0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x4d, 0x45, 0x78, 0x20, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x2c, 0x20, 0x65, 0x2e, 0x67, 0x2e, 0x20, 0x74, 0x6f, 0x20, 0x62, 0x65, 0x20, 0x6d, 0x61,
+	0x70, 0x70, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x65, 0x64, 0x20, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x12, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72,
+	0x64, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x4b, 0x0a, 0x0e,
+	0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x09, 0x82, 0xe2, 0x09, 0x05, 0x0a, 0x03, 0x31, 0x30, 0x6d, 0x52, 0x0d, 0x6c, 0x6f, 0x67, 0x69,
+	0x6e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x3a, 0x08, 0x9a, 0xe2, 0x09, 0x04, 0x61,
+	0x75, 0x74, 0x68, 0x3a, 0x08, 0x9a, 0xe2, 0x09, 0x04, 0x61, 0x75, 0x74, 0x68, 0x3a, 0x26, 0x9a,
+	0xe2, 0x09, 0x04, 0x61, 0x75, 0x74, 0x68, 0x9a, 0xe2, 0x09, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x9a, 0xe2, 0x09, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x9a, 0xe2, 0x09, 0x05,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x28, 0x0a, 0x07, 0x43, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x55, 0x72, 0x69, 0x1a,
+	0x6f, 0x0a, 0x0b, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x40,
+	0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x15, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e,
+	0x52, 0x65, 0x70, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x42, 0x0c, 0x82, 0xe2, 0x09, 0x08, 0x0a, 0x06,
+	0x43, 0x41, 0x43, 0x48, 0x45, 0x44, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x54, 0x79, 0x70, 0x65,
+	0x3a, 0x1e, 0x9a, 0xe2, 0x09, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x9a, 0xe2,
+	0x09, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x9a, 0xe2, 0x09, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x1a, 0x6d, 0x0a, 0x09, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x65, 0x66, 0x73, 0x12, 0x40, 0x0a,
+	0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x52,
+	0x65, 0x70, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x42, 0x0c, 0x82, 0xe2, 0x09, 0x08, 0x0a, 0x06, 0x43,
+	0x41, 0x43, 0x48, 0x45, 0x44, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x3a,
+	0x1e, 0x9a, 0xe2, 0x09, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x9a, 0xe2, 0x09,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x9a, 0xe2, 0x09, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x1a,
+	0x67, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x40, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x15, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67,
+	0x2e, 0x52, 0x65, 0x70, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x42, 0x0c, 0x82, 0xe2, 0x09, 0x08, 0x0a,
+	0x06, 0x43, 0x41, 0x43, 0x48, 0x45, 0x44, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x54, 0x79, 0x70,
+	0x65, 0x3a, 0x15, 0x9a, 0xe2, 0x09, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x9a,
 	0xe2, 0x09, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x1a, 0xd9, 0x01, 0x0a, 0x04, 0x4a, 0x77, 0x6b,
 	0x73, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x73,
 	0x5f, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x6f,
@@ -2986,260 +3895,583 @@ var file_shared_cfg_mexcfg_proto_rawDesc = []byte{
 	0x04, 0x0a, 0x02, 0x35, 0x6d, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x3a, 0x1f, 0x9a, 0xe2, 0x09, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x9a,
 	0xe2, 0x09, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x9a, 0xe2, 0x09, 0x06, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x1a, 0x70, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x6f, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65,
-	0x72, 0x12, 0x4a, 0x0a, 0x0e, 0x73, 0x65, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0x82, 0xe2, 0x09, 0x04, 0x0a, 0x02, 0x35, 0x6d, 0x52, 0x0d,
-	0x73, 0x65, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x15, 0x9a,
-	0xe2, 0x09, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x9a, 0xe2, 0x09, 0x05, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x1a, 0xc6, 0x01, 0x0a, 0x08, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x69, 0x6e,
-	0x67, 0x12, 0xab, 0x01, 0x0a, 0x1f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6c, 0x67, 0x6f,
-	0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x64, 0x34,
-	0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x67, 0x6f,
-	0x72, 0x69, 0x74, 0x68, 0x6d, 0x42, 0x39, 0x82, 0xe2, 0x09, 0x35, 0x0a, 0x0b, 0x4c, 0x41, 0x54,
-	0x45, 0x53, 0x54, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x1a, 0x26, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43,
-	0x45, 0x53, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x45, 0x54,
-	0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x52, 0x49, 0x54, 0x48, 0x4d,
-	0x52, 0x1d, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x3a,
-	0x0c, 0x9a, 0xe2, 0x09, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0xad, 0x02,
-	0x0a, 0x07, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x30, 0x0a, 0x0e, 0x6c, 0x6f, 0x67,
-	0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0a, 0x82, 0xe2, 0x09, 0x06, 0x0a, 0x04, 0x77, 0x61, 0x72, 0x6e, 0x52, 0x0c, 0x6c,
-	0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x47, 0x72, 0x70, 0x63, 0x12, 0x40, 0x0a, 0x16, 0x72,
-	0x65, 0x64, 0x61, 0x63, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x0a, 0x82, 0xe2, 0x09,
-	0x06, 0x0a, 0x04, 0x74, 0x72, 0x75, 0x65, 0x52, 0x14, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x50,
-	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x4a, 0x0a,
-	0x13, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1a, 0x82, 0xe2, 0x09, 0x16,
-	0x0a, 0x14, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
-	0x2c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x52, 0x11, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x30, 0x0a, 0x0d, 0x74, 0x72, 0x61,
-	0x63, 0x65, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x42, 0x0b, 0x82, 0xe2, 0x09, 0x07, 0x0a, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x0c, 0x74,
-	0x72, 0x61, 0x63, 0x65, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x0c, 0x74,
-	0x72, 0x61, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x06, 0x82, 0xe2, 0x09, 0x02, 0x10, 0x01, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65,
-	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x3a, 0x05, 0x9a, 0xe2, 0x09, 0x01, 0x2a, 0x1a, 0xc9, 0x01,
-	0x0a, 0x09, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x5a, 0x0a, 0x16, 0x70,
-	0x69, 0x6e, 0x67, 0x65, 0x72, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x69, 0x67, 0x1a, 0x86, 0x09, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x6f, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x72, 0x12, 0x9c, 0x01, 0x0a, 0x0e, 0x73, 0x65, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0x82, 0xe2, 0x09, 0x05, 0x0a, 0x03, 0x31, 0x35,
-	0x73, 0x52, 0x14, 0x70, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x59, 0x0a, 0x16, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
-	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x42, 0x08, 0x82, 0xe2, 0x09, 0x04, 0x0a, 0x02, 0x33, 0x73, 0x52, 0x14, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
-	0x61, 0x6c, 0x3a, 0x05, 0x9a, 0xe2, 0x09, 0x01, 0x2a, 0x1a, 0x40, 0x0a, 0x04, 0x41, 0x75, 0x74,
-	0x68, 0x12, 0x2c, 0x0a, 0x0e, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x5f, 0x72, 0x6f,
-	0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x06, 0x82, 0xe2, 0x09, 0x02, 0x10,
-	0x01, 0x52, 0x0c, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x3a,
-	0x0a, 0x9a, 0xe2, 0x09, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0xe0, 0x07, 0x0a, 0x0a,
-	0x53, 0x74, 0x72, 0x69, 0x63, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x40, 0x0a, 0x06, 0x73, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x64, 0x34, 0x6c,
-	0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x63, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x2e, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x63, 0x0a, 0x13,
-	0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x72, 0x73,
-	0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x64, 0x34, 0x6c, 0x2e,
-	0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x63, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x63, 0x74, 0x4a, 0x53, 0x4f, 0x4e, 0x50, 0x61, 0x72, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x11,
-	0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x4a, 0x73, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x73, 0x69, 0x6e,
-	0x67, 0x1a, 0xaa, 0x01, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x98, 0x01, 0x0a,
-	0x19, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61,
-	0x6c, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x42, 0x5c, 0x82, 0xe2, 0x09, 0x06, 0x0a, 0x04, 0x74, 0x72, 0x75, 0x65, 0x8a, 0xe2, 0x09, 0x4e,
-	0x12, 0x4c, 0x49, 0x66, 0x20, 0x74, 0x72, 0x75, 0x65, 0x2c, 0x20, 0x63, 0x65, 0x72, 0x74, 0x61,
-	0x69, 0x6e, 0x20, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x20, 0x66, 0x61, 0x69, 0x6c, 0x75,
-	0x72, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x53, 0x6f, 0x6c, 0x72, 0x20, 0x73, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x20, 0x64, 0x6f, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x63, 0x61, 0x75, 0x73, 0x65, 0x20,
-	0x61, 0x20, 0x35, 0x30, 0x30, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x17,
-	0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x46,
-	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x3a, 0x05, 0x9a, 0xe2, 0x09, 0x01, 0x2a, 0x1a, 0xfd,
-	0x04, 0x0a, 0x11, 0x53, 0x74, 0x72, 0x69, 0x63, 0x74, 0x4a, 0x53, 0x4f, 0x4e, 0x50, 0x61, 0x72,
-	0x73, 0x69, 0x6e, 0x67, 0x12, 0x74, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x42, 0x60, 0x82, 0xe2, 0x09, 0x07, 0x0a, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x8a,
-	0xe2, 0x09, 0x51, 0x12, 0x4f, 0x49, 0x66, 0x20, 0x74, 0x72, 0x75, 0x65, 0x2c, 0x20, 0x75, 0x6e,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x5a, 0x82, 0xe2, 0x09, 0x04, 0x0a, 0x02, 0x35, 0x6d,
+	0x8a, 0xe2, 0x09, 0x4e, 0x12, 0x4c, 0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x3a, 0x20, 0x6e, 0x6f, 0x20, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x72, 0x20, 0x75, 0x73, 0x65, 0x64,
+	0x2c, 0x20, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x64, 0x20, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x20, 0x69,
+	0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x6f, 0x75, 0x74, 0x62,
+	0x6f, 0x78, 0x52, 0x0d, 0x73, 0x65, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0xbf, 0x01, 0x0a, 0x14, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x5f, 0x70, 0x6f, 0x6c,
+	0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x72, 0x82, 0xe2, 0x09,
+	0x05, 0x0a, 0x03, 0x33, 0x30, 0x73, 0x8a, 0xe2, 0x09, 0x65, 0x12, 0x63, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x20, 0x69, 0x6e, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x20, 0x69,
+	0x73, 0x20, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2c, 0x20, 0x69,
+	0x6e, 0x20, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x12, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x50, 0x6f, 0x6c, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x12, 0x63, 0x0a, 0x11, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x5f, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x37,
+	0x82, 0xe2, 0x09, 0x05, 0x0a, 0x03, 0x31, 0x30, 0x30, 0x8a, 0xe2, 0x09, 0x2a, 0x12, 0x28, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x20,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x20, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x20,
+	0x61, 0x74, 0x20, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x0f, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x13, 0x6f, 0x75, 0x74,
+	0x62, 0x6f, 0x78, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x56, 0x82, 0xe2, 0x09, 0x04, 0x0a, 0x02, 0x31, 0x30,
+	0x8a, 0xe2, 0x09, 0x4a, 0x12, 0x48, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x73, 0x20, 0x61, 0x66, 0x74, 0x65, 0x72, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x20, 0x61, 0x6e,
+	0x20, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x20, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x20, 0x69, 0x73,
+	0x20, 0x64, 0x65, 0x61, 0x64, 0x2d, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x65, 0x64, 0x52, 0x11,
+	0x6f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x4d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x73, 0x12, 0xb5, 0x01, 0x0a, 0x14, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x5f, 0x72, 0x65, 0x74,
+	0x72, 0x79, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x68, 0x82, 0xe2, 0x09,
+	0x05, 0x0a, 0x03, 0x31, 0x30, 0x73, 0x8a, 0xe2, 0x09, 0x5b, 0x12, 0x59, 0x44, 0x65, 0x6c, 0x61,
+	0x79, 0x20, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x69, 0x72,
+	0x73, 0x74, 0x20, 0x72, 0x65, 0x74, 0x72, 0x79, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x20, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x20, 0x65, 0x6e, 0x74, 0x72,
+	0x79, 0x3b, 0x20, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x64, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20,
+	0x65, 0x76, 0x65, 0x72, 0x79, 0x20, 0x66, 0x75, 0x72, 0x74, 0x68, 0x65, 0x72, 0x20, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x12, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x52, 0x65, 0x74,
+	0x72, 0x79, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x12, 0x8f, 0x01, 0x0a, 0x18, 0x6f, 0x75,
+	0x74, 0x62, 0x6f, 0x78, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x62,
+	0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x3b, 0x82, 0xe2, 0x09, 0x04, 0x0a, 0x02, 0x31,
+	0x68, 0x8a, 0xe2, 0x09, 0x2f, 0x12, 0x2d, 0x55, 0x70, 0x70, 0x65, 0x72, 0x20, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x20,
+	0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x20, 0x74, 0x77, 0x6f, 0x20, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x73, 0x52, 0x15, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x4d, 0x61, 0x78, 0x52,
+	0x65, 0x74, 0x72, 0x79, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x12, 0xc6, 0x01, 0x0a, 0x0c,
+	0x6f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x5f, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x87, 0x01,
+	0x82, 0xe2, 0x09, 0x04, 0x0a, 0x02, 0x35, 0x6d, 0x8a, 0xe2, 0x09, 0x7b, 0x12, 0x79, 0x54, 0x69,
+	0x6d, 0x65, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x20, 0x63, 0x6c, 0x61,
+	0x69, 0x6d, 0x65, 0x64, 0x20, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x20, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x20, 0x66,
+	0x72, 0x6f, 0x6d, 0x20, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x20, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x73, 0x3b, 0x20, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61,
+	0x20, 0x63, 0x72, 0x61, 0x73, 0x68, 0x65, 0x64, 0x20, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x20, 0x61, 0x72, 0x65, 0x20, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x64, 0x20, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x4c,
+	0x65, 0x61, 0x73, 0x65, 0x3a, 0x15, 0x9a, 0xe2, 0x09, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x9a, 0xe2, 0x09, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x1a, 0xc6, 0x01, 0x0a, 0x08,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x69, 0x6e, 0x67, 0x12, 0xab, 0x01, 0x0a, 0x1f, 0x64, 0x75, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x28, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67,
+	0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x42, 0x39, 0x82, 0xe2,
+	0x09, 0x35, 0x0a, 0x0b, 0x4c, 0x41, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x1a,
+	0x26, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x53, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43,
+	0x41, 0x54, 0x45, 0x5f, 0x44, 0x45, 0x54, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x4c,
+	0x47, 0x4f, 0x52, 0x49, 0x54, 0x48, 0x4d, 0x52, 0x1d, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x67,
+	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x3a, 0x0c, 0x9a, 0xe2, 0x09, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x1a, 0xd4, 0x02, 0x0a, 0x09, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x7d, 0x0a, 0x11, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x63, 0x68, 0x75,
+	0x6e, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x51, 0x82,
+	0xe2, 0x09, 0x05, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x8a, 0xe2, 0x09, 0x44, 0x12, 0x42, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x20, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x67, 0x65, 0x74, 0x68, 0x65, 0x72,
+	0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
+	0x67, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x20, 0x69, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0xb9, 0x01, 0x0a, 0x13, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6e, 0x82, 0xe2, 0x09, 0x04,
+	0x0a, 0x02, 0x31, 0x6d, 0x8a, 0xe2, 0x09, 0x62, 0x12, 0x60, 0x4d, 0x61, 0x78, 0x69, 0x6d, 0x75,
+	0x6d, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x61, 0x20, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x20, 0x6f,
+	0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x20,
+	0x69, 0x74, 0x65, 0x6d, 0x20, 0x69, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x77,
+	0x61, 0x69, 0x74, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x20, 0x6c, 0x6f, 0x63, 0x6b, 0x20, 0x68, 0x65, 0x6c, 0x64, 0x20, 0x62, 0x79, 0x20,
+	0x6f, 0x74, 0x68, 0x65, 0x72, 0x20, 0x6a, 0x6f, 0x62, 0x73, 0x52, 0x11, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x4c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x3a, 0x0c, 0x9a,
+	0xe2, 0x09, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0xad, 0x02, 0x0a, 0x07,
+	0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x30, 0x0a, 0x0e, 0x6c, 0x6f, 0x67, 0x5f, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0a, 0x82, 0xe2, 0x09, 0x06, 0x0a, 0x04, 0x77, 0x61, 0x72, 0x6e, 0x52, 0x0c, 0x6c, 0x6f, 0x67,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x47, 0x72, 0x70, 0x63, 0x12, 0x40, 0x0a, 0x16, 0x72, 0x65, 0x64,
+	0x61, 0x63, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x0a, 0x82, 0xe2, 0x09, 0x06, 0x0a,
+	0x04, 0x74, 0x72, 0x75, 0x65, 0x52, 0x14, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x50, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x4a, 0x0a, 0x13, 0x72,
+	0x65, 0x64, 0x61, 0x63, 0x74, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1a, 0x82, 0xe2, 0x09, 0x16, 0x0a, 0x14,
+	0x63, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x2c, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x11, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x30, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x63, 0x65,
+	0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42, 0x0b,
+	0x82, 0xe2, 0x09, 0x07, 0x0a, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x0c, 0x74, 0x72, 0x61,
+	0x63, 0x65, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x0c, 0x74, 0x72, 0x61,
+	0x63, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x06, 0x82, 0xe2, 0x09, 0x02, 0x10, 0x01, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x3a, 0x05, 0x9a, 0xe2, 0x09, 0x01, 0x2a, 0x1a, 0xc9, 0x01, 0x0a, 0x09,
+	0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x5a, 0x0a, 0x16, 0x70, 0x69, 0x6e,
+	0x67, 0x65, 0x72, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0x82, 0xe2, 0x09, 0x05, 0x0a, 0x03, 0x31, 0x35, 0x73, 0x52,
+	0x14, 0x70, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x59, 0x0a, 0x16, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x08, 0x82, 0xe2, 0x09, 0x04, 0x0a, 0x02, 0x33, 0x73, 0x52, 0x14, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x3a, 0x05, 0x9a, 0xe2, 0x09, 0x01, 0x2a, 0x1a, 0x40, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12,
+	0x2c, 0x0a, 0x0e, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x5f, 0x72, 0x6f, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x06, 0x82, 0xe2, 0x09, 0x02, 0x10, 0x01, 0x52,
+	0x0c, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x3a, 0x0a, 0x9a,
+	0xe2, 0x09, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0xe0, 0x07, 0x0a, 0x0a, 0x53, 0x74,
+	0x72, 0x69, 0x63, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x40, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d,
+	0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x63, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x63, 0x0a, 0x13, 0x73, 0x74,
+	0x72, 0x69, 0x63, 0x74, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x72, 0x73, 0x69, 0x6e,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65,
+	0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x63, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x63,
+	0x74, 0x4a, 0x53, 0x4f, 0x4e, 0x50, 0x61, 0x72, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x11, 0x73, 0x74,
+	0x72, 0x69, 0x63, 0x74, 0x4a, 0x73, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x73, 0x69, 0x6e, 0x67, 0x1a,
+	0xaa, 0x01, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x98, 0x01, 0x0a, 0x19, 0x74,
+	0x6f, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x5f,
+	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x42, 0x5c,
+	0x82, 0xe2, 0x09, 0x06, 0x0a, 0x04, 0x74, 0x72, 0x75, 0x65, 0x8a, 0xe2, 0x09, 0x4e, 0x12, 0x4c,
+	0x49, 0x66, 0x20, 0x74, 0x72, 0x75, 0x65, 0x2c, 0x20, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e,
+	0x20, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x20, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x73, 0x20, 0x6f, 0x66, 0x20, 0x53, 0x6f, 0x6c, 0x72, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x20, 0x64, 0x6f, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x63, 0x61, 0x75, 0x73, 0x65, 0x20, 0x61, 0x20,
+	0x35, 0x30, 0x30, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x17, 0x74, 0x6f,
+	0x6c, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x73, 0x3a, 0x05, 0x9a, 0xe2, 0x09, 0x01, 0x2a, 0x1a, 0xfd, 0x04, 0x0a,
+	0x11, 0x53, 0x74, 0x72, 0x69, 0x63, 0x74, 0x4a, 0x53, 0x4f, 0x4e, 0x50, 0x61, 0x72, 0x73, 0x69,
+	0x6e, 0x67, 0x12, 0x74, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x60, 0x82, 0xe2, 0x09, 0x07, 0x0a, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x8a, 0xe2, 0x09,
+	0x51, 0x12, 0x4f, 0x49, 0x66, 0x20, 0x74, 0x72, 0x75, 0x65, 0x2c, 0x20, 0x75, 0x6e, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6e, 0x20, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x20, 0x69,
+	0x6e, 0x20, 0x64, 0x61, 0x74, 0x61, 0x20, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x64, 0x20, 0x62,
+	0x79, 0x20, 0x61, 0x75, 0x74, 0x68, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x77,
+	0x69, 0x6c, 0x6c, 0x20, 0x63, 0x61, 0x75, 0x73, 0x65, 0x20, 0x61, 0x6e, 0x20, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x7a, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x62, 0x82, 0xe2, 0x09, 0x07, 0x0a, 0x05,
+	0x66, 0x61, 0x6c, 0x73, 0x65, 0x8a, 0xe2, 0x09, 0x53, 0x12, 0x51, 0x49, 0x66, 0x20, 0x74, 0x72,
+	0x75, 0x65, 0x2c, 0x20, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x20, 0x70, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x64, 0x61, 0x74, 0x61, 0x20, 0x68,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x77, 0x69, 0x6c, 0x6c, 0x20, 0x63, 0x61,
+	0x75, 0x73, 0x65, 0x20, 0x61, 0x6e, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x76, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x42, 0x60, 0x82, 0xe2, 0x09, 0x06, 0x0a, 0x04, 0x74, 0x72, 0x75, 0x65, 0x8a,
+	0xe2, 0x09, 0x52, 0x12, 0x50, 0x49, 0x66, 0x20, 0x74, 0x72, 0x75, 0x65, 0x2c, 0x20, 0x75, 0x6e,
 	0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x20, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
 	0x20, 0x69, 0x6e, 0x20, 0x64, 0x61, 0x74, 0x61, 0x20, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x64,
-	0x20, 0x62, 0x79, 0x20, 0x61, 0x75, 0x74, 0x68, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x20, 0x77, 0x69, 0x6c, 0x6c, 0x20, 0x63, 0x61, 0x75, 0x73, 0x65, 0x20, 0x61, 0x6e, 0x20, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x7a, 0x0a, 0x06, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x62, 0x82, 0xe2, 0x09, 0x07,
-	0x0a, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x8a, 0xe2, 0x09, 0x53, 0x12, 0x51, 0x49, 0x66, 0x20,
-	0x74, 0x72, 0x75, 0x65, 0x2c, 0x20, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x20, 0x70, 0x72,
-	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x64, 0x61, 0x74, 0x61,
-	0x20, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x77, 0x69, 0x6c, 0x6c, 0x20,
-	0x63, 0x61, 0x75, 0x73, 0x65, 0x20, 0x61, 0x6e, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x76, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x60, 0x82, 0xe2, 0x09, 0x06, 0x0a, 0x04, 0x74, 0x72, 0x75,
-	0x65, 0x8a, 0xe2, 0x09, 0x52, 0x12, 0x50, 0x49, 0x66, 0x20, 0x74, 0x72, 0x75, 0x65, 0x2c, 0x20,
-	0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x20, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
-	0x65, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x64, 0x61, 0x74, 0x61, 0x20, 0x68, 0x61, 0x6e, 0x64, 0x6c,
-	0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x20, 0x77, 0x69, 0x6c, 0x6c, 0x20, 0x63, 0x61, 0x75, 0x73, 0x65, 0x20, 0x61,
-	0x6e, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x7f,
-	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x42, 0x63, 0x82, 0xe2, 0x09, 0x06, 0x0a, 0x04, 0x74, 0x72, 0x75, 0x65, 0x8a, 0xe2, 0x09, 0x55,
-	0x12, 0x53, 0x49, 0x66, 0x20, 0x74, 0x72, 0x75, 0x65, 0x2c, 0x20, 0x75, 0x6e, 0x6b, 0x6e, 0x6f,
-	0x77, 0x6e, 0x20, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x20, 0x69, 0x6e,
-	0x20, 0x64, 0x61, 0x74, 0x61, 0x20, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x64, 0x20, 0x62, 0x79,
-	0x20, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x20, 0x62, 0x79, 0x20, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
 	0x65, 0x20, 0x77, 0x69, 0x6c, 0x6c, 0x20, 0x63, 0x61, 0x75, 0x73, 0x65, 0x20, 0x61, 0x6e, 0x20,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
-	0x76, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x60,
-	0x82, 0xe2, 0x09, 0x06, 0x0a, 0x04, 0x74, 0x72, 0x75, 0x65, 0x8a, 0xe2, 0x09, 0x52, 0x12, 0x50,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x7f, 0x0a, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42, 0x63,
+	0x82, 0xe2, 0x09, 0x06, 0x0a, 0x04, 0x74, 0x72, 0x75, 0x65, 0x8a, 0xe2, 0x09, 0x55, 0x12, 0x53,
 	0x49, 0x66, 0x20, 0x74, 0x72, 0x75, 0x65, 0x2c, 0x20, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
 	0x20, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x64,
-	0x61, 0x74, 0x61, 0x20, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x77, 0x69, 0x6c,
-	0x6c, 0x20, 0x63, 0x61, 0x75, 0x73, 0x65, 0x20, 0x61, 0x6e, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x3a, 0x05, 0x9a, 0xe2, 0x09, 0x01, 0x2a, 0x1a, 0x8f,
-	0x04, 0x0a, 0x06, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x4d, 0x0a, 0x0c, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x18, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x45, 0x6d,
-	0x61, 0x69, 0x6c, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x42, 0x10, 0x82, 0xe2, 0x09, 0x0c, 0x0a,
-	0x0a, 0x4d, 0x4f, 0x43, 0x4b, 0x4d, 0x41, 0x49, 0x4c, 0x45, 0x52, 0x52, 0x0b, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x48, 0x0a, 0x0a, 0x66, 0x6c, 0x6f, 0x77,
-	0x6d, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x20, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20,
+	0x77, 0x69, 0x6c, 0x6c, 0x20, 0x63, 0x61, 0x75, 0x73, 0x65, 0x20, 0x61, 0x6e, 0x20, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x76, 0x0a,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x60, 0x82, 0xe2,
+	0x09, 0x06, 0x0a, 0x04, 0x74, 0x72, 0x75, 0x65, 0x8a, 0xe2, 0x09, 0x52, 0x12, 0x50, 0x49, 0x66,
+	0x20, 0x74, 0x72, 0x75, 0x65, 0x2c, 0x20, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x20, 0x70,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x64, 0x61, 0x74,
+	0x61, 0x20, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x77, 0x69, 0x6c, 0x6c, 0x20,
+	0x63, 0x61, 0x75, 0x73, 0x65, 0x20, 0x61, 0x6e, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x3a, 0x05, 0x9a, 0xe2, 0x09, 0x01, 0x2a, 0x1a, 0xa1, 0x04, 0x0a,
+	0x06, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x4d, 0x0a, 0x0c, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e,
+	0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x42, 0x10, 0x82, 0xe2, 0x09, 0x0c, 0x0a, 0x0a, 0x4d,
+	0x4f, 0x43, 0x4b, 0x4d, 0x41, 0x49, 0x4c, 0x45, 0x52, 0x52, 0x0b, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x48, 0x0a, 0x0a, 0x66, 0x6c, 0x6f, 0x77, 0x6d, 0x61,
+	0x69, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x64, 0x34, 0x6c,
+	0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x6d, 0x61,
+	0x69, 0x6c, 0x65, 0x72, 0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x77, 0x6d, 0x61, 0x69, 0x6c, 0x65, 0x72,
+	0x1a, 0xe6, 0x02, 0x0a, 0x0a, 0x46, 0x6c, 0x6f, 0x77, 0x6d, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x12,
+	0x45, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x6f, 0x61, 0x75, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0x82, 0xe2, 0x09, 0x1e, 0x0a, 0x1c, 0x68, 0x74, 0x74,
+	0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x6d,
+	0x61, 0x69, 0x6c, 0x65, 0x72, 0x2e, 0x6e, 0x65, 0x74, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x12, 0x3f, 0x0a, 0x0a, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x5f, 0x61, 0x70, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x82, 0xe2, 0x09, 0x1c,
+	0x0a, 0x1a, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x61, 0x70, 0x69, 0x2e, 0x66, 0x6c,
+	0x6f, 0x77, 0x6d, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x2e, 0x6e, 0x65, 0x74, 0x52, 0x09, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x41, 0x70, 0x69, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x82, 0xe2, 0x09,
+	0x02, 0x10, 0x01, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x50, 0x0a, 0x15, 0x6e, 0x6f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x1c, 0x82, 0xe2, 0x09, 0x18, 0x0a, 0x16, 0x6e, 0x6f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x40, 0x64,
+	0x61, 0x74, 0x61, 0x34, 0x6c, 0x69, 0x66, 0x65, 0x2e, 0x63, 0x61, 0x72, 0x65, 0x52, 0x13, 0x6e,
+	0x6f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x3a, 0x15, 0x9a, 0xe2, 0x09, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x9a, 0xe2, 0x09, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x3a, 0x15, 0x9a, 0xe2, 0x09, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x9a, 0xe2, 0x09, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x1a, 0x83, 0x07, 0x0a, 0x03, 0x4f, 0x61, 0x69, 0x12, 0x78, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x42, 0x5e, 0x82, 0xe2, 0x09, 0x07, 0x0a,
+	0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x8a, 0xe2, 0x09, 0x4f, 0x12, 0x4d, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x28, 0x75, 0x6e, 0x61, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x29, 0x20, 0x4f, 0x41, 0x49, 0x2d, 0x50, 0x4d,
+	0x48, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x68,
+	0x61, 0x72, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x6f,
+	0x63, 0x61, 0x6c, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x32, 0x0a, 0x0f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0x82, 0xe2, 0x09,
+	0x05, 0x0a, 0x03, 0x4d, 0x45, 0x78, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x5d, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x42, 0x8a, 0xe2, 0x09, 0x3e, 0x12, 0x3c,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x20, 0x55, 0x52, 0x4c, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x4f, 0x41, 0x49, 0x2d, 0x50, 0x4d, 0x48, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x20, 0x61, 0x73, 0x20, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x20, 0x74,
+	0x6f, 0x20, 0x68, 0x61, 0x72, 0x76, 0x65, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x07, 0x62, 0x61,
+	0x73, 0x65, 0x55, 0x72, 0x6c, 0x12, 0xb4, 0x01, 0x0a, 0x15, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x7f, 0x82, 0xe2, 0x09, 0x05, 0x0a, 0x03, 0x6d, 0x65, 0x78,
+	0x8a, 0xe2, 0x09, 0x72, 0x12, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x20,
+	0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x20, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x2c, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68,
+	0x20, 0x68, 0x61, 0x76, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x20, 0x6f,
+	0x61, 0x69, 0x3a, 0x3c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x20, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x3e, 0x3a, 0x3c, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x20, 0x74, 0x79, 0x70, 0x65, 0x3e, 0x3a, 0x3c, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65,
+	0x73, 0x73, 0x20, 0x49, 0x44, 0x3e, 0x52, 0x14, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x0c,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x1c, 0x82, 0xe2, 0x09, 0x18, 0x0a, 0x16, 0x6e, 0x6f, 0x72, 0x65, 0x70, 0x6c,
+	0x79, 0x40, 0x64, 0x61, 0x74, 0x61, 0x34, 0x6c, 0x69, 0x66, 0x65, 0x2e, 0x63, 0x61, 0x72, 0x65,
+	0x52, 0x0b, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x73, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
+	0x42, 0x56, 0x82, 0xe2, 0x09, 0x05, 0x0a, 0x03, 0x31, 0x30, 0x30, 0x8a, 0xe2, 0x09, 0x49, 0x12,
+	0x47, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x70, 0x65, 0x72, 0x20,
+	0x70, 0x61, 0x67, 0x65, 0x20, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x20, 0x61, 0x20, 0x72, 0x65,
+	0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x69,
+	0x73, 0x20, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0xf3, 0x01, 0x0a, 0x10, 0x68, 0x61, 0x72, 0x76, 0x65, 0x73, 0x74, 0x65, 0x72,
+	0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x42, 0xc7, 0x01,
+	0x82, 0xe2, 0x09, 0x05, 0x0a, 0x03, 0xe2, 0x88, 0x85, 0x8a, 0xe2, 0x09, 0xb9, 0x01, 0x12, 0xb6,
+	0x01, 0x49, 0x44, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x20, 0x77, 0x68, 0x6f, 0x73, 0x65, 0x20, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x20, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x20,
+	0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x28, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75,
+	0x73, 0x29, 0x20, 0x68, 0x61, 0x72, 0x76, 0x65, 0x73, 0x74, 0x65, 0x72, 0x73, 0x3b, 0x20, 0x69,
+	0x66, 0x20, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x20, 0x72, 0x75, 0x6c,
+	0x65, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65,
+	0x64, 0x2c, 0x20, 0x68, 0x61, 0x72, 0x76, 0x65, 0x73, 0x74, 0x65, 0x72, 0x73, 0x20, 0x73, 0x65,
+	0x65, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x20, 0x72, 0x75, 0x6c, 0x65,
+	0x20, 0x6f, 0x66, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x73, 0x65,
+	0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x0f, 0x68, 0x61, 0x72, 0x76, 0x65, 0x73, 0x74,
+	0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x3a, 0x0c, 0x9a, 0xe2, 0x09, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0xef, 0x05, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0xb6, 0x01, 0x0a, 0x13, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x64, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x42, 0x85, 0x01, 0x82, 0xe2, 0x09, 0x07, 0x0a, 0x05, 0x66,
+	0x61, 0x6c, 0x73, 0x65, 0x8a, 0xe2, 0x09, 0x76, 0x12, 0x74, 0x49, 0x66, 0x20, 0x74, 0x72, 0x75,
+	0x65, 0x2c, 0x20, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x20, 0x6d, 0x61, 0x79,
+	0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x6f, 0x72, 0x20,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x20, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x6d, 0x73, 0x65, 0x6c, 0x76,
+	0x65, 0x73, 0x20, 0x6f, 0x72, 0x20, 0x62, 0x79, 0x20, 0x61, 0x20, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x20, 0x6f, 0x66, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x69,
+	0x72, 0x20, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x11,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x73, 0x12, 0x8b, 0x01, 0x0a, 0x0c, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x68, 0x82, 0xe2, 0x09, 0x05, 0x0a, 0x03,
+	0xe2, 0x88, 0x85, 0x8a, 0xe2, 0x09, 0x5b, 0x12, 0x59, 0x49, 0x44, 0x73, 0x20, 0x6f, 0x66, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x28, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x29, 0x20, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x20, 0x73, 0x68, 0x61, 0x72, 0x65, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x20, 0x6f, 0x66, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x69, 0x72, 0x20, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x52, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12,
+	0x8f, 0x03, 0x0a, 0x10, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x72,
+	0x75, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0xe3, 0x02, 0x8a, 0xe2, 0x09,
+	0xde, 0x02, 0x0a, 0x19, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x20, 0x72,
+	0x75, 0x6c, 0x65, 0x73, 0x20, 0x62, 0x79, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0xc0, 0x02,
+	0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x20, 0x72, 0x65, 0x73, 0x74,
+	0x72, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x20, 0x6f, 0x66,
+	0x20, 0x61, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x73, 0x65, 0x65,
+	0x2c, 0x20, 0x65, 0x2e, 0x67, 0x2e, 0x20, 0x7b, 0x22, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x22, 0x3a, 0x20, 0x7b, 0x22, 0x3c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x49,
+	0x44, 0x3e, 0x22, 0x3a, 0x20, 0x7b, 0x22, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x22, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22,
+	0x5d, 0x2c, 0x20, 0x22, 0x68, 0x69, 0x65, 0x72, 0x61, 0x72, 0x63, 0x68, 0x79, 0x41, 0x78, 0x69,
+	0x73, 0x22, 0x3a, 0x20, 0x22, 0x75, 0x6e, 0x69, 0x74, 0x22, 0x2c, 0x20, 0x22, 0x73, 0x75, 0x62,
+	0x74, 0x72, 0x65, 0x65, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x22, 0x3c, 0x75, 0x6e, 0x69, 0x74, 0x20,
+	0x49, 0x44, 0x3e, 0x22, 0x5d, 0x7d, 0x7d, 0x7d, 0x3b, 0x20, 0x75, 0x73, 0x65, 0x72, 0x73, 0x20,
+	0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x2f, 0x72, 0x65, 0x61, 0x64, 0x61, 0x6c, 0x6c, 0x20, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c,
+	0x65, 0x67, 0x65, 0x20, 0x77, 0x68, 0x6f, 0x20, 0x61, 0x72, 0x65, 0x20, 0x69, 0x6e, 0x20, 0x61,
+	0x74, 0x20, 0x6c, 0x65, 0x61, 0x73, 0x74, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x73, 0x75, 0x63, 0x68,
+	0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x73, 0x65, 0x65, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x69,
+	0x6e, 0x67, 0x20, 0x61, 0x20, 0x72, 0x75, 0x6c, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x6f, 0x6e, 0x65,
+	0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x69, 0x72, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x52, 0x0f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x3a, 0x05, 0x9a, 0xe2, 0x09, 0x01, 0x2a, 0x1a, 0xeb, 0x05, 0x0a, 0x0d, 0x53, 0x61, 0x76,
+	0x65, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x12, 0xbc, 0x01, 0x0a, 0x15, 0x6e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x42, 0x86, 0x01, 0x82, 0xe2, 0x09,
+	0x06, 0x0a, 0x04, 0x74, 0x72, 0x75, 0x65, 0x8a, 0xe2, 0x09, 0x78, 0x12, 0x76, 0x49, 0x66, 0x20,
+	0x74, 0x72, 0x75, 0x65, 0x2c, 0x20, 0x73, 0x61, 0x76, 0x65, 0x64, 0x20, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x65, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x72, 0x65, 0x2d, 0x65, 0x76, 0x61, 0x6c,
+	0x75, 0x61, 0x74, 0x65, 0x64, 0x20, 0x61, 0x66, 0x74, 0x65, 0x72, 0x20, 0x65, 0x61, 0x63, 0x68,
+	0x20, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x61, 0x6e,
+	0x64, 0x20, 0x74, 0x68, 0x65, 0x69, 0x72, 0x20, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x20, 0x61,
+	0x72, 0x65, 0x20, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x6e,
+	0x65, 0x77, 0x6c, 0x79, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x20, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x52, 0x14, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0xc9, 0x01, 0x0a, 0x15, 0x6e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x93, 0x01, 0x82, 0xe2, 0x09, 0x1b,
+	0x0a, 0x19, 0x73, 0x61, 0x76, 0x65, 0x64, 0x2d, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2d, 0x6e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x8a, 0xe2, 0x09, 0x70, 0x12,
+	0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6d, 0x61, 0x69,
+	0x6c, 0x20, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x20, 0x28, 0x69, 0x6e, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x20, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72,
+	0x20, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x29,
+	0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x6e, 0x65, 0x77, 0x6c, 0x79,
+	0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x52,
+	0x14, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x65, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x61, 0x76,
+	0x65, 0x64, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x37, 0x82, 0xe2, 0x09, 0x04, 0x0a, 0x02, 0x35, 0x30, 0x8a, 0xe2, 0x09, 0x2b, 0x12,
+	0x29, 0x4d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20,
+	0x6f, 0x66, 0x20, 0x73, 0x61, 0x76, 0x65, 0x64, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65,
+	0x73, 0x20, 0x70, 0x65, 0x72, 0x20, 0x75, 0x73, 0x65, 0x72, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x53,
+	0x61, 0x76, 0x65, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x12, 0xdc, 0x01, 0x0a,
+	0x10, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x61,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x95, 0x01, 0x82, 0xe2, 0x09, 0x05, 0x0a, 0x03, 0x36, 0x30, 0x73, 0x8a, 0xe2,
+	0x09, 0x87, 0x01, 0x12, 0x84, 0x01, 0x54, 0x69, 0x6d, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x77, 0x61,
+	0x69, 0x74, 0x20, 0x61, 0x66, 0x74, 0x65, 0x72, 0x20, 0x61, 0x6e, 0x20, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x73, 0x61, 0x76, 0x65, 0x64, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x65, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x72, 0x65, 0x2d, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x65, 0x64, 0x2c, 0x20, 0x73, 0x6f, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x62, 0x75, 0x72,
+	0x73, 0x74, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x20, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65,
+	0x64, 0x20, 0x74, 0x6f, 0x67, 0x65, 0x74, 0x68, 0x65, 0x72, 0x52, 0x0f, 0x65, 0x76, 0x61, 0x6c,
+	0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x3a, 0x09, 0x9a, 0xe2, 0x09,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x1a, 0xb4, 0x14, 0x0a, 0x08, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x12, 0x58, 0x0a, 0x10, 0x62, 0x69, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
+	0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x42,
+	0x49, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0e, 0x62,
+	0x69, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x3b, 0x0a,
+	0x05, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64,
 	0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x2e, 0x46, 0x6c, 0x6f, 0x77,
-	0x6d, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x77, 0x6d, 0x61, 0x69, 0x6c,
-	0x65, 0x72, 0x1a, 0xdd, 0x02, 0x0a, 0x0a, 0x46, 0x6c, 0x6f, 0x77, 0x6d, 0x61, 0x69, 0x6c, 0x65,
-	0x72, 0x12, 0x45, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x6f, 0x61, 0x75, 0x74,
-	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0x82, 0xe2, 0x09, 0x1e, 0x0a, 0x1c, 0x68,
-	0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x2e, 0x66, 0x6c, 0x6f,
-	0x77, 0x6d, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x2e, 0x6e, 0x65, 0x74, 0x52, 0x0b, 0x6f, 0x72, 0x69,
-	0x67, 0x69, 0x6e, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x12, 0x3f, 0x0a, 0x0a, 0x6f, 0x72, 0x69, 0x67,
-	0x69, 0x6e, 0x5f, 0x61, 0x70, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x82, 0xe2,
-	0x09, 0x1c, 0x0a, 0x1a, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x61, 0x70, 0x69, 0x2e,
-	0x66, 0x6c, 0x6f, 0x77, 0x6d, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x2e, 0x6e, 0x65, 0x74, 0x52, 0x09,
-	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x41, 0x70, 0x69, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x82,
-	0xe2, 0x09, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63,
-	0x72, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x49, 0x64, 0x12, 0x50, 0x0a, 0x15, 0x6e, 0x6f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x1c, 0x82, 0xe2, 0x09, 0x18, 0x0a, 0x16, 0x6e, 0x6f, 0x72, 0x65, 0x70, 0x6c, 0x79,
-	0x40, 0x64, 0x61, 0x74, 0x61, 0x34, 0x6c, 0x69, 0x66, 0x65, 0x2e, 0x63, 0x61, 0x72, 0x65, 0x52,
-	0x13, 0x6e, 0x6f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x3a, 0x0c, 0x9a, 0xe2, 0x09, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x3a, 0x0c, 0x9a, 0xe2, 0x09, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x1a, 0x96, 0x0a, 0x0a, 0x08, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x58, 0x0a,
-	0x10, 0x62, 0x69, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65,
-	0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x42, 0x49, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0e, 0x62, 0x69, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x62, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x42, 0x6c,
+	0x6f, 0x62, 0x73, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x12, 0x3e, 0x0a, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x64, 0x34, 0x6c,
+	0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x92, 0x02, 0x0a, 0x0e, 0x42,
+	0x49, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x28, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x14, 0x82, 0xe2, 0x09, 0x10, 0x0a, 0x0e, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
+	0xb6, 0x01, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x9d, 0x01, 0x82, 0xe2, 0x09, 0x02, 0x10, 0x01, 0x8a, 0xe2, 0x09, 0x76, 0x0a, 0x1b, 0x42,
+	0x49, 0x20, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x20,
+	0x41, 0x50, 0x49, 0x20, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x1a, 0x57, 0x4e, 0x6f, 0x74, 0x65,
+	0x3a, 0x20, 0x54, 0x68, 0x65, 0x20, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x69, 0x73, 0x20,
+	0x61, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x20, 0x66,
+	0x72, 0x6f, 0x6d, 0x20, 0x61, 0x20, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x20, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x20, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x20, 0x60, 0x61, 0x70, 0x70, 0x73, 0x2f,
+	0x3c, 0x45, 0x4e, 0x56, 0x3e, 0x2f, 0x70, 0x68, 0x64, 0x70, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x60, 0x2e, 0x9a, 0xe2, 0x09, 0x19, 0x12, 0x17, 0x42, 0x49, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x53, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54,
+	0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x3a, 0x05, 0x9a, 0xe2, 0x09, 0x01, 0x2a, 0x1a,
+	0xa7, 0x01, 0x0a, 0x05, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x12, 0x86, 0x01, 0x0a, 0x11, 0x6d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5a, 0x82, 0xe2, 0x09, 0x0c, 0x0a, 0x0a, 0x62, 0x6c, 0x6f,
+	0x62, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x8a, 0xe2, 0x09, 0x46, 0x12, 0x44, 0x54, 0x68, 0x65,
+	0x20, 0x62, 0x6c, 0x6f, 0x62, 0x20, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x20, 0x75, 0x73, 0x65, 0x73,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x61, 0x6d, 0x65, 0x20, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x20, 0x61, 0x73, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64,
+	0x20, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x20, 0x60, 0x44, 0x42, 0x60, 0x20, 0x61, 0x62, 0x6f, 0x76,
+	0x65, 0x52, 0x0f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x3a, 0x15, 0x9a, 0xe2, 0x09, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x9a, 0xe2, 0x09, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x1a, 0x91, 0x0f, 0x0a, 0x06, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x36, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0x92, 0xe2, 0x09, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x92, 0xe2, 0x09, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x92, 0xe2, 0x09, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x22, 0x0a, 0x08,
+	0x65, 0x6e, 0x76, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0x82, 0xe2, 0x09, 0x03, 0x0a, 0x01, 0x2f, 0x52, 0x07, 0x65, 0x6e, 0x76, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x21, 0x0a, 0x08, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x06, 0x82, 0xe2, 0x09, 0x02, 0x10, 0x01, 0x52, 0x07, 0x61, 0x70, 0x69, 0x4b,
+	0x65, 0x79, 0x73, 0x12, 0x45, 0x0a, 0x06, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66,
+	0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x52, 0x06, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x12, 0xf8, 0x01, 0x0a, 0x0e, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0xb5,
+	0x01, 0x82, 0xe2, 0x09, 0x06, 0x0a, 0x04, 0x31, 0x38, 0x30, 0x73, 0x8a, 0xe2, 0x09, 0xa6, 0x01,
+	0x0a, 0x29, 0x4d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x20, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x20, 0x61, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x20, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x20, 0x6d, 0x61, 0x79, 0x20, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x79, 0x49, 0x66, 0x20,
+	0x6e, 0x6f, 0x74, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x20, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x47, 0x52, 0x45, 0x45, 0x4e, 0x20, 0x77, 0x69,
+	0x74, 0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x20, 0x68, 0x61, 0x73, 0x68,
+	0x20, 0x61, 0x66, 0x74, 0x65, 0x72, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x74, 0x69, 0x6d, 0x65,
+	0x2c, 0x20, 0x61, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x20, 0x75, 0x70, 0x64, 0x61, 0x65,
+	0x20, 0x69, 0x73, 0x20, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x72, 0x65, 0x64, 0x20, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x2e, 0x52, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0xe8, 0x01, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72,
+	0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x42, 0xc2, 0x01,
+	0x82, 0xe2, 0x09, 0x06, 0x0a, 0x04, 0x74, 0x72, 0x75, 0x65, 0x8a, 0xe2, 0x09, 0xb3, 0x01, 0x0a,
+	0x1f, 0x52, 0x6f, 0x6c, 0x6c, 0x20, 0x62, 0x61, 0x63, 0x6b, 0x20, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73,
+	0x12, 0x8f, 0x01, 0x49, 0x66, 0x20, 0x61, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x20, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x66, 0x61, 0x69, 0x6c, 0x73, 0x20, 0x6f, 0x72, 0x20, 0x6e,
+	0x6f, 0x74, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x20,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x67, 0x65, 0x20, 0x6f, 0x6e, 0x20, 0x69, 0x74, 0x20, 0x69,
+	0x6e, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6c, 0x61, 0x73, 0x74,
+	0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x20, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x67, 0x65, 0x64, 0x20, 0x6f,
+	0x6e, 0x20, 0x69, 0x73, 0x20, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x20, 0x61, 0x6e,
+	0x64, 0x20, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x64, 0x20, 0x61, 0x67, 0x61, 0x69,
+	0x6e, 0x2e, 0x52, 0x0c, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x12, 0xd1, 0x02, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1d, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x42, 0x99, 0x02, 0x82, 0xe2, 0x09, 0x08, 0x0a, 0x06, 0x47, 0x49, 0x54, 0x48, 0x55, 0x42, 0x8a,
+	0xe2, 0x09, 0x88, 0x02, 0x0a, 0x1f, 0x57, 0x68, 0x65, 0x72, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x20, 0x69, 0x73, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64,
+	0x20, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0xe4, 0x01, 0x47, 0x49, 0x54, 0x48, 0x55, 0x42, 0x20, 0x63,
+	0x6c, 0x6f, 0x6e, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x70, 0x6f, 0x20, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x27, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x27, 0x2c, 0x20, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x20, 0x72, 0x65, 0x61,
+	0x64, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x27,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x27, 0x20, 0x28, 0x61, 0x6e, 0x64, 0x20, 0x72, 0x65, 0x6c, 0x6f,
+	0x61, 0x64, 0x73, 0x20, 0x69, 0x74, 0x20, 0x6f, 0x6e, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x29, 0x2c, 0x20, 0x48, 0x54, 0x54, 0x50, 0x20, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x61, 0x72, 0x62, 0x61, 0x6c, 0x6c, 0x20, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x27, 0x68, 0x74,
+	0x74, 0x70, 0x27, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x4f, 0x43, 0x49, 0x20, 0x70, 0x75, 0x6c, 0x6c,
+	0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x20, 0x61,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x65, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x27, 0x6f, 0x63, 0x69, 0x27, 0x2e, 0x52, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66,
+	0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x12, 0x3f, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78,
 	0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x52, 0x05, 0x62,
-	0x6c, 0x6f, 0x62, 0x73, 0x12, 0x3e, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63,
-	0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x1a, 0x92, 0x02, 0x0a, 0x0e, 0x42, 0x49, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12,
-	0x28, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x82,
-	0xe2, 0x09, 0x10, 0x0a, 0x0e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0xb6, 0x01, 0x0a, 0x06, 0x73, 0x65,
-	0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x9d, 0x01, 0x82, 0xe2, 0x09,
-	0x02, 0x10, 0x01, 0x8a, 0xe2, 0x09, 0x76, 0x0a, 0x1b, 0x42, 0x49, 0x20, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x20, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x20, 0x41, 0x50, 0x49, 0x20, 0x73, 0x65,
-	0x63, 0x72, 0x65, 0x74, 0x1a, 0x57, 0x4e, 0x6f, 0x74, 0x65, 0x3a, 0x20, 0x54, 0x68, 0x65, 0x20,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x69, 0x73, 0x20, 0x61, 0x20, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x20, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x61, 0x20,
-	0x56, 0x61, 0x75, 0x6c, 0x74, 0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x20, 0x73, 0x65, 0x63,
-	0x72, 0x65, 0x74, 0x20, 0x60, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x3c, 0x45, 0x4e, 0x56, 0x3e, 0x2f,
-	0x70, 0x68, 0x64, 0x70, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x60, 0x2e, 0x9a, 0xe2, 0x09,
-	0x19, 0x12, 0x17, 0x42, 0x49, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x53, 0x5f, 0x46, 0x49, 0x4c,
-	0x54, 0x45, 0x52, 0x5f, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72,
-	0x65, 0x74, 0x3a, 0x05, 0x9a, 0xe2, 0x09, 0x01, 0x2a, 0x1a, 0xa7, 0x01, 0x0a, 0x05, 0x42, 0x6c,
-	0x6f, 0x62, 0x73, 0x12, 0x86, 0x01, 0x0a, 0x11, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x5a, 0x82, 0xe2, 0x09, 0x0c, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x8a, 0xe2, 0x09, 0x46, 0x12, 0x44, 0x54, 0x68, 0x65, 0x20, 0x62, 0x6c, 0x6f, 0x62, 0x20,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x20, 0x75, 0x73, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73,
-	0x61, 0x6d, 0x65, 0x20, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x20, 0x61, 0x73, 0x20,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x20, 0x75, 0x6e, 0x64, 0x65, 0x72,
-	0x20, 0x60, 0x44, 0x42, 0x60, 0x20, 0x61, 0x62, 0x6f, 0x76, 0x65, 0x52, 0x0f, 0x6d, 0x61, 0x73,
-	0x74, 0x65, 0x72, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x3a, 0x15, 0x9a, 0xe2,
-	0x09, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x9a, 0xe2, 0x09, 0x05, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x1a, 0xf3, 0x04, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x36,
-	0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e,
-	0x92, 0xe2, 0x09, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x92, 0xe2, 0x09, 0x05,
-	0x71, 0x75, 0x65, 0x72, 0x79, 0x92, 0xe2, 0x09, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x06,
-	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x22, 0x0a, 0x08, 0x65, 0x6e, 0x76, 0x5f, 0x70, 0x61,
-	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0x82, 0xe2, 0x09, 0x03, 0x0a, 0x01,
-	0x2f, 0x52, 0x07, 0x65, 0x6e, 0x76, 0x50, 0x61, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x08, 0x61, 0x70,
-	0x69, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x06, 0x82, 0xe2,
-	0x09, 0x02, 0x10, 0x01, 0x52, 0x07, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x45, 0x0a,
-	0x06, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
-	0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x52, 0x06, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x12, 0xf8, 0x01, 0x0a, 0x0e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0xb5, 0x01, 0x82, 0xe2, 0x09, 0x06, 0x0a,
-	0x04, 0x31, 0x38, 0x30, 0x73, 0x8a, 0xe2, 0x09, 0xa6, 0x01, 0x0a, 0x29, 0x4d, 0x61, 0x78, 0x69,
-	0x6d, 0x75, 0x6d, 0x20, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x61, 0x20, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x6d, 0x61, 0x79,
-	0x20, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x79, 0x49, 0x66, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x61, 0x6c,
-	0x6c, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x20, 0x72, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x20, 0x47, 0x52, 0x45, 0x45, 0x4e, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x74, 0x68, 0x65,
-	0x20, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x20, 0x68, 0x61, 0x73, 0x68, 0x20, 0x61, 0x66, 0x74, 0x65, 0x72,
-	0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x2c, 0x20, 0x61, 0x20, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x20, 0x75, 0x70, 0x64, 0x61, 0x65, 0x20, 0x69, 0x73, 0x20, 0x63, 0x6f,
-	0x6e, 0x73, 0x69, 0x64, 0x65, 0x72, 0x65, 0x64, 0x20, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x2e,
-	0x52, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x1a,
-	0x9b, 0x01, 0x0a, 0x06, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65,
-	0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
-	0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x13, 0x64, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x82, 0xe2, 0x09, 0x06, 0x0a, 0x04, 0x6d, 0x61, 0x69, 0x6e,
-	0x52, 0x11, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x5f, 0x6b, 0x65,
-	0x79, 0x5f, 0x70, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x06, 0x82, 0xe2, 0x09,
-	0x02, 0x10, 0x01, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x4b, 0x65, 0x79, 0x50, 0x65,
-	0x6d, 0x3a, 0x0a, 0x9a, 0xe2, 0x09, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x3a, 0x0a, 0x9a,
-	0xe2, 0x09, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x6a, 0x0a, 0x07, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x05, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x06, 0x82, 0xe2, 0x09, 0x02, 0x20, 0x01, 0x52, 0x05, 0x62, 0x75, 0x69,
-	0x6c, 0x64, 0x12, 0x1a, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x06, 0x82, 0xe2, 0x09, 0x02, 0x20, 0x01, 0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x12, 0x25,
-	0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x06, 0x82, 0xe2, 0x09, 0x02, 0x20, 0x01, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c,
-	0x64, 0x44, 0x61, 0x74, 0x65, 0x2a, 0x3a, 0x0a, 0x1b, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x65, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x67, 0x6f, 0x72,
-	0x69, 0x74, 0x68, 0x6d, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x49, 0x4d, 0x50, 0x4c, 0x45, 0x10, 0x00,
-	0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x41, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10,
-	0x01, 0x2a, 0x22, 0x0a, 0x08, 0x52, 0x65, 0x70, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a,
-	0x06, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x41, 0x43,
-	0x48, 0x45, 0x44, 0x10, 0x01, 0x2a, 0x2d, 0x0a, 0x0b, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x65, 0x72,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x4f, 0x43, 0x4b, 0x4d, 0x41, 0x49, 0x4c,
-	0x45, 0x52, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x46, 0x4c, 0x4f, 0x57, 0x4d, 0x41, 0x49, 0x4c,
-	0x45, 0x52, 0x10, 0x01, 0x42, 0x4a, 0x82, 0xb5, 0x18, 0x09, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x67, 0x65, 0x73, 0x75, 0x6e, 0x64, 0x68, 0x65, 0x69, 0x74, 0x73, 0x63, 0x6c, 0x6f, 0x75, 0x64,
-	0x2f, 0x72, 0x6b, 0x69, 0x2d, 0x6d, 0x65, 0x78, 0x2d, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x2f, 0x6d, 0x65, 0x78, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2f, 0x63, 0x66, 0x67,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x48,
+	0x74, 0x74, 0x70, 0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x12, 0x3c, 0x0a, 0x03, 0x6f, 0x63, 0x69,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78,
+	0x2e, 0x63, 0x66, 0x67, 0x2e, 0x4d, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4f,
+	0x63, 0x69, 0x52, 0x03, 0x6f, 0x63, 0x69, 0x1a, 0x9b, 0x01, 0x0a, 0x06, 0x47, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x3a, 0x0a, 0x13, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x82, 0xe2,
+	0x09, 0x06, 0x0a, 0x04, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x11, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x0e, 0x64,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x70, 0x65, 0x6d, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x42, 0x06, 0x82, 0xe2, 0x09, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x64, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x4b, 0x65, 0x79, 0x50, 0x65, 0x6d, 0x3a, 0x0a, 0x9a, 0xe2, 0x09, 0x06, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0xa5, 0x01, 0x0a, 0x05, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x7c, 0x0a, 0x0d, 0x70, 0x6f, 0x6c, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x3c, 0x82, 0xe2, 0x09, 0x04, 0x0a, 0x02, 0x35, 0x73, 0x8a,
+	0xe2, 0x09, 0x30, 0x0a, 0x2e, 0x48, 0x6f, 0x77, 0x20, 0x6f, 0x66, 0x74, 0x65, 0x6e, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x20, 0x69, 0x73, 0x20,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x52, 0x0c, 0x70, 0x6f, 0x6c, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x3a, 0x0a, 0x9a, 0xe2, 0x09, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x65, 0x0a,
+	0x04, 0x48, 0x74, 0x74, 0x70, 0x12, 0x51, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x3f, 0x8a, 0xe2, 0x09, 0x3b, 0x0a, 0x39, 0x55, 0x52, 0x4c, 0x20, 0x6f, 0x66,
+	0x20, 0x61, 0x20, 0x28, 0x70, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x79, 0x20, 0x67, 0x7a, 0x69,
+	0x70, 0x2d, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x29, 0x20, 0x74, 0x61,
+	0x72, 0x62, 0x61, 0x6c, 0x6c, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x3a, 0x0a, 0x9a, 0xe2, 0x09, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x1a, 0x8a, 0x02, 0x0a, 0x03, 0x4f, 0x63, 0x69, 0x12, 0x63, 0x0a, 0x09,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x45, 0x8a, 0xe2, 0x09, 0x41, 0x0a, 0x3f, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x20,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2c, 0x20, 0x65, 0x2e, 0x67, 0x2e, 0x20,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x65, 0x78, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x3a,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x06, 0x82, 0xe2, 0x09, 0x02, 0x10, 0x01, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x52, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x5f, 0x68, 0x74, 0x74, 0x70, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x42, 0x33, 0x8a, 0xe2, 0x09, 0x2f, 0x0a, 0x2d, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
+	0x20, 0x76, 0x69, 0x61, 0x20, 0x48, 0x54, 0x54, 0x50, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x65, 0x61,
+	0x64, 0x20, 0x6f, 0x66, 0x20, 0x48, 0x54, 0x54, 0x50, 0x53, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x69,
+	0x6e, 0x48, 0x74, 0x74, 0x70, 0x3a, 0x0a, 0x9a, 0xe2, 0x09, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x3a, 0x0a, 0x9a, 0xe2, 0x09, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x6a, 0x0a,
+	0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x05, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x82, 0xe2, 0x09, 0x02, 0x20, 0x01, 0x52,
+	0x05, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x1a, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x63, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x82, 0xe2, 0x09, 0x02, 0x20, 0x01, 0x52, 0x04, 0x64, 0x65,
+	0x73, 0x63, 0x12, 0x25, 0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x82, 0xe2, 0x09, 0x02, 0x20, 0x01, 0x52, 0x09,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x65, 0x2a, 0x3a, 0x0a, 0x1b, 0x44, 0x75, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x49, 0x4d, 0x50,
+	0x4c, 0x45, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x41, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x4f,
+	0x4e, 0x4c, 0x59, 0x10, 0x01, 0x2a, 0x22, 0x0a, 0x08, 0x52, 0x65, 0x70, 0x6f, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a,
+	0x06, 0x43, 0x41, 0x43, 0x48, 0x45, 0x44, 0x10, 0x01, 0x2a, 0x3c, 0x0a, 0x10, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a,
+	0x06, 0x47, 0x49, 0x54, 0x48, 0x55, 0x42, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x4f, 0x43,
+	0x41, 0x4c, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x54, 0x54, 0x50, 0x10, 0x02, 0x12, 0x07,
+	0x0a, 0x03, 0x4f, 0x43, 0x49, 0x10, 0x03, 0x2a, 0x2d, 0x0a, 0x0b, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x4f, 0x43, 0x4b, 0x4d, 0x41,
+	0x49, 0x4c, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x46, 0x4c, 0x4f, 0x57, 0x4d, 0x41,
+	0x49, 0x4c, 0x45, 0x52, 0x10, 0x01, 0x42, 0x3a, 0x82, 0xb5, 0x18, 0x09, 0x4d, 0x65, 0x78, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x64, 0x34, 0x6c, 0x2d, 0x64, 0x61, 0x74, 0x61, 0x34, 0x6c, 0x69, 0x66, 0x65, 0x2f,
+	0x6d, 0x65, 0x78, 0x2f, 0x6d, 0x65, 0x78, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2f, 0x63,
+	0x66, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3254,109 +4486,136 @@ func file_shared_cfg_mexcfg_proto_rawDescGZIP() []byte {
 	return file_shared_cfg_mexcfg_proto_rawDescData
 }
 
-var file_shared_cfg_mexcfg_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_shared_cfg_mexcfg_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_shared_cfg_mexcfg_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_shared_cfg_mexcfg_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
 var file_shared_cfg_mexcfg_proto_goTypes = []interface{}{
 	(DuplicateDetectionAlgorithm)(0),               // 0: d4l.mex.cfg.DuplicateDetectionAlgorithm
 	(RepoType)(0),                                  // 1: d4l.mex.cfg.RepoType
-	(EmailerType)(0),                               // 2: d4l.mex.cfg.EmailerType
-	(*MexConfig)(nil),                              // 3: d4l.mex.cfg.MexConfig
-	(*Version)(nil),                                // 4: d4l.mex.cfg.Version
-	(*MexConfig_Web)(nil),                          // 5: d4l.mex.cfg.MexConfig.Web
-	(*MexConfig_Postgres)(nil),                     // 6: d4l.mex.cfg.MexConfig.Postgres
-	(*MexConfig_Solr)(nil),                         // 7: d4l.mex.cfg.MexConfig.Solr
-	(*MexConfig_Redis)(nil),                        // 8: d4l.mex.cfg.MexConfig.Redis
-	(*MexConfig_OAuth)(nil),                        // 9: d4l.mex.cfg.MexConfig.OAuth
-	(*MexConfig_Codings)(nil),                      // 10: d4l.mex.cfg.MexConfig.Codings
-	(*MexConfig_EntityTypes)(nil),                  // 11: d4l.mex.cfg.MexConfig.EntityTypes
-	(*MexConfig_FieldDefs)(nil),                    // 12: d4l.mex.cfg.MexConfig.FieldDefs
-	(*MexConfig_SearchConfig)(nil),                 // 13: d4l.mex.cfg.MexConfig.SearchConfig
-	(*MexConfig_Jwks)(nil),                         // 14: d4l.mex.cfg.MexConfig.Jwks
-	(*MexConfig_Jobs)(nil),                         // 15: d4l.mex.cfg.MexConfig.Jobs
-	(*MexConfig_AutoIndexer)(nil),                  // 16: d4l.mex.cfg.MexConfig.AutoIndexer
-	(*MexConfig_Indexing)(nil),                     // 17: d4l.mex.cfg.MexConfig.Indexing
-	(*MexConfig_Logging)(nil),                      // 18: d4l.mex.cfg.MexConfig.Logging
-	(*MexConfig_Telemetry)(nil),                    // 19: d4l.mex.cfg.MexConfig.Telemetry
-	(*MexConfig_Auth)(nil),                         // 20: d4l.mex.cfg.MexConfig.Auth
-	(*MexConfig_Strictness)(nil),                   // 21: d4l.mex.cfg.MexConfig.Strictness
-	(*MexConfig_Notify)(nil),                       // 22: d4l.mex.cfg.MexConfig.Notify
-	(*MexConfig_Services)(nil),                     // 23: d4l.mex.cfg.MexConfig.Services
-	(*MexConfig_Web_CACerts)(nil),                  // 24: d4l.mex.cfg.MexConfig.Web.CACerts
-	(*MexConfig_Web_IPFilter)(nil),                 // 25: d4l.mex.cfg.MexConfig.Web.IPFilter
-	(*MexConfig_Web_RateLimiting)(nil),             // 26: d4l.mex.cfg.MexConfig.Web.RateLimiting
-	(*MexConfig_OAuth_Server)(nil),                 // 27: d4l.mex.cfg.MexConfig.OAuth.Server
-	(*MexConfig_Strictness_Search)(nil),            // 28: d4l.mex.cfg.MexConfig.Strictness.Search
-	(*MexConfig_Strictness_StrictJSONParsing)(nil), // 29: d4l.mex.cfg.MexConfig.Strictness.StrictJSONParsing
-	(*MexConfig_Notify_Flowmailer)(nil),            // 30: d4l.mex.cfg.MexConfig.Notify.Flowmailer
-	(*MexConfig_Services_BIEventsFilter)(nil),      // 31: d4l.mex.cfg.MexConfig.Services.BIEventsFilter
-	(*MexConfig_Services_Blobs)(nil),               // 32: d4l.mex.cfg.MexConfig.Services.Blobs
-	(*MexConfig_Services_Config)(nil),              // 33: d4l.mex.cfg.MexConfig.Services.Config
-	(*MexConfig_Services_Config_Github)(nil),       // 34: d4l.mex.cfg.MexConfig.Services.Config.Github
-	(*durationpb.Duration)(nil),                    // 35: google.protobuf.Duration
+	(ConfigSourceType)(0),                          // 2: d4l.mex.cfg.ConfigSourceType
+	(EmailerType)(0),                               // 3: d4l.mex.cfg.EmailerType
+	(*MexConfig)(nil),                              // 4: d4l.mex.cfg.MexConfig
+	(*Version)(nil),                                // 5: d4l.mex.cfg.Version
+	(*MexConfig_Web)(nil),                          // 6: d4l.mex.cfg.MexConfig.Web
+	(*MexConfig_Postgres)(nil),                     // 7: d4l.mex.cfg.MexConfig.Postgres
+	(*MexConfig_Solr)(nil),                         // 8: d4l.mex.cfg.MexConfig.Solr
+	(*MexConfig_Redis)(nil),                        // 9: d4l.mex.cfg.MexConfig.Redis
+	(*MexConfig_OAuth)(nil),                        // 10: d4l.mex.cfg.MexConfig.OAuth
+	(*MexConfig_Codings)(nil),                      // 11: d4l.mex.cfg.MexConfig.Codings
+	(*MexConfig_EntityTypes)(nil),                  // 12: d4l.mex.cfg.MexConfig.EntityTypes
+	(*MexConfig_FieldDefs)(nil),                    // 13: d4l.mex.cfg.MexConfig.FieldDefs
+	(*MexConfig_SearchConfig)(nil),                 // 14: d4l.mex.cfg.MexConfig.SearchConfig
+	(*MexConfig_Jwks)(nil),                         // 15: d4l.mex.cfg.MexConfig.Jwks
+	(*MexConfig_Jobs)(nil),                         // 16: d4l.mex.cfg.MexConfig.Jobs
+	(*MexConfig_AutoIndexer)(nil),                  // 17: d4l.mex.cfg.MexConfig.AutoIndexer
+	(*MexConfig_Indexing)(nil),                     // 18: d4l.mex.cfg.MexConfig.Indexing
+	(*MexConfig_Ingestion)(nil),                    // 19: d4l.mex.cfg.MexConfig.Ingestion
+	(*MexConfig_Logging)(nil),                      // 20: d4l.mex.cfg.MexConfig.Logging
+	(*MexConfig_Telemetry)(nil),                    // 21: d4l.mex.cfg.MexConfig.Telemetry
+	(*MexConfig_Auth)(nil),                         // 22: d4l.mex.cfg.MexConfig.Auth
+	(*MexConfig_Strictness)(nil),                   // 23: d4l.mex.cfg.MexConfig.Strictness
+	(*MexConfig_Notify)(nil),                       // 24: d4l.mex.cfg.MexConfig.Notify
+	(*MexConfig_Oai)(nil),                          // 25: d4l.mex.cfg.MexConfig.Oai
+	(*MexConfig_AccessControl)(nil),                // 26: d4l.mex.cfg.MexConfig.AccessControl
+	(*MexConfig_SavedSearches)(nil),                // 27: d4l.mex.cfg.MexConfig.SavedSearches
+	(*MexConfig_Services)(nil),                     // 28: d4l.mex.cfg.MexConfig.Services
+	(*MexConfig_Web_CACerts)(nil),                  // 29: d4l.mex.cfg.MexConfig.Web.CACerts
+	(*MexConfig_Web_IPFilter)(nil),                 // 30: d4l.mex.cfg.MexConfig.Web.IPFilter
+	(*MexConfig_Web_RateLimiting)(nil),             // 31: d4l.mex.cfg.MexConfig.Web.RateLimiting
+	(*MexConfig_OAuth_Server)(nil),                 // 32: d4l.mex.cfg.MexConfig.OAuth.Server
+	(*MexConfig_OAuth_Server_Upstream)(nil),        // 33: d4l.mex.cfg.MexConfig.OAuth.Server.Upstream
+	(*MexConfig_Strictness_Search)(nil),            // 34: d4l.mex.cfg.MexConfig.Strictness.Search
+	(*MexConfig_Strictness_StrictJSONParsing)(nil), // 35: d4l.mex.cfg.MexConfig.Strictness.StrictJSONParsing
+	(*MexConfig_Notify_Flowmailer)(nil),            // 36: d4l.mex.cfg.MexConfig.Notify.Flowmailer
+	(*MexConfig_Services_BIEventsFilter)(nil),      // 37: d4l.mex.cfg.MexConfig.Services.BIEventsFilter
+	(*MexConfig_Services_Blobs)(nil),               // 38: d4l.mex.cfg.MexConfig.Services.Blobs
+	(*MexConfig_Services_Config)(nil),              // 39: d4l.mex.cfg.MexConfig.Services.Config
+	(*MexConfig_Services_Config_Github)(nil),       // 40: d4l.mex.cfg.MexConfig.Services.Config.Github
+	(*MexConfig_Services_Config_Local)(nil),        // 41: d4l.mex.cfg.MexConfig.Services.Config.Local
+	(*MexConfig_Services_Config_Http)(nil),         // 42: d4l.mex.cfg.MexConfig.Services.Config.Http
+	(*MexConfig_Services_Config_Oci)(nil),          // 43: d4l.mex.cfg.MexConfig.Services.Config.Oci
+	(*durationpb.Duration)(nil),                    // 44: google.protobuf.Duration
 }
 var file_shared_cfg_mexcfg_proto_depIdxs = []int32{
-	4,  // 0: d4l.mex.cfg.MexConfig.version:type_name -> d4l.mex.cfg.Version
-	5,  // 1: d4l.mex.cfg.MexConfig.web:type_name -> d4l.mex.cfg.MexConfig.Web
-	6,  // 2: d4l.mex.cfg.MexConfig.db:type_name -> d4l.mex.cfg.MexConfig.Postgres
-	7,  // 3: d4l.mex.cfg.MexConfig.solr:type_name -> d4l.mex.cfg.MexConfig.Solr
-	8,  // 4: d4l.mex.cfg.MexConfig.redis:type_name -> d4l.mex.cfg.MexConfig.Redis
-	9,  // 5: d4l.mex.cfg.MexConfig.oauth:type_name -> d4l.mex.cfg.MexConfig.OAuth
-	10, // 6: d4l.mex.cfg.MexConfig.codings:type_name -> d4l.mex.cfg.MexConfig.Codings
-	11, // 7: d4l.mex.cfg.MexConfig.entity_types:type_name -> d4l.mex.cfg.MexConfig.EntityTypes
-	12, // 8: d4l.mex.cfg.MexConfig.field_defs:type_name -> d4l.mex.cfg.MexConfig.FieldDefs
-	13, // 9: d4l.mex.cfg.MexConfig.search_config:type_name -> d4l.mex.cfg.MexConfig.SearchConfig
-	14, // 10: d4l.mex.cfg.MexConfig.jwks:type_name -> d4l.mex.cfg.MexConfig.Jwks
-	15, // 11: d4l.mex.cfg.MexConfig.jobs:type_name -> d4l.mex.cfg.MexConfig.Jobs
-	16, // 12: d4l.mex.cfg.MexConfig.auto_indexer:type_name -> d4l.mex.cfg.MexConfig.AutoIndexer
-	17, // 13: d4l.mex.cfg.MexConfig.indexing:type_name -> d4l.mex.cfg.MexConfig.Indexing
-	18, // 14: d4l.mex.cfg.MexConfig.logging:type_name -> d4l.mex.cfg.MexConfig.Logging
-	19, // 15: d4l.mex.cfg.MexConfig.telemetry:type_name -> d4l.mex.cfg.MexConfig.Telemetry
-	20, // 16: d4l.mex.cfg.MexConfig.auth:type_name -> d4l.mex.cfg.MexConfig.Auth
-	23, // 17: d4l.mex.cfg.MexConfig.services:type_name -> d4l.mex.cfg.MexConfig.Services
-	21, // 18: d4l.mex.cfg.MexConfig.strictness:type_name -> d4l.mex.cfg.MexConfig.Strictness
-	22, // 19: d4l.mex.cfg.MexConfig.notify:type_name -> d4l.mex.cfg.MexConfig.Notify
-	35, // 20: d4l.mex.cfg.MexConfig.Web.read_timeout:type_name -> google.protobuf.Duration
-	35, // 21: d4l.mex.cfg.MexConfig.Web.write_timeout:type_name -> google.protobuf.Duration
-	35, // 22: d4l.mex.cfg.MexConfig.Web.idle_timeout:type_name -> google.protobuf.Duration
-	24, // 23: d4l.mex.cfg.MexConfig.Web.ca_certs:type_name -> d4l.mex.cfg.MexConfig.Web.CACerts
-	25, // 24: d4l.mex.cfg.MexConfig.Web.ip_filter:type_name -> d4l.mex.cfg.MexConfig.Web.IPFilter
-	26, // 25: d4l.mex.cfg.MexConfig.Web.rate_limiting:type_name -> d4l.mex.cfg.MexConfig.Web.RateLimiting
-	35, // 26: d4l.mex.cfg.MexConfig.Postgres.connection_pause:type_name -> google.protobuf.Duration
-	35, // 27: d4l.mex.cfg.MexConfig.Postgres.slow_threshold:type_name -> google.protobuf.Duration
-	35, // 28: d4l.mex.cfg.MexConfig.Solr.connection_pause:type_name -> google.protobuf.Duration
-	35, // 29: d4l.mex.cfg.MexConfig.Solr.commit_within:type_name -> google.protobuf.Duration
-	35, // 30: d4l.mex.cfg.MexConfig.Redis.connection_pause:type_name -> google.protobuf.Duration
-	35, // 31: d4l.mex.cfg.MexConfig.Redis.shutdown_grace_period:type_name -> google.protobuf.Duration
-	27, // 32: d4l.mex.cfg.MexConfig.OAuth.server:type_name -> d4l.mex.cfg.MexConfig.OAuth.Server
-	1,  // 33: d4l.mex.cfg.MexConfig.EntityTypes.repo_type:type_name -> d4l.mex.cfg.RepoType
-	1,  // 34: d4l.mex.cfg.MexConfig.FieldDefs.repo_type:type_name -> d4l.mex.cfg.RepoType
-	1,  // 35: d4l.mex.cfg.MexConfig.SearchConfig.repo_type:type_name -> d4l.mex.cfg.RepoType
-	35, // 36: d4l.mex.cfg.MexConfig.Jwks.connection_pause:type_name -> google.protobuf.Duration
-	35, // 37: d4l.mex.cfg.MexConfig.Jobs.expiration:type_name -> google.protobuf.Duration
-	35, // 38: d4l.mex.cfg.MexConfig.AutoIndexer.set_expiration:type_name -> google.protobuf.Duration
-	0,  // 39: d4l.mex.cfg.MexConfig.Indexing.duplication_detection_algorithm:type_name -> d4l.mex.cfg.DuplicateDetectionAlgorithm
-	35, // 40: d4l.mex.cfg.MexConfig.Telemetry.pinger_update_interval:type_name -> google.protobuf.Duration
-	35, // 41: d4l.mex.cfg.MexConfig.Telemetry.status_update_interval:type_name -> google.protobuf.Duration
-	28, // 42: d4l.mex.cfg.MexConfig.Strictness.search:type_name -> d4l.mex.cfg.MexConfig.Strictness.Search
-	29, // 43: d4l.mex.cfg.MexConfig.Strictness.strict_json_parsing:type_name -> d4l.mex.cfg.MexConfig.Strictness.StrictJSONParsing
-	2,  // 44: d4l.mex.cfg.MexConfig.Notify.emailer_type:type_name -> d4l.mex.cfg.EmailerType
-	30, // 45: d4l.mex.cfg.MexConfig.Notify.flowmailer:type_name -> d4l.mex.cfg.MexConfig.Notify.Flowmailer
-	31, // 46: d4l.mex.cfg.MexConfig.Services.bi_events_filter:type_name -> d4l.mex.cfg.MexConfig.Services.BIEventsFilter
-	32, // 47: d4l.mex.cfg.MexConfig.Services.blobs:type_name -> d4l.mex.cfg.MexConfig.Services.Blobs
-	33, // 48: d4l.mex.cfg.MexConfig.Services.config:type_name -> d4l.mex.cfg.MexConfig.Services.Config
-	35, // 49: d4l.mex.cfg.MexConfig.Web.CACerts.access_pause:type_name -> google.protobuf.Duration
-	35, // 50: d4l.mex.cfg.MexConfig.Web.RateLimiting.period:type_name -> google.protobuf.Duration
-	35, // 51: d4l.mex.cfg.MexConfig.OAuth.Server.key_file_access_pause:type_name -> google.protobuf.Duration
-	35, // 52: d4l.mex.cfg.MexConfig.OAuth.Server.auth_code_validity:type_name -> google.protobuf.Duration
-	35, // 53: d4l.mex.cfg.MexConfig.OAuth.Server.access_token_validity:type_name -> google.protobuf.Duration
-	35, // 54: d4l.mex.cfg.MexConfig.OAuth.Server.refresh_token_validity:type_name -> google.protobuf.Duration
-	34, // 55: d4l.mex.cfg.MexConfig.Services.Config.github:type_name -> d4l.mex.cfg.MexConfig.Services.Config.Github
-	35, // 56: d4l.mex.cfg.MexConfig.Services.Config.update_timeout:type_name -> google.protobuf.Duration
-	57, // [57:57] is the sub-list for method output_type
-	57, // [57:57] is the sub-list for method input_type
-	57, // [57:57] is the sub-list for extension type_name
-	57, // [57:57] is the sub-list for extension extendee
-	0,  // [0:57] is the sub-list for field type_name
+	5,  // 0: d4l.mex.cfg.MexConfig.version:type_name -> d4l.mex.cfg.Version
+	6,  // 1: d4l.mex.cfg.MexConfig.web:type_name -> d4l.mex.cfg.MexConfig.Web
+	7,  // 2: d4l.mex.cfg.MexConfig.db:type_name -> d4l.mex.cfg.MexConfig.Postgres
+	8,  // 3: d4l.mex.cfg.MexConfig.solr:type_name -> d4l.mex.cfg.MexConfig.Solr
+	9,  // 4: d4l.mex.cfg.MexConfig.redis:type_name -> d4l.mex.cfg.MexConfig.Redis
+	10, // 5: d4l.mex.cfg.MexConfig.oauth:type_name -> d4l.mex.cfg.MexConfig.OAuth
+	11, // 6: d4l.mex.cfg.MexConfig.codings:type_name -> d4l.mex.cfg.MexConfig.Codings
+	12, // 7: d4l.mex.cfg.MexConfig.entity_types:type_name -> d4l.mex.cfg.MexConfig.EntityTypes
+	13, // 8: d4l.mex.cfg.MexConfig.field_defs:type_name -> d4l.mex.cfg.MexConfig.FieldDefs
+	14, // 9: d4l.mex.cfg.MexConfig.search_config:type_name -> d4l.mex.cfg.MexConfig.SearchConfig
+	15, // 10: d4l.mex.cfg.MexConfig.jwks:type_name -> d4l.mex.cfg.MexConfig.Jwks
+	16, // 11: d4l.mex.cfg.MexConfig.jobs:type_name -> d4l.mex.cfg.MexConfig.Jobs
+	17, // 12: d4l.mex.cfg.MexConfig.auto_indexer:type_name -> d4l.mex.cfg.MexConfig.AutoIndexer
+	18, // 13: d4l.mex.cfg.MexConfig.indexing:type_name -> d4l.mex.cfg.MexConfig.Indexing
+	20, // 14: d4l.mex.cfg.MexConfig.logging:type_name -> d4l.mex.cfg.MexConfig.Logging
+	21, // 15: d4l.mex.cfg.MexConfig.telemetry:type_name -> d4l.mex.cfg.MexConfig.Telemetry
+	22, // 16: d4l.mex.cfg.MexConfig.auth:type_name -> d4l.mex.cfg.MexConfig.Auth
+	28, // 17: d4l.mex.cfg.MexConfig.services:type_name -> d4l.mex.cfg.MexConfig.Services
+	23, // 18: d4l.mex.cfg.MexConfig.strictness:type_name -> d4l.mex.cfg.MexConfig.Strictness
+	24, // 19: d4l.mex.cfg.MexConfig.notify:type_name -> d4l.mex.cfg.MexConfig.Notify
+	25, // 20: d4l.mex.cfg.MexConfig.oai:type_name -> d4l.mex.cfg.MexConfig.Oai
+	26, // 21: d4l.mex.cfg.MexConfig.access_control:type_name -> d4l.mex.cfg.MexConfig.AccessControl
+	27, // 22: d4l.mex.cfg.MexConfig.saved_searches:type_name -> d4l.mex.cfg.MexConfig.SavedSearches
+	19, // 23: d4l.mex.cfg.MexConfig.ingestion:type_name -> d4l.mex.cfg.MexConfig.Ingestion
+	44, // 24: d4l.mex.cfg.MexConfig.Web.read_timeout:type_name -> google.protobuf.Duration
+	44, // 25: d4l.mex.cfg.MexConfig.Web.write_timeout:type_name -> google.protobuf.Duration
+	44, // 26: d4l.mex.cfg.MexConfig.Web.idle_timeout:type_name -> google.protobuf.Duration
+	29, // 27: d4l.mex.cfg.MexConfig.Web.ca_certs:type_name -> d4l.mex.cfg.MexConfig.Web.CACerts
+	30, // 28: d4l.mex.cfg.MexConfig.Web.ip_filter:type_name -> d4l.mex.cfg.MexConfig.Web.IPFilter
+	31, // 29: d4l.mex.cfg.MexConfig.Web.rate_limiting:type_name -> d4l.mex.cfg.MexConfig.Web.RateLimiting
+	44, // 30: d4l.mex.cfg.MexConfig.Postgres.connection_pause:type_name -> google.protobuf.Duration
+	44, // 31: d4l.mex.cfg.MexConfig.Postgres.slow_threshold:type_name -> google.protobuf.Duration
+	44, // 32: d4l.mex.cfg.MexConfig.Solr.connection_pause:type_name -> google.protobuf.Duration
+	44, // 33: d4l.mex.cfg.MexConfig.Solr.commit_within:type_name -> google.protobuf.Duration
+	44, // 34: d4l.mex.cfg.MexConfig.Redis.connection_pause:type_name -> google.protobuf.Duration
+	44, // 35: d4l.mex.cfg.MexConfig.Redis.shutdown_grace_period:type_name -> google.protobuf.Duration
+	32, // 36: d4l.mex.cfg.MexConfig.OAuth.server:type_name -> d4l.mex.cfg.MexConfig.OAuth.Server
+	44, // 37: d4l.mex.cfg.MexConfig.OAuth.revocation_cache_ttl:type_name -> google.protobuf.Duration
+	1,  // 38: d4l.mex.cfg.MexConfig.EntityTypes.repo_type:type_name -> d4l.mex.cfg.RepoType
+	1,  // 39: d4l.mex.cfg.MexConfig.FieldDefs.repo_type:type_name -> d4l.mex.cfg.RepoType
+	1,  // 40: d4l.mex.cfg.MexConfig.SearchConfig.repo_type:type_name -> d4l.mex.cfg.RepoType
+	44, // 41: d4l.mex.cfg.MexConfig.Jwks.connection_pause:type_name -> google.protobuf.Duration
+	44, // 42: d4l.mex.cfg.MexConfig.Jobs.expiration:type_name -> google.protobuf.Duration
+	44, // 43: d4l.mex.cfg.MexConfig.AutoIndexer.set_expiration:type_name -> google.protobuf.Duration
+	44, // 44: d4l.mex.cfg.MexConfig.AutoIndexer.outbox_poll_interval:type_name -> google.protobuf.Duration
+	44, // 45: d4l.mex.cfg.MexConfig.AutoIndexer.outbox_retry_backoff:type_name -> google.protobuf.Duration
+	44, // 46: d4l.mex.cfg.MexConfig.AutoIndexer.outbox_max_retry_backoff:type_name -> google.protobuf.Duration
+	44, // 47: d4l.mex.cfg.MexConfig.AutoIndexer.outbox_lease:type_name -> google.protobuf.Duration
+	0,  // 48: d4l.mex.cfg.MexConfig.Indexing.duplication_detection_algorithm:type_name -> d4l.mex.cfg.DuplicateDetectionAlgorithm
+	44, // 49: d4l.mex.cfg.MexConfig.Ingestion.stream_lock_timeout:type_name -> google.protobuf.Duration
+	44, // 50: d4l.mex.cfg.MexConfig.Telemetry.pinger_update_interval:type_name -> google.protobuf.Duration
+	44, // 51: d4l.mex.cfg.MexConfig.Telemetry.status_update_interval:type_name -> google.protobuf.Duration
+	34, // 52: d4l.mex.cfg.MexConfig.Strictness.search:type_name -> d4l.mex.cfg.MexConfig.Strictness.Search
+	35, // 53: d4l.mex.cfg.MexConfig.Strictness.strict_json_parsing:type_name -> d4l.mex.cfg.MexConfig.Strictness.StrictJSONParsing
+	3,  // 54: d4l.mex.cfg.MexConfig.Notify.emailer_type:type_name -> d4l.mex.cfg.EmailerType
+	36, // 55: d4l.mex.cfg.MexConfig.Notify.flowmailer:type_name -> d4l.mex.cfg.MexConfig.Notify.Flowmailer
+	44, // 56: d4l.mex.cfg.MexConfig.SavedSearches.evaluation_delay:type_name -> google.protobuf.Duration
+	37, // 57: d4l.mex.cfg.MexConfig.Services.bi_events_filter:type_name -> d4l.mex.cfg.MexConfig.Services.BIEventsFilter
+	38, // 58: d4l.mex.cfg.MexConfig.Services.blobs:type_name -> d4l.mex.cfg.MexConfig.Services.Blobs
+	39, // 59: d4l.mex.cfg.MexConfig.Services.config:type_name -> d4l.mex.cfg.MexConfig.Services.Config
+	44, // 60: d4l.mex.cfg.MexConfig.Web.CACerts.access_pause:type_name -> google.protobuf.Duration
+	44, // 61: d4l.mex.cfg.MexConfig.Web.RateLimiting.period:type_name -> google.protobuf.Duration
+	44, // 62: d4l.mex.cfg.MexConfig.OAuth.Server.key_file_access_pause:type_name -> google.protobuf.Duration
+	44, // 63: d4l.mex.cfg.MexConfig.OAuth.Server.auth_code_validity:type_name -> google.protobuf.Duration
+	44, // 64: d4l.mex.cfg.MexConfig.OAuth.Server.access_token_validity:type_name -> google.protobuf.Duration
+	44, // 65: d4l.mex.cfg.MexConfig.OAuth.Server.refresh_token_validity:type_name -> google.protobuf.Duration
+	33, // 66: d4l.mex.cfg.MexConfig.OAuth.Server.upstream:type_name -> d4l.mex.cfg.MexConfig.OAuth.Server.Upstream
+	44, // 67: d4l.mex.cfg.MexConfig.OAuth.Server.Upstream.login_validity:type_name -> google.protobuf.Duration
+	40, // 68: d4l.mex.cfg.MexConfig.Services.Config.github:type_name -> d4l.mex.cfg.MexConfig.Services.Config.Github
+	44, // 69: d4l.mex.cfg.MexConfig.Services.Config.update_timeout:type_name -> google.protobuf.Duration
+	2,  // 70: d4l.mex.cfg.MexConfig.Services.Config.source:type_name -> d4l.mex.cfg.ConfigSourceType
+	41, // 71: d4l.mex.cfg.MexConfig.Services.Config.local:type_name -> d4l.mex.cfg.MexConfig.Services.Config.Local
+	42, // 72: d4l.mex.cfg.MexConfig.Services.Config.http:type_name -> d4l.mex.cfg.MexConfig.Services.Config.Http
+	43, // 73: d4l.mex.cfg.MexConfig.Services.Config.oci:type_name -> d4l.mex.cfg.MexConfig.Services.Config.Oci
+	44, // 74: d4l.mex.cfg.MexConfig.Services.Config.Local.poll_interval:type_name -> google.protobuf.Duration
+	75, // [75:75] is the sub-list for method output_type
+	75, // [75:75] is the sub-list for method input_type
+	75, // [75:75] is the sub-list for extension type_name
+	75, // [75:75] is the sub-list for extension extendee
+	0,  // [0:75] is the sub-list for field type_name
 }
 
 func init() { file_shared_cfg_mexcfg_proto_init() }
@@ -3546,7 +4805,7 @@ func file_shared_cfg_mexcfg_proto_init() {
 			}
 		}
 		file_shared_cfg_mexcfg_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MexConfig_Logging); i {
+			switch v := v.(*MexConfig_Ingestion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3558,7 +4817,7 @@ func file_shared_cfg_mexcfg_proto_init() {
 			}
 		}
 		file_shared_cfg_mexcfg_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MexConfig_Telemetry); i {
+			switch v := v.(*MexConfig_Logging); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3570,7 +4829,7 @@ func file_shared_cfg_mexcfg_proto_init() {
 			}
 		}
 		file_shared_cfg_mexcfg_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MexConfig_Auth); i {
+			switch v := v.(*MexConfig_Telemetry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3582,7 +4841,7 @@ func file_shared_cfg_mexcfg_proto_init() {
 			}
 		}
 		file_shared_cfg_mexcfg_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MexConfig_Strictness); i {
+			switch v := v.(*MexConfig_Auth); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3594,7 +4853,7 @@ func file_shared_cfg_mexcfg_proto_init() {
 			}
 		}
 		file_shared_cfg_mexcfg_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MexConfig_Notify); i {
+			switch v := v.(*MexConfig_Strictness); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3606,7 +4865,7 @@ func file_shared_cfg_mexcfg_proto_init() {
 			}
 		}
 		file_shared_cfg_mexcfg_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MexConfig_Services); i {
+			switch v := v.(*MexConfig_Notify); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3618,7 +4877,7 @@ func file_shared_cfg_mexcfg_proto_init() {
 			}
 		}
 		file_shared_cfg_mexcfg_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MexConfig_Web_CACerts); i {
+			switch v := v.(*MexConfig_Oai); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3630,7 +4889,7 @@ func file_shared_cfg_mexcfg_proto_init() {
 			}
 		}
 		file_shared_cfg_mexcfg_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MexConfig_Web_IPFilter); i {
+			switch v := v.(*MexConfig_AccessControl); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3642,7 +4901,7 @@ func file_shared_cfg_mexcfg_proto_init() {
 			}
 		}
 		file_shared_cfg_mexcfg_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MexConfig_Web_RateLimiting); i {
+			switch v := v.(*MexConfig_SavedSearches); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3654,7 +4913,7 @@ func file_shared_cfg_mexcfg_proto_init() {
 			}
 		}
 		file_shared_cfg_mexcfg_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MexConfig_OAuth_Server); i {
+			switch v := v.(*MexConfig_Services); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3666,7 +4925,7 @@ func file_shared_cfg_mexcfg_proto_init() {
 			}
 		}
 		file_shared_cfg_mexcfg_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MexConfig_Strictness_Search); i {
+			switch v := v.(*MexConfig_Web_CACerts); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3678,7 +4937,7 @@ func file_shared_cfg_mexcfg_proto_init() {
 			}
 		}
 		file_shared_cfg_mexcfg_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MexConfig_Strictness_StrictJSONParsing); i {
+			switch v := v.(*MexConfig_Web_IPFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3690,7 +4949,7 @@ func file_shared_cfg_mexcfg_proto_init() {
 			}
 		}
 		file_shared_cfg_mexcfg_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MexConfig_Notify_Flowmailer); i {
+			switch v := v.(*MexConfig_Web_RateLimiting); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3702,7 +4961,7 @@ func file_shared_cfg_mexcfg_proto_init() {
 			}
 		}
 		file_shared_cfg_mexcfg_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MexConfig_Services_BIEventsFilter); i {
+			switch v := v.(*MexConfig_OAuth_Server); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3714,7 +4973,7 @@ func file_shared_cfg_mexcfg_proto_init() {
 			}
 		}
 		file_shared_cfg_mexcfg_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MexConfig_Services_Blobs); i {
+			switch v := v.(*MexConfig_OAuth_Server_Upstream); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3726,7 +4985,7 @@ func file_shared_cfg_mexcfg_proto_init() {
 			}
 		}
 		file_shared_cfg_mexcfg_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MexConfig_Services_Config); i {
+			switch v := v.(*MexConfig_Strictness_Search); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3738,6 +4997,66 @@ func file_shared_cfg_mexcfg_proto_init() {
 			}
 		}
 		file_shared_cfg_mexcfg_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MexConfig_Strictness_StrictJSONParsing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_cfg_mexcfg_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MexConfig_Notify_Flowmailer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_cfg_mexcfg_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MexConfig_Services_BIEventsFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_cfg_mexcfg_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MexConfig_Services_Blobs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_cfg_mexcfg_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MexConfig_Services_Config); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_cfg_mexcfg_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MexConfig_Services_Config_Github); i {
 			case 0:
 				return &v.state
@@ -3749,14 +5068,50 @@ func file_shared_cfg_mexcfg_proto_init() {
 				return nil
 			}
 		}
+		file_shared_cfg_mexcfg_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MexConfig_Services_Config_Local); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_cfg_mexcfg_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MexConfig_Services_Config_Http); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_cfg_mexcfg_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MexConfig_Services_Config_Oci); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_shared_cfg_mexcfg_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   32,
+			NumEnums:      4,
+			NumMessages:   40,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -10,6 +10,7 @@ const (
 	ContextKeyTraceID     keyType = "mex-trace-id"
 	ContextKeyUserID      keyType = "mex-user-id"
 	ContextKeyUserClaims  keyType = "mex-user-claims"
+	ContextKeyUser        keyType = "mex-user"
 	ContextKeyJobID       keyType = "mex-job-id"
 	ContextKeyTenantID    keyType = "mex-tenant-id"
 	ContextKeyRequestID   keyType = "mex-request-id"
@@ -47,12 +48,16 @@ func NewContextWithValues(ctxSource context.Context, jobID string) context.Conte
 	ctxNew = context.WithValue(ctxNew, ContextKeyTenantID, ctxSource.Value(ContextKeyTenantID))
 	ctxNew = context.WithValue(ctxNew, ContextKeyUserID, ctxSource.Value(ContextKeyUserID))
 	ctxNew = context.WithValue(ctxNew, ContextKeyUserClaims, ctxSource.Value(ContextKeyUserClaims))
+	ctxNew = context.WithValue(ctxNew, ContextKeyUser, ctxSource.Value(ContextKeyUser))
 	ctxNew = context.WithValue(ctxNew, ContextKeyTraceSecret, ctxSource.Value(ContextKeyTraceSecret))
 
 	return ctxNew
 }
 
 const (
-	ConfigUpdateChannelNameSuffix = "mex-config-update"
-	MergedItemToFragmentRelation  = "dataOriginatesFrom"
+	ConfigUpdateChannelNameSuffix    = "mex-config-update"
+	AuditStreamNameSuffix            = "mex-audit"
+	IndexUpdateChannelNameSuffix     = "mex-index-update"
+	TokenRevocationChannelNameSuffix = "mex-token-revocation"
+	MergedItemToFragmentRelation     = "dataOriginatesFrom"
 )
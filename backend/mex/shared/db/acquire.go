@@ -4,17 +4,26 @@ import (
 	"context"
 
 	"github.com/jackc/pgx/v5"
-	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/pgconn"
 )
 
 type keyType string
 
 const ContextKeyTx keyType = "tx"
 
+// Pool is the part of a pgxpool.Pool used for running queries and transactions, so that a MockTx can replace the
+// pool in tests.
+type Pool interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error)
+}
+
 // Instead of just using `svc.DB` for accessing the database, we may also find a transaction
 // in the context which we need to use (because the endpoint is called as part of a larger transaction).
 // This function makes this distinction so that the caller can just normally use the transaction handle.
-func AcquireTx(ctx context.Context, db *pgxpool.Pool) (pgx.Tx, error) {
+func AcquireTx(ctx context.Context, db Pool) (pgx.Tx, error) {
 	x := ctx.Value(ContextKeyTx)
 	if x == nil {
 		tx, err := db.BeginTx(ctx, pgx.TxOptions{
@@ -0,0 +1,285 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// MockStatement is a statement executed on a MockTx. Transaction control is recorded with the SQL keywords as name
+// (BEGIN, COMMIT, ROLLBACK, SAVEPOINT, RELEASE SAVEPOINT, ROLLBACK TO SAVEPOINT).
+type MockStatement struct {
+	// Name of the sqlc query (taken from its "-- name:" comment), or the SQL text for other statements
+	Name string
+	Args []any
+}
+
+// MockResult is the result of a statement executed on a MockTx.
+type MockResult struct {
+	// Rows hold the column values in the order in which they are scanned; missing columns are scanned as zero values.
+	// If Rows is nil, QueryRow returns a single row of zero values; an empty, non-nil Rows results in pgx.ErrNoRows.
+	Rows         [][]any
+	RowsAffected int64
+	Err          error
+}
+
+// MockHandler computes the result of a statement.
+type MockHandler func(stmt MockStatement) MockResult
+
+type mockState struct {
+	mu         sync.Mutex
+	handler    MockHandler
+	statements []MockStatement
+}
+
+/*
+MockTx is a pgx.Tx without a database. It records the statements executed on it (including those of nested
+transactions) and answers them using the handler, which typically switches on the sqlc query name. Putting it into the
+context under ContextKeyTx makes AcquireTx use it instead of the pool; it can also be used as the pool itself.
+*/
+type MockTx struct {
+	state  *mockState
+	nested bool
+	closed bool
+}
+
+func NewMockTx(handler MockHandler) *MockTx {
+	if handler == nil {
+		handler = func(MockStatement) MockResult { return MockResult{} }
+	}
+	return &MockTx{state: &mockState{handler: handler}}
+}
+
+// Statements returns the names of all statements executed so far, in order.
+func (tx *MockTx) Statements() []string {
+	tx.state.mu.Lock()
+	defer tx.state.mu.Unlock()
+
+	names := make([]string, len(tx.state.statements))
+	for i, stmt := range tx.state.statements {
+		names[i] = stmt.Name
+	}
+	return names
+}
+
+// Executed returns the statements with the given name executed so far, in order.
+func (tx *MockTx) Executed(name string) []MockStatement {
+	tx.state.mu.Lock()
+	defer tx.state.mu.Unlock()
+
+	var statements []MockStatement
+	for _, stmt := range tx.state.statements {
+		if stmt.Name == name {
+			statements = append(statements, stmt)
+		}
+	}
+	return statements
+}
+
+func (tx *MockTx) run(sql string, args []any) MockResult {
+	name := sql
+	if strings.HasPrefix(sql, "-- name: ") {
+		name = strings.Fields(sql)[2]
+	}
+	stmt := MockStatement{Name: name, Args: args}
+
+	tx.state.mu.Lock()
+	tx.state.statements = append(tx.state.statements, stmt)
+	handler := tx.state.handler
+	tx.state.mu.Unlock()
+
+	return handler(stmt)
+}
+
+func (tx *MockTx) Begin(_ context.Context) (pgx.Tx, error) {
+	if tx.closed {
+		return nil, pgx.ErrTxClosed
+	}
+	if result := tx.run("SAVEPOINT", nil); result.Err != nil {
+		return nil, result.Err
+	}
+	return &MockTx{state: tx.state, nested: true}, nil
+}
+
+// BeginTx starts a nested transaction, so that a MockTx can also be used as Pool.
+func (tx *MockTx) BeginTx(ctx context.Context, _ pgx.TxOptions) (pgx.Tx, error) {
+	return tx.Begin(ctx)
+}
+
+func (tx *MockTx) end(sql string, nestedSQL string) error {
+	if tx.closed {
+		return pgx.ErrTxClosed
+	}
+	tx.closed = true
+	if tx.nested {
+		sql = nestedSQL
+	}
+	return tx.run(sql, nil).Err
+}
+
+func (tx *MockTx) Commit(_ context.Context) error {
+	return tx.end("COMMIT", "RELEASE SAVEPOINT")
+}
+
+func (tx *MockTx) Rollback(_ context.Context) error {
+	return tx.end("ROLLBACK", "ROLLBACK TO SAVEPOINT")
+}
+
+func (tx *MockTx) CopyFrom(_ context.Context, tableName pgx.Identifier, _ []string, rowSrc pgx.CopyFromSource) (int64, error) {
+	var rows [][]any
+	for rowSrc.Next() {
+		values, err := rowSrc.Values()
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, values)
+	}
+	result := tx.run("COPY "+tableName.Sanitize(), []any{rows})
+	return int64(len(rows)), result.Err
+}
+
+func (tx *MockTx) SendBatch(_ context.Context, _ *pgx.Batch) pgx.BatchResults {
+	panic("batches are not supported by MockTx")
+}
+
+func (tx *MockTx) LargeObjects() pgx.LargeObjects {
+	panic("large objects are not supported by MockTx")
+}
+
+func (tx *MockTx) Prepare(_ context.Context, name, sql string) (*pgconn.StatementDescription, error) {
+	return &pgconn.StatementDescription{Name: name, SQL: sql}, nil
+}
+
+func (tx *MockTx) Exec(_ context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	if tx.closed {
+		return pgconn.CommandTag{}, pgx.ErrTxClosed
+	}
+	result := tx.run(sql, args)
+	return pgconn.NewCommandTag(fmt.Sprintf("UPDATE %d", result.RowsAffected)), result.Err
+}
+
+func (tx *MockTx) Query(_ context.Context, sql string, args ...any) (pgx.Rows, error) {
+	if tx.closed {
+		return nil, pgx.ErrTxClosed
+	}
+	result := tx.run(sql, args)
+	if result.Err != nil {
+		return nil, result.Err
+	}
+	return &mockRows{rows: result.Rows, current: -1}, nil
+}
+
+func (tx *MockTx) QueryRow(_ context.Context, sql string, args ...any) pgx.Row {
+	if tx.closed {
+		return &mockRow{err: pgx.ErrTxClosed}
+	}
+	result := tx.run(sql, args)
+	switch {
+	case result.Err != nil:
+		return &mockRow{err: result.Err}
+	case result.Rows == nil:
+		return &mockRow{}
+	case len(result.Rows) == 0:
+		return &mockRow{err: pgx.ErrNoRows}
+	default:
+		return &mockRow{values: result.Rows[0]}
+	}
+}
+
+func (tx *MockTx) Conn() *pgx.Conn {
+	return nil
+}
+
+type mockRow struct {
+	values []any
+	err    error
+}
+
+func (row *mockRow) Scan(dest ...any) error {
+	if row.err != nil {
+		return row.err
+	}
+	return scanValues(row.values, dest)
+}
+
+type mockRows struct {
+	rows    [][]any
+	current int
+	err     error
+}
+
+func (rows *mockRows) Close()                                       {}
+func (rows *mockRows) Err() error                                   { return rows.err }
+func (rows *mockRows) CommandTag() pgconn.CommandTag                { return pgconn.NewCommandTag("SELECT") }
+func (rows *mockRows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (rows *mockRows) RawValues() [][]byte                          { return nil }
+func (rows *mockRows) Conn() *pgx.Conn                              { return nil }
+
+func (rows *mockRows) Next() bool {
+	rows.current++
+	return rows.current < len(rows.rows)
+}
+
+func (rows *mockRows) Scan(dest ...any) error {
+	if err := scanValues(rows.rows[rows.current], dest); err != nil {
+		rows.err = err
+		return err
+	}
+	return nil
+}
+
+func (rows *mockRows) Values() ([]any, error) {
+	return rows.rows[rows.current], nil
+}
+
+// scanValues assigns the values to the destinations, converting Go values to the pgtype types where needed.
+func scanValues(values []any, dest []any) error {
+	if len(values) > len(dest) {
+		return fmt.Errorf("mock row has %d values, but only %d destinations", len(values), len(dest))
+	}
+
+	for i, value := range values {
+		if value == nil {
+			continue
+		}
+		target := reflect.ValueOf(dest[i]).Elem()
+		source := reflect.ValueOf(toPgtype(value, target.Type()))
+		switch {
+		case source.Type().AssignableTo(target.Type()):
+			target.Set(source)
+		case source.Type().ConvertibleTo(target.Type()):
+			target.Set(source.Convert(target.Type()))
+		default:
+			return fmt.Errorf("cannot scan %T into %s", value, target.Type())
+		}
+	}
+	return nil
+}
+
+func toPgtype(value any, targetType reflect.Type) any {
+	switch v := value.(type) {
+	case string:
+		if targetType == reflect.TypeOf(pgtype.Text{}) {
+			return pgtype.Text{String: v, Valid: true}
+		}
+	case time.Time:
+		if targetType == reflect.TypeOf(pgtype.Timestamptz{}) {
+			return pgtype.Timestamptz{Time: v, Valid: true}
+		}
+	case int:
+		switch targetType {
+		case reflect.TypeOf(pgtype.Int4{}):
+			return pgtype.Int4{Int32: int32(v), Valid: true}
+		case reflect.TypeOf(pgtype.Int8{}):
+			return pgtype.Int8{Int64: int64(v), Valid: true}
+		}
+	}
+	return value
+}
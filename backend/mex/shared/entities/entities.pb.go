@@ -25,13 +25,14 @@ type EntityTypeConfig struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BusinessIdFieldName   string `protobuf:"bytes,1,opt,name=business_id_field_name,json=businessIdFieldName,proto3" json:"business_id_field_name,omitempty"`
-	AggregationEntityType string `protobuf:"bytes,2,opt,name=aggregation_entity_type,json=aggregationEntityType,proto3" json:"aggregation_entity_type,omitempty"`
-	AggregationAlgorithm  string `protobuf:"bytes,3,opt,name=aggregation_algorithm,json=aggregationAlgorithm,proto3" json:"aggregation_algorithm,omitempty"`
-	IsFocal               bool   `protobuf:"varint,4,opt,name=is_focal,json=isFocal,proto3" json:"is_focal,omitempty"`
-	PartitionFieldName    string `protobuf:"bytes,5,opt,name=partition_field_name,json=partitionFieldName,proto3" json:"partition_field_name,omitempty"`
-	DuplicateStrategy     string `protobuf:"bytes,6,opt,name=duplicate_strategy,json=duplicateStrategy,proto3" json:"duplicate_strategy,omitempty"`
-	IsAggregatable        bool   `protobuf:"varint,7,opt,name=is_aggregatable,json=isAggregatable,proto3" json:"is_aggregatable,omitempty"`
+	BusinessIdFieldName   string            `protobuf:"bytes,1,opt,name=business_id_field_name,json=businessIdFieldName,proto3" json:"business_id_field_name,omitempty"`
+	AggregationEntityType string            `protobuf:"bytes,2,opt,name=aggregation_entity_type,json=aggregationEntityType,proto3" json:"aggregation_entity_type,omitempty"`
+	AggregationAlgorithm  string            `protobuf:"bytes,3,opt,name=aggregation_algorithm,json=aggregationAlgorithm,proto3" json:"aggregation_algorithm,omitempty"`
+	IsFocal               bool              `protobuf:"varint,4,opt,name=is_focal,json=isFocal,proto3" json:"is_focal,omitempty"`
+	PartitionFieldName    string            `protobuf:"bytes,5,opt,name=partition_field_name,json=partitionFieldName,proto3" json:"partition_field_name,omitempty"`
+	DuplicateStrategy     string            `protobuf:"bytes,6,opt,name=duplicate_strategy,json=duplicateStrategy,proto3" json:"duplicate_strategy,omitempty"`
+	IsAggregatable        bool              `protobuf:"varint,7,opt,name=is_aggregatable,json=isAggregatable,proto3" json:"is_aggregatable,omitempty"`
+	Schema                *EntityTypeSchema `protobuf:"bytes,8,opt,name=schema,proto3" json:"schema,omitempty"`
 }
 
 func (x *EntityTypeConfig) Reset() {
@@ -115,6 +116,227 @@ func (x *EntityTypeConfig) GetIsAggregatable() bool {
 	return false
 }
 
+func (x *EntityTypeConfig) GetSchema() *EntityTypeSchema {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+// Constraints on the values of the items of an entity type, checked when items are created.
+type EntityTypeSchema struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If not empty, only these fields (and the business ID field) may have values.
+	AllowedFields []string `protobuf:"bytes,1,rep,name=allowed_fields,json=allowedFields,proto3" json:"allowed_fields,omitempty"`
+	// Fields which must have at least one value.
+	RequiredFields []string `protobuf:"bytes,2,rep,name=required_fields,json=requiredFields,proto3" json:"required_fields,omitempty"`
+	// Number of values of single fields, overriding the multi-valuedness of the field definition.
+	Cardinalities []*FieldCardinality `protobuf:"bytes,3,rep,name=cardinalities,proto3" json:"cardinalities,omitempty"`
+	// Requirements on the languages of the values of single fields.
+	Languages []*FieldLanguages `protobuf:"bytes,4,rep,name=languages,proto3" json:"languages,omitempty"`
+}
+
+func (x *EntityTypeSchema) Reset() {
+	*x = EntityTypeSchema{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_entities_entities_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntityTypeSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityTypeSchema) ProtoMessage() {}
+
+func (x *EntityTypeSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_entities_entities_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityTypeSchema.ProtoReflect.Descriptor instead.
+func (*EntityTypeSchema) Descriptor() ([]byte, []int) {
+	return file_shared_entities_entities_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EntityTypeSchema) GetAllowedFields() []string {
+	if x != nil {
+		return x.AllowedFields
+	}
+	return nil
+}
+
+func (x *EntityTypeSchema) GetRequiredFields() []string {
+	if x != nil {
+		return x.RequiredFields
+	}
+	return nil
+}
+
+func (x *EntityTypeSchema) GetCardinalities() []*FieldCardinality {
+	if x != nil {
+		return x.Cardinalities
+	}
+	return nil
+}
+
+func (x *EntityTypeSchema) GetLanguages() []*FieldLanguages {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+type FieldCardinality struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FieldName string `protobuf:"bytes,1,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	MinValues uint32 `protobuf:"varint,2,opt,name=min_values,json=minValues,proto3" json:"min_values,omitempty"`
+	// 0 means no limit.
+	MaxValues uint32 `protobuf:"varint,3,opt,name=max_values,json=maxValues,proto3" json:"max_values,omitempty"`
+}
+
+func (x *FieldCardinality) Reset() {
+	*x = FieldCardinality{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_entities_entities_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldCardinality) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldCardinality) ProtoMessage() {}
+
+func (x *FieldCardinality) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_entities_entities_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldCardinality.ProtoReflect.Descriptor instead.
+func (*FieldCardinality) Descriptor() ([]byte, []int) {
+	return file_shared_entities_entities_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FieldCardinality) GetFieldName() string {
+	if x != nil {
+		return x.FieldName
+	}
+	return ""
+}
+
+func (x *FieldCardinality) GetMinValues() uint32 {
+	if x != nil {
+		return x.MinValues
+	}
+	return 0
+}
+
+func (x *FieldCardinality) GetMaxValues() uint32 {
+	if x != nil {
+		return x.MaxValues
+	}
+	return 0
+}
+
+type FieldLanguages struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FieldName string `protobuf:"bytes,1,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	// Every value must have a language.
+	LanguageRequired bool `protobuf:"varint,2,opt,name=language_required,json=languageRequired,proto3" json:"language_required,omitempty"`
+	// If not empty, values with a language must have one of these.
+	AllowedLanguages []string `protobuf:"bytes,3,rep,name=allowed_languages,json=allowedLanguages,proto3" json:"allowed_languages,omitempty"`
+	// Languages for which at least one value must be present.
+	RequiredLanguages []string `protobuf:"bytes,4,rep,name=required_languages,json=requiredLanguages,proto3" json:"required_languages,omitempty"`
+}
+
+func (x *FieldLanguages) Reset() {
+	*x = FieldLanguages{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_entities_entities_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldLanguages) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldLanguages) ProtoMessage() {}
+
+func (x *FieldLanguages) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_entities_entities_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldLanguages.ProtoReflect.Descriptor instead.
+func (*FieldLanguages) Descriptor() ([]byte, []int) {
+	return file_shared_entities_entities_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FieldLanguages) GetFieldName() string {
+	if x != nil {
+		return x.FieldName
+	}
+	return ""
+}
+
+func (x *FieldLanguages) GetLanguageRequired() bool {
+	if x != nil {
+		return x.LanguageRequired
+	}
+	return false
+}
+
+func (x *FieldLanguages) GetAllowedLanguages() []string {
+	if x != nil {
+		return x.AllowedLanguages
+	}
+	return nil
+}
+
+func (x *FieldLanguages) GetRequiredLanguages() []string {
+	if x != nil {
+		return x.RequiredLanguages
+	}
+	return nil
+}
+
 type EntityType struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -127,7 +349,7 @@ type EntityType struct {
 func (x *EntityType) Reset() {
 	*x = EntityType{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shared_entities_entities_proto_msgTypes[1]
+		mi := &file_shared_entities_entities_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -140,7 +362,7 @@ func (x *EntityType) String() string {
 func (*EntityType) ProtoMessage() {}
 
 func (x *EntityType) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_entities_entities_proto_msgTypes[1]
+	mi := &file_shared_entities_entities_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -153,7 +375,7 @@ func (x *EntityType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EntityType.ProtoReflect.Descriptor instead.
 func (*EntityType) Descriptor() ([]byte, []int) {
-	return file_shared_entities_entities_proto_rawDescGZIP(), []int{1}
+	return file_shared_entities_entities_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *EntityType) GetName() string {
@@ -181,7 +403,7 @@ type EntityTypeList struct {
 func (x *EntityTypeList) Reset() {
 	*x = EntityTypeList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shared_entities_entities_proto_msgTypes[2]
+		mi := &file_shared_entities_entities_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -194,7 +416,7 @@ func (x *EntityTypeList) String() string {
 func (*EntityTypeList) ProtoMessage() {}
 
 func (x *EntityTypeList) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_entities_entities_proto_msgTypes[2]
+	mi := &file_shared_entities_entities_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -207,7 +429,7 @@ func (x *EntityTypeList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EntityTypeList.ProtoReflect.Descriptor instead.
 func (*EntityTypeList) Descriptor() ([]byte, []int) {
-	return file_shared_entities_entities_proto_rawDescGZIP(), []int{2}
+	return file_shared_entities_entities_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *EntityTypeList) GetEntityTypes() []*EntityType {
@@ -223,7 +445,7 @@ var file_shared_entities_entities_proto_rawDesc = []byte{
 	0x0a, 0x1e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65,
 	0x73, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x12, 0x10, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69,
-	0x65, 0x73, 0x22, 0xd9, 0x02, 0x0a, 0x10, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x22, 0x95, 0x03, 0x0a, 0x10, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70,
 	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x33, 0x0a, 0x16, 0x62, 0x75, 0x73, 0x69, 0x6e,
 	0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6e, 0x61, 0x6d,
 	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73,
@@ -244,24 +466,60 @@ var file_shared_entities_entities_proto_rawDesc = []byte{
 	0x28, 0x09, 0x52, 0x11, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72,
 	0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x73, 0x5f, 0x61, 0x67, 0x67, 0x72,
 	0x65, 0x67, 0x61, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
-	0x69, 0x73, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x5c,
-	0x0a, 0x0a, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x3a, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x22, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x69, 0x65, 0x73, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x51, 0x0a, 0x0e,
-	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3f,
-	0x0a, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x73, 0x42,
-	0x4b, 0x5a, 0x49, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x65,
-	0x73, 0x75, 0x6e, 0x64, 0x68, 0x65, 0x69, 0x74, 0x73, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x72,
-	0x6b, 0x69, 0x2d, 0x6d, 0x65, 0x78, 0x2d, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f,
-	0x6d, 0x65, 0x78, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x69, 0x65, 0x73, 0x3b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x73, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x3a,
+	0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0xec, 0x01, 0x0a, 0x10, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12,
+	0x25, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12,
+	0x48, 0x0a, 0x0d, 0x63, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78,
+	0x2e, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43,
+	0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0d, 0x63, 0x61, 0x72, 0x64,
+	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x09, 0x6c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64,
+	0x34, 0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2e,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x52, 0x09,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x22, 0x6f, 0x0a, 0x10, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x43, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a,
+	0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d,
+	0x61, 0x78, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x6d, 0x61, 0x78, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0xb8, 0x01, 0x0a, 0x0e, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x4c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x73, 0x22, 0x5c, 0x0a, 0x0a, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x64, 0x34, 0x6c, 0x2e, 0x6d, 0x65,
+	0x78, 0x2e, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x22, 0x51, 0x0a, 0x0e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x34,
+	0x6c, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2e, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x54, 0x79, 0x70, 0x65, 0x73, 0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x34, 0x6c, 0x2d, 0x64, 0x61, 0x74, 0x61, 0x34, 0x6c, 0x69,
+	0x66, 0x65, 0x2f, 0x6d, 0x65, 0x78, 0x2f, 0x6d, 0x65, 0x78, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x3b, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -276,20 +534,26 @@ func file_shared_entities_entities_proto_rawDescGZIP() []byte {
 	return file_shared_entities_entities_proto_rawDescData
 }
 
-var file_shared_entities_entities_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_shared_entities_entities_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_shared_entities_entities_proto_goTypes = []interface{}{
 	(*EntityTypeConfig)(nil), // 0: d4l.mex.entities.EntityTypeConfig
-	(*EntityType)(nil),       // 1: d4l.mex.entities.EntityType
-	(*EntityTypeList)(nil),   // 2: d4l.mex.entities.EntityTypeList
+	(*EntityTypeSchema)(nil), // 1: d4l.mex.entities.EntityTypeSchema
+	(*FieldCardinality)(nil), // 2: d4l.mex.entities.FieldCardinality
+	(*FieldLanguages)(nil),   // 3: d4l.mex.entities.FieldLanguages
+	(*EntityType)(nil),       // 4: d4l.mex.entities.EntityType
+	(*EntityTypeList)(nil),   // 5: d4l.mex.entities.EntityTypeList
 }
 var file_shared_entities_entities_proto_depIdxs = []int32{
-	0, // 0: d4l.mex.entities.EntityType.config:type_name -> d4l.mex.entities.EntityTypeConfig
-	1, // 1: d4l.mex.entities.EntityTypeList.entity_types:type_name -> d4l.mex.entities.EntityType
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	1, // 0: d4l.mex.entities.EntityTypeConfig.schema:type_name -> d4l.mex.entities.EntityTypeSchema
+	2, // 1: d4l.mex.entities.EntityTypeSchema.cardinalities:type_name -> d4l.mex.entities.FieldCardinality
+	3, // 2: d4l.mex.entities.EntityTypeSchema.languages:type_name -> d4l.mex.entities.FieldLanguages
+	0, // 3: d4l.mex.entities.EntityType.config:type_name -> d4l.mex.entities.EntityTypeConfig
+	4, // 4: d4l.mex.entities.EntityTypeList.entity_types:type_name -> d4l.mex.entities.EntityType
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_shared_entities_entities_proto_init() }
@@ -311,7 +575,7 @@ func file_shared_entities_entities_proto_init() {
 			}
 		}
 		file_shared_entities_entities_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EntityType); i {
+			switch v := v.(*EntityTypeSchema); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -323,6 +587,42 @@ func file_shared_entities_entities_proto_init() {
 			}
 		}
 		file_shared_entities_entities_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldCardinality); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_entities_entities_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldLanguages); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_entities_entities_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EntityType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_entities_entities_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EntityTypeList); i {
 			case 0:
 				return &v.state
@@ -341,7 +641,7 @@ func file_shared_entities_entities_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_shared_entities_entities_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
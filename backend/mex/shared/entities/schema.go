@@ -0,0 +1,100 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/d4l-data4life/mex/mex/shared/items"
+	"github.com/d4l-data4life/mex/mex/shared/utils"
+)
+
+// CheckSchema returns the violations of the schema of the entity type by the given item values (none if the entity
+// type has no schema). The violations are reported in the order of the schema definition.
+func CheckSchema(entityType *EntityType, values []*items.ItemValue) []string {
+	schema := entityType.GetConfig().GetSchema()
+	if schema == nil {
+		return nil
+	}
+
+	valuesByField := make(map[string][]*items.ItemValue)
+	var fieldNames []string
+	for _, v := range values {
+		if _, ok := valuesByField[v.FieldName]; !ok {
+			fieldNames = append(fieldNames, v.FieldName)
+		}
+		valuesByField[v.FieldName] = append(valuesByField[v.FieldName], v)
+	}
+
+	var violations []string
+	if len(schema.AllowedFields) > 0 {
+		businessIDFieldName := entityType.GetConfig().GetBusinessIdFieldName()
+		for _, fieldName := range fieldNames {
+			if fieldName != businessIDFieldName && !utils.Contains(schema.AllowedFields, fieldName) {
+				violations = append(violations, fmt.Sprintf("field '%s' is not allowed", fieldName))
+			}
+		}
+	}
+
+	violations = append(violations, CheckRequiredFields(schema.RequiredFields, values)...)
+
+	for _, cardinality := range schema.Cardinalities {
+		violations = append(violations, checkCardinality(cardinality, len(valuesByField[cardinality.FieldName]))...)
+	}
+
+	for _, languages := range schema.Languages {
+		violations = append(violations, checkLanguages(languages, valuesByField[languages.FieldName])...)
+	}
+
+	return violations
+}
+
+// CheckRequiredFields returns a violation for each of the given required fields without a value, in the given order.
+func CheckRequiredFields(requiredFields []string, values []*items.ItemValue) []string {
+	present := make(map[string]bool, len(values))
+	for _, v := range values {
+		present[v.FieldName] = true
+	}
+
+	var violations []string
+	for _, fieldName := range requiredFields {
+		if !present[fieldName] {
+			violations = append(violations, fmt.Sprintf("field '%s' is required", fieldName))
+		}
+	}
+	return violations
+}
+
+func checkCardinality(cardinality *FieldCardinality, count int) []string {
+	var violations []string
+	if count < int(cardinality.MinValues) {
+		violations = append(violations, fmt.Sprintf("field '%s' has %d values, at least %d required", cardinality.FieldName, count, cardinality.MinValues))
+	}
+	if cardinality.MaxValues > 0 && count > int(cardinality.MaxValues) {
+		violations = append(violations, fmt.Sprintf("field '%s' has %d values, at most %d allowed", cardinality.FieldName, count, cardinality.MaxValues))
+	}
+	return violations
+}
+
+func checkLanguages(languages *FieldLanguages, values []*items.ItemValue) []string {
+	var violations []string
+	present := make(map[string]bool)
+	for _, v := range values {
+		switch {
+		case v.Language == "":
+			if languages.LanguageRequired {
+				violations = append(violations, fmt.Sprintf("field '%s' has a value without language", languages.FieldName))
+			}
+		case len(languages.AllowedLanguages) > 0 && !utils.Contains(languages.AllowedLanguages, v.Language):
+			violations = append(violations, fmt.Sprintf("field '%s' has a value in language '%s', allowed are: %s",
+				languages.FieldName, v.Language, strings.Join(languages.AllowedLanguages, ", ")))
+		}
+		present[v.Language] = true
+	}
+
+	for _, language := range languages.RequiredLanguages {
+		if !present[language] {
+			violations = append(violations, fmt.Sprintf("field '%s' has no value in language '%s'", languages.FieldName, language))
+		}
+	}
+	return violations
+}
@@ -0,0 +1,90 @@
+package entities
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/d4l-data4life/mex/mex/shared/items"
+)
+
+func TestCheckSchema(t *testing.T) {
+	entityType := &EntityType{
+		Name: "Resource",
+		Config: &EntityTypeConfig{
+			BusinessIdFieldName: "identifier",
+			Schema: &EntityTypeSchema{
+				AllowedFields:  []string{"title", "keyword", "created"},
+				RequiredFields: []string{"title"},
+				Cardinalities: []*FieldCardinality{
+					{FieldName: "created", MaxValues: 1},
+					{FieldName: "keyword", MinValues: 1, MaxValues: 3},
+				},
+				Languages: []*FieldLanguages{
+					{FieldName: "title", LanguageRequired: true, AllowedLanguages: []string{"de", "en"}, RequiredLanguages: []string{"en"}},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		values []*items.ItemValue
+		want   []string
+	}{
+		{
+			name: "Conforming values",
+			values: []*items.ItemValue{
+				{FieldName: "identifier", FieldValue: "R1"},
+				{FieldName: "title", FieldValue: "Titel", Language: "de"},
+				{FieldName: "title", FieldValue: "Title", Language: "en"},
+				{FieldName: "keyword", FieldValue: "health"},
+			},
+		},
+		{
+			name: "Field not allowed",
+			values: []*items.ItemValue{
+				{FieldName: "title", FieldValue: "Title", Language: "en"},
+				{FieldName: "keyword", FieldValue: "health"},
+				{FieldName: "author", FieldValue: "Jane Doe"},
+			},
+			want: []string{"field 'author' is not allowed"},
+		},
+		{
+			name: "Required field and cardinalities",
+			values: []*items.ItemValue{
+				{FieldName: "created", FieldValue: "2021"},
+				{FieldName: "created", FieldValue: "2022"},
+			},
+			want: []string{
+				"field 'title' is required",
+				"field 'created' has 2 values, at most 1 allowed",
+				"field 'keyword' has 0 values, at least 1 required",
+				"field 'title' has no value in language 'en'",
+			},
+		},
+		{
+			name: "Languages",
+			values: []*items.ItemValue{
+				{FieldName: "title", FieldValue: "Title"},
+				{FieldName: "title", FieldValue: "Titre", Language: "fr"},
+				{FieldName: "keyword", FieldValue: "health"},
+			},
+			want: []string{
+				"field 'title' has a value without language",
+				"field 'title' has a value in language 'fr', allowed are: de, en",
+				"field 'title' has no value in language 'en'",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CheckSchema(entityType, tt.values); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CheckSchema() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := CheckSchema(&EntityType{Name: "Person", Config: &EntityTypeConfig{}}, []*items.ItemValue{{FieldName: "name"}}); got != nil {
+		t.Errorf("CheckSchema() without schema = %v, want none", got)
+	}
+}
@@ -360,6 +360,430 @@ func (x *IndexDefExtCoding) GetCodingsetNames() []string {
 	return nil
 }
 
+// IndexDefExtValidation restricts the values of a field; it can be added to fields of any kind.
+// The rules are checked when items are created or updated.
+type IndexDefExtValidation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Regular expressions (RE2 syntax) which every value has to match completely
+	Patterns []string `protobuf:"bytes,1,rep,name=patterns,proto3" json:"patterns,omitempty"`
+	// If not empty, the only values allowed
+	AllowedValues []string `protobuf:"bytes,2,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"`
+	// Limits of the value length in characters; 0 means no limit
+	MinLength uint32 `protobuf:"varint,3,opt,name=min_length,json=minLength,proto3" json:"min_length,omitempty"`
+	MaxLength uint32 `protobuf:"varint,4,opt,name=max_length,json=maxLength,proto3" json:"max_length,omitempty"`
+	// Limits of the values of number fields
+	MinValue *float64 `protobuf:"fixed64,5,opt,name=min_value,json=minValue,proto3,oneof" json:"min_value,omitempty"`
+	MaxValue *float64 `protobuf:"fixed64,6,opt,name=max_value,json=maxValue,proto3,oneof" json:"max_value,omitempty"`
+	// Entity types whose items must have at least one value for the field
+	RequiredForEntityTypes []string `protobuf:"bytes,7,rep,name=required_for_entity_types,json=requiredForEntityTypes,proto3" json:"required_for_entity_types,omitempty"`
+	// Accepted precisions of the values of timestamp fields: "year", "month", "day" or "second"; all if empty
+	DatePrecisions []string `protobuf:"bytes,8,rep,name=date_precisions,json=datePrecisions,proto3" json:"date_precisions,omitempty"`
+}
+
+func (x *IndexDefExtValidation) Reset() {
+	*x = IndexDefExtValidation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_fields_fields_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IndexDefExtValidation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexDefExtValidation) ProtoMessage() {}
+
+func (x *IndexDefExtValidation) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_fields_fields_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexDefExtValidation.ProtoReflect.Descriptor instead.
+func (*IndexDefExtValidation) Descriptor() ([]byte, []int) {
+	return file_shared_fields_fields_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *IndexDefExtValidation) GetPatterns() []string {
+	if x != nil {
+		return x.Patterns
+	}
+	return nil
+}
+
+func (x *IndexDefExtValidation) GetAllowedValues() []string {
+	if x != nil {
+		return x.AllowedValues
+	}
+	return nil
+}
+
+func (x *IndexDefExtValidation) GetMinLength() uint32 {
+	if x != nil {
+		return x.MinLength
+	}
+	return 0
+}
+
+func (x *IndexDefExtValidation) GetMaxLength() uint32 {
+	if x != nil {
+		return x.MaxLength
+	}
+	return 0
+}
+
+func (x *IndexDefExtValidation) GetMinValue() float64 {
+	if x != nil && x.MinValue != nil {
+		return *x.MinValue
+	}
+	return 0
+}
+
+func (x *IndexDefExtValidation) GetMaxValue() float64 {
+	if x != nil && x.MaxValue != nil {
+		return *x.MaxValue
+	}
+	return 0
+}
+
+func (x *IndexDefExtValidation) GetRequiredForEntityTypes() []string {
+	if x != nil {
+		return x.RequiredForEntityTypes
+	}
+	return nil
+}
+
+func (x *IndexDefExtValidation) GetDatePrecisions() []string {
+	if x != nil {
+		return x.DatePrecisions
+	}
+	return nil
+}
+
+// VocabularyMapping maps MEx entity types, fields and relation types onto vocabulary IRIs (e.g. DCAT-AP) and drives
+// the JSON-LD export of items. The Dublin Core mapping drives the OAI-PMH provider. It is stored next to the field
+// definitions in the config (field_defs/vocabulary.json).
+// IRIs can be given in compact form (e.g. "dcat:Dataset") if the prefix is declared.
+type VocabularyMapping struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Base IRI of the exported items, the item ID is appended to it. If empty, items are identified as "urn:uuid:<item ID>".
+	BaseIri     string                                 `protobuf:"bytes,1,opt,name=base_iri,json=baseIri,proto3" json:"base_iri,omitempty"`
+	Prefixes    map[string]string                      `protobuf:"bytes,2,rep,name=prefixes,proto3" json:"prefixes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	EntityTypes []*VocabularyMapping_EntityTypeMapping `protobuf:"bytes,3,rep,name=entity_types,json=entityTypes,proto3" json:"entity_types,omitempty"`
+	Fields      []*VocabularyMapping_FieldMapping      `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	Relations   []*VocabularyMapping_RelationMapping   `protobuf:"bytes,5,rep,name=relations,proto3" json:"relations,omitempty"`
+	DublinCore  []*VocabularyMapping_DublinCoreMapping `protobuf:"bytes,6,rep,name=dublin_core,json=dublinCore,proto3" json:"dublin_core,omitempty"`
+}
+
+func (x *VocabularyMapping) Reset() {
+	*x = VocabularyMapping{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_fields_fields_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VocabularyMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyMapping) ProtoMessage() {}
+
+func (x *VocabularyMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_fields_fields_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyMapping.ProtoReflect.Descriptor instead.
+func (*VocabularyMapping) Descriptor() ([]byte, []int) {
+	return file_shared_fields_fields_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *VocabularyMapping) GetBaseIri() string {
+	if x != nil {
+		return x.BaseIri
+	}
+	return ""
+}
+
+func (x *VocabularyMapping) GetPrefixes() map[string]string {
+	if x != nil {
+		return x.Prefixes
+	}
+	return nil
+}
+
+func (x *VocabularyMapping) GetEntityTypes() []*VocabularyMapping_EntityTypeMapping {
+	if x != nil {
+		return x.EntityTypes
+	}
+	return nil
+}
+
+func (x *VocabularyMapping) GetFields() []*VocabularyMapping_FieldMapping {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *VocabularyMapping) GetRelations() []*VocabularyMapping_RelationMapping {
+	if x != nil {
+		return x.Relations
+	}
+	return nil
+}
+
+func (x *VocabularyMapping) GetDublinCore() []*VocabularyMapping_DublinCoreMapping {
+	if x != nil {
+		return x.DublinCore
+	}
+	return nil
+}
+
+type VocabularyMapping_EntityTypeMapping struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntityType string `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	ClassIri   string `protobuf:"bytes,2,opt,name=class_iri,json=classIri,proto3" json:"class_iri,omitempty"`
+}
+
+func (x *VocabularyMapping_EntityTypeMapping) Reset() {
+	*x = VocabularyMapping_EntityTypeMapping{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_fields_fields_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VocabularyMapping_EntityTypeMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyMapping_EntityTypeMapping) ProtoMessage() {}
+
+func (x *VocabularyMapping_EntityTypeMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_fields_fields_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyMapping_EntityTypeMapping.ProtoReflect.Descriptor instead.
+func (*VocabularyMapping_EntityTypeMapping) Descriptor() ([]byte, []int) {
+	return file_shared_fields_fields_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *VocabularyMapping_EntityTypeMapping) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *VocabularyMapping_EntityTypeMapping) GetClassIri() string {
+	if x != nil {
+		return x.ClassIri
+	}
+	return ""
+}
+
+type VocabularyMapping_FieldMapping struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FieldName   string `protobuf:"bytes,1,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	PropertyIri string `protobuf:"bytes,2,opt,name=property_iri,json=propertyIri,proto3" json:"property_iri,omitempty"`
+}
+
+func (x *VocabularyMapping_FieldMapping) Reset() {
+	*x = VocabularyMapping_FieldMapping{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_fields_fields_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VocabularyMapping_FieldMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyMapping_FieldMapping) ProtoMessage() {}
+
+func (x *VocabularyMapping_FieldMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_fields_fields_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyMapping_FieldMapping.ProtoReflect.Descriptor instead.
+func (*VocabularyMapping_FieldMapping) Descriptor() ([]byte, []int) {
+	return file_shared_fields_fields_proto_rawDescGZIP(), []int{7, 1}
+}
+
+func (x *VocabularyMapping_FieldMapping) GetFieldName() string {
+	if x != nil {
+		return x.FieldName
+	}
+	return ""
+}
+
+func (x *VocabularyMapping_FieldMapping) GetPropertyIri() string {
+	if x != nil {
+		return x.PropertyIri
+	}
+	return ""
+}
+
+type VocabularyMapping_RelationMapping struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RelationType string `protobuf:"bytes,1,opt,name=relation_type,json=relationType,proto3" json:"relation_type,omitempty"`
+	PropertyIri  string `protobuf:"bytes,2,opt,name=property_iri,json=propertyIri,proto3" json:"property_iri,omitempty"`
+}
+
+func (x *VocabularyMapping_RelationMapping) Reset() {
+	*x = VocabularyMapping_RelationMapping{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_fields_fields_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VocabularyMapping_RelationMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyMapping_RelationMapping) ProtoMessage() {}
+
+func (x *VocabularyMapping_RelationMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_fields_fields_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyMapping_RelationMapping.ProtoReflect.Descriptor instead.
+func (*VocabularyMapping_RelationMapping) Descriptor() ([]byte, []int) {
+	return file_shared_fields_fields_proto_rawDescGZIP(), []int{7, 2}
+}
+
+func (x *VocabularyMapping_RelationMapping) GetRelationType() string {
+	if x != nil {
+		return x.RelationType
+	}
+	return ""
+}
+
+func (x *VocabularyMapping_RelationMapping) GetPropertyIri() string {
+	if x != nil {
+		return x.PropertyIri
+	}
+	return ""
+}
+
+type VocabularyMapping_DublinCoreMapping struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FieldName string `protobuf:"bytes,1,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	// One of the 15 elements of the Dublin Core Metadata Element Set, e.g. "title" or "creator".
+	Element string `protobuf:"bytes,2,opt,name=element,proto3" json:"element,omitempty"`
+}
+
+func (x *VocabularyMapping_DublinCoreMapping) Reset() {
+	*x = VocabularyMapping_DublinCoreMapping{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_fields_fields_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VocabularyMapping_DublinCoreMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyMapping_DublinCoreMapping) ProtoMessage() {}
+
+func (x *VocabularyMapping_DublinCoreMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_fields_fields_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyMapping_DublinCoreMapping.ProtoReflect.Descriptor instead.
+func (*VocabularyMapping_DublinCoreMapping) Descriptor() ([]byte, []int) {
+	return file_shared_fields_fields_proto_rawDescGZIP(), []int{7, 3}
+}
+
+func (x *VocabularyMapping_DublinCoreMapping) GetFieldName() string {
+	if x != nil {
+		return x.FieldName
+	}
+	return ""
+}
+
+func (x *VocabularyMapping_DublinCoreMapping) GetElement() string {
+	if x != nil {
+		return x.Element
+	}
+	return ""
+}
+
 var File_shared_fields_fields_proto protoreflect.FileDescriptor
 
 var file_shared_fields_fields_proto_rawDesc = []byte{
@@ -414,12 +838,83 @@ var file_shared_fields_fields_proto_rawDesc = []byte{
 	0x78, 0x44, 0x65, 0x66, 0x45, 0x78, 0x74, 0x43, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x0a,
 	0x0f, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
 	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x65,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x42, 0x47, 0x5a, 0x45, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x65, 0x73, 0x75, 0x6e, 0x64, 0x68, 0x65, 0x69, 0x74, 0x73,
-	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x72, 0x6b, 0x69, 0x2d, 0x6d, 0x65, 0x78, 0x2d, 0x6d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x6d, 0x65, 0x78, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65,
-	0x64, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x3b, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0xdc, 0x02, 0x0a, 0x15, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x44, 0x65, 0x66, 0x45, 0x78, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x12, 0x20, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x19, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x16, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x46, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x12, 0x27, 0x0a, 0x0f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x69,
+	0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa5, 0x06, 0x0a, 0x11, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75,
+	0x6c, 0x61, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x62,
+	0x61, 0x73, 0x65, 0x5f, 0x69, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62,
+	0x61, 0x73, 0x65, 0x49, 0x72, 0x69, 0x12, 0x43, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x76,
+	0x30, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x4e, 0x0a, 0x0c, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x76, 0x30, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62,
+	0x75, 0x6c, 0x61, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x0b,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x06, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x65,
+	0x78, 0x2e, 0x76, 0x30, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x47, 0x0a, 0x09, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x6d, 0x65, 0x78, 0x2e, 0x76, 0x30, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61,
+	0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4c, 0x0a, 0x0b, 0x64, 0x75, 0x62, 0x6c, 0x69, 0x6e, 0x5f, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x65, 0x78, 0x2e,
+	0x76, 0x30, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x4d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x75, 0x62, 0x6c, 0x69, 0x6e, 0x43, 0x6f, 0x72, 0x65, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x64, 0x75, 0x62, 0x6c, 0x69, 0x6e, 0x43, 0x6f,
+	0x72, 0x65, 0x1a, 0x51, 0x0a, 0x11, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x5f, 0x69, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x49, 0x72, 0x69, 0x1a, 0x50, 0x0a, 0x0c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79,
+	0x5f, 0x69, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x79, 0x49, 0x72, 0x69, 0x1a, 0x59, 0x0a, 0x0f, 0x52, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x72, 0x69, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x49,
+	0x72, 0x69, 0x1a, 0x4c, 0x0a, 0x11, 0x44, 0x75, 0x62, 0x6c, 0x69, 0x6e, 0x43, 0x6f, 0x72, 0x65,
+	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x1a, 0x3b, 0x0a, 0x0d, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x37, 0x5a,
+	0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x34, 0x6c, 0x2d,
+	0x64, 0x61, 0x74, 0x61, 0x34, 0x6c, 0x69, 0x66, 0x65, 0x2f, 0x6d, 0x65, 0x78, 0x2f, 0x6d, 0x65,
+	0x78, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x3b,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -434,25 +929,37 @@ func file_shared_fields_fields_proto_rawDescGZIP() []byte {
 	return file_shared_fields_fields_proto_rawDescData
 }
 
-var file_shared_fields_fields_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_shared_fields_fields_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_shared_fields_fields_proto_goTypes = []interface{}{
-	(*IndexDef)(nil),             // 0: mex.v0.IndexDef
-	(*FieldDef)(nil),             // 1: mex.v0.FieldDef
-	(*FieldDefList)(nil),         // 2: mex.v0.FieldDefList
-	(*IndexDefExtHierarchy)(nil), // 3: mex.v0.IndexDefExtHierarchy
-	(*IndexDefExtLink)(nil),      // 4: mex.v0.IndexDefExtLink
-	(*IndexDefExtCoding)(nil),    // 5: mex.v0.IndexDefExtCoding
-	(*anypb.Any)(nil),            // 6: google.protobuf.Any
+	(*IndexDef)(nil),                            // 0: mex.v0.IndexDef
+	(*FieldDef)(nil),                            // 1: mex.v0.FieldDef
+	(*FieldDefList)(nil),                        // 2: mex.v0.FieldDefList
+	(*IndexDefExtHierarchy)(nil),                // 3: mex.v0.IndexDefExtHierarchy
+	(*IndexDefExtLink)(nil),                     // 4: mex.v0.IndexDefExtLink
+	(*IndexDefExtCoding)(nil),                   // 5: mex.v0.IndexDefExtCoding
+	(*IndexDefExtValidation)(nil),               // 6: mex.v0.IndexDefExtValidation
+	(*VocabularyMapping)(nil),                   // 7: mex.v0.VocabularyMapping
+	(*VocabularyMapping_EntityTypeMapping)(nil), // 8: mex.v0.VocabularyMapping.EntityTypeMapping
+	(*VocabularyMapping_FieldMapping)(nil),      // 9: mex.v0.VocabularyMapping.FieldMapping
+	(*VocabularyMapping_RelationMapping)(nil),   // 10: mex.v0.VocabularyMapping.RelationMapping
+	(*VocabularyMapping_DublinCoreMapping)(nil), // 11: mex.v0.VocabularyMapping.DublinCoreMapping
+	nil,               // 12: mex.v0.VocabularyMapping.PrefixesEntry
+	(*anypb.Any)(nil), // 13: google.protobuf.Any
 }
 var file_shared_fields_fields_proto_depIdxs = []int32{
-	6, // 0: mex.v0.IndexDef.ext:type_name -> google.protobuf.Any
-	0, // 1: mex.v0.FieldDef.index_def:type_name -> mex.v0.IndexDef
-	1, // 2: mex.v0.FieldDefList.field_defs:type_name -> mex.v0.FieldDef
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	13, // 0: mex.v0.IndexDef.ext:type_name -> google.protobuf.Any
+	0,  // 1: mex.v0.FieldDef.index_def:type_name -> mex.v0.IndexDef
+	1,  // 2: mex.v0.FieldDefList.field_defs:type_name -> mex.v0.FieldDef
+	12, // 3: mex.v0.VocabularyMapping.prefixes:type_name -> mex.v0.VocabularyMapping.PrefixesEntry
+	8,  // 4: mex.v0.VocabularyMapping.entity_types:type_name -> mex.v0.VocabularyMapping.EntityTypeMapping
+	9,  // 5: mex.v0.VocabularyMapping.fields:type_name -> mex.v0.VocabularyMapping.FieldMapping
+	10, // 6: mex.v0.VocabularyMapping.relations:type_name -> mex.v0.VocabularyMapping.RelationMapping
+	11, // 7: mex.v0.VocabularyMapping.dublin_core:type_name -> mex.v0.VocabularyMapping.DublinCoreMapping
+	8,  // [8:8] is the sub-list for method output_type
+	8,  // [8:8] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_shared_fields_fields_proto_init() }
@@ -533,14 +1040,87 @@ func file_shared_fields_fields_proto_init() {
 				return nil
 			}
 		}
+		file_shared_fields_fields_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IndexDefExtValidation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_fields_fields_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VocabularyMapping); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_fields_fields_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VocabularyMapping_EntityTypeMapping); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_fields_fields_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VocabularyMapping_FieldMapping); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_fields_fields_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VocabularyMapping_RelationMapping); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_fields_fields_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VocabularyMapping_DublinCoreMapping); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
+	file_shared_fields_fields_proto_msgTypes[6].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_shared_fields_fields_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
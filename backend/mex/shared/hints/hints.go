@@ -1,3 +1,8 @@
+/*
+Package hints lets handlers suggest HTTP response headers to the REST gateway, which rewrites them accordingly.
+The hints travel as gRPC header metadata. In streaming handlers they must therefore be given before the first message
+is sent, since the headers go out with it; later hints are silently dropped.
+*/
 package hints
 
 import (
@@ -2,7 +2,6 @@ package index
 
 import (
 	"context"
-	"time"
 
 	"github.com/go-redis/redis/v8"
 
@@ -14,15 +13,17 @@ type Announcer interface {
 	AnnounceBusinessItemID(businessID string)
 }
 
+/*
+RedisAnnouncer publishes the IDs of new items. The announcements are only a trigger for the index service: the business
+IDs to be indexed are recorded in the index outbox within the transaction of the items, so a lost announcement merely
+delays the indexing until the next poll of the outbox.
+*/
 type RedisAnnouncer struct {
 	Log   L.Logger
 	Redis *redis.Client
 
 	TechnicalIDsTopicName string
 	BusinessIDsTopicName  string
-	BusinessIDSetName     string
-
-	SetExpiration time.Duration
 }
 
 func (idx *RedisAnnouncer) AnnounceTechnicalItemID(technicalID string) {
@@ -34,17 +35,6 @@ func (idx *RedisAnnouncer) AnnounceTechnicalItemID(technicalID string) {
 
 func (idx *RedisAnnouncer) AnnounceBusinessItemID(businessID string) {
 	ctx := context.Background()
-
-	// Add the item ID to the set so it is recorded for later indexing in case the below (*) broadcast is somehow lost.
-	if cmdSAdd := idx.Redis.SAdd(ctx, idx.BusinessIDSetName, businessID); cmdSAdd.Err() != nil {
-		idx.Log.Warn(ctx, L.Messagef("could not add business ID to indexer set: %s (%s)", businessID, cmdSAdd.Err().Error()))
-	}
-
-	if idx.Redis.Expire(ctx, idx.BusinessIDSetName, idx.SetExpiration).Err() != nil {
-		idx.Log.Warn(ctx, L.Message("could not reset indexer expiration time"))
-	}
-
-	// (*) Broadcast the item ID.
 	if cmdPublish := idx.Redis.Publish(ctx, idx.BusinessIDsTopicName, businessID); cmdPublish.Err() != nil {
 		idx.Log.Warn(ctx, L.Messagef("could not publish business ID: %s: %s", businessID, cmdPublish.Err().Error()))
 	}
@@ -23,6 +23,21 @@ func NewHeaderInterceptor(key string, key2 any) grpc.UnaryServerInterceptor {
 	}
 }
 
+// NewHeaderStreamInterceptor is the streaming counterpart of NewHeaderInterceptor.
+func NewHeaderStreamInterceptor(key string, key2 any) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		md, ok := metadata.FromIncomingContext(ss.Context())
+		if ok {
+			value := md.Get(key)
+			if len(value) > 0 {
+				return handler(srv, WrapServerStream(ss, context.WithValue(ss.Context(), key2, value[0])))
+			}
+		}
+
+		return handler(srv, ss)
+	}
+}
+
 func HeaderReader(key string, header string) func(context.Context, *http.Request) metadata.MD {
 	return func(ctx context.Context, r *http.Request) metadata.MD {
 		return metadata.Pairs(key, r.Header.Get(header))
@@ -0,0 +1,47 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/d4l-data4life/mex/mex/shared/errstat"
+)
+
+// NewErrorStatusInterceptor makes sure that handlers only ever return gRPC status errors.
+// Plain errors would otherwise reach the client with code Unknown.
+func NewErrorStatusInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		return resp, toStatusError(err)
+	}
+}
+
+// NewErrorStatusStreamInterceptor is the streaming counterpart of NewErrorStatusInterceptor.
+func NewErrorStatusStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return toStatusError(handler(srv, ss))
+	}
+}
+
+func toStatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return errstat.MakeGRPCStatus(codes.Canceled, err.Error()).Err()
+	case errors.Is(err, context.DeadlineExceeded):
+		return errstat.MakeGRPCStatus(codes.DeadlineExceeded, err.Error()).Err()
+	default:
+		return errstat.MakeGRPCStatus(codes.Internal, err.Error()).Err()
+	}
+}
@@ -0,0 +1,70 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type testStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testStream) Context() context.Context {
+	return s.ctx
+}
+
+type testKey string
+
+func TestErrorStatusStreamInterceptor(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{name: "no error", err: nil, code: codes.OK},
+		{name: "status error", err: status.Error(codes.NotFound, "gone"), code: codes.NotFound},
+		{name: "plain error", err: errors.New("boom"), code: codes.Internal},
+		{name: "canceled", err: fmt.Errorf("reading: %w", context.Canceled), code: codes.Canceled},
+		{name: "deadline", err: context.DeadlineExceeded, code: codes.DeadlineExceeded},
+	}
+
+	interceptor := NewErrorStatusStreamInterceptor()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := interceptor(nil, &testStream{ctx: context.Background()}, &grpc.StreamServerInfo{}, func(srv any, ss grpc.ServerStream) error {
+				return tt.err
+			})
+
+			st, ok := status.FromError(err)
+			if !ok {
+				t.Fatalf("not a status error: %v", err)
+			}
+			if st.Code() != tt.code {
+				t.Errorf("wrong code: got %v, want %v", st.Code(), tt.code)
+			}
+		})
+	}
+}
+
+func TestHeaderStreamInterceptor(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-trace", "trace-0001"))
+
+	interceptor := NewHeaderStreamInterceptor("x-trace", testKey("trace"))
+	err := interceptor(nil, &testStream{ctx: ctx}, &grpc.StreamServerInfo{}, func(srv any, ss grpc.ServerStream) error {
+		if v := ss.Context().Value(testKey("trace")); v != "trace-0001" {
+			t.Errorf("wrong context value: %v", v)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,206 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/d4l-data4life/mex/mex/shared/known/jobspb"
+)
+
+// MockJob is the state of a job kept by the MockJobber.
+type MockJob struct {
+	Title   string
+	Status  string
+	Error   string
+	Logs    []string
+	ItemIDs []string
+}
+
+// MockJobber keeps jobs and locks in memory.
+type MockJobber struct {
+	mu     sync.Mutex
+	jobs   map[string]*MockJob
+	locks  map[string]string
+	nextID int
+}
+
+func NewMockJobber() *MockJobber {
+	return &MockJobber{
+		jobs:  map[string]*MockJob{},
+		locks: map[string]string{},
+	}
+}
+
+// Job returns a copy of the job with the given ID (nil if there is no such job).
+func (j *MockJobber) Job(jobID string) *MockJob {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	job, ok := j.jobs[jobID]
+	if !ok {
+		return nil
+	}
+	jobCopy := *job
+	jobCopy.Logs = append([]string{}, job.Logs...)
+	jobCopy.ItemIDs = append([]string{}, job.ItemIDs...)
+	return &jobCopy
+}
+
+// Locked returns true if the lock for the given resource is held.
+func (j *MockJobber) Locked(resourceName string) bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	_, ok := j.locks[resourceName]
+	return ok
+}
+
+func (j *MockJobber) job(jobID string) (*MockJob, error) {
+	job, ok := j.jobs[jobID]
+	if !ok {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("job not found: %s", jobID))
+	}
+	return job, nil
+}
+
+func (j *MockJobber) CreateJob(_ context.Context, request *jobspb.CreateJobRequest) (*jobspb.CreateJobResponse, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.nextID++
+	jobID := fmt.Sprintf("job-%d", j.nextID)
+	j.jobs[jobID] = &MockJob{Title: request.Title, Status: StatusCreated}
+	return &jobspb.CreateJobResponse{JobId: jobID}, nil
+}
+
+func (j *MockJobber) GetJob(_ context.Context, request *jobspb.GetJobRequest) (*jobspb.GetJobResponse, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	job, err := j.job(request.JobId)
+	if err != nil {
+		return nil, err
+	}
+	return &jobspb.GetJobResponse{
+		JobId:    request.JobId,
+		Title:    job.Title,
+		Status:   job.Status,
+		Error:    job.Error,
+		LogCount: int32(len(job.Logs)),
+	}, nil
+}
+
+func (j *MockJobber) JobExists(_ context.Context, jobID string) bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	_, ok := j.jobs[jobID]
+	return ok
+}
+
+func (j *MockJobber) GetLogs(_ context.Context, request *jobspb.GetJobLogsRequest) (*jobspb.GetJobLogsResponse, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	job, err := j.job(request.JobId)
+	if err != nil {
+		return nil, err
+	}
+	return &jobspb.GetJobLogsResponse{JobId: request.JobId, Logs: append([]string{}, job.Logs...)}, nil
+}
+
+func (j *MockJobber) AddLogs(_ context.Context, request *jobspb.AddJobLogsRequest) (*jobspb.AddJobLogsResponse, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	job, err := j.job(request.JobId)
+	if err != nil {
+		return nil, err
+	}
+	job.Logs = append(job.Logs, request.Logs...)
+	return &jobspb.AddJobLogsResponse{JobId: request.JobId, LogCount: int32(len(job.Logs))}, nil
+}
+
+func (j *MockJobber) GetItems(_ context.Context, request *jobspb.GetJobItemsRequest) (*jobspb.GetJobItemsResponse, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	job, err := j.job(request.JobId)
+	if err != nil {
+		return nil, err
+	}
+	return &jobspb.GetJobItemsResponse{JobId: request.JobId, ItemIds: append([]string{}, job.ItemIDs...)}, nil
+}
+
+func (j *MockJobber) AddItems(_ context.Context, request *jobspb.AddJobItemsRequest) (*jobspb.AddJobItemsResponse, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	job, err := j.job(request.JobId)
+	if err != nil {
+		return nil, err
+	}
+	job.ItemIDs = append(job.ItemIDs, request.ItemIds...)
+	return &jobspb.AddJobItemsResponse{JobId: request.JobId, ItemCount: int32(len(job.ItemIDs))}, nil
+}
+
+func (j *MockJobber) SetStatus(_ context.Context, request *jobspb.SetJobStatusRequest) (*jobspb.SetJobStatusResponse, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	job, err := j.job(request.JobId)
+	if err != nil {
+		return nil, err
+	}
+	job.Status = request.Status
+	return &jobspb.SetJobStatusResponse{JobId: request.JobId, Status: request.Status}, nil
+}
+
+func (j *MockJobber) SetStatusRunning(ctx context.Context, jobID string) error {
+	_, err := j.SetStatus(ctx, &jobspb.SetJobStatusRequest{JobId: jobID, Status: StatusRunning})
+	return err
+}
+
+func (j *MockJobber) SetStatusDone(ctx context.Context, jobID string) error {
+	_, err := j.SetStatus(ctx, &jobspb.SetJobStatusRequest{JobId: jobID, Status: StatusDone})
+	return err
+}
+
+func (j *MockJobber) SetError(_ context.Context, request *jobspb.SetJobErrorRequest) (*jobspb.SetJobErrorResponse, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	job, err := j.job(request.JobId)
+	if err != nil {
+		return nil, err
+	}
+	job.Error = request.Error
+	return &jobspb.SetJobErrorResponse{JobId: request.JobId}, nil
+}
+
+func (j *MockJobber) AcquireLock(_ context.Context, resourceName string) (string, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if _, ok := j.locks[resourceName]; ok {
+		return "", fmt.Errorf("could not acquire lock for resource %s", resourceName)
+	}
+	j.nextID++
+	handle := fmt.Sprintf("lock-%d", j.nextID)
+	j.locks[resourceName] = handle
+	return handle, nil
+}
+
+func (j *MockJobber) ReleaseLock(_ context.Context, resourceName string, handle string) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.locks[resourceName] == handle {
+		delete(j.locks, resourceName)
+	}
+	return nil
+}
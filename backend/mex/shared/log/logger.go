@@ -27,3 +27,27 @@ func NewLogInterceptor(log Logger, excludePatterns []string) grpc.UnaryServerInt
 		return resp, err
 	}
 }
+
+// NewLogStreamInterceptor is the streaming counterpart of NewLogInterceptor.
+func NewLogStreamInterceptor(log Logger, excludePatterns []string) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		// Bypass logs for excluded paths
+		for _, pattern := range excludePatterns {
+			if pattern == info.FullMethod {
+				return handler(srv, ss)
+			}
+		}
+
+		ctx := ss.Context()
+		log.Info(ctx, Messagef(">>> %s (stream)", info.FullMethod))
+
+		err := handler(srv, ss)
+		if err != nil {
+			log.Warn(ctx, Message(err.Error()))
+		}
+
+		log.Info(ctx, Messagef("<<< %s (stream)", info.FullMethod))
+
+		return err
+	}
+}
@@ -0,0 +1,39 @@
+package flowmailer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-redis/redis/v8"
+
+	"github.com/d4l-data4life/mex/mex/shared/cfg"
+	L "github.com/d4l-data4life/mex/mex/shared/log"
+	"github.com/d4l-data4life/mex/mex/shared/mail"
+)
+
+// NewMailer creates the mailer of the configured type.
+func NewMailer(ctx context.Context, log L.Logger, config *cfg.MexConfig, redisClient *redis.Client) (mail.Mailer, error) {
+	switch config.Notify.EmailerType {
+	case cfg.EmailerType_MOCKMAILER:
+		log.Warn(ctx, L.Message("using mockmailer"))
+		return NewMockMailer(redisClient), nil
+
+	case cfg.EmailerType_FLOWMAILER:
+		mailer, err := NewSimpleFlowmailer(Params{
+			OriginOAuth:         config.Notify.Flowmailer.OriginOauth,
+			OriginAPI:           config.Notify.Flowmailer.OriginApi,
+			ClientID:            config.Notify.Flowmailer.ClientId,
+			ClientSecret:        config.Notify.Flowmailer.ClientSecret,
+			AccountID:           config.Notify.Flowmailer.AccountId,
+			NoReplyEmailAddress: config.Notify.Flowmailer.NoreplyEmailAddress,
+			Timeout:             config.Web.ReadTimeout.AsDuration(), // reuse
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to init Flowmailer client: %w", err)
+		}
+		return mailer, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported emailer type: %d", config.Notify.EmailerType)
+	}
+}
@@ -0,0 +1,37 @@
+package mail
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// RetrieveTemplate fetches the mail template with the given name from the config service.
+func RetrieveTemplate(configServiceOrigin string, templateName string) (*MailTemplate, error) {
+	client := &http.Client{Timeout: time.Second}
+	resp, err := client.Get(fmt.Sprintf("%s/api/v0/config/files/mail_templates/%s", configServiceOrigin, templateName))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not fetch template %q: status code %d", templateName, resp.StatusCode)
+	}
+
+	buf, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	mailTemplate := MailTemplate{}
+	err = protojson.Unmarshal(buf, &mailTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mailTemplate, nil
+}
@@ -18,6 +18,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	L "github.com/d4l-data4life/mex/mex/shared/log"
+	"github.com/d4l-data4life/mex/mex/shared/utils"
 )
 
 // ClientAPI specifies the interface for a Solr client
@@ -43,10 +44,19 @@ type ClientAPI interface {
 	DeleteCollection(ctx context.Context, collectionName string) error
 	CreateCollection(ctx context.Context, collectionName string, configsetName string, replicationFactor uint32) error
 
+	GetAliases(ctx context.Context) (map[string]string, error)
+	CreateAlias(ctx context.Context, aliasName string, collectionName string) error
+
+	// ForCollection returns a client with the same settings for another collection (or alias)
+	ForCollection(collectionName string) ClientAPI
+
 	DropIndex(ctx context.Context) error
 
 	AddDocuments(ctx context.Context, docs []string, num int) error
 	RemoveDocuments(ctx context.Context, docIDs []string) error
+	RemoveDocumentsByQuery(ctx context.Context, query string) error
+	Commit(ctx context.Context) error
+	CountDocuments(ctx context.Context) (int64, error)
 
 	GetClusterStatus(ctx context.Context) (*ClusterStatus, int, error)
 	Ping(ctx context.Context) error
@@ -110,6 +120,16 @@ type AdminCollections struct {
 	Collections []string `json:"collections"`
 }
 
+type AdminAliases struct {
+	Aliases map[string]string `json:"aliases"`
+}
+
+type documentCountResponse struct {
+	Response struct {
+		NumFound int64 `json:"numFound"`
+	} `json:"response"`
+}
+
 // Client represents the API of a specific Solr instance & collection
 type solrClient struct {
 	log L.Logger
@@ -568,11 +588,58 @@ func (c *solrClient) CreateCollection(ctx context.Context, collectionName string
 	return nil
 }
 
+func (c *solrClient) ForCollection(collectionName string) ClientAPI {
+	client := *c
+	client.collection = collectionName
+	return &client
+}
+
+// GetAliases returns the collection(s) each alias points to, keyed by alias name
+func (c *solrClient) GetAliases(ctx context.Context) (map[string]string, error) {
+	statusCode, responseBody, err := c.DoRequest(ctx, "GET", "/solr/admin/collections?action=LISTALIASES&wt=json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode != http.StatusOK {
+		return nil, fmt.Errorf("get admin aliases status code: %d", statusCode)
+	}
+
+	var adminAliases AdminAliases
+	err = json.Unmarshal(responseBody, &adminAliases)
+	if err != nil {
+		return nil, err
+	}
+	if adminAliases.Aliases == nil {
+		adminAliases.Aliases = map[string]string{}
+	}
+
+	return adminAliases.Aliases, nil
+}
+
+// CreateAlias creates an alias or atomically switches an existing alias to the given collection
+func (c *solrClient) CreateAlias(ctx context.Context, aliasName string, collectionName string) error {
+	c.log.Trace(ctx, L.Messagef("CreateAlias: alias: '%s', collection: '%s'", aliasName, collectionName), L.Phase("solr-client"))
+
+	statusCode, body, err := c.DoRequest(ctx, "GET",
+		fmt.Sprintf(`/solr/admin/collections?action=CREATEALIAS&name=%s&collections=%s&wt=json`, url.QueryEscape(aliasName), url.QueryEscape(collectionName)), nil)
+	if err != nil {
+		return err
+	}
+
+	if statusCode != http.StatusOK {
+		return fmt.Errorf("alias creation failed, status code: %d (%s)", statusCode, string(body))
+	}
+
+	return nil
+}
+
 func (c *solrClient) DeleteCollection(ctx context.Context, collectionName string) error {
 	c.log.Trace(ctx, L.Messagef("DeleteCollection: collection: '%s'", collectionName), L.Phase("solr-client"))
 
+	// The collection itself is deleted even if an alias of the same name shadows it.
 	statusCode, body, err := c.DoRequest(ctx, "GET",
-		fmt.Sprintf(`/solr/admin/collections?action=DELETE&name=%s&wt=json`, collectionName), nil)
+		fmt.Sprintf(`/solr/admin/collections?action=DELETE&name=%s&followAliases=false&wt=json`, collectionName), nil)
 	if err != nil {
 		return err
 	}
@@ -688,3 +755,46 @@ func (c *solrClient) RemoveDocuments(ctx context.Context, docIDs []string) error
 	}
 	return nil
 }
+
+// Commit makes all pending document changes visible
+func (c *solrClient) Commit(ctx context.Context) error {
+	c.log.Trace(ctx, L.Message("Commit"), L.Phase("solr-client"))
+
+	_, _, err := c.DoRequest(ctx, "POST", fmt.Sprintf("/solr/%s/update?commit=true", c.collection), []byte(`<commit/>`))
+	return err
+}
+
+// CountDocuments returns the number of (committed) documents in the collection
+func (c *solrClient) CountDocuments(ctx context.Context) (int64, error) {
+	statusCode, responseBody, err := c.DoRequest(ctx, "GET", fmt.Sprintf("/solr/%s/select?q=*:*&rows=0&wt=json", c.collection), nil)
+	if err != nil {
+		return 0, err
+	}
+
+	if statusCode != http.StatusOK {
+		return 0, fmt.Errorf("document count status code: %d", statusCode)
+	}
+
+	var countResponse documentCountResponse
+	err = json.Unmarshal(responseBody, &countResponse)
+	if err != nil {
+		return 0, err
+	}
+
+	return countResponse.Response.NumFound, nil
+}
+
+// RemoveDocumentsByQuery removes all documents matching the given Solr (standard query parser) query.
+func (c *solrClient) RemoveDocumentsByQuery(ctx context.Context, query string) error {
+	c.log.Trace(ctx, L.Messagef("RemoveDocumentsByQuery: query length: %d", len(query)), L.Phase("solr-client"))
+	if query == "" {
+		return nil
+	}
+
+	_, _, err := c.DoRequest(
+		ctx, "POST",
+		fmt.Sprintf("/solr/%s/update", c.collection),
+		[]byte(fmt.Sprintf(`<delete commitWithin="%d"><query>%s</query></delete>`, c.commitWithin/time.Millisecond, utils.SanitizeXML(query))),
+	)
+	return err
+}
@@ -77,6 +77,7 @@ const (
 	EditLowerCutoff       = 4
 	EditUpperCutoff       = 10
 	MaxDocLimit           = 1000
+	CursorMarkStart       = "*"
 	MaxFacetLimit         = 1000
 	FacetPrefix           = "facet"
 	TagPostfix            = "tag"
@@ -108,8 +109,10 @@ const (
 	DefaultSolrBatchSize  = 25
 
 	// Standard extension elements in field definition
-	HierarchyExtID = "mex.v0.IndexDefExtHierarchy"
-	LinkExtID      = "mex.v0.IndexDefExtLink"
+	HierarchyExtID  = "mex.v0.IndexDefExtHierarchy"
+	LinkExtID       = "mex.v0.IndexDefExtLink"
+	CodingExtID     = "mex.v0.IndexDefExtCoding"
+	ValidationExtID = "mex.v0.IndexDefExtValidation"
 )
 
 var AllowedSortOrders = []string{"asc", "desc"}
@@ -25,7 +25,42 @@ type MockClient struct {
 	FieldsRemoved        []string
 	CopyFieldsRemoved    []string
 	DynamicFieldsRemoved []string
+	QueriesRemoved       []string
 	DocsUploaded         int
+
+	// If set, collections and aliases are kept in the cluster, and ForCollection returns one client per collection.
+	Cluster *MockCluster
+}
+
+// MockCluster holds the collections and aliases of the Solr instance shared by the clients of several collections
+type MockCluster struct {
+	Collections []string
+	Aliases     map[string]string
+	// Admin operations in order, e.g. "CREATEALIAS mex mex_20230601123005"
+	Operations []string
+
+	clients map[string]*MockClient
+}
+
+// NewMockCluster returns a cluster with the given collections and aliases; use Client to get the client of one of them.
+func NewMockCluster(collections []string, aliases map[string]string) *MockCluster {
+	if aliases == nil {
+		aliases = map[string]string{}
+	}
+	return &MockCluster{Collections: collections, Aliases: aliases, Operations: []string{}, clients: map[string]*MockClient{}}
+}
+
+// Client returns the client of the given collection (or alias) of the cluster.
+func (cluster *MockCluster) Client(collectionName string) *MockClient {
+	client, ok := cluster.clients[collectionName]
+	if !ok {
+		mockClient := NewMockClient(false, DefaultUniqueKey, ReturnVals{})
+		mockClient.Core = collectionName
+		mockClient.Cluster = cluster
+		client = &mockClient
+		cluster.clients[collectionName] = client
+	}
+	return client
 }
 
 type ReturnVals struct {
@@ -51,6 +86,7 @@ func NewMockClient(alwaysFails bool, uniqueID string, returnVals ReturnVals) Moc
 		FieldsRemoved:        []string{},
 		CopyFieldsRemoved:    []string{},
 		DynamicFieldsRemoved: []string{},
+		QueriesRemoved:       []string{},
 		DocsUploaded:         0,
 	}
 }
@@ -154,20 +190,68 @@ func (solrClient *MockClient) GetCollections(_ context.Context) ([]string, error
 	if solrClient.AlwaysFail {
 		return []string{}, fmt.Errorf("provoked error")
 	}
+	if solrClient.Cluster != nil {
+		return append([]string{}, solrClient.Cluster.Collections...), nil
+	}
 	return []string{}, nil
 }
 
-func (solrClient *MockClient) CreateCollection(_ context.Context, _ string, _ string, _ uint32) error {
+func (solrClient *MockClient) CreateCollection(_ context.Context, collectionName string, _ string, _ uint32) error {
 	if solrClient.AlwaysFail {
 		return fmt.Errorf("provoked error")
 	}
+	if cluster := solrClient.Cluster; cluster != nil {
+		cluster.Collections = append(cluster.Collections, collectionName)
+		cluster.Operations = append(cluster.Operations, "CREATE "+collectionName)
+	}
 	return nil
 }
 
-func (solrClient *MockClient) DeleteCollection(_ context.Context, _ string) error {
+func (solrClient *MockClient) GetAliases(_ context.Context) (map[string]string, error) {
+	if solrClient.AlwaysFail {
+		return nil, fmt.Errorf("provoked error")
+	}
+	aliases := map[string]string{}
+	if solrClient.Cluster != nil {
+		for alias, collection := range solrClient.Cluster.Aliases {
+			aliases[alias] = collection
+		}
+	}
+	return aliases, nil
+}
+
+func (solrClient *MockClient) CreateAlias(_ context.Context, aliasName string, collectionName string) error {
 	if solrClient.AlwaysFail {
 		return fmt.Errorf("provoked error")
 	}
+	if cluster := solrClient.Cluster; cluster != nil {
+		cluster.Aliases[aliasName] = collectionName
+		cluster.Operations = append(cluster.Operations, fmt.Sprintf("CREATEALIAS %s %s", aliasName, collectionName))
+	}
+	return nil
+}
+
+func (solrClient *MockClient) ForCollection(collectionName string) ClientAPI {
+	if solrClient.Cluster != nil {
+		return solrClient.Cluster.Client(collectionName)
+	}
+	return solrClient
+}
+
+func (solrClient *MockClient) DeleteCollection(_ context.Context, collectionName string) error {
+	if solrClient.AlwaysFail {
+		return fmt.Errorf("provoked error")
+	}
+	if cluster := solrClient.Cluster; cluster != nil {
+		remaining := []string{}
+		for _, collection := range cluster.Collections {
+			if collection != collectionName {
+				remaining = append(remaining, collection)
+			}
+		}
+		cluster.Collections = remaining
+		cluster.Operations = append(cluster.Operations, "DELETE "+collectionName)
+	}
 	return nil
 }
 
@@ -206,3 +290,25 @@ func (solrClient *MockClient) RemoveDocuments(_ context.Context, _ []string) err
 	}
 	return nil
 }
+
+func (solrClient *MockClient) RemoveDocumentsByQuery(_ context.Context, query string) error {
+	if solrClient.AlwaysFail {
+		return fmt.Errorf("provoked error")
+	}
+	solrClient.QueriesRemoved = append(solrClient.QueriesRemoved, query)
+	return nil
+}
+
+func (solrClient *MockClient) Commit(_ context.Context) error {
+	if solrClient.AlwaysFail {
+		return fmt.Errorf("provoked error")
+	}
+	return nil
+}
+
+func (solrClient *MockClient) CountDocuments(_ context.Context) (int64, error) {
+	if solrClient.AlwaysFail {
+		return 0, fmt.Errorf("provoked error")
+	}
+	return int64(solrClient.DocsUploaded), nil
+}
@@ -128,6 +128,7 @@ type ParamObj struct {
 	HlFragsize uint32 `json:"hl.fragsize,omitempty"`
 	HlTagPre   string `json:"hl.tag.pre,omitempty"`
 	HlTagPost  string `json:"hl.tag.post,omitempty"`
+	CursorMark string `json:"cursorMark,omitempty"`
 }
 
 // QueryBody represents the body of a query for the Solr JSON query API
@@ -157,6 +158,8 @@ type QueryResponse struct {
 	Facets       map[string]interface{} `json:"facets"`       // facet is a JSON object with freely chosen labels as the top-level properties
 	Highlighting map[string]interface{} `json:"highlighting"` // highlighting is a JSON object with the document IDs as the top-level properties
 	Error        map[string]interface{} `json:"error"`        // error is a JSON object with freely chosen labels as the top-level properties
+	// nextCursorMark is only returned for cursor-based paging; it equals the passed cursor mark if there are no more results
+	NextCursorMark string `json:"nextCursorMark,omitempty"`
 }
 
 // CopyFieldResponse represents the SOlr information for a single copy field returned by Solr
@@ -25,7 +25,9 @@ import (
 	"google.golang.org/grpc/grpclog"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/d4l-data4life/mex/mex/shared/audit"
 	"github.com/d4l-data4life/mex/mex/shared/auth"
+	"github.com/d4l-data4life/mex/mex/shared/auth/apikeys"
 	"github.com/d4l-data4life/mex/mex/shared/auth/authn"
 	"github.com/d4l-data4life/mex/mex/shared/certs"
 	"github.com/d4l-data4life/mex/mex/shared/cfg"
@@ -94,10 +96,16 @@ type SetupOpts struct {
 	TelemetryService  *telemetry.Service
 	TopicConfigChange *rdb.Topic
 	PromRegistry      *prometheus.Registry
+	PrivMgr           *auth.PrivMgr
 }
 
 type SetupFunc = func(ctx context.Context, opts SetupOpts) error
 
+// AuditStreamName returns the name of the Redis stream queueing the audit entries of services without database access.
+func AuditStreamName(config *cfg.MexConfig) string {
+	return fmt.Sprintf("%s/%s", config.Redis.PubSubPrefix, constants.AuditStreamNameSuffix)
+}
+
 type Support struct {
 	Postgres       bool
 	Solr           bool
@@ -325,7 +333,11 @@ func Run(ctx context.Context, opts RunOpts) error {
 	}
 
 	opts.Log.Info(ctx, L.Messagef("configured API keys: %d", apiKeyAuthn.Count()))
-	if apiKeyAuthn.Count() == 0 {
+	if pgClient != nil {
+		// Managed API keys live in the database.
+		apiKeyAuthn.SetManagedKeys(&apikeys.Store{DB: pgClient, Log: opts.Log})
+		opts.Log.Info(ctx, L.Message("managed API keys enabled"))
+	} else if apiKeyAuthn.Count() == 0 {
 		opts.Log.Info(ctx, L.Message("no API keys configured; service will not accept requests with API keys"))
 	}
 
@@ -334,6 +346,32 @@ func Run(ctx context.Context, opts RunOpts) error {
 		securitypb.AuthenticationType_API_KEY: apiKeyAuthn,
 	}
 
+	visibilityRules, err := auth.ParseVisibilityRules(opts.Config.AccessControl.VisibilityRules)
+	if err != nil {
+		cancel()
+		return fmt.Errorf("failed to parse visibility rules: %s", err.Error())
+	}
+	privMgr := auth.NewPrivMgr()
+	privMgr.SetVisibilityRules(visibilityRules)
+	if len(visibilityRules.GroupRules) > 0 {
+		opts.Log.Info(ctx, L.Messagef("configured visibility rules: %d group(s)", len(visibilityRules.GroupRules)))
+	}
+
+	// Roles beyond the built-in ones come from the config repository and are reloaded on config updates.
+	// Without them, requests are authorized with fewer privileges, so a failure to load them is not fatal.
+	if !cfg.StringIsEmpty(opts.Config.Services.Config.Origin) {
+		rolesLoader := &auth.RolesLoader{
+			Log:                 opts.Log,
+			PrivMgr:             privMgr,
+			OriginCMS:           opts.Config.Services.Config.Origin,
+			StrictConfigParsing: opts.Config.Strictness.StrictJsonParsing.Config,
+		}
+		if err := rolesLoader.Load(ctx); err != nil {
+			opts.Log.Warn(ctx, L.Messagef("could not load roles, using the built-in ones until the next config update: %s", err.Error()))
+		}
+		topicConfigChange.Subscribe(rolesLoader)
+	}
+
 	// ------------------------------------------------------------------------------------------------------
 	// Key stores
 	var tokenValidator keys.TokenValidator
@@ -366,8 +404,16 @@ func Run(ctx context.Context, opts RunOpts) error {
 			return err
 		}
 
+		// Cached revocation checks are dropped whenever the auth service announces a revocation.
+		revocationTopicName := fmt.Sprintf("%s/%s", opts.Config.Redis.PubSubPrefix, constants.TokenRevocationChannelNameSuffix)
+		revocationList := auth.NewRevocationList(redisClient, revocationTopicName, opts.Config.Oauth.RevocationCacheTtl.AsDuration())
+		if revocationList.CacheTTL > 0 {
+			rdb.NewTopic(ctx, opts.Log, redisClient, revocationTopicName).Subscribe(revocationList)
+		}
+
 		authJWT, err := authn.NewJWTAuthenticator(
 			tokenValidator,
+			revocationList,
 			opts.Config.Oauth.ClientId,
 			opts.Config.Oauth.ConsumerGroupId,
 			opts.Config.Oauth.ProducerGroupId,
@@ -381,6 +427,17 @@ func Run(ctx context.Context, opts RunOpts) error {
 		telemetryService.AddPinger(pingers.NewKeystorePinger(tokenValidator, opts.Config.Telemetry.PingerUpdateInterval.AsDuration()))
 	}
 
+	// ------------------------------------------------------------------------------------------------------
+	// Audit trail
+	// Services with database access write the audit log directly; the others queue their entries in Redis,
+	// from where the metadata service moves them to the database.
+	var auditSink audit.Sink
+	if pgClient != nil {
+		auditSink = &audit.PostgresStore{DB: pgClient}
+	} else {
+		auditSink = &audit.RedisSink{Redis: redisClient, Stream: AuditStreamName(opts.Config)}
+	}
+
 	opts.Log.Info(ctx, L.Message("--[ Services ]------------------------------------------------------"), L.PhaseStartup)
 
 	// ------------------------------------------------------------------------------------------------------
@@ -399,19 +456,38 @@ func Run(ctx context.Context, opts RunOpts) error {
 	// Start gRPC service
 	grpclog.SetLoggerV2(grpc_adapt.GRPCLogger{Logger: opts.Log, Level: grpc_adapt.ParseLevel(opts.Config.Logging.LogLevelGrpc)})
 
+	logExcludes := []string{
+		"/d4l.mex.telemetry.Telemetry/ReadinessProbe",
+		"/d4l.mex.telemetry.Telemetry/LivenessProbe",
+	}
+
 	ints := []grpc.UnaryServerInterceptor{
+		interceptors.NewErrorStatusInterceptor(),
 		interceptors.NewHeaderInterceptor(string(constants.ContextKeyTraceThis), constants.ContextKeyTraceThis),
 		interceptors.NewHeaderInterceptor(string(constants.ContextKeyTraceID), constants.ContextKeyTraceID),
 		interceptors.NewHeaderInterceptor(string(constants.ContextKeyJobID), constants.ContextKeyJobID),
 		interceptors.NewHeaderInterceptor(string(constants.ContextKeyRequestID), constants.ContextKeyRequestID),
-		L.NewLogInterceptor(opts.Log, []string{
-			"/d4l.mex.telemetry.Telemetry/ReadinessProbe",
-			"/d4l.mex.telemetry.Telemetry/LivenessProbe",
-		}),
-		auth.NewInterceptor(authnRegistry, auth.NewPrivMgr()),
+		L.NewLogInterceptor(opts.Log, logExcludes),
+		audit.NewInterceptor(auditSink, opts.ServiceTag, opts.Log),
+		auth.NewInterceptor(authnRegistry, privMgr),
 	}
 
-	grpcServer := grpc.NewServer(grpc.MaxRecvMsgSize(int(opts.Config.Web.MaxBodyBytes)), grpc.ChainUnaryInterceptor(ints...))
+	streamInts := []grpc.StreamServerInterceptor{
+		interceptors.NewErrorStatusStreamInterceptor(),
+		interceptors.NewHeaderStreamInterceptor(string(constants.ContextKeyTraceThis), constants.ContextKeyTraceThis),
+		interceptors.NewHeaderStreamInterceptor(string(constants.ContextKeyTraceID), constants.ContextKeyTraceID),
+		interceptors.NewHeaderStreamInterceptor(string(constants.ContextKeyJobID), constants.ContextKeyJobID),
+		interceptors.NewHeaderStreamInterceptor(string(constants.ContextKeyRequestID), constants.ContextKeyRequestID),
+		L.NewLogStreamInterceptor(opts.Log, logExcludes),
+		audit.NewStreamInterceptor(auditSink, opts.ServiceTag, opts.Log),
+		auth.NewStreamInterceptor(authnRegistry, privMgr),
+	}
+
+	grpcServer := grpc.NewServer(
+		grpc.MaxRecvMsgSize(int(opts.Config.Web.MaxBodyBytes)),
+		grpc.ChainUnaryInterceptor(ints...),
+		grpc.ChainStreamInterceptor(streamInts...),
+	)
 	reflection.Register(grpcServer)
 
 	lis, err := net.Listen("tcp", opts.Config.Web.GrpcHost)
@@ -465,6 +541,7 @@ func Run(ctx context.Context, opts RunOpts) error {
 		TelemetryService:  telemetryService,
 		TopicConfigChange: topicConfigChange,
 		PromRegistry:      promRegistry,
+		PrivMgr:           privMgr,
 	})
 	if err != nil {
 		cancel()
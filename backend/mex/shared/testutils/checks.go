@@ -114,6 +114,14 @@ func CheckOffset(expectedOffset uint32) BodyCheck {
 	}
 }
 
+func CheckCursorMark(expectedCursorMark string) BodyCheck {
+	return func(body *solr.QueryBody, t *testing.T) {
+		if body.Params.CursorMark != expectedCursorMark {
+			t.Errorf("incorrect cursor mark: wanted '%s', got '%s'", expectedCursorMark, body.Params.CursorMark)
+		}
+	}
+}
+
 func CheckFields(expectedFields []string) BodyCheck {
 	return func(body *solr.QueryBody, t *testing.T) {
 		sort.Strings(expectedFields)